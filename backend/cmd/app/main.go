@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
+	"strings"
 	"template/internal/ent/db"
+	"template/internal/features/trash"
 	"template/internal/graph"
 	"template/internal/route"
 	"template/internal/shared/environment"
@@ -19,11 +22,13 @@ func main() {
 	}
 
 	db.InitDatabase()
+	trash.StartPurgeScheduler(context.Background(), environment.TRASH_RETENTION)
 	router := gin.Default()
 
-	// Configure CORS to allow all origins
+	// Configure CORS to allow the configured origins, or all origins when none is configured
 	router.Use(cors.New(cors.Config{
-		AllowAllOrigins:  true,
+		AllowAllOrigins:  len(environment.ALLOWED_ORIGINS) == 0,
+		AllowOrigins:     environment.ALLOWED_ORIGINS,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
 		ExposeHeaders:    []string{"Content-Length"},
@@ -45,7 +50,13 @@ func main() {
 
 func playgroundHandler() gin.HandlerFunc {
 	h := playground.ApolloSandboxHandler("GraphQL", "/graphql")
+	graphQLHandler := graph.GraphQLHandler()
 	return func(c *gin.Context) {
+		// Subscriptions upgrade GET /graphql to a websocket, hand them over to the GraphQL handler
+		if strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+			graphQLHandler(c)
+			return
+		}
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
@@ -32,9 +32,9 @@ func CreateQuestion(t *testing.T, userID uuid.UUID, input model.CreateQuestionIn
 	return questionEntity
 }
 
-// CreateTest creates a test test with the given input
-func CreateTest(t *testing.T, input model.CreateTestInput) *ent.Test {
-	testEntity, err := test.CreateTest(context.Background(), input)
+// CreateTest creates a test test with the given input and user ID as the creator
+func CreateTest(t *testing.T, userID uuid.UUID, input model.CreateTestInput) *ent.Test {
+	testEntity, err := test.CreateTest(context.Background(), userID, false, input)
 	if err != nil {
 		t.Fatalf("Failed to create test: %v", err)
 	}
@@ -53,7 +53,7 @@ func CreateTestWithCourse(t *testing.T, userID uuid.UUID) (*ent.Course, *ent.Tes
 		Name:     utils.Faker.Lorem().Word(),
 		CourseID: &courseEntity.ID,
 	}
-	testEntity := CreateTest(t, testInput)
+	testEntity := CreateTest(t, userID, testInput)
 
 	return courseEntity, testEntity
 }
@@ -73,13 +73,13 @@ func CreateTestScenario(t *testing.T, questionCountConfigs []QuestionCountConfig
 	}
 	userEntity := CreateUser(t, userInput)
 
-	testEntity := CreateTest(t, model.CreateTestInput{
+	testEntity := CreateTest(t, userEntity.ID, model.CreateTestInput{
 		Name:      utils.Faker.Lorem().Word(),
 		TotalTime: 120,
 	})
 	collectionEntity, questions := CreateCollectionWithQuestions(t, userEntity.ID, questionCountConfigs)
 
-	_, err := test.UpdateQuestionCollectionsForTest(context.Background(), userEntity.ID, model.AddMultiCollectionToTestInput{
+	_, err := test.UpdateQuestionCollectionsForTest(context.Background(), userEntity.ID, false, model.AddMultiCollectionToTestInput{
 		TestID:        testEntity.ID,
 		CollectionIds: []uuid.UUID{collectionEntity.ID},
 	})
@@ -96,7 +96,7 @@ func CreateTestScenario(t *testing.T, questionCountConfigs []QuestionCountConfig
 			PointsPerQuestion: config.Points,
 		}
 	})
-	_, err = test.UpdateTestQuestionRequirement(context.Background(), userEntity.ID, testEntity.ID, updateTestQuestionRequirementInput)
+	_, err = test.UpdateTestQuestionRequirement(context.Background(), userEntity.ID, false, testEntity.ID, updateTestQuestionRequirementInput)
 	if err != nil {
 		t.Fatalf("Failed to update test question requirement: %v", err)
 	}
@@ -0,0 +1,145 @@
+package access
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/features/access"
+	"template/internal/features/course"
+	"template/internal/features/test"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAccessControl(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	author := prepare.CreateUser(t, model.RegisterInput{Email: "author@test.com", Password: "password123"})
+	teacher := prepare.CreateUser(t, model.RegisterInput{Email: "teacher@test.com", Password: "password123"})
+
+	courseEntity, testEntity := prepare.CreateTestWithCourse(t, author.ID)
+
+	t.Run("OtherTeacher_CannotReadUpdateOrDeleteTest", func(t *testing.T) {
+		_, err := test.GetTestByID(ctx, teacher.ID, false, testEntity.ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found or unauthorized")
+
+		_, err = test.UpdateTest(ctx, teacher.ID, false, testEntity.ID, model.UpdateTestInput{Name: utils.Ptr("Hijacked")})
+		assert.Error(t, err)
+
+		deleted, err := test.DeleteTest(ctx, teacher.ID, false, testEntity.ID)
+		assert.Error(t, err)
+		assert.False(t, deleted)
+
+		paginated, err := test.PaginatedTests(ctx, teacher.ID, false, nil)
+		require.NoError(t, err)
+		assert.Empty(t, paginated.Items)
+	})
+
+	t.Run("OtherTeacher_CannotAddTestToCourse", func(t *testing.T) {
+		_, err := test.CreateTest(ctx, teacher.ID, false, model.CreateTestInput{
+			Name:     "Intruder",
+			CourseID: &courseEntity.ID,
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "course not found or unauthorized")
+	})
+
+	t.Run("OnlyOwner_CanShare", func(t *testing.T) {
+		_, err := access.ShareResource(ctx, teacher.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeTest,
+			ResourceID:   testEntity.ID,
+			UserID:       &teacher.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("ViewerGrant_AllowsReadOnly", func(t *testing.T) {
+		grant, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeTest,
+			ResourceID:   testEntity.ID,
+			UserID:       &teacher.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, grant.UserID)
+		assert.Equal(t, teacher.ID, *grant.UserID)
+
+		fetched, err := test.GetTestByID(ctx, teacher.ID, false, testEntity.ID)
+		require.NoError(t, err)
+		assert.Equal(t, testEntity.ID, fetched.ID)
+
+		paginated, err := test.PaginatedTests(ctx, teacher.ID, false, nil)
+		require.NoError(t, err)
+		assert.Len(t, paginated.Items, 1)
+
+		_, err = test.UpdateTest(ctx, teacher.ID, false, testEntity.ID, model.UpdateTestInput{Name: utils.Ptr("Renamed")})
+		assert.Error(t, err)
+	})
+
+	t.Run("EditorGrant_AllowsUpdateButNotShareOrDelete", func(t *testing.T) {
+		grant, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeTest,
+			ResourceID:   testEntity.ID,
+			UserID:       &teacher.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		require.NoError(t, err)
+
+		grants, err := access.GetAccessGrants(ctx, author.ID, false, model.AccessResourceTypeTest, testEntity.ID)
+		require.NoError(t, err)
+		require.Len(t, grants, 1, "Sharing again should change the role of the existing grant")
+		assert.Equal(t, grant.ID, grants[0].ID)
+
+		updated, err := test.UpdateTest(ctx, teacher.ID, false, testEntity.ID, model.UpdateTestInput{Name: utils.Ptr("Renamed")})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", updated.Name)
+
+		_, err = access.GetAccessGrants(ctx, teacher.ID, false, model.AccessResourceTypeTest, testEntity.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("RevokeGrant_RemovesAccess", func(t *testing.T) {
+		grants, err := access.GetAccessGrants(ctx, author.ID, false, model.AccessResourceTypeTest, testEntity.ID)
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+
+		revoked, err := access.RevokeAccessGrant(ctx, author.ID, false, grants[0].ID)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		_, err = test.GetTestByID(ctx, teacher.ID, false, testEntity.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("CourseGrant_GivesAccessToCourseAndItsTests", func(t *testing.T) {
+		_, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeCourse,
+			ResourceID:   courseEntity.ID,
+			UserID:       &teacher.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		require.NoError(t, err)
+
+		visible, err := course.GetVisibleCourseByID(ctx, teacher.ID, courseEntity.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, courseEntity.ID, visible.ID)
+
+		_, err = test.GetTestByID(ctx, teacher.ID, false, testEntity.ID)
+		assert.NoError(t, err)
+
+		_, err = course.UpdateCourse(ctx, teacher.ID, courseEntity.ID, model.UpdateCourseInput{Title: utils.Ptr("Renamed")})
+		assert.Error(t, err)
+	})
+
+	t.Run("Admin_CanAccessEveryTest", func(t *testing.T) {
+		fetched, err := test.GetTestByID(ctx, teacher.ID, true, testEntity.ID)
+		require.NoError(t, err)
+		assert.Equal(t, testEntity.ID, fetched.ID)
+	})
+}
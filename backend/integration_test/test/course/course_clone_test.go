@@ -0,0 +1,237 @@
+package course
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionoption"
+	entTest "template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/features/course"
+	"template/internal/features/course_section"
+	"template/internal/features/test"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"template/integration_test/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCourse(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	author := prepare.CreateUser(t, model.RegisterInput{Email: "author@test.com", Password: "password123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@test.com", Password: "password123"})
+
+	sourceCourse := prepare.CreateCourse(t, author.ID, model.CreateCourseInput{
+		Title:       "Semester 1",
+		Description: utils.Ptr("Original course"),
+	})
+
+	rootSection, err := course_section.CreateCourseSection(ctx, author.ID, sourceCourse.ID, model.CreateCourseSectionInput{
+		Title:       "Chapter 1",
+		Description: "Root section",
+		CourseID:    sourceCourse.ID,
+	})
+	require.NoError(t, err)
+	_, err = course_section.CreateCourseSection(ctx, author.ID, sourceCourse.ID, model.CreateCourseSectionInput{
+		Title:       "Chapter 1.1",
+		Description: "Child section",
+		CourseID:    sourceCourse.ID,
+		SectionID:   &rootSection.ID,
+	})
+	require.NoError(t, err)
+
+	sourceTest := prepare.CreateTest(t, author.ID, model.CreateTestInput{
+		Name:      "Final exam",
+		CourseID:  &sourceCourse.ID,
+		TotalTime: 60,
+	})
+	collection, questions := prepare.CreateCollectionWithQuestions(t, author.ID, []prepare.QuestionCountConfig{
+		{Count: 3, Points: 2},
+	})
+	_, err = test.UpdateQuestionCollectionsForTest(ctx, author.ID, false, model.AddMultiCollectionToTestInput{
+		TestID:        sourceTest.ID,
+		CollectionIds: []uuid.UUID{collection.ID},
+	})
+	require.NoError(t, err)
+	_, err = test.UpdateTestQuestionRequirement(ctx, author.ID, false, sourceTest.ID, []model.UpdateTestQuestionRequirementInput{
+		{NumberOfQuestions: 2, PointsPerQuestion: 2},
+	})
+	require.NoError(t, err)
+	_, err = test.BatchIgnoreQuestions(ctx, author.ID, false, model.BatchIgnoreQuestionsInput{
+		TestID: sourceTest.ID,
+		QuestionIgnoreData: []*model.QuestionIgnoreData{
+			{QuestionID: questions[0].ID, Reason: utils.Ptr("Outdated")},
+		},
+	})
+	require.NoError(t, err)
+	_, err = test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  sourceTest.ID,
+		UserIds: []uuid.UUID{otherUser.ID},
+	})
+	require.NoError(t, err)
+
+	t.Run("CloneCourse_NonCreator_Error", func(t *testing.T) {
+		_, err := course.CloneCourse(ctx, otherUser.ID, false, sourceCourse.ID, "Stolen course")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("CloneCourse_EmptyTitle_Error", func(t *testing.T) {
+		_, err := course.CloneCourse(ctx, author.ID, false, sourceCourse.ID, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("CloneCourse_Success", func(t *testing.T) {
+		cloned, err := course.CloneCourse(ctx, author.ID, false, sourceCourse.ID, "Semester 2")
+		require.NoError(t, err)
+		assert.NotEqual(t, sourceCourse.ID, cloned.ID)
+		assert.Equal(t, "Semester 2", cloned.Title)
+		assert.Equal(t, sourceCourse.Description, cloned.Description)
+		assert.Equal(t, "draft", cloned.Status.String())
+
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+
+		// Section tree is preserved
+		clonedRoot, err := client.CourseSection.Query().
+			Where(coursesection.CourseID(cloned.ID), coursesection.SectionIDIsNil()).
+			WithChildren().
+			Only(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, rootSection.ID, clonedRoot.ID)
+		assert.Equal(t, "Chapter 1", clonedRoot.Title)
+		require.Len(t, clonedRoot.Edges.Children, 1)
+		assert.Equal(t, "Chapter 1.1", clonedRoot.Edges.Children[0].Title)
+		assert.Equal(t, cloned.ID, clonedRoot.Edges.Children[0].CourseID)
+
+		// Tests are copied with their collections, requirements and ignored questions
+		clonedTest, err := client.Test.Query().
+			Where(entTest.CourseID(cloned.ID)).
+			WithQuestionCollections(func(qcq *ent.QuestionCollectionQuery) {
+				qcq.WithQuestions(func(qq *ent.QuestionQuery) {
+					qq.WithQuestionOptions()
+				})
+			}).
+			WithTestQuestionCounts().
+			WithTestIgnoreQuestions().
+			Only(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, sourceTest.ID, clonedTest.ID)
+		assert.Equal(t, sourceTest.Name, clonedTest.Name)
+
+		require.Len(t, clonedTest.Edges.QuestionCollections, 1)
+		clonedCollection := clonedTest.Edges.QuestionCollections[0]
+		assert.NotEqual(t, collection.ID, clonedCollection.ID)
+		assert.Equal(t, author.ID, clonedCollection.CreatorID)
+		require.Len(t, clonedCollection.Edges.Questions, len(questions))
+		for _, q := range clonedCollection.Edges.Questions {
+			assert.NotEmpty(t, q.Edges.QuestionOptions, "Question options should be copied")
+		}
+
+		require.Len(t, clonedTest.Edges.TestQuestionCounts, 1)
+		assert.Equal(t, 2, clonedTest.Edges.TestQuestionCounts[0].NumberOfQuestions)
+
+		require.Len(t, clonedTest.Edges.TestIgnoreQuestions, 1)
+		ignoredQuestionID := clonedTest.Edges.TestIgnoreQuestions[0].QuestionID
+		assert.NotEqual(t, questions[0].ID, ignoredQuestionID, "Ignored question should point to the cloned question")
+		clonedQuestionIDs := make([]uuid.UUID, 0, len(clonedCollection.Edges.Questions))
+		for _, q := range clonedCollection.Edges.Questions {
+			clonedQuestionIDs = append(clonedQuestionIDs, q.ID)
+		}
+		assert.Contains(t, clonedQuestionIDs, ignoredQuestionID)
+
+		// Test sessions are not copied
+		sessionCount, err := client.TestSession.Query().Where(testsession.TestID(clonedTest.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, sessionCount)
+	})
+
+	t.Run("CloneCourse_CopiesSettingsAndExplanations", func(t *testing.T) {
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+
+		openAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+		closeAt := openAt.Add(48 * time.Hour)
+		_, err = client.Test.UpdateOneID(sourceTest.ID).
+			SetOpenAt(openAt).
+			SetCloseAt(closeAt).
+			SetMaxAttempts(3).
+			SetAttemptCooldownMinutes(15).
+			SetScoringPolicy(entTest.ScoringPolicyLatest).
+			SetFeedbackRelease(entTest.FeedbackReleaseAfterClose).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = client.Question.UpdateOneID(questions[1].ID).SetExplanation("Because of the chapter 1").Save(ctx)
+		require.NoError(t, err)
+		_, err = client.QuestionOption.Update().
+			Where(questionoption.QuestionID(questions[1].ID)).
+			SetExplanation("See the course notes").
+			Save(ctx)
+		require.NoError(t, err)
+		secondSection, err := course_section.CreateCourseSection(ctx, author.ID, sourceCourse.ID, model.CreateCourseSectionInput{
+			Title:       "Chapter 2",
+			Description: "Requires the chapter 1",
+			CourseID:    sourceCourse.ID,
+		})
+		require.NoError(t, err)
+		_, err = client.CourseSectionPrerequisite.Create().
+			SetSectionID(secondSection.ID).
+			SetPrerequisiteSectionID(rootSection.ID).
+			SetMinScorePercent(60).
+			Save(ctx)
+		require.NoError(t, err)
+
+		cloned, err := course.CloneCourse(ctx, author.ID, false, sourceCourse.ID, "Semester 3")
+		require.NoError(t, err)
+
+		clonedTest, err := client.Test.Query().Where(entTest.CourseID(cloned.ID)).Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, clonedTest.OpenAt)
+		assert.True(t, openAt.Equal(*clonedTest.OpenAt))
+		require.NotNil(t, clonedTest.CloseAt)
+		assert.True(t, closeAt.Equal(*clonedTest.CloseAt))
+		assert.Equal(t, utils.Ptr(3), clonedTest.MaxAttempts)
+		assert.Equal(t, utils.Ptr(15), clonedTest.AttemptCooldownMinutes)
+		assert.Equal(t, entTest.ScoringPolicyLatest, clonedTest.ScoringPolicy)
+		assert.Equal(t, entTest.FeedbackReleaseAfterClose, clonedTest.FeedbackRelease)
+
+		clonedQuestion, err := client.Question.Query().
+			Where(
+				question.HasCollectionWith(questioncollection.HasTestWith(entTest.ID(clonedTest.ID))),
+				question.ExplanationNotNil(),
+			).
+			WithQuestionOptions().
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Because of the chapter 1", *clonedQuestion.Explanation)
+		require.NotEmpty(t, clonedQuestion.Edges.QuestionOptions)
+		for _, option := range clonedQuestion.Edges.QuestionOptions {
+			require.NotNil(t, option.Explanation)
+			assert.Equal(t, "See the course notes", *option.Explanation)
+		}
+
+		prerequisite, err := client.CourseSectionPrerequisite.Query().
+			Where(coursesectionprerequisite.HasSectionWith(coursesection.CourseID(cloned.ID))).
+			WithSection().
+			WithPrerequisiteSection().
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Chapter 2", prerequisite.Edges.Section.Title)
+		assert.Equal(t, "Chapter 1", prerequisite.Edges.PrerequisiteSection.Title)
+		assert.Equal(t, cloned.ID, prerequisite.Edges.PrerequisiteSection.CourseID)
+		assert.Equal(t, utils.Ptr(60), prerequisite.MinScorePercent)
+	})
+}
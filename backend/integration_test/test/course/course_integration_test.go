@@ -120,7 +120,7 @@ func TestCourseIntegration(t *testing.T) {
 
 		// Verify it's an authorization error
 		assert.Contains(t, err.Error(), "unauthorized", "Error should indicate unauthorized access")
-		assert.Contains(t, err.Error(), "only the creator can update", "Error should specify creator-only access")
+		assert.Contains(t, err.Error(), "only the creator or an editor can update", "Error should specify creator-only access")
 	})
 
 	t.Run("UpdateCourse_InvalidID_Error", func(t *testing.T) {
@@ -142,13 +142,12 @@ func TestCourseIntegration(t *testing.T) {
 		require.NotNil(t, createdCourse, "Course should exist from previous test")
 
 		// Test course deletion by non-creator (should fail)
-		success, err := course.RemoveCourse(ctx, user2ID, createdCourse.ID)
+		success, err := course.RemoveCourse(ctx, user2ID, false, createdCourse.ID)
 		assert.Error(t, err, "Course deletion by non-creator should fail")
 		assert.False(t, success, "Deletion should not succeed for unauthorized user")
 
 		// Verify it's an authorization error
 		assert.Contains(t, err.Error(), "unauthorized", "Error should indicate unauthorized access")
-		assert.Contains(t, err.Error(), "only the creator can delete", "Error should specify creator-only access")
 
 		// Verify course still exists
 		existingCourse, err := course.GetCourseByID(ctx, createdCourse.ID)
@@ -161,7 +160,7 @@ func TestCourseIntegration(t *testing.T) {
 		// Test course deletion with invalid/non-existent ID
 		nonExistentID := uuid.New()
 
-		success, err := course.RemoveCourse(ctx, user1ID, nonExistentID)
+		success, err := course.RemoveCourse(ctx, user1ID, false, nonExistentID)
 		assert.Error(t, err, "Course deletion with invalid ID should fail")
 		assert.False(t, success, "Deletion should not succeed for invalid ID")
 
@@ -173,7 +172,7 @@ func TestCourseIntegration(t *testing.T) {
 		require.NotNil(t, createdCourse, "Course should exist from previous test")
 
 		// Test successful course deletion by creator
-		success, err := course.RemoveCourse(ctx, user1ID, createdCourse.ID)
+		success, err := course.RemoveCourse(ctx, user1ID, false, createdCourse.ID)
 		assert.NoError(t, err, "Course deletion by creator should succeed")
 		assert.True(t, success, "Deletion should succeed for creator")
 
@@ -201,7 +200,7 @@ func TestCourseIntegration(t *testing.T) {
 		assert.Nil(t, minimalCourse.Description, "Course description should be nil when not provided")
 
 		// Clean up
-		_, err = course.RemoveCourse(ctx, user1ID, minimalCourse.ID)
+		_, err = course.RemoveCourse(ctx, user1ID, false, minimalCourse.ID)
 		assert.NoError(t, err, "Cleanup should succeed")
 	})
 
@@ -229,7 +228,7 @@ func TestCourseIntegration(t *testing.T) {
 		assert.Equal(t, originalCourse.Description, updatedCourse.Description, "Course description should remain unchanged")
 
 		// Clean up
-		_, err = course.RemoveCourse(ctx, user1ID, updatedCourse.ID)
+		_, err = course.RemoveCourse(ctx, user1ID, false, updatedCourse.ID)
 		assert.NoError(t, err, "Cleanup should succeed")
 	})
 }
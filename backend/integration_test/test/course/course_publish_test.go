@@ -0,0 +1,117 @@
+package course
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent/db"
+	"template/internal/features/course"
+	"template/internal/features/course_enrollment"
+	"template/internal/features/course_section"
+	"template/internal/graph/model"
+	"testing"
+
+	"template/integration_test/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoursePublishing(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	author := prepare.CreateUser(t, model.RegisterInput{Email: "author@test.com", Password: "password123"})
+	learner := prepare.CreateUser(t, model.RegisterInput{Email: "learner@test.com", Password: "password123"})
+
+	createdCourse := prepare.CreateCourse(t, author.ID, model.CreateCourseInput{
+		Title:       "Publishing Course",
+		Description: utils.Ptr("Course used to test the publishing workflow"),
+	})
+	assert.Equal(t, "draft", createdCourse.Status.String(), "New courses should start as drafts")
+	assert.False(t, createdCourse.IsPublished)
+
+	_, err := course_enrollment.EnrollUser(ctx, author.ID, false, model.EnrollCourseInput{
+		CourseID: createdCourse.ID,
+		UserID:   learner.ID,
+	})
+	require.NoError(t, err)
+
+	t.Run("SubmitForReview_Success", func(t *testing.T) {
+		reviewed, err := course.SubmitCourseForReview(ctx, author.ID, false, createdCourse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "review", reviewed.Status.String())
+		assert.False(t, reviewed.IsPublished)
+	})
+
+	t.Run("Publish_NonCreator_Error", func(t *testing.T) {
+		_, err := course.PublishCourse(ctx, learner.ID, false, createdCourse.ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("Publish_WithoutMedia_Error", func(t *testing.T) {
+		_, err := course.PublishCourse(ctx, author.ID, false, createdCourse.ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "media")
+	})
+
+	t.Run("Publish_WithoutSection_Error", func(t *testing.T) {
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+		media, err := client.Media.Create().
+			SetFileName("cover.png").
+			SetFileURL("https://example.com/cover.png").
+			SetMimeType("image/png").
+			SetUploaderID(author.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = course.UpdateCourse(ctx, author.ID, createdCourse.ID, model.UpdateCourseInput{MediaID: &media.ID})
+		require.NoError(t, err)
+
+		_, err = course.PublishCourse(ctx, author.ID, false, createdCourse.ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "section")
+	})
+
+	t.Run("Publish_Success", func(t *testing.T) {
+		_, err := course_section.CreateCourseSection(ctx, author.ID, createdCourse.ID, model.CreateCourseSectionInput{
+			Title:       "Introduction",
+			Description: "First section",
+			CourseID:    createdCourse.ID,
+		})
+		require.NoError(t, err)
+
+		published, err := course.PublishCourse(ctx, author.ID, false, createdCourse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "published", published.Status.String())
+		assert.True(t, published.IsPublished)
+		assert.NotNil(t, published.PublishedAt)
+
+		paginated, err := course.PaginatedCourses(ctx, learner.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, paginated.Items, 1, "Enrolled learners should see the published course")
+	})
+
+	t.Run("Archive_Success", func(t *testing.T) {
+		archived, err := course.ArchiveCourse(ctx, author.ID, false, createdCourse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "archived", archived.Status.String())
+		assert.False(t, archived.IsPublished)
+		assert.NotNil(t, archived.ArchivedAt)
+
+		paginated, err := course.PaginatedCourses(ctx, learner.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, paginated.Items, "Archived courses should be hidden from learners")
+
+		paginated, err = course.PaginatedCourses(ctx, author.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, paginated.Items, 1, "Authors should still see their archived courses")
+	})
+
+	t.Run("Publish_FromArchived_Error", func(t *testing.T) {
+		_, err := course.PublishCourse(ctx, author.ID, false, createdCourse.ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot change course status")
+	})
+}
@@ -0,0 +1,143 @@
+package course_enrollment
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent/db"
+	"template/internal/features/course"
+	"template/internal/features/course_enrollment"
+	"template/internal/graph/model"
+	"testing"
+
+	"template/integration_test/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCourseEnrollment(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	creator := prepare.CreateUser(t, model.RegisterInput{Email: "creator@test.com", Password: "password123"})
+	learner := prepare.CreateUser(t, model.RegisterInput{Email: "learner@test.com", Password: "password123"})
+	instructor := prepare.CreateUser(t, model.RegisterInput{Email: "instructor@test.com", Password: "password123"})
+	outsider := prepare.CreateUser(t, model.RegisterInput{Email: "outsider@test.com", Password: "password123"})
+
+	createdCourse := prepare.CreateCourse(t, creator.ID, model.CreateCourseInput{
+		Title:       "Enrollment Course",
+		Description: utils.Ptr("Course used to test enrollments"),
+	})
+
+	t.Run("EnrollUser_Success", func(t *testing.T) {
+		enrollment, err := course_enrollment.EnrollUser(ctx, creator.ID, false, model.EnrollCourseInput{
+			CourseID: createdCourse.ID,
+			UserID:   learner.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, createdCourse.ID, enrollment.CourseID)
+		assert.Equal(t, learner.ID, enrollment.UserID)
+		assert.Equal(t, "learner", enrollment.Role.String())
+		assert.Equal(t, "active", enrollment.Status.String())
+		assert.False(t, enrollment.EnrolledAt.IsZero())
+	})
+
+	t.Run("EnrollUser_ExistingEnrollmentIsUpdated", func(t *testing.T) {
+		enrollment, err := course_enrollment.EnrollUser(ctx, creator.ID, false, model.EnrollCourseInput{
+			CourseID: createdCourse.ID,
+			UserID:   instructor.ID,
+		})
+		require.NoError(t, err)
+
+		updated, err := course_enrollment.EnrollUser(ctx, creator.ID, false, model.EnrollCourseInput{
+			CourseID: createdCourse.ID,
+			UserID:   instructor.ID,
+			Role:     utils.Ptr(model.CourseEnrollmentRoleInstructor),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, enrollment.ID, updated.ID, "Re-enrolling should update the existing enrollment")
+		assert.Equal(t, "instructor", updated.Role.String())
+	})
+
+	t.Run("EnrollUser_UnauthorizedUser", func(t *testing.T) {
+		_, err := course_enrollment.EnrollUser(ctx, outsider.ID, false, model.EnrollCourseInput{
+			CourseID: createdCourse.ID,
+			UserID:   outsider.ID,
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("BulkEnroll_ReportsUnknownEmails", func(t *testing.T) {
+		// The instructor is allowed to manage the roster
+		result, err := course_enrollment.BulkEnrollByEmails(ctx, instructor.ID, false, model.BulkEnrollCourseInput{
+			CourseID: createdCourse.ID,
+			Emails:   []string{"outsider@test.com", " outsider@test.com ", "missing@test.com"},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Enrollments, 1)
+		assert.Equal(t, outsider.ID, result.Enrollments[0].UserID)
+		assert.Equal(t, []string{"missing@test.com"}, result.UnknownEmails)
+	})
+
+	t.Run("BulkEnroll_MatchesEmailsRegardlessOfCase", func(t *testing.T) {
+		result, err := course_enrollment.BulkEnrollByEmails(ctx, instructor.ID, false, model.BulkEnrollCourseInput{
+			CourseID: createdCourse.ID,
+			Emails:   []string{"Learner@Test.com", "learner@test.com"},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Enrollments, 1, "The same email in another case is the same user")
+		assert.Equal(t, learner.ID, result.Enrollments[0].UserID)
+		assert.Empty(t, result.UnknownEmails)
+	})
+
+	t.Run("Roster_FilterByRole", func(t *testing.T) {
+		roster, err := course_enrollment.PaginatedCourseEnrollments(ctx, creator.ID, false, createdCourse.ID, nil, &model.CourseEnrollmentFilterInput{
+			Roles: []model.CourseEnrollmentRole{model.CourseEnrollmentRoleLearner},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, roster.TotalItems)
+
+		_, err = course_enrollment.PaginatedCourseEnrollments(ctx, learner.ID, false, createdCourse.ID, nil, nil)
+		assert.Error(t, err, "Learners should not be able to see the roster")
+	})
+
+	t.Run("Visibility_LearnerOnlySeesPublishedCourses", func(t *testing.T) {
+		_, err := course.GetVisibleCourseByID(ctx, learner.ID, createdCourse.ID, false)
+		assert.Error(t, err, "Learners should not see unpublished courses")
+
+		myCourses, err := course_enrollment.MyCourses(ctx, learner.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, myCourses.Items)
+
+		// Instructors see the course even when it is not published
+		_, err = course.GetVisibleCourseByID(ctx, instructor.ID, createdCourse.ID, false)
+		assert.NoError(t, err)
+
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+		_, err = client.Course.UpdateOneID(createdCourse.ID).SetIsPublished(true).Save(ctx)
+		require.NoError(t, err)
+
+		visible, err := course.GetVisibleCourseByID(ctx, learner.ID, createdCourse.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, createdCourse.ID, visible.ID)
+
+		myCourses, err = course_enrollment.MyCourses(ctx, learner.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, myCourses.Items, 1)
+		assert.Equal(t, createdCourse.ID, myCourses.Items[0].ID)
+	})
+
+	t.Run("Unenroll_Success", func(t *testing.T) {
+		removed, err := course_enrollment.Unenroll(ctx, creator.ID, false, createdCourse.ID, learner.ID)
+		require.NoError(t, err)
+		assert.True(t, removed)
+
+		_, err = course.GetVisibleCourseByID(ctx, learner.ID, createdCourse.ID, false)
+		assert.Error(t, err, "Unenrolled learners should no longer see the course")
+
+		_, err = course_enrollment.Unenroll(ctx, creator.ID, false, createdCourse.ID, learner.ID)
+		assert.Error(t, err)
+	})
+}
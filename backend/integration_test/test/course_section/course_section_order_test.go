@@ -0,0 +1,141 @@
+package course_section
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent"
+	"template/internal/features/course"
+	"template/internal/features/course_section"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCourseSectionOrdering tests moving, reordering and the nested outline of course sections
+func TestCourseSectionOrdering(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	userEntity := prepare.CreateUser(t, model.RegisterInput{
+		Email:    "user@ordering.com",
+		Password: "testpassword123",
+	})
+	userID := userEntity.ID
+
+	otherUser := prepare.CreateUser(t, model.RegisterInput{
+		Email:    "other@ordering.com",
+		Password: "testpassword123",
+	})
+
+	testCourse, err := course.CreateCourse(ctx, userID, model.CreateCourseInput{
+		Title:       "Ordering Course",
+		Description: utils.Ptr("Course used to test section ordering"),
+	})
+	require.NoError(t, err)
+
+	createSection := func(title string, parentID *uuid.UUID) *ent.CourseSection {
+		section, err := course_section.CreateCourseSection(ctx, userID, testCourse.ID, model.CreateCourseSectionInput{
+			Title:       title,
+			Description: title,
+			CourseID:    testCourse.ID,
+			SectionID:   parentID,
+		})
+		require.NoError(t, err)
+		return section
+	}
+
+	rootA := createSection("Root A", nil)
+	rootB := createSection("Root B", nil)
+	rootC := createSection("Root C", nil)
+	childA1 := createSection("Child A1", &rootA.ID)
+	childA2 := createSection("Child A2", &rootA.ID)
+
+	outlineTitles := func(nodes []*model.CourseOutlineSection) []string {
+		titles := make([]string, len(nodes))
+		for i, node := range nodes {
+			titles[i] = node.Title
+		}
+		return titles
+	}
+
+	t.Run("ReorderCourseSections_Success", func(t *testing.T) {
+		sections, err := course_section.ReorderCourseSections(ctx, userID, testCourse.ID, nil, []uuid.UUID{rootC.ID, rootA.ID, rootB.ID})
+		require.NoError(t, err)
+		require.Len(t, sections, 3)
+		assert.Equal(t, rootC.ID, sections[0].ID)
+		assert.Equal(t, 0, sections[0].Order)
+		assert.Equal(t, rootA.ID, sections[1].ID)
+		assert.Equal(t, 1, sections[1].Order)
+		assert.Equal(t, rootB.ID, sections[2].ID)
+		assert.Equal(t, 2, sections[2].Order)
+	})
+
+	t.Run("ReorderCourseSections_MissingSibling_Error", func(t *testing.T) {
+		_, err := course_section.ReorderCourseSections(ctx, userID, testCourse.ID, nil, []uuid.UUID{rootC.ID, rootA.ID})
+		assert.Error(t, err)
+	})
+
+	t.Run("ReorderCourseSections_Duplicates_Error", func(t *testing.T) {
+		_, err := course_section.ReorderCourseSections(ctx, userID, testCourse.ID, &rootA.ID, []uuid.UUID{childA1.ID, childA1.ID})
+		assert.Error(t, err)
+	})
+
+	t.Run("ReorderCourseSections_Unauthorized_Error", func(t *testing.T) {
+		_, err := course_section.ReorderCourseSections(ctx, otherUser.ID, testCourse.ID, nil, []uuid.UUID{rootA.ID, rootB.ID, rootC.ID})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("MoveCourseSection_UnderItsChild_Error", func(t *testing.T) {
+		_, err := course_section.MoveCourseSection(ctx, userID, rootA.ID, &childA1.ID, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("MoveCourseSection_UnderItself_Error", func(t *testing.T) {
+		_, err := course_section.MoveCourseSection(ctx, userID, rootB.ID, &rootB.ID, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("MoveCourseSection_ToAnotherParent_Success", func(t *testing.T) {
+		moved, err := course_section.MoveCourseSection(ctx, userID, childA2.ID, &rootB.ID, 0)
+		require.NoError(t, err)
+		require.NotNil(t, moved.SectionID)
+		assert.Equal(t, rootB.ID, *moved.SectionID)
+		assert.Equal(t, 0, moved.Order)
+
+		// Moving a root section under another root places it at the requested position
+		moved, err = course_section.MoveCourseSection(ctx, userID, rootC.ID, &rootB.ID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 0, moved.Order)
+
+		outline, err := course_section.GetCourseOutline(ctx, userID, false, testCourse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Root A", "Root B"}, outlineTitles(outline))
+		assert.Equal(t, 0, outline[0].Order)
+		assert.Equal(t, 1, outline[1].Order, "Root siblings should be renumbered after the move")
+		assert.Equal(t, []string{"Child A1"}, outlineTitles(outline[0].Children))
+		assert.Equal(t, []string{"Root C", "Child A2"}, outlineTitles(outline[1].Children))
+		assert.Equal(t, 1, outline[1].Children[1].Order)
+	})
+
+	t.Run("MoveCourseSection_ToRoot_Success", func(t *testing.T) {
+		moved, err := course_section.MoveCourseSection(ctx, userID, childA1.ID, nil, 100)
+		require.NoError(t, err)
+		assert.Nil(t, moved.SectionID)
+		assert.Equal(t, 2, moved.Order, "Position should be clamped to the end of the siblings")
+
+		outline, err := course_section.GetCourseOutline(ctx, userID, false, testCourse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Root A", "Root B", "Child A1"}, outlineTitles(outline))
+		assert.Empty(t, outline[0].Children)
+	})
+
+	t.Run("GetCourseOutline_Unauthorized_Error", func(t *testing.T) {
+		_, err := course_section.GetCourseOutline(ctx, otherUser.ID, false, testCourse.ID)
+		assert.Error(t, err)
+	})
+}
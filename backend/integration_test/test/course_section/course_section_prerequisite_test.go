@@ -0,0 +1,144 @@
+package course_section
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/features/course"
+	"template/internal/features/course_section"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCourseSectionPrerequisites tests gating sections behind the tests of other sections
+func TestCourseSectionPrerequisites(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	author := prepare.CreateUser(t, model.RegisterInput{Email: "author@prerequisite.com", Password: "testpassword123"})
+	learner := prepare.CreateUser(t, model.RegisterInput{Email: "learner@prerequisite.com", Password: "testpassword123"})
+
+	testCourse, err := course.CreateCourse(ctx, author.ID, model.CreateCourseInput{
+		Title:       "Prerequisite Course",
+		Description: utils.Ptr("Course used to test section prerequisites"),
+	})
+	require.NoError(t, err)
+
+	createSection := func(title string) uuid.UUID {
+		section, err := course_section.CreateCourseSection(ctx, author.ID, testCourse.ID, model.CreateCourseSectionInput{
+			Title:       title,
+			Description: title,
+			CourseID:    testCourse.ID,
+		})
+		require.NoError(t, err)
+		return section.ID
+	}
+	sectionA := createSection("Section A")
+	sectionB := createSection("Section B")
+
+	// Attach a test to each section
+	questionCountConfigs := []prepare.QuestionCountConfig{{Count: 2, Points: 5}}
+	scenarioA := prepare.CreateTestScenario(t, questionCountConfigs)
+	scenarioB := prepare.CreateTestScenario(t, questionCountConfigs)
+	_, err = client.Test.UpdateOneID(scenarioA.Test.ID).SetCourseSectionID(sectionA).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Test.UpdateOneID(scenarioB.Test.ID).SetCourseSectionID(sectionB).Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("SetSectionPrerequisites_Self_Error", func(t *testing.T) {
+		_, err := course_section.SetSectionPrerequisites(ctx, author.ID, sectionA, []*model.SectionPrerequisiteInput{
+			{PrerequisiteSectionID: sectionA},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("SetSectionPrerequisites_Unauthorized_Error", func(t *testing.T) {
+		_, err := course_section.SetSectionPrerequisites(ctx, learner.ID, sectionB, []*model.SectionPrerequisiteInput{
+			{PrerequisiteSectionID: sectionA},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("SetSectionPrerequisites_Success", func(t *testing.T) {
+		prerequisites, err := course_section.SetSectionPrerequisites(ctx, author.ID, sectionB, []*model.SectionPrerequisiteInput{
+			{PrerequisiteSectionID: sectionA, MinScorePercent: utils.Ptr(50)},
+		})
+		require.NoError(t, err)
+		require.Len(t, prerequisites, 1)
+		assert.Equal(t, sectionA, prerequisites[0].PrerequisiteSectionID)
+		assert.Equal(t, 50, *prerequisites[0].MinScorePercent)
+	})
+
+	t.Run("SetSectionPrerequisites_Cycle_Error", func(t *testing.T) {
+		_, err := course_section.SetSectionPrerequisites(ctx, author.ID, sectionA, []*model.SectionPrerequisiteInput{
+			{PrerequisiteSectionID: sectionB},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle")
+	})
+
+	sessionsB, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenarioB.Test.ID,
+		UserIds: []uuid.UUID{learner.ID},
+	})
+	require.NoError(t, err)
+
+	t.Run("StartTestSession_Locked_Error", func(t *testing.T) {
+		unlocked, err := course_section.IsSectionUnlocked(ctx, client, learner.ID, sectionB)
+		require.NoError(t, err)
+		assert.False(t, unlocked)
+
+		batch, err := course_section.GetUnlockedSections(ctx, learner.ID, []uuid.UUID{sectionA, sectionB})
+		require.NoError(t, err)
+		assert.True(t, batch[sectionA], "A section without prerequisites is unlocked")
+		assert.False(t, batch[sectionB])
+
+		_, err = test_session.StartTestSession(ctx, learner.ID, sessionsB[0].ID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "prerequisites")
+	})
+
+	sessionsA, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenarioA.Test.ID,
+		UserIds: []uuid.UUID{learner.ID},
+	})
+	require.NoError(t, err)
+
+	t.Run("IsSectionUnlocked_ScoreBelowMinimum", func(t *testing.T) {
+		_, err := client.TestSession.UpdateOneID(sessionsA[0].ID).
+			SetStatus(testsession.StatusCompleted).
+			SetMaxPoints(10).
+			SetPointsEarned(4).
+			Save(ctx)
+		require.NoError(t, err)
+
+		unlocked, err := course_section.IsSectionUnlocked(ctx, client, learner.ID, sectionB)
+		require.NoError(t, err)
+		assert.False(t, unlocked)
+	})
+
+	t.Run("StartTestSession_Unlocked_Success", func(t *testing.T) {
+		_, err := client.TestSession.UpdateOneID(sessionsA[0].ID).
+			SetPointsEarned(5).
+			Save(ctx)
+		require.NoError(t, err)
+
+		unlocked, err := course_section.IsSectionUnlocked(ctx, client, learner.ID, sectionB)
+		require.NoError(t, err)
+		assert.True(t, unlocked)
+
+		started, err := test_session.StartTestSession(ctx, learner.ID, sessionsB[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, testsession.StatusInProgress, started.Status)
+	})
+}
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"template/internal/graph"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// TestAuthDirectives tests that every root field of the schema declares who can resolve it
+func TestAuthDirectives(t *testing.T) {
+	t.Run("ValidateAuthDirectives_Schema_Success", func(t *testing.T) {
+		executableSchema := graph.NewExecutableSchema(graph.Config{
+			Resolvers:  &graph.Resolver{},
+			Directives: graph.NewDirectiveRoot(),
+		})
+		assert.NoError(t, graph.ValidateAuthDirectives(executableSchema.Schema()))
+	})
+
+	t.Run("ValidateAuthDirectives_MissingDirective_Error", func(t *testing.T) {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+			directive @public on FIELD_DEFINITION
+			directive @authenticated on FIELD_DEFINITION
+			type Query {
+				version: String! @public
+				me: String! @authenticated
+				secret: String!
+			}
+		`})
+		require.NoError(t, err)
+
+		err = graph.ValidateAuthDirectives(schema)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Query.secret")
+		assert.NotContains(t, err.Error(), "Query.me")
+	})
+}
@@ -0,0 +1,133 @@
+package group
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/features/group"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGroupTestAssignment tests assigning a test to a group and summarizing the results of its members
+func TestGroupTestAssignment(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	teacher := scenario.User
+	otherTeacher := prepare.CreateUser(t, model.RegisterInput{Email: "other-teacher@test.com", Password: "password123"})
+	studentA := prepare.CreateUser(t, model.RegisterInput{Email: "a@test.com", Password: "password123"})
+	studentB := prepare.CreateUser(t, model.RegisterInput{Email: "b@test.com", Password: "password123"})
+	lateStudent := prepare.CreateUser(t, model.RegisterInput{Email: "late@test.com", Password: "password123"})
+
+	class, err := group.CreateGroup(ctx, teacher.ID, model.CreateGroupInput{
+		Name:      "Class 10A",
+		MemberIds: []uuid.UUID{studentA.ID, studentB.ID},
+	})
+	require.NoError(t, err)
+
+	assignInput := model.AssignTestToGroupInput{
+		GroupID:             class.ID,
+		TestID:              scenario.Test.ID,
+		IncludeLaterMembers: utils.Ptr(true),
+		ExpiredTime:         utils.Ptr(time.Now().Add(2 * time.Hour)),
+	}
+
+	t.Run("OtherTeacher_CannotAssign", func(t *testing.T) {
+		_, err := group.AssignTestToGroup(ctx, otherTeacher.ID, false, assignInput)
+		assert.Error(t, err)
+	})
+
+	var assignmentID uuid.UUID
+
+	t.Run("Assign_CreatesSessionForEveryMember", func(t *testing.T) {
+		assignment, err := group.AssignTestToGroup(ctx, teacher.ID, false, assignInput)
+		require.NoError(t, err)
+		assignmentID = assignment.ID
+
+		summary, err := group.GetGroupTestResultSummary(ctx, teacher.ID, false, assignmentID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.SessionCount)
+		assert.Equal(t, 2, summary.PendingCount)
+		assert.Nil(t, summary.AverageScorePercent)
+
+		_, err = group.AssignTestToGroup(ctx, teacher.ID, false, assignInput)
+		assert.Error(t, err, "The test is already assigned to the group")
+	})
+
+	t.Run("LaterMember_GetsSession", func(t *testing.T) {
+		// Pretend the test was assigned a day ago, its sessions already expired
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+		assignedAt := time.Now().Add(-24 * time.Hour)
+		_, err = client.GroupTestAssignment.UpdateOneID(assignmentID).
+			SetCreatedAt(assignedAt).
+			SetExpiredAt(assignedAt.Add(2 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = group.AddGroupMembers(ctx, teacher.ID, false, class.ID, []uuid.UUID{lateStudent.ID, studentA.ID})
+		require.NoError(t, err)
+
+		summary, err := group.GetGroupTestResultSummary(ctx, teacher.ID, false, assignmentID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, summary.SessionCount, "Only the new member gets a session")
+
+		lateSession, err := client.TestSession.Query().
+			Where(testsession.GroupAssignmentID(assignmentID), testsession.UserID(lateStudent.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, lateSession.ExpiredAt)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), *lateSession.ExpiredAt, time.Minute, "The later member gets the same time span from when they join")
+	})
+
+	t.Run("Summary_AggregatesCompletedSessions", func(t *testing.T) {
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+
+		for userID, points := range map[uuid.UUID]int{studentA.ID: 10, studentB.ID: 5} {
+			_, err := client.TestSession.Update().
+				Where(testsession.GroupAssignmentID(assignmentID), testsession.UserID(userID)).
+				SetStatus(testsession.StatusCompleted).
+				SetPointsEarned(points).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+
+		summary, err := group.GetGroupTestResultSummary(ctx, teacher.ID, false, assignmentID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.CompletedCount)
+		assert.Equal(t, 1, summary.PendingCount)
+		require.NotNil(t, summary.AverageScorePercent)
+		assert.InDelta(t, 75.0, *summary.AverageScorePercent, 0.01)
+		assert.InDelta(t, 100.0, *summary.HighestScorePercent, 0.01)
+		assert.InDelta(t, 50.0, *summary.LowestScorePercent, 0.01)
+
+		_, err = group.GetGroupTestResultSummary(ctx, otherTeacher.ID, false, assignmentID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Unassign_KeepsSessions", func(t *testing.T) {
+		unassigned, err := group.UnassignTestFromGroup(ctx, teacher.ID, false, assignmentID)
+		require.NoError(t, err)
+		assert.True(t, unassigned)
+
+		assignments, err := group.GetGroupTestAssignments(ctx, teacher.ID, false, class.ID)
+		require.NoError(t, err)
+		assert.Empty(t, assignments)
+
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+		count, err := client.TestSession.Query().Where(testsession.TestID(scenario.Test.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+}
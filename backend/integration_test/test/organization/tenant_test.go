@@ -0,0 +1,259 @@
+package organization
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/db"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/access"
+	"template/internal/features/course"
+	"template/internal/features/course_section"
+	"template/internal/features/organization"
+	"template/internal/features/role"
+	"template/internal/features/test"
+	"template/internal/features/test_session"
+	"template/internal/features/user"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+	"template/internal/seeder"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrganizationTenancy tests that the users of an organization can't access the resources of another one
+func TestOrganizationTenancy(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+	ctx := context.Background()
+
+	schoolA, err := organization.CreateOrganization(ctx, model.CreateOrganizationInput{Name: "School A", Slug: "school-a"})
+	require.NoError(t, err)
+	schoolB, err := organization.CreateOrganization(ctx, model.CreateOrganizationInput{Name: "School B", Slug: "school-b"})
+	require.NoError(t, err)
+
+	adminA := prepare.CreateRegularUser(t, "admin@school-a.com", "admin_school_a")
+	teacherB := prepare.CreateRegularUser(t, "teacher@school-b.com", "teacher_school_b")
+	owner := prepare.CreateOwnerUser(t, "owner@platform.com", "owner_platform")
+
+	_, err = organization.AssignOrganizationUsers(ctx, model.AssignOrganizationUsersInput{
+		OrganizationID: schoolA.ID,
+		UserIds:        []uuid.UUID{adminA.ID},
+		AsAdmin:        utils.Ptr(true),
+	})
+	require.NoError(t, err)
+	_, err = organization.AssignOrganizationUsers(ctx, model.AssignOrganizationUsersInput{
+		OrganizationID: schoolB.ID,
+		UserIds:        []uuid.UUID{teacherB.ID},
+	})
+	require.NoError(t, err)
+
+	ctxA := mixin.WithTenant(ctx, &schoolA.ID)
+	ctxB := mixin.WithTenant(ctx, &schoolB.ID)
+
+	courseB, err := course.CreateCourse(ctxB, teacherB.ID, model.CreateCourseInput{Title: "Physics"})
+	require.NoError(t, err)
+	sectionB, err := course_section.CreateCourseSection(ctxB, teacherB.ID, courseB.ID, model.CreateCourseSectionInput{Title: "Mechanics", CourseID: courseB.ID})
+	require.NoError(t, err)
+	testB, err := test.CreateTest(ctxB, teacherB.ID, false, model.CreateTestInput{Name: "Midterm", CourseID: &courseB.ID})
+	require.NoError(t, err)
+
+	t.Run("CreatedResources_BelongToTenant", func(t *testing.T) {
+		require.NotNil(t, courseB.OrganizationID)
+		assert.Equal(t, schoolB.ID, *courseB.OrganizationID)
+		require.NotNil(t, testB.OrganizationID)
+		assert.Equal(t, schoolB.ID, *testB.OrganizationID)
+	})
+
+	t.Run("OrganizationAdmin_IsAdminOfTheirOrganizationOnly", func(t *testing.T) {
+		principal, err := role.GetPrincipal(ctx, adminA.ID)
+		require.NoError(t, err)
+		assert.True(t, principal.IsAdminOrOwner())
+		assert.False(t, principal.IsPlatformOwner())
+		require.NotNil(t, principal.OrganizationID)
+		assert.Equal(t, schoolA.ID, *principal.OrganizationID)
+
+		_, err = course.GetVisibleCourseByID(ctxA, adminA.ID, courseB.ID, true)
+		assert.Error(t, err)
+
+		_, err = test.GetTestByID(ctxA, adminA.ID, true, testB.ID)
+		assert.Error(t, err)
+
+		_, err = test.UpdateTest(ctxA, adminA.ID, true, testB.ID, model.UpdateTestInput{Name: utils.Ptr("Hijacked")})
+		assert.Error(t, err)
+
+		sections, err := course_section.GetCourseSectionsByIDs(ctxA, []uuid.UUID{sectionB.ID})
+		require.NoError(t, err)
+		assert.Empty(t, sections)
+	})
+
+	t.Run("OrganizationAdmin_CannotManageOtherOrganizationUsers", func(t *testing.T) {
+		_, err := user.GetUserByID(ctxA, teacherB.ID)
+		assert.Error(t, err)
+
+		_, err = user.AdminUpdateUser(ctxA, adminA.ID, teacherB.ID, model.AdminEditUserInput{IsActive: utils.Ptr(false)})
+		assert.Error(t, err)
+
+		users, err := user.PaginatedUsers(ctxA, nil)
+		require.NoError(t, err)
+		for _, u := range users.Items {
+			require.NotNil(t, u.OrganizationID)
+			assert.Equal(t, schoolA.ID, *u.OrganizationID)
+		}
+	})
+
+	t.Run("OrganizationAdmin_CannotSetOtherOrganizationAccommodations", func(t *testing.T) {
+		_, err := test_session.SetUserAccommodation(ctxA, adminA.ID, true, teacherB.ID, model.AccommodationInput{
+			ExtraMinutes: utils.Ptr(30),
+		})
+		assert.Error(t, err)
+
+		_, err = test_session.GetUserAccommodation(ctxA, adminA.ID, true, teacherB.ID)
+		assert.Error(t, err)
+
+		_, err = test_session.SetUserAccommodation(ctxB, owner.ID, true, teacherB.ID, model.AccommodationInput{
+			ExtraMinutes: utils.Ptr(30),
+		})
+		require.NoError(t, err)
+
+		accommodation, err := test_session.GetUserAccommodation(ctxA, adminA.ID, true, teacherB.ID)
+		assert.Error(t, err)
+		assert.Nil(t, accommodation)
+	})
+
+	t.Run("Resources_CannotBeSharedAcrossTenants", func(t *testing.T) {
+		_, err := access.ShareResource(ctxB, teacherB.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeTest,
+			ResourceID:   testB.ID,
+			UserID:       &adminA.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("OrganizationAdmin_CannotGrantOwnerRole", func(t *testing.T) {
+		_, err := user.AdminCreateUser(ctxA, adminA.ID, model.AdminCreateUserInput{
+			Email:    "new-owner@school-a.com",
+			Password: "password123",
+			RoleID:   prepare.GetRoleID(t, seeder.RoleOwner),
+		})
+		assert.Error(t, err)
+
+		created, err := user.AdminCreateUser(ctxA, adminA.ID, model.AdminCreateUserInput{
+			Email:    "student@school-a.com",
+			Password: "password123",
+			RoleID:   prepare.GetRoleID(t, seeder.RoleUser),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, created.OrganizationID)
+		assert.Equal(t, schoolA.ID, *created.OrganizationID)
+	})
+
+	t.Run("PlatformOwner_AccessesEveryOrganization", func(t *testing.T) {
+		principal, err := role.GetPrincipal(ctx, owner.ID)
+		require.NoError(t, err)
+		assert.True(t, principal.IsPlatformOwner())
+
+		_, err = test.GetTestByID(ctx, owner.ID, true, testB.ID)
+		assert.NoError(t, err)
+
+		_, err = organization.AssignOrganizationUsers(ctx, model.AssignOrganizationUsersInput{
+			OrganizationID: schoolA.ID,
+			UserIds:        []uuid.UUID{owner.ID},
+		})
+		assert.Error(t, err, "Owners can't be assigned to an organization")
+	})
+
+	t.Run("CreatorAssignedToAnotherOrganization_StillResolved", func(t *testing.T) {
+		author := prepare.CreateRegularUser(t, "author@school-b.com", "author_school_b")
+		_, err := organization.AssignOrganizationUsers(ctx, model.AssignOrganizationUsersInput{
+			OrganizationID: schoolB.ID,
+			UserIds:        []uuid.UUID{author.ID},
+		})
+		require.NoError(t, err)
+		authored, err := course.CreateCourse(ctxB, author.ID, model.CreateCourseInput{Title: "Chemistry"})
+		require.NoError(t, err)
+
+		_, err = organization.AssignOrganizationUsers(ctx, model.AssignOrganizationUsersInput{
+			OrganizationID: schoolA.ID,
+			UserIds:        []uuid.UUID{author.ID},
+		})
+		require.NoError(t, err)
+
+		creator, err := dataloader.GetUser(dataloader.AddToContext(ctxB), authored.CreatorID)
+		require.NoError(t, err)
+		assert.Equal(t, author.ID, creator.ID)
+
+		_, err = dataloader.GetCourse(dataloader.AddToContext(ctxA), authored.ID)
+		assert.ErrorContains(t, err, "item not found", "The resources of another tenant are reported as not found")
+	})
+
+	t.Run("Organizations_ScopedToTenant", func(t *testing.T) {
+		organizations, err := organization.PaginatedOrganizations(ctxA, nil)
+		require.NoError(t, err)
+		require.Len(t, organizations.Items, 1, "An organization admin only sees their organization")
+		assert.Equal(t, schoolA.ID, organizations.Items[0].ID)
+
+		_, err = organization.GetOrganizationByID(ctxA, schoolB.ID)
+		assert.Error(t, err)
+		_, err = organization.UpdateOrganization(ctxA, schoolB.ID, model.UpdateOrganizationInput{Name: utils.Ptr("Hijacked")})
+		assert.Error(t, err)
+		_, err = organization.CreateOrganization(ctxA, model.CreateOrganizationInput{Name: "School C", Slug: "school-c"})
+		assert.Error(t, err)
+	})
+
+	t.Run("GrantsMediaAndOptions_ScopedToTenant", func(t *testing.T) {
+		client, err := db.OpenClient()
+		require.NoError(t, err)
+
+		grant, err := client.AccessGrant.Create().
+			SetTestID(testB.ID).
+			SetUserID(teacherB.ID).
+			SetRole(accessgrant.RoleViewer).
+			Save(ctxB)
+		require.NoError(t, err)
+		require.NotNil(t, grant.OrganizationID)
+		assert.Equal(t, schoolB.ID, *grant.OrganizationID)
+
+		media, err := client.Media.Create().
+			SetFileName("slides.pdf").
+			SetFileURL("/uploads/slides.pdf").
+			SetMimeType("application/pdf").
+			SetUploaderID(teacherB.ID).
+			Save(ctxB)
+		require.NoError(t, err)
+
+		collection, err := client.QuestionCollection.Create().SetTitle("Kinematics").SetCreatorID(teacherB.ID).Save(ctxB)
+		require.NoError(t, err)
+		q, err := client.Question.Create().SetCollectionID(collection.ID).SetQuestionText("Speed unit?").Save(ctxB)
+		require.NoError(t, err)
+		option, err := client.QuestionOption.Create().SetQuestionID(q.ID).SetOptionText("m/s").SetIsCorrect(true).Save(ctxB)
+		require.NoError(t, err)
+
+		_, err = client.AccessGrant.Get(ctxA, grant.ID)
+		assert.Error(t, err)
+		_, err = client.Media.Get(ctxA, media.ID)
+		assert.Error(t, err)
+		_, err = client.QuestionOption.Get(ctxA, option.ID)
+		assert.Error(t, err)
+		_, err = client.QuestionOption.UpdateOneID(option.ID).SetIsCorrect(false).Save(ctxA)
+		assert.Error(t, err, "The options of another tenant can't be updated")
+
+		_, err = client.QuestionOption.Get(ctxB, option.ID)
+		assert.NoError(t, err)
+		_, err = client.Media.Get(ctxB, media.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeleteOrganization_WithUsers_Error", func(t *testing.T) {
+		_, err := organization.DeleteOrganization(ctx, schoolB.ID)
+		assert.Error(t, err)
+
+		_, err = organization.CreateOrganization(ctx, model.CreateOrganizationInput{Name: "Copy", Slug: "school-a"})
+		assert.Error(t, err, "Slugs are unique")
+	})
+}
@@ -0,0 +1,188 @@
+package question
+
+import (
+	"context"
+	"sync"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/features/question"
+	"template/internal/features/question_collection"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuestionHistory(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	user := prepare.CreateUser(t, model.RegisterInput{Email: "author@history.com", Password: "testpassword123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@history.com", Password: "testpassword123"})
+
+	collection, err := question_collection.CreateQuestionCollection(ctx, user.ID, model.CreateQuestionCollectionInput{
+		Title:       "History Collection",
+		Description: utils.Ptr("Collection used to test question history"),
+	})
+	require.NoError(t, err)
+
+	createdQuestion, err := question.CreateQuestion(ctx, user.ID, model.CreateQuestionInput{
+		QuestionText:         "What is the capital of France?",
+		QuestionCollectionID: collection.ID,
+		Points:               10,
+		Options: []*model.QuestionOptionInput{
+			{OptionText: "Paris", IsCorrect: true},
+			{OptionText: "London", IsCorrect: false},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("CreateQuestion_RecordsFirstVersion", func(t *testing.T) {
+		history, err := question.GetQuestionHistory(ctx, user.ID, false, createdQuestion.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, 1, history[0].Version)
+		assert.Len(t, history[0].Options, 2)
+		assert.Empty(t, history[0].Changes)
+	})
+
+	t.Run("UpdateQuestion_RecordsVersionWithChanges", func(t *testing.T) {
+		_, err := question.UpdateQuestion(ctx, user.ID, createdQuestion.ID, model.UpdateQuestionInput{
+			QuestionText: utils.Ptr("What is the capital city of France?"),
+			Points:       20,
+			Options: []*model.QuestionOptionInput{
+				{OptionText: "Paris", IsCorrect: true},
+				{OptionText: "Berlin", IsCorrect: false},
+			},
+		})
+		require.NoError(t, err)
+
+		history, err := question.GetQuestionHistory(ctx, user.ID, false, createdQuestion.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, 2, history[1].Version)
+
+		changeTypes := make([]model.QuestionVersionChangeType, 0)
+		for _, change := range history[1].Changes {
+			changeTypes = append(changeTypes, change.Type)
+		}
+		assert.ElementsMatch(t, []model.QuestionVersionChangeType{
+			model.QuestionVersionChangeTypeQuestionText,
+			model.QuestionVersionChangeTypePoints,
+			model.QuestionVersionChangeTypeOptionRemoved,
+			model.QuestionVersionChangeTypeOptionAdded,
+		}, changeTypes)
+	})
+
+	t.Run("UpdateQuestion_WithoutChanges_KeepsVersion", func(t *testing.T) {
+		_, err := question.UpdateQuestion(ctx, user.ID, createdQuestion.ID, model.UpdateQuestionInput{
+			Points: 20,
+		})
+		require.NoError(t, err)
+
+		history, err := question.GetQuestionHistory(ctx, user.ID, false, createdQuestion.ID)
+		require.NoError(t, err)
+		assert.Len(t, history, 2)
+	})
+
+	t.Run("GetQuestionHistory_Unauthorized_Error", func(t *testing.T) {
+		_, err := question.GetQuestionHistory(ctx, otherUser.ID, false, createdQuestion.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("StartTestSession_PinsVersion_AndDeleteKeepsAnswers", func(t *testing.T) {
+		scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 1, Points: 10}})
+		candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@history.com", Password: "testpassword123"})
+
+		sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  scenario.Test.ID,
+			UserIds: []uuid.UUID{candidate.ID},
+		})
+		require.NoError(t, err)
+		_, err = test_session.StartTestSession(ctx, candidate.ID, sessions[0].ID)
+		require.NoError(t, err)
+
+		answer, err := client.TestSessionAnswer.Query().
+			Where(testsessionanswer.SessionID(sessions[0].ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, answer.QuestionVersionID)
+
+		pinnedVersion, err := client.QuestionVersion.Get(ctx, *answer.QuestionVersionID)
+		require.NoError(t, err)
+		assert.Equal(t, answer.QuestionID, pinnedVersion.QuestionID)
+
+		deleted, err := question.DeleteQuestion(ctx, scenario.User.ID, answer.QuestionID)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		exists, err := client.TestSessionAnswer.Query().
+			Where(testsessionanswer.ID(answer.ID)).
+			Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists, "Answers must be retained when the question is deleted")
+
+		history, err := question.GetQuestionHistory(ctx, scenario.User.ID, false, answer.QuestionID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, history)
+
+		// The session is graded against the pinned version although the question was deleted
+		pinnedOptions, err := pinnedVersion.QueryOptions().All(ctx)
+		require.NoError(t, err)
+		correctOptionIds := []uuid.UUID{}
+		for _, option := range pinnedOptions {
+			if option.IsCorrect {
+				correctOptionIds = append(correctOptionIds, option.OptionID)
+			}
+		}
+		submitted, err := test_session.SubmitTestSession(ctx, candidate.ID, sessions[0].ID, model.SubmitTestSessionInput{
+			Answers: []*model.TestSessionAnswerInput{{QuestionID: answer.QuestionID, QuestionOptionIds: correctOptionIds}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pinnedVersion.Points, submitted.PointsEarned)
+	})
+
+	t.Run("RecordQuestionVersions_Concurrent_SharesVersion", func(t *testing.T) {
+		_, err := client.Question.UpdateOneID(createdQuestion.ID).SetQuestionText("Which city is the capital of France?").Save(ctx)
+		require.NoError(t, err)
+
+		versionIds := make([]uuid.UUID, 2)
+		errs := make([]error, 2)
+		var wg sync.WaitGroup
+		for i := range versionIds {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tx, err := db.OpenTransaction(ctx)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				versions, err := question.RecordQuestionVersions(ctx, tx.Client(), nil, []uuid.UUID{createdQuestion.ID})
+				if err != nil {
+					errs[i] = db.Rollback(tx, err)
+					return
+				}
+				versionIds[i] = versions[createdQuestion.ID].ID
+				errs[i] = tx.Commit()
+			}()
+		}
+		wg.Wait()
+
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[1])
+		assert.Equal(t, versionIds[0], versionIds[1], "The second recording reuses the version of the first one")
+
+		history, err := question.GetQuestionHistory(ctx, user.ID, false, createdQuestion.ID)
+		require.NoError(t, err)
+		assert.Len(t, history, 3)
+	})
+}
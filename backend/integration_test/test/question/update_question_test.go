@@ -315,6 +315,28 @@ func TestUpdateQuestion(t *testing.T) {
 		assert.Contains(t, err.Error(), "don't have access")
 	})
 
+	t.Run("UpdateQuestion_ClearExplanation", func(t *testing.T) {
+		updatedQuestion, err := question.UpdateQuestion(context.Background(), userID, createdQuestion.ID, model.UpdateQuestionInput{
+			Points:      createdQuestion.Points,
+			Explanation: utils.Ptr("Berlin is the capital since 1990"),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, updatedQuestion.Explanation)
+
+		updatedQuestion, err = question.UpdateQuestion(context.Background(), userID, createdQuestion.ID, model.UpdateQuestionInput{
+			Points: createdQuestion.Points,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedQuestion.Explanation, "An omitted explanation is left unchanged")
+
+		updatedQuestion, err = question.UpdateQuestion(context.Background(), userID, createdQuestion.ID, model.UpdateQuestionInput{
+			Points:      createdQuestion.Points,
+			Explanation: utils.Ptr(""),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updatedQuestion.Explanation, "An empty explanation clears it")
+	})
+
 	t.Run("UpdateQuestion_EmptyUpdate", func(t *testing.T) {
 		updateInput := model.UpdateQuestionInput{
 			Points: createdQuestion.Points, // At minimum, points is required
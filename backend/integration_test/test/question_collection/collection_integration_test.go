@@ -142,7 +142,7 @@ func TestQuestionCollectionIntegration(t *testing.T) {
 			Page:  utils.Ptr(1),
 			Limit: utils.Ptr(10),
 		}
-		allCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, paginationInput)
+		allCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, paginationInput, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 3, allCollections.TotalItems)
 		assert.Len(t, allCollections.Items, 3)
@@ -224,15 +224,15 @@ func TestQuestionCollectionIntegration(t *testing.T) {
 		require.NoError(t, err)
 
 		// Step 10: Verify user isolation
-		teacherCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, nil)
+		teacherCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 3, teacherCollections.TotalItems) // Should only see teacher's collections
 
-		student1Collections, err := question_collection.PaginatedQuestionCollections(ctx, student1ID, nil)
+		student1Collections, err := question_collection.PaginatedQuestionCollections(ctx, student1ID, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 1, student1Collections.TotalItems) // Should only see student1's collection
 
-		student2Collections, err := question_collection.PaginatedQuestionCollections(ctx, student2ID, nil)
+		student2Collections, err := question_collection.PaginatedQuestionCollections(ctx, student2ID, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 1, student2Collections.TotalItems) // Should only see student2's collection
 
@@ -242,7 +242,7 @@ func TestQuestionCollectionIntegration(t *testing.T) {
 		assert.True(t, success)
 
 		// Verify deletion
-		finalTeacherCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, nil)
+		finalTeacherCollections, err := question_collection.PaginatedQuestionCollections(ctx, teacherID, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 2, finalTeacherCollections.TotalItems) // Should have 2 collections now
 
@@ -76,7 +76,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 	}
 
 	t.Run("PaginatedCollections_DefaultPagination", func(t *testing.T) {
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 1, result.CurrentPage)
@@ -99,7 +99,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(5),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 1, result.CurrentPage)
@@ -115,7 +115,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(5),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 2, result.CurrentPage)
@@ -131,7 +131,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(5),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 3, result.CurrentPage)
@@ -147,7 +147,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(5),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 10, result.CurrentPage)
@@ -163,7 +163,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(100),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 1, result.CurrentPage)
@@ -179,7 +179,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(0),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		// This may succeed or fail depending on implementation
 		// If it succeeds, it should handle gracefully
 		if err == nil {
@@ -193,7 +193,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(-5),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		// Should handle negative values gracefully
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -201,13 +201,13 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 
 	t.Run("PaginatedCollections_UserIsolation", func(t *testing.T) {
 		// User1 should only see their own collections
-		result1, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil)
+		result1, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result1)
 		assert.Equal(t, len(collectionTitles), result1.TotalItems)
 
 		// User2 should only see their own collections
-		result2, err := question_collection.PaginatedQuestionCollections(ctx, user2ID, nil)
+		result2, err := question_collection.PaginatedQuestionCollections(ctx, user2ID, nil, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result2)
 		assert.Equal(t, len(user2CollectionTitles), result2.TotalItems)
@@ -229,7 +229,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 		emptyUserEntity := prepare.CreateUser(t, emptyUserInput)
 		emptyUserID := emptyUserEntity.ID
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, emptyUserID, nil)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, emptyUserID, nil, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 0, result.TotalItems)
@@ -242,7 +242,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 
 	t.Run("PaginatedCollections_AfterDeletion", func(t *testing.T) {
 		// Get initial count
-		initialResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil)
+		initialResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil, nil)
 		assert.NoError(t, err)
 		initialCount := initialResult.TotalItems
 
@@ -254,7 +254,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 		require.NoError(t, err)
 
 		// Verify count increased
-		afterCreateResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil)
+		afterCreateResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCount+1, afterCreateResult.TotalItems)
 
@@ -264,7 +264,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 		assert.True(t, success)
 
 		// Verify count is back to initial
-		afterDeleteResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil)
+		afterDeleteResult, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCount, afterDeleteResult.TotalItems)
 	})
@@ -276,10 +276,10 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(5),
 		}
 
-		result1, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result1, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 
-		result2, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result2, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 
 		// Results should be identical
@@ -297,7 +297,7 @@ func TestPaginatedQuestionCollections(t *testing.T) {
 			Limit: utils.Ptr(limit),
 		}
 
-		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput)
+		result, err := question_collection.PaginatedQuestionCollections(ctx, user1ID, paginationInput, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 
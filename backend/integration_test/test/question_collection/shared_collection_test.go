@@ -0,0 +1,182 @@
+package question_collection
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/features/access"
+	"template/internal/features/group"
+	"template/internal/features/question"
+	"template/internal/features/question_collection"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedQuestionCollections(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	author := prepare.CreateUser(t, model.RegisterInput{Email: "author@test.com", Password: "password123"})
+	colleague := prepare.CreateUser(t, model.RegisterInput{Email: "colleague@test.com", Password: "password123"})
+	departmentMember := prepare.CreateUser(t, model.RegisterInput{Email: "member@test.com", Password: "password123"})
+
+	collection, questions := prepare.CreateCollectionWithQuestions(t, author.ID, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	prepare.CreateQuestionCollection(t, colleague.ID, model.CreateQuestionCollectionInput{Title: "Colleague collection"})
+
+	newQuestionInput := model.CreateQuestionInput{
+		QuestionText:         "Shared question",
+		QuestionCollectionID: collection.ID,
+		Points:               5,
+		Options: []*model.QuestionOptionInput{
+			{OptionText: "Right", IsCorrect: true},
+			{OptionText: "Wrong", IsCorrect: false},
+		},
+	}
+	questionIds := []uuid.UUID{questions[0].ID, questions[1].ID}
+
+	t.Run("NotShared_NoAccess", func(t *testing.T) {
+		_, err := question.CreateQuestion(ctx, colleague.ID, newQuestionInput)
+		assert.Error(t, err)
+
+		_, err = question.ExportQuestions(ctx, colleague.ID, questionIds)
+		assert.Error(t, err)
+
+		shared, err := question_collection.PaginatedQuestionCollections(ctx, colleague.ID, nil, utils.Ptr(model.QuestionCollectionScopeSharedWithMe))
+		require.NoError(t, err)
+		assert.Empty(t, shared.Items)
+	})
+
+	t.Run("ViewerGrant_ListsButCannotEdit", func(t *testing.T) {
+		_, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			UserID:       &colleague.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		require.NoError(t, err)
+
+		shared, err := question_collection.PaginatedQuestionCollections(ctx, colleague.ID, nil, utils.Ptr(model.QuestionCollectionScopeSharedWithMe))
+		require.NoError(t, err)
+		require.Len(t, shared.Items, 1)
+		assert.Equal(t, collection.ID, shared.Items[0].ID)
+
+		owned, err := question_collection.PaginatedQuestionCollections(ctx, colleague.ID, nil, utils.Ptr(model.QuestionCollectionScopeOwned))
+		require.NoError(t, err)
+		require.Len(t, owned.Items, 1)
+		assert.Equal(t, "Colleague collection", owned.Items[0].Title)
+
+		all, err := question_collection.PaginatedQuestionCollections(ctx, colleague.ID, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, all.Items, 2)
+
+		_, err = question_collection.GetQuestionCollectionByID(ctx, colleague.ID, collection.ID)
+		assert.NoError(t, err)
+
+		_, err = question.CreateQuestion(ctx, colleague.ID, newQuestionInput)
+		assert.Error(t, err)
+	})
+
+	t.Run("EditorGrant_AllowsCreateBatchUpdateAndExport", func(t *testing.T) {
+		_, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			UserID:       &colleague.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		require.NoError(t, err)
+
+		created, err := question.CreateQuestion(ctx, colleague.ID, newQuestionInput)
+		require.NoError(t, err)
+		assert.Equal(t, collection.ID, created.CollectionID)
+
+		err = question_collection.UpdateBatchQuestionsByCollection(ctx, colleague.ID, model.UpdateBatchQuestionsByCollectionInput{
+			CollectionID: collection.ID,
+			Questions: []*model.UpdateQuestionData{
+				{
+					ID:           &questions[0].ID,
+					QuestionText: utils.Ptr("Edited by a colleague"),
+					Points:       10,
+					Options: []*model.UpdateQuestionOptionInput{
+						{OptionText: utils.Ptr("Yes"), IsCorrect: utils.Ptr(true)},
+						{OptionText: utils.Ptr("No"), IsCorrect: utils.Ptr(false)},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		exported, err := question.ExportQuestions(ctx, colleague.ID, questionIds)
+		require.NoError(t, err)
+		assert.Contains(t, exported, "Edited by a colleague")
+	})
+
+	t.Run("GroupGrant_GivesAccessToMembers", func(t *testing.T) {
+		_, err := question.ExportQuestions(ctx, departmentMember.ID, questionIds)
+		assert.Error(t, err)
+
+		department, err := group.CreateGroup(ctx, author.ID, model.CreateGroupInput{
+			Name:      "Science department",
+			MemberIds: []uuid.UUID{departmentMember.ID},
+		})
+		require.NoError(t, err)
+
+		_, err = access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			GroupID:      &department.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		require.NoError(t, err)
+
+		shared, err := question_collection.PaginatedQuestionCollections(ctx, departmentMember.ID, nil, utils.Ptr(model.QuestionCollectionScopeSharedWithMe))
+		require.NoError(t, err)
+		assert.Len(t, shared.Items, 1)
+
+		_, err = question.ExportQuestions(ctx, departmentMember.ID, questionIds)
+		assert.NoError(t, err)
+
+		_, err = group.RemoveGroupMembers(ctx, author.ID, false, department.ID, []uuid.UUID{departmentMember.ID})
+		require.NoError(t, err)
+
+		_, err = question.ExportQuestions(ctx, departmentMember.ID, questionIds)
+		assert.Error(t, err)
+	})
+
+	t.Run("ShareWithInvisibleGroup_Rejected", func(t *testing.T) {
+		foreignGroup, err := group.CreateGroup(ctx, departmentMember.ID, model.CreateGroupInput{
+			Name: "Group the author is not part of",
+		})
+		require.NoError(t, err)
+
+		_, err = access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			GroupID:      &foreignGroup.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		assert.Error(t, err)
+
+		_, err = access.ShareResource(ctx, author.ID, true, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			GroupID:      &foreignGroup.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		assert.NoError(t, err, "Admins can share with any group")
+	})
+
+	t.Run("ShareWithUserAndGroup_Rejected", func(t *testing.T) {
+		_, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   collection.ID,
+			UserID:       &colleague.ID,
+			GroupID:      utils.Ptr(uuid.New()),
+			Role:         model.AccessRoleViewer,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package role
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/features/permission"
+	"template/internal/features/role"
+	"template/internal/graph/model"
+	"template/internal/seeder"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrincipalCache tests that cached roles and permissions are reloaded once the roles of a user change
+func TestPrincipalCache(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+
+	role.SetPrincipalCacheTTL(time.Minute)
+	t.Cleanup(func() { role.SetPrincipalCacheTTL(0) })
+
+	ctx := context.Background()
+
+	member := prepare.CreateRegularUser(t, "member@cache.com", "member_cache")
+	admin := prepare.CreateAdminUser(t, "admin@cache.com", "admin_cache")
+	userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+
+	reviewer, err := role.CreateRole(ctx, admin.ID, model.CreateRoleInput{
+		Name:        "cache-reviewer",
+		Permissions: []permission.Permission{permission.CollectionRead},
+	})
+	require.NoError(t, err)
+
+	t.Run("RequestPrincipal_LoadedOnce", func(t *testing.T) {
+		requestCtx := role.WithRequestPrincipals(ctx)
+
+		first, err := role.GetPrincipal(requestCtx, member.ID)
+		require.NoError(t, err)
+		second, err := role.GetPrincipal(requestCtx, member.ID)
+		require.NoError(t, err)
+
+		assert.Same(t, first, second)
+		assert.Contains(t, first.Roles, seeder.RoleUser)
+		assert.False(t, first.IsAdminOrOwner())
+	})
+
+	t.Run("SetUserRoles_InvalidatesCache", func(t *testing.T) {
+		err := role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.CollectionRead})
+		assert.Error(t, err)
+
+		_, err = role.SetUserRoles(ctx, admin.ID, member.ID, []uuid.UUID{userRoleID, reviewer.ID})
+		require.NoError(t, err)
+
+		err = role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.CollectionRead})
+		assert.NoError(t, err, "The new role is seen without waiting for the cache to expire")
+	})
+
+	t.Run("RolePermissionChange_InvalidatesCache", func(t *testing.T) {
+		err := role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.TestRead})
+		assert.Error(t, err)
+
+		_, err = role.AddRolePermissions(ctx, admin.ID, reviewer.ID, []permission.Permission{permission.TestRead})
+		require.NoError(t, err)
+
+		err = role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.TestRead})
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeleteRole_InvalidatesCache", func(t *testing.T) {
+		_, err := role.DeleteRole(ctx, reviewer.ID)
+		require.NoError(t, err)
+
+		err = role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.CollectionRead})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+package role
+
+import (
+	"context"
+	"sync"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/features/permission"
+	"template/internal/features/role"
+	"template/internal/features/user"
+	"template/internal/graph/model"
+	"template/internal/seeder"
+	"template/internal/shared/utilities/slice"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRoleManagement tests creating custom roles, managing their permissions and assigning them to users
+func TestRoleManagement(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+
+	ctx := context.Background()
+	admin := prepare.CreateAdminUser(t, "admin@roles.com", "admin_roles")
+
+	var reviewerRoleID uuid.UUID
+
+	t.Run("CreateRole_Success", func(t *testing.T) {
+		created, err := role.CreateRole(ctx, admin.ID, model.CreateRoleInput{
+			Name:        "reviewer",
+			Description: utils.Ptr("Reviews question collections"),
+			Permissions: []permission.Permission{permission.CollectionRead, permission.QuestionRead},
+		})
+		require.NoError(t, err)
+		reviewerRoleID = created.ID
+
+		permissions, err := role.GetPermissionsByRoleIDs(ctx, []uuid.UUID{created.ID})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []permission.Permission{permission.CollectionRead, permission.QuestionRead}, permissions[created.ID])
+	})
+
+	t.Run("CreateRole_DuplicateName_Error", func(t *testing.T) {
+		_, err := role.CreateRole(ctx, admin.ID, model.CreateRoleInput{Name: "reviewer"})
+		assert.Error(t, err)
+	})
+
+	t.Run("AddAndRemoveRolePermissions_Success", func(t *testing.T) {
+		_, err := role.AddRolePermissions(ctx, admin.ID, reviewerRoleID, []permission.Permission{permission.TestRead})
+		require.NoError(t, err)
+		_, err = role.RemoveRolePermissions(ctx, reviewerRoleID, []permission.Permission{permission.QuestionRead})
+		require.NoError(t, err)
+
+		permissions, err := role.GetPermissionsByRoleIDs(ctx, []uuid.UUID{reviewerRoleID})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []permission.Permission{permission.CollectionRead, permission.TestRead}, permissions[reviewerRoleID])
+	})
+
+	t.Run("PermissionsNotHeld_CannotBeGranted", func(t *testing.T) {
+		_, err := role.AddRolePermissions(ctx, admin.ID, reviewerRoleID, []permission.Permission{permission.OrganizationCreate})
+		assert.Error(t, err, "Admins don't have the organization permissions")
+
+		_, err = role.CreateRole(ctx, admin.ID, model.CreateRoleInput{
+			Name:        "organizer",
+			Permissions: []permission.Permission{permission.OrganizationCreate},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("SystemRoles_AreProtected", func(t *testing.T) {
+		ownerRoleID := prepare.GetRoleID(t, seeder.RoleOwner)
+		userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+
+		_, err := role.RemoveRolePermissions(ctx, ownerRoleID, []permission.Permission{permission.UserRead})
+		assert.Error(t, err, "The owner role keeps every permission")
+
+		_, err = role.UpdateRole(ctx, userRoleID, model.UpdateRoleInput{Name: utils.Ptr("member")})
+		assert.Error(t, err, "System roles can't be renamed")
+
+		_, err = role.DeleteRole(ctx, userRoleID)
+		assert.Error(t, err, "System roles can't be deleted")
+	})
+
+	t.Run("SetUserRoles_MultipleRoles_Success", func(t *testing.T) {
+		member := prepare.CreateRegularUser(t, "member@roles.com", "member_roles")
+		userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+
+		_, err := role.SetUserRoles(ctx, admin.ID, member.ID, []uuid.UUID{userRoleID, reviewerRoleID})
+		require.NoError(t, err)
+
+		withRoles := prepare.GetUserWithRoles(t, member.ID)
+		assert.Len(t, withRoles.Edges.Roles, 2)
+
+		err = role.CheckUserPermissions(ctx, member.ID, []permission.Permission{permission.CollectionRead, permission.SessionRead})
+		assert.NoError(t, err, "The user has the permissions of both roles")
+	})
+
+	t.Run("LastOwner_CannotBeDemoted", func(t *testing.T) {
+		owner := prepare.CreateOwnerUser(t, "owner@roles.com", "owner_roles")
+		userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+
+		_, err := role.SetUserRoles(ctx, owner.ID, owner.ID, []uuid.UUID{userRoleID})
+		assert.Error(t, err)
+
+		_, err = user.AdminUpdateUser(ctx, owner.ID, owner.ID, model.AdminEditUserInput{RoleIds: []uuid.UUID{userRoleID}})
+		assert.Error(t, err)
+
+		_, err = user.AdminUpdateUser(ctx, owner.ID, owner.ID, model.AdminEditUserInput{IsActive: utils.Ptr(false)})
+		assert.Error(t, err, "The last owner can't be deactivated")
+
+		// With a second owner the first one can be demoted
+		prepare.CreateOwnerUser(t, "second-owner@roles.com", "second_owner_roles")
+		_, err = role.SetUserRoles(ctx, owner.ID, owner.ID, []uuid.UUID{userRoleID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("OwnerRole_OnlyManagedByOwners", func(t *testing.T) {
+		owner := prepare.CreateOwnerUser(t, "third-owner@roles.com", "third_owner_roles")
+		member := prepare.CreateRegularUser(t, "promoted@roles.com", "promoted_roles")
+		ownerRoleID := prepare.GetRoleID(t, seeder.RoleOwner)
+		userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+
+		_, err := role.SetUserRoles(ctx, admin.ID, member.ID, []uuid.UUID{ownerRoleID})
+		assert.Error(t, err, "An admin can't grant the owner role")
+		_, err = user.AdminUpdateUser(ctx, admin.ID, member.ID, model.AdminEditUserInput{RoleIds: []uuid.UUID{ownerRoleID}})
+		assert.Error(t, err)
+
+		_, err = role.SetUserRoles(ctx, admin.ID, owner.ID, []uuid.UUID{userRoleID})
+		assert.Error(t, err, "An admin can't revoke the owner role")
+		_, err = user.AdminUpdateUser(ctx, admin.ID, owner.ID, model.AdminEditUserInput{Password: utils.Ptr("taken-over")})
+		assert.Error(t, err, "An admin can't take over an owner account")
+
+		_, err = role.SetUserRoles(ctx, owner.ID, member.ID, []uuid.UUID{ownerRoleID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeleteRole_Success", func(t *testing.T) {
+		deleted, err := role.DeleteRole(ctx, reviewerRoleID)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		_, err = role.GetRoleByID(ctx, reviewerRoleID)
+		assert.Error(t, err)
+	})
+}
+
+// TestOwnerDemotion_Concurrent tests that owners demoting themselves at the same time can't remove every owner
+func TestOwnerDemotion_Concurrent(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+
+	ctx := context.Background()
+	userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+	owners := []uuid.UUID{
+		prepare.CreateOwnerUser(t, "first@demotion.com", "first_demotion").ID,
+		prepare.CreateOwnerUser(t, "second@demotion.com", "second_demotion").ID,
+	}
+
+	errs := make([]error, len(owners))
+	var wg sync.WaitGroup
+	for i, ownerID := range owners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = role.SetUserRoles(ctx, ownerID, ownerID, []uuid.UUID{userRoleID})
+		}()
+	}
+	wg.Wait()
+
+	failed := slice.Filter(errs, func(err error) bool { return err != nil })
+	assert.Len(t, failed, 1, "Only one of the owners is demoted")
+
+	remaining := 0
+	for _, ownerID := range owners {
+		principal, err := role.GetPrincipal(ctx, ownerID)
+		require.NoError(t, err)
+		if principal.IsPlatformOwner() {
+			remaining++
+		}
+	}
+	assert.Equal(t, 1, remaining)
+}
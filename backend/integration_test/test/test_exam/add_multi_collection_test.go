@@ -29,7 +29,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{scenario.Collection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -43,7 +43,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{scenario.Collection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -57,7 +57,7 @@ func TestAddMultiCollection(t *testing.T) {
 			TestID:        scenario.Test.ID,
 			CollectionIds: []uuid.UUID{scenario.Collection.ID},
 		}
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, initialInput)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, initialInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 
@@ -70,7 +70,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{collection2.ID, collection3.ID},
 		}
 
-		result, err = test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, replaceInput)
+		result, err = test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, replaceInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -84,7 +84,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{scenario.Collection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "test not found")
@@ -108,7 +108,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{unauthorizedCollection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more collections not found or unauthorized")
@@ -132,7 +132,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{scenario.Collection.ID, unauthorizedCollection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more collections not found or unauthorized")
@@ -147,7 +147,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{nonExistentCollectionID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more collections not found or unauthorized")
@@ -162,7 +162,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{scenario.Collection.ID, scenario.Collection.ID},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		// This should still succeed, but the collection will only be added once
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -176,7 +176,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: []uuid.UUID{},
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		// Should succeed as clearing collections is valid
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -207,7 +207,7 @@ func TestAddMultiCollection(t *testing.T) {
 			CollectionIds: collectionIds,
 		}
 
-		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, input)
+		result, err := test.UpdateQuestionCollectionsForTest(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -37,7 +37,28 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("BatchIgnoreQuestions_Admin_Success", func(t *testing.T) {
+		scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 10}})
+		admin := prepare.CreateUser(t, model.RegisterInput{
+			Email:    utils.Faker.Internet().Email(),
+			Password: "testpassword123",
+		})
+
+		input := model.BatchIgnoreQuestionsInput{
+			TestID:             scenario.Test.ID,
+			QuestionIgnoreData: []*model.QuestionIgnoreData{{QuestionID: scenario.Questions[0].ID}},
+		}
+
+		result, err := test.BatchIgnoreQuestions(ctx, admin.ID, false, input)
+		assert.Error(t, err)
+		assert.False(t, result)
+
+		result, err = test.BatchIgnoreQuestions(ctx, admin.ID, true, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -53,7 +74,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -76,7 +97,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -99,7 +120,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, initialInput)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, initialInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 
@@ -118,7 +139,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err = test.BatchIgnoreQuestions(ctx, scenario.User.ID, updateInput)
+		result, err = test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, updateInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -136,7 +157,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -152,7 +173,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "test not found")
@@ -166,7 +187,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			QuestionIgnoreData: []*model.QuestionIgnoreData{},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -194,7 +215,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario1.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario1.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more questions not found or unauthorized")
@@ -227,7 +248,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario1.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario1.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more questions not found or unauthorized")
@@ -247,7 +268,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.Error(t, err)
 		assert.False(t, result)
 		assert.Contains(t, err.Error(), "one or more questions not found or unauthorized")
@@ -270,7 +291,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		// Should succeed, but only one ignore record should be created
 		assert.Error(t, err)
 		assert.False(t, result)
@@ -292,7 +313,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		// This may succeed or fail depending on database constraints
 		if err == nil {
 			assert.True(t, result)
@@ -324,7 +345,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			QuestionIgnoreData: questionIgnoreData,
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, input)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, input)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -341,7 +362,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			},
 		}
 
-		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, initialInput)
+		result, err := test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, initialInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 
@@ -351,7 +372,7 @@ func TestBatchIgnoreQuestions(t *testing.T) {
 			QuestionIgnoreData: []*model.QuestionIgnoreData{},
 		}
 
-		result, err = test.BatchIgnoreQuestions(ctx, scenario.User.ID, clearInput)
+		result, err = test.BatchIgnoreQuestions(ctx, scenario.User.ID, false, clearInput)
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
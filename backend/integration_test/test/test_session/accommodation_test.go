@@ -0,0 +1,212 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccommodations tests the time accommodations, rest breaks and audited extensions of test sessions
+func TestAccommodations(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	proctor := scenario.User
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@accommodation.com", Password: "testpassword123"})
+
+	proctorCourse := prepare.CreateCourse(t, proctor.ID, model.CreateCourseInput{
+		Title:       "Accommodation Course",
+		Description: utils.Ptr("Course used to test accommodations"),
+	})
+	_, err = client.Test.UpdateOneID(scenario.Test.ID).
+		SetCourseID(proctorCourse.ID).
+		SetTotalTime(60).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createSession := func() uuid.UUID {
+		sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  scenario.Test.ID,
+			UserIds: []uuid.UUID{candidate.ID},
+		})
+		require.NoError(t, err)
+		return sessions[0].ID
+	}
+
+	t.Run("SetUserAccommodation_NotAdmin_Error", func(t *testing.T) {
+		_, err := test_session.SetUserAccommodation(ctx, proctor.ID, false, candidate.ID, model.AccommodationInput{
+			TimeMultiplier: utils.Ptr(1.5),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("SetUserAccommodation_InvalidMultiplier_Error", func(t *testing.T) {
+		_, err := test_session.SetUserAccommodation(ctx, proctor.ID, true, candidate.ID, model.AccommodationInput{
+			TimeMultiplier: utils.Ptr(0.5),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("StartTestSession_WithUserAccommodation", func(t *testing.T) {
+		accommodation, err := test_session.SetUserAccommodation(ctx, proctor.ID, true, candidate.ID, model.AccommodationInput{
+			TimeMultiplier: utils.Ptr(1.5),
+			ExtraMinutes:   utils.Ptr(10),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1.5, accommodation.TimeMultiplier)
+		assert.False(t, accommodation.AllowRestBreaks)
+
+		started, err := test_session.StartTestSession(ctx, candidate.ID, createSession())
+		require.NoError(t, err)
+		require.NotNil(t, started.ExpiredAt)
+		assert.WithinDuration(t, started.StartedAt.Add(100*time.Minute), *started.ExpiredAt, time.Second)
+
+		_, err = test_session.PauseTestSession(ctx, candidate.ID, started.ID)
+		assert.Error(t, err, "Rest breaks are not allowed by the user accommodation")
+	})
+
+	t.Run("StartTestSession_WithSessionOverride_And_RestBreak", func(t *testing.T) {
+		sessionID := createSession()
+		_, err := test_session.SetTestSessionAccommodation(ctx, candidate.ID, false, sessionID, model.AccommodationInput{
+			ExtraMinutes: utils.Ptr(30),
+		})
+		assert.Error(t, err, "Candidates cannot set their own accommodations")
+
+		_, err = test_session.SetTestSessionAccommodation(ctx, proctor.ID, false, sessionID, model.AccommodationInput{
+			TimeMultiplier:  utils.Ptr(1.0),
+			ExtraMinutes:    utils.Ptr(0),
+			AllowRestBreaks: utils.Ptr(true),
+		})
+		require.NoError(t, err)
+
+		started, err := test_session.StartTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, started.StartedAt.Add(60*time.Minute), *started.ExpiredAt, time.Second)
+
+		paused, err := test_session.PauseTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		require.NotNil(t, paused.PausedAt)
+
+		_, err = test_session.SaveTestSessionAnswer(ctx, candidate.ID, sessionID, model.TestSessionAnswerInput{
+			QuestionID:        uuid.New(),
+			QuestionOptionIds: []uuid.UUID{},
+		})
+		assert.Error(t, err, "Answers cannot be saved during a rest break")
+
+		resumed, err := test_session.ResumeTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		assert.Nil(t, resumed.PausedAt)
+		assert.True(t, resumed.ExpiredAt.After(*started.ExpiredAt), "The break duration should be added back to the session")
+	})
+
+	t.Run("RestBreak_EnforcesAllowance", func(t *testing.T) {
+		sessionID := createSession()
+		_, err := test_session.SetTestSessionAccommodation(ctx, proctor.ID, false, sessionID, model.AccommodationInput{
+			MaxRestBreaks: utils.Ptr(-1),
+		})
+		assert.Error(t, err, "The break count must not be negative")
+
+		_, err = test_session.SetTestSessionAccommodation(ctx, proctor.ID, false, sessionID, model.AccommodationInput{
+			AllowRestBreaks:     utils.Ptr(true),
+			MaxRestBreaks:       utils.Ptr(1),
+			MaxRestBreakMinutes: utils.Ptr(5),
+		})
+		require.NoError(t, err)
+
+		started, err := test_session.StartTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+
+		_, err = test_session.PauseTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+
+		// Pretend the break started 20 minutes ago
+		_, err = client.TestSession.UpdateOneID(sessionID).
+			SetPausedAt(time.Now().Add(-20 * time.Minute)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		resumed, err := test_session.ResumeTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, started.ExpiredAt.Add(5*time.Minute), *resumed.ExpiredAt, time.Second, "Only the allowed break length should be added back")
+
+		_, err = test_session.PauseTestSession(ctx, candidate.ID, sessionID)
+		assert.Error(t, err, "The only allowed rest break was already taken")
+	})
+
+	t.Run("PausedSession_ExpiresAfterAllowedBreak_And_ForceSubmitEndsBreak", func(t *testing.T) {
+		sessionID := createSession()
+		_, err := test_session.SetTestSessionAccommodation(ctx, proctor.ID, false, sessionID, model.AccommodationInput{
+			AllowRestBreaks:     utils.Ptr(true),
+			MaxRestBreakMinutes: utils.Ptr(5),
+		})
+		require.NoError(t, err)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		_, err = test_session.PauseTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+
+		// Pretend the time ran out 10 minutes ago during a break of 30 minutes, 5 minutes beyond the allowed break
+		_, err = client.TestSession.UpdateOneID(sessionID).
+			SetPausedAt(time.Now().Add(-30 * time.Minute)).
+			SetExpiredAt(time.Now().Add(-10 * time.Minute)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = test_session.ExtendTestSession(ctx, proctor.ID, false, sessionID, 15, "Break overrun")
+		require.NoError(t, err)
+		resumed, err := test_session.ResumeTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), *resumed.ExpiredAt, time.Second, "The extension counts from now")
+
+		_, err = test_session.PauseTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+
+		submitted, err := test_session.ForceSubmitTestSession(ctx, proctor.ID, false, sessionID)
+		require.NoError(t, err)
+		assert.Nil(t, submitted.PausedAt)
+
+		openBreaks, err := client.TestSessionBreak.Query().
+			Where(testsessionbreak.SessionID(sessionID), testsessionbreak.EndedAtIsNil()).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, openBreaks, "The rest break ends with the submission")
+	})
+
+	t.Run("ExtendTestSession_RecordsAudit", func(t *testing.T) {
+		started, err := test_session.StartTestSession(ctx, candidate.ID, createSession())
+		require.NoError(t, err)
+
+		_, err = test_session.ExtendTestSession(ctx, proctor.ID, false, started.ID, 15, " ")
+		assert.Error(t, err, "A reason is required")
+
+		extended, err := test_session.ExtendTestSession(ctx, proctor.ID, false, started.ID, 15, "Fire alarm")
+		require.NoError(t, err)
+
+		extensions, err := test_session.GetTestSessionTimeExtensions(ctx, proctor.ID, false, started.ID)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+		assert.Equal(t, proctor.ID, extensions[0].ExtendedByID)
+		assert.Equal(t, 15, extensions[0].Minutes)
+		assert.Equal(t, "Fire alarm", extensions[0].Reason)
+		assert.WithinDuration(t, *started.ExpiredAt, extensions[0].PreviousExpiredAt, time.Second)
+		assert.WithinDuration(t, *extended.ExpiredAt, extensions[0].NewExpiredAt, time.Second)
+
+		_, err = test_session.GetTestSessionTimeExtensions(ctx, candidate.ID, false, started.ID)
+		assert.Error(t, err)
+	})
+}
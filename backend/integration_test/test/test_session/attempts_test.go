@@ -0,0 +1,118 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/features/test"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttemptsPolicy tests the cooldown between attempts and the final grade of each scoring policy
+func TestAttemptsPolicy(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@attempts.com", Password: "testpassword123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@attempts.com", Password: "testpassword123"})
+
+	// completeAttempt starts a new attempt and completes it with the given points out of 10
+	completeAttempt := func(pointsEarned int, completedAt time.Time) {
+		sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  scenario.Test.ID,
+			UserIds: []uuid.UUID{candidate.ID},
+		})
+		require.NoError(t, err)
+		started, err := test_session.StartTestSession(ctx, candidate.ID, sessions[0].ID)
+		require.NoError(t, err)
+
+		_, err = client.TestSession.UpdateOneID(started.ID).
+			SetStatus(testsession.StatusCompleted).
+			SetMaxPoints(10).
+			SetPointsEarned(pointsEarned).
+			SetCompletedAt(completedAt).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	completeAttempt(8, now.Add(-3*time.Hour))
+	completeAttempt(4, now.Add(-2*time.Hour))
+
+	t.Run("UserTestAttempts_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.GetUserTestAttempts(ctx, otherUser.ID, false, scenario.Test.ID, candidate.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("UserTestAttempts_Highest", func(t *testing.T) {
+		attempts, err := test_session.GetUserTestAttempts(ctx, candidate.ID, false, scenario.Test.ID, candidate.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.TestScoringPolicyHighest, attempts.ScoringPolicy)
+		assert.Equal(t, 2, attempts.AttemptsUsed)
+		assert.Nil(t, attempts.AttemptsRemaining)
+		require.Len(t, attempts.Attempts, 2)
+		require.NotNil(t, attempts.FinalPoints)
+		assert.Equal(t, 8.0, *attempts.FinalPoints)
+		assert.Equal(t, 80.0, *attempts.FinalPercent)
+	})
+
+	t.Run("UserTestAttempts_Latest", func(t *testing.T) {
+		_, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			ScoringPolicy: utils.Ptr(model.TestScoringPolicyLatest),
+			MaxAttempts:   utils.Ptr(3),
+		})
+		require.NoError(t, err)
+
+		attempts, err := test_session.GetUserTestAttempts(ctx, candidate.ID, false, scenario.Test.ID, candidate.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, *attempts.FinalPoints)
+		require.NotNil(t, attempts.AttemptsRemaining)
+		assert.Equal(t, 1, *attempts.AttemptsRemaining)
+	})
+
+	t.Run("UserTestAttempts_Average", func(t *testing.T) {
+		_, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			ScoringPolicy: utils.Ptr(model.TestScoringPolicyAverage),
+		})
+		require.NoError(t, err)
+
+		attempts, err := test_session.GetUserTestAttempts(ctx, scenario.User.ID, true, scenario.Test.ID, candidate.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 6.0, *attempts.FinalPoints)
+		assert.Equal(t, 60.0, *attempts.FinalPercent)
+	})
+
+	t.Run("StartTestSession_Cooldown_Error", func(t *testing.T) {
+		_, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			AttemptCooldownMinutes: utils.Ptr(180),
+		})
+		require.NoError(t, err)
+
+		attempts, err := test_session.GetUserTestAttempts(ctx, candidate.ID, false, scenario.Test.ID, candidate.ID)
+		require.NoError(t, err)
+		require.NotNil(t, attempts.NextAttemptAvailableAt)
+		assert.WithinDuration(t, now.Add(time.Hour), *attempts.NextAttemptAvailableAt, time.Second)
+
+		sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  scenario.Test.ID,
+			UserIds: []uuid.UUID{candidate.ID},
+		})
+		require.NoError(t, err)
+		_, err = test_session.StartTestSession(ctx, candidate.ID, sessions[0].ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "next attempt is available")
+	})
+}
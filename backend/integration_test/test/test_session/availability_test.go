@@ -0,0 +1,125 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/features/common"
+	"template/internal/features/test"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAvailabilityWindows tests the open/close windows and the maximum attempts of tests
+func TestAvailabilityWindows(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@availability.com", Password: "testpassword123"})
+
+	createSession := func(input model.CreateTestSessionInput) uuid.UUID {
+		input.TestID = scenario.Test.ID
+		input.UserIds = []uuid.UUID{candidate.ID}
+		sessions, err := test_session.CreateTestSession(ctx, input)
+		require.NoError(t, err)
+		return sessions[0].ID
+	}
+
+	assertBadRequest := func(t *testing.T, err error, contains string) {
+		require.Error(t, err)
+		var badRequestErr *common.BadRequestError
+		assert.True(t, errors.As(err, &badRequestErr), "Expected a bad request error, got %v", err)
+		assert.Contains(t, err.Error(), contains)
+	}
+
+	t.Run("UpdateTest_InvalidWindow_Error", func(t *testing.T) {
+		now := time.Now()
+		_, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			OpenAt:  utils.Ptr(now),
+			CloseAt: utils.Ptr(now.Add(-time.Hour)),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("StartTestSession_NotOpenYet_Error", func(t *testing.T) {
+		_, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			OpenAt: utils.Ptr(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, createSession(model.CreateTestSessionInput{}))
+		assertBadRequest(t, err, "not open yet")
+	})
+
+	t.Run("StartTestSession_AssignmentOverride_NonUTCZone_Success", func(t *testing.T) {
+		// An hour ago expressed in UTC+7 must be stored and compared as the same instant
+		zone := time.FixedZone("UTC+7", 7*60*60)
+		openAt := time.Now().Add(-time.Hour).In(zone)
+		sessionID := createSession(model.CreateTestSessionInput{
+			OpenAt:  utils.Ptr(openAt),
+			CloseAt: utils.Ptr(time.Now().Add(time.Hour).In(zone)),
+		})
+
+		stored, err := client.TestSession.Get(ctx, sessionID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.OpenAt)
+		assert.WithinDuration(t, openAt, *stored.OpenAt, time.Second)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, sessionID)
+		require.NoError(t, err)
+	})
+
+	t.Run("StartTestSession_Closed_Error", func(t *testing.T) {
+		_, err := client.Test.UpdateOneID(scenario.Test.ID).
+			SetOpenAt(time.Now().UTC().Add(-2 * time.Hour)).
+			SetCloseAt(time.Now().UTC().Add(-time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, createSession(model.CreateTestSessionInput{}))
+		assertBadRequest(t, err, "closed")
+	})
+
+	t.Run("StartTestSession_MaxAttempts_Error", func(t *testing.T) {
+		updated, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			ClearOpenAt:  utils.Ptr(true),
+			ClearCloseAt: utils.Ptr(true),
+			MaxAttempts:  utils.Ptr(2),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.OpenAt)
+		assert.Nil(t, updated.CloseAt)
+
+		// One attempt has already been started by the assignment override subtest
+		_, err = test_session.StartTestSession(ctx, candidate.ID, createSession(model.CreateTestSessionInput{}))
+		require.NoError(t, err)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, createSession(model.CreateTestSessionInput{}))
+		assertBadRequest(t, err, "maximum number of attempts")
+	})
+
+	t.Run("UpdateTest_ClearMaxAttempts_Success", func(t *testing.T) {
+		updated, err := test.UpdateTest(ctx, scenario.User.ID, false, scenario.Test.ID, model.UpdateTestInput{
+			ClearMaxAttempts:            utils.Ptr(true),
+			ClearAttemptCooldownMinutes: utils.Ptr(true),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.MaxAttempts)
+		assert.Nil(t, updated.AttemptCooldownMinutes)
+
+		_, err = test_session.StartTestSession(ctx, candidate.ID, createSession(model.CreateTestSessionInput{}))
+		assert.NoError(t, err)
+	})
+}
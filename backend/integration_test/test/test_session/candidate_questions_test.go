@@ -0,0 +1,112 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent"
+	"template/internal/features/question"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCandidateQuestions tests that candidates get the questions of their session without the answer key
+func TestCandidateQuestions(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	questionCountConfigs := []prepare.QuestionCountConfig{{Count: 2, Points: 10}}
+	scenario := prepare.CreateTestScenario(t, questionCountConfigs)
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@questions.com", Password: "testpassword123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@questions.com", Password: "testpassword123"})
+
+	sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenario.Test.ID,
+		UserIds: []uuid.UUID{candidate.ID},
+	})
+	require.NoError(t, err)
+	sessionID := sessions[0].ID
+	_, err = test_session.StartTestSession(ctx, candidate.ID, sessionID)
+	require.NoError(t, err)
+
+	answers, err := getAnswers(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, answers, 2)
+
+	t.Run("GetCandidateQuestions_Candidate_Success", func(t *testing.T) {
+		questions, err := test_session.GetCandidateQuestions(ctx, candidate.ID, false, sessionID)
+		require.NoError(t, err)
+		require.Len(t, questions, len(answers))
+
+		answersByQuestion := slice.ToMap(answers, func(answer *ent.TestSessionAnswer) (uuid.UUID, *ent.TestSessionAnswer) {
+			return answer.QuestionID, answer
+		})
+		for index, candidateQuestion := range questions {
+			answer := answersByQuestion[candidateQuestion.ID]
+			require.NotNil(t, answer)
+			assert.Equal(t, answer.Order, candidateQuestion.Order)
+			if index > 0 {
+				assert.Greater(t, candidateQuestion.Order, questions[index-1].Order)
+			}
+
+			correctCount := len(slice.Filter(answer.Edges.Question.Edges.QuestionOptions, func(option *ent.QuestionOption) bool {
+				return option.IsCorrect
+			}))
+			assert.Equal(t, correctCount > 1, candidateQuestion.IsMultipleChoice)
+
+			optionIds := slice.Map(answer.Edges.Question.Edges.QuestionOptions, func(option *ent.QuestionOption) uuid.UUID {
+				return option.ID
+			})
+			candidateOptionIds := slice.Map(candidateQuestion.Options, func(option *model.CandidateQuestionOption) uuid.UUID {
+				return option.ID
+			})
+			assert.ElementsMatch(t, optionIds, candidateOptionIds)
+		}
+	})
+
+	t.Run("GetCandidateQuestions_ShuffleIsStable", func(t *testing.T) {
+		first, err := test_session.GetCandidateQuestions(ctx, candidate.ID, false, sessionID)
+		require.NoError(t, err)
+		second, err := test_session.GetCandidateQuestions(ctx, candidate.ID, false, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("GetCandidateQuestions_Proctor_Success", func(t *testing.T) {
+		questions, err := test_session.GetCandidateQuestions(ctx, otherUser.ID, true, sessionID)
+		require.NoError(t, err)
+		assert.Len(t, questions, len(answers))
+	})
+
+	t.Run("GetCandidateQuestions_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.GetCandidateQuestions(ctx, otherUser.ID, false, sessionID)
+		assert.Error(t, err)
+	})
+
+	t.Run("GetAnswerKeyAccess_OnlyAuthorAndAdmins", func(t *testing.T) {
+		questionIds := slice.Map(answers, func(answer *ent.TestSessionAnswer) uuid.UUID { return answer.QuestionID })
+
+		access, err := question.GetAnswerKeyAccess(ctx, candidate.ID, false, questionIds)
+		require.NoError(t, err)
+		for _, questionId := range questionIds {
+			assert.False(t, access[questionId])
+		}
+
+		access, err = question.GetAnswerKeyAccess(ctx, scenario.User.ID, false, questionIds)
+		require.NoError(t, err)
+		for _, questionId := range questionIds {
+			assert.True(t, access[questionId])
+		}
+
+		access, err = question.GetAnswerKeyAccess(ctx, otherUser.ID, true, questionIds)
+		require.NoError(t, err)
+		for _, questionId := range questionIds {
+			assert.True(t, access[questionId])
+		}
+	})
+}
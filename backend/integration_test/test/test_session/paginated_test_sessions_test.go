@@ -35,7 +35,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 	}
 
 	t.Run("PaginatedTestSessions_DefaultPagination", func(t *testing.T) {
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, nil)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -55,7 +55,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -74,7 +74,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -93,7 +93,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -112,7 +112,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -141,12 +141,12 @@ func TestPaginatedTestSessions(t *testing.T) {
 		}
 
 		// First user should still see only their sessions
-		result1, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, nil)
+		result1, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, 15, result1.TotalItems)
 
 		// Second user should see only their sessions
-		result2, err := test_session.PaginatedTestSessions(ctx, anotherUser.ID, nil)
+		result2, err := test_session.PaginatedTestSessions(ctx, anotherUser.ID, false, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, 3, result2.TotalItems)
 	})
@@ -158,7 +158,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Password: "testpassword123",
 		})
 
-		result, err := test_session.PaginatedTestSessions(ctx, emptyUser.ID, nil)
+		result, err := test_session.PaginatedTestSessions(ctx, emptyUser.ID, false, nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -177,7 +177,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -194,7 +194,7 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -211,10 +211,10 @@ func TestPaginatedTestSessions(t *testing.T) {
 			Limit: &limit,
 		}
 
-		result1, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result1, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 
-		result2, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, paginationInput)
+		result2, err := test_session.PaginatedTestSessions(ctx, scenario.User.ID, false, paginationInput, nil)
 		require.NoError(t, err)
 
 		// Results should be consistent
@@ -0,0 +1,127 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProctoring tests the live status of a running test and the proctor actions on its sessions
+func TestProctoring(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	questionCountConfigs := []prepare.QuestionCountConfig{{Count: 3, Points: 10}}
+	scenario := prepare.CreateTestScenario(t, questionCountConfigs)
+	proctor := scenario.User
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@proctor.com", Password: "testpassword123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@proctor.com", Password: "testpassword123"})
+
+	proctorCourse := prepare.CreateCourse(t, proctor.ID, model.CreateCourseInput{
+		Title:       "Proctored Course",
+		Description: utils.Ptr("Course used to test proctoring"),
+	})
+	_, err = client.Test.UpdateOneID(scenario.Test.ID).
+		SetCourseID(proctorCourse.ID).
+		SetTotalTime(30).
+		Save(ctx)
+	require.NoError(t, err)
+
+	sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenario.Test.ID,
+		UserIds: []uuid.UUID{candidate.ID},
+	})
+	require.NoError(t, err)
+	started, err := test_session.StartTestSession(ctx, candidate.ID, sessions[0].ID)
+	require.NoError(t, err)
+	sessionID := started.ID
+
+	answers, err := getAnswers(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, answers, 3)
+
+	correctOptionIds := func(answer *ent.TestSessionAnswer) []uuid.UUID {
+		correctOptions := slice.Filter(answer.Edges.Question.Edges.QuestionOptions, func(option *ent.QuestionOption) bool {
+			return option.IsCorrect
+		})
+		return slice.Map(correctOptions, func(option *ent.QuestionOption) uuid.UUID {
+			return option.ID
+		})
+	}
+
+	t.Run("TestLiveStatus_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.GetTestLiveStatus(ctx, otherUser.ID, false, scenario.Test.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("SaveTestSessionAnswer_NotOwner_Error", func(t *testing.T) {
+		_, err := test_session.SaveTestSessionAnswer(ctx, otherUser.ID, sessionID, model.TestSessionAnswerInput{
+			QuestionID:        answers[0].QuestionID,
+			QuestionOptionIds: correctOptionIds(answers[0]),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("SaveTestSessionAnswer_And_ReportIntegrityEvent_Success", func(t *testing.T) {
+		saved, err := test_session.SaveTestSessionAnswer(ctx, candidate.ID, sessionID, model.TestSessionAnswerInput{
+			QuestionID:        answers[0].QuestionID,
+			QuestionOptionIds: correctOptionIds(answers[0]),
+		})
+		require.NoError(t, err)
+		assert.True(t, saved)
+
+		event, err := test_session.ReportIntegrityEvent(ctx, candidate.ID, sessionID, model.ReportIntegrityEventInput{
+			EventType: model.IntegrityEventTypeTabSwitch,
+			Details:   utils.Ptr("Left the test tab"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, event.SessionID)
+
+		statuses, err := test_session.GetTestLiveStatus(ctx, proctor.ID, false, scenario.Test.ID)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, model.TestSessionStatusInProgress, statuses[0].Status)
+		assert.Equal(t, 1, statuses[0].AnsweredCount)
+		assert.Equal(t, 3, statuses[0].TotalQuestions)
+		assert.NotNil(t, statuses[0].LastActivityAt)
+		require.Len(t, statuses[0].IntegrityEvents, 1)
+		assert.Equal(t, model.IntegrityEventTypeTabSwitch, statuses[0].IntegrityEvents[0].EventType)
+	})
+
+	t.Run("ExtendTestSession_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.ExtendTestSession(ctx, otherUser.ID, false, sessionID, 10, "Network outage")
+		assert.Error(t, err)
+	})
+
+	t.Run("ExtendTestSession_Success", func(t *testing.T) {
+		extended, err := test_session.ExtendTestSession(ctx, proctor.ID, false, sessionID, 10, "Network outage")
+		require.NoError(t, err)
+		require.NotNil(t, extended.ExpiredAt)
+		assert.WithinDuration(t, started.ExpiredAt.Add(10*time.Minute), *extended.ExpiredAt, time.Second)
+	})
+
+	t.Run("ForceSubmitTestSession_Success", func(t *testing.T) {
+		submitted, err := test_session.ForceSubmitTestSession(ctx, proctor.ID, false, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, testsession.StatusCompleted, submitted.Status)
+		assert.Equal(t, 10, submitted.PointsEarned, "Only the autosaved answer should earn points")
+
+		_, err = test_session.ForceSubmitTestSession(ctx, proctor.ID, false, sessionID)
+		assert.Error(t, err)
+	})
+}
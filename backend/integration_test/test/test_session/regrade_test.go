@@ -0,0 +1,239 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/features/access"
+	questionFeat "template/internal/features/question"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegrade tests regrading a question and a test after an answer key changed
+func TestRegrade(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	questionCountConfigs := []prepare.QuestionCountConfig{{Count: 2, Points: 10}}
+	scenario := prepare.CreateTestScenario(t, questionCountConfigs)
+	author := scenario.User
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@regrade.com", Password: "testpassword123"})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@regrade.com", Password: "testpassword123"})
+
+	course := prepare.CreateCourse(t, author.ID, model.CreateCourseInput{
+		Title:       "Regrade Course",
+		Description: utils.Ptr("Course used to test regrading"),
+	})
+	_, err = client.Test.UpdateOneID(scenario.Test.ID).
+		SetCourseID(course.ID).
+		SetTotalTime(30).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Give the second question a known answer key through the regular edit path
+	secondQuestion, err := client.Question.Query().
+		Where(question.CollectionID(scenario.Collection.ID)).
+		Order(ent.Asc(question.FieldCreatedAt)).
+		Offset(1).
+		First(ctx)
+	require.NoError(t, err)
+	secondQuestionID := secondQuestion.ID
+	_, err = questionFeat.UpdateQuestion(ctx, author.ID, secondQuestionID, model.UpdateQuestionInput{
+		Points: 10,
+		Options: []*model.QuestionOptionInput{
+			{OptionText: "Right option", IsCorrect: true},
+			{OptionText: "Wrong option", IsCorrect: false},
+		},
+	})
+	require.NoError(t, err)
+	rightOption, err := client.QuestionOption.Query().
+		Where(questionoption.QuestionID(secondQuestionID), questionoption.OptionText("Right option")).
+		Only(ctx)
+	require.NoError(t, err)
+	wrongOption, err := client.QuestionOption.Query().
+		Where(questionoption.QuestionID(secondQuestionID), questionoption.OptionText("Wrong option")).
+		Only(ctx)
+	require.NoError(t, err)
+
+	sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenario.Test.ID,
+		UserIds: []uuid.UUID{candidate.ID},
+	})
+	require.NoError(t, err)
+	sessionID := sessions[0].ID
+	_, err = test_session.StartTestSession(ctx, candidate.ID, sessionID)
+	require.NoError(t, err)
+
+	answers, err := getAnswers(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, answers, 2)
+
+	// The candidate answers the first question correctly and the second one with the wrong option
+	firstQuestion := slice.FindValue(answers, func(answer *ent.TestSessionAnswer) bool {
+		return answer.QuestionID != secondQuestionID
+	}).Edges.Question
+	correctOptionIds := slice.Map(slice.Filter(firstQuestion.Edges.QuestionOptions, func(option *ent.QuestionOption) bool {
+		return option.IsCorrect
+	}), func(option *ent.QuestionOption) uuid.UUID {
+		return option.ID
+	})
+	submitInput := model.SubmitTestSessionInput{
+		Answers: []*model.TestSessionAnswerInput{
+			{QuestionID: firstQuestion.ID, QuestionOptionIds: correctOptionIds},
+			{QuestionID: secondQuestionID, QuestionOptionIds: []uuid.UUID{wrongOption.ID}},
+		},
+	}
+	submitted, err := test_session.SubmitTestSession(ctx, candidate.ID, sessionID, submitInput)
+	require.NoError(t, err)
+	require.Equal(t, 10, submitted.PointsEarned)
+
+	// The author fixes the answer key, one option is referenced by ID and the other one is matched by its text
+	_, err = questionFeat.UpdateQuestion(ctx, author.ID, secondQuestionID, model.UpdateQuestionInput{
+		Points: 10,
+		Options: []*model.QuestionOptionInput{
+			{ID: &rightOption.ID, OptionText: "Right option", IsCorrect: false},
+			{OptionText: "Wrong option", IsCorrect: true},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("UpdateQuestion_KeepsOptionIds", func(t *testing.T) {
+		options, err := client.QuestionOption.Query().
+			Where(questionoption.QuestionID(secondQuestionID)).
+			All(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{rightOption.ID, wrongOption.ID}, slice.Map(options, func(option *ent.QuestionOption) uuid.UUID {
+			return option.ID
+		}))
+	})
+
+	t.Run("RegradeQuestion_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.RegradeQuestion(ctx, otherUser.ID, false, secondQuestionID, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("RegradeQuestion_CollectionEditor_Success", func(t *testing.T) {
+		grant, err := access.ShareResource(ctx, author.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeQuestionCollection,
+			ResourceID:   scenario.Collection.ID,
+			UserID:       &otherUser.ID,
+			Role:         model.AccessRoleEditor,
+		})
+		require.NoError(t, err)
+
+		_, err = test_session.RegradeQuestion(ctx, otherUser.ID, false, secondQuestionID, true)
+		assert.NoError(t, err, "Editors of the collection can regrade its questions")
+
+		_, err = access.RevokeAccessGrant(ctx, author.ID, false, grant.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("RegradeQuestion_DryRun_Success", func(t *testing.T) {
+		result, err := test_session.RegradeQuestion(ctx, author.ID, false, secondQuestionID, true)
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 1, result.ScannedAnswers)
+		assert.Equal(t, 1, result.ChangedAnswers)
+		require.Len(t, result.Sessions, 1)
+		assert.Equal(t, sessionID, result.Sessions[0].SessionID)
+		assert.Equal(t, 10, result.Sessions[0].PointsBefore)
+		assert.Equal(t, 20, result.Sessions[0].PointsAfter)
+		assert.Equal(t, 10, result.Sessions[0].Delta)
+
+		session, err := client.TestSession.Get(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, 10, session.PointsEarned, "A dry run must not change the session")
+	})
+
+	t.Run("RegradeTest_Success", func(t *testing.T) {
+		result, err := test_session.RegradeTest(ctx, author.ID, false, scenario.Test.ID, false)
+		require.NoError(t, err)
+		assert.False(t, result.DryRun)
+		assert.Equal(t, 2, result.ScannedAnswers)
+		assert.Equal(t, 1, result.ChangedAnswers)
+
+		session, err := client.TestSession.Get(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, 20, session.PointsEarned)
+
+		regrades, err := test_session.GetTestSessionRegrades(ctx, candidate.ID, false, sessionID)
+		require.NoError(t, err)
+		require.Len(t, regrades, 1)
+		assert.Equal(t, testsessionregrade.ScopeTest, regrades[0].Scope)
+		assert.Equal(t, 10, regrades[0].PointsBefore)
+		assert.Equal(t, 20, regrades[0].PointsAfter)
+
+		// Regrading again is a no-op
+		result, err = test_session.RegradeTest(ctx, author.ID, false, scenario.Test.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.ChangedAnswers)
+		assert.Empty(t, result.Sessions)
+	})
+
+	t.Run("RegradeQuestion_EditedPoints_UpdatesMaxPoints", func(t *testing.T) {
+		_, err := questionFeat.UpdateQuestion(ctx, author.ID, secondQuestionID, model.UpdateQuestionInput{
+			Points: 15,
+			Options: []*model.QuestionOptionInput{
+				{ID: &rightOption.ID, OptionText: "Right option", IsCorrect: false},
+				{ID: &wrongOption.ID, OptionText: "Wrong option", IsCorrect: true},
+			},
+		})
+		require.NoError(t, err)
+
+		result, err := test_session.RegradeQuestion(ctx, author.ID, false, secondQuestionID, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.ChangedAnswers)
+		require.Len(t, result.Sessions, 1)
+		assert.Equal(t, 20, result.Sessions[0].MaxPointsBefore)
+		assert.Equal(t, 25, result.Sessions[0].MaxPointsAfter)
+		assert.Equal(t, 5, result.Sessions[0].Delta)
+
+		session, err := client.TestSession.Get(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, 25, session.PointsEarned)
+		assert.Equal(t, 25, session.MaxPoints, "The score can't go above the maximum points")
+
+		latestVersion, err := client.QuestionVersion.Query().
+			Where(questionversion.QuestionID(secondQuestionID)).
+			Order(ent.Desc(questionversion.FieldVersion)).
+			First(ctx)
+		require.NoError(t, err)
+		answer, err := client.TestSessionAnswer.Query().
+			Where(testsessionanswer.SessionID(sessionID), testsessionanswer.QuestionID(secondQuestionID)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, answer.QuestionVersionID)
+		assert.Equal(t, latestVersion.ID, *answer.QuestionVersionID, "The answer moves to the version it was regraded against")
+
+		testResult, err := test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, true)
+		require.NoError(t, err)
+		questionResult := slice.FindValue(testResult.Questions, func(q *model.QuestionResult) bool {
+			return q.Question.ID == secondQuestionID
+		})
+		require.NotNil(t, questionResult)
+		require.NotNil(t, questionResult.Points)
+		assert.Equal(t, 15, *questionResult.Points)
+	})
+
+	t.Run("GetTestSessionRegrades_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.GetTestSessionRegrades(ctx, otherUser.ID, false, sessionID)
+		assert.Error(t, err)
+	})
+}
@@ -103,7 +103,7 @@ func TestSessionFlow(t *testing.T) {
 			return question.ID
 		})
 
-		test.BatchIgnoreQuestions(context.Background(), scenario.User.ID, model.BatchIgnoreQuestionsInput{
+		test.BatchIgnoreQuestions(context.Background(), scenario.User.ID, false, model.BatchIgnoreQuestionsInput{
 			TestID: scenario.Test.ID,
 			QuestionIgnoreData: []*model.QuestionIgnoreData{
 				{QuestionID: questionIds[0]},
@@ -113,7 +113,7 @@ func TestSessionFlow(t *testing.T) {
 		_, err = test_session.StartTestSession(context.Background(), scenario.User.ID, session.ID)
 		require.Error(t, err)
 
-		_, err = test.BatchIgnoreQuestions(context.Background(), scenario.User.ID, model.BatchIgnoreQuestionsInput{
+		_, err = test.BatchIgnoreQuestions(context.Background(), scenario.User.ID, false, model.BatchIgnoreQuestionsInput{
 			TestID:             scenario.Test.ID,
 			QuestionIgnoreData: []*model.QuestionIgnoreData{},
 		})
@@ -0,0 +1,148 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/test"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionResultSnapshot tests that the result of a session is rendered from the snapshot taken at session start
+func TestSessionResultSnapshot(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	questionCountConfigs := []prepare.QuestionCountConfig{{Count: 1, Points: 10}}
+	scenario := prepare.CreateTestScenario(t, questionCountConfigs)
+	candidate := prepare.CreateUser(t, model.RegisterInput{Email: "candidate@result.com", Password: "testpassword123"})
+
+	_, err = client.Question.Update().
+		Where(question.CollectionID(scenario.Collection.ID)).
+		SetExplanation("Explained with a [source](https://example.com)").
+		Save(ctx)
+	require.NoError(t, err)
+
+	sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+		TestID:  scenario.Test.ID,
+		UserIds: []uuid.UUID{candidate.ID},
+	})
+	require.NoError(t, err)
+	sessionID := sessions[0].ID
+	_, err = test_session.StartTestSession(ctx, candidate.ID, sessionID)
+	require.NoError(t, err)
+
+	answers, err := getAnswers(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, answers, 1)
+
+	question := answers[0].Edges.Question
+	originalQuestionText := question.QuestionText
+	correctOptions := slice.Filter(question.Edges.QuestionOptions, func(option *ent.QuestionOption) bool {
+		return option.IsCorrect
+	})
+	correctOptionIds := slice.Map(correctOptions, func(option *ent.QuestionOption) uuid.UUID {
+		return option.ID
+	})
+
+	_, err = test_session.SubmitTestSession(ctx, candidate.ID, sessionID, model.SubmitTestSessionInput{
+		Answers: []*model.TestSessionAnswerInput{
+			{QuestionID: question.ID, QuestionOptionIds: correctOptionIds},
+		},
+	})
+	require.NoError(t, err)
+
+	// Edit the question and its options after the session
+	_, err = client.Question.UpdateOneID(question.ID).SetQuestionText("Edited question").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.QuestionOption.Update().
+		Where(questionoption.QuestionID(question.ID)).
+		SetOptionText("Edited option").
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("GetTestSessionResult_RendersSnapshot_Success", func(t *testing.T) {
+		result, err := test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, false)
+		require.NoError(t, err)
+		require.Len(t, result.Questions, 1)
+
+		questionResult := result.Questions[0]
+		require.NotNil(t, questionResult.IsCorrect)
+		assert.True(t, *questionResult.IsCorrect)
+		assert.Equal(t, originalQuestionText, questionResult.QuestionText)
+		require.NotNil(t, questionResult.Points)
+		assert.Equal(t, 10, *questionResult.Points)
+		require.Len(t, questionResult.Options, len(question.Edges.QuestionOptions))
+		require.Len(t, questionResult.SelectedOptions, len(correctOptions))
+
+		for _, option := range questionResult.Options {
+			assert.NotEqual(t, "Edited option", option.OptionText)
+			assert.Equal(t, slice.Contains(correctOptionIds, option.ID), option.IsSelected)
+		}
+		for _, selected := range questionResult.SelectedOptions {
+			require.NotNil(t, selected.ID)
+			assert.Contains(t, correctOptionIds, *selected.ID)
+		}
+
+		assert.True(t, result.FeedbackReleased)
+		require.NotNil(t, questionResult.Explanation)
+		assert.Equal(t, "Explained with a [source](https://example.com)", *questionResult.Explanation)
+	})
+
+	t.Run("GetTestSessionResult_FeedbackNeverReleased_HidesFeedback", func(t *testing.T) {
+		_, err := client.Test.UpdateOneID(scenario.Test.ID).SetFeedbackRelease(test.FeedbackReleaseNever).Save(ctx)
+		require.NoError(t, err)
+
+		result, err := test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, false)
+		require.NoError(t, err)
+		assert.False(t, result.FeedbackReleased)
+		assert.Nil(t, result.Questions[0].Explanation)
+		assert.Nil(t, result.Questions[0].IsCorrect)
+		assert.Nil(t, result.Questions[0].Points)
+		for _, option := range result.Questions[0].Options {
+			assert.Nil(t, option.IsCorrect)
+			assert.Nil(t, option.Explanation)
+		}
+
+		adminResult, err := test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, true)
+		require.NoError(t, err)
+		assert.NotNil(t, adminResult.Questions[0].Explanation, "Admins always see the feedback")
+	})
+
+	t.Run("GetTestSessionResult_FeedbackAfterClose_ReleasedOnceClosed", func(t *testing.T) {
+		closeAt := time.Now().UTC().Add(time.Hour)
+		_, err := client.Test.UpdateOneID(scenario.Test.ID).
+			SetFeedbackRelease(test.FeedbackReleaseAfterClose).
+			SetCloseAt(closeAt).
+			Save(ctx)
+		require.NoError(t, err)
+
+		result, err := test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, false)
+		require.NoError(t, err)
+		assert.False(t, result.FeedbackReleased)
+		require.NotNil(t, result.FeedbackReleaseAt)
+		assert.WithinDuration(t, closeAt, *result.FeedbackReleaseAt, time.Second)
+
+		_, err = client.Test.UpdateOneID(scenario.Test.ID).SetCloseAt(time.Now().UTC().Add(-time.Minute)).Save(ctx)
+		require.NoError(t, err)
+
+		result, err = test_session.GetTestSessionResult(ctx, candidate.ID, sessionID, false)
+		require.NoError(t, err)
+		assert.True(t, result.FeedbackReleased)
+		assert.NotNil(t, result.Questions[0].Options[0].IsCorrect)
+	})
+}
@@ -0,0 +1,73 @@
+package test_session
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent/testsession"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionSubscriptions tests the session events streamed to proctors and candidates
+func TestSessionSubscriptions(t *testing.T) {
+	prepare.SetupTestDb(t)
+
+	scenario := prepare.CreateTestScenario(t, []prepare.QuestionCountConfig{{Count: 2, Points: 5}})
+	otherUser := prepare.CreateUser(t, model.RegisterInput{Email: "other@subscription.com", Password: "testpassword123"})
+
+	sessions, err := test_session.CreateTestSession(context.Background(), model.CreateTestSessionInput{
+		TestID:  scenario.Test.ID,
+		UserIds: []uuid.UUID{scenario.User.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	sessionID := sessions[0].ID
+
+	t.Run("WatchTestSessions_AllTests_Unauthorized_Error", func(t *testing.T) {
+		_, err := test_session.WatchTestSessions(context.Background(), otherUser.ID, false, nil, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("WatchTestSessionTimer_NotOwner_Error", func(t *testing.T) {
+		_, err := test_session.WatchTestSessionTimer(context.Background(), otherUser.ID, false, sessionID)
+		assert.Error(t, err)
+	})
+
+	t.Run("WatchTestSessions_StatusChanged_And_Submitted", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		statusChanges, err := test_session.WatchTestSessions(ctx, scenario.User.ID, true, &scenario.Test.ID, false)
+		require.NoError(t, err)
+		submissions, err := test_session.WatchTestSessions(ctx, scenario.User.ID, true, &scenario.Test.ID, true)
+		require.NoError(t, err)
+
+		_, err = test_session.StartTestSession(ctx, scenario.User.ID, sessionID)
+		require.NoError(t, err)
+
+		started := <-statusChanges
+		require.NotNil(t, started)
+		assert.Equal(t, sessionID, started.ID)
+		assert.Equal(t, testsession.StatusInProgress, started.Status)
+
+		timers, err := test_session.WatchTestSessionTimer(ctx, scenario.User.ID, false, sessionID)
+		require.NoError(t, err)
+		timer := <-timers
+		require.NotNil(t, timer)
+		assert.Equal(t, model.TestSessionStatusInProgress, timer.Status)
+
+		_, err = test_session.SubmitTestSession(ctx, scenario.User.ID, sessionID, model.SubmitTestSessionInput{})
+		require.NoError(t, err)
+
+		submitted := <-submissions
+		require.NotNil(t, submitted)
+		assert.Equal(t, sessionID, submitted.ID)
+		assert.Equal(t, testsession.StatusCompleted, submitted.Status)
+	})
+}
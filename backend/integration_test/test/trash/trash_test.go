@@ -0,0 +1,257 @@
+package trash
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/integration_test/utils"
+	"template/internal/ent/db"
+	"template/internal/ent/schema/mixin"
+	entTest "template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/features/access"
+	"template/internal/features/course"
+	"template/internal/features/course_section"
+	"template/internal/features/group"
+	"template/internal/features/question"
+	"template/internal/features/question_collection"
+	"template/internal/features/test"
+	"template/internal/features/test_session"
+	"template/internal/features/trash"
+	"template/internal/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrash tests moving items to the trash, listing, restoring them with their children and purging them
+func TestTrash(t *testing.T) {
+	prepare.SetupTestDb(t)
+	ctx := context.Background()
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	teacher := prepare.CreateUser(t, model.RegisterInput{Email: "teacher@trash.com", Password: "password123"})
+	student := prepare.CreateUser(t, model.RegisterInput{Email: "student@trash.com", Password: "password123"})
+
+	trashIds := func(t *testing.T, entityType model.TrashEntityType) []uuid.UUID {
+		result, err := trash.PaginatedTrash(ctx, entityType, nil)
+		require.NoError(t, err)
+		ids := []uuid.UUID{}
+		for _, item := range result.Items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	t.Run("Course_RestoredWithItsChildren", func(t *testing.T) {
+		crs, keptTest := prepare.CreateTestWithCourse(t, teacher.ID)
+		section, err := course_section.CreateCourseSection(ctx, teacher.ID, crs.ID, model.CreateCourseSectionInput{
+			Title:    "Chapter 1",
+			CourseID: crs.ID,
+		})
+		require.NoError(t, err)
+		deletedBefore := prepare.CreateTest(t, teacher.ID, model.CreateTestInput{Name: "Deleted before", CourseID: &crs.ID})
+
+		_, err = test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  keptTest.ID,
+			UserIds: []uuid.UUID{student.ID},
+		})
+		require.NoError(t, err)
+
+		_, err = test.DeleteTest(ctx, teacher.ID, false, deletedBefore.ID)
+		require.NoError(t, err)
+		_, err = course.RemoveCourse(ctx, teacher.ID, false, crs.ID)
+		require.NoError(t, err)
+
+		_, err = course.GetCourseByID(ctx, crs.ID)
+		assert.Error(t, err, "A trashed course is hidden")
+		exists, err := client.TestSession.Query().Where(testsession.TestID(keptTest.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists, "The sessions are kept when the test of the course is trashed")
+
+		assert.Contains(t, trashIds(t, model.TrashEntityTypeCourse), crs.ID)
+		assert.NotContains(t, trashIds(t, model.TrashEntityTypeTest), keptTest.ID, "The tests trashed with the course aren't listed")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeTest, keptTest.ID)
+		assert.ErrorContains(t, err, "restore the course of the test first")
+
+		restored, err := trash.Restore(ctx, model.TrashEntityTypeCourse, crs.ID)
+		require.NoError(t, err)
+		assert.True(t, restored)
+
+		_, err = course.GetCourseByID(ctx, crs.ID)
+		assert.NoError(t, err)
+		_, err = client.CourseSection.Get(ctx, section.ID)
+		assert.NoError(t, err, "The sections are restored with the course")
+		_, err = client.Test.Get(ctx, keptTest.ID)
+		assert.NoError(t, err)
+		exists, err = client.TestSession.Query().Where(testsession.TestID(keptTest.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = client.Test.Query().Where(entTest.ID(deletedBefore.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists, "The test deleted before the course stays in the trash")
+		assert.Contains(t, trashIds(t, model.TrashEntityTypeTest), deletedBefore.ID)
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeCourse, crs.ID)
+		assert.ErrorContains(t, err, "item not found in the trash")
+	})
+
+	t.Run("CourseSection_KeepsSessionsAndRestoresChildren", func(t *testing.T) {
+		crs, sectionTest := prepare.CreateTestWithCourse(t, teacher.ID)
+		section, err := course_section.CreateCourseSection(ctx, teacher.ID, crs.ID, model.CreateCourseSectionInput{
+			Title:    "Chapter 2",
+			CourseID: crs.ID,
+		})
+		require.NoError(t, err)
+		child, err := course_section.CreateCourseSection(ctx, teacher.ID, crs.ID, model.CreateCourseSectionInput{
+			Title:     "Chapter 2.1",
+			CourseID:  crs.ID,
+			SectionID: &section.ID,
+		})
+		require.NoError(t, err)
+		_, err = client.Test.UpdateOneID(sectionTest.ID).SetCourseSectionID(child.ID).Save(ctx)
+		require.NoError(t, err)
+
+		sessions, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  sectionTest.ID,
+			UserIds: []uuid.UUID{student.ID},
+		})
+		require.NoError(t, err)
+		_, err = client.TestSession.UpdateOneID(sessions[0].ID).SetStatus(testsession.StatusCompleted).Save(ctx)
+		require.NoError(t, err)
+
+		_, err = test_session.DeleteTestSession(ctx, sessions[0].ID)
+		assert.Error(t, err, "A session holding results can't be deleted")
+
+		_, err = course_section.RemoveCourseSection(ctx, teacher.ID, section.ID)
+		require.NoError(t, err)
+
+		_, err = client.CourseSection.Get(ctx, child.ID)
+		assert.Error(t, err, "The children are trashed with the section")
+		_, err = client.Test.Get(ctx, sectionTest.ID)
+		assert.Error(t, err, "The tests are trashed with the section")
+		exists, err := client.TestSession.Query().Where(testsession.ID(sessions[0].ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists, "The sessions are kept when the section is trashed")
+
+		sectionIds := trashIds(t, model.TrashEntityTypeCourseSection)
+		assert.Contains(t, sectionIds, section.ID)
+		assert.NotContains(t, sectionIds, child.ID, "The children trashed with the section aren't listed")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeCourseSection, child.ID)
+		assert.ErrorContains(t, err, "parent section")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeCourseSection, section.ID)
+		require.NoError(t, err)
+		_, err = client.CourseSection.Get(ctx, child.ID)
+		assert.NoError(t, err)
+		_, err = client.Test.Get(ctx, sectionTest.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("QuestionCollection_RestoredWithItsQuestions", func(t *testing.T) {
+		collection, questions := prepare.CreateCollectionWithQuestions(t, teacher.ID, []prepare.QuestionCountConfig{{Count: 2, Points: 1}})
+
+		_, err := question.DeleteQuestion(ctx, teacher.ID, questions[0].ID)
+		require.NoError(t, err)
+		_, err = question_collection.DeleteQuestionCollection(ctx, teacher.ID, collection.ID)
+		require.NoError(t, err)
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeQuestion, questions[1].ID)
+		assert.ErrorContains(t, err, "restore the question collection of the question first")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeQuestionCollection, collection.ID)
+		require.NoError(t, err)
+
+		_, err = client.Question.Get(ctx, questions[1].ID)
+		assert.NoError(t, err)
+		_, err = client.Question.Get(ctx, questions[0].ID)
+		assert.Error(t, err, "The question deleted before the collection stays in the trash")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeQuestion, questions[0].ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Group_GrantsIgnoredWhileTrashed", func(t *testing.T) {
+		testEntity := prepare.CreateTest(t, teacher.ID, model.CreateTestInput{Name: "Shared with a group"})
+		class, err := group.CreateGroup(ctx, teacher.ID, model.CreateGroupInput{
+			Name:      "Trashed class",
+			MemberIds: []uuid.UUID{student.ID},
+		})
+		require.NoError(t, err)
+		_, err = access.ShareResource(ctx, teacher.ID, false, model.ShareResourceInput{
+			ResourceType: model.AccessResourceTypeTest,
+			ResourceID:   testEntity.ID,
+			GroupID:      &class.ID,
+			Role:         model.AccessRoleViewer,
+		})
+		require.NoError(t, err)
+
+		_, err = test.GetTestByID(ctx, student.ID, false, testEntity.ID)
+		require.NoError(t, err)
+
+		_, err = group.DeleteGroup(ctx, teacher.ID, false, class.ID)
+		require.NoError(t, err)
+		_, err = test.GetTestByID(ctx, student.ID, false, testEntity.ID)
+		assert.Error(t, err, "The grants of a trashed group don't give access")
+
+		_, err = trash.Restore(ctx, model.TrashEntityTypeGroup, class.ID)
+		require.NoError(t, err)
+		_, err = test.GetTestByID(ctx, student.ID, false, testEntity.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Purge_AfterRetention", func(t *testing.T) {
+		collection, _ := prepare.CreateCollectionWithQuestions(t, teacher.ID, []prepare.QuestionCountConfig{{Count: 1, Points: 1}})
+		_, err := question_collection.DeleteQuestionCollection(ctx, teacher.ID, collection.ID)
+		require.NoError(t, err)
+
+		purged, err := trash.PurgeTrash(ctx, time.Now().UTC().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged, "The items deleted within the retention period are kept")
+
+		purged, err = trash.PurgeTrash(ctx, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Positive(t, purged)
+
+		_, err = client.QuestionCollection.Get(mixin.WithSoftDelete(ctx), collection.ID)
+		assert.Error(t, err, "The purged collection is deleted permanently")
+		assert.Empty(t, trashIds(t, model.TrashEntityTypeQuestionCollection))
+	})
+
+	t.Run("Purge_KeepsTestsWithSessions", func(t *testing.T) {
+		testEntity := prepare.CreateTest(t, teacher.ID, model.CreateTestInput{Name: "Taken test"})
+		_, err := test_session.CreateTestSession(ctx, model.CreateTestSessionInput{
+			TestID:  testEntity.ID,
+			UserIds: []uuid.UUID{student.ID},
+		})
+		require.NoError(t, err)
+		_, err = test.DeleteTest(ctx, teacher.ID, false, testEntity.ID)
+		require.NoError(t, err)
+
+		_, err = trash.PurgeTrash(ctx, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+
+		assert.Contains(t, trashIds(t, model.TrashEntityTypeTest), testEntity.ID, "A test with sessions isn't purged")
+		exists, err := client.TestSession.Query().Where(testsession.TestID(testEntity.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Trash_Search", func(t *testing.T) {
+		crs := prepare.CreateCourse(t, teacher.ID, model.CreateCourseInput{Title: "Searchable course"})
+		_, err := course.RemoveCourse(ctx, teacher.ID, false, crs.ID)
+		require.NoError(t, err)
+
+		result, err := trash.PaginatedTrash(ctx, model.TrashEntityTypeCourse, &model.PaginationInput{Search: utils.Ptr("searchable")})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Searchable course", result.Items[0].Name)
+	})
+}
@@ -19,6 +19,7 @@ func TestAdminCreateUser(t *testing.T) {
 
 	ctx := context.Background()
 	userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+	admin := prepare.CreateAdminUser(t, "acting-admin@test.com", "acting_admin")
 
 	t.Run("CanCreateUser_Success", func(t *testing.T) {
 		input := model.AdminCreateUserInput{
@@ -27,7 +28,7 @@ func TestAdminCreateUser(t *testing.T) {
 			RoleID:   userRoleID,
 		}
 
-		createdUser, err := user.AdminCreateUser(ctx, input)
+		createdUser, err := user.AdminCreateUser(ctx, admin.ID, input)
 		require.NoError(t, err, "AdminCreateUser should succeed with valid input")
 		require.NotNil(t, createdUser, "Created user should not be nil")
 
@@ -54,7 +55,7 @@ func TestAdminCreateUser(t *testing.T) {
 			Password: "password123",
 			RoleID:   userRoleID,
 		}
-		_, err := user.AdminCreateUser(ctx, firstUserInput)
+		_, err := user.AdminCreateUser(ctx, admin.ID, firstUserInput)
 		require.NoError(t, err, "First user creation should succeed")
 
 		// Try to create second user with same email
@@ -64,7 +65,7 @@ func TestAdminCreateUser(t *testing.T) {
 			RoleID:   userRoleID,
 		}
 
-		createdUser, err := user.AdminCreateUser(ctx, secondUserInput)
+		createdUser, err := user.AdminCreateUser(ctx, admin.ID, secondUserInput)
 		assert.Error(t, err, "AdminCreateUser should fail with duplicate email")
 		assert.Nil(t, createdUser, "User should not be created when email exists")
 		assert.Contains(t, err.Error(), "email already exists", "Error should indicate email duplication")
@@ -80,7 +81,7 @@ func TestAdminCreateUser(t *testing.T) {
 			RoleID:   userRoleID,
 		}
 
-		createdUser, err := user.AdminCreateUser(ctx, input)
+		createdUser, err := user.AdminCreateUser(ctx, admin.ID, input)
 		assert.Error(t, err, "AdminCreateUser should fail with empty email")
 		assert.Nil(t, createdUser, "User should not be created with empty email")
 	})
@@ -94,7 +95,7 @@ func TestAdminCreateUser(t *testing.T) {
 			RoleID:   userRoleID,
 		}
 
-		createdUser, err := user.AdminCreateUser(ctx, input)
+		createdUser, err := user.AdminCreateUser(ctx, admin.ID, input)
 		assert.Error(t, err, "AdminCreateUser should fail with empty password")
 		assert.Nil(t, createdUser, "User should not be created with empty password")
 	})
@@ -106,7 +107,7 @@ func TestAdminCreateUser(t *testing.T) {
 			RoleID:   userRoleID,
 		}
 
-		createdUser, err := user.AdminCreateUser(ctx, input)
+		createdUser, err := user.AdminCreateUser(ctx, admin.ID, input)
 		assert.Error(t, err, "AdminCreateUser should fail with empty email and password")
 		assert.Nil(t, createdUser, "User should not be created with empty email and password")
 	})
@@ -120,7 +121,7 @@ func TestAdminCreateUser(t *testing.T) {
 		}
 
 		for i, userInput := range users {
-			createdUser, err := user.AdminCreateUser(ctx, userInput)
+			createdUser, err := user.AdminCreateUser(ctx, admin.ID, userInput)
 			assert.NoError(t, err, "User %d creation should succeed", i+1)
 			assert.NotNil(t, createdUser, "User %d should not be nil", i+1)
 			assert.Equal(t, userInput.Email, createdUser.Email, "User %d email should match", i+1)
@@ -21,6 +21,7 @@ func TestAdminUpdateUser(t *testing.T) {
 
 	ctx := context.Background()
 	userRoleID := prepare.GetRoleID(t, seeder.RoleUser)
+	admin := prepare.CreateAdminUser(t, "acting-admin@test.com", "acting_admin")
 
 	t.Run("CanUpdateEmail_Success", func(t *testing.T) {
 		// Create test user
@@ -29,7 +30,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "originalpass123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 
 		// Update only email
@@ -38,7 +39,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Email: &newEmail,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed for email update")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
@@ -57,7 +58,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "oldpassword123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 
 		// Update only password
@@ -66,7 +67,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: &newPassword,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed for password update")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
@@ -88,7 +89,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "password123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 		require.True(t, originalUser.IsActive, "User should be active by default")
 
@@ -98,7 +99,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			IsActive: &newIsActive,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed for isActive update")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
@@ -117,7 +118,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "originalpass123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 
 		// Update multiple fields at once
@@ -130,7 +131,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			IsActive: &newIsActive,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed for multiple field update")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
@@ -152,7 +153,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "password123",
 			RoleID:   userRoleID,
 		}
-		_, err := user.AdminCreateUser(ctx, firstInput)
+		_, err := user.AdminCreateUser(ctx, admin.ID, firstInput)
 		require.NoError(t, err, "First user creation should succeed")
 
 		// Create second user
@@ -161,7 +162,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "password456",
 			RoleID:   userRoleID,
 		}
-		secondUser, err := user.AdminCreateUser(ctx, secondInput)
+		secondUser, err := user.AdminCreateUser(ctx, admin.ID, secondInput)
 		require.NoError(t, err, "Second user creation should succeed")
 
 		// Try to update second user's email to first user's email
@@ -170,7 +171,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Email: &duplicateEmail,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, secondUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, secondUser.ID, updateInput)
 		assert.Error(t, err, "AdminUpdateUser should fail with duplicate email")
 		assert.Nil(t, updatedUser, "User should not be updated when email exists")
 		assert.Contains(t, err.Error(), "email already exists", "Error should indicate email duplication")
@@ -183,7 +184,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "password123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 
 		// Update user's email to the same email (should be allowed)
@@ -192,7 +193,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Email: &sameEmail,
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed when updating to same email")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
@@ -208,7 +209,7 @@ func TestAdminUpdateUser(t *testing.T) {
 			Email: pointer.From("newemail@test.com"),
 		}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, nonExistentID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, nonExistentID, updateInput)
 		assert.Error(t, err, "AdminUpdateUser should fail for non-existent user")
 		assert.Nil(t, updatedUser, "User should not be returned for non-existent ID")
 		assert.Contains(t, err.Error(), "user not found", "Error should indicate user not found")
@@ -221,13 +222,13 @@ func TestAdminUpdateUser(t *testing.T) {
 			Password: "password123",
 			RoleID:   userRoleID,
 		}
-		originalUser, err := user.AdminCreateUser(ctx, originalInput)
+		originalUser, err := user.AdminCreateUser(ctx, admin.ID, originalInput)
 		require.NoError(t, err, "Test user creation should succeed")
 
 		// Update with empty input (no fields provided)
 		updateInput := model.AdminEditUserInput{}
 
-		updatedUser, err := user.AdminUpdateUser(ctx, originalUser.ID, updateInput)
+		updatedUser, err := user.AdminUpdateUser(ctx, admin.ID, originalUser.ID, updateInput)
 		require.NoError(t, err, "AdminUpdateUser should succeed even with no fields to update")
 		require.NotNil(t, updatedUser, "Updated user should not be nil")
 
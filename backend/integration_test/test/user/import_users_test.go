@@ -0,0 +1,201 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"template/integration_test/prepare"
+	"template/internal/ent/db"
+	entUser "template/internal/ent/user"
+	"template/internal/features/auth"
+	"template/internal/features/user"
+	"template/internal/graph/model"
+	"template/internal/seeder"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportUsers(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+
+	ctx := context.Background()
+	admin := prepare.CreateAdminUser(t, "admin@import.com", "admin_import")
+
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	dryRun := true
+	inviteToken := model.UserImportCredentialsInviteToken
+	csv := "email,first_name,last_name,username,roles,group\n" +
+		"alice@import.com,Alice,Martin,alice,user,Class A\n" +
+		"bob@import.com,Bob,,,user;admin,Class A;Class B\n"
+
+	t.Run("DryRun_ReportsWithoutImporting", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: csv, DryRun: &dryRun})
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.False(t, report.Imported)
+		assert.Equal(t, 2, report.CreatedCount)
+		assert.Equal(t, 0, report.ErrorCount)
+		for _, row := range report.Rows {
+			assert.Nil(t, row.TemporaryPassword, "No credentials are returned for a dry run")
+		}
+
+		exists, err := client.User.Query().Where(entUser.EmailEQ("alice@import.com")).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("InvalidRow_NothingImported", func(t *testing.T) {
+		invalid := csv + "not-an-email,,,,unknown-role,\nalice@import.com,,,,,\n"
+
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: invalid})
+		require.NoError(t, err)
+
+		assert.False(t, report.Imported)
+		assert.Equal(t, 2, report.ErrorCount)
+		require.Len(t, report.Rows, 4)
+		assert.Equal(t, 4, report.Rows[2].Line)
+		assert.NotEmpty(t, report.Rows[2].Errors)
+		assert.Contains(t, report.Rows[3].Errors[0], "duplicate email")
+
+		exists, err := client.User.Query().Where(entUser.EmailEQ("alice@import.com")).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("UnknownColumn_Error", func(t *testing.T) {
+		_, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: "email,phone\nx@import.com,0600\n"})
+		assert.ErrorContains(t, err, "unknown column")
+	})
+
+	t.Run("Create_WithTemporaryPasswords", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: csv})
+		require.NoError(t, err)
+
+		assert.True(t, report.Imported)
+		assert.Equal(t, 2, report.CreatedCount)
+		require.NotNil(t, report.Rows[0].TemporaryPassword)
+
+		alice, err := auth.Login(ctx, model.LoginInput{Email: "alice@import.com", Password: *report.Rows[0].TemporaryPassword})
+		require.NoError(t, err)
+		assert.True(t, alice.MustChangePassword)
+		assert.Equal(t, "alice", alice.Username)
+
+		bob, err := client.User.Query().
+			Where(entUser.EmailEQ("bob@import.com")).
+			WithRoles().
+			WithMemberGroups().
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "bob@import.com", bob.Username, "The email is the default username")
+		assert.Len(t, bob.Edges.Roles, 2)
+		assert.Len(t, bob.Edges.MemberGroups, 2)
+	})
+
+	t.Run("ReImport_Unchanged", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: csv})
+		require.NoError(t, err)
+
+		assert.True(t, report.Imported)
+		assert.Equal(t, 2, report.UnchangedCount)
+		assert.Equal(t, 0, report.CreatedCount)
+		assert.Nil(t, report.Rows[0].TemporaryPassword)
+	})
+
+	t.Run("ReImport_UpdatesByEmail", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{
+			CSV: "email,last_name,roles\nalice@import.com,Durand,user\nbob@import.com,,user\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.UpdatedCount)
+
+		alice, err := client.User.Query().Where(entUser.EmailEQ("alice@import.com")).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Durand", *alice.LastName)
+		assert.Equal(t, "Alice", *alice.FirstName, "Empty cells are left unchanged")
+
+		bobRoles, err := client.User.Query().Where(entUser.EmailEQ("bob@import.com")).QueryRoles().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, bobRoles, 1)
+		assert.Equal(t, seeder.RoleUser, bobRoles[0].Name)
+	})
+
+	t.Run("ReImport_MatchesEmailsCaseInsensitively", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{
+			CSV: "email,first_name\nALICE@Import.com,Alice\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.CreatedCount)
+		assert.Equal(t, 1, report.UnchangedCount)
+
+		count, err := client.User.Query().Where(entUser.EmailEqualFold("alice@import.com")).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("InvalidUsername_Error", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{
+			CSV: "email,username\ndave@import.com,da ve\nerin@import.com,ALICE\n",
+		})
+		require.NoError(t, err)
+		assert.False(t, report.Imported)
+		assert.Equal(t, 2, report.ErrorCount)
+		assert.Contains(t, report.Rows[0].Errors[0], "username must be")
+		assert.Contains(t, report.Rows[1].Errors[0], "username already exists")
+	})
+
+	t.Run("Invite_AcceptedOnce", func(t *testing.T) {
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{
+			CSV:         "email\ncarol@import.com\n",
+			Credentials: &inviteToken,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, report.Rows[0].InviteToken)
+		assert.Nil(t, report.Rows[0].TemporaryPassword)
+
+		input := model.AcceptInviteInput{Token: *report.Rows[0].InviteToken, Password: "carolpassword"}
+		ok, err := auth.AcceptInvite(ctx, input)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		carol, err := auth.Login(ctx, model.LoginInput{Email: "carol@import.com", Password: "carolpassword"})
+		require.NoError(t, err)
+		assert.False(t, carol.MustChangePassword)
+
+		_, err = auth.AcceptInvite(ctx, input)
+		assert.ErrorContains(t, err, "invalid or expired invite")
+	})
+
+	t.Run("Export_MatchesImportColumns", func(t *testing.T) {
+		exported, err := user.ExportUsers(ctx)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(exported), "\n")
+		assert.Equal(t, "email,first_name,last_name,username,roles,group", lines[0])
+		assert.Contains(t, lines, "alice@import.com,Alice,Durand,alice,user,Class A")
+
+		report, err := user.ImportUsers(ctx, admin.ID, true, model.ImportUsersInput{CSV: exported, DryRun: &dryRun})
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.ErrorCount)
+		assert.Equal(t, len(lines)-1, report.UnchangedCount, "Importing the export changes nothing")
+	})
+
+	t.Run("Groups_OnlyManagedGroupsAreJoined", func(t *testing.T) {
+		manager := prepare.CreateUser(t, model.RegisterInput{Email: "manager@import.com", Password: "testpassword123"})
+
+		report, err := user.ImportUsers(ctx, manager.ID, false, model.ImportUsersInput{
+			CSV: "email,group\nfrank@import.com,Class A\n",
+		})
+		require.NoError(t, err)
+		require.True(t, report.Imported)
+
+		groups, err := client.User.Query().Where(entUser.EmailEQ("frank@import.com")).QueryMemberGroups().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.Equal(t, manager.ID, groups[0].CreatorID, "The group of the admin isn't joined")
+	})
+}
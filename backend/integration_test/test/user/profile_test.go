@@ -0,0 +1,145 @@
+package user
+
+import (
+	"context"
+	"template/integration_test/prepare"
+	"template/internal/ent/db"
+	"template/internal/features/auth"
+	"template/internal/features/jwt"
+	"template/internal/features/jwt_token"
+	"template/internal/features/user"
+	"template/internal/graph/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserProfile(t *testing.T) {
+	prepare.SetupTestDb(t)
+	prepare.SetupRoleSystem(t)
+
+	ctx := context.Background()
+	client, err := db.OpenClient()
+	require.NoError(t, err)
+
+	member := prepare.CreateUser(t, model.RegisterInput{Email: "member@profile.com", Password: "password123"})
+	other := prepare.CreateRegularUser(t, "other@profile.com", "other_profile")
+
+	t.Run("UpdateMyProfile_NamesAndUsername", func(t *testing.T) {
+		firstName, lastName, username := "Marie", "Curie", "marie.curie"
+
+		updated, err := user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{
+			FirstName: &firstName,
+			LastName:  &lastName,
+			Username:  &username,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Marie", *updated.FirstName)
+		assert.Equal(t, "Curie", *updated.LastName)
+		assert.Equal(t, "marie.curie", updated.Username)
+
+		empty := ""
+		updated, err = user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{LastName: &empty})
+		require.NoError(t, err)
+		assert.Nil(t, updated.LastName, "An empty name clears it")
+		assert.Equal(t, "Marie", *updated.FirstName, "Omitted fields are left unchanged")
+	})
+
+	t.Run("UpdateMyProfile_UsernameValidation", func(t *testing.T) {
+		taken := "OTHER_profile"
+		_, err := user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{Username: &taken})
+		assert.ErrorContains(t, err, "username already exists", "Usernames are compared whatever the case")
+
+		invalid := "no spaces allowed"
+		_, err = user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{Username: &invalid})
+		assert.ErrorContains(t, err, "username must be")
+	})
+
+	t.Run("UpdateMyProfile_Avatar", func(t *testing.T) {
+		image, err := client.Media.Create().
+			SetFileName("me.png").
+			SetFileURL("/uploads/me.png").
+			SetMimeType("image/png").
+			SetUploaderID(member.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		document, err := client.Media.Create().
+			SetFileName("cv.pdf").
+			SetFileURL("/uploads/cv.pdf").
+			SetMimeType("application/pdf").
+			SetUploaderID(member.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		othersImage, err := client.Media.Create().
+			SetFileName("other.png").
+			SetFileURL("/uploads/other.png").
+			SetMimeType("image/png").
+			SetUploaderID(other.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{AvatarID: &othersImage.ID})
+		assert.ErrorContains(t, err, "media not found or unauthorized")
+
+		_, err = user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{AvatarID: &document.ID})
+		assert.ErrorContains(t, err, "the avatar must be an image")
+
+		updated, err := user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{AvatarID: &image.ID})
+		require.NoError(t, err)
+		assert.Equal(t, image.ID, *updated.AvatarID)
+
+		remove := true
+		updated, err = user.UpdateMyProfile(ctx, member.ID, model.UpdateMyProfileInput{RemoveAvatar: &remove})
+		require.NoError(t, err)
+		assert.Nil(t, updated.AvatarID)
+	})
+
+	t.Run("Register_WithUsername", func(t *testing.T) {
+		username := "new.member"
+		_, err := auth.Register(ctx, model.RegisterInput{Email: "new@profile.com", Password: "password123", Username: &username})
+		require.NoError(t, err)
+
+		_, err = auth.Register(ctx, model.RegisterInput{Email: "copy@profile.com", Password: "password123", Username: &username})
+		assert.ErrorContains(t, err, "username already exists")
+	})
+
+	t.Run("ChangePassword_RevokesOtherTokens", func(t *testing.T) {
+		current := saveTokenPair(t, member.ID, "current")
+		previous := saveTokenPair(t, member.ID, "previous")
+
+		_, err := auth.ChangePassword(ctx, member.ID, current, model.ChangePasswordInput{
+			CurrentPassword: "wrong",
+			NewPassword:     "newpassword123",
+		})
+		assert.ErrorContains(t, err, "invalid current password")
+
+		ok, err := auth.ChangePassword(ctx, member.ID, current, model.ChangePasswordInput{
+			CurrentPassword: "password123",
+			NewPassword:     "newpassword123",
+		})
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		_, err = jwt_token.ValidateToken(ctx, current)
+		assert.NoError(t, err, "The token of the request is kept")
+		_, err = jwt_token.ValidateToken(ctx, previous)
+		assert.Error(t, err, "The other sessions are revoked")
+
+		_, err = auth.Login(ctx, model.LoginInput{Email: "member@profile.com", Password: "newpassword123"})
+		assert.NoError(t, err)
+	})
+}
+
+// saveTokenPair logs a user in and returns the access token, the session makes the tokens of the same second differ.
+func saveTokenPair(t *testing.T, userID uuid.UUID, session string) string {
+	t.Helper()
+
+	tokenPair, err := jwt.GenerateTokenPair(userID.String(), map[string]interface{}{"session": session})
+	require.NoError(t, err)
+	_, err = jwt_token.SaveTokenPair(context.Background(), userID, tokenPair)
+	require.NoError(t, err)
+
+	return tokenPair.AccessToken
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/course"
+	"template/internal/ent/group"
+	"template/internal/ent/organization"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/test"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AccessGrant is the model entity for the AccessGrant schema.
+type AccessGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID *uuid.UUID `json:"group_id,omitempty"`
+	// Viewers can read the resource, editors can also change it
+	Role accessgrant.Role `json:"role,omitempty"`
+	// TestID holds the value of the "test_id" field.
+	TestID *uuid.UUID `json:"test_id,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID *uuid.UUID `json:"course_id,omitempty"`
+	// QuestionCollectionID holds the value of the "question_collection_id" field.
+	QuestionCollectionID *uuid.UUID `json:"question_collection_id,omitempty"`
+	// GrantedByID holds the value of the "granted_by_id" field.
+	GrantedByID *uuid.UUID `json:"granted_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccessGrantQuery when eager-loading is set.
+	Edges        AccessGrantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccessGrantEdges holds the relations/edges for other nodes in the graph.
+type AccessGrantEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Test holds the value of the test edge.
+	Test *Test `json:"test,omitempty"`
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// QuestionCollection holds the value of the question_collection edge.
+	QuestionCollection *QuestionCollection `json:"question_collection,omitempty"`
+	// GrantedBy holds the value of the granted_by edge.
+	GrantedBy *User `json:"granted_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// TestOrErr returns the Test value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) TestOrErr() (*Test, error) {
+	if e.Test != nil {
+		return e.Test, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: test.Label}
+	}
+	return nil, &NotLoadedError{edge: "test"}
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// QuestionCollectionOrErr returns the QuestionCollection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) QuestionCollectionOrErr() (*QuestionCollection, error) {
+	if e.QuestionCollection != nil {
+		return e.QuestionCollection, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: questioncollection.Label}
+	}
+	return nil, &NotLoadedError{edge: "question_collection"}
+}
+
+// GrantedByOrErr returns the GrantedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) GrantedByOrErr() (*User, error) {
+	if e.GrantedBy != nil {
+		return e.GrantedBy, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "granted_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessgrant.FieldOrganizationID, accessgrant.FieldUserID, accessgrant.FieldGroupID, accessgrant.FieldTestID, accessgrant.FieldCourseID, accessgrant.FieldQuestionCollectionID, accessgrant.FieldGrantedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case accessgrant.FieldRole:
+			values[i] = new(sql.NullString)
+		case accessgrant.FieldCreatedAt, accessgrant.FieldUpdatedAt, accessgrant.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case accessgrant.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessGrant fields.
+func (ag *AccessGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessgrant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ag.ID = *value
+			}
+		case accessgrant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ag.CreatedAt = value.Time
+			}
+		case accessgrant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ag.UpdatedAt = value.Time
+			}
+		case accessgrant.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ag.DeletedAt = new(time.Time)
+				*ag.DeletedAt = value.Time
+			}
+		case accessgrant.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				ag.OrganizationID = new(uuid.UUID)
+				*ag.OrganizationID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ag.UserID = new(uuid.UUID)
+				*ag.UserID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldGroupID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				ag.GroupID = new(uuid.UUID)
+				*ag.GroupID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				ag.Role = accessgrant.Role(value.String)
+			}
+		case accessgrant.FieldTestID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field test_id", values[i])
+			} else if value.Valid {
+				ag.TestID = new(uuid.UUID)
+				*ag.TestID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldCourseID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				ag.CourseID = new(uuid.UUID)
+				*ag.CourseID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldQuestionCollectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_collection_id", values[i])
+			} else if value.Valid {
+				ag.QuestionCollectionID = new(uuid.UUID)
+				*ag.QuestionCollectionID = *value.S.(*uuid.UUID)
+			}
+		case accessgrant.FieldGrantedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by_id", values[i])
+			} else if value.Valid {
+				ag.GrantedByID = new(uuid.UUID)
+				*ag.GrantedByID = *value.S.(*uuid.UUID)
+			}
+		default:
+			ag.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessGrant.
+// This includes values selected through modifiers, order, etc.
+func (ag *AccessGrant) Value(name string) (ent.Value, error) {
+	return ag.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryOrganization() *OrganizationQuery {
+	return NewAccessGrantClient(ag.config).QueryOrganization(ag)
+}
+
+// QueryUser queries the "user" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryUser() *UserQuery {
+	return NewAccessGrantClient(ag.config).QueryUser(ag)
+}
+
+// QueryGroup queries the "group" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryGroup() *GroupQuery {
+	return NewAccessGrantClient(ag.config).QueryGroup(ag)
+}
+
+// QueryTest queries the "test" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryTest() *TestQuery {
+	return NewAccessGrantClient(ag.config).QueryTest(ag)
+}
+
+// QueryCourse queries the "course" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryCourse() *CourseQuery {
+	return NewAccessGrantClient(ag.config).QueryCourse(ag)
+}
+
+// QueryQuestionCollection queries the "question_collection" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryQuestionCollection() *QuestionCollectionQuery {
+	return NewAccessGrantClient(ag.config).QueryQuestionCollection(ag)
+}
+
+// QueryGrantedBy queries the "granted_by" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryGrantedBy() *UserQuery {
+	return NewAccessGrantClient(ag.config).QueryGrantedBy(ag)
+}
+
+// Update returns a builder for updating this AccessGrant.
+// Note that you need to call AccessGrant.Unwrap() before calling this method if this AccessGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ag *AccessGrant) Update() *AccessGrantUpdateOne {
+	return NewAccessGrantClient(ag.config).UpdateOne(ag)
+}
+
+// Unwrap unwraps the AccessGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ag *AccessGrant) Unwrap() *AccessGrant {
+	_tx, ok := ag.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessGrant is not a transactional entity")
+	}
+	ag.config.driver = _tx.drv
+	return ag
+}
+
+// String implements the fmt.Stringer.
+func (ag *AccessGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ag.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ag.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ag.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ag.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ag.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ag.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ag.GroupID; v != nil {
+		builder.WriteString("group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", ag.Role))
+	builder.WriteString(", ")
+	if v := ag.TestID; v != nil {
+		builder.WriteString("test_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ag.CourseID; v != nil {
+		builder.WriteString("course_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ag.QuestionCollectionID; v != nil {
+		builder.WriteString("question_collection_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ag.GrantedByID; v != nil {
+		builder.WriteString("granted_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessGrants is a parsable slice of AccessGrant.
+type AccessGrants []*AccessGrant
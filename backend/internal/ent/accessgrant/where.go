@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessgrant
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGroupID, v))
+}
+
+// TestID applies equality check predicate on the "test_id" field. It's identical to TestIDEQ.
+func TestID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldTestID, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCourseID, v))
+}
+
+// QuestionCollectionID applies equality check predicate on the "question_collection_id" field. It's identical to QuestionCollectionIDEQ.
+func QuestionCollectionID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldQuestionCollectionID, v))
+}
+
+// GrantedByID applies equality check predicate on the "granted_by_id" field. It's identical to GrantedByIDEQ.
+func GrantedByID(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGrantedByID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldUserID))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldGroupID))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// TestIDEQ applies the EQ predicate on the "test_id" field.
+func TestIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldTestID, v))
+}
+
+// TestIDNEQ applies the NEQ predicate on the "test_id" field.
+func TestIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldTestID, v))
+}
+
+// TestIDIn applies the In predicate on the "test_id" field.
+func TestIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldTestID, vs...))
+}
+
+// TestIDNotIn applies the NotIn predicate on the "test_id" field.
+func TestIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldTestID, vs...))
+}
+
+// TestIDIsNil applies the IsNil predicate on the "test_id" field.
+func TestIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldTestID))
+}
+
+// TestIDNotNil applies the NotNil predicate on the "test_id" field.
+func TestIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldTestID))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// CourseIDIsNil applies the IsNil predicate on the "course_id" field.
+func CourseIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldCourseID))
+}
+
+// CourseIDNotNil applies the NotNil predicate on the "course_id" field.
+func CourseIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldCourseID))
+}
+
+// QuestionCollectionIDEQ applies the EQ predicate on the "question_collection_id" field.
+func QuestionCollectionIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldQuestionCollectionID, v))
+}
+
+// QuestionCollectionIDNEQ applies the NEQ predicate on the "question_collection_id" field.
+func QuestionCollectionIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldQuestionCollectionID, v))
+}
+
+// QuestionCollectionIDIn applies the In predicate on the "question_collection_id" field.
+func QuestionCollectionIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldQuestionCollectionID, vs...))
+}
+
+// QuestionCollectionIDNotIn applies the NotIn predicate on the "question_collection_id" field.
+func QuestionCollectionIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldQuestionCollectionID, vs...))
+}
+
+// QuestionCollectionIDIsNil applies the IsNil predicate on the "question_collection_id" field.
+func QuestionCollectionIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldQuestionCollectionID))
+}
+
+// QuestionCollectionIDNotNil applies the NotNil predicate on the "question_collection_id" field.
+func QuestionCollectionIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldQuestionCollectionID))
+}
+
+// GrantedByIDEQ applies the EQ predicate on the "granted_by_id" field.
+func GrantedByIDEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGrantedByID, v))
+}
+
+// GrantedByIDNEQ applies the NEQ predicate on the "granted_by_id" field.
+func GrantedByIDNEQ(v uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldGrantedByID, v))
+}
+
+// GrantedByIDIn applies the In predicate on the "granted_by_id" field.
+func GrantedByIDIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldGrantedByID, vs...))
+}
+
+// GrantedByIDNotIn applies the NotIn predicate on the "granted_by_id" field.
+func GrantedByIDNotIn(vs ...uuid.UUID) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldGrantedByID, vs...))
+}
+
+// GrantedByIDIsNil applies the IsNil predicate on the "granted_by_id" field.
+func GrantedByIDIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldGrantedByID))
+}
+
+// GrantedByIDNotNil applies the NotNil predicate on the "granted_by_id" field.
+func GrantedByIDNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldGrantedByID))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTest applies the HasEdge predicate on the "test" edge.
+func HasTest() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TestTable, TestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestWith applies the HasEdge predicate on the "test" edge with a given conditions (other predicates).
+func HasTestWith(preds ...predicate.Test) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newTestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuestionCollection applies the HasEdge predicate on the "question_collection" edge.
+func HasQuestionCollection() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionCollectionTable, QuestionCollectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionCollectionWith applies the HasEdge predicate on the "question_collection" edge with a given conditions (other predicates).
+func HasQuestionCollectionWith(preds ...predicate.QuestionCollection) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newQuestionCollectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGrantedBy applies the HasEdge predicate on the "granted_by" edge.
+func HasGrantedBy() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GrantedByTable, GrantedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGrantedByWith applies the HasEdge predicate on the "granted_by" edge with a given conditions (other predicates).
+func HasGrantedByWith(preds ...predicate.User) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newGrantedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.NotPredicates(p))
+}
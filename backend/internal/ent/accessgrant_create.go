@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/course"
+	"template/internal/ent/group"
+	"template/internal/ent/organization"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/test"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AccessGrantCreate is the builder for creating a AccessGrant entity.
+type AccessGrantCreate struct {
+	config
+	mutation *AccessGrantMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (agc *AccessGrantCreate) SetCreatedAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetCreatedAt(t)
+	return agc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableCreatedAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetCreatedAt(*t)
+	}
+	return agc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (agc *AccessGrantCreate) SetUpdatedAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetUpdatedAt(t)
+	return agc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableUpdatedAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetUpdatedAt(*t)
+	}
+	return agc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (agc *AccessGrantCreate) SetDeletedAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetDeletedAt(t)
+	return agc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableDeletedAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetDeletedAt(*t)
+	}
+	return agc
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (agc *AccessGrantCreate) SetOrganizationID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetOrganizationID(u)
+	return agc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableOrganizationID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetOrganizationID(*u)
+	}
+	return agc
+}
+
+// SetUserID sets the "user_id" field.
+func (agc *AccessGrantCreate) SetUserID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetUserID(u)
+	return agc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableUserID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetUserID(*u)
+	}
+	return agc
+}
+
+// SetGroupID sets the "group_id" field.
+func (agc *AccessGrantCreate) SetGroupID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetGroupID(u)
+	return agc
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableGroupID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetGroupID(*u)
+	}
+	return agc
+}
+
+// SetRole sets the "role" field.
+func (agc *AccessGrantCreate) SetRole(a accessgrant.Role) *AccessGrantCreate {
+	agc.mutation.SetRole(a)
+	return agc
+}
+
+// SetTestID sets the "test_id" field.
+func (agc *AccessGrantCreate) SetTestID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetTestID(u)
+	return agc
+}
+
+// SetNillableTestID sets the "test_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableTestID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetTestID(*u)
+	}
+	return agc
+}
+
+// SetCourseID sets the "course_id" field.
+func (agc *AccessGrantCreate) SetCourseID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetCourseID(u)
+	return agc
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableCourseID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetCourseID(*u)
+	}
+	return agc
+}
+
+// SetQuestionCollectionID sets the "question_collection_id" field.
+func (agc *AccessGrantCreate) SetQuestionCollectionID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetQuestionCollectionID(u)
+	return agc
+}
+
+// SetNillableQuestionCollectionID sets the "question_collection_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableQuestionCollectionID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetQuestionCollectionID(*u)
+	}
+	return agc
+}
+
+// SetGrantedByID sets the "granted_by_id" field.
+func (agc *AccessGrantCreate) SetGrantedByID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetGrantedByID(u)
+	return agc
+}
+
+// SetNillableGrantedByID sets the "granted_by_id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableGrantedByID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetGrantedByID(*u)
+	}
+	return agc
+}
+
+// SetID sets the "id" field.
+func (agc *AccessGrantCreate) SetID(u uuid.UUID) *AccessGrantCreate {
+	agc.mutation.SetID(u)
+	return agc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableID(u *uuid.UUID) *AccessGrantCreate {
+	if u != nil {
+		agc.SetID(*u)
+	}
+	return agc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (agc *AccessGrantCreate) SetOrganization(o *Organization) *AccessGrantCreate {
+	return agc.SetOrganizationID(o.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (agc *AccessGrantCreate) SetUser(u *User) *AccessGrantCreate {
+	return agc.SetUserID(u.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (agc *AccessGrantCreate) SetGroup(g *Group) *AccessGrantCreate {
+	return agc.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (agc *AccessGrantCreate) SetTest(t *Test) *AccessGrantCreate {
+	return agc.SetTestID(t.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (agc *AccessGrantCreate) SetCourse(c *Course) *AccessGrantCreate {
+	return agc.SetCourseID(c.ID)
+}
+
+// SetQuestionCollection sets the "question_collection" edge to the QuestionCollection entity.
+func (agc *AccessGrantCreate) SetQuestionCollection(q *QuestionCollection) *AccessGrantCreate {
+	return agc.SetQuestionCollectionID(q.ID)
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (agc *AccessGrantCreate) SetGrantedBy(u *User) *AccessGrantCreate {
+	return agc.SetGrantedByID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (agc *AccessGrantCreate) Mutation() *AccessGrantMutation {
+	return agc.mutation
+}
+
+// Save creates the AccessGrant in the database.
+func (agc *AccessGrantCreate) Save(ctx context.Context) (*AccessGrant, error) {
+	if err := agc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, agc.sqlSave, agc.mutation, agc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (agc *AccessGrantCreate) SaveX(ctx context.Context) *AccessGrant {
+	v, err := agc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (agc *AccessGrantCreate) Exec(ctx context.Context) error {
+	_, err := agc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agc *AccessGrantCreate) ExecX(ctx context.Context) {
+	if err := agc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (agc *AccessGrantCreate) defaults() error {
+	if _, ok := agc.mutation.CreatedAt(); !ok {
+		if accessgrant.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accessgrant.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accessgrant.DefaultCreatedAt()
+		agc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := agc.mutation.UpdatedAt(); !ok {
+		if accessgrant.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accessgrant.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accessgrant.DefaultUpdatedAt()
+		agc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := agc.mutation.ID(); !ok {
+		if accessgrant.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized accessgrant.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := accessgrant.DefaultID()
+		agc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (agc *AccessGrantCreate) check() error {
+	if _, ok := agc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessGrant.created_at"`)}
+	}
+	if _, ok := agc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccessGrant.updated_at"`)}
+	}
+	if _, ok := agc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "AccessGrant.role"`)}
+	}
+	if v, ok := agc.mutation.Role(); ok {
+		if err := accessgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AccessGrant.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (agc *AccessGrantCreate) sqlSave(ctx context.Context) (*AccessGrant, error) {
+	if err := agc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := agc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, agc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	agc.mutation.id = &_node.ID
+	agc.mutation.done = true
+	return _node, nil
+}
+
+func (agc *AccessGrantCreate) createSpec() (*AccessGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessGrant{config: agc.config}
+		_spec = sqlgraph.NewCreateSpec(accessgrant.Table, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID))
+	)
+	if id, ok := agc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := agc.mutation.CreatedAt(); ok {
+		_spec.SetField(accessgrant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := agc.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := agc.mutation.DeletedAt(); ok {
+		_spec.SetField(accessgrant.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := agc.mutation.Role(); ok {
+		_spec.SetField(accessgrant.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if nodes := agc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.OrganizationTable,
+			Columns: []string{accessgrant.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GroupTable,
+			Columns: []string{accessgrant.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.TestTable,
+			Columns: []string{accessgrant.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TestID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.CourseTable,
+			Columns: []string{accessgrant.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.QuestionCollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.QuestionCollectionTable,
+			Columns: []string{accessgrant.QuestionCollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuestionCollectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GrantedByTable,
+			Columns: []string{accessgrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GrantedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccessGrantCreateBulk is the builder for creating many AccessGrant entities in bulk.
+type AccessGrantCreateBulk struct {
+	config
+	err      error
+	builders []*AccessGrantCreate
+}
+
+// Save creates the AccessGrant entities in the database.
+func (agcb *AccessGrantCreateBulk) Save(ctx context.Context) ([]*AccessGrant, error) {
+	if agcb.err != nil {
+		return nil, agcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(agcb.builders))
+	nodes := make([]*AccessGrant, len(agcb.builders))
+	mutators := make([]Mutator, len(agcb.builders))
+	for i := range agcb.builders {
+		func(i int, root context.Context) {
+			builder := agcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, agcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, agcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, agcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (agcb *AccessGrantCreateBulk) SaveX(ctx context.Context) []*AccessGrant {
+	v, err := agcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (agcb *AccessGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := agcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agcb *AccessGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := agcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/course"
+	"template/internal/ent/group"
+	"template/internal/ent/organization"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/test"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AccessGrantUpdate is the builder for updating AccessGrant entities.
+type AccessGrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessGrantMutation
+}
+
+// Where appends a list predicates to the AccessGrantUpdate builder.
+func (agu *AccessGrantUpdate) Where(ps ...predicate.AccessGrant) *AccessGrantUpdate {
+	agu.mutation.Where(ps...)
+	return agu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (agu *AccessGrantUpdate) SetCreatedAt(t time.Time) *AccessGrantUpdate {
+	agu.mutation.SetCreatedAt(t)
+	return agu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableCreatedAt(t *time.Time) *AccessGrantUpdate {
+	if t != nil {
+		agu.SetCreatedAt(*t)
+	}
+	return agu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (agu *AccessGrantUpdate) SetUpdatedAt(t time.Time) *AccessGrantUpdate {
+	agu.mutation.SetUpdatedAt(t)
+	return agu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (agu *AccessGrantUpdate) SetDeletedAt(t time.Time) *AccessGrantUpdate {
+	agu.mutation.SetDeletedAt(t)
+	return agu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableDeletedAt(t *time.Time) *AccessGrantUpdate {
+	if t != nil {
+		agu.SetDeletedAt(*t)
+	}
+	return agu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (agu *AccessGrantUpdate) ClearDeletedAt() *AccessGrantUpdate {
+	agu.mutation.ClearDeletedAt()
+	return agu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (agu *AccessGrantUpdate) SetOrganizationID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetOrganizationID(u)
+	return agu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableOrganizationID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetOrganizationID(*u)
+	}
+	return agu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (agu *AccessGrantUpdate) ClearOrganizationID() *AccessGrantUpdate {
+	agu.mutation.ClearOrganizationID()
+	return agu
+}
+
+// SetUserID sets the "user_id" field.
+func (agu *AccessGrantUpdate) SetUserID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetUserID(u)
+	return agu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableUserID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetUserID(*u)
+	}
+	return agu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (agu *AccessGrantUpdate) ClearUserID() *AccessGrantUpdate {
+	agu.mutation.ClearUserID()
+	return agu
+}
+
+// SetGroupID sets the "group_id" field.
+func (agu *AccessGrantUpdate) SetGroupID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetGroupID(u)
+	return agu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableGroupID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetGroupID(*u)
+	}
+	return agu
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (agu *AccessGrantUpdate) ClearGroupID() *AccessGrantUpdate {
+	agu.mutation.ClearGroupID()
+	return agu
+}
+
+// SetRole sets the "role" field.
+func (agu *AccessGrantUpdate) SetRole(a accessgrant.Role) *AccessGrantUpdate {
+	agu.mutation.SetRole(a)
+	return agu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableRole(a *accessgrant.Role) *AccessGrantUpdate {
+	if a != nil {
+		agu.SetRole(*a)
+	}
+	return agu
+}
+
+// SetTestID sets the "test_id" field.
+func (agu *AccessGrantUpdate) SetTestID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetTestID(u)
+	return agu
+}
+
+// SetNillableTestID sets the "test_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableTestID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetTestID(*u)
+	}
+	return agu
+}
+
+// ClearTestID clears the value of the "test_id" field.
+func (agu *AccessGrantUpdate) ClearTestID() *AccessGrantUpdate {
+	agu.mutation.ClearTestID()
+	return agu
+}
+
+// SetCourseID sets the "course_id" field.
+func (agu *AccessGrantUpdate) SetCourseID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetCourseID(u)
+	return agu
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableCourseID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetCourseID(*u)
+	}
+	return agu
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (agu *AccessGrantUpdate) ClearCourseID() *AccessGrantUpdate {
+	agu.mutation.ClearCourseID()
+	return agu
+}
+
+// SetQuestionCollectionID sets the "question_collection_id" field.
+func (agu *AccessGrantUpdate) SetQuestionCollectionID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetQuestionCollectionID(u)
+	return agu
+}
+
+// SetNillableQuestionCollectionID sets the "question_collection_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableQuestionCollectionID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetQuestionCollectionID(*u)
+	}
+	return agu
+}
+
+// ClearQuestionCollectionID clears the value of the "question_collection_id" field.
+func (agu *AccessGrantUpdate) ClearQuestionCollectionID() *AccessGrantUpdate {
+	agu.mutation.ClearQuestionCollectionID()
+	return agu
+}
+
+// SetGrantedByID sets the "granted_by_id" field.
+func (agu *AccessGrantUpdate) SetGrantedByID(u uuid.UUID) *AccessGrantUpdate {
+	agu.mutation.SetGrantedByID(u)
+	return agu
+}
+
+// SetNillableGrantedByID sets the "granted_by_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableGrantedByID(u *uuid.UUID) *AccessGrantUpdate {
+	if u != nil {
+		agu.SetGrantedByID(*u)
+	}
+	return agu
+}
+
+// ClearGrantedByID clears the value of the "granted_by_id" field.
+func (agu *AccessGrantUpdate) ClearGrantedByID() *AccessGrantUpdate {
+	agu.mutation.ClearGrantedByID()
+	return agu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (agu *AccessGrantUpdate) SetOrganization(o *Organization) *AccessGrantUpdate {
+	return agu.SetOrganizationID(o.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (agu *AccessGrantUpdate) SetUser(u *User) *AccessGrantUpdate {
+	return agu.SetUserID(u.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (agu *AccessGrantUpdate) SetGroup(g *Group) *AccessGrantUpdate {
+	return agu.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (agu *AccessGrantUpdate) SetTest(t *Test) *AccessGrantUpdate {
+	return agu.SetTestID(t.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (agu *AccessGrantUpdate) SetCourse(c *Course) *AccessGrantUpdate {
+	return agu.SetCourseID(c.ID)
+}
+
+// SetQuestionCollection sets the "question_collection" edge to the QuestionCollection entity.
+func (agu *AccessGrantUpdate) SetQuestionCollection(q *QuestionCollection) *AccessGrantUpdate {
+	return agu.SetQuestionCollectionID(q.ID)
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (agu *AccessGrantUpdate) SetGrantedBy(u *User) *AccessGrantUpdate {
+	return agu.SetGrantedByID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (agu *AccessGrantUpdate) Mutation() *AccessGrantMutation {
+	return agu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (agu *AccessGrantUpdate) ClearOrganization() *AccessGrantUpdate {
+	agu.mutation.ClearOrganization()
+	return agu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (agu *AccessGrantUpdate) ClearUser() *AccessGrantUpdate {
+	agu.mutation.ClearUser()
+	return agu
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (agu *AccessGrantUpdate) ClearGroup() *AccessGrantUpdate {
+	agu.mutation.ClearGroup()
+	return agu
+}
+
+// ClearTest clears the "test" edge to the Test entity.
+func (agu *AccessGrantUpdate) ClearTest() *AccessGrantUpdate {
+	agu.mutation.ClearTest()
+	return agu
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (agu *AccessGrantUpdate) ClearCourse() *AccessGrantUpdate {
+	agu.mutation.ClearCourse()
+	return agu
+}
+
+// ClearQuestionCollection clears the "question_collection" edge to the QuestionCollection entity.
+func (agu *AccessGrantUpdate) ClearQuestionCollection() *AccessGrantUpdate {
+	agu.mutation.ClearQuestionCollection()
+	return agu
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (agu *AccessGrantUpdate) ClearGrantedBy() *AccessGrantUpdate {
+	agu.mutation.ClearGrantedBy()
+	return agu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (agu *AccessGrantUpdate) Save(ctx context.Context) (int, error) {
+	if err := agu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, agu.sqlSave, agu.mutation, agu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (agu *AccessGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := agu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (agu *AccessGrantUpdate) Exec(ctx context.Context) error {
+	_, err := agu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agu *AccessGrantUpdate) ExecX(ctx context.Context) {
+	if err := agu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (agu *AccessGrantUpdate) defaults() error {
+	if _, ok := agu.mutation.UpdatedAt(); !ok {
+		if accessgrant.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accessgrant.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accessgrant.UpdateDefaultUpdatedAt()
+		agu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (agu *AccessGrantUpdate) check() error {
+	if v, ok := agu.mutation.Role(); ok {
+		if err := accessgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AccessGrant.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (agu *AccessGrantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := agu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessgrant.Table, accessgrant.Columns, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID))
+	if ps := agu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := agu.mutation.CreatedAt(); ok {
+		_spec.SetField(accessgrant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := agu.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := agu.mutation.DeletedAt(); ok {
+		_spec.SetField(accessgrant.FieldDeletedAt, field.TypeTime, value)
+	}
+	if agu.mutation.DeletedAtCleared() {
+		_spec.ClearField(accessgrant.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := agu.mutation.Role(); ok {
+		_spec.SetField(accessgrant.FieldRole, field.TypeEnum, value)
+	}
+	if agu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.OrganizationTable,
+			Columns: []string{accessgrant.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.OrganizationTable,
+			Columns: []string{accessgrant.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GroupTable,
+			Columns: []string{accessgrant.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GroupTable,
+			Columns: []string{accessgrant.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.TestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.TestTable,
+			Columns: []string{accessgrant.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.TestTable,
+			Columns: []string{accessgrant.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.CourseTable,
+			Columns: []string{accessgrant.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.CourseTable,
+			Columns: []string{accessgrant.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.QuestionCollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.QuestionCollectionTable,
+			Columns: []string{accessgrant.QuestionCollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.QuestionCollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.QuestionCollectionTable,
+			Columns: []string{accessgrant.QuestionCollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GrantedByTable,
+			Columns: []string{accessgrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GrantedByTable,
+			Columns: []string{accessgrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, agu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	agu.mutation.done = true
+	return n, nil
+}
+
+// AccessGrantUpdateOne is the builder for updating a single AccessGrant entity.
+type AccessGrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessGrantMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aguo *AccessGrantUpdateOne) SetCreatedAt(t time.Time) *AccessGrantUpdateOne {
+	aguo.mutation.SetCreatedAt(t)
+	return aguo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableCreatedAt(t *time.Time) *AccessGrantUpdateOne {
+	if t != nil {
+		aguo.SetCreatedAt(*t)
+	}
+	return aguo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aguo *AccessGrantUpdateOne) SetUpdatedAt(t time.Time) *AccessGrantUpdateOne {
+	aguo.mutation.SetUpdatedAt(t)
+	return aguo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aguo *AccessGrantUpdateOne) SetDeletedAt(t time.Time) *AccessGrantUpdateOne {
+	aguo.mutation.SetDeletedAt(t)
+	return aguo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableDeletedAt(t *time.Time) *AccessGrantUpdateOne {
+	if t != nil {
+		aguo.SetDeletedAt(*t)
+	}
+	return aguo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aguo *AccessGrantUpdateOne) ClearDeletedAt() *AccessGrantUpdateOne {
+	aguo.mutation.ClearDeletedAt()
+	return aguo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (aguo *AccessGrantUpdateOne) SetOrganizationID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetOrganizationID(u)
+	return aguo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetOrganizationID(*u)
+	}
+	return aguo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (aguo *AccessGrantUpdateOne) ClearOrganizationID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearOrganizationID()
+	return aguo
+}
+
+// SetUserID sets the "user_id" field.
+func (aguo *AccessGrantUpdateOne) SetUserID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetUserID(u)
+	return aguo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableUserID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetUserID(*u)
+	}
+	return aguo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aguo *AccessGrantUpdateOne) ClearUserID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearUserID()
+	return aguo
+}
+
+// SetGroupID sets the "group_id" field.
+func (aguo *AccessGrantUpdateOne) SetGroupID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetGroupID(u)
+	return aguo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableGroupID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetGroupID(*u)
+	}
+	return aguo
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (aguo *AccessGrantUpdateOne) ClearGroupID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearGroupID()
+	return aguo
+}
+
+// SetRole sets the "role" field.
+func (aguo *AccessGrantUpdateOne) SetRole(a accessgrant.Role) *AccessGrantUpdateOne {
+	aguo.mutation.SetRole(a)
+	return aguo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableRole(a *accessgrant.Role) *AccessGrantUpdateOne {
+	if a != nil {
+		aguo.SetRole(*a)
+	}
+	return aguo
+}
+
+// SetTestID sets the "test_id" field.
+func (aguo *AccessGrantUpdateOne) SetTestID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetTestID(u)
+	return aguo
+}
+
+// SetNillableTestID sets the "test_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableTestID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetTestID(*u)
+	}
+	return aguo
+}
+
+// ClearTestID clears the value of the "test_id" field.
+func (aguo *AccessGrantUpdateOne) ClearTestID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearTestID()
+	return aguo
+}
+
+// SetCourseID sets the "course_id" field.
+func (aguo *AccessGrantUpdateOne) SetCourseID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetCourseID(u)
+	return aguo
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableCourseID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetCourseID(*u)
+	}
+	return aguo
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (aguo *AccessGrantUpdateOne) ClearCourseID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearCourseID()
+	return aguo
+}
+
+// SetQuestionCollectionID sets the "question_collection_id" field.
+func (aguo *AccessGrantUpdateOne) SetQuestionCollectionID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetQuestionCollectionID(u)
+	return aguo
+}
+
+// SetNillableQuestionCollectionID sets the "question_collection_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableQuestionCollectionID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetQuestionCollectionID(*u)
+	}
+	return aguo
+}
+
+// ClearQuestionCollectionID clears the value of the "question_collection_id" field.
+func (aguo *AccessGrantUpdateOne) ClearQuestionCollectionID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearQuestionCollectionID()
+	return aguo
+}
+
+// SetGrantedByID sets the "granted_by_id" field.
+func (aguo *AccessGrantUpdateOne) SetGrantedByID(u uuid.UUID) *AccessGrantUpdateOne {
+	aguo.mutation.SetGrantedByID(u)
+	return aguo
+}
+
+// SetNillableGrantedByID sets the "granted_by_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableGrantedByID(u *uuid.UUID) *AccessGrantUpdateOne {
+	if u != nil {
+		aguo.SetGrantedByID(*u)
+	}
+	return aguo
+}
+
+// ClearGrantedByID clears the value of the "granted_by_id" field.
+func (aguo *AccessGrantUpdateOne) ClearGrantedByID() *AccessGrantUpdateOne {
+	aguo.mutation.ClearGrantedByID()
+	return aguo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (aguo *AccessGrantUpdateOne) SetOrganization(o *Organization) *AccessGrantUpdateOne {
+	return aguo.SetOrganizationID(o.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) SetUser(u *User) *AccessGrantUpdateOne {
+	return aguo.SetUserID(u.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (aguo *AccessGrantUpdateOne) SetGroup(g *Group) *AccessGrantUpdateOne {
+	return aguo.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (aguo *AccessGrantUpdateOne) SetTest(t *Test) *AccessGrantUpdateOne {
+	return aguo.SetTestID(t.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (aguo *AccessGrantUpdateOne) SetCourse(c *Course) *AccessGrantUpdateOne {
+	return aguo.SetCourseID(c.ID)
+}
+
+// SetQuestionCollection sets the "question_collection" edge to the QuestionCollection entity.
+func (aguo *AccessGrantUpdateOne) SetQuestionCollection(q *QuestionCollection) *AccessGrantUpdateOne {
+	return aguo.SetQuestionCollectionID(q.ID)
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) SetGrantedBy(u *User) *AccessGrantUpdateOne {
+	return aguo.SetGrantedByID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (aguo *AccessGrantUpdateOne) Mutation() *AccessGrantMutation {
+	return aguo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (aguo *AccessGrantUpdateOne) ClearOrganization() *AccessGrantUpdateOne {
+	aguo.mutation.ClearOrganization()
+	return aguo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) ClearUser() *AccessGrantUpdateOne {
+	aguo.mutation.ClearUser()
+	return aguo
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (aguo *AccessGrantUpdateOne) ClearGroup() *AccessGrantUpdateOne {
+	aguo.mutation.ClearGroup()
+	return aguo
+}
+
+// ClearTest clears the "test" edge to the Test entity.
+func (aguo *AccessGrantUpdateOne) ClearTest() *AccessGrantUpdateOne {
+	aguo.mutation.ClearTest()
+	return aguo
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (aguo *AccessGrantUpdateOne) ClearCourse() *AccessGrantUpdateOne {
+	aguo.mutation.ClearCourse()
+	return aguo
+}
+
+// ClearQuestionCollection clears the "question_collection" edge to the QuestionCollection entity.
+func (aguo *AccessGrantUpdateOne) ClearQuestionCollection() *AccessGrantUpdateOne {
+	aguo.mutation.ClearQuestionCollection()
+	return aguo
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) ClearGrantedBy() *AccessGrantUpdateOne {
+	aguo.mutation.ClearGrantedBy()
+	return aguo
+}
+
+// Where appends a list predicates to the AccessGrantUpdate builder.
+func (aguo *AccessGrantUpdateOne) Where(ps ...predicate.AccessGrant) *AccessGrantUpdateOne {
+	aguo.mutation.Where(ps...)
+	return aguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aguo *AccessGrantUpdateOne) Select(field string, fields ...string) *AccessGrantUpdateOne {
+	aguo.fields = append([]string{field}, fields...)
+	return aguo
+}
+
+// Save executes the query and returns the updated AccessGrant entity.
+func (aguo *AccessGrantUpdateOne) Save(ctx context.Context) (*AccessGrant, error) {
+	if err := aguo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, aguo.sqlSave, aguo.mutation, aguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aguo *AccessGrantUpdateOne) SaveX(ctx context.Context) *AccessGrant {
+	node, err := aguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aguo *AccessGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := aguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aguo *AccessGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := aguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aguo *AccessGrantUpdateOne) defaults() error {
+	if _, ok := aguo.mutation.UpdatedAt(); !ok {
+		if accessgrant.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accessgrant.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accessgrant.UpdateDefaultUpdatedAt()
+		aguo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aguo *AccessGrantUpdateOne) check() error {
+	if v, ok := aguo.mutation.Role(); ok {
+		if err := accessgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AccessGrant.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aguo *AccessGrantUpdateOne) sqlSave(ctx context.Context) (_node *AccessGrant, err error) {
+	if err := aguo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessgrant.Table, accessgrant.Columns, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID))
+	id, ok := aguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accessgrant.FieldID)
+		for _, f := range fields {
+			if !accessgrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accessgrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aguo.mutation.CreatedAt(); ok {
+		_spec.SetField(accessgrant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := aguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aguo.mutation.DeletedAt(); ok {
+		_spec.SetField(accessgrant.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aguo.mutation.DeletedAtCleared() {
+		_spec.ClearField(accessgrant.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aguo.mutation.Role(); ok {
+		_spec.SetField(accessgrant.FieldRole, field.TypeEnum, value)
+	}
+	if aguo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.OrganizationTable,
+			Columns: []string{accessgrant.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.OrganizationTable,
+			Columns: []string{accessgrant.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GroupTable,
+			Columns: []string{accessgrant.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GroupTable,
+			Columns: []string{accessgrant.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.TestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.TestTable,
+			Columns: []string{accessgrant.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.TestTable,
+			Columns: []string{accessgrant.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.CourseTable,
+			Columns: []string{accessgrant.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.CourseTable,
+			Columns: []string{accessgrant.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.QuestionCollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.QuestionCollectionTable,
+			Columns: []string{accessgrant.QuestionCollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.QuestionCollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.QuestionCollectionTable,
+			Columns: []string{accessgrant.QuestionCollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GrantedByTable,
+			Columns: []string{accessgrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.GrantedByTable,
+			Columns: []string{accessgrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessGrant{config: aguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aguo.mutation.done = true
+	return _node, nil
+}
@@ -11,22 +11,36 @@ import (
 
 	"template/internal/ent/migrate"
 
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/permission"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
 	"template/internal/ent/role"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/todo"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"template/internal/ent/video"
 	"template/internal/ent/videoquestiontimestamp"
 
@@ -42,14 +56,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessGrant is the client for interacting with the AccessGrant builders.
+	AccessGrant *AccessGrantClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
+	// CourseEnrollment is the client for interacting with the CourseEnrollment builders.
+	CourseEnrollment *CourseEnrollmentClient
 	// CourseSection is the client for interacting with the CourseSection builders.
 	CourseSection *CourseSectionClient
+	// CourseSectionPrerequisite is the client for interacting with the CourseSectionPrerequisite builders.
+	CourseSectionPrerequisite *CourseSectionPrerequisiteClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
+	// GroupTestAssignment is the client for interacting with the GroupTestAssignment builders.
+	GroupTestAssignment *GroupTestAssignmentClient
 	// JwtToken is the client for interacting with the JwtToken builders.
 	JwtToken *JwtTokenClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Question is the client for interacting with the Question builders.
@@ -58,6 +84,10 @@ type Client struct {
 	QuestionCollection *QuestionCollectionClient
 	// QuestionOption is the client for interacting with the QuestionOption builders.
 	QuestionOption *QuestionOptionClient
+	// QuestionVersion is the client for interacting with the QuestionVersion builders.
+	QuestionVersion *QuestionVersionClient
+	// QuestionVersionOption is the client for interacting with the QuestionVersionOption builders.
+	QuestionVersionOption *QuestionVersionOptionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// Test is the client for interacting with the Test builders.
@@ -70,10 +100,22 @@ type Client struct {
 	TestSession *TestSessionClient
 	// TestSessionAnswer is the client for interacting with the TestSessionAnswer builders.
 	TestSessionAnswer *TestSessionAnswerClient
+	// TestSessionBreak is the client for interacting with the TestSessionBreak builders.
+	TestSessionBreak *TestSessionBreakClient
+	// TestSessionIntegrityEvent is the client for interacting with the TestSessionIntegrityEvent builders.
+	TestSessionIntegrityEvent *TestSessionIntegrityEventClient
+	// TestSessionRegrade is the client for interacting with the TestSessionRegrade builders.
+	TestSessionRegrade *TestSessionRegradeClient
+	// TestSessionTimeExtension is the client for interacting with the TestSessionTimeExtension builders.
+	TestSessionTimeExtension *TestSessionTimeExtensionClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserAccommodation is the client for interacting with the UserAccommodation builders.
+	UserAccommodation *UserAccommodationClient
+	// UserInvite is the client for interacting with the UserInvite builders.
+	UserInvite *UserInviteClient
 	// Video is the client for interacting with the Video builders.
 	Video *VideoClient
 	// VideoQuestionTimestamp is the client for interacting with the VideoQuestionTimestamp builders.
@@ -89,22 +131,36 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessGrant = NewAccessGrantClient(c.config)
 	c.Course = NewCourseClient(c.config)
+	c.CourseEnrollment = NewCourseEnrollmentClient(c.config)
 	c.CourseSection = NewCourseSectionClient(c.config)
+	c.CourseSectionPrerequisite = NewCourseSectionPrerequisiteClient(c.config)
+	c.Group = NewGroupClient(c.config)
+	c.GroupTestAssignment = NewGroupTestAssignmentClient(c.config)
 	c.JwtToken = NewJwtTokenClient(c.config)
 	c.Media = NewMediaClient(c.config)
+	c.Organization = NewOrganizationClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.Question = NewQuestionClient(c.config)
 	c.QuestionCollection = NewQuestionCollectionClient(c.config)
 	c.QuestionOption = NewQuestionOptionClient(c.config)
+	c.QuestionVersion = NewQuestionVersionClient(c.config)
+	c.QuestionVersionOption = NewQuestionVersionOptionClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.Test = NewTestClient(c.config)
 	c.TestIgnoreQuestion = NewTestIgnoreQuestionClient(c.config)
 	c.TestQuestionCount = NewTestQuestionCountClient(c.config)
 	c.TestSession = NewTestSessionClient(c.config)
 	c.TestSessionAnswer = NewTestSessionAnswerClient(c.config)
+	c.TestSessionBreak = NewTestSessionBreakClient(c.config)
+	c.TestSessionIntegrityEvent = NewTestSessionIntegrityEventClient(c.config)
+	c.TestSessionRegrade = NewTestSessionRegradeClient(c.config)
+	c.TestSessionTimeExtension = NewTestSessionTimeExtensionClient(c.config)
 	c.Todo = NewTodoClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserAccommodation = NewUserAccommodationClient(c.config)
+	c.UserInvite = NewUserInviteClient(c.config)
 	c.Video = NewVideoClient(c.config)
 	c.VideoQuestionTimestamp = NewVideoQuestionTimestampClient(c.config)
 }
@@ -197,26 +253,40 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		Course:                 NewCourseClient(cfg),
-		CourseSection:          NewCourseSectionClient(cfg),
-		JwtToken:               NewJwtTokenClient(cfg),
-		Media:                  NewMediaClient(cfg),
-		Permission:             NewPermissionClient(cfg),
-		Question:               NewQuestionClient(cfg),
-		QuestionCollection:     NewQuestionCollectionClient(cfg),
-		QuestionOption:         NewQuestionOptionClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		Test:                   NewTestClient(cfg),
-		TestIgnoreQuestion:     NewTestIgnoreQuestionClient(cfg),
-		TestQuestionCount:      NewTestQuestionCountClient(cfg),
-		TestSession:            NewTestSessionClient(cfg),
-		TestSessionAnswer:      NewTestSessionAnswerClient(cfg),
-		Todo:                   NewTodoClient(cfg),
-		User:                   NewUserClient(cfg),
-		Video:                  NewVideoClient(cfg),
-		VideoQuestionTimestamp: NewVideoQuestionTimestampClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		AccessGrant:               NewAccessGrantClient(cfg),
+		Course:                    NewCourseClient(cfg),
+		CourseEnrollment:          NewCourseEnrollmentClient(cfg),
+		CourseSection:             NewCourseSectionClient(cfg),
+		CourseSectionPrerequisite: NewCourseSectionPrerequisiteClient(cfg),
+		Group:                     NewGroupClient(cfg),
+		GroupTestAssignment:       NewGroupTestAssignmentClient(cfg),
+		JwtToken:                  NewJwtTokenClient(cfg),
+		Media:                     NewMediaClient(cfg),
+		Organization:              NewOrganizationClient(cfg),
+		Permission:                NewPermissionClient(cfg),
+		Question:                  NewQuestionClient(cfg),
+		QuestionCollection:        NewQuestionCollectionClient(cfg),
+		QuestionOption:            NewQuestionOptionClient(cfg),
+		QuestionVersion:           NewQuestionVersionClient(cfg),
+		QuestionVersionOption:     NewQuestionVersionOptionClient(cfg),
+		Role:                      NewRoleClient(cfg),
+		Test:                      NewTestClient(cfg),
+		TestIgnoreQuestion:        NewTestIgnoreQuestionClient(cfg),
+		TestQuestionCount:         NewTestQuestionCountClient(cfg),
+		TestSession:               NewTestSessionClient(cfg),
+		TestSessionAnswer:         NewTestSessionAnswerClient(cfg),
+		TestSessionBreak:          NewTestSessionBreakClient(cfg),
+		TestSessionIntegrityEvent: NewTestSessionIntegrityEventClient(cfg),
+		TestSessionRegrade:        NewTestSessionRegradeClient(cfg),
+		TestSessionTimeExtension:  NewTestSessionTimeExtensionClient(cfg),
+		Todo:                      NewTodoClient(cfg),
+		User:                      NewUserClient(cfg),
+		UserAccommodation:         NewUserAccommodationClient(cfg),
+		UserInvite:                NewUserInviteClient(cfg),
+		Video:                     NewVideoClient(cfg),
+		VideoQuestionTimestamp:    NewVideoQuestionTimestampClient(cfg),
 	}, nil
 }
 
@@ -234,33 +304,47 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		Course:                 NewCourseClient(cfg),
-		CourseSection:          NewCourseSectionClient(cfg),
-		JwtToken:               NewJwtTokenClient(cfg),
-		Media:                  NewMediaClient(cfg),
-		Permission:             NewPermissionClient(cfg),
-		Question:               NewQuestionClient(cfg),
-		QuestionCollection:     NewQuestionCollectionClient(cfg),
-		QuestionOption:         NewQuestionOptionClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		Test:                   NewTestClient(cfg),
-		TestIgnoreQuestion:     NewTestIgnoreQuestionClient(cfg),
-		TestQuestionCount:      NewTestQuestionCountClient(cfg),
-		TestSession:            NewTestSessionClient(cfg),
-		TestSessionAnswer:      NewTestSessionAnswerClient(cfg),
-		Todo:                   NewTodoClient(cfg),
-		User:                   NewUserClient(cfg),
-		Video:                  NewVideoClient(cfg),
-		VideoQuestionTimestamp: NewVideoQuestionTimestampClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		AccessGrant:               NewAccessGrantClient(cfg),
+		Course:                    NewCourseClient(cfg),
+		CourseEnrollment:          NewCourseEnrollmentClient(cfg),
+		CourseSection:             NewCourseSectionClient(cfg),
+		CourseSectionPrerequisite: NewCourseSectionPrerequisiteClient(cfg),
+		Group:                     NewGroupClient(cfg),
+		GroupTestAssignment:       NewGroupTestAssignmentClient(cfg),
+		JwtToken:                  NewJwtTokenClient(cfg),
+		Media:                     NewMediaClient(cfg),
+		Organization:              NewOrganizationClient(cfg),
+		Permission:                NewPermissionClient(cfg),
+		Question:                  NewQuestionClient(cfg),
+		QuestionCollection:        NewQuestionCollectionClient(cfg),
+		QuestionOption:            NewQuestionOptionClient(cfg),
+		QuestionVersion:           NewQuestionVersionClient(cfg),
+		QuestionVersionOption:     NewQuestionVersionOptionClient(cfg),
+		Role:                      NewRoleClient(cfg),
+		Test:                      NewTestClient(cfg),
+		TestIgnoreQuestion:        NewTestIgnoreQuestionClient(cfg),
+		TestQuestionCount:         NewTestQuestionCountClient(cfg),
+		TestSession:               NewTestSessionClient(cfg),
+		TestSessionAnswer:         NewTestSessionAnswerClient(cfg),
+		TestSessionBreak:          NewTestSessionBreakClient(cfg),
+		TestSessionIntegrityEvent: NewTestSessionIntegrityEventClient(cfg),
+		TestSessionRegrade:        NewTestSessionRegradeClient(cfg),
+		TestSessionTimeExtension:  NewTestSessionTimeExtensionClient(cfg),
+		Todo:                      NewTodoClient(cfg),
+		User:                      NewUserClient(cfg),
+		UserAccommodation:         NewUserAccommodationClient(cfg),
+		UserInvite:                NewUserInviteClient(cfg),
+		Video:                     NewVideoClient(cfg),
+		VideoQuestionTimestamp:    NewVideoQuestionTimestampClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Course.
+//		AccessGrant.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -283,9 +367,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Course, c.CourseSection, c.JwtToken, c.Media, c.Permission, c.Question,
-		c.QuestionCollection, c.QuestionOption, c.Role, c.Test, c.TestIgnoreQuestion,
-		c.TestQuestionCount, c.TestSession, c.TestSessionAnswer, c.Todo, c.User,
+		c.AccessGrant, c.Course, c.CourseEnrollment, c.CourseSection,
+		c.CourseSectionPrerequisite, c.Group, c.GroupTestAssignment, c.JwtToken,
+		c.Media, c.Organization, c.Permission, c.Question, c.QuestionCollection,
+		c.QuestionOption, c.QuestionVersion, c.QuestionVersionOption, c.Role, c.Test,
+		c.TestIgnoreQuestion, c.TestQuestionCount, c.TestSession, c.TestSessionAnswer,
+		c.TestSessionBreak, c.TestSessionIntegrityEvent, c.TestSessionRegrade,
+		c.TestSessionTimeExtension, c.Todo, c.User, c.UserAccommodation, c.UserInvite,
 		c.Video, c.VideoQuestionTimestamp,
 	} {
 		n.Use(hooks...)
@@ -296,9 +384,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Course, c.CourseSection, c.JwtToken, c.Media, c.Permission, c.Question,
-		c.QuestionCollection, c.QuestionOption, c.Role, c.Test, c.TestIgnoreQuestion,
-		c.TestQuestionCount, c.TestSession, c.TestSessionAnswer, c.Todo, c.User,
+		c.AccessGrant, c.Course, c.CourseEnrollment, c.CourseSection,
+		c.CourseSectionPrerequisite, c.Group, c.GroupTestAssignment, c.JwtToken,
+		c.Media, c.Organization, c.Permission, c.Question, c.QuestionCollection,
+		c.QuestionOption, c.QuestionVersion, c.QuestionVersionOption, c.Role, c.Test,
+		c.TestIgnoreQuestion, c.TestQuestionCount, c.TestSession, c.TestSessionAnswer,
+		c.TestSessionBreak, c.TestSessionIntegrityEvent, c.TestSessionRegrade,
+		c.TestSessionTimeExtension, c.Todo, c.User, c.UserAccommodation, c.UserInvite,
 		c.Video, c.VideoQuestionTimestamp,
 	} {
 		n.Intercept(interceptors...)
@@ -308,14 +400,26 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessGrantMutation:
+		return c.AccessGrant.mutate(ctx, m)
 	case *CourseMutation:
 		return c.Course.mutate(ctx, m)
+	case *CourseEnrollmentMutation:
+		return c.CourseEnrollment.mutate(ctx, m)
 	case *CourseSectionMutation:
 		return c.CourseSection.mutate(ctx, m)
+	case *CourseSectionPrerequisiteMutation:
+		return c.CourseSectionPrerequisite.mutate(ctx, m)
+	case *GroupMutation:
+		return c.Group.mutate(ctx, m)
+	case *GroupTestAssignmentMutation:
+		return c.GroupTestAssignment.mutate(ctx, m)
 	case *JwtTokenMutation:
 		return c.JwtToken.mutate(ctx, m)
 	case *MediaMutation:
 		return c.Media.mutate(ctx, m)
+	case *OrganizationMutation:
+		return c.Organization.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
 	case *QuestionMutation:
@@ -324,6 +428,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.QuestionCollection.mutate(ctx, m)
 	case *QuestionOptionMutation:
 		return c.QuestionOption.mutate(ctx, m)
+	case *QuestionVersionMutation:
+		return c.QuestionVersion.mutate(ctx, m)
+	case *QuestionVersionOptionMutation:
+		return c.QuestionVersionOption.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *TestMutation:
@@ -336,10 +444,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TestSession.mutate(ctx, m)
 	case *TestSessionAnswerMutation:
 		return c.TestSessionAnswer.mutate(ctx, m)
+	case *TestSessionBreakMutation:
+		return c.TestSessionBreak.mutate(ctx, m)
+	case *TestSessionIntegrityEventMutation:
+		return c.TestSessionIntegrityEvent.mutate(ctx, m)
+	case *TestSessionRegradeMutation:
+		return c.TestSessionRegrade.mutate(ctx, m)
+	case *TestSessionTimeExtensionMutation:
+		return c.TestSessionTimeExtension.mutate(ctx, m)
 	case *TodoMutation:
 		return c.Todo.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserAccommodationMutation:
+		return c.UserAccommodation.mutate(ctx, m)
+	case *UserInviteMutation:
+		return c.UserInvite.mutate(ctx, m)
 	case *VideoMutation:
 		return c.Video.mutate(ctx, m)
 	case *VideoQuestionTimestampMutation:
@@ -349,6 +469,253 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccessGrantClient is a client for the AccessGrant schema.
+type AccessGrantClient struct {
+	config
+}
+
+// NewAccessGrantClient returns a client for the AccessGrant from the given config.
+func NewAccessGrantClient(c config) *AccessGrantClient {
+	return &AccessGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accessgrant.Hooks(f(g(h())))`.
+func (c *AccessGrantClient) Use(hooks ...Hook) {
+	c.hooks.AccessGrant = append(c.hooks.AccessGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accessgrant.Intercept(f(g(h())))`.
+func (c *AccessGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessGrant = append(c.inters.AccessGrant, interceptors...)
+}
+
+// Create returns a builder for creating a AccessGrant entity.
+func (c *AccessGrantClient) Create() *AccessGrantCreate {
+	mutation := newAccessGrantMutation(c.config, OpCreate)
+	return &AccessGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessGrant entities.
+func (c *AccessGrantClient) CreateBulk(builders ...*AccessGrantCreate) *AccessGrantCreateBulk {
+	return &AccessGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessGrantClient) MapCreateBulk(slice any, setFunc func(*AccessGrantCreate, int)) *AccessGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessGrantCreateBulk{err: fmt.Errorf("calling to AccessGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessGrant.
+func (c *AccessGrantClient) Update() *AccessGrantUpdate {
+	mutation := newAccessGrantMutation(c.config, OpUpdate)
+	return &AccessGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessGrantClient) UpdateOne(ag *AccessGrant) *AccessGrantUpdateOne {
+	mutation := newAccessGrantMutation(c.config, OpUpdateOne, withAccessGrant(ag))
+	return &AccessGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessGrantClient) UpdateOneID(id uuid.UUID) *AccessGrantUpdateOne {
+	mutation := newAccessGrantMutation(c.config, OpUpdateOne, withAccessGrantID(id))
+	return &AccessGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessGrant.
+func (c *AccessGrantClient) Delete() *AccessGrantDelete {
+	mutation := newAccessGrantMutation(c.config, OpDelete)
+	return &AccessGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessGrantClient) DeleteOne(ag *AccessGrant) *AccessGrantDeleteOne {
+	return c.DeleteOneID(ag.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessGrantClient) DeleteOneID(id uuid.UUID) *AccessGrantDeleteOne {
+	builder := c.Delete().Where(accessgrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessGrant.
+func (c *AccessGrantClient) Query() *AccessGrantQuery {
+	return &AccessGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessGrant entity by its id.
+func (c *AccessGrantClient) Get(ctx context.Context, id uuid.UUID) (*AccessGrant, error) {
+	return c.Query().Where(accessgrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessGrantClient) GetX(ctx context.Context, id uuid.UUID) *AccessGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a AccessGrant.
+func (c *AccessGrantClient) QueryOrganization(ag *AccessGrant) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.OrganizationTable, accessgrant.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a AccessGrant.
+func (c *AccessGrantClient) QueryUser(ag *AccessGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.UserTable, accessgrant.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a AccessGrant.
+func (c *AccessGrantClient) QueryGroup(ag *AccessGrant) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.GroupTable, accessgrant.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTest queries the test edge of a AccessGrant.
+func (c *AccessGrantClient) QueryTest(ag *AccessGrant) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.TestTable, accessgrant.TestColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a AccessGrant.
+func (c *AccessGrantClient) QueryCourse(ag *AccessGrant) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.CourseTable, accessgrant.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionCollection queries the question_collection edge of a AccessGrant.
+func (c *AccessGrantClient) QueryQuestionCollection(ag *AccessGrant) *QuestionCollectionQuery {
+	query := (&QuestionCollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.QuestionCollectionTable, accessgrant.QuestionCollectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrantedBy queries the granted_by edge of a AccessGrant.
+func (c *AccessGrantClient) QueryGrantedBy(ag *AccessGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ag.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessgrant.Table, accessgrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessgrant.GrantedByTable, accessgrant.GrantedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(ag.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccessGrantClient) Hooks() []Hook {
+	hooks := c.hooks.AccessGrant
+	return append(hooks[:len(hooks):len(hooks)], accessgrant.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessGrantClient) Interceptors() []Interceptor {
+	inters := c.inters.AccessGrant
+	return append(inters[:len(inters):len(inters)], accessgrant.Interceptors[:]...)
+}
+
+func (c *AccessGrantClient) mutate(ctx context.Context, m *AccessGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessGrant mutation op: %q", m.Op())
+	}
+}
+
 // CourseClient is a client for the Course schema.
 type CourseClient struct {
 	config
@@ -457,6 +824,22 @@ func (c *CourseClient) GetX(ctx context.Context, id uuid.UUID) *Course {
 	return obj
 }
 
+// QueryOrganization queries the organization edge of a Course.
+func (c *CourseClient) QueryOrganization(co *Course) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, course.OrganizationTable, course.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryMedia queries the media edge of a Course.
 func (c *CourseClient) QueryMedia(co *Course) *MediaQuery {
 	query := (&MediaClient{config: c.config}).Query()
@@ -537,6 +920,38 @@ func (c *CourseClient) QueryTests(co *Course) *TestQuery {
 	return query
 }
 
+// QueryEnrollments queries the enrollments edge of a Course.
+func (c *CourseClient) QueryEnrollments(co *Course) *CourseEnrollmentQuery {
+	query := (&CourseEnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(courseenrollment.Table, courseenrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessGrants queries the access_grants edge of a Course.
+func (c *CourseClient) QueryAccessGrants(co *Course) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.AccessGrantsTable, course.AccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CourseClient) Hooks() []Hook {
 	hooks := c.hooks.Course
@@ -564,107 +979,107 @@ func (c *CourseClient) mutate(ctx context.Context, m *CourseMutation) (Value, er
 	}
 }
 
-// CourseSectionClient is a client for the CourseSection schema.
-type CourseSectionClient struct {
+// CourseEnrollmentClient is a client for the CourseEnrollment schema.
+type CourseEnrollmentClient struct {
 	config
 }
 
-// NewCourseSectionClient returns a client for the CourseSection from the given config.
-func NewCourseSectionClient(c config) *CourseSectionClient {
-	return &CourseSectionClient{config: c}
+// NewCourseEnrollmentClient returns a client for the CourseEnrollment from the given config.
+func NewCourseEnrollmentClient(c config) *CourseEnrollmentClient {
+	return &CourseEnrollmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `coursesection.Hooks(f(g(h())))`.
-func (c *CourseSectionClient) Use(hooks ...Hook) {
-	c.hooks.CourseSection = append(c.hooks.CourseSection, hooks...)
+// A call to `Use(f, g, h)` equals to `courseenrollment.Hooks(f(g(h())))`.
+func (c *CourseEnrollmentClient) Use(hooks ...Hook) {
+	c.hooks.CourseEnrollment = append(c.hooks.CourseEnrollment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `coursesection.Intercept(f(g(h())))`.
-func (c *CourseSectionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CourseSection = append(c.inters.CourseSection, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `courseenrollment.Intercept(f(g(h())))`.
+func (c *CourseEnrollmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CourseEnrollment = append(c.inters.CourseEnrollment, interceptors...)
 }
 
-// Create returns a builder for creating a CourseSection entity.
-func (c *CourseSectionClient) Create() *CourseSectionCreate {
-	mutation := newCourseSectionMutation(c.config, OpCreate)
-	return &CourseSectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CourseEnrollment entity.
+func (c *CourseEnrollmentClient) Create() *CourseEnrollmentCreate {
+	mutation := newCourseEnrollmentMutation(c.config, OpCreate)
+	return &CourseEnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CourseSection entities.
-func (c *CourseSectionClient) CreateBulk(builders ...*CourseSectionCreate) *CourseSectionCreateBulk {
-	return &CourseSectionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CourseEnrollment entities.
+func (c *CourseEnrollmentClient) CreateBulk(builders ...*CourseEnrollmentCreate) *CourseEnrollmentCreateBulk {
+	return &CourseEnrollmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CourseSectionClient) MapCreateBulk(slice any, setFunc func(*CourseSectionCreate, int)) *CourseSectionCreateBulk {
+func (c *CourseEnrollmentClient) MapCreateBulk(slice any, setFunc func(*CourseEnrollmentCreate, int)) *CourseEnrollmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CourseSectionCreateBulk{err: fmt.Errorf("calling to CourseSectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CourseEnrollmentCreateBulk{err: fmt.Errorf("calling to CourseEnrollmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CourseSectionCreate, rv.Len())
+	builders := make([]*CourseEnrollmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CourseSectionCreateBulk{config: c.config, builders: builders}
+	return &CourseEnrollmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CourseSection.
-func (c *CourseSectionClient) Update() *CourseSectionUpdate {
-	mutation := newCourseSectionMutation(c.config, OpUpdate)
-	return &CourseSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CourseEnrollment.
+func (c *CourseEnrollmentClient) Update() *CourseEnrollmentUpdate {
+	mutation := newCourseEnrollmentMutation(c.config, OpUpdate)
+	return &CourseEnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CourseSectionClient) UpdateOne(cs *CourseSection) *CourseSectionUpdateOne {
-	mutation := newCourseSectionMutation(c.config, OpUpdateOne, withCourseSection(cs))
-	return &CourseSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseEnrollmentClient) UpdateOne(ce *CourseEnrollment) *CourseEnrollmentUpdateOne {
+	mutation := newCourseEnrollmentMutation(c.config, OpUpdateOne, withCourseEnrollment(ce))
+	return &CourseEnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CourseSectionClient) UpdateOneID(id uuid.UUID) *CourseSectionUpdateOne {
-	mutation := newCourseSectionMutation(c.config, OpUpdateOne, withCourseSectionID(id))
-	return &CourseSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseEnrollmentClient) UpdateOneID(id uuid.UUID) *CourseEnrollmentUpdateOne {
+	mutation := newCourseEnrollmentMutation(c.config, OpUpdateOne, withCourseEnrollmentID(id))
+	return &CourseEnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CourseSection.
-func (c *CourseSectionClient) Delete() *CourseSectionDelete {
-	mutation := newCourseSectionMutation(c.config, OpDelete)
-	return &CourseSectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CourseEnrollment.
+func (c *CourseEnrollmentClient) Delete() *CourseEnrollmentDelete {
+	mutation := newCourseEnrollmentMutation(c.config, OpDelete)
+	return &CourseEnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CourseSectionClient) DeleteOne(cs *CourseSection) *CourseSectionDeleteOne {
-	return c.DeleteOneID(cs.ID)
+func (c *CourseEnrollmentClient) DeleteOne(ce *CourseEnrollment) *CourseEnrollmentDeleteOne {
+	return c.DeleteOneID(ce.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CourseSectionClient) DeleteOneID(id uuid.UUID) *CourseSectionDeleteOne {
-	builder := c.Delete().Where(coursesection.ID(id))
+func (c *CourseEnrollmentClient) DeleteOneID(id uuid.UUID) *CourseEnrollmentDeleteOne {
+	builder := c.Delete().Where(courseenrollment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CourseSectionDeleteOne{builder}
+	return &CourseEnrollmentDeleteOne{builder}
 }
 
-// Query returns a query builder for CourseSection.
-func (c *CourseSectionClient) Query() *CourseSectionQuery {
-	return &CourseSectionQuery{
+// Query returns a query builder for CourseEnrollment.
+func (c *CourseEnrollmentClient) Query() *CourseEnrollmentQuery {
+	return &CourseEnrollmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCourseSection},
+		ctx:    &QueryContext{Type: TypeCourseEnrollment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CourseSection entity by its id.
-func (c *CourseSectionClient) Get(ctx context.Context, id uuid.UUID) (*CourseSection, error) {
-	return c.Query().Where(coursesection.ID(id)).Only(ctx)
+// Get returns a CourseEnrollment entity by its id.
+func (c *CourseEnrollmentClient) Get(ctx context.Context, id uuid.UUID) (*CourseEnrollment, error) {
+	return c.Query().Where(courseenrollment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CourseSectionClient) GetX(ctx context.Context, id uuid.UUID) *CourseSection {
+func (c *CourseEnrollmentClient) GetX(ctx context.Context, id uuid.UUID) *CourseEnrollment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -672,57 +1087,224 @@ func (c *CourseSectionClient) GetX(ctx context.Context, id uuid.UUID) *CourseSec
 	return obj
 }
 
-// QueryCourse queries the course edge of a CourseSection.
-func (c *CourseSectionClient) QueryCourse(cs *CourseSection) *CourseQuery {
+// QueryCourse queries the course edge of a CourseEnrollment.
+func (c *CourseEnrollmentClient) QueryCourse(ce *CourseEnrollment) *CourseQuery {
 	query := (&CourseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := cs.ID
+		id := ce.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.From(courseenrollment.Table, courseenrollment.FieldID, id),
 			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, coursesection.CourseTable, coursesection.CourseColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, courseenrollment.CourseTable, courseenrollment.CourseColumn),
 		)
-		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryParent queries the parent edge of a CourseSection.
-func (c *CourseSectionClient) QueryParent(cs *CourseSection) *CourseSectionQuery {
-	query := (&CourseSectionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a CourseEnrollment.
+func (c *CourseEnrollmentClient) QueryUser(ce *CourseEnrollment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := cs.ID
+		id := ce.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
-			sqlgraph.To(coursesection.Table, coursesection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, coursesection.ParentTable, coursesection.ParentColumn),
+			sqlgraph.From(courseenrollment.Table, courseenrollment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, courseenrollment.UserTable, courseenrollment.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryChildren queries the children edge of a CourseSection.
-func (c *CourseSectionClient) QueryChildren(cs *CourseSection) *CourseSectionQuery {
-	query := (&CourseSectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := cs.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
-			sqlgraph.To(coursesection.Table, coursesection.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.ChildrenTable, coursesection.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *CourseEnrollmentClient) Hooks() []Hook {
+	hooks := c.hooks.CourseEnrollment
+	return append(hooks[:len(hooks):len(hooks)], courseenrollment.Hooks[:]...)
 }
 
-// QueryCourseSectionVideos queries the course_section_videos edge of a CourseSection.
-func (c *CourseSectionClient) QueryCourseSectionVideos(cs *CourseSection) *VideoQuery {
-	query := (&VideoClient{config: c.config}).Query()
+// Interceptors returns the client interceptors.
+func (c *CourseEnrollmentClient) Interceptors() []Interceptor {
+	inters := c.inters.CourseEnrollment
+	return append(inters[:len(inters):len(inters)], courseenrollment.Interceptors[:]...)
+}
+
+func (c *CourseEnrollmentClient) mutate(ctx context.Context, m *CourseEnrollmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CourseEnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CourseEnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CourseEnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CourseEnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CourseEnrollment mutation op: %q", m.Op())
+	}
+}
+
+// CourseSectionClient is a client for the CourseSection schema.
+type CourseSectionClient struct {
+	config
+}
+
+// NewCourseSectionClient returns a client for the CourseSection from the given config.
+func NewCourseSectionClient(c config) *CourseSectionClient {
+	return &CourseSectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coursesection.Hooks(f(g(h())))`.
+func (c *CourseSectionClient) Use(hooks ...Hook) {
+	c.hooks.CourseSection = append(c.hooks.CourseSection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coursesection.Intercept(f(g(h())))`.
+func (c *CourseSectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CourseSection = append(c.inters.CourseSection, interceptors...)
+}
+
+// Create returns a builder for creating a CourseSection entity.
+func (c *CourseSectionClient) Create() *CourseSectionCreate {
+	mutation := newCourseSectionMutation(c.config, OpCreate)
+	return &CourseSectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CourseSection entities.
+func (c *CourseSectionClient) CreateBulk(builders ...*CourseSectionCreate) *CourseSectionCreateBulk {
+	return &CourseSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CourseSectionClient) MapCreateBulk(slice any, setFunc func(*CourseSectionCreate, int)) *CourseSectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CourseSectionCreateBulk{err: fmt.Errorf("calling to CourseSectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CourseSectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CourseSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CourseSection.
+func (c *CourseSectionClient) Update() *CourseSectionUpdate {
+	mutation := newCourseSectionMutation(c.config, OpUpdate)
+	return &CourseSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CourseSectionClient) UpdateOne(cs *CourseSection) *CourseSectionUpdateOne {
+	mutation := newCourseSectionMutation(c.config, OpUpdateOne, withCourseSection(cs))
+	return &CourseSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CourseSectionClient) UpdateOneID(id uuid.UUID) *CourseSectionUpdateOne {
+	mutation := newCourseSectionMutation(c.config, OpUpdateOne, withCourseSectionID(id))
+	return &CourseSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CourseSection.
+func (c *CourseSectionClient) Delete() *CourseSectionDelete {
+	mutation := newCourseSectionMutation(c.config, OpDelete)
+	return &CourseSectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CourseSectionClient) DeleteOne(cs *CourseSection) *CourseSectionDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CourseSectionClient) DeleteOneID(id uuid.UUID) *CourseSectionDeleteOne {
+	builder := c.Delete().Where(coursesection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CourseSectionDeleteOne{builder}
+}
+
+// Query returns a query builder for CourseSection.
+func (c *CourseSectionClient) Query() *CourseSectionQuery {
+	return &CourseSectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCourseSection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CourseSection entity by its id.
+func (c *CourseSectionClient) Get(ctx context.Context, id uuid.UUID) (*CourseSection, error) {
+	return c.Query().Where(coursesection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CourseSectionClient) GetX(ctx context.Context, id uuid.UUID) *CourseSection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a CourseSection.
+func (c *CourseSectionClient) QueryCourse(cs *CourseSection) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesection.CourseTable, coursesection.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a CourseSection.
+func (c *CourseSectionClient) QueryParent(cs *CourseSection) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesection.ParentTable, coursesection.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a CourseSection.
+func (c *CourseSectionClient) QueryChildren(cs *CourseSection) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.ChildrenTable, coursesection.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourseSectionVideos queries the course_section_videos edge of a CourseSection.
+func (c *CourseSectionClient) QueryCourseSectionVideos(cs *CourseSection) *VideoQuery {
+	query := (&VideoClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := cs.ID
 		step := sqlgraph.NewStep(
@@ -784,6 +1366,38 @@ func (c *CourseSectionClient) QueryTests(cs *CourseSection) *TestQuery {
 	return query
 }
 
+// QueryPrerequisites queries the prerequisites edge of a CourseSection.
+func (c *CourseSectionClient) QueryPrerequisites(cs *CourseSection) *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionPrerequisiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.To(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.PrerequisitesTable, coursesection.PrerequisitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequiredBy queries the required_by edge of a CourseSection.
+func (c *CourseSectionClient) QueryRequiredBy(cs *CourseSection) *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionPrerequisiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, id),
+			sqlgraph.To(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.RequiredByTable, coursesection.RequiredByColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CourseSectionClient) Hooks() []Hook {
 	hooks := c.hooks.CourseSection
@@ -811,107 +1425,107 @@ func (c *CourseSectionClient) mutate(ctx context.Context, m *CourseSectionMutati
 	}
 }
 
-// JwtTokenClient is a client for the JwtToken schema.
-type JwtTokenClient struct {
+// CourseSectionPrerequisiteClient is a client for the CourseSectionPrerequisite schema.
+type CourseSectionPrerequisiteClient struct {
 	config
 }
 
-// NewJwtTokenClient returns a client for the JwtToken from the given config.
-func NewJwtTokenClient(c config) *JwtTokenClient {
-	return &JwtTokenClient{config: c}
+// NewCourseSectionPrerequisiteClient returns a client for the CourseSectionPrerequisite from the given config.
+func NewCourseSectionPrerequisiteClient(c config) *CourseSectionPrerequisiteClient {
+	return &CourseSectionPrerequisiteClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `jwttoken.Hooks(f(g(h())))`.
-func (c *JwtTokenClient) Use(hooks ...Hook) {
-	c.hooks.JwtToken = append(c.hooks.JwtToken, hooks...)
+// A call to `Use(f, g, h)` equals to `coursesectionprerequisite.Hooks(f(g(h())))`.
+func (c *CourseSectionPrerequisiteClient) Use(hooks ...Hook) {
+	c.hooks.CourseSectionPrerequisite = append(c.hooks.CourseSectionPrerequisite, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `jwttoken.Intercept(f(g(h())))`.
-func (c *JwtTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.JwtToken = append(c.inters.JwtToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coursesectionprerequisite.Intercept(f(g(h())))`.
+func (c *CourseSectionPrerequisiteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CourseSectionPrerequisite = append(c.inters.CourseSectionPrerequisite, interceptors...)
 }
 
-// Create returns a builder for creating a JwtToken entity.
-func (c *JwtTokenClient) Create() *JwtTokenCreate {
-	mutation := newJwtTokenMutation(c.config, OpCreate)
-	return &JwtTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CourseSectionPrerequisite entity.
+func (c *CourseSectionPrerequisiteClient) Create() *CourseSectionPrerequisiteCreate {
+	mutation := newCourseSectionPrerequisiteMutation(c.config, OpCreate)
+	return &CourseSectionPrerequisiteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of JwtToken entities.
-func (c *JwtTokenClient) CreateBulk(builders ...*JwtTokenCreate) *JwtTokenCreateBulk {
-	return &JwtTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CourseSectionPrerequisite entities.
+func (c *CourseSectionPrerequisiteClient) CreateBulk(builders ...*CourseSectionPrerequisiteCreate) *CourseSectionPrerequisiteCreateBulk {
+	return &CourseSectionPrerequisiteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *JwtTokenClient) MapCreateBulk(slice any, setFunc func(*JwtTokenCreate, int)) *JwtTokenCreateBulk {
+func (c *CourseSectionPrerequisiteClient) MapCreateBulk(slice any, setFunc func(*CourseSectionPrerequisiteCreate, int)) *CourseSectionPrerequisiteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &JwtTokenCreateBulk{err: fmt.Errorf("calling to JwtTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CourseSectionPrerequisiteCreateBulk{err: fmt.Errorf("calling to CourseSectionPrerequisiteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*JwtTokenCreate, rv.Len())
+	builders := make([]*CourseSectionPrerequisiteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &JwtTokenCreateBulk{config: c.config, builders: builders}
+	return &CourseSectionPrerequisiteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for JwtToken.
-func (c *JwtTokenClient) Update() *JwtTokenUpdate {
-	mutation := newJwtTokenMutation(c.config, OpUpdate)
-	return &JwtTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CourseSectionPrerequisite.
+func (c *CourseSectionPrerequisiteClient) Update() *CourseSectionPrerequisiteUpdate {
+	mutation := newCourseSectionPrerequisiteMutation(c.config, OpUpdate)
+	return &CourseSectionPrerequisiteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *JwtTokenClient) UpdateOne(jt *JwtToken) *JwtTokenUpdateOne {
-	mutation := newJwtTokenMutation(c.config, OpUpdateOne, withJwtToken(jt))
-	return &JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseSectionPrerequisiteClient) UpdateOne(csp *CourseSectionPrerequisite) *CourseSectionPrerequisiteUpdateOne {
+	mutation := newCourseSectionPrerequisiteMutation(c.config, OpUpdateOne, withCourseSectionPrerequisite(csp))
+	return &CourseSectionPrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *JwtTokenClient) UpdateOneID(id uuid.UUID) *JwtTokenUpdateOne {
-	mutation := newJwtTokenMutation(c.config, OpUpdateOne, withJwtTokenID(id))
-	return &JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseSectionPrerequisiteClient) UpdateOneID(id uuid.UUID) *CourseSectionPrerequisiteUpdateOne {
+	mutation := newCourseSectionPrerequisiteMutation(c.config, OpUpdateOne, withCourseSectionPrerequisiteID(id))
+	return &CourseSectionPrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for JwtToken.
-func (c *JwtTokenClient) Delete() *JwtTokenDelete {
-	mutation := newJwtTokenMutation(c.config, OpDelete)
-	return &JwtTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CourseSectionPrerequisite.
+func (c *CourseSectionPrerequisiteClient) Delete() *CourseSectionPrerequisiteDelete {
+	mutation := newCourseSectionPrerequisiteMutation(c.config, OpDelete)
+	return &CourseSectionPrerequisiteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *JwtTokenClient) DeleteOne(jt *JwtToken) *JwtTokenDeleteOne {
-	return c.DeleteOneID(jt.ID)
+func (c *CourseSectionPrerequisiteClient) DeleteOne(csp *CourseSectionPrerequisite) *CourseSectionPrerequisiteDeleteOne {
+	return c.DeleteOneID(csp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *JwtTokenClient) DeleteOneID(id uuid.UUID) *JwtTokenDeleteOne {
-	builder := c.Delete().Where(jwttoken.ID(id))
+func (c *CourseSectionPrerequisiteClient) DeleteOneID(id uuid.UUID) *CourseSectionPrerequisiteDeleteOne {
+	builder := c.Delete().Where(coursesectionprerequisite.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &JwtTokenDeleteOne{builder}
+	return &CourseSectionPrerequisiteDeleteOne{builder}
 }
 
-// Query returns a query builder for JwtToken.
-func (c *JwtTokenClient) Query() *JwtTokenQuery {
-	return &JwtTokenQuery{
+// Query returns a query builder for CourseSectionPrerequisite.
+func (c *CourseSectionPrerequisiteClient) Query() *CourseSectionPrerequisiteQuery {
+	return &CourseSectionPrerequisiteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeJwtToken},
+		ctx:    &QueryContext{Type: TypeCourseSectionPrerequisite},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a JwtToken entity by its id.
-func (c *JwtTokenClient) Get(ctx context.Context, id uuid.UUID) (*JwtToken, error) {
-	return c.Query().Where(jwttoken.ID(id)).Only(ctx)
+// Get returns a CourseSectionPrerequisite entity by its id.
+func (c *CourseSectionPrerequisiteClient) Get(ctx context.Context, id uuid.UUID) (*CourseSectionPrerequisite, error) {
+	return c.Query().Where(coursesectionprerequisite.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *JwtTokenClient) GetX(ctx context.Context, id uuid.UUID) *JwtToken {
+func (c *CourseSectionPrerequisiteClient) GetX(ctx context.Context, id uuid.UUID) *CourseSectionPrerequisite {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -919,150 +1533,166 @@ func (c *JwtTokenClient) GetX(ctx context.Context, id uuid.UUID) *JwtToken {
 	return obj
 }
 
-// QueryUser queries the user edge of a JwtToken.
-func (c *JwtTokenClient) QueryUser(jt *JwtToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QuerySection queries the section edge of a CourseSectionPrerequisite.
+func (c *CourseSectionPrerequisiteClient) QuerySection(csp *CourseSectionPrerequisite) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := jt.ID
+		id := csp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(jwttoken.Table, jwttoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, jwttoken.UserTable, jwttoken.UserColumn),
+			sqlgraph.From(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesectionprerequisite.SectionTable, coursesectionprerequisite.SectionColumn),
 		)
-		fromV = sqlgraph.Neighbors(jt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(csp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *JwtTokenClient) Hooks() []Hook {
-	hooks := c.hooks.JwtToken
-	return append(hooks[:len(hooks):len(hooks)], jwttoken.Hooks[:]...)
+// QueryPrerequisiteSection queries the prerequisite_section edge of a CourseSectionPrerequisite.
+func (c *CourseSectionPrerequisiteClient) QueryPrerequisiteSection(csp *CourseSectionPrerequisite) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := csp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesectionprerequisite.PrerequisiteSectionTable, coursesectionprerequisite.PrerequisiteSectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(csp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CourseSectionPrerequisiteClient) Hooks() []Hook {
+	hooks := c.hooks.CourseSectionPrerequisite
+	return append(hooks[:len(hooks):len(hooks)], coursesectionprerequisite.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *JwtTokenClient) Interceptors() []Interceptor {
-	inters := c.inters.JwtToken
-	return append(inters[:len(inters):len(inters)], jwttoken.Interceptors[:]...)
+func (c *CourseSectionPrerequisiteClient) Interceptors() []Interceptor {
+	inters := c.inters.CourseSectionPrerequisite
+	return append(inters[:len(inters):len(inters)], coursesectionprerequisite.Interceptors[:]...)
 }
 
-func (c *JwtTokenClient) mutate(ctx context.Context, m *JwtTokenMutation) (Value, error) {
+func (c *CourseSectionPrerequisiteClient) mutate(ctx context.Context, m *CourseSectionPrerequisiteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&JwtTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseSectionPrerequisiteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&JwtTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseSectionPrerequisiteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseSectionPrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&JwtTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CourseSectionPrerequisiteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown JwtToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CourseSectionPrerequisite mutation op: %q", m.Op())
 	}
 }
 
-// MediaClient is a client for the Media schema.
-type MediaClient struct {
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
 	config
 }
 
-// NewMediaClient returns a client for the Media from the given config.
-func NewMediaClient(c config) *MediaClient {
-	return &MediaClient{config: c}
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
-func (c *MediaClient) Use(hooks ...Hook) {
-	c.hooks.Media = append(c.hooks.Media, hooks...)
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
-func (c *MediaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Media = append(c.inters.Media, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
 }
 
-// Create returns a builder for creating a Media entity.
-func (c *MediaClient) Create() *MediaCreate {
-	mutation := newMediaMutation(c.config, OpCreate)
-	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Media entities.
-func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
-	return &MediaCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaCreate, rv.Len())
+	builders := make([]*GroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaCreateBulk{config: c.config, builders: builders}
+	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Media.
-func (c *MediaClient) Update() *MediaUpdate {
-	mutation := newMediaMutation(c.config, OpUpdate)
-	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaClient) UpdateOneID(id uuid.UUID) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupClient) UpdateOneID(id uuid.UUID) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Media.
-func (c *MediaClient) Delete() *MediaDelete {
-	mutation := newMediaMutation(c.config, OpDelete)
-	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
+	return c.DeleteOneID(gr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaClient) DeleteOneID(id uuid.UUID) *MediaDeleteOne {
-	builder := c.Delete().Where(media.ID(id))
+func (c *GroupClient) DeleteOneID(id uuid.UUID) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaDeleteOne{builder}
+	return &GroupDeleteOne{builder}
 }
 
-// Query returns a query builder for Media.
-func (c *MediaClient) Query() *MediaQuery {
-	return &MediaQuery{
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMedia},
+		ctx:    &QueryContext{Type: TypeGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Media entity by its id.
-func (c *MediaClient) Get(ctx context.Context, id uuid.UUID) (*Media, error) {
-	return c.Query().Where(media.ID(id)).Only(ctx)
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id uuid.UUID) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
+func (c *GroupClient) GetX(ctx context.Context, id uuid.UUID) *Group {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1070,198 +1700,214 @@ func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
 	return obj
 }
 
-// QueryUserMedia queries the user_media edge of a Media.
-func (c *MediaClient) QueryUserMedia(m *Media) *UserQuery {
+// QueryOrganization queries the organization edge of a Group.
+func (c *GroupClient) QueryOrganization(gr *Group) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, group.OrganizationTable, group.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a Group.
+func (c *GroupClient) QueryCreator(gr *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := gr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.From(group.Table, group.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, media.UserMediaTable, media.UserMediaColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, group.CreatorTable, group.CreatorColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryUser queries the user edge of a Media.
-func (c *MediaClient) QueryUser(m *Media) *UserQuery {
+// QueryMembers queries the members edge of a Group.
+func (c *GroupClient) QueryMembers(gr *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := gr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.From(group.Table, group.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, media.UserTable, media.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, group.MembersTable, group.MembersPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCourseMedia queries the course_media edge of a Media.
-func (c *MediaClient) QueryCourseMedia(m *Media) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
+// QueryAccessGrants queries the access_grants edge of a Group.
+func (c *GroupClient) QueryAccessGrants(gr *Group) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := gr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, media.CourseMediaTable, media.CourseMediaColumn),
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.AccessGrantsTable, group.AccessGrantsColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryVideoMedia queries the video_media edge of a Media.
-func (c *MediaClient) QueryVideoMedia(m *Media) *VideoQuery {
-	query := (&VideoClient{config: c.config}).Query()
+// QueryTestAssignments queries the test_assignments edge of a Group.
+func (c *GroupClient) QueryTestAssignments(gr *Group) *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := gr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(video.Table, video.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, media.VideoMediaTable, media.VideoMediaColumn),
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.TestAssignmentsTable, group.TestAssignmentsColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MediaClient) Hooks() []Hook {
-	hooks := c.hooks.Media
-	return append(hooks[:len(hooks):len(hooks)], media.Hooks[:]...)
+func (c *GroupClient) Hooks() []Hook {
+	hooks := c.hooks.Group
+	return append(hooks[:len(hooks):len(hooks)], group.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *MediaClient) Interceptors() []Interceptor {
-	inters := c.inters.Media
-	return append(inters[:len(inters):len(inters)], media.Interceptors[:]...)
+func (c *GroupClient) Interceptors() []Interceptor {
+	inters := c.inters.Group
+	return append(inters[:len(inters):len(inters)], group.Interceptors[:]...)
 }
 
-func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
 	}
 }
 
-// PermissionClient is a client for the Permission schema.
-type PermissionClient struct {
+// GroupTestAssignmentClient is a client for the GroupTestAssignment schema.
+type GroupTestAssignmentClient struct {
 	config
 }
 
-// NewPermissionClient returns a client for the Permission from the given config.
-func NewPermissionClient(c config) *PermissionClient {
-	return &PermissionClient{config: c}
+// NewGroupTestAssignmentClient returns a client for the GroupTestAssignment from the given config.
+func NewGroupTestAssignmentClient(c config) *GroupTestAssignmentClient {
+	return &GroupTestAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
-func (c *PermissionClient) Use(hooks ...Hook) {
-	c.hooks.Permission = append(c.hooks.Permission, hooks...)
+// A call to `Use(f, g, h)` equals to `grouptestassignment.Hooks(f(g(h())))`.
+func (c *GroupTestAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.GroupTestAssignment = append(c.hooks.GroupTestAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
-func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Permission = append(c.inters.Permission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `grouptestassignment.Intercept(f(g(h())))`.
+func (c *GroupTestAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupTestAssignment = append(c.inters.GroupTestAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a Permission entity.
-func (c *PermissionClient) Create() *PermissionCreate {
-	mutation := newPermissionMutation(c.config, OpCreate)
-	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupTestAssignment entity.
+func (c *GroupTestAssignmentClient) Create() *GroupTestAssignmentCreate {
+	mutation := newGroupTestAssignmentMutation(c.config, OpCreate)
+	return &GroupTestAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Permission entities.
-func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupTestAssignment entities.
+func (c *GroupTestAssignmentClient) CreateBulk(builders ...*GroupTestAssignmentCreate) *GroupTestAssignmentCreateBulk {
+	return &GroupTestAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
+func (c *GroupTestAssignmentClient) MapCreateBulk(slice any, setFunc func(*GroupTestAssignmentCreate, int)) *GroupTestAssignmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupTestAssignmentCreateBulk{err: fmt.Errorf("calling to GroupTestAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PermissionCreate, rv.Len())
+	builders := make([]*GroupTestAssignmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PermissionCreateBulk{config: c.config, builders: builders}
+	return &GroupTestAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Permission.
-func (c *PermissionClient) Update() *PermissionUpdate {
-	mutation := newPermissionMutation(c.config, OpUpdate)
-	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupTestAssignment.
+func (c *GroupTestAssignmentClient) Update() *GroupTestAssignmentUpdate {
+	mutation := newGroupTestAssignmentMutation(c.config, OpUpdate)
+	return &GroupTestAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PermissionClient) UpdateOne(pe *Permission) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(pe))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupTestAssignmentClient) UpdateOne(gta *GroupTestAssignment) *GroupTestAssignmentUpdateOne {
+	mutation := newGroupTestAssignmentMutation(c.config, OpUpdateOne, withGroupTestAssignment(gta))
+	return &GroupTestAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
-	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
-	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupTestAssignmentClient) UpdateOneID(id uuid.UUID) *GroupTestAssignmentUpdateOne {
+	mutation := newGroupTestAssignmentMutation(c.config, OpUpdateOne, withGroupTestAssignmentID(id))
+	return &GroupTestAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Permission.
-func (c *PermissionClient) Delete() *PermissionDelete {
-	mutation := newPermissionMutation(c.config, OpDelete)
-	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupTestAssignment.
+func (c *GroupTestAssignmentClient) Delete() *GroupTestAssignmentDelete {
+	mutation := newGroupTestAssignmentMutation(c.config, OpDelete)
+	return &GroupTestAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PermissionClient) DeleteOne(pe *Permission) *PermissionDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *GroupTestAssignmentClient) DeleteOne(gta *GroupTestAssignment) *GroupTestAssignmentDeleteOne {
+	return c.DeleteOneID(gta.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
-	builder := c.Delete().Where(permission.ID(id))
+func (c *GroupTestAssignmentClient) DeleteOneID(id uuid.UUID) *GroupTestAssignmentDeleteOne {
+	builder := c.Delete().Where(grouptestassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PermissionDeleteOne{builder}
+	return &GroupTestAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for Permission.
-func (c *PermissionClient) Query() *PermissionQuery {
-	return &PermissionQuery{
+// Query returns a query builder for GroupTestAssignment.
+func (c *GroupTestAssignmentClient) Query() *GroupTestAssignmentQuery {
+	return &GroupTestAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePermission},
+		ctx:    &QueryContext{Type: TypeGroupTestAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Permission entity by its id.
-func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
-	return c.Query().Where(permission.ID(id)).Only(ctx)
+// Get returns a GroupTestAssignment entity by its id.
+func (c *GroupTestAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*GroupTestAssignment, error) {
+	return c.Query().Where(grouptestassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
+func (c *GroupTestAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *GroupTestAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1269,150 +1915,198 @@ func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
 	return obj
 }
 
-// QueryRoles queries the roles edge of a Permission.
-func (c *PermissionClient) QueryRoles(pe *Permission) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
+// QueryGroup queries the group edge of a GroupTestAssignment.
+func (c *GroupTestAssignmentClient) QueryGroup(gta *GroupTestAssignment) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
+		id := gta.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(permission.Table, permission.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, permission.RolesTable, permission.RolesPrimaryKey...),
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.GroupTable, grouptestassignment.GroupColumn),
 		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTest queries the test edge of a GroupTestAssignment.
+func (c *GroupTestAssignmentClient) QueryTest(gta *GroupTestAssignment) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.TestTable, grouptestassignment.TestColumn),
+		)
+		fromV = sqlgraph.Neighbors(gta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedBy queries the assigned_by edge of a GroupTestAssignment.
+func (c *GroupTestAssignmentClient) QueryAssignedBy(gta *GroupTestAssignment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.AssignedByTable, grouptestassignment.AssignedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(gta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessions queries the test_sessions edge of a GroupTestAssignment.
+func (c *GroupTestAssignmentClient) QueryTestSessions(gta *GroupTestAssignment) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grouptestassignment.TestSessionsTable, grouptestassignment.TestSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gta.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PermissionClient) Hooks() []Hook {
-	hooks := c.hooks.Permission
-	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
+func (c *GroupTestAssignmentClient) Hooks() []Hook {
+	hooks := c.hooks.GroupTestAssignment
+	return append(hooks[:len(hooks):len(hooks)], grouptestassignment.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PermissionClient) Interceptors() []Interceptor {
-	inters := c.inters.Permission
-	return append(inters[:len(inters):len(inters)], permission.Interceptors[:]...)
+func (c *GroupTestAssignmentClient) Interceptors() []Interceptor {
+	inters := c.inters.GroupTestAssignment
+	return append(inters[:len(inters):len(inters)], grouptestassignment.Interceptors[:]...)
 }
 
-func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
+func (c *GroupTestAssignmentClient) mutate(ctx context.Context, m *GroupTestAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupTestAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupTestAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupTestAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupTestAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown GroupTestAssignment mutation op: %q", m.Op())
 	}
 }
 
-// QuestionClient is a client for the Question schema.
-type QuestionClient struct {
+// JwtTokenClient is a client for the JwtToken schema.
+type JwtTokenClient struct {
 	config
 }
 
-// NewQuestionClient returns a client for the Question from the given config.
-func NewQuestionClient(c config) *QuestionClient {
-	return &QuestionClient{config: c}
+// NewJwtTokenClient returns a client for the JwtToken from the given config.
+func NewJwtTokenClient(c config) *JwtTokenClient {
+	return &JwtTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
-func (c *QuestionClient) Use(hooks ...Hook) {
-	c.hooks.Question = append(c.hooks.Question, hooks...)
+// A call to `Use(f, g, h)` equals to `jwttoken.Hooks(f(g(h())))`.
+func (c *JwtTokenClient) Use(hooks ...Hook) {
+	c.hooks.JwtToken = append(c.hooks.JwtToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
-func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Question = append(c.inters.Question, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `jwttoken.Intercept(f(g(h())))`.
+func (c *JwtTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JwtToken = append(c.inters.JwtToken, interceptors...)
 }
 
-// Create returns a builder for creating a Question entity.
-func (c *QuestionClient) Create() *QuestionCreate {
-	mutation := newQuestionMutation(c.config, OpCreate)
-	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a JwtToken entity.
+func (c *JwtTokenClient) Create() *JwtTokenCreate {
+	mutation := newJwtTokenMutation(c.config, OpCreate)
+	return &JwtTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Question entities.
-func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
-	return &QuestionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of JwtToken entities.
+func (c *JwtTokenClient) CreateBulk(builders ...*JwtTokenCreate) *JwtTokenCreateBulk {
+	return &JwtTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
+func (c *JwtTokenClient) MapCreateBulk(slice any, setFunc func(*JwtTokenCreate, int)) *JwtTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &JwtTokenCreateBulk{err: fmt.Errorf("calling to JwtTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QuestionCreate, rv.Len())
+	builders := make([]*JwtTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QuestionCreateBulk{config: c.config, builders: builders}
+	return &JwtTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Question.
-func (c *QuestionClient) Update() *QuestionUpdate {
-	mutation := newQuestionMutation(c.config, OpUpdate)
-	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for JwtToken.
+func (c *JwtTokenClient) Update() *JwtTokenUpdate {
+	mutation := newJwtTokenMutation(c.config, OpUpdate)
+	return &JwtTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QuestionClient) UpdateOne(q *Question) *QuestionUpdateOne {
-	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(q))
-	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *JwtTokenClient) UpdateOne(jt *JwtToken) *JwtTokenUpdateOne {
+	mutation := newJwtTokenMutation(c.config, OpUpdateOne, withJwtToken(jt))
+	return &JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QuestionClient) UpdateOneID(id uuid.UUID) *QuestionUpdateOne {
-	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
-	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *JwtTokenClient) UpdateOneID(id uuid.UUID) *JwtTokenUpdateOne {
+	mutation := newJwtTokenMutation(c.config, OpUpdateOne, withJwtTokenID(id))
+	return &JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Question.
-func (c *QuestionClient) Delete() *QuestionDelete {
-	mutation := newQuestionMutation(c.config, OpDelete)
-	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for JwtToken.
+func (c *JwtTokenClient) Delete() *JwtTokenDelete {
+	mutation := newJwtTokenMutation(c.config, OpDelete)
+	return &JwtTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QuestionClient) DeleteOne(q *Question) *QuestionDeleteOne {
-	return c.DeleteOneID(q.ID)
+func (c *JwtTokenClient) DeleteOne(jt *JwtToken) *JwtTokenDeleteOne {
+	return c.DeleteOneID(jt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionClient) DeleteOneID(id uuid.UUID) *QuestionDeleteOne {
-	builder := c.Delete().Where(question.ID(id))
+func (c *JwtTokenClient) DeleteOneID(id uuid.UUID) *JwtTokenDeleteOne {
+	builder := c.Delete().Where(jwttoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionDeleteOne{builder}
+	return &JwtTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for Question.
-func (c *QuestionClient) Query() *QuestionQuery {
-	return &QuestionQuery{
+// Query returns a query builder for JwtToken.
+func (c *JwtTokenClient) Query() *JwtTokenQuery {
+	return &JwtTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestion},
+		ctx:    &QueryContext{Type: TypeJwtToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Question entity by its id.
-func (c *QuestionClient) Get(ctx context.Context, id uuid.UUID) (*Question, error) {
-	return c.Query().Where(question.ID(id)).Only(ctx)
+// Get returns a JwtToken entity by its id.
+func (c *JwtTokenClient) Get(ctx context.Context, id uuid.UUID) (*JwtToken, error) {
+	return c.Query().Where(jwttoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionClient) GetX(ctx context.Context, id uuid.UUID) *Question {
+func (c *JwtTokenClient) GetX(ctx context.Context, id uuid.UUID) *JwtToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1420,214 +2114,150 @@ func (c *QuestionClient) GetX(ctx context.Context, id uuid.UUID) *Question {
 	return obj
 }
 
-// QueryCollection queries the collection edge of a Question.
-func (c *QuestionClient) QueryCollection(q *Question) *QuestionCollectionQuery {
-	query := (&QuestionCollectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, question.CollectionTable, question.CollectionColumn),
-		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryQuestionOptions queries the question_options edge of a Question.
-func (c *QuestionClient) QueryQuestionOptions(q *Question) *QuestionOptionQuery {
-	query := (&QuestionOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(questionoption.Table, questionoption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.QuestionOptionsTable, question.QuestionOptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryVideoQuestionTimestampsQuestion queries the video_question_timestamps_question edge of a Question.
-func (c *QuestionClient) QueryVideoQuestionTimestampsQuestion(q *Question) *VideoQuestionTimestampQuery {
-	query := (&VideoQuestionTimestampClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(videoquestiontimestamp.Table, videoquestiontimestamp.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.VideoQuestionTimestampsQuestionTable, question.VideoQuestionTimestampsQuestionColumn),
-		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTestSessionAnswers queries the test_session_answers edge of a Question.
-func (c *QuestionClient) QueryTestSessionAnswers(q *Question) *TestSessionAnswerQuery {
-	query := (&TestSessionAnswerClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(testsessionanswer.Table, testsessionanswer.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.TestSessionAnswersTable, question.TestSessionAnswersColumn),
-		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTestIgnoreQuestions queries the test_ignore_questions edge of a Question.
-func (c *QuestionClient) QueryTestIgnoreQuestions(q *Question) *TestIgnoreQuestionQuery {
-	query := (&TestIgnoreQuestionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a JwtToken.
+func (c *JwtTokenClient) QueryUser(jt *JwtToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
+		id := jt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(testignorequestion.Table, testignorequestion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, question.TestIgnoreQuestionsTable, question.TestIgnoreQuestionsColumn),
+			sqlgraph.From(jwttoken.Table, jwttoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, jwttoken.UserTable, jwttoken.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(jt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionClient) Hooks() []Hook {
-	hooks := c.hooks.Question
-	return append(hooks[:len(hooks):len(hooks)], question.Hooks[:]...)
+func (c *JwtTokenClient) Hooks() []Hook {
+	hooks := c.hooks.JwtToken
+	return append(hooks[:len(hooks):len(hooks)], jwttoken.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionClient) Interceptors() []Interceptor {
-	inters := c.inters.Question
-	return append(inters[:len(inters):len(inters)], question.Interceptors[:]...)
+func (c *JwtTokenClient) Interceptors() []Interceptor {
+	inters := c.inters.JwtToken
+	return append(inters[:len(inters):len(inters)], jwttoken.Interceptors[:]...)
 }
 
-func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+func (c *JwtTokenClient) mutate(ctx context.Context, m *JwtTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JwtTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JwtTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JwtTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&JwtTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown JwtToken mutation op: %q", m.Op())
 	}
 }
 
-// QuestionCollectionClient is a client for the QuestionCollection schema.
-type QuestionCollectionClient struct {
+// MediaClient is a client for the Media schema.
+type MediaClient struct {
 	config
 }
 
-// NewQuestionCollectionClient returns a client for the QuestionCollection from the given config.
-func NewQuestionCollectionClient(c config) *QuestionCollectionClient {
-	return &QuestionCollectionClient{config: c}
+// NewMediaClient returns a client for the Media from the given config.
+func NewMediaClient(c config) *MediaClient {
+	return &MediaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `questioncollection.Hooks(f(g(h())))`.
-func (c *QuestionCollectionClient) Use(hooks ...Hook) {
-	c.hooks.QuestionCollection = append(c.hooks.QuestionCollection, hooks...)
+// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
+func (c *MediaClient) Use(hooks ...Hook) {
+	c.hooks.Media = append(c.hooks.Media, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `questioncollection.Intercept(f(g(h())))`.
-func (c *QuestionCollectionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.QuestionCollection = append(c.inters.QuestionCollection, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
+func (c *MediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Media = append(c.inters.Media, interceptors...)
 }
 
-// Create returns a builder for creating a QuestionCollection entity.
-func (c *QuestionCollectionClient) Create() *QuestionCollectionCreate {
-	mutation := newQuestionCollectionMutation(c.config, OpCreate)
-	return &QuestionCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Media entity.
+func (c *MediaClient) Create() *MediaCreate {
+	mutation := newMediaMutation(c.config, OpCreate)
+	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of QuestionCollection entities.
-func (c *QuestionCollectionClient) CreateBulk(builders ...*QuestionCollectionCreate) *QuestionCollectionCreateBulk {
-	return &QuestionCollectionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Media entities.
+func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
+	return &MediaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QuestionCollectionClient) MapCreateBulk(slice any, setFunc func(*QuestionCollectionCreate, int)) *QuestionCollectionCreateBulk {
+func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QuestionCollectionCreateBulk{err: fmt.Errorf("calling to QuestionCollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QuestionCollectionCreate, rv.Len())
+	builders := make([]*MediaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QuestionCollectionCreateBulk{config: c.config, builders: builders}
+	return &MediaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for QuestionCollection.
-func (c *QuestionCollectionClient) Update() *QuestionCollectionUpdate {
-	mutation := newQuestionCollectionMutation(c.config, OpUpdate)
-	return &QuestionCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Media.
+func (c *MediaClient) Update() *MediaUpdate {
+	mutation := newMediaMutation(c.config, OpUpdate)
+	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QuestionCollectionClient) UpdateOne(qc *QuestionCollection) *QuestionCollectionUpdateOne {
-	mutation := newQuestionCollectionMutation(c.config, OpUpdateOne, withQuestionCollection(qc))
-	return &QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QuestionCollectionClient) UpdateOneID(id uuid.UUID) *QuestionCollectionUpdateOne {
-	mutation := newQuestionCollectionMutation(c.config, OpUpdateOne, withQuestionCollectionID(id))
-	return &QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MediaClient) UpdateOneID(id uuid.UUID) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for QuestionCollection.
-func (c *QuestionCollectionClient) Delete() *QuestionCollectionDelete {
-	mutation := newQuestionCollectionMutation(c.config, OpDelete)
-	return &QuestionCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Media.
+func (c *MediaClient) Delete() *MediaDelete {
+	mutation := newMediaMutation(c.config, OpDelete)
+	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QuestionCollectionClient) DeleteOne(qc *QuestionCollection) *QuestionCollectionDeleteOne {
-	return c.DeleteOneID(qc.ID)
+func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
+	return c.DeleteOneID(m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionCollectionClient) DeleteOneID(id uuid.UUID) *QuestionCollectionDeleteOne {
-	builder := c.Delete().Where(questioncollection.ID(id))
+func (c *MediaClient) DeleteOneID(id uuid.UUID) *MediaDeleteOne {
+	builder := c.Delete().Where(media.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionCollectionDeleteOne{builder}
+	return &MediaDeleteOne{builder}
 }
 
-// Query returns a query builder for QuestionCollection.
-func (c *QuestionCollectionClient) Query() *QuestionCollectionQuery {
-	return &QuestionCollectionQuery{
+// Query returns a query builder for Media.
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestionCollection},
+		ctx:    &QueryContext{Type: TypeMedia},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a QuestionCollection entity by its id.
-func (c *QuestionCollectionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionCollection, error) {
-	return c.Query().Where(questioncollection.ID(id)).Only(ctx)
-}
+// Get returns a Media entity by its id.
+func (c *MediaClient) Get(ctx context.Context, id uuid.UUID) (*Media, error) {
+	return c.Query().Where(media.ID(id)).Only(ctx)
+}
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionCollectionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionCollection {
+func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1635,198 +2265,214 @@ func (c *QuestionCollectionClient) GetX(ctx context.Context, id uuid.UUID) *Ques
 	return obj
 }
 
-// QueryCreator queries the creator edge of a QuestionCollection.
-func (c *QuestionCollectionClient) QueryCreator(qc *QuestionCollection) *UserQuery {
+// QueryOrganization queries the organization edge of a Media.
+func (c *MediaClient) QueryOrganization(m *Media) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.OrganizationTable, media.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserMedia queries the user_media edge of a Media.
+func (c *MediaClient) QueryUserMedia(m *Media) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qc.ID
+		id := m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.From(media.Table, media.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.CreatorTable, questioncollection.CreatorColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, media.UserMediaTable, media.UserMediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryQuestions queries the questions edge of a QuestionCollection.
-func (c *QuestionCollectionClient) QueryQuestions(qc *QuestionCollection) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Media.
+func (c *MediaClient) QueryUser(m *Media) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qc.ID
+		id := m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, questioncollection.QuestionsTable, questioncollection.QuestionsColumn),
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.UserTable, media.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCourseSection queries the course_section edge of a QuestionCollection.
-func (c *QuestionCollectionClient) QueryCourseSection(qc *QuestionCollection) *CourseSectionQuery {
-	query := (&CourseSectionClient{config: c.config}).Query()
+// QueryCourseMedia queries the course_media edge of a Media.
+func (c *MediaClient) QueryCourseMedia(m *Media) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qc.ID
+		id := m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
-			sqlgraph.To(coursesection.Table, coursesection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.CourseSectionTable, questioncollection.CourseSectionColumn),
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, media.CourseMediaTable, media.CourseMediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTest queries the test edge of a QuestionCollection.
-func (c *QuestionCollectionClient) QueryTest(qc *QuestionCollection) *TestQuery {
-	query := (&TestClient{config: c.config}).Query()
+// QueryVideoMedia queries the video_media edge of a Media.
+func (c *MediaClient) QueryVideoMedia(m *Media) *VideoQuery {
+	query := (&VideoClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qc.ID
+		id := m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
-			sqlgraph.To(test.Table, test.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, questioncollection.TestTable, questioncollection.TestPrimaryKey...),
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(video.Table, video.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, media.VideoMediaTable, media.VideoMediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionCollectionClient) Hooks() []Hook {
-	hooks := c.hooks.QuestionCollection
-	return append(hooks[:len(hooks):len(hooks)], questioncollection.Hooks[:]...)
+func (c *MediaClient) Hooks() []Hook {
+	hooks := c.hooks.Media
+	return append(hooks[:len(hooks):len(hooks)], media.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionCollectionClient) Interceptors() []Interceptor {
-	inters := c.inters.QuestionCollection
-	return append(inters[:len(inters):len(inters)], questioncollection.Interceptors[:]...)
+func (c *MediaClient) Interceptors() []Interceptor {
+	inters := c.inters.Media
+	return append(inters[:len(inters):len(inters)], media.Interceptors[:]...)
 }
 
-func (c *QuestionCollectionClient) mutate(ctx context.Context, m *QuestionCollectionMutation) (Value, error) {
+func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown QuestionCollection mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
 	}
 }
 
-// QuestionOptionClient is a client for the QuestionOption schema.
-type QuestionOptionClient struct {
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
 	config
 }
 
-// NewQuestionOptionClient returns a client for the QuestionOption from the given config.
-func NewQuestionOptionClient(c config) *QuestionOptionClient {
-	return &QuestionOptionClient{config: c}
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `questionoption.Hooks(f(g(h())))`.
-func (c *QuestionOptionClient) Use(hooks ...Hook) {
-	c.hooks.QuestionOption = append(c.hooks.QuestionOption, hooks...)
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `questionoption.Intercept(f(g(h())))`.
-func (c *QuestionOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.QuestionOption = append(c.inters.QuestionOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
 }
 
-// Create returns a builder for creating a QuestionOption entity.
-func (c *QuestionOptionClient) Create() *QuestionOptionCreate {
-	mutation := newQuestionOptionMutation(c.config, OpCreate)
-	return &QuestionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of QuestionOption entities.
-func (c *QuestionOptionClient) CreateBulk(builders ...*QuestionOptionCreate) *QuestionOptionCreateBulk {
-	return &QuestionOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QuestionOptionClient) MapCreateBulk(slice any, setFunc func(*QuestionOptionCreate, int)) *QuestionOptionCreateBulk {
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QuestionOptionCreateBulk{err: fmt.Errorf("calling to QuestionOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QuestionOptionCreate, rv.Len())
+	builders := make([]*OrganizationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QuestionOptionCreateBulk{config: c.config, builders: builders}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for QuestionOption.
-func (c *QuestionOptionClient) Update() *QuestionOptionUpdate {
-	mutation := newQuestionOptionMutation(c.config, OpUpdate)
-	return &QuestionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QuestionOptionClient) UpdateOne(qo *QuestionOption) *QuestionOptionUpdateOne {
-	mutation := newQuestionOptionMutation(c.config, OpUpdateOne, withQuestionOption(qo))
-	return &QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QuestionOptionClient) UpdateOneID(id uuid.UUID) *QuestionOptionUpdateOne {
-	mutation := newQuestionOptionMutation(c.config, OpUpdateOne, withQuestionOptionID(id))
-	return &QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for QuestionOption.
-func (c *QuestionOptionClient) Delete() *QuestionOptionDelete {
-	mutation := newQuestionOptionMutation(c.config, OpDelete)
-	return &QuestionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QuestionOptionClient) DeleteOne(qo *QuestionOption) *QuestionOptionDeleteOne {
-	return c.DeleteOneID(qo.ID)
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionOptionClient) DeleteOneID(id uuid.UUID) *QuestionOptionDeleteOne {
-	builder := c.Delete().Where(questionoption.ID(id))
+func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionOptionDeleteOne{builder}
+	return &OrganizationDeleteOne{builder}
 }
 
-// Query returns a query builder for QuestionOption.
-func (c *QuestionOptionClient) Query() *QuestionOptionQuery {
-	return &QuestionOptionQuery{
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestionOption},
+		ctx:    &QueryContext{Type: TypeOrganization},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a QuestionOption entity by its id.
-func (c *QuestionOptionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionOption, error) {
-	return c.Query().Where(questionoption.ID(id)).Only(ctx)
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionOptionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionOption {
+func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1834,150 +2480,246 @@ func (c *QuestionOptionClient) GetX(ctx context.Context, id uuid.UUID) *Question
 	return obj
 }
 
-// QueryQuestion queries the question edge of a QuestionOption.
-func (c *QuestionOptionClient) QueryQuestion(qo *QuestionOption) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a Organization.
+func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qo.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questionoption.Table, questionoption.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, questionoption.QuestionTable, questionoption.QuestionColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
 		)
-		fromV = sqlgraph.Neighbors(qo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourses queries the courses edge of a Organization.
+func (c *OrganizationClient) QueryCourses(o *Organization) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CoursesTable, organization.CoursesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionCollections queries the question_collections edge of a Organization.
+func (c *OrganizationClient) QueryQuestionCollections(o *Organization) *QuestionCollectionQuery {
+	query := (&QuestionCollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.QuestionCollectionsTable, organization.QuestionCollectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTests queries the tests edge of a Organization.
+func (c *OrganizationClient) QueryTests(o *Organization) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TestsTable, organization.TestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Organization.
+func (c *OrganizationClient) QueryGroups(o *Organization) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.GroupsTable, organization.GroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMedia queries the media edge of a Organization.
+func (c *OrganizationClient) QueryMedia(o *Organization) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.MediaTable, organization.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessGrants queries the access_grants edge of a Organization.
+func (c *OrganizationClient) QueryAccessGrants(o *Organization) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AccessGrantsTable, organization.AccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionOptionClient) Hooks() []Hook {
-	hooks := c.hooks.QuestionOption
-	return append(hooks[:len(hooks):len(hooks)], questionoption.Hooks[:]...)
+func (c *OrganizationClient) Hooks() []Hook {
+	hooks := c.hooks.Organization
+	return append(hooks[:len(hooks):len(hooks)], organization.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionOptionClient) Interceptors() []Interceptor {
-	inters := c.inters.QuestionOption
-	return append(inters[:len(inters):len(inters)], questionoption.Interceptors[:]...)
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	inters := c.inters.Organization
+	return append(inters[:len(inters):len(inters)], organization.Interceptors[:]...)
 }
 
-func (c *QuestionOptionClient) mutate(ctx context.Context, m *QuestionOptionMutation) (Value, error) {
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown QuestionOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// PermissionClient is a client for the Permission schema.
+type PermissionClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewPermissionClient returns a client for the Permission from the given config.
+func NewPermissionClient(c config) *PermissionClient {
+	return &PermissionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `permission.Hooks(f(g(h())))`.
+func (c *PermissionClient) Use(hooks ...Hook) {
+	c.hooks.Permission = append(c.hooks.Permission, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
-}
-
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// A call to `Intercept(f, g, h)` equals to `permission.Intercept(f(g(h())))`.
+func (c *PermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Permission = append(c.inters.Permission, interceptors...)
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// Create returns a builder for creating a Permission entity.
+func (c *PermissionClient) Create() *PermissionCreate {
+	mutation := newPermissionMutation(c.config, OpCreate)
+	return &PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Permission entities.
+func (c *PermissionClient) CreateBulk(builders ...*PermissionCreate) *PermissionCreateBulk {
+	return &PermissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *PermissionClient) MapCreateBulk(slice any, setFunc func(*PermissionCreate, int)) *PermissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PermissionCreateBulk{err: fmt.Errorf("calling to PermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*PermissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &PermissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Permission.
+func (c *PermissionClient) Update() *PermissionUpdate {
+	mutation := newPermissionMutation(c.config, OpUpdate)
+	return &PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PermissionClient) UpdateOne(pe *Permission) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermission(pe))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id uuid.UUID) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PermissionClient) UpdateOneID(id uuid.UUID) *PermissionUpdateOne {
+	mutation := newPermissionMutation(c.config, OpUpdateOne, withPermissionID(id))
+	return &PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Permission.
+func (c *PermissionClient) Delete() *PermissionDelete {
+	mutation := newPermissionMutation(c.config, OpDelete)
+	return &PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *PermissionClient) DeleteOne(pe *Permission) *PermissionDeleteOne {
+	return c.DeleteOneID(pe.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id uuid.UUID) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *PermissionClient) DeleteOneID(id uuid.UUID) *PermissionDeleteOne {
+	builder := c.Delete().Where(permission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &PermissionDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for Permission.
+func (c *PermissionClient) Query() *PermissionQuery {
+	return &PermissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypePermission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id uuid.UUID) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a Permission entity by its id.
+func (c *PermissionClient) Get(ctx context.Context, id uuid.UUID) (*Permission, error) {
+	return c.Query().Where(permission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
+func (c *PermissionClient) GetX(ctx context.Context, id uuid.UUID) *Permission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1985,166 +2727,150 @@ func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Role.
-func (c *RoleClient) QueryUsers(r *Role) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPermissions queries the permissions edge of a Role.
-func (c *RoleClient) QueryPermissions(r *Role) *PermissionQuery {
-	query := (&PermissionClient{config: c.config}).Query()
+// QueryRoles queries the roles edge of a Permission.
+func (c *PermissionClient) QueryRoles(pe *Permission) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := pe.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(permission.Table, permission.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, role.PermissionsTable, role.PermissionsPrimaryKey...),
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, permission.RolesTable, permission.RolesPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	hooks := c.hooks.Role
-	return append(hooks[:len(hooks):len(hooks)], role.Hooks[:]...)
+func (c *PermissionClient) Hooks() []Hook {
+	hooks := c.hooks.Permission
+	return append(hooks[:len(hooks):len(hooks)], permission.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	inters := c.inters.Role
-	return append(inters[:len(inters):len(inters)], role.Interceptors[:]...)
+func (c *PermissionClient) Interceptors() []Interceptor {
+	inters := c.inters.Permission
+	return append(inters[:len(inters):len(inters)], permission.Interceptors[:]...)
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Permission mutation op: %q", m.Op())
 	}
 }
 
-// TestClient is a client for the Test schema.
-type TestClient struct {
+// QuestionClient is a client for the Question schema.
+type QuestionClient struct {
 	config
 }
 
-// NewTestClient returns a client for the Test from the given config.
-func NewTestClient(c config) *TestClient {
-	return &TestClient{config: c}
+// NewQuestionClient returns a client for the Question from the given config.
+func NewQuestionClient(c config) *QuestionClient {
+	return &QuestionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `test.Hooks(f(g(h())))`.
-func (c *TestClient) Use(hooks ...Hook) {
-	c.hooks.Test = append(c.hooks.Test, hooks...)
+// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
+func (c *QuestionClient) Use(hooks ...Hook) {
+	c.hooks.Question = append(c.hooks.Question, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `test.Intercept(f(g(h())))`.
-func (c *TestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Test = append(c.inters.Test, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
+func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Question = append(c.inters.Question, interceptors...)
 }
 
-// Create returns a builder for creating a Test entity.
-func (c *TestClient) Create() *TestCreate {
-	mutation := newTestMutation(c.config, OpCreate)
-	return &TestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Question entity.
+func (c *QuestionClient) Create() *QuestionCreate {
+	mutation := newQuestionMutation(c.config, OpCreate)
+	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Test entities.
-func (c *TestClient) CreateBulk(builders ...*TestCreate) *TestCreateBulk {
-	return &TestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Question entities.
+func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
+	return &QuestionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TestClient) MapCreateBulk(slice any, setFunc func(*TestCreate, int)) *TestCreateBulk {
+func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TestCreateBulk{err: fmt.Errorf("calling to TestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TestCreate, rv.Len())
+	builders := make([]*QuestionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TestCreateBulk{config: c.config, builders: builders}
+	return &QuestionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Test.
-func (c *TestClient) Update() *TestUpdate {
-	mutation := newTestMutation(c.config, OpUpdate)
-	return &TestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Question.
+func (c *QuestionClient) Update() *QuestionUpdate {
+	mutation := newQuestionMutation(c.config, OpUpdate)
+	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TestClient) UpdateOne(t *Test) *TestUpdateOne {
-	mutation := newTestMutation(c.config, OpUpdateOne, withTest(t))
-	return &TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionClient) UpdateOne(q *Question) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(q))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TestClient) UpdateOneID(id uuid.UUID) *TestUpdateOne {
-	mutation := newTestMutation(c.config, OpUpdateOne, withTestID(id))
-	return &TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionClient) UpdateOneID(id uuid.UUID) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Test.
-func (c *TestClient) Delete() *TestDelete {
-	mutation := newTestMutation(c.config, OpDelete)
-	return &TestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Question.
+func (c *QuestionClient) Delete() *QuestionDelete {
+	mutation := newQuestionMutation(c.config, OpDelete)
+	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TestClient) DeleteOne(t *Test) *TestDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *QuestionClient) DeleteOne(q *Question) *QuestionDeleteOne {
+	return c.DeleteOneID(q.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TestClient) DeleteOneID(id uuid.UUID) *TestDeleteOne {
-	builder := c.Delete().Where(test.ID(id))
+func (c *QuestionClient) DeleteOneID(id uuid.UUID) *QuestionDeleteOne {
+	builder := c.Delete().Where(question.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TestDeleteOne{builder}
+	return &QuestionDeleteOne{builder}
 }
 
-// Query returns a query builder for Test.
-func (c *TestClient) Query() *TestQuery {
-	return &TestQuery{
+// Query returns a query builder for Question.
+func (c *QuestionClient) Query() *QuestionQuery {
+	return &QuestionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTest},
+		ctx:    &QueryContext{Type: TypeQuestion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Test entity by its id.
-func (c *TestClient) Get(ctx context.Context, id uuid.UUID) (*Test, error) {
-	return c.Query().Where(test.ID(id)).Only(ctx)
+// Get returns a Question entity by its id.
+func (c *QuestionClient) Get(ctx context.Context, id uuid.UUID) (*Question, error) {
+	return c.Query().Where(question.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TestClient) GetX(ctx context.Context, id uuid.UUID) *Test {
+func (c *QuestionClient) GetX(ctx context.Context, id uuid.UUID) *Question {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2152,230 +2878,230 @@ func (c *TestClient) GetX(ctx context.Context, id uuid.UUID) *Test {
 	return obj
 }
 
-// QueryCourseSection queries the course_section edge of a Test.
-func (c *TestClient) QueryCourseSection(t *Test) *CourseSectionQuery {
-	query := (&CourseSectionClient{config: c.config}).Query()
+// QueryCollection queries the collection edge of a Question.
+func (c *QuestionClient) QueryCollection(q *Question) *QuestionCollectionQuery {
+	query := (&QuestionCollectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
-			sqlgraph.To(coursesection.Table, coursesection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, test.CourseSectionTable, test.CourseSectionColumn),
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.CollectionTable, question.CollectionColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCourse queries the course edge of a Test.
-func (c *TestClient) QueryCourse(t *Test) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
+// QueryQuestionOptions queries the question_options edge of a Question.
+func (c *QuestionClient) QueryQuestionOptions(q *Question) *QuestionOptionQuery {
+	query := (&QuestionOptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, test.CourseTable, test.CourseColumn),
-		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(questionoption.Table, questionoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.QuestionOptionsTable, question.QuestionOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTestSessions queries the test_sessions edge of a Test.
-func (c *TestClient) QueryTestSessions(t *Test) *TestSessionQuery {
-	query := (&TestSessionClient{config: c.config}).Query()
+// QueryVideoQuestionTimestampsQuestion queries the video_question_timestamps_question edge of a Question.
+func (c *QuestionClient) QueryVideoQuestionTimestampsQuestion(q *Question) *VideoQuestionTimestampQuery {
+	query := (&VideoQuestionTimestampClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
-			sqlgraph.To(testsession.Table, testsession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, test.TestSessionsTable, test.TestSessionsColumn),
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(videoquestiontimestamp.Table, videoquestiontimestamp.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.VideoQuestionTimestampsQuestionTable, question.VideoQuestionTimestampsQuestionColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryQuestionCollections queries the question_collections edge of a Test.
-func (c *TestClient) QueryQuestionCollections(t *Test) *QuestionCollectionQuery {
-	query := (&QuestionCollectionClient{config: c.config}).Query()
+// QueryTestSessionAnswers queries the test_session_answers edge of a Question.
+func (c *QuestionClient) QueryTestSessionAnswers(q *Question) *TestSessionAnswerQuery {
+	query := (&TestSessionAnswerClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
-			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, test.QuestionCollectionsTable, test.QuestionCollectionsPrimaryKey...),
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(testsessionanswer.Table, testsessionanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.TestSessionAnswersTable, question.TestSessionAnswersColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTestQuestionCounts queries the test_question_counts edge of a Test.
-func (c *TestClient) QueryTestQuestionCounts(t *Test) *TestQuestionCountQuery {
-	query := (&TestQuestionCountClient{config: c.config}).Query()
+// QueryVersions queries the versions edge of a Question.
+func (c *QuestionClient) QueryVersions(q *Question) *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
-			sqlgraph.To(testquestioncount.Table, testquestioncount.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, test.TestQuestionCountsTable, test.TestQuestionCountsColumn),
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.VersionsTable, question.VersionsColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTestIgnoreQuestions queries the test_ignore_questions edge of a Test.
-func (c *TestClient) QueryTestIgnoreQuestions(t *Test) *TestIgnoreQuestionQuery {
+// QueryTestIgnoreQuestions queries the test_ignore_questions edge of a Question.
+func (c *QuestionClient) QueryTestIgnoreQuestions(q *Question) *TestIgnoreQuestionQuery {
 	query := (&TestIgnoreQuestionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := q.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.From(question.Table, question.FieldID, id),
 			sqlgraph.To(testignorequestion.Table, testignorequestion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, test.TestIgnoreQuestionsTable, test.TestIgnoreQuestionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, question.TestIgnoreQuestionsTable, question.TestIgnoreQuestionsColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TestClient) Hooks() []Hook {
-	hooks := c.hooks.Test
-	return append(hooks[:len(hooks):len(hooks)], test.Hooks[:]...)
+func (c *QuestionClient) Hooks() []Hook {
+	hooks := c.hooks.Question
+	return append(hooks[:len(hooks):len(hooks)], question.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TestClient) Interceptors() []Interceptor {
-	inters := c.inters.Test
-	return append(inters[:len(inters):len(inters)], test.Interceptors[:]...)
+func (c *QuestionClient) Interceptors() []Interceptor {
+	inters := c.inters.Question
+	return append(inters[:len(inters):len(inters)], question.Interceptors[:]...)
 }
 
-func (c *TestClient) mutate(ctx context.Context, m *TestMutation) (Value, error) {
+func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Test mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
 	}
 }
 
-// TestIgnoreQuestionClient is a client for the TestIgnoreQuestion schema.
-type TestIgnoreQuestionClient struct {
+// QuestionCollectionClient is a client for the QuestionCollection schema.
+type QuestionCollectionClient struct {
 	config
 }
 
-// NewTestIgnoreQuestionClient returns a client for the TestIgnoreQuestion from the given config.
-func NewTestIgnoreQuestionClient(c config) *TestIgnoreQuestionClient {
-	return &TestIgnoreQuestionClient{config: c}
+// NewQuestionCollectionClient returns a client for the QuestionCollection from the given config.
+func NewQuestionCollectionClient(c config) *QuestionCollectionClient {
+	return &QuestionCollectionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `testignorequestion.Hooks(f(g(h())))`.
-func (c *TestIgnoreQuestionClient) Use(hooks ...Hook) {
-	c.hooks.TestIgnoreQuestion = append(c.hooks.TestIgnoreQuestion, hooks...)
+// A call to `Use(f, g, h)` equals to `questioncollection.Hooks(f(g(h())))`.
+func (c *QuestionCollectionClient) Use(hooks ...Hook) {
+	c.hooks.QuestionCollection = append(c.hooks.QuestionCollection, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `testignorequestion.Intercept(f(g(h())))`.
-func (c *TestIgnoreQuestionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TestIgnoreQuestion = append(c.inters.TestIgnoreQuestion, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `questioncollection.Intercept(f(g(h())))`.
+func (c *QuestionCollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionCollection = append(c.inters.QuestionCollection, interceptors...)
 }
 
-// Create returns a builder for creating a TestIgnoreQuestion entity.
-func (c *TestIgnoreQuestionClient) Create() *TestIgnoreQuestionCreate {
-	mutation := newTestIgnoreQuestionMutation(c.config, OpCreate)
-	return &TestIgnoreQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QuestionCollection entity.
+func (c *QuestionCollectionClient) Create() *QuestionCollectionCreate {
+	mutation := newQuestionCollectionMutation(c.config, OpCreate)
+	return &QuestionCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TestIgnoreQuestion entities.
-func (c *TestIgnoreQuestionClient) CreateBulk(builders ...*TestIgnoreQuestionCreate) *TestIgnoreQuestionCreateBulk {
-	return &TestIgnoreQuestionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QuestionCollection entities.
+func (c *QuestionCollectionClient) CreateBulk(builders ...*QuestionCollectionCreate) *QuestionCollectionCreateBulk {
+	return &QuestionCollectionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TestIgnoreQuestionClient) MapCreateBulk(slice any, setFunc func(*TestIgnoreQuestionCreate, int)) *TestIgnoreQuestionCreateBulk {
+func (c *QuestionCollectionClient) MapCreateBulk(slice any, setFunc func(*QuestionCollectionCreate, int)) *QuestionCollectionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TestIgnoreQuestionCreateBulk{err: fmt.Errorf("calling to TestIgnoreQuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionCollectionCreateBulk{err: fmt.Errorf("calling to QuestionCollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TestIgnoreQuestionCreate, rv.Len())
+	builders := make([]*QuestionCollectionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TestIgnoreQuestionCreateBulk{config: c.config, builders: builders}
+	return &QuestionCollectionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TestIgnoreQuestion.
-func (c *TestIgnoreQuestionClient) Update() *TestIgnoreQuestionUpdate {
-	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdate)
-	return &TestIgnoreQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QuestionCollection.
+func (c *QuestionCollectionClient) Update() *QuestionCollectionUpdate {
+	mutation := newQuestionCollectionMutation(c.config, OpUpdate)
+	return &QuestionCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TestIgnoreQuestionClient) UpdateOne(tiq *TestIgnoreQuestion) *TestIgnoreQuestionUpdateOne {
-	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdateOne, withTestIgnoreQuestion(tiq))
-	return &TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionCollectionClient) UpdateOne(qc *QuestionCollection) *QuestionCollectionUpdateOne {
+	mutation := newQuestionCollectionMutation(c.config, OpUpdateOne, withQuestionCollection(qc))
+	return &QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TestIgnoreQuestionClient) UpdateOneID(id uuid.UUID) *TestIgnoreQuestionUpdateOne {
-	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdateOne, withTestIgnoreQuestionID(id))
-	return &TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionCollectionClient) UpdateOneID(id uuid.UUID) *QuestionCollectionUpdateOne {
+	mutation := newQuestionCollectionMutation(c.config, OpUpdateOne, withQuestionCollectionID(id))
+	return &QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TestIgnoreQuestion.
-func (c *TestIgnoreQuestionClient) Delete() *TestIgnoreQuestionDelete {
-	mutation := newTestIgnoreQuestionMutation(c.config, OpDelete)
-	return &TestIgnoreQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QuestionCollection.
+func (c *QuestionCollectionClient) Delete() *QuestionCollectionDelete {
+	mutation := newQuestionCollectionMutation(c.config, OpDelete)
+	return &QuestionCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TestIgnoreQuestionClient) DeleteOne(tiq *TestIgnoreQuestion) *TestIgnoreQuestionDeleteOne {
-	return c.DeleteOneID(tiq.ID)
+func (c *QuestionCollectionClient) DeleteOne(qc *QuestionCollection) *QuestionCollectionDeleteOne {
+	return c.DeleteOneID(qc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TestIgnoreQuestionClient) DeleteOneID(id uuid.UUID) *TestIgnoreQuestionDeleteOne {
-	builder := c.Delete().Where(testignorequestion.ID(id))
+func (c *QuestionCollectionClient) DeleteOneID(id uuid.UUID) *QuestionCollectionDeleteOne {
+	builder := c.Delete().Where(questioncollection.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TestIgnoreQuestionDeleteOne{builder}
+	return &QuestionCollectionDeleteOne{builder}
 }
 
-// Query returns a query builder for TestIgnoreQuestion.
-func (c *TestIgnoreQuestionClient) Query() *TestIgnoreQuestionQuery {
-	return &TestIgnoreQuestionQuery{
+// Query returns a query builder for QuestionCollection.
+func (c *QuestionCollectionClient) Query() *QuestionCollectionQuery {
+	return &QuestionCollectionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTestIgnoreQuestion},
+		ctx:    &QueryContext{Type: TypeQuestionCollection},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TestIgnoreQuestion entity by its id.
-func (c *TestIgnoreQuestionClient) Get(ctx context.Context, id uuid.UUID) (*TestIgnoreQuestion, error) {
-	return c.Query().Where(testignorequestion.ID(id)).Only(ctx)
+// Get returns a QuestionCollection entity by its id.
+func (c *QuestionCollectionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionCollection, error) {
+	return c.Query().Where(questioncollection.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TestIgnoreQuestionClient) GetX(ctx context.Context, id uuid.UUID) *TestIgnoreQuestion {
+func (c *QuestionCollectionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionCollection {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2383,166 +3109,230 @@ func (c *TestIgnoreQuestionClient) GetX(ctx context.Context, id uuid.UUID) *Test
 	return obj
 }
 
-// QueryTest queries the test edge of a TestIgnoreQuestion.
-func (c *TestIgnoreQuestionClient) QueryTest(tiq *TestIgnoreQuestion) *TestQuery {
-	query := (&TestClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryOrganization(qc *QuestionCollection) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tiq.ID
+		id := qc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testignorequestion.Table, testignorequestion.FieldID, id),
-			sqlgraph.To(test.Table, test.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, testignorequestion.TestTable, testignorequestion.TestColumn),
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.OrganizationTable, questioncollection.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(tiq.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryQuestion queries the question edge of a TestIgnoreQuestion.
-func (c *TestIgnoreQuestionClient) QueryQuestion(tiq *TestIgnoreQuestion) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
+// QueryCreator queries the creator edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryCreator(qc *QuestionCollection) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tiq.ID
+		id := qc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testignorequestion.Table, testignorequestion.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, testignorequestion.QuestionTable, testignorequestion.QuestionColumn),
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.CreatorTable, questioncollection.CreatorColumn),
 		)
-		fromV = sqlgraph.Neighbors(tiq.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *TestIgnoreQuestionClient) Hooks() []Hook {
-	hooks := c.hooks.TestIgnoreQuestion
-	return append(hooks[:len(hooks):len(hooks)], testignorequestion.Hooks[:]...)
+// QueryQuestions queries the questions edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryQuestions(qc *QuestionCollection) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questioncollection.QuestionsTable, questioncollection.QuestionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *TestIgnoreQuestionClient) Interceptors() []Interceptor {
-	inters := c.inters.TestIgnoreQuestion
-	return append(inters[:len(inters):len(inters)], testignorequestion.Interceptors[:]...)
+// QueryCourseSection queries the course_section edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryCourseSection(qc *QuestionCollection) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.CourseSectionTable, questioncollection.CourseSectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *TestIgnoreQuestionClient) mutate(ctx context.Context, m *TestIgnoreQuestionMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&TestIgnoreQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&TestIgnoreQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&TestIgnoreQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+// QueryTest queries the test edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryTest(qc *QuestionCollection) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, questioncollection.TestTable, questioncollection.TestPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessGrants queries the access_grants edge of a QuestionCollection.
+func (c *QuestionCollectionClient) QueryAccessGrants(qc *QuestionCollection) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questioncollection.AccessGrantsTable, questioncollection.AccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionCollectionClient) Hooks() []Hook {
+	hooks := c.hooks.QuestionCollection
+	return append(hooks[:len(hooks):len(hooks)], questioncollection.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionCollectionClient) Interceptors() []Interceptor {
+	inters := c.inters.QuestionCollection
+	return append(inters[:len(inters):len(inters)], questioncollection.Interceptors[:]...)
+}
+
+func (c *QuestionCollectionClient) mutate(ctx context.Context, m *QuestionCollectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TestIgnoreQuestion mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QuestionCollection mutation op: %q", m.Op())
 	}
 }
 
-// TestQuestionCountClient is a client for the TestQuestionCount schema.
-type TestQuestionCountClient struct {
+// QuestionOptionClient is a client for the QuestionOption schema.
+type QuestionOptionClient struct {
 	config
 }
 
-// NewTestQuestionCountClient returns a client for the TestQuestionCount from the given config.
-func NewTestQuestionCountClient(c config) *TestQuestionCountClient {
-	return &TestQuestionCountClient{config: c}
+// NewQuestionOptionClient returns a client for the QuestionOption from the given config.
+func NewQuestionOptionClient(c config) *QuestionOptionClient {
+	return &QuestionOptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `testquestioncount.Hooks(f(g(h())))`.
-func (c *TestQuestionCountClient) Use(hooks ...Hook) {
-	c.hooks.TestQuestionCount = append(c.hooks.TestQuestionCount, hooks...)
+// A call to `Use(f, g, h)` equals to `questionoption.Hooks(f(g(h())))`.
+func (c *QuestionOptionClient) Use(hooks ...Hook) {
+	c.hooks.QuestionOption = append(c.hooks.QuestionOption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `testquestioncount.Intercept(f(g(h())))`.
-func (c *TestQuestionCountClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TestQuestionCount = append(c.inters.TestQuestionCount, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `questionoption.Intercept(f(g(h())))`.
+func (c *QuestionOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionOption = append(c.inters.QuestionOption, interceptors...)
 }
 
-// Create returns a builder for creating a TestQuestionCount entity.
-func (c *TestQuestionCountClient) Create() *TestQuestionCountCreate {
-	mutation := newTestQuestionCountMutation(c.config, OpCreate)
-	return &TestQuestionCountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QuestionOption entity.
+func (c *QuestionOptionClient) Create() *QuestionOptionCreate {
+	mutation := newQuestionOptionMutation(c.config, OpCreate)
+	return &QuestionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TestQuestionCount entities.
-func (c *TestQuestionCountClient) CreateBulk(builders ...*TestQuestionCountCreate) *TestQuestionCountCreateBulk {
-	return &TestQuestionCountCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QuestionOption entities.
+func (c *QuestionOptionClient) CreateBulk(builders ...*QuestionOptionCreate) *QuestionOptionCreateBulk {
+	return &QuestionOptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TestQuestionCountClient) MapCreateBulk(slice any, setFunc func(*TestQuestionCountCreate, int)) *TestQuestionCountCreateBulk {
+func (c *QuestionOptionClient) MapCreateBulk(slice any, setFunc func(*QuestionOptionCreate, int)) *QuestionOptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TestQuestionCountCreateBulk{err: fmt.Errorf("calling to TestQuestionCountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionOptionCreateBulk{err: fmt.Errorf("calling to QuestionOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TestQuestionCountCreate, rv.Len())
+	builders := make([]*QuestionOptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TestQuestionCountCreateBulk{config: c.config, builders: builders}
+	return &QuestionOptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TestQuestionCount.
-func (c *TestQuestionCountClient) Update() *TestQuestionCountUpdate {
-	mutation := newTestQuestionCountMutation(c.config, OpUpdate)
-	return &TestQuestionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QuestionOption.
+func (c *QuestionOptionClient) Update() *QuestionOptionUpdate {
+	mutation := newQuestionOptionMutation(c.config, OpUpdate)
+	return &QuestionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TestQuestionCountClient) UpdateOne(tqc *TestQuestionCount) *TestQuestionCountUpdateOne {
-	mutation := newTestQuestionCountMutation(c.config, OpUpdateOne, withTestQuestionCount(tqc))
-	return &TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionOptionClient) UpdateOne(qo *QuestionOption) *QuestionOptionUpdateOne {
+	mutation := newQuestionOptionMutation(c.config, OpUpdateOne, withQuestionOption(qo))
+	return &QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TestQuestionCountClient) UpdateOneID(id uuid.UUID) *TestQuestionCountUpdateOne {
-	mutation := newTestQuestionCountMutation(c.config, OpUpdateOne, withTestQuestionCountID(id))
-	return &TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionOptionClient) UpdateOneID(id uuid.UUID) *QuestionOptionUpdateOne {
+	mutation := newQuestionOptionMutation(c.config, OpUpdateOne, withQuestionOptionID(id))
+	return &QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TestQuestionCount.
-func (c *TestQuestionCountClient) Delete() *TestQuestionCountDelete {
-	mutation := newTestQuestionCountMutation(c.config, OpDelete)
-	return &TestQuestionCountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QuestionOption.
+func (c *QuestionOptionClient) Delete() *QuestionOptionDelete {
+	mutation := newQuestionOptionMutation(c.config, OpDelete)
+	return &QuestionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TestQuestionCountClient) DeleteOne(tqc *TestQuestionCount) *TestQuestionCountDeleteOne {
-	return c.DeleteOneID(tqc.ID)
+func (c *QuestionOptionClient) DeleteOne(qo *QuestionOption) *QuestionOptionDeleteOne {
+	return c.DeleteOneID(qo.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TestQuestionCountClient) DeleteOneID(id uuid.UUID) *TestQuestionCountDeleteOne {
-	builder := c.Delete().Where(testquestioncount.ID(id))
+func (c *QuestionOptionClient) DeleteOneID(id uuid.UUID) *QuestionOptionDeleteOne {
+	builder := c.Delete().Where(questionoption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TestQuestionCountDeleteOne{builder}
+	return &QuestionOptionDeleteOne{builder}
 }
 
-// Query returns a query builder for TestQuestionCount.
-func (c *TestQuestionCountClient) Query() *TestQuestionCountQuery {
-	return &TestQuestionCountQuery{
+// Query returns a query builder for QuestionOption.
+func (c *QuestionOptionClient) Query() *QuestionOptionQuery {
+	return &QuestionOptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTestQuestionCount},
+		ctx:    &QueryContext{Type: TypeQuestionOption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TestQuestionCount entity by its id.
-func (c *TestQuestionCountClient) Get(ctx context.Context, id uuid.UUID) (*TestQuestionCount, error) {
-	return c.Query().Where(testquestioncount.ID(id)).Only(ctx)
+// Get returns a QuestionOption entity by its id.
+func (c *QuestionOptionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionOption, error) {
+	return c.Query().Where(questionoption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TestQuestionCountClient) GetX(ctx context.Context, id uuid.UUID) *TestQuestionCount {
+func (c *QuestionOptionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionOption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2550,150 +3340,150 @@ func (c *TestQuestionCountClient) GetX(ctx context.Context, id uuid.UUID) *TestQ
 	return obj
 }
 
-// QueryTest queries the test edge of a TestQuestionCount.
-func (c *TestQuestionCountClient) QueryTest(tqc *TestQuestionCount) *TestQuery {
-	query := (&TestClient{config: c.config}).Query()
+// QueryQuestion queries the question edge of a QuestionOption.
+func (c *QuestionOptionClient) QueryQuestion(qo *QuestionOption) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tqc.ID
+		id := qo.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testquestioncount.Table, testquestioncount.FieldID, id),
-			sqlgraph.To(test.Table, test.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, testquestioncount.TestTable, testquestioncount.TestColumn),
+			sqlgraph.From(questionoption.Table, questionoption.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionoption.QuestionTable, questionoption.QuestionColumn),
 		)
-		fromV = sqlgraph.Neighbors(tqc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qo.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TestQuestionCountClient) Hooks() []Hook {
-	hooks := c.hooks.TestQuestionCount
-	return append(hooks[:len(hooks):len(hooks)], testquestioncount.Hooks[:]...)
+func (c *QuestionOptionClient) Hooks() []Hook {
+	hooks := c.hooks.QuestionOption
+	return append(hooks[:len(hooks):len(hooks)], questionoption.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TestQuestionCountClient) Interceptors() []Interceptor {
-	inters := c.inters.TestQuestionCount
-	return append(inters[:len(inters):len(inters)], testquestioncount.Interceptors[:]...)
+func (c *QuestionOptionClient) Interceptors() []Interceptor {
+	inters := c.inters.QuestionOption
+	return append(inters[:len(inters):len(inters)], questionoption.Interceptors[:]...)
 }
 
-func (c *TestQuestionCountClient) mutate(ctx context.Context, m *TestQuestionCountMutation) (Value, error) {
+func (c *QuestionOptionClient) mutate(ctx context.Context, m *QuestionOptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TestQuestionCountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TestQuestionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TestQuestionCountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QuestionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TestQuestionCount mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QuestionOption mutation op: %q", m.Op())
 	}
 }
 
-// TestSessionClient is a client for the TestSession schema.
-type TestSessionClient struct {
+// QuestionVersionClient is a client for the QuestionVersion schema.
+type QuestionVersionClient struct {
 	config
 }
 
-// NewTestSessionClient returns a client for the TestSession from the given config.
-func NewTestSessionClient(c config) *TestSessionClient {
-	return &TestSessionClient{config: c}
+// NewQuestionVersionClient returns a client for the QuestionVersion from the given config.
+func NewQuestionVersionClient(c config) *QuestionVersionClient {
+	return &QuestionVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `testsession.Hooks(f(g(h())))`.
-func (c *TestSessionClient) Use(hooks ...Hook) {
-	c.hooks.TestSession = append(c.hooks.TestSession, hooks...)
+// A call to `Use(f, g, h)` equals to `questionversion.Hooks(f(g(h())))`.
+func (c *QuestionVersionClient) Use(hooks ...Hook) {
+	c.hooks.QuestionVersion = append(c.hooks.QuestionVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `testsession.Intercept(f(g(h())))`.
-func (c *TestSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TestSession = append(c.inters.TestSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `questionversion.Intercept(f(g(h())))`.
+func (c *QuestionVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionVersion = append(c.inters.QuestionVersion, interceptors...)
 }
 
-// Create returns a builder for creating a TestSession entity.
-func (c *TestSessionClient) Create() *TestSessionCreate {
-	mutation := newTestSessionMutation(c.config, OpCreate)
-	return &TestSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QuestionVersion entity.
+func (c *QuestionVersionClient) Create() *QuestionVersionCreate {
+	mutation := newQuestionVersionMutation(c.config, OpCreate)
+	return &QuestionVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TestSession entities.
-func (c *TestSessionClient) CreateBulk(builders ...*TestSessionCreate) *TestSessionCreateBulk {
-	return &TestSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QuestionVersion entities.
+func (c *QuestionVersionClient) CreateBulk(builders ...*QuestionVersionCreate) *QuestionVersionCreateBulk {
+	return &QuestionVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TestSessionClient) MapCreateBulk(slice any, setFunc func(*TestSessionCreate, int)) *TestSessionCreateBulk {
+func (c *QuestionVersionClient) MapCreateBulk(slice any, setFunc func(*QuestionVersionCreate, int)) *QuestionVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TestSessionCreateBulk{err: fmt.Errorf("calling to TestSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionVersionCreateBulk{err: fmt.Errorf("calling to QuestionVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TestSessionCreate, rv.Len())
+	builders := make([]*QuestionVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TestSessionCreateBulk{config: c.config, builders: builders}
+	return &QuestionVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TestSession.
-func (c *TestSessionClient) Update() *TestSessionUpdate {
-	mutation := newTestSessionMutation(c.config, OpUpdate)
-	return &TestSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QuestionVersion.
+func (c *QuestionVersionClient) Update() *QuestionVersionUpdate {
+	mutation := newQuestionVersionMutation(c.config, OpUpdate)
+	return &QuestionVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TestSessionClient) UpdateOne(ts *TestSession) *TestSessionUpdateOne {
-	mutation := newTestSessionMutation(c.config, OpUpdateOne, withTestSession(ts))
-	return &TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionVersionClient) UpdateOne(qv *QuestionVersion) *QuestionVersionUpdateOne {
+	mutation := newQuestionVersionMutation(c.config, OpUpdateOne, withQuestionVersion(qv))
+	return &QuestionVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TestSessionClient) UpdateOneID(id uuid.UUID) *TestSessionUpdateOne {
-	mutation := newTestSessionMutation(c.config, OpUpdateOne, withTestSessionID(id))
-	return &TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionVersionClient) UpdateOneID(id uuid.UUID) *QuestionVersionUpdateOne {
+	mutation := newQuestionVersionMutation(c.config, OpUpdateOne, withQuestionVersionID(id))
+	return &QuestionVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TestSession.
-func (c *TestSessionClient) Delete() *TestSessionDelete {
-	mutation := newTestSessionMutation(c.config, OpDelete)
-	return &TestSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QuestionVersion.
+func (c *QuestionVersionClient) Delete() *QuestionVersionDelete {
+	mutation := newQuestionVersionMutation(c.config, OpDelete)
+	return &QuestionVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TestSessionClient) DeleteOne(ts *TestSession) *TestSessionDeleteOne {
-	return c.DeleteOneID(ts.ID)
+func (c *QuestionVersionClient) DeleteOne(qv *QuestionVersion) *QuestionVersionDeleteOne {
+	return c.DeleteOneID(qv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TestSessionClient) DeleteOneID(id uuid.UUID) *TestSessionDeleteOne {
-	builder := c.Delete().Where(testsession.ID(id))
+func (c *QuestionVersionClient) DeleteOneID(id uuid.UUID) *QuestionVersionDeleteOne {
+	builder := c.Delete().Where(questionversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TestSessionDeleteOne{builder}
+	return &QuestionVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for TestSession.
-func (c *TestSessionClient) Query() *TestSessionQuery {
-	return &TestSessionQuery{
+// Query returns a query builder for QuestionVersion.
+func (c *QuestionVersionClient) Query() *QuestionVersionQuery {
+	return &QuestionVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTestSession},
+		ctx:    &QueryContext{Type: TypeQuestionVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TestSession entity by its id.
-func (c *TestSessionClient) Get(ctx context.Context, id uuid.UUID) (*TestSession, error) {
-	return c.Query().Where(testsession.ID(id)).Only(ctx)
+// Get returns a QuestionVersion entity by its id.
+func (c *QuestionVersionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionVersion, error) {
+	return c.Query().Where(questionversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TestSessionClient) GetX(ctx context.Context, id uuid.UUID) *TestSession {
+func (c *QuestionVersionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2701,198 +3491,198 @@ func (c *TestSessionClient) GetX(ctx context.Context, id uuid.UUID) *TestSession
 	return obj
 }
 
-// QueryUser queries the user edge of a TestSession.
-func (c *TestSessionClient) QueryUser(ts *TestSession) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryQuestion queries the question edge of a QuestionVersion.
+func (c *QuestionVersionClient) QueryQuestion(qv *QuestionVersion) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ts.ID
+		id := qv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsession.Table, testsession.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, testsession.UserTable, testsession.UserColumn),
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversion.QuestionTable, questionversion.QuestionColumn),
 		)
-		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCourseSection queries the course_section edge of a TestSession.
-func (c *TestSessionClient) QueryCourseSection(ts *TestSession) *CourseSectionQuery {
-	query := (&CourseSectionClient{config: c.config}).Query()
+// QueryCreatedBy queries the created_by edge of a QuestionVersion.
+func (c *QuestionVersionClient) QueryCreatedBy(qv *QuestionVersion) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ts.ID
+		id := qv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsession.Table, testsession.FieldID, id),
-			sqlgraph.To(coursesection.Table, coursesection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, testsession.CourseSectionTable, testsession.CourseSectionColumn),
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversion.CreatedByTable, questionversion.CreatedByColumn),
 		)
-		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTest queries the test edge of a TestSession.
-func (c *TestSessionClient) QueryTest(ts *TestSession) *TestQuery {
-	query := (&TestClient{config: c.config}).Query()
+// QueryOptions queries the options edge of a QuestionVersion.
+func (c *QuestionVersionClient) QueryOptions(qv *QuestionVersion) *QuestionVersionOptionQuery {
+	query := (&QuestionVersionOptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ts.ID
+		id := qv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsession.Table, testsession.FieldID, id),
-			sqlgraph.To(test.Table, test.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, testsession.TestTable, testsession.TestColumn),
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, id),
+			sqlgraph.To(questionversionoption.Table, questionversionoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionversion.OptionsTable, questionversion.OptionsColumn),
 		)
-		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTestSessionAnswers queries the test_session_answers edge of a TestSession.
-func (c *TestSessionClient) QueryTestSessionAnswers(ts *TestSession) *TestSessionAnswerQuery {
+// QueryTestSessionAnswers queries the test_session_answers edge of a QuestionVersion.
+func (c *QuestionVersionClient) QueryTestSessionAnswers(qv *QuestionVersion) *TestSessionAnswerQuery {
 	query := (&TestSessionAnswerClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ts.ID
+		id := qv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, id),
 			sqlgraph.To(testsessionanswer.Table, testsessionanswer.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, testsession.TestSessionAnswersTable, testsession.TestSessionAnswersColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionversion.TestSessionAnswersTable, questionversion.TestSessionAnswersColumn),
 		)
-		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TestSessionClient) Hooks() []Hook {
-	hooks := c.hooks.TestSession
-	return append(hooks[:len(hooks):len(hooks)], testsession.Hooks[:]...)
+func (c *QuestionVersionClient) Hooks() []Hook {
+	hooks := c.hooks.QuestionVersion
+	return append(hooks[:len(hooks):len(hooks)], questionversion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TestSessionClient) Interceptors() []Interceptor {
-	inters := c.inters.TestSession
-	return append(inters[:len(inters):len(inters)], testsession.Interceptors[:]...)
+func (c *QuestionVersionClient) Interceptors() []Interceptor {
+	inters := c.inters.QuestionVersion
+	return append(inters[:len(inters):len(inters)], questionversion.Interceptors[:]...)
 }
 
-func (c *TestSessionClient) mutate(ctx context.Context, m *TestSessionMutation) (Value, error) {
+func (c *QuestionVersionClient) mutate(ctx context.Context, m *QuestionVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TestSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TestSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TestSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QuestionVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TestSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QuestionVersion mutation op: %q", m.Op())
 	}
 }
 
-// TestSessionAnswerClient is a client for the TestSessionAnswer schema.
-type TestSessionAnswerClient struct {
+// QuestionVersionOptionClient is a client for the QuestionVersionOption schema.
+type QuestionVersionOptionClient struct {
 	config
 }
 
-// NewTestSessionAnswerClient returns a client for the TestSessionAnswer from the given config.
-func NewTestSessionAnswerClient(c config) *TestSessionAnswerClient {
-	return &TestSessionAnswerClient{config: c}
+// NewQuestionVersionOptionClient returns a client for the QuestionVersionOption from the given config.
+func NewQuestionVersionOptionClient(c config) *QuestionVersionOptionClient {
+	return &QuestionVersionOptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `testsessionanswer.Hooks(f(g(h())))`.
-func (c *TestSessionAnswerClient) Use(hooks ...Hook) {
-	c.hooks.TestSessionAnswer = append(c.hooks.TestSessionAnswer, hooks...)
+// A call to `Use(f, g, h)` equals to `questionversionoption.Hooks(f(g(h())))`.
+func (c *QuestionVersionOptionClient) Use(hooks ...Hook) {
+	c.hooks.QuestionVersionOption = append(c.hooks.QuestionVersionOption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `testsessionanswer.Intercept(f(g(h())))`.
-func (c *TestSessionAnswerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TestSessionAnswer = append(c.inters.TestSessionAnswer, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `questionversionoption.Intercept(f(g(h())))`.
+func (c *QuestionVersionOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionVersionOption = append(c.inters.QuestionVersionOption, interceptors...)
 }
 
-// Create returns a builder for creating a TestSessionAnswer entity.
-func (c *TestSessionAnswerClient) Create() *TestSessionAnswerCreate {
-	mutation := newTestSessionAnswerMutation(c.config, OpCreate)
-	return &TestSessionAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QuestionVersionOption entity.
+func (c *QuestionVersionOptionClient) Create() *QuestionVersionOptionCreate {
+	mutation := newQuestionVersionOptionMutation(c.config, OpCreate)
+	return &QuestionVersionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TestSessionAnswer entities.
-func (c *TestSessionAnswerClient) CreateBulk(builders ...*TestSessionAnswerCreate) *TestSessionAnswerCreateBulk {
-	return &TestSessionAnswerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QuestionVersionOption entities.
+func (c *QuestionVersionOptionClient) CreateBulk(builders ...*QuestionVersionOptionCreate) *QuestionVersionOptionCreateBulk {
+	return &QuestionVersionOptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TestSessionAnswerClient) MapCreateBulk(slice any, setFunc func(*TestSessionAnswerCreate, int)) *TestSessionAnswerCreateBulk {
+func (c *QuestionVersionOptionClient) MapCreateBulk(slice any, setFunc func(*QuestionVersionOptionCreate, int)) *QuestionVersionOptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TestSessionAnswerCreateBulk{err: fmt.Errorf("calling to TestSessionAnswerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionVersionOptionCreateBulk{err: fmt.Errorf("calling to QuestionVersionOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TestSessionAnswerCreate, rv.Len())
+	builders := make([]*QuestionVersionOptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TestSessionAnswerCreateBulk{config: c.config, builders: builders}
+	return &QuestionVersionOptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TestSessionAnswer.
-func (c *TestSessionAnswerClient) Update() *TestSessionAnswerUpdate {
-	mutation := newTestSessionAnswerMutation(c.config, OpUpdate)
-	return &TestSessionAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QuestionVersionOption.
+func (c *QuestionVersionOptionClient) Update() *QuestionVersionOptionUpdate {
+	mutation := newQuestionVersionOptionMutation(c.config, OpUpdate)
+	return &QuestionVersionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TestSessionAnswerClient) UpdateOne(tsa *TestSessionAnswer) *TestSessionAnswerUpdateOne {
-	mutation := newTestSessionAnswerMutation(c.config, OpUpdateOne, withTestSessionAnswer(tsa))
-	return &TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionVersionOptionClient) UpdateOne(qvo *QuestionVersionOption) *QuestionVersionOptionUpdateOne {
+	mutation := newQuestionVersionOptionMutation(c.config, OpUpdateOne, withQuestionVersionOption(qvo))
+	return &QuestionVersionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TestSessionAnswerClient) UpdateOneID(id uuid.UUID) *TestSessionAnswerUpdateOne {
-	mutation := newTestSessionAnswerMutation(c.config, OpUpdateOne, withTestSessionAnswerID(id))
-	return &TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionVersionOptionClient) UpdateOneID(id uuid.UUID) *QuestionVersionOptionUpdateOne {
+	mutation := newQuestionVersionOptionMutation(c.config, OpUpdateOne, withQuestionVersionOptionID(id))
+	return &QuestionVersionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TestSessionAnswer.
-func (c *TestSessionAnswerClient) Delete() *TestSessionAnswerDelete {
-	mutation := newTestSessionAnswerMutation(c.config, OpDelete)
-	return &TestSessionAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QuestionVersionOption.
+func (c *QuestionVersionOptionClient) Delete() *QuestionVersionOptionDelete {
+	mutation := newQuestionVersionOptionMutation(c.config, OpDelete)
+	return &QuestionVersionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TestSessionAnswerClient) DeleteOne(tsa *TestSessionAnswer) *TestSessionAnswerDeleteOne {
-	return c.DeleteOneID(tsa.ID)
+func (c *QuestionVersionOptionClient) DeleteOne(qvo *QuestionVersionOption) *QuestionVersionOptionDeleteOne {
+	return c.DeleteOneID(qvo.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TestSessionAnswerClient) DeleteOneID(id uuid.UUID) *TestSessionAnswerDeleteOne {
-	builder := c.Delete().Where(testsessionanswer.ID(id))
+func (c *QuestionVersionOptionClient) DeleteOneID(id uuid.UUID) *QuestionVersionOptionDeleteOne {
+	builder := c.Delete().Where(questionversionoption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TestSessionAnswerDeleteOne{builder}
+	return &QuestionVersionOptionDeleteOne{builder}
 }
 
-// Query returns a query builder for TestSessionAnswer.
-func (c *TestSessionAnswerClient) Query() *TestSessionAnswerQuery {
-	return &TestSessionAnswerQuery{
+// Query returns a query builder for QuestionVersionOption.
+func (c *QuestionVersionOptionClient) Query() *QuestionVersionOptionQuery {
+	return &QuestionVersionOptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTestSessionAnswer},
+		ctx:    &QueryContext{Type: TypeQuestionVersionOption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TestSessionAnswer entity by its id.
-func (c *TestSessionAnswerClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionAnswer, error) {
-	return c.Query().Where(testsessionanswer.ID(id)).Only(ctx)
+// Get returns a QuestionVersionOption entity by its id.
+func (c *QuestionVersionOptionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionVersionOption, error) {
+	return c.Query().Where(questionversionoption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TestSessionAnswerClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionAnswer {
+func (c *QuestionVersionOptionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionVersionOption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2900,166 +3690,2618 @@ func (c *TestSessionAnswerClient) GetX(ctx context.Context, id uuid.UUID) *TestS
 	return obj
 }
 
-// QueryQuestion queries the question edge of a TestSessionAnswer.
-func (c *TestSessionAnswerClient) QueryQuestion(tsa *TestSessionAnswer) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
+// QueryVersion queries the version edge of a QuestionVersionOption.
+func (c *QuestionVersionOptionClient) QueryVersion(qvo *QuestionVersionOption) *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tsa.ID
+		id := qvo.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.QuestionTable, testsessionanswer.QuestionColumn),
+			sqlgraph.From(questionversionoption.Table, questionversionoption.FieldID, id),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversionoption.VersionTable, questionversionoption.VersionColumn),
 		)
-		fromV = sqlgraph.Neighbors(tsa.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qvo.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTestSession queries the test_session edge of a TestSessionAnswer.
-func (c *TestSessionAnswerClient) QueryTestSession(tsa *TestSessionAnswer) *TestSessionQuery {
-	query := (&TestSessionClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *QuestionVersionOptionClient) Hooks() []Hook {
+	hooks := c.hooks.QuestionVersionOption
+	return append(hooks[:len(hooks):len(hooks)], questionversionoption.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionVersionOptionClient) Interceptors() []Interceptor {
+	inters := c.inters.QuestionVersionOption
+	return append(inters[:len(inters):len(inters)], questionversionoption.Interceptors[:]...)
+}
+
+func (c *QuestionVersionOptionClient) mutate(ctx context.Context, m *QuestionVersionOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionVersionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionVersionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionVersionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionVersionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionVersionOption mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id uuid.UUID) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id uuid.UUID) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id uuid.UUID) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id uuid.UUID) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Role.
+func (c *RoleClient) QueryUsers(r *Role) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tsa.ID
+		id := r.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, id),
-			sqlgraph.To(testsession.Table, testsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.TestSessionTable, testsessionanswer.TestSessionColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(tsa.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Role.
+func (c *RoleClient) QueryPermissions(r *Role) *PermissionQuery {
+	query := (&PermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.PermissionsTable, role.PermissionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TestSessionAnswerClient) Hooks() []Hook {
-	hooks := c.hooks.TestSessionAnswer
-	return append(hooks[:len(hooks):len(hooks)], testsessionanswer.Hooks[:]...)
+func (c *RoleClient) Hooks() []Hook {
+	hooks := c.hooks.Role
+	return append(hooks[:len(hooks):len(hooks)], role.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	inters := c.inters.Role
+	return append(inters[:len(inters):len(inters)], role.Interceptors[:]...)
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// TestClient is a client for the Test schema.
+type TestClient struct {
+	config
+}
+
+// NewTestClient returns a client for the Test from the given config.
+func NewTestClient(c config) *TestClient {
+	return &TestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `test.Hooks(f(g(h())))`.
+func (c *TestClient) Use(hooks ...Hook) {
+	c.hooks.Test = append(c.hooks.Test, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `test.Intercept(f(g(h())))`.
+func (c *TestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Test = append(c.inters.Test, interceptors...)
+}
+
+// Create returns a builder for creating a Test entity.
+func (c *TestClient) Create() *TestCreate {
+	mutation := newTestMutation(c.config, OpCreate)
+	return &TestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Test entities.
+func (c *TestClient) CreateBulk(builders ...*TestCreate) *TestCreateBulk {
+	return &TestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestClient) MapCreateBulk(slice any, setFunc func(*TestCreate, int)) *TestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestCreateBulk{err: fmt.Errorf("calling to TestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Test.
+func (c *TestClient) Update() *TestUpdate {
+	mutation := newTestMutation(c.config, OpUpdate)
+	return &TestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestClient) UpdateOne(t *Test) *TestUpdateOne {
+	mutation := newTestMutation(c.config, OpUpdateOne, withTest(t))
+	return &TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestClient) UpdateOneID(id uuid.UUID) *TestUpdateOne {
+	mutation := newTestMutation(c.config, OpUpdateOne, withTestID(id))
+	return &TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Test.
+func (c *TestClient) Delete() *TestDelete {
+	mutation := newTestMutation(c.config, OpDelete)
+	return &TestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestClient) DeleteOne(t *Test) *TestDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestClient) DeleteOneID(id uuid.UUID) *TestDeleteOne {
+	builder := c.Delete().Where(test.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestDeleteOne{builder}
+}
+
+// Query returns a query builder for Test.
+func (c *TestClient) Query() *TestQuery {
+	return &TestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Test entity by its id.
+func (c *TestClient) Get(ctx context.Context, id uuid.UUID) (*Test, error) {
+	return c.Query().Where(test.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestClient) GetX(ctx context.Context, id uuid.UUID) *Test {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Test.
+func (c *TestClient) QueryOrganization(t *Test) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.OrganizationTable, test.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourseSection queries the course_section edge of a Test.
+func (c *TestClient) QueryCourseSection(t *Test) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.CourseSectionTable, test.CourseSectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a Test.
+func (c *TestClient) QueryCourse(t *Test) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.CourseTable, test.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a Test.
+func (c *TestClient) QueryCreator(t *Test) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.CreatorTable, test.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessGrants queries the access_grants edge of a Test.
+func (c *TestClient) QueryAccessGrants(t *Test) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, test.AccessGrantsTable, test.AccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupAssignments queries the group_assignments edge of a Test.
+func (c *TestClient) QueryGroupAssignments(t *Test) *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, test.GroupAssignmentsTable, test.GroupAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessions queries the test_sessions edge of a Test.
+func (c *TestClient) QueryTestSessions(t *Test) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, test.TestSessionsTable, test.TestSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionCollections queries the question_collections edge of a Test.
+func (c *TestClient) QueryQuestionCollections(t *Test) *QuestionCollectionQuery {
+	query := (&QuestionCollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, test.QuestionCollectionsTable, test.QuestionCollectionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestQuestionCounts queries the test_question_counts edge of a Test.
+func (c *TestClient) QueryTestQuestionCounts(t *Test) *TestQuestionCountQuery {
+	query := (&TestQuestionCountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(testquestioncount.Table, testquestioncount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, test.TestQuestionCountsTable, test.TestQuestionCountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestIgnoreQuestions queries the test_ignore_questions edge of a Test.
+func (c *TestClient) QueryTestIgnoreQuestions(t *Test) *TestIgnoreQuestionQuery {
+	query := (&TestIgnoreQuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, id),
+			sqlgraph.To(testignorequestion.Table, testignorequestion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, test.TestIgnoreQuestionsTable, test.TestIgnoreQuestionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestClient) Hooks() []Hook {
+	hooks := c.hooks.Test
+	return append(hooks[:len(hooks):len(hooks)], test.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestClient) Interceptors() []Interceptor {
+	inters := c.inters.Test
+	return append(inters[:len(inters):len(inters)], test.Interceptors[:]...)
+}
+
+func (c *TestClient) mutate(ctx context.Context, m *TestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Test mutation op: %q", m.Op())
+	}
+}
+
+// TestIgnoreQuestionClient is a client for the TestIgnoreQuestion schema.
+type TestIgnoreQuestionClient struct {
+	config
+}
+
+// NewTestIgnoreQuestionClient returns a client for the TestIgnoreQuestion from the given config.
+func NewTestIgnoreQuestionClient(c config) *TestIgnoreQuestionClient {
+	return &TestIgnoreQuestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testignorequestion.Hooks(f(g(h())))`.
+func (c *TestIgnoreQuestionClient) Use(hooks ...Hook) {
+	c.hooks.TestIgnoreQuestion = append(c.hooks.TestIgnoreQuestion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testignorequestion.Intercept(f(g(h())))`.
+func (c *TestIgnoreQuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestIgnoreQuestion = append(c.inters.TestIgnoreQuestion, interceptors...)
+}
+
+// Create returns a builder for creating a TestIgnoreQuestion entity.
+func (c *TestIgnoreQuestionClient) Create() *TestIgnoreQuestionCreate {
+	mutation := newTestIgnoreQuestionMutation(c.config, OpCreate)
+	return &TestIgnoreQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestIgnoreQuestion entities.
+func (c *TestIgnoreQuestionClient) CreateBulk(builders ...*TestIgnoreQuestionCreate) *TestIgnoreQuestionCreateBulk {
+	return &TestIgnoreQuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestIgnoreQuestionClient) MapCreateBulk(slice any, setFunc func(*TestIgnoreQuestionCreate, int)) *TestIgnoreQuestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestIgnoreQuestionCreateBulk{err: fmt.Errorf("calling to TestIgnoreQuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestIgnoreQuestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestIgnoreQuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestIgnoreQuestion.
+func (c *TestIgnoreQuestionClient) Update() *TestIgnoreQuestionUpdate {
+	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdate)
+	return &TestIgnoreQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestIgnoreQuestionClient) UpdateOne(tiq *TestIgnoreQuestion) *TestIgnoreQuestionUpdateOne {
+	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdateOne, withTestIgnoreQuestion(tiq))
+	return &TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestIgnoreQuestionClient) UpdateOneID(id uuid.UUID) *TestIgnoreQuestionUpdateOne {
+	mutation := newTestIgnoreQuestionMutation(c.config, OpUpdateOne, withTestIgnoreQuestionID(id))
+	return &TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestIgnoreQuestion.
+func (c *TestIgnoreQuestionClient) Delete() *TestIgnoreQuestionDelete {
+	mutation := newTestIgnoreQuestionMutation(c.config, OpDelete)
+	return &TestIgnoreQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestIgnoreQuestionClient) DeleteOne(tiq *TestIgnoreQuestion) *TestIgnoreQuestionDeleteOne {
+	return c.DeleteOneID(tiq.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestIgnoreQuestionClient) DeleteOneID(id uuid.UUID) *TestIgnoreQuestionDeleteOne {
+	builder := c.Delete().Where(testignorequestion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestIgnoreQuestionDeleteOne{builder}
+}
+
+// Query returns a query builder for TestIgnoreQuestion.
+func (c *TestIgnoreQuestionClient) Query() *TestIgnoreQuestionQuery {
+	return &TestIgnoreQuestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestIgnoreQuestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestIgnoreQuestion entity by its id.
+func (c *TestIgnoreQuestionClient) Get(ctx context.Context, id uuid.UUID) (*TestIgnoreQuestion, error) {
+	return c.Query().Where(testignorequestion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestIgnoreQuestionClient) GetX(ctx context.Context, id uuid.UUID) *TestIgnoreQuestion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTest queries the test edge of a TestIgnoreQuestion.
+func (c *TestIgnoreQuestionClient) QueryTest(tiq *TestIgnoreQuestion) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tiq.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testignorequestion.Table, testignorequestion.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testignorequestion.TestTable, testignorequestion.TestColumn),
+		)
+		fromV = sqlgraph.Neighbors(tiq.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestion queries the question edge of a TestIgnoreQuestion.
+func (c *TestIgnoreQuestionClient) QueryQuestion(tiq *TestIgnoreQuestion) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tiq.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testignorequestion.Table, testignorequestion.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testignorequestion.QuestionTable, testignorequestion.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tiq.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestIgnoreQuestionClient) Hooks() []Hook {
+	hooks := c.hooks.TestIgnoreQuestion
+	return append(hooks[:len(hooks):len(hooks)], testignorequestion.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestIgnoreQuestionClient) Interceptors() []Interceptor {
+	inters := c.inters.TestIgnoreQuestion
+	return append(inters[:len(inters):len(inters)], testignorequestion.Interceptors[:]...)
+}
+
+func (c *TestIgnoreQuestionClient) mutate(ctx context.Context, m *TestIgnoreQuestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestIgnoreQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestIgnoreQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestIgnoreQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestIgnoreQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestIgnoreQuestion mutation op: %q", m.Op())
+	}
+}
+
+// TestQuestionCountClient is a client for the TestQuestionCount schema.
+type TestQuestionCountClient struct {
+	config
+}
+
+// NewTestQuestionCountClient returns a client for the TestQuestionCount from the given config.
+func NewTestQuestionCountClient(c config) *TestQuestionCountClient {
+	return &TestQuestionCountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testquestioncount.Hooks(f(g(h())))`.
+func (c *TestQuestionCountClient) Use(hooks ...Hook) {
+	c.hooks.TestQuestionCount = append(c.hooks.TestQuestionCount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testquestioncount.Intercept(f(g(h())))`.
+func (c *TestQuestionCountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestQuestionCount = append(c.inters.TestQuestionCount, interceptors...)
+}
+
+// Create returns a builder for creating a TestQuestionCount entity.
+func (c *TestQuestionCountClient) Create() *TestQuestionCountCreate {
+	mutation := newTestQuestionCountMutation(c.config, OpCreate)
+	return &TestQuestionCountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestQuestionCount entities.
+func (c *TestQuestionCountClient) CreateBulk(builders ...*TestQuestionCountCreate) *TestQuestionCountCreateBulk {
+	return &TestQuestionCountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestQuestionCountClient) MapCreateBulk(slice any, setFunc func(*TestQuestionCountCreate, int)) *TestQuestionCountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestQuestionCountCreateBulk{err: fmt.Errorf("calling to TestQuestionCountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestQuestionCountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestQuestionCountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestQuestionCount.
+func (c *TestQuestionCountClient) Update() *TestQuestionCountUpdate {
+	mutation := newTestQuestionCountMutation(c.config, OpUpdate)
+	return &TestQuestionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestQuestionCountClient) UpdateOne(tqc *TestQuestionCount) *TestQuestionCountUpdateOne {
+	mutation := newTestQuestionCountMutation(c.config, OpUpdateOne, withTestQuestionCount(tqc))
+	return &TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestQuestionCountClient) UpdateOneID(id uuid.UUID) *TestQuestionCountUpdateOne {
+	mutation := newTestQuestionCountMutation(c.config, OpUpdateOne, withTestQuestionCountID(id))
+	return &TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestQuestionCount.
+func (c *TestQuestionCountClient) Delete() *TestQuestionCountDelete {
+	mutation := newTestQuestionCountMutation(c.config, OpDelete)
+	return &TestQuestionCountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestQuestionCountClient) DeleteOne(tqc *TestQuestionCount) *TestQuestionCountDeleteOne {
+	return c.DeleteOneID(tqc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestQuestionCountClient) DeleteOneID(id uuid.UUID) *TestQuestionCountDeleteOne {
+	builder := c.Delete().Where(testquestioncount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestQuestionCountDeleteOne{builder}
+}
+
+// Query returns a query builder for TestQuestionCount.
+func (c *TestQuestionCountClient) Query() *TestQuestionCountQuery {
+	return &TestQuestionCountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestQuestionCount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestQuestionCount entity by its id.
+func (c *TestQuestionCountClient) Get(ctx context.Context, id uuid.UUID) (*TestQuestionCount, error) {
+	return c.Query().Where(testquestioncount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestQuestionCountClient) GetX(ctx context.Context, id uuid.UUID) *TestQuestionCount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTest queries the test edge of a TestQuestionCount.
+func (c *TestQuestionCountClient) QueryTest(tqc *TestQuestionCount) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tqc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testquestioncount.Table, testquestioncount.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testquestioncount.TestTable, testquestioncount.TestColumn),
+		)
+		fromV = sqlgraph.Neighbors(tqc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestQuestionCountClient) Hooks() []Hook {
+	hooks := c.hooks.TestQuestionCount
+	return append(hooks[:len(hooks):len(hooks)], testquestioncount.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestQuestionCountClient) Interceptors() []Interceptor {
+	inters := c.inters.TestQuestionCount
+	return append(inters[:len(inters):len(inters)], testquestioncount.Interceptors[:]...)
+}
+
+func (c *TestQuestionCountClient) mutate(ctx context.Context, m *TestQuestionCountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestQuestionCountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestQuestionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestQuestionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestQuestionCountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestQuestionCount mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionClient is a client for the TestSession schema.
+type TestSessionClient struct {
+	config
+}
+
+// NewTestSessionClient returns a client for the TestSession from the given config.
+func NewTestSessionClient(c config) *TestSessionClient {
+	return &TestSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsession.Hooks(f(g(h())))`.
+func (c *TestSessionClient) Use(hooks ...Hook) {
+	c.hooks.TestSession = append(c.hooks.TestSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsession.Intercept(f(g(h())))`.
+func (c *TestSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSession = append(c.inters.TestSession, interceptors...)
+}
+
+// Create returns a builder for creating a TestSession entity.
+func (c *TestSessionClient) Create() *TestSessionCreate {
+	mutation := newTestSessionMutation(c.config, OpCreate)
+	return &TestSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSession entities.
+func (c *TestSessionClient) CreateBulk(builders ...*TestSessionCreate) *TestSessionCreateBulk {
+	return &TestSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionClient) MapCreateBulk(slice any, setFunc func(*TestSessionCreate, int)) *TestSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionCreateBulk{err: fmt.Errorf("calling to TestSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSession.
+func (c *TestSessionClient) Update() *TestSessionUpdate {
+	mutation := newTestSessionMutation(c.config, OpUpdate)
+	return &TestSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionClient) UpdateOne(ts *TestSession) *TestSessionUpdateOne {
+	mutation := newTestSessionMutation(c.config, OpUpdateOne, withTestSession(ts))
+	return &TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionClient) UpdateOneID(id uuid.UUID) *TestSessionUpdateOne {
+	mutation := newTestSessionMutation(c.config, OpUpdateOne, withTestSessionID(id))
+	return &TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSession.
+func (c *TestSessionClient) Delete() *TestSessionDelete {
+	mutation := newTestSessionMutation(c.config, OpDelete)
+	return &TestSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionClient) DeleteOne(ts *TestSession) *TestSessionDeleteOne {
+	return c.DeleteOneID(ts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionClient) DeleteOneID(id uuid.UUID) *TestSessionDeleteOne {
+	builder := c.Delete().Where(testsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSession.
+func (c *TestSessionClient) Query() *TestSessionQuery {
+	return &TestSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSession entity by its id.
+func (c *TestSessionClient) Get(ctx context.Context, id uuid.UUID) (*TestSession, error) {
+	return c.Query().Where(testsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionClient) GetX(ctx context.Context, id uuid.UUID) *TestSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TestSession.
+func (c *TestSessionClient) QueryUser(ts *TestSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsession.UserTable, testsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourseSection queries the course_section edge of a TestSession.
+func (c *TestSessionClient) QueryCourseSection(ts *TestSession) *CourseSectionQuery {
+	query := (&CourseSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsession.CourseSectionTable, testsession.CourseSectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTest queries the test edge of a TestSession.
+func (c *TestSessionClient) QueryTest(ts *TestSession) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsession.TestTable, testsession.TestColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupAssignment queries the group_assignment edge of a TestSession.
+func (c *TestSessionClient) QueryGroupAssignment(ts *TestSession) *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsession.GroupAssignmentTable, testsession.GroupAssignmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessionAnswers queries the test_session_answers edge of a TestSession.
+func (c *TestSessionClient) QueryTestSessionAnswers(ts *TestSession) *TestSessionAnswerQuery {
+	query := (&TestSessionAnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(testsessionanswer.Table, testsessionanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.TestSessionAnswersTable, testsession.TestSessionAnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIntegrityEvents queries the integrity_events edge of a TestSession.
+func (c *TestSessionClient) QueryIntegrityEvents(ts *TestSession) *TestSessionIntegrityEventQuery {
+	query := (&TestSessionIntegrityEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(testsessionintegrityevent.Table, testsessionintegrityevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.IntegrityEventsTable, testsession.IntegrityEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBreaks queries the breaks edge of a TestSession.
+func (c *TestSessionClient) QueryBreaks(ts *TestSession) *TestSessionBreakQuery {
+	query := (&TestSessionBreakClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(testsessionbreak.Table, testsessionbreak.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.BreaksTable, testsession.BreaksColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimeExtensions queries the time_extensions edge of a TestSession.
+func (c *TestSessionClient) QueryTimeExtensions(ts *TestSession) *TestSessionTimeExtensionQuery {
+	query := (&TestSessionTimeExtensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(testsessiontimeextension.Table, testsessiontimeextension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.TimeExtensionsTable, testsession.TimeExtensionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRegrades queries the regrades edge of a TestSession.
+func (c *TestSessionClient) QueryRegrades(ts *TestSession) *TestSessionRegradeQuery {
+	query := (&TestSessionRegradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, id),
+			sqlgraph.To(testsessionregrade.Table, testsessionregrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.RegradesTable, testsession.RegradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionClient) Hooks() []Hook {
+	hooks := c.hooks.TestSession
+	return append(hooks[:len(hooks):len(hooks)], testsession.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSession
+	return append(inters[:len(inters):len(inters)], testsession.Interceptors[:]...)
+}
+
+func (c *TestSessionClient) mutate(ctx context.Context, m *TestSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSession mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionAnswerClient is a client for the TestSessionAnswer schema.
+type TestSessionAnswerClient struct {
+	config
+}
+
+// NewTestSessionAnswerClient returns a client for the TestSessionAnswer from the given config.
+func NewTestSessionAnswerClient(c config) *TestSessionAnswerClient {
+	return &TestSessionAnswerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsessionanswer.Hooks(f(g(h())))`.
+func (c *TestSessionAnswerClient) Use(hooks ...Hook) {
+	c.hooks.TestSessionAnswer = append(c.hooks.TestSessionAnswer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsessionanswer.Intercept(f(g(h())))`.
+func (c *TestSessionAnswerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSessionAnswer = append(c.inters.TestSessionAnswer, interceptors...)
+}
+
+// Create returns a builder for creating a TestSessionAnswer entity.
+func (c *TestSessionAnswerClient) Create() *TestSessionAnswerCreate {
+	mutation := newTestSessionAnswerMutation(c.config, OpCreate)
+	return &TestSessionAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSessionAnswer entities.
+func (c *TestSessionAnswerClient) CreateBulk(builders ...*TestSessionAnswerCreate) *TestSessionAnswerCreateBulk {
+	return &TestSessionAnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionAnswerClient) MapCreateBulk(slice any, setFunc func(*TestSessionAnswerCreate, int)) *TestSessionAnswerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionAnswerCreateBulk{err: fmt.Errorf("calling to TestSessionAnswerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionAnswerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionAnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSessionAnswer.
+func (c *TestSessionAnswerClient) Update() *TestSessionAnswerUpdate {
+	mutation := newTestSessionAnswerMutation(c.config, OpUpdate)
+	return &TestSessionAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionAnswerClient) UpdateOne(tsa *TestSessionAnswer) *TestSessionAnswerUpdateOne {
+	mutation := newTestSessionAnswerMutation(c.config, OpUpdateOne, withTestSessionAnswer(tsa))
+	return &TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionAnswerClient) UpdateOneID(id uuid.UUID) *TestSessionAnswerUpdateOne {
+	mutation := newTestSessionAnswerMutation(c.config, OpUpdateOne, withTestSessionAnswerID(id))
+	return &TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSessionAnswer.
+func (c *TestSessionAnswerClient) Delete() *TestSessionAnswerDelete {
+	mutation := newTestSessionAnswerMutation(c.config, OpDelete)
+	return &TestSessionAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionAnswerClient) DeleteOne(tsa *TestSessionAnswer) *TestSessionAnswerDeleteOne {
+	return c.DeleteOneID(tsa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionAnswerClient) DeleteOneID(id uuid.UUID) *TestSessionAnswerDeleteOne {
+	builder := c.Delete().Where(testsessionanswer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionAnswerDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSessionAnswer.
+func (c *TestSessionAnswerClient) Query() *TestSessionAnswerQuery {
+	return &TestSessionAnswerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSessionAnswer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSessionAnswer entity by its id.
+func (c *TestSessionAnswerClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionAnswer, error) {
+	return c.Query().Where(testsessionanswer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionAnswerClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionAnswer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuestion queries the question edge of a TestSessionAnswer.
+func (c *TestSessionAnswerClient) QueryQuestion(tsa *TestSessionAnswer) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.QuestionTable, testsessionanswer.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionVersion queries the question_version edge of a TestSessionAnswer.
+func (c *TestSessionAnswerClient) QueryQuestionVersion(tsa *TestSessionAnswer) *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, id),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.QuestionVersionTable, testsessionanswer.QuestionVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSession queries the test_session edge of a TestSessionAnswer.
+func (c *TestSessionAnswerClient) QueryTestSession(tsa *TestSessionAnswer) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.TestSessionTable, testsessionanswer.TestSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionAnswerClient) Hooks() []Hook {
+	hooks := c.hooks.TestSessionAnswer
+	return append(hooks[:len(hooks):len(hooks)], testsessionanswer.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionAnswerClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSessionAnswer
+	return append(inters[:len(inters):len(inters)], testsessionanswer.Interceptors[:]...)
+}
+
+func (c *TestSessionAnswerClient) mutate(ctx context.Context, m *TestSessionAnswerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSessionAnswer mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionBreakClient is a client for the TestSessionBreak schema.
+type TestSessionBreakClient struct {
+	config
+}
+
+// NewTestSessionBreakClient returns a client for the TestSessionBreak from the given config.
+func NewTestSessionBreakClient(c config) *TestSessionBreakClient {
+	return &TestSessionBreakClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsessionbreak.Hooks(f(g(h())))`.
+func (c *TestSessionBreakClient) Use(hooks ...Hook) {
+	c.hooks.TestSessionBreak = append(c.hooks.TestSessionBreak, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsessionbreak.Intercept(f(g(h())))`.
+func (c *TestSessionBreakClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSessionBreak = append(c.inters.TestSessionBreak, interceptors...)
+}
+
+// Create returns a builder for creating a TestSessionBreak entity.
+func (c *TestSessionBreakClient) Create() *TestSessionBreakCreate {
+	mutation := newTestSessionBreakMutation(c.config, OpCreate)
+	return &TestSessionBreakCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSessionBreak entities.
+func (c *TestSessionBreakClient) CreateBulk(builders ...*TestSessionBreakCreate) *TestSessionBreakCreateBulk {
+	return &TestSessionBreakCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionBreakClient) MapCreateBulk(slice any, setFunc func(*TestSessionBreakCreate, int)) *TestSessionBreakCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionBreakCreateBulk{err: fmt.Errorf("calling to TestSessionBreakClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionBreakCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionBreakCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSessionBreak.
+func (c *TestSessionBreakClient) Update() *TestSessionBreakUpdate {
+	mutation := newTestSessionBreakMutation(c.config, OpUpdate)
+	return &TestSessionBreakUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionBreakClient) UpdateOne(tsb *TestSessionBreak) *TestSessionBreakUpdateOne {
+	mutation := newTestSessionBreakMutation(c.config, OpUpdateOne, withTestSessionBreak(tsb))
+	return &TestSessionBreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionBreakClient) UpdateOneID(id uuid.UUID) *TestSessionBreakUpdateOne {
+	mutation := newTestSessionBreakMutation(c.config, OpUpdateOne, withTestSessionBreakID(id))
+	return &TestSessionBreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSessionBreak.
+func (c *TestSessionBreakClient) Delete() *TestSessionBreakDelete {
+	mutation := newTestSessionBreakMutation(c.config, OpDelete)
+	return &TestSessionBreakDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionBreakClient) DeleteOne(tsb *TestSessionBreak) *TestSessionBreakDeleteOne {
+	return c.DeleteOneID(tsb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionBreakClient) DeleteOneID(id uuid.UUID) *TestSessionBreakDeleteOne {
+	builder := c.Delete().Where(testsessionbreak.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionBreakDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSessionBreak.
+func (c *TestSessionBreakClient) Query() *TestSessionBreakQuery {
+	return &TestSessionBreakQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSessionBreak},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSessionBreak entity by its id.
+func (c *TestSessionBreakClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionBreak, error) {
+	return c.Query().Where(testsessionbreak.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionBreakClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionBreak {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTestSession queries the test_session edge of a TestSessionBreak.
+func (c *TestSessionBreakClient) QueryTestSession(tsb *TestSessionBreak) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionbreak.Table, testsessionbreak.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionbreak.TestSessionTable, testsessionbreak.TestSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionBreakClient) Hooks() []Hook {
+	hooks := c.hooks.TestSessionBreak
+	return append(hooks[:len(hooks):len(hooks)], testsessionbreak.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionBreakClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSessionBreak
+	return append(inters[:len(inters):len(inters)], testsessionbreak.Interceptors[:]...)
+}
+
+func (c *TestSessionBreakClient) mutate(ctx context.Context, m *TestSessionBreakMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionBreakCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionBreakUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionBreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionBreakDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSessionBreak mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionIntegrityEventClient is a client for the TestSessionIntegrityEvent schema.
+type TestSessionIntegrityEventClient struct {
+	config
+}
+
+// NewTestSessionIntegrityEventClient returns a client for the TestSessionIntegrityEvent from the given config.
+func NewTestSessionIntegrityEventClient(c config) *TestSessionIntegrityEventClient {
+	return &TestSessionIntegrityEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsessionintegrityevent.Hooks(f(g(h())))`.
+func (c *TestSessionIntegrityEventClient) Use(hooks ...Hook) {
+	c.hooks.TestSessionIntegrityEvent = append(c.hooks.TestSessionIntegrityEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsessionintegrityevent.Intercept(f(g(h())))`.
+func (c *TestSessionIntegrityEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSessionIntegrityEvent = append(c.inters.TestSessionIntegrityEvent, interceptors...)
+}
+
+// Create returns a builder for creating a TestSessionIntegrityEvent entity.
+func (c *TestSessionIntegrityEventClient) Create() *TestSessionIntegrityEventCreate {
+	mutation := newTestSessionIntegrityEventMutation(c.config, OpCreate)
+	return &TestSessionIntegrityEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSessionIntegrityEvent entities.
+func (c *TestSessionIntegrityEventClient) CreateBulk(builders ...*TestSessionIntegrityEventCreate) *TestSessionIntegrityEventCreateBulk {
+	return &TestSessionIntegrityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionIntegrityEventClient) MapCreateBulk(slice any, setFunc func(*TestSessionIntegrityEventCreate, int)) *TestSessionIntegrityEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionIntegrityEventCreateBulk{err: fmt.Errorf("calling to TestSessionIntegrityEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionIntegrityEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionIntegrityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSessionIntegrityEvent.
+func (c *TestSessionIntegrityEventClient) Update() *TestSessionIntegrityEventUpdate {
+	mutation := newTestSessionIntegrityEventMutation(c.config, OpUpdate)
+	return &TestSessionIntegrityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionIntegrityEventClient) UpdateOne(tsie *TestSessionIntegrityEvent) *TestSessionIntegrityEventUpdateOne {
+	mutation := newTestSessionIntegrityEventMutation(c.config, OpUpdateOne, withTestSessionIntegrityEvent(tsie))
+	return &TestSessionIntegrityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionIntegrityEventClient) UpdateOneID(id uuid.UUID) *TestSessionIntegrityEventUpdateOne {
+	mutation := newTestSessionIntegrityEventMutation(c.config, OpUpdateOne, withTestSessionIntegrityEventID(id))
+	return &TestSessionIntegrityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSessionIntegrityEvent.
+func (c *TestSessionIntegrityEventClient) Delete() *TestSessionIntegrityEventDelete {
+	mutation := newTestSessionIntegrityEventMutation(c.config, OpDelete)
+	return &TestSessionIntegrityEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionIntegrityEventClient) DeleteOne(tsie *TestSessionIntegrityEvent) *TestSessionIntegrityEventDeleteOne {
+	return c.DeleteOneID(tsie.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionIntegrityEventClient) DeleteOneID(id uuid.UUID) *TestSessionIntegrityEventDeleteOne {
+	builder := c.Delete().Where(testsessionintegrityevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionIntegrityEventDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSessionIntegrityEvent.
+func (c *TestSessionIntegrityEventClient) Query() *TestSessionIntegrityEventQuery {
+	return &TestSessionIntegrityEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSessionIntegrityEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSessionIntegrityEvent entity by its id.
+func (c *TestSessionIntegrityEventClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionIntegrityEvent, error) {
+	return c.Query().Where(testsessionintegrityevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionIntegrityEventClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionIntegrityEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTestSession queries the test_session edge of a TestSessionIntegrityEvent.
+func (c *TestSessionIntegrityEventClient) QueryTestSession(tsie *TestSessionIntegrityEvent) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsie.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionintegrityevent.Table, testsessionintegrityevent.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionintegrityevent.TestSessionTable, testsessionintegrityevent.TestSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsie.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionIntegrityEventClient) Hooks() []Hook {
+	hooks := c.hooks.TestSessionIntegrityEvent
+	return append(hooks[:len(hooks):len(hooks)], testsessionintegrityevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionIntegrityEventClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSessionIntegrityEvent
+	return append(inters[:len(inters):len(inters)], testsessionintegrityevent.Interceptors[:]...)
+}
+
+func (c *TestSessionIntegrityEventClient) mutate(ctx context.Context, m *TestSessionIntegrityEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionIntegrityEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionIntegrityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionIntegrityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionIntegrityEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSessionIntegrityEvent mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionRegradeClient is a client for the TestSessionRegrade schema.
+type TestSessionRegradeClient struct {
+	config
+}
+
+// NewTestSessionRegradeClient returns a client for the TestSessionRegrade from the given config.
+func NewTestSessionRegradeClient(c config) *TestSessionRegradeClient {
+	return &TestSessionRegradeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsessionregrade.Hooks(f(g(h())))`.
+func (c *TestSessionRegradeClient) Use(hooks ...Hook) {
+	c.hooks.TestSessionRegrade = append(c.hooks.TestSessionRegrade, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsessionregrade.Intercept(f(g(h())))`.
+func (c *TestSessionRegradeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSessionRegrade = append(c.inters.TestSessionRegrade, interceptors...)
+}
+
+// Create returns a builder for creating a TestSessionRegrade entity.
+func (c *TestSessionRegradeClient) Create() *TestSessionRegradeCreate {
+	mutation := newTestSessionRegradeMutation(c.config, OpCreate)
+	return &TestSessionRegradeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSessionRegrade entities.
+func (c *TestSessionRegradeClient) CreateBulk(builders ...*TestSessionRegradeCreate) *TestSessionRegradeCreateBulk {
+	return &TestSessionRegradeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionRegradeClient) MapCreateBulk(slice any, setFunc func(*TestSessionRegradeCreate, int)) *TestSessionRegradeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionRegradeCreateBulk{err: fmt.Errorf("calling to TestSessionRegradeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionRegradeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionRegradeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSessionRegrade.
+func (c *TestSessionRegradeClient) Update() *TestSessionRegradeUpdate {
+	mutation := newTestSessionRegradeMutation(c.config, OpUpdate)
+	return &TestSessionRegradeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionRegradeClient) UpdateOne(tsr *TestSessionRegrade) *TestSessionRegradeUpdateOne {
+	mutation := newTestSessionRegradeMutation(c.config, OpUpdateOne, withTestSessionRegrade(tsr))
+	return &TestSessionRegradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionRegradeClient) UpdateOneID(id uuid.UUID) *TestSessionRegradeUpdateOne {
+	mutation := newTestSessionRegradeMutation(c.config, OpUpdateOne, withTestSessionRegradeID(id))
+	return &TestSessionRegradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSessionRegrade.
+func (c *TestSessionRegradeClient) Delete() *TestSessionRegradeDelete {
+	mutation := newTestSessionRegradeMutation(c.config, OpDelete)
+	return &TestSessionRegradeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionRegradeClient) DeleteOne(tsr *TestSessionRegrade) *TestSessionRegradeDeleteOne {
+	return c.DeleteOneID(tsr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionRegradeClient) DeleteOneID(id uuid.UUID) *TestSessionRegradeDeleteOne {
+	builder := c.Delete().Where(testsessionregrade.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionRegradeDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSessionRegrade.
+func (c *TestSessionRegradeClient) Query() *TestSessionRegradeQuery {
+	return &TestSessionRegradeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSessionRegrade},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSessionRegrade entity by its id.
+func (c *TestSessionRegradeClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionRegrade, error) {
+	return c.Query().Where(testsessionregrade.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionRegradeClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionRegrade {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTestSession queries the test_session edge of a TestSessionRegrade.
+func (c *TestSessionRegradeClient) QueryTestSession(tsr *TestSessionRegrade) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionregrade.Table, testsessionregrade.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionregrade.TestSessionTable, testsessionregrade.TestSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRegradedBy queries the regraded_by edge of a TestSessionRegrade.
+func (c *TestSessionRegradeClient) QueryRegradedBy(tsr *TestSessionRegrade) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionregrade.Table, testsessionregrade.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionregrade.RegradedByTable, testsessionregrade.RegradedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionRegradeClient) Hooks() []Hook {
+	hooks := c.hooks.TestSessionRegrade
+	return append(hooks[:len(hooks):len(hooks)], testsessionregrade.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionRegradeClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSessionRegrade
+	return append(inters[:len(inters):len(inters)], testsessionregrade.Interceptors[:]...)
+}
+
+func (c *TestSessionRegradeClient) mutate(ctx context.Context, m *TestSessionRegradeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionRegradeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionRegradeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionRegradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionRegradeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSessionRegrade mutation op: %q", m.Op())
+	}
+}
+
+// TestSessionTimeExtensionClient is a client for the TestSessionTimeExtension schema.
+type TestSessionTimeExtensionClient struct {
+	config
+}
+
+// NewTestSessionTimeExtensionClient returns a client for the TestSessionTimeExtension from the given config.
+func NewTestSessionTimeExtensionClient(c config) *TestSessionTimeExtensionClient {
+	return &TestSessionTimeExtensionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testsessiontimeextension.Hooks(f(g(h())))`.
+func (c *TestSessionTimeExtensionClient) Use(hooks ...Hook) {
+	c.hooks.TestSessionTimeExtension = append(c.hooks.TestSessionTimeExtension, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testsessiontimeextension.Intercept(f(g(h())))`.
+func (c *TestSessionTimeExtensionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestSessionTimeExtension = append(c.inters.TestSessionTimeExtension, interceptors...)
+}
+
+// Create returns a builder for creating a TestSessionTimeExtension entity.
+func (c *TestSessionTimeExtensionClient) Create() *TestSessionTimeExtensionCreate {
+	mutation := newTestSessionTimeExtensionMutation(c.config, OpCreate)
+	return &TestSessionTimeExtensionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestSessionTimeExtension entities.
+func (c *TestSessionTimeExtensionClient) CreateBulk(builders ...*TestSessionTimeExtensionCreate) *TestSessionTimeExtensionCreateBulk {
+	return &TestSessionTimeExtensionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestSessionTimeExtensionClient) MapCreateBulk(slice any, setFunc func(*TestSessionTimeExtensionCreate, int)) *TestSessionTimeExtensionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestSessionTimeExtensionCreateBulk{err: fmt.Errorf("calling to TestSessionTimeExtensionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestSessionTimeExtensionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestSessionTimeExtensionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestSessionTimeExtension.
+func (c *TestSessionTimeExtensionClient) Update() *TestSessionTimeExtensionUpdate {
+	mutation := newTestSessionTimeExtensionMutation(c.config, OpUpdate)
+	return &TestSessionTimeExtensionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestSessionTimeExtensionClient) UpdateOne(tste *TestSessionTimeExtension) *TestSessionTimeExtensionUpdateOne {
+	mutation := newTestSessionTimeExtensionMutation(c.config, OpUpdateOne, withTestSessionTimeExtension(tste))
+	return &TestSessionTimeExtensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestSessionTimeExtensionClient) UpdateOneID(id uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	mutation := newTestSessionTimeExtensionMutation(c.config, OpUpdateOne, withTestSessionTimeExtensionID(id))
+	return &TestSessionTimeExtensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestSessionTimeExtension.
+func (c *TestSessionTimeExtensionClient) Delete() *TestSessionTimeExtensionDelete {
+	mutation := newTestSessionTimeExtensionMutation(c.config, OpDelete)
+	return &TestSessionTimeExtensionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestSessionTimeExtensionClient) DeleteOne(tste *TestSessionTimeExtension) *TestSessionTimeExtensionDeleteOne {
+	return c.DeleteOneID(tste.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestSessionTimeExtensionClient) DeleteOneID(id uuid.UUID) *TestSessionTimeExtensionDeleteOne {
+	builder := c.Delete().Where(testsessiontimeextension.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestSessionTimeExtensionDeleteOne{builder}
+}
+
+// Query returns a query builder for TestSessionTimeExtension.
+func (c *TestSessionTimeExtensionClient) Query() *TestSessionTimeExtensionQuery {
+	return &TestSessionTimeExtensionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestSessionTimeExtension},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestSessionTimeExtension entity by its id.
+func (c *TestSessionTimeExtensionClient) Get(ctx context.Context, id uuid.UUID) (*TestSessionTimeExtension, error) {
+	return c.Query().Where(testsessiontimeextension.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestSessionTimeExtensionClient) GetX(ctx context.Context, id uuid.UUID) *TestSessionTimeExtension {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTestSession queries the test_session edge of a TestSessionTimeExtension.
+func (c *TestSessionTimeExtensionClient) QueryTestSession(tste *TestSessionTimeExtension) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tste.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessiontimeextension.Table, testsessiontimeextension.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessiontimeextension.TestSessionTable, testsessiontimeextension.TestSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(tste.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExtendedBy queries the extended_by edge of a TestSessionTimeExtension.
+func (c *TestSessionTimeExtensionClient) QueryExtendedBy(tste *TestSessionTimeExtension) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tste.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessiontimeextension.Table, testsessiontimeextension.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessiontimeextension.ExtendedByTable, testsessiontimeextension.ExtendedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(tste.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestSessionTimeExtensionClient) Hooks() []Hook {
+	hooks := c.hooks.TestSessionTimeExtension
+	return append(hooks[:len(hooks):len(hooks)], testsessiontimeextension.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestSessionTimeExtensionClient) Interceptors() []Interceptor {
+	inters := c.inters.TestSessionTimeExtension
+	return append(inters[:len(inters):len(inters)], testsessiontimeextension.Interceptors[:]...)
+}
+
+func (c *TestSessionTimeExtensionClient) mutate(ctx context.Context, m *TestSessionTimeExtensionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestSessionTimeExtensionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestSessionTimeExtensionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestSessionTimeExtensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestSessionTimeExtensionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestSessionTimeExtension mutation op: %q", m.Op())
+	}
+}
+
+// TodoClient is a client for the Todo schema.
+type TodoClient struct {
+	config
+}
+
+// NewTodoClient returns a client for the Todo from the given config.
+func NewTodoClient(c config) *TodoClient {
+	return &TodoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todo.Hooks(f(g(h())))`.
+func (c *TodoClient) Use(hooks ...Hook) {
+	c.hooks.Todo = append(c.hooks.Todo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todo.Intercept(f(g(h())))`.
+func (c *TodoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Todo = append(c.inters.Todo, interceptors...)
+}
+
+// Create returns a builder for creating a Todo entity.
+func (c *TodoClient) Create() *TodoCreate {
+	mutation := newTodoMutation(c.config, OpCreate)
+	return &TodoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Todo entities.
+func (c *TodoClient) CreateBulk(builders ...*TodoCreate) *TodoCreateBulk {
+	return &TodoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoClient) MapCreateBulk(slice any, setFunc func(*TodoCreate, int)) *TodoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoCreateBulk{err: fmt.Errorf("calling to TodoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Todo.
+func (c *TodoClient) Update() *TodoUpdate {
+	mutation := newTodoMutation(c.config, OpUpdate)
+	return &TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoClient) UpdateOne(t *Todo) *TodoUpdateOne {
+	mutation := newTodoMutation(c.config, OpUpdateOne, withTodo(t))
+	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoClient) UpdateOneID(id uuid.UUID) *TodoUpdateOne {
+	mutation := newTodoMutation(c.config, OpUpdateOne, withTodoID(id))
+	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Todo.
+func (c *TodoClient) Delete() *TodoDelete {
+	mutation := newTodoMutation(c.config, OpDelete)
+	return &TodoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoClient) DeleteOne(t *Todo) *TodoDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoClient) DeleteOneID(id uuid.UUID) *TodoDeleteOne {
+	builder := c.Delete().Where(todo.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoDeleteOne{builder}
+}
+
+// Query returns a query builder for Todo.
+func (c *TodoClient) Query() *TodoQuery {
+	return &TodoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodo},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Todo entity by its id.
+func (c *TodoClient) Get(ctx context.Context, id uuid.UUID) (*Todo, error) {
+	return c.Query().Where(todo.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoClient) GetX(ctx context.Context, id uuid.UUID) *Todo {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TodoClient) Hooks() []Hook {
+	hooks := c.hooks.Todo
+	return append(hooks[:len(hooks):len(hooks)], todo.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoClient) Interceptors() []Interceptor {
+	inters := c.inters.Todo
+	return append(inters[:len(inters):len(inters)], todo.Interceptors[:]...)
+}
+
+func (c *TodoClient) mutate(ctx context.Context, m *TodoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Todo mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a User.
+func (c *UserClient) QueryOrganization(u *User) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.OrganizationTable, user.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMedia queries the media edge of a User.
+func (c *UserClient) QueryMedia(u *User) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.MediaTable, user.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaUploader queries the media_uploader edge of a User.
+func (c *UserClient) QueryMediaUploader(u *User) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MediaUploaderTable, user.MediaUploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a User.
+func (c *UserClient) QueryRoles(u *User) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourseCreator queries the course_creator edge of a User.
+func (c *UserClient) QueryCourseCreator(u *User) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CourseCreatorTable, user.CourseCreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionCollections queries the question_collections edge of a User.
+func (c *UserClient) QueryQuestionCollections(u *User) *QuestionCollectionQuery {
+	query := (&QuestionCollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuestionCollectionsTable, user.QuestionCollectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessions queries the test_sessions edge of a User.
+func (c *UserClient) QueryTestSessions(u *User) *TestSessionQuery {
+	query := (&TestSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionsTable, user.TestSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJwtTokens queries the jwt_tokens edge of a User.
+func (c *UserClient) QueryJwtTokens(u *User) *JwtTokenQuery {
+	query := (&JwtTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(jwttoken.Table, jwttoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.JwtTokensTable, user.JwtTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourseEnrollments queries the course_enrollments edge of a User.
+func (c *UserClient) QueryCourseEnrollments(u *User) *CourseEnrollmentQuery {
+	query := (&CourseEnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(courseenrollment.Table, courseenrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CourseEnrollmentsTable, user.CourseEnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccommodation queries the accommodation edge of a User.
+func (c *UserClient) QueryAccommodation(u *User) *UserAccommodationQuery {
+	query := (&UserAccommodationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useraccommodation.Table, useraccommodation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.AccommodationTable, user.AccommodationColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessionTimeExtensions queries the test_session_time_extensions edge of a User.
+func (c *UserClient) QueryTestSessionTimeExtensions(u *User) *TestSessionTimeExtensionQuery {
+	query := (&TestSessionTimeExtensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(testsessiontimeextension.Table, testsessiontimeextension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionTimeExtensionsTable, user.TestSessionTimeExtensionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestSessionRegrades queries the test_session_regrades edge of a User.
+func (c *UserClient) QueryTestSessionRegrades(u *User) *TestSessionRegradeQuery {
+	query := (&TestSessionRegradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(testsessionregrade.Table, testsessionregrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionRegradesTable, user.TestSessionRegradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionVersions queries the question_versions edge of a User.
+func (c *UserClient) QueryQuestionVersions(u *User) *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuestionVersionsTable, user.QuestionVersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessGrants queries the access_grants edge of a User.
+func (c *UserClient) QueryAccessGrants(u *User) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccessGrantsTable, user.AccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrantedAccessGrants queries the granted_access_grants edge of a User.
+func (c *UserClient) QueryGrantedAccessGrants(u *User) *AccessGrantQuery {
+	query := (&AccessGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GrantedAccessGrantsTable, user.GrantedAccessGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedTests queries the created_tests edge of a User.
+func (c *UserClient) QueryCreatedTests(u *User) *TestQuery {
+	query := (&TestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedTestsTable, user.CreatedTestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedGroups queries the created_groups edge of a User.
+func (c *UserClient) QueryCreatedGroups(u *User) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedGroupsTable, user.CreatedGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberGroups queries the member_groups edge of a User.
+func (c *UserClient) QueryMemberGroups(u *User) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.MemberGroupsTable, user.MemberGroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupTestAssignments queries the group_test_assignments edge of a User.
+func (c *UserClient) QueryGroupTestAssignments(u *User) *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupTestAssignmentsTable, user.GroupTestAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a User.
+func (c *UserClient) QueryInvites(u *User) *UserInviteQuery {
+	query := (&UserInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userinvite.Table, userinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitesTable, user.InvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TestSessionAnswerClient) Interceptors() []Interceptor {
-	inters := c.inters.TestSessionAnswer
-	return append(inters[:len(inters):len(inters)], testsessionanswer.Interceptors[:]...)
+func (c *UserClient) Interceptors() []Interceptor {
+	inters := c.inters.User
+	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
 }
 
-func (c *TestSessionAnswerClient) mutate(ctx context.Context, m *TestSessionAnswerMutation) (Value, error) {
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TestSessionAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TestSessionAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TestSessionAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TestSessionAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TestSessionAnswer mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
-// TodoClient is a client for the Todo schema.
-type TodoClient struct {
+// UserAccommodationClient is a client for the UserAccommodation schema.
+type UserAccommodationClient struct {
 	config
 }
 
-// NewTodoClient returns a client for the Todo from the given config.
-func NewTodoClient(c config) *TodoClient {
-	return &TodoClient{config: c}
+// NewUserAccommodationClient returns a client for the UserAccommodation from the given config.
+func NewUserAccommodationClient(c config) *UserAccommodationClient {
+	return &UserAccommodationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `todo.Hooks(f(g(h())))`.
-func (c *TodoClient) Use(hooks ...Hook) {
-	c.hooks.Todo = append(c.hooks.Todo, hooks...)
+// A call to `Use(f, g, h)` equals to `useraccommodation.Hooks(f(g(h())))`.
+func (c *UserAccommodationClient) Use(hooks ...Hook) {
+	c.hooks.UserAccommodation = append(c.hooks.UserAccommodation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `todo.Intercept(f(g(h())))`.
-func (c *TodoClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Todo = append(c.inters.Todo, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `useraccommodation.Intercept(f(g(h())))`.
+func (c *UserAccommodationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserAccommodation = append(c.inters.UserAccommodation, interceptors...)
 }
 
-// Create returns a builder for creating a Todo entity.
-func (c *TodoClient) Create() *TodoCreate {
-	mutation := newTodoMutation(c.config, OpCreate)
-	return &TodoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserAccommodation entity.
+func (c *UserAccommodationClient) Create() *UserAccommodationCreate {
+	mutation := newUserAccommodationMutation(c.config, OpCreate)
+	return &UserAccommodationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Todo entities.
-func (c *TodoClient) CreateBulk(builders ...*TodoCreate) *TodoCreateBulk {
-	return &TodoCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserAccommodation entities.
+func (c *UserAccommodationClient) CreateBulk(builders ...*UserAccommodationCreate) *UserAccommodationCreateBulk {
+	return &UserAccommodationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TodoClient) MapCreateBulk(slice any, setFunc func(*TodoCreate, int)) *TodoCreateBulk {
+func (c *UserAccommodationClient) MapCreateBulk(slice any, setFunc func(*UserAccommodationCreate, int)) *UserAccommodationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TodoCreateBulk{err: fmt.Errorf("calling to TodoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserAccommodationCreateBulk{err: fmt.Errorf("calling to UserAccommodationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TodoCreate, rv.Len())
+	builders := make([]*UserAccommodationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TodoCreateBulk{config: c.config, builders: builders}
+	return &UserAccommodationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Todo.
-func (c *TodoClient) Update() *TodoUpdate {
-	mutation := newTodoMutation(c.config, OpUpdate)
-	return &TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserAccommodation.
+func (c *UserAccommodationClient) Update() *UserAccommodationUpdate {
+	mutation := newUserAccommodationMutation(c.config, OpUpdate)
+	return &UserAccommodationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TodoClient) UpdateOne(t *Todo) *TodoUpdateOne {
-	mutation := newTodoMutation(c.config, OpUpdateOne, withTodo(t))
-	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserAccommodationClient) UpdateOne(ua *UserAccommodation) *UserAccommodationUpdateOne {
+	mutation := newUserAccommodationMutation(c.config, OpUpdateOne, withUserAccommodation(ua))
+	return &UserAccommodationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TodoClient) UpdateOneID(id uuid.UUID) *TodoUpdateOne {
-	mutation := newTodoMutation(c.config, OpUpdateOne, withTodoID(id))
-	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserAccommodationClient) UpdateOneID(id uuid.UUID) *UserAccommodationUpdateOne {
+	mutation := newUserAccommodationMutation(c.config, OpUpdateOne, withUserAccommodationID(id))
+	return &UserAccommodationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Todo.
-func (c *TodoClient) Delete() *TodoDelete {
-	mutation := newTodoMutation(c.config, OpDelete)
-	return &TodoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserAccommodation.
+func (c *UserAccommodationClient) Delete() *UserAccommodationDelete {
+	mutation := newUserAccommodationMutation(c.config, OpDelete)
+	return &UserAccommodationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TodoClient) DeleteOne(t *Todo) *TodoDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *UserAccommodationClient) DeleteOne(ua *UserAccommodation) *UserAccommodationDeleteOne {
+	return c.DeleteOneID(ua.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TodoClient) DeleteOneID(id uuid.UUID) *TodoDeleteOne {
-	builder := c.Delete().Where(todo.ID(id))
+func (c *UserAccommodationClient) DeleteOneID(id uuid.UUID) *UserAccommodationDeleteOne {
+	builder := c.Delete().Where(useraccommodation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TodoDeleteOne{builder}
+	return &UserAccommodationDeleteOne{builder}
 }
 
-// Query returns a query builder for Todo.
-func (c *TodoClient) Query() *TodoQuery {
-	return &TodoQuery{
+// Query returns a query builder for UserAccommodation.
+func (c *UserAccommodationClient) Query() *UserAccommodationQuery {
+	return &UserAccommodationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTodo},
+		ctx:    &QueryContext{Type: TypeUserAccommodation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Todo entity by its id.
-func (c *TodoClient) Get(ctx context.Context, id uuid.UUID) (*Todo, error) {
-	return c.Query().Where(todo.ID(id)).Only(ctx)
+// Get returns a UserAccommodation entity by its id.
+func (c *UserAccommodationClient) Get(ctx context.Context, id uuid.UUID) (*UserAccommodation, error) {
+	return c.Query().Where(useraccommodation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TodoClient) GetX(ctx context.Context, id uuid.UUID) *Todo {
+func (c *UserAccommodationClient) GetX(ctx context.Context, id uuid.UUID) *UserAccommodation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3067,134 +6309,150 @@ func (c *TodoClient) GetX(ctx context.Context, id uuid.UUID) *Todo {
 	return obj
 }
 
+// QueryUser queries the user edge of a UserAccommodation.
+func (c *UserAccommodationClient) QueryUser(ua *UserAccommodation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ua.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraccommodation.Table, useraccommodation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, useraccommodation.UserTable, useraccommodation.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ua.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *TodoClient) Hooks() []Hook {
-	hooks := c.hooks.Todo
-	return append(hooks[:len(hooks):len(hooks)], todo.Hooks[:]...)
+func (c *UserAccommodationClient) Hooks() []Hook {
+	hooks := c.hooks.UserAccommodation
+	return append(hooks[:len(hooks):len(hooks)], useraccommodation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TodoClient) Interceptors() []Interceptor {
-	inters := c.inters.Todo
-	return append(inters[:len(inters):len(inters)], todo.Interceptors[:]...)
+func (c *UserAccommodationClient) Interceptors() []Interceptor {
+	inters := c.inters.UserAccommodation
+	return append(inters[:len(inters):len(inters)], useraccommodation.Interceptors[:]...)
 }
 
-func (c *TodoClient) mutate(ctx context.Context, m *TodoMutation) (Value, error) {
+func (c *UserAccommodationClient) mutate(ctx context.Context, m *UserAccommodationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TodoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserAccommodationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserAccommodationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserAccommodationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TodoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserAccommodationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Todo mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserAccommodation mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// UserInviteClient is a client for the UserInvite schema.
+type UserInviteClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewUserInviteClient returns a client for the UserInvite from the given config.
+func NewUserInviteClient(c config) *UserInviteClient {
+	return &UserInviteClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `userinvite.Hooks(f(g(h())))`.
+func (c *UserInviteClient) Use(hooks ...Hook) {
+	c.hooks.UserInvite = append(c.hooks.UserInvite, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `userinvite.Intercept(f(g(h())))`.
+func (c *UserInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserInvite = append(c.inters.UserInvite, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserInvite entity.
+func (c *UserInviteClient) Create() *UserInviteCreate {
+	mutation := newUserInviteMutation(c.config, OpCreate)
+	return &UserInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserInvite entities.
+func (c *UserInviteClient) CreateBulk(builders ...*UserInviteCreate) *UserInviteCreateBulk {
+	return &UserInviteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *UserInviteClient) MapCreateBulk(slice any, setFunc func(*UserInviteCreate, int)) *UserInviteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserInviteCreateBulk{err: fmt.Errorf("calling to UserInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*UserInviteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &UserInviteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserInvite.
+func (c *UserInviteClient) Update() *UserInviteUpdate {
+	mutation := newUserInviteMutation(c.config, OpUpdate)
+	return &UserInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserInviteClient) UpdateOne(ui *UserInvite) *UserInviteUpdateOne {
+	mutation := newUserInviteMutation(c.config, OpUpdateOne, withUserInvite(ui))
+	return &UserInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserInviteClient) UpdateOneID(id uuid.UUID) *UserInviteUpdateOne {
+	mutation := newUserInviteMutation(c.config, OpUpdateOne, withUserInviteID(id))
+	return &UserInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserInvite.
+func (c *UserInviteClient) Delete() *UserInviteDelete {
+	mutation := newUserInviteMutation(c.config, OpDelete)
+	return &UserInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *UserInviteClient) DeleteOne(ui *UserInvite) *UserInviteDeleteOne {
+	return c.DeleteOneID(ui.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *UserInviteClient) DeleteOneID(id uuid.UUID) *UserInviteDeleteOne {
+	builder := c.Delete().Where(userinvite.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &UserInviteDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for UserInvite.
+func (c *UserInviteClient) Query() *UserInviteQuery {
+	return &UserInviteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeUserInvite},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a UserInvite entity by its id.
+func (c *UserInviteClient) Get(ctx context.Context, id uuid.UUID) (*UserInvite, error) {
+	return c.Query().Where(userinvite.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+func (c *UserInviteClient) GetX(ctx context.Context, id uuid.UUID) *UserInvite {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3202,142 +6460,46 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 	return obj
 }
 
-// QueryMedia queries the media edge of a User.
-func (c *UserClient) QueryMedia(u *User) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, user.MediaTable, user.MediaColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMediaUploader queries the media_uploader edge of a User.
-func (c *UserClient) QueryMediaUploader(u *User) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.MediaUploaderTable, user.MediaUploaderColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoles queries the roles edge of a User.
-func (c *UserClient) QueryRoles(u *User) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCourseCreator queries the course_creator edge of a User.
-func (c *UserClient) QueryCourseCreator(u *User) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.CourseCreatorTable, user.CourseCreatorColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryQuestionCollections queries the question_collections edge of a User.
-func (c *UserClient) QueryQuestionCollections(u *User) *QuestionCollectionQuery {
-	query := (&QuestionCollectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(questioncollection.Table, questioncollection.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.QuestionCollectionsTable, user.QuestionCollectionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTestSessions queries the test_sessions edge of a User.
-func (c *UserClient) QueryTestSessions(u *User) *TestSessionQuery {
-	query := (&TestSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(testsession.Table, testsession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionsTable, user.TestSessionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryJwtTokens queries the jwt_tokens edge of a User.
-func (c *UserClient) QueryJwtTokens(u *User) *JwtTokenQuery {
-	query := (&JwtTokenClient{config: c.config}).Query()
+// QueryUser queries the user edge of a UserInvite.
+func (c *UserInviteClient) QueryUser(ui *UserInvite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
+		id := ui.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(jwttoken.Table, jwttoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.JwtTokensTable, user.JwtTokensColumn),
+			sqlgraph.From(userinvite.Table, userinvite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userinvite.UserTable, userinvite.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	hooks := c.hooks.User
-	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
+func (c *UserInviteClient) Hooks() []Hook {
+	hooks := c.hooks.UserInvite
+	return append(hooks[:len(hooks):len(hooks)], userinvite.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	inters := c.inters.User
-	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
+func (c *UserInviteClient) Interceptors() []Interceptor {
+	inters := c.inters.UserInvite
+	return append(inters[:len(inters):len(inters)], userinvite.Interceptors[:]...)
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *UserInviteClient) mutate(ctx context.Context, m *UserInviteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserInvite mutation op: %q", m.Op())
 	}
 }
 
@@ -3710,15 +6872,21 @@ func (c *VideoQuestionTimestampClient) mutate(ctx context.Context, m *VideoQuest
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Course, CourseSection, JwtToken, Media, Permission, Question,
-		QuestionCollection, QuestionOption, Role, Test, TestIgnoreQuestion,
-		TestQuestionCount, TestSession, TestSessionAnswer, Todo, User, Video,
-		VideoQuestionTimestamp []ent.Hook
+		AccessGrant, Course, CourseEnrollment, CourseSection, CourseSectionPrerequisite,
+		Group, GroupTestAssignment, JwtToken, Media, Organization, Permission,
+		Question, QuestionCollection, QuestionOption, QuestionVersion,
+		QuestionVersionOption, Role, Test, TestIgnoreQuestion, TestQuestionCount,
+		TestSession, TestSessionAnswer, TestSessionBreak, TestSessionIntegrityEvent,
+		TestSessionRegrade, TestSessionTimeExtension, Todo, User, UserAccommodation,
+		UserInvite, Video, VideoQuestionTimestamp []ent.Hook
 	}
 	inters struct {
-		Course, CourseSection, JwtToken, Media, Permission, Question,
-		QuestionCollection, QuestionOption, Role, Test, TestIgnoreQuestion,
-		TestQuestionCount, TestSession, TestSessionAnswer, Todo, User, Video,
-		VideoQuestionTimestamp []ent.Interceptor
+		AccessGrant, Course, CourseEnrollment, CourseSection, CourseSectionPrerequisite,
+		Group, GroupTestAssignment, JwtToken, Media, Organization, Permission,
+		Question, QuestionCollection, QuestionOption, QuestionVersion,
+		QuestionVersionOption, Role, Test, TestIgnoreQuestion, TestQuestionCount,
+		TestSession, TestSessionAnswer, TestSessionBreak, TestSessionIntegrityEvent,
+		TestSessionRegrade, TestSessionTimeExtension, Todo, User, UserAccommodation,
+		UserInvite, Video, VideoQuestionTimestamp []ent.Interceptor
 	}
 )
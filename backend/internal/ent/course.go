@@ -7,6 +7,7 @@ import (
 	"strings"
 	"template/internal/ent/course"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/user"
 	"time"
 
@@ -26,6 +27,8 @@ type Course struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -36,6 +39,12 @@ type Course struct {
 	CreatorID uuid.UUID `json:"creator_id,omitempty"`
 	// IsPublished holds the value of the "is_published" field.
 	IsPublished bool `json:"is_published,omitempty"`
+	// Status holds the value of the "status" field.
+	Status course.Status `json:"status,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CourseQuery when eager-loading is set.
 	Edges        CourseEdges `json:"edges"`
@@ -44,6 +53,8 @@ type Course struct {
 
 // CourseEdges holds the relations/edges for other nodes in the graph.
 type CourseEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Media holds the value of the media edge.
 	Media *Media `json:"media,omitempty"`
 	// Creator holds the value of the creator edge.
@@ -54,9 +65,24 @@ type CourseEdges struct {
 	CourseVideos []*Video `json:"course_videos,omitempty"`
 	// Tests holds the value of the tests edge.
 	Tests []*Test `json:"tests,omitempty"`
+	// Enrollments holds the value of the enrollments edge.
+	Enrollments []*CourseEnrollment `json:"enrollments,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CourseEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // MediaOrErr returns the Media value or an error if the edge
@@ -64,7 +90,7 @@ type CourseEdges struct {
 func (e CourseEdges) MediaOrErr() (*Media, error) {
 	if e.Media != nil {
 		return e.Media, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: media.Label}
 	}
 	return nil, &NotLoadedError{edge: "media"}
@@ -75,7 +101,7 @@ func (e CourseEdges) MediaOrErr() (*Media, error) {
 func (e CourseEdges) CreatorOrErr() (*User, error) {
 	if e.Creator != nil {
 		return e.Creator, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "creator"}
@@ -84,7 +110,7 @@ func (e CourseEdges) CreatorOrErr() (*User, error) {
 // CourseSectionsOrErr returns the CourseSections value or an error if the edge
 // was not loaded in eager-loading.
 func (e CourseEdges) CourseSectionsOrErr() ([]*CourseSection, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.CourseSections, nil
 	}
 	return nil, &NotLoadedError{edge: "course_sections"}
@@ -93,7 +119,7 @@ func (e CourseEdges) CourseSectionsOrErr() ([]*CourseSection, error) {
 // CourseVideosOrErr returns the CourseVideos value or an error if the edge
 // was not loaded in eager-loading.
 func (e CourseEdges) CourseVideosOrErr() ([]*Video, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.CourseVideos, nil
 	}
 	return nil, &NotLoadedError{edge: "course_videos"}
@@ -102,24 +128,42 @@ func (e CourseEdges) CourseVideosOrErr() ([]*Video, error) {
 // TestsOrErr returns the Tests value or an error if the edge
 // was not loaded in eager-loading.
 func (e CourseEdges) TestsOrErr() ([]*Test, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Tests, nil
 	}
 	return nil, &NotLoadedError{edge: "tests"}
 }
 
+// EnrollmentsOrErr returns the Enrollments value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) EnrollmentsOrErr() ([]*CourseEnrollment, error) {
+	if e.loadedTypes[6] {
+		return e.Enrollments, nil
+	}
+	return nil, &NotLoadedError{edge: "enrollments"}
+}
+
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[7] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Course) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case course.FieldMediaID:
+		case course.FieldOrganizationID, course.FieldMediaID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case course.FieldIsPublished:
 			values[i] = new(sql.NullBool)
-		case course.FieldTitle, course.FieldDescription:
+		case course.FieldTitle, course.FieldDescription, course.FieldStatus:
 			values[i] = new(sql.NullString)
-		case course.FieldCreatedAt, course.FieldUpdatedAt, course.FieldDeletedAt:
+		case course.FieldCreatedAt, course.FieldUpdatedAt, course.FieldDeletedAt, course.FieldPublishedAt, course.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		case course.FieldID, course.FieldCreatorID:
 			values[i] = new(uuid.UUID)
@@ -163,6 +207,13 @@ func (c *Course) assignValues(columns []string, values []any) error {
 				c.DeletedAt = new(time.Time)
 				*c.DeletedAt = value.Time
 			}
+		case course.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				c.OrganizationID = new(uuid.UUID)
+				*c.OrganizationID = *value.S.(*uuid.UUID)
+			}
 		case course.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -195,6 +246,26 @@ func (c *Course) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.IsPublished = value.Bool
 			}
+		case course.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = course.Status(value.String)
+			}
+		case course.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				c.PublishedAt = new(time.Time)
+				*c.PublishedAt = value.Time
+			}
+		case course.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				c.ArchivedAt = new(time.Time)
+				*c.ArchivedAt = value.Time
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -208,6 +279,11 @@ func (c *Course) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the Course entity.
+func (c *Course) QueryOrganization() *OrganizationQuery {
+	return NewCourseClient(c.config).QueryOrganization(c)
+}
+
 // QueryMedia queries the "media" edge of the Course entity.
 func (c *Course) QueryMedia() *MediaQuery {
 	return NewCourseClient(c.config).QueryMedia(c)
@@ -233,6 +309,16 @@ func (c *Course) QueryTests() *TestQuery {
 	return NewCourseClient(c.config).QueryTests(c)
 }
 
+// QueryEnrollments queries the "enrollments" edge of the Course entity.
+func (c *Course) QueryEnrollments() *CourseEnrollmentQuery {
+	return NewCourseClient(c.config).QueryEnrollments(c)
+}
+
+// QueryAccessGrants queries the "access_grants" edge of the Course entity.
+func (c *Course) QueryAccessGrants() *AccessGrantQuery {
+	return NewCourseClient(c.config).QueryAccessGrants(c)
+}
+
 // Update returns a builder for updating this Course.
 // Note that you need to call Course.Unwrap() before calling this method if this Course
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -267,6 +353,11 @@ func (c *Course) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := c.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(c.Title)
 	builder.WriteString(", ")
@@ -285,6 +376,19 @@ func (c *Course) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_published=")
 	builder.WriteString(fmt.Sprintf("%v", c.IsPublished))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	if v := c.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,9 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/test"
 	"template/internal/ent/user"
 	"template/internal/ent/video"
@@ -68,6 +71,20 @@ func (cc *CourseCreate) SetNillableDeletedAt(t *time.Time) *CourseCreate {
 	return cc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (cc *CourseCreate) SetOrganizationID(u uuid.UUID) *CourseCreate {
+	cc.mutation.SetOrganizationID(u)
+	return cc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableOrganizationID(u *uuid.UUID) *CourseCreate {
+	if u != nil {
+		cc.SetOrganizationID(*u)
+	}
+	return cc
+}
+
 // SetTitle sets the "title" field.
 func (cc *CourseCreate) SetTitle(s string) *CourseCreate {
 	cc.mutation.SetTitle(s)
@@ -122,6 +139,48 @@ func (cc *CourseCreate) SetNillableIsPublished(b *bool) *CourseCreate {
 	return cc
 }
 
+// SetStatus sets the "status" field.
+func (cc *CourseCreate) SetStatus(c course.Status) *CourseCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableStatus(c *course.Status) *CourseCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (cc *CourseCreate) SetPublishedAt(t time.Time) *CourseCreate {
+	cc.mutation.SetPublishedAt(t)
+	return cc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (cc *CourseCreate) SetNillablePublishedAt(t *time.Time) *CourseCreate {
+	if t != nil {
+		cc.SetPublishedAt(*t)
+	}
+	return cc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (cc *CourseCreate) SetArchivedAt(t time.Time) *CourseCreate {
+	cc.mutation.SetArchivedAt(t)
+	return cc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableArchivedAt(t *time.Time) *CourseCreate {
+	if t != nil {
+		cc.SetArchivedAt(*t)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CourseCreate) SetID(u uuid.UUID) *CourseCreate {
 	cc.mutation.SetID(u)
@@ -136,6 +195,11 @@ func (cc *CourseCreate) SetNillableID(u *uuid.UUID) *CourseCreate {
 	return cc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (cc *CourseCreate) SetOrganization(o *Organization) *CourseCreate {
+	return cc.SetOrganizationID(o.ID)
+}
+
 // SetMedia sets the "media" edge to the Media entity.
 func (cc *CourseCreate) SetMedia(m *Media) *CourseCreate {
 	return cc.SetMediaID(m.ID)
@@ -191,6 +255,36 @@ func (cc *CourseCreate) AddTests(t ...*Test) *CourseCreate {
 	return cc.AddTestIDs(ids...)
 }
 
+// AddEnrollmentIDs adds the "enrollments" edge to the CourseEnrollment entity by IDs.
+func (cc *CourseCreate) AddEnrollmentIDs(ids ...uuid.UUID) *CourseCreate {
+	cc.mutation.AddEnrollmentIDs(ids...)
+	return cc
+}
+
+// AddEnrollments adds the "enrollments" edges to the CourseEnrollment entity.
+func (cc *CourseCreate) AddEnrollments(c ...*CourseEnrollment) *CourseCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddEnrollmentIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (cc *CourseCreate) AddAccessGrantIDs(ids ...uuid.UUID) *CourseCreate {
+	cc.mutation.AddAccessGrantIDs(ids...)
+	return cc
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (cc *CourseCreate) AddAccessGrants(a ...*AccessGrant) *CourseCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cc.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (cc *CourseCreate) Mutation() *CourseMutation {
 	return cc.mutation
@@ -246,6 +340,10 @@ func (cc *CourseCreate) defaults() error {
 		v := course.DefaultIsPublished
 		cc.mutation.SetIsPublished(v)
 	}
+	if _, ok := cc.mutation.Status(); !ok {
+		v := course.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		if course.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized course.DefaultID (forgotten import ent/runtime?)")
@@ -278,6 +376,14 @@ func (cc *CourseCreate) check() error {
 	if _, ok := cc.mutation.IsPublished(); !ok {
 		return &ValidationError{Name: "is_published", err: errors.New(`ent: missing required field "Course.is_published"`)}
 	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Course.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := course.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Course.status": %w`, err)}
+		}
+	}
 	if len(cc.mutation.CreatorIDs()) == 0 {
 		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "Course.creator"`)}
 	}
@@ -340,6 +446,35 @@ func (cc *CourseCreate) createSpec() (*Course, *sqlgraph.CreateSpec) {
 		_spec.SetField(course.FieldIsPublished, field.TypeBool, value)
 		_node.IsPublished = value
 	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(course.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.PublishedAt(); ok {
+		_spec.SetField(course.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := cc.mutation.ArchivedAt(); ok {
+		_spec.SetField(course.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if nodes := cc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   course.OrganizationTable,
+			Columns: []string{course.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := cc.mutation.MediaIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -422,6 +557,38 @@ func (cc *CourseCreate) createSpec() (*Course, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.EnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
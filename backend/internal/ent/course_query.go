@@ -7,15 +7,19 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/test"
 	"template/internal/ent/user"
 	"template/internal/ent/video"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,11 +33,15 @@ type CourseQuery struct {
 	order              []course.OrderOption
 	inters             []Interceptor
 	predicates         []predicate.Course
+	withOrganization   *OrganizationQuery
 	withMedia          *MediaQuery
 	withCreator        *UserQuery
 	withCourseSections *CourseSectionQuery
 	withCourseVideos   *VideoQuery
 	withTests          *TestQuery
+	withEnrollments    *CourseEnrollmentQuery
+	withAccessGrants   *AccessGrantQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -70,6 +78,28 @@ func (cq *CourseQuery) Order(o ...course.OrderOption) *CourseQuery {
 	return cq
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (cq *CourseQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, course.OrganizationTable, course.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMedia chains the current query on the "media" edge.
 func (cq *CourseQuery) QueryMedia() *MediaQuery {
 	query := (&MediaClient{config: cq.config}).Query()
@@ -180,6 +210,50 @@ func (cq *CourseQuery) QueryTests() *TestQuery {
 	return query
 }
 
+// QueryEnrollments chains the current query on the "enrollments" edge.
+func (cq *CourseQuery) QueryEnrollments() *CourseEnrollmentQuery {
+	query := (&CourseEnrollmentClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(courseenrollment.Table, courseenrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessGrants chains the current query on the "access_grants" edge.
+func (cq *CourseQuery) QueryAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.AccessGrantsTable, course.AccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Course entity from the query.
 // Returns a *NotFoundError when no Course was found.
 func (cq *CourseQuery) First(ctx context.Context) (*Course, error) {
@@ -372,17 +446,31 @@ func (cq *CourseQuery) Clone() *CourseQuery {
 		order:              append([]course.OrderOption{}, cq.order...),
 		inters:             append([]Interceptor{}, cq.inters...),
 		predicates:         append([]predicate.Course{}, cq.predicates...),
+		withOrganization:   cq.withOrganization.Clone(),
 		withMedia:          cq.withMedia.Clone(),
 		withCreator:        cq.withCreator.Clone(),
 		withCourseSections: cq.withCourseSections.Clone(),
 		withCourseVideos:   cq.withCourseVideos.Clone(),
 		withTests:          cq.withTests.Clone(),
+		withEnrollments:    cq.withEnrollments.Clone(),
+		withAccessGrants:   cq.withAccessGrants.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithOrganization(opts ...func(*OrganizationQuery)) *CourseQuery {
+	query := (&OrganizationClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOrganization = query
+	return cq
+}
+
 // WithMedia tells the query-builder to eager-load the nodes that are connected to
 // the "media" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CourseQuery) WithMedia(opts ...func(*MediaQuery)) *CourseQuery {
@@ -438,6 +526,28 @@ func (cq *CourseQuery) WithTests(opts ...func(*TestQuery)) *CourseQuery {
 	return cq
 }
 
+// WithEnrollments tells the query-builder to eager-load the nodes that are connected to
+// the "enrollments" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithEnrollments(opts ...func(*CourseEnrollmentQuery)) *CourseQuery {
+	query := (&CourseEnrollmentClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withEnrollments = query
+	return cq
+}
+
+// WithAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithAccessGrants(opts ...func(*AccessGrantQuery)) *CourseQuery {
+	query := (&AccessGrantClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAccessGrants = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,12 +626,15 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 	var (
 		nodes       = []*Course{}
 		_spec       = cq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
+			cq.withOrganization != nil,
 			cq.withMedia != nil,
 			cq.withCreator != nil,
 			cq.withCourseSections != nil,
 			cq.withCourseVideos != nil,
 			cq.withTests != nil,
+			cq.withEnrollments != nil,
+			cq.withAccessGrants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -533,6 +646,9 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -542,6 +658,12 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := cq.withOrganization; query != nil {
+		if err := cq.loadOrganization(ctx, query, nodes, nil,
+			func(n *Course, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := cq.withMedia; query != nil {
 		if err := cq.loadMedia(ctx, query, nodes, nil,
 			func(n *Course, e *Media) { n.Edges.Media = e }); err != nil {
@@ -575,9 +697,55 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 			return nil, err
 		}
 	}
+	if query := cq.withEnrollments; query != nil {
+		if err := cq.loadEnrollments(ctx, query, nodes,
+			func(n *Course) { n.Edges.Enrollments = []*CourseEnrollment{} },
+			func(n *Course, e *CourseEnrollment) { n.Edges.Enrollments = append(n.Edges.Enrollments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withAccessGrants; query != nil {
+		if err := cq.loadAccessGrants(ctx, query, nodes,
+			func(n *Course) { n.Edges.AccessGrants = []*AccessGrant{} },
+			func(n *Course, e *AccessGrant) { n.Edges.AccessGrants = append(n.Edges.AccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (cq *CourseQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Course, init func(*Course), assign func(*Course, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Course)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (cq *CourseQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*Course, init func(*Course), assign func(*Course, *Media)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Course)
@@ -732,9 +900,75 @@ func (cq *CourseQuery) loadTests(ctx context.Context, query *TestQuery, nodes []
 	}
 	return nil
 }
+func (cq *CourseQuery) loadEnrollments(ctx context.Context, query *CourseEnrollmentQuery, nodes []*Course, init func(*Course), assign func(*Course, *CourseEnrollment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(courseenrollment.FieldCourseID)
+	}
+	query.Where(predicate.CourseEnrollment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.EnrollmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *CourseQuery) loadAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*Course, init func(*Course), assign func(*Course, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldCourseID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.AccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "course_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *CourseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -758,6 +992,9 @@ func (cq *CourseQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if cq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(course.FieldOrganizationID)
+		}
 		if cq.withMedia != nil {
 			_spec.Node.AddColumnOnce(course.FieldMediaID)
 		}
@@ -803,6 +1040,9 @@ func (cq *CourseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cq.ctx.Unique != nil && *cq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -820,6 +1060,32 @@ func (cq *CourseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CourseQuery) ForUpdate(opts ...sql.LockOption) *CourseQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CourseQuery) ForShare(opts ...sql.LockOption) *CourseQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CourseGroupBy is the group-by builder for Course entities.
 type CourseGroupBy struct {
 	selector
@@ -6,9 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/test"
 	"template/internal/ent/user"
@@ -74,6 +77,26 @@ func (cu *CourseUpdate) ClearDeletedAt() *CourseUpdate {
 	return cu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (cu *CourseUpdate) SetOrganizationID(u uuid.UUID) *CourseUpdate {
+	cu.mutation.SetOrganizationID(u)
+	return cu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableOrganizationID(u *uuid.UUID) *CourseUpdate {
+	if u != nil {
+		cu.SetOrganizationID(*u)
+	}
+	return cu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (cu *CourseUpdate) ClearOrganizationID() *CourseUpdate {
+	cu.mutation.ClearOrganizationID()
+	return cu
+}
+
 // SetTitle sets the "title" field.
 func (cu *CourseUpdate) SetTitle(s string) *CourseUpdate {
 	cu.mutation.SetTitle(s)
@@ -156,6 +179,65 @@ func (cu *CourseUpdate) SetNillableIsPublished(b *bool) *CourseUpdate {
 	return cu
 }
 
+// SetStatus sets the "status" field.
+func (cu *CourseUpdate) SetStatus(c course.Status) *CourseUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableStatus(c *course.Status) *CourseUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
+	}
+	return cu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (cu *CourseUpdate) SetPublishedAt(t time.Time) *CourseUpdate {
+	cu.mutation.SetPublishedAt(t)
+	return cu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillablePublishedAt(t *time.Time) *CourseUpdate {
+	if t != nil {
+		cu.SetPublishedAt(*t)
+	}
+	return cu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (cu *CourseUpdate) ClearPublishedAt() *CourseUpdate {
+	cu.mutation.ClearPublishedAt()
+	return cu
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (cu *CourseUpdate) SetArchivedAt(t time.Time) *CourseUpdate {
+	cu.mutation.SetArchivedAt(t)
+	return cu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableArchivedAt(t *time.Time) *CourseUpdate {
+	if t != nil {
+		cu.SetArchivedAt(*t)
+	}
+	return cu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (cu *CourseUpdate) ClearArchivedAt() *CourseUpdate {
+	cu.mutation.ClearArchivedAt()
+	return cu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (cu *CourseUpdate) SetOrganization(o *Organization) *CourseUpdate {
+	return cu.SetOrganizationID(o.ID)
+}
+
 // SetMedia sets the "media" edge to the Media entity.
 func (cu *CourseUpdate) SetMedia(m *Media) *CourseUpdate {
 	return cu.SetMediaID(m.ID)
@@ -211,11 +293,47 @@ func (cu *CourseUpdate) AddTests(t ...*Test) *CourseUpdate {
 	return cu.AddTestIDs(ids...)
 }
 
+// AddEnrollmentIDs adds the "enrollments" edge to the CourseEnrollment entity by IDs.
+func (cu *CourseUpdate) AddEnrollmentIDs(ids ...uuid.UUID) *CourseUpdate {
+	cu.mutation.AddEnrollmentIDs(ids...)
+	return cu
+}
+
+// AddEnrollments adds the "enrollments" edges to the CourseEnrollment entity.
+func (cu *CourseUpdate) AddEnrollments(c ...*CourseEnrollment) *CourseUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddEnrollmentIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (cu *CourseUpdate) AddAccessGrantIDs(ids ...uuid.UUID) *CourseUpdate {
+	cu.mutation.AddAccessGrantIDs(ids...)
+	return cu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (cu *CourseUpdate) AddAccessGrants(a ...*AccessGrant) *CourseUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (cu *CourseUpdate) Mutation() *CourseMutation {
 	return cu.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (cu *CourseUpdate) ClearOrganization() *CourseUpdate {
+	cu.mutation.ClearOrganization()
+	return cu
+}
+
 // ClearMedia clears the "media" edge to the Media entity.
 func (cu *CourseUpdate) ClearMedia() *CourseUpdate {
 	cu.mutation.ClearMedia()
@@ -291,6 +409,48 @@ func (cu *CourseUpdate) RemoveTests(t ...*Test) *CourseUpdate {
 	return cu.RemoveTestIDs(ids...)
 }
 
+// ClearEnrollments clears all "enrollments" edges to the CourseEnrollment entity.
+func (cu *CourseUpdate) ClearEnrollments() *CourseUpdate {
+	cu.mutation.ClearEnrollments()
+	return cu
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to CourseEnrollment entities by IDs.
+func (cu *CourseUpdate) RemoveEnrollmentIDs(ids ...uuid.UUID) *CourseUpdate {
+	cu.mutation.RemoveEnrollmentIDs(ids...)
+	return cu
+}
+
+// RemoveEnrollments removes "enrollments" edges to CourseEnrollment entities.
+func (cu *CourseUpdate) RemoveEnrollments(c ...*CourseEnrollment) *CourseUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveEnrollmentIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (cu *CourseUpdate) ClearAccessGrants() *CourseUpdate {
+	cu.mutation.ClearAccessGrants()
+	return cu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (cu *CourseUpdate) RemoveAccessGrantIDs(ids ...uuid.UUID) *CourseUpdate {
+	cu.mutation.RemoveAccessGrantIDs(ids...)
+	return cu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (cu *CourseUpdate) RemoveAccessGrants(a ...*AccessGrant) *CourseUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.RemoveAccessGrantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CourseUpdate) Save(ctx context.Context) (int, error) {
 	if err := cu.defaults(); err != nil {
@@ -340,6 +500,11 @@ func (cu *CourseUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Course.title": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := course.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Course.status": %w`, err)}
+		}
+	}
 	if cu.mutation.CreatorCleared() && len(cu.mutation.CreatorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Course.creator"`)
 	}
@@ -382,6 +547,50 @@ func (cu *CourseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.IsPublished(); ok {
 		_spec.SetField(course.FieldIsPublished, field.TypeBool, value)
 	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(course.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.PublishedAt(); ok {
+		_spec.SetField(course.FieldPublishedAt, field.TypeTime, value)
+	}
+	if cu.mutation.PublishedAtCleared() {
+		_spec.ClearField(course.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.ArchivedAt(); ok {
+		_spec.SetField(course.FieldArchivedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(course.FieldArchivedAt, field.TypeTime)
+	}
+	if cu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   course.OrganizationTable,
+			Columns: []string{course.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   course.OrganizationTable,
+			Columns: []string{course.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cu.mutation.MediaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -575,6 +784,96 @@ func (cu *CourseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedEnrollmentsIDs(); len(nodes) > 0 && !cu.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.EnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !cu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{course.Label}
@@ -635,6 +934,26 @@ func (cuo *CourseUpdateOne) ClearDeletedAt() *CourseUpdateOne {
 	return cuo
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (cuo *CourseUpdateOne) SetOrganizationID(u uuid.UUID) *CourseUpdateOne {
+	cuo.mutation.SetOrganizationID(u)
+	return cuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *CourseUpdateOne {
+	if u != nil {
+		cuo.SetOrganizationID(*u)
+	}
+	return cuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (cuo *CourseUpdateOne) ClearOrganizationID() *CourseUpdateOne {
+	cuo.mutation.ClearOrganizationID()
+	return cuo
+}
+
 // SetTitle sets the "title" field.
 func (cuo *CourseUpdateOne) SetTitle(s string) *CourseUpdateOne {
 	cuo.mutation.SetTitle(s)
@@ -717,6 +1036,65 @@ func (cuo *CourseUpdateOne) SetNillableIsPublished(b *bool) *CourseUpdateOne {
 	return cuo
 }
 
+// SetStatus sets the "status" field.
+func (cuo *CourseUpdateOne) SetStatus(c course.Status) *CourseUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableStatus(c *course.Status) *CourseUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
+	}
+	return cuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (cuo *CourseUpdateOne) SetPublishedAt(t time.Time) *CourseUpdateOne {
+	cuo.mutation.SetPublishedAt(t)
+	return cuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillablePublishedAt(t *time.Time) *CourseUpdateOne {
+	if t != nil {
+		cuo.SetPublishedAt(*t)
+	}
+	return cuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (cuo *CourseUpdateOne) ClearPublishedAt() *CourseUpdateOne {
+	cuo.mutation.ClearPublishedAt()
+	return cuo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (cuo *CourseUpdateOne) SetArchivedAt(t time.Time) *CourseUpdateOne {
+	cuo.mutation.SetArchivedAt(t)
+	return cuo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableArchivedAt(t *time.Time) *CourseUpdateOne {
+	if t != nil {
+		cuo.SetArchivedAt(*t)
+	}
+	return cuo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (cuo *CourseUpdateOne) ClearArchivedAt() *CourseUpdateOne {
+	cuo.mutation.ClearArchivedAt()
+	return cuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (cuo *CourseUpdateOne) SetOrganization(o *Organization) *CourseUpdateOne {
+	return cuo.SetOrganizationID(o.ID)
+}
+
 // SetMedia sets the "media" edge to the Media entity.
 func (cuo *CourseUpdateOne) SetMedia(m *Media) *CourseUpdateOne {
 	return cuo.SetMediaID(m.ID)
@@ -772,11 +1150,47 @@ func (cuo *CourseUpdateOne) AddTests(t ...*Test) *CourseUpdateOne {
 	return cuo.AddTestIDs(ids...)
 }
 
+// AddEnrollmentIDs adds the "enrollments" edge to the CourseEnrollment entity by IDs.
+func (cuo *CourseUpdateOne) AddEnrollmentIDs(ids ...uuid.UUID) *CourseUpdateOne {
+	cuo.mutation.AddEnrollmentIDs(ids...)
+	return cuo
+}
+
+// AddEnrollments adds the "enrollments" edges to the CourseEnrollment entity.
+func (cuo *CourseUpdateOne) AddEnrollments(c ...*CourseEnrollment) *CourseUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddEnrollmentIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (cuo *CourseUpdateOne) AddAccessGrantIDs(ids ...uuid.UUID) *CourseUpdateOne {
+	cuo.mutation.AddAccessGrantIDs(ids...)
+	return cuo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (cuo *CourseUpdateOne) AddAccessGrants(a ...*AccessGrant) *CourseUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (cuo *CourseUpdateOne) Mutation() *CourseMutation {
 	return cuo.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (cuo *CourseUpdateOne) ClearOrganization() *CourseUpdateOne {
+	cuo.mutation.ClearOrganization()
+	return cuo
+}
+
 // ClearMedia clears the "media" edge to the Media entity.
 func (cuo *CourseUpdateOne) ClearMedia() *CourseUpdateOne {
 	cuo.mutation.ClearMedia()
@@ -852,6 +1266,48 @@ func (cuo *CourseUpdateOne) RemoveTests(t ...*Test) *CourseUpdateOne {
 	return cuo.RemoveTestIDs(ids...)
 }
 
+// ClearEnrollments clears all "enrollments" edges to the CourseEnrollment entity.
+func (cuo *CourseUpdateOne) ClearEnrollments() *CourseUpdateOne {
+	cuo.mutation.ClearEnrollments()
+	return cuo
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to CourseEnrollment entities by IDs.
+func (cuo *CourseUpdateOne) RemoveEnrollmentIDs(ids ...uuid.UUID) *CourseUpdateOne {
+	cuo.mutation.RemoveEnrollmentIDs(ids...)
+	return cuo
+}
+
+// RemoveEnrollments removes "enrollments" edges to CourseEnrollment entities.
+func (cuo *CourseUpdateOne) RemoveEnrollments(c ...*CourseEnrollment) *CourseUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveEnrollmentIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (cuo *CourseUpdateOne) ClearAccessGrants() *CourseUpdateOne {
+	cuo.mutation.ClearAccessGrants()
+	return cuo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (cuo *CourseUpdateOne) RemoveAccessGrantIDs(ids ...uuid.UUID) *CourseUpdateOne {
+	cuo.mutation.RemoveAccessGrantIDs(ids...)
+	return cuo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (cuo *CourseUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *CourseUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.RemoveAccessGrantIDs(ids...)
+}
+
 // Where appends a list predicates to the CourseUpdate builder.
 func (cuo *CourseUpdateOne) Where(ps ...predicate.Course) *CourseUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -914,6 +1370,11 @@ func (cuo *CourseUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Course.title": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := course.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Course.status": %w`, err)}
+		}
+	}
 	if cuo.mutation.CreatorCleared() && len(cuo.mutation.CreatorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Course.creator"`)
 	}
@@ -973,6 +1434,50 @@ func (cuo *CourseUpdateOne) sqlSave(ctx context.Context) (_node *Course, err err
 	if value, ok := cuo.mutation.IsPublished(); ok {
 		_spec.SetField(course.FieldIsPublished, field.TypeBool, value)
 	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(course.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.PublishedAt(); ok {
+		_spec.SetField(course.FieldPublishedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(course.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.ArchivedAt(); ok {
+		_spec.SetField(course.FieldArchivedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(course.FieldArchivedAt, field.TypeTime)
+	}
+	if cuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   course.OrganizationTable,
+			Columns: []string{course.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   course.OrganizationTable,
+			Columns: []string{course.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cuo.mutation.MediaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1166,6 +1671,96 @@ func (cuo *CourseUpdateOne) sqlSave(ctx context.Context) (_node *Course, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedEnrollmentsIDs(); len(nodes) > 0 && !cuo.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.EnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.EnrollmentsTable,
+			Columns: []string{course.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !cuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AccessGrantsTable,
+			Columns: []string{course.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Course{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CourseEnrollment is the model entity for the CourseEnrollment schema.
+type CourseEnrollment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID uuid.UUID `json:"course_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role courseenrollment.Role `json:"role,omitempty"`
+	// Status holds the value of the "status" field.
+	Status courseenrollment.Status `json:"status,omitempty"`
+	// EnrolledAt holds the value of the "enrolled_at" field.
+	EnrolledAt time.Time `json:"enrolled_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CourseEnrollmentQuery when eager-loading is set.
+	Edges        CourseEnrollmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CourseEnrollmentEdges holds the relations/edges for other nodes in the graph.
+type CourseEnrollmentEdges struct {
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CourseEnrollmentEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CourseEnrollmentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CourseEnrollment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case courseenrollment.FieldRole, courseenrollment.FieldStatus:
+			values[i] = new(sql.NullString)
+		case courseenrollment.FieldCreatedAt, courseenrollment.FieldUpdatedAt, courseenrollment.FieldDeletedAt, courseenrollment.FieldEnrolledAt:
+			values[i] = new(sql.NullTime)
+		case courseenrollment.FieldID, courseenrollment.FieldCourseID, courseenrollment.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CourseEnrollment fields.
+func (ce *CourseEnrollment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case courseenrollment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ce.ID = *value
+			}
+		case courseenrollment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ce.CreatedAt = value.Time
+			}
+		case courseenrollment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ce.UpdatedAt = value.Time
+			}
+		case courseenrollment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ce.DeletedAt = new(time.Time)
+				*ce.DeletedAt = value.Time
+			}
+		case courseenrollment.FieldCourseID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value != nil {
+				ce.CourseID = *value
+			}
+		case courseenrollment.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ce.UserID = *value
+			}
+		case courseenrollment.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				ce.Role = courseenrollment.Role(value.String)
+			}
+		case courseenrollment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ce.Status = courseenrollment.Status(value.String)
+			}
+		case courseenrollment.FieldEnrolledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enrolled_at", values[i])
+			} else if value.Valid {
+				ce.EnrolledAt = value.Time
+			}
+		default:
+			ce.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CourseEnrollment.
+// This includes values selected through modifiers, order, etc.
+func (ce *CourseEnrollment) Value(name string) (ent.Value, error) {
+	return ce.selectValues.Get(name)
+}
+
+// QueryCourse queries the "course" edge of the CourseEnrollment entity.
+func (ce *CourseEnrollment) QueryCourse() *CourseQuery {
+	return NewCourseEnrollmentClient(ce.config).QueryCourse(ce)
+}
+
+// QueryUser queries the "user" edge of the CourseEnrollment entity.
+func (ce *CourseEnrollment) QueryUser() *UserQuery {
+	return NewCourseEnrollmentClient(ce.config).QueryUser(ce)
+}
+
+// Update returns a builder for updating this CourseEnrollment.
+// Note that you need to call CourseEnrollment.Unwrap() before calling this method if this CourseEnrollment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ce *CourseEnrollment) Update() *CourseEnrollmentUpdateOne {
+	return NewCourseEnrollmentClient(ce.config).UpdateOne(ce)
+}
+
+// Unwrap unwraps the CourseEnrollment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ce *CourseEnrollment) Unwrap() *CourseEnrollment {
+	_tx, ok := ce.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CourseEnrollment is not a transactional entity")
+	}
+	ce.config.driver = _tx.drv
+	return ce
+}
+
+// String implements the fmt.Stringer.
+func (ce *CourseEnrollment) String() string {
+	var builder strings.Builder
+	builder.WriteString("CourseEnrollment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ce.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ce.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ce.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ce.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("course_id=")
+	builder.WriteString(fmt.Sprintf("%v", ce.CourseID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ce.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", ce.Role))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ce.Status))
+	builder.WriteString(", ")
+	builder.WriteString("enrolled_at=")
+	builder.WriteString(ce.EnrolledAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CourseEnrollments is a parsable slice of CourseEnrollment.
+type CourseEnrollments []*CourseEnrollment
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package courseenrollment
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldCourseID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldUserID, v))
+}
+
+// EnrolledAt applies equality check predicate on the "enrolled_at" field. It's identical to EnrolledAtEQ.
+func EnrolledAt(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldEnrolledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// EnrolledAtEQ applies the EQ predicate on the "enrolled_at" field.
+func EnrolledAtEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldEQ(FieldEnrolledAt, v))
+}
+
+// EnrolledAtNEQ applies the NEQ predicate on the "enrolled_at" field.
+func EnrolledAtNEQ(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNEQ(FieldEnrolledAt, v))
+}
+
+// EnrolledAtIn applies the In predicate on the "enrolled_at" field.
+func EnrolledAtIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldIn(FieldEnrolledAt, vs...))
+}
+
+// EnrolledAtNotIn applies the NotIn predicate on the "enrolled_at" field.
+func EnrolledAtNotIn(vs ...time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldNotIn(FieldEnrolledAt, vs...))
+}
+
+// EnrolledAtGT applies the GT predicate on the "enrolled_at" field.
+func EnrolledAtGT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGT(FieldEnrolledAt, v))
+}
+
+// EnrolledAtGTE applies the GTE predicate on the "enrolled_at" field.
+func EnrolledAtGTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldGTE(FieldEnrolledAt, v))
+}
+
+// EnrolledAtLT applies the LT predicate on the "enrolled_at" field.
+func EnrolledAtLT(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLT(FieldEnrolledAt, v))
+}
+
+// EnrolledAtLTE applies the LTE predicate on the "enrolled_at" field.
+func EnrolledAtLTE(v time.Time) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.FieldLTE(FieldEnrolledAt, v))
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CourseEnrollment) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CourseEnrollment) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CourseEnrollment) predicate.CourseEnrollment {
+	return predicate.CourseEnrollment(sql.NotPredicates(p))
+}
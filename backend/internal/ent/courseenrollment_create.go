@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseEnrollmentCreate is the builder for creating a CourseEnrollment entity.
+type CourseEnrollmentCreate struct {
+	config
+	mutation *CourseEnrollmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cec *CourseEnrollmentCreate) SetCreatedAt(t time.Time) *CourseEnrollmentCreate {
+	cec.mutation.SetCreatedAt(t)
+	return cec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableCreatedAt(t *time.Time) *CourseEnrollmentCreate {
+	if t != nil {
+		cec.SetCreatedAt(*t)
+	}
+	return cec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cec *CourseEnrollmentCreate) SetUpdatedAt(t time.Time) *CourseEnrollmentCreate {
+	cec.mutation.SetUpdatedAt(t)
+	return cec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableUpdatedAt(t *time.Time) *CourseEnrollmentCreate {
+	if t != nil {
+		cec.SetUpdatedAt(*t)
+	}
+	return cec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cec *CourseEnrollmentCreate) SetDeletedAt(t time.Time) *CourseEnrollmentCreate {
+	cec.mutation.SetDeletedAt(t)
+	return cec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableDeletedAt(t *time.Time) *CourseEnrollmentCreate {
+	if t != nil {
+		cec.SetDeletedAt(*t)
+	}
+	return cec
+}
+
+// SetCourseID sets the "course_id" field.
+func (cec *CourseEnrollmentCreate) SetCourseID(u uuid.UUID) *CourseEnrollmentCreate {
+	cec.mutation.SetCourseID(u)
+	return cec
+}
+
+// SetUserID sets the "user_id" field.
+func (cec *CourseEnrollmentCreate) SetUserID(u uuid.UUID) *CourseEnrollmentCreate {
+	cec.mutation.SetUserID(u)
+	return cec
+}
+
+// SetRole sets the "role" field.
+func (cec *CourseEnrollmentCreate) SetRole(c courseenrollment.Role) *CourseEnrollmentCreate {
+	cec.mutation.SetRole(c)
+	return cec
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableRole(c *courseenrollment.Role) *CourseEnrollmentCreate {
+	if c != nil {
+		cec.SetRole(*c)
+	}
+	return cec
+}
+
+// SetStatus sets the "status" field.
+func (cec *CourseEnrollmentCreate) SetStatus(c courseenrollment.Status) *CourseEnrollmentCreate {
+	cec.mutation.SetStatus(c)
+	return cec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableStatus(c *courseenrollment.Status) *CourseEnrollmentCreate {
+	if c != nil {
+		cec.SetStatus(*c)
+	}
+	return cec
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (cec *CourseEnrollmentCreate) SetEnrolledAt(t time.Time) *CourseEnrollmentCreate {
+	cec.mutation.SetEnrolledAt(t)
+	return cec
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableEnrolledAt(t *time.Time) *CourseEnrollmentCreate {
+	if t != nil {
+		cec.SetEnrolledAt(*t)
+	}
+	return cec
+}
+
+// SetID sets the "id" field.
+func (cec *CourseEnrollmentCreate) SetID(u uuid.UUID) *CourseEnrollmentCreate {
+	cec.mutation.SetID(u)
+	return cec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cec *CourseEnrollmentCreate) SetNillableID(u *uuid.UUID) *CourseEnrollmentCreate {
+	if u != nil {
+		cec.SetID(*u)
+	}
+	return cec
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (cec *CourseEnrollmentCreate) SetCourse(c *Course) *CourseEnrollmentCreate {
+	return cec.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cec *CourseEnrollmentCreate) SetUser(u *User) *CourseEnrollmentCreate {
+	return cec.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseEnrollmentMutation object of the builder.
+func (cec *CourseEnrollmentCreate) Mutation() *CourseEnrollmentMutation {
+	return cec.mutation
+}
+
+// Save creates the CourseEnrollment in the database.
+func (cec *CourseEnrollmentCreate) Save(ctx context.Context) (*CourseEnrollment, error) {
+	if err := cec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cec.sqlSave, cec.mutation, cec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cec *CourseEnrollmentCreate) SaveX(ctx context.Context) *CourseEnrollment {
+	v, err := cec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cec *CourseEnrollmentCreate) Exec(ctx context.Context) error {
+	_, err := cec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cec *CourseEnrollmentCreate) ExecX(ctx context.Context) {
+	if err := cec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cec *CourseEnrollmentCreate) defaults() error {
+	if _, ok := cec.mutation.CreatedAt(); !ok {
+		if courseenrollment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.DefaultCreatedAt()
+		cec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cec.mutation.UpdatedAt(); !ok {
+		if courseenrollment.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.DefaultUpdatedAt()
+		cec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cec.mutation.Role(); !ok {
+		v := courseenrollment.DefaultRole
+		cec.mutation.SetRole(v)
+	}
+	if _, ok := cec.mutation.Status(); !ok {
+		v := courseenrollment.DefaultStatus
+		cec.mutation.SetStatus(v)
+	}
+	if _, ok := cec.mutation.EnrolledAt(); !ok {
+		if courseenrollment.DefaultEnrolledAt == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.DefaultEnrolledAt (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.DefaultEnrolledAt()
+		cec.mutation.SetEnrolledAt(v)
+	}
+	if _, ok := cec.mutation.ID(); !ok {
+		if courseenrollment.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.DefaultID()
+		cec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cec *CourseEnrollmentCreate) check() error {
+	if _, ok := cec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CourseEnrollment.created_at"`)}
+	}
+	if _, ok := cec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CourseEnrollment.updated_at"`)}
+	}
+	if _, ok := cec.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "CourseEnrollment.course_id"`)}
+	}
+	if _, ok := cec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CourseEnrollment.user_id"`)}
+	}
+	if _, ok := cec.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "CourseEnrollment.role"`)}
+	}
+	if v, ok := cec.mutation.Role(); ok {
+		if err := courseenrollment.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.role": %w`, err)}
+		}
+	}
+	if _, ok := cec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CourseEnrollment.status"`)}
+	}
+	if v, ok := cec.mutation.Status(); ok {
+		if err := courseenrollment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.status": %w`, err)}
+		}
+	}
+	if _, ok := cec.mutation.EnrolledAt(); !ok {
+		return &ValidationError{Name: "enrolled_at", err: errors.New(`ent: missing required field "CourseEnrollment.enrolled_at"`)}
+	}
+	if len(cec.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "CourseEnrollment.course"`)}
+	}
+	if len(cec.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CourseEnrollment.user"`)}
+	}
+	return nil
+}
+
+func (cec *CourseEnrollmentCreate) sqlSave(ctx context.Context) (*CourseEnrollment, error) {
+	if err := cec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cec.mutation.id = &_node.ID
+	cec.mutation.done = true
+	return _node, nil
+}
+
+func (cec *CourseEnrollmentCreate) createSpec() (*CourseEnrollment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CourseEnrollment{config: cec.config}
+		_spec = sqlgraph.NewCreateSpec(courseenrollment.Table, sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID))
+	)
+	if id, ok := cec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cec.mutation.CreatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cec.mutation.UpdatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cec.mutation.DeletedAt(); ok {
+		_spec.SetField(courseenrollment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cec.mutation.Role(); ok {
+		_spec.SetField(courseenrollment.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := cec.mutation.Status(); ok {
+		_spec.SetField(courseenrollment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cec.mutation.EnrolledAt(); ok {
+		_spec.SetField(courseenrollment.FieldEnrolledAt, field.TypeTime, value)
+		_node.EnrolledAt = value
+	}
+	if nodes := cec.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.CourseTable,
+			Columns: []string{courseenrollment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.UserTable,
+			Columns: []string{courseenrollment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CourseEnrollmentCreateBulk is the builder for creating many CourseEnrollment entities in bulk.
+type CourseEnrollmentCreateBulk struct {
+	config
+	err      error
+	builders []*CourseEnrollmentCreate
+}
+
+// Save creates the CourseEnrollment entities in the database.
+func (cecb *CourseEnrollmentCreateBulk) Save(ctx context.Context) ([]*CourseEnrollment, error) {
+	if cecb.err != nil {
+		return nil, cecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cecb.builders))
+	nodes := make([]*CourseEnrollment, len(cecb.builders))
+	mutators := make([]Mutator, len(cecb.builders))
+	for i := range cecb.builders {
+		func(i int, root context.Context) {
+			builder := cecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CourseEnrollmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cecb *CourseEnrollmentCreateBulk) SaveX(ctx context.Context) []*CourseEnrollment {
+	v, err := cecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cecb *CourseEnrollmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := cecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecb *CourseEnrollmentCreateBulk) ExecX(ctx context.Context) {
+	if err := cecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CourseEnrollmentDelete is the builder for deleting a CourseEnrollment entity.
+type CourseEnrollmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *CourseEnrollmentMutation
+}
+
+// Where appends a list predicates to the CourseEnrollmentDelete builder.
+func (ced *CourseEnrollmentDelete) Where(ps ...predicate.CourseEnrollment) *CourseEnrollmentDelete {
+	ced.mutation.Where(ps...)
+	return ced
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ced *CourseEnrollmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ced.sqlExec, ced.mutation, ced.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ced *CourseEnrollmentDelete) ExecX(ctx context.Context) int {
+	n, err := ced.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ced *CourseEnrollmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(courseenrollment.Table, sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID))
+	if ps := ced.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ced.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ced.mutation.done = true
+	return affected, err
+}
+
+// CourseEnrollmentDeleteOne is the builder for deleting a single CourseEnrollment entity.
+type CourseEnrollmentDeleteOne struct {
+	ced *CourseEnrollmentDelete
+}
+
+// Where appends a list predicates to the CourseEnrollmentDelete builder.
+func (cedo *CourseEnrollmentDeleteOne) Where(ps ...predicate.CourseEnrollment) *CourseEnrollmentDeleteOne {
+	cedo.ced.mutation.Where(ps...)
+	return cedo
+}
+
+// Exec executes the deletion query.
+func (cedo *CourseEnrollmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := cedo.ced.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{courseenrollment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cedo *CourseEnrollmentDeleteOne) ExecX(ctx context.Context) {
+	if err := cedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
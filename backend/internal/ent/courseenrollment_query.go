@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseEnrollmentQuery is the builder for querying CourseEnrollment entities.
+type CourseEnrollmentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []courseenrollment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CourseEnrollment
+	withCourse *CourseQuery
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CourseEnrollmentQuery builder.
+func (ceq *CourseEnrollmentQuery) Where(ps ...predicate.CourseEnrollment) *CourseEnrollmentQuery {
+	ceq.predicates = append(ceq.predicates, ps...)
+	return ceq
+}
+
+// Limit the number of records to be returned by this query.
+func (ceq *CourseEnrollmentQuery) Limit(limit int) *CourseEnrollmentQuery {
+	ceq.ctx.Limit = &limit
+	return ceq
+}
+
+// Offset to start from.
+func (ceq *CourseEnrollmentQuery) Offset(offset int) *CourseEnrollmentQuery {
+	ceq.ctx.Offset = &offset
+	return ceq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ceq *CourseEnrollmentQuery) Unique(unique bool) *CourseEnrollmentQuery {
+	ceq.ctx.Unique = &unique
+	return ceq
+}
+
+// Order specifies how the records should be ordered.
+func (ceq *CourseEnrollmentQuery) Order(o ...courseenrollment.OrderOption) *CourseEnrollmentQuery {
+	ceq.order = append(ceq.order, o...)
+	return ceq
+}
+
+// QueryCourse chains the current query on the "course" edge.
+func (ceq *CourseEnrollmentQuery) QueryCourse() *CourseQuery {
+	query := (&CourseClient{config: ceq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(courseenrollment.Table, courseenrollment.FieldID, selector),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, courseenrollment.CourseTable, courseenrollment.CourseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ceq *CourseEnrollmentQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ceq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(courseenrollment.Table, courseenrollment.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, courseenrollment.UserTable, courseenrollment.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CourseEnrollment entity from the query.
+// Returns a *NotFoundError when no CourseEnrollment was found.
+func (ceq *CourseEnrollmentQuery) First(ctx context.Context) (*CourseEnrollment, error) {
+	nodes, err := ceq.Limit(1).All(setContextOp(ctx, ceq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{courseenrollment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) FirstX(ctx context.Context) *CourseEnrollment {
+	node, err := ceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CourseEnrollment ID from the query.
+// Returns a *NotFoundError when no CourseEnrollment ID was found.
+func (ceq *CourseEnrollmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ceq.Limit(1).IDs(setContextOp(ctx, ceq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{courseenrollment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CourseEnrollment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CourseEnrollment entity is found.
+// Returns a *NotFoundError when no CourseEnrollment entities are found.
+func (ceq *CourseEnrollmentQuery) Only(ctx context.Context) (*CourseEnrollment, error) {
+	nodes, err := ceq.Limit(2).All(setContextOp(ctx, ceq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{courseenrollment.Label}
+	default:
+		return nil, &NotSingularError{courseenrollment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) OnlyX(ctx context.Context) *CourseEnrollment {
+	node, err := ceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CourseEnrollment ID in the query.
+// Returns a *NotSingularError when more than one CourseEnrollment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ceq *CourseEnrollmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ceq.Limit(2).IDs(setContextOp(ctx, ceq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{courseenrollment.Label}
+	default:
+		err = &NotSingularError{courseenrollment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CourseEnrollments.
+func (ceq *CourseEnrollmentQuery) All(ctx context.Context) ([]*CourseEnrollment, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryAll)
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CourseEnrollment, *CourseEnrollmentQuery]()
+	return withInterceptors[[]*CourseEnrollment](ctx, ceq, qr, ceq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) AllX(ctx context.Context) []*CourseEnrollment {
+	nodes, err := ceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CourseEnrollment IDs.
+func (ceq *CourseEnrollmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ceq.ctx.Unique == nil && ceq.path != nil {
+		ceq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryIDs)
+	if err = ceq.Select(courseenrollment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ceq *CourseEnrollmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryCount)
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ceq, querierCount[*CourseEnrollmentQuery](), ceq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) CountX(ctx context.Context) int {
+	count, err := ceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ceq *CourseEnrollmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryExist)
+	switch _, err := ceq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ceq *CourseEnrollmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := ceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CourseEnrollmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ceq *CourseEnrollmentQuery) Clone() *CourseEnrollmentQuery {
+	if ceq == nil {
+		return nil
+	}
+	return &CourseEnrollmentQuery{
+		config:     ceq.config,
+		ctx:        ceq.ctx.Clone(),
+		order:      append([]courseenrollment.OrderOption{}, ceq.order...),
+		inters:     append([]Interceptor{}, ceq.inters...),
+		predicates: append([]predicate.CourseEnrollment{}, ceq.predicates...),
+		withCourse: ceq.withCourse.Clone(),
+		withUser:   ceq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  ceq.sql.Clone(),
+		path: ceq.path,
+	}
+}
+
+// WithCourse tells the query-builder to eager-load the nodes that are connected to
+// the "course" edge. The optional arguments are used to configure the query builder of the edge.
+func (ceq *CourseEnrollmentQuery) WithCourse(opts ...func(*CourseQuery)) *CourseEnrollmentQuery {
+	query := (&CourseClient{config: ceq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ceq.withCourse = query
+	return ceq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ceq *CourseEnrollmentQuery) WithUser(opts ...func(*UserQuery)) *CourseEnrollmentQuery {
+	query := (&UserClient{config: ceq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ceq.withUser = query
+	return ceq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CourseEnrollment.Query().
+//		GroupBy(courseenrollment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ceq *CourseEnrollmentQuery) GroupBy(field string, fields ...string) *CourseEnrollmentGroupBy {
+	ceq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CourseEnrollmentGroupBy{build: ceq}
+	grbuild.flds = &ceq.ctx.Fields
+	grbuild.label = courseenrollment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CourseEnrollment.Query().
+//		Select(courseenrollment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ceq *CourseEnrollmentQuery) Select(fields ...string) *CourseEnrollmentSelect {
+	ceq.ctx.Fields = append(ceq.ctx.Fields, fields...)
+	sbuild := &CourseEnrollmentSelect{CourseEnrollmentQuery: ceq}
+	sbuild.label = courseenrollment.Label
+	sbuild.flds, sbuild.scan = &ceq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CourseEnrollmentSelect configured with the given aggregations.
+func (ceq *CourseEnrollmentQuery) Aggregate(fns ...AggregateFunc) *CourseEnrollmentSelect {
+	return ceq.Select().Aggregate(fns...)
+}
+
+func (ceq *CourseEnrollmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ceq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ceq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ceq.ctx.Fields {
+		if !courseenrollment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ceq.path != nil {
+		prev, err := ceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ceq.sql = prev
+	}
+	return nil
+}
+
+func (ceq *CourseEnrollmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CourseEnrollment, error) {
+	var (
+		nodes       = []*CourseEnrollment{}
+		_spec       = ceq.querySpec()
+		loadedTypes = [2]bool{
+			ceq.withCourse != nil,
+			ceq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CourseEnrollment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CourseEnrollment{config: ceq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ceq.modifiers) > 0 {
+		_spec.Modifiers = ceq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ceq.withCourse; query != nil {
+		if err := ceq.loadCourse(ctx, query, nodes, nil,
+			func(n *CourseEnrollment, e *Course) { n.Edges.Course = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ceq.withUser; query != nil {
+		if err := ceq.loadUser(ctx, query, nodes, nil,
+			func(n *CourseEnrollment, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ceq *CourseEnrollmentQuery) loadCourse(ctx context.Context, query *CourseQuery, nodes []*CourseEnrollment, init func(*CourseEnrollment), assign func(*CourseEnrollment, *Course)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CourseEnrollment)
+	for i := range nodes {
+		fk := nodes[i].CourseID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(course.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "course_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ceq *CourseEnrollmentQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*CourseEnrollment, init func(*CourseEnrollment), assign func(*CourseEnrollment, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CourseEnrollment)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ceq *CourseEnrollmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ceq.querySpec()
+	if len(ceq.modifiers) > 0 {
+		_spec.Modifiers = ceq.modifiers
+	}
+	_spec.Node.Columns = ceq.ctx.Fields
+	if len(ceq.ctx.Fields) > 0 {
+		_spec.Unique = ceq.ctx.Unique != nil && *ceq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ceq.driver, _spec)
+}
+
+func (ceq *CourseEnrollmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(courseenrollment.Table, courseenrollment.Columns, sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID))
+	_spec.From = ceq.sql
+	if unique := ceq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ceq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ceq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, courseenrollment.FieldID)
+		for i := range fields {
+			if fields[i] != courseenrollment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ceq.withCourse != nil {
+			_spec.Node.AddColumnOnce(courseenrollment.FieldCourseID)
+		}
+		if ceq.withUser != nil {
+			_spec.Node.AddColumnOnce(courseenrollment.FieldUserID)
+		}
+	}
+	if ps := ceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ceq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ceq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ceq *CourseEnrollmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ceq.driver.Dialect())
+	t1 := builder.Table(courseenrollment.Table)
+	columns := ceq.ctx.Fields
+	if len(columns) == 0 {
+		columns = courseenrollment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ceq.sql != nil {
+		selector = ceq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ceq.ctx.Unique != nil && *ceq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ceq.modifiers {
+		m(selector)
+	}
+	for _, p := range ceq.predicates {
+		p(selector)
+	}
+	for _, p := range ceq.order {
+		p(selector)
+	}
+	if offset := ceq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ceq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ceq *CourseEnrollmentQuery) ForUpdate(opts ...sql.LockOption) *CourseEnrollmentQuery {
+	if ceq.driver.Dialect() == dialect.Postgres {
+		ceq.Unique(false)
+	}
+	ceq.modifiers = append(ceq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ceq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ceq *CourseEnrollmentQuery) ForShare(opts ...sql.LockOption) *CourseEnrollmentQuery {
+	if ceq.driver.Dialect() == dialect.Postgres {
+		ceq.Unique(false)
+	}
+	ceq.modifiers = append(ceq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ceq
+}
+
+// CourseEnrollmentGroupBy is the group-by builder for CourseEnrollment entities.
+type CourseEnrollmentGroupBy struct {
+	selector
+	build *CourseEnrollmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cegb *CourseEnrollmentGroupBy) Aggregate(fns ...AggregateFunc) *CourseEnrollmentGroupBy {
+	cegb.fns = append(cegb.fns, fns...)
+	return cegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cegb *CourseEnrollmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cegb.build.ctx, ent.OpQueryGroupBy)
+	if err := cegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseEnrollmentQuery, *CourseEnrollmentGroupBy](ctx, cegb.build, cegb, cegb.build.inters, v)
+}
+
+func (cegb *CourseEnrollmentGroupBy) sqlScan(ctx context.Context, root *CourseEnrollmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cegb.fns))
+	for _, fn := range cegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cegb.flds)+len(cegb.fns))
+		for _, f := range *cegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CourseEnrollmentSelect is the builder for selecting fields of CourseEnrollment entities.
+type CourseEnrollmentSelect struct {
+	*CourseEnrollmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ces *CourseEnrollmentSelect) Aggregate(fns ...AggregateFunc) *CourseEnrollmentSelect {
+	ces.fns = append(ces.fns, fns...)
+	return ces
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ces *CourseEnrollmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ces.ctx, ent.OpQuerySelect)
+	if err := ces.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseEnrollmentQuery, *CourseEnrollmentSelect](ctx, ces.CourseEnrollmentQuery, ces, ces.inters, v)
+}
+
+func (ces *CourseEnrollmentSelect) sqlScan(ctx context.Context, root *CourseEnrollmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ces.fns))
+	for _, fn := range ces.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ces.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ces.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
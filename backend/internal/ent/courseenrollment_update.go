@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseEnrollmentUpdate is the builder for updating CourseEnrollment entities.
+type CourseEnrollmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CourseEnrollmentMutation
+}
+
+// Where appends a list predicates to the CourseEnrollmentUpdate builder.
+func (ceu *CourseEnrollmentUpdate) Where(ps ...predicate.CourseEnrollment) *CourseEnrollmentUpdate {
+	ceu.mutation.Where(ps...)
+	return ceu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ceu *CourseEnrollmentUpdate) SetCreatedAt(t time.Time) *CourseEnrollmentUpdate {
+	ceu.mutation.SetCreatedAt(t)
+	return ceu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableCreatedAt(t *time.Time) *CourseEnrollmentUpdate {
+	if t != nil {
+		ceu.SetCreatedAt(*t)
+	}
+	return ceu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ceu *CourseEnrollmentUpdate) SetUpdatedAt(t time.Time) *CourseEnrollmentUpdate {
+	ceu.mutation.SetUpdatedAt(t)
+	return ceu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ceu *CourseEnrollmentUpdate) SetDeletedAt(t time.Time) *CourseEnrollmentUpdate {
+	ceu.mutation.SetDeletedAt(t)
+	return ceu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableDeletedAt(t *time.Time) *CourseEnrollmentUpdate {
+	if t != nil {
+		ceu.SetDeletedAt(*t)
+	}
+	return ceu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ceu *CourseEnrollmentUpdate) ClearDeletedAt() *CourseEnrollmentUpdate {
+	ceu.mutation.ClearDeletedAt()
+	return ceu
+}
+
+// SetCourseID sets the "course_id" field.
+func (ceu *CourseEnrollmentUpdate) SetCourseID(u uuid.UUID) *CourseEnrollmentUpdate {
+	ceu.mutation.SetCourseID(u)
+	return ceu
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableCourseID(u *uuid.UUID) *CourseEnrollmentUpdate {
+	if u != nil {
+		ceu.SetCourseID(*u)
+	}
+	return ceu
+}
+
+// SetUserID sets the "user_id" field.
+func (ceu *CourseEnrollmentUpdate) SetUserID(u uuid.UUID) *CourseEnrollmentUpdate {
+	ceu.mutation.SetUserID(u)
+	return ceu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableUserID(u *uuid.UUID) *CourseEnrollmentUpdate {
+	if u != nil {
+		ceu.SetUserID(*u)
+	}
+	return ceu
+}
+
+// SetRole sets the "role" field.
+func (ceu *CourseEnrollmentUpdate) SetRole(c courseenrollment.Role) *CourseEnrollmentUpdate {
+	ceu.mutation.SetRole(c)
+	return ceu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableRole(c *courseenrollment.Role) *CourseEnrollmentUpdate {
+	if c != nil {
+		ceu.SetRole(*c)
+	}
+	return ceu
+}
+
+// SetStatus sets the "status" field.
+func (ceu *CourseEnrollmentUpdate) SetStatus(c courseenrollment.Status) *CourseEnrollmentUpdate {
+	ceu.mutation.SetStatus(c)
+	return ceu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableStatus(c *courseenrollment.Status) *CourseEnrollmentUpdate {
+	if c != nil {
+		ceu.SetStatus(*c)
+	}
+	return ceu
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (ceu *CourseEnrollmentUpdate) SetEnrolledAt(t time.Time) *CourseEnrollmentUpdate {
+	ceu.mutation.SetEnrolledAt(t)
+	return ceu
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (ceu *CourseEnrollmentUpdate) SetNillableEnrolledAt(t *time.Time) *CourseEnrollmentUpdate {
+	if t != nil {
+		ceu.SetEnrolledAt(*t)
+	}
+	return ceu
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (ceu *CourseEnrollmentUpdate) SetCourse(c *Course) *CourseEnrollmentUpdate {
+	return ceu.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ceu *CourseEnrollmentUpdate) SetUser(u *User) *CourseEnrollmentUpdate {
+	return ceu.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseEnrollmentMutation object of the builder.
+func (ceu *CourseEnrollmentUpdate) Mutation() *CourseEnrollmentMutation {
+	return ceu.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (ceu *CourseEnrollmentUpdate) ClearCourse() *CourseEnrollmentUpdate {
+	ceu.mutation.ClearCourse()
+	return ceu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ceu *CourseEnrollmentUpdate) ClearUser() *CourseEnrollmentUpdate {
+	ceu.mutation.ClearUser()
+	return ceu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ceu *CourseEnrollmentUpdate) Save(ctx context.Context) (int, error) {
+	if err := ceu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ceu.sqlSave, ceu.mutation, ceu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceu *CourseEnrollmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ceu *CourseEnrollmentUpdate) Exec(ctx context.Context) error {
+	_, err := ceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceu *CourseEnrollmentUpdate) ExecX(ctx context.Context) {
+	if err := ceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ceu *CourseEnrollmentUpdate) defaults() error {
+	if _, ok := ceu.mutation.UpdatedAt(); !ok {
+		if courseenrollment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.UpdateDefaultUpdatedAt()
+		ceu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ceu *CourseEnrollmentUpdate) check() error {
+	if v, ok := ceu.mutation.Role(); ok {
+		if err := courseenrollment.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.role": %w`, err)}
+		}
+	}
+	if v, ok := ceu.mutation.Status(); ok {
+		if err := courseenrollment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.status": %w`, err)}
+		}
+	}
+	if ceu.mutation.CourseCleared() && len(ceu.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseEnrollment.course"`)
+	}
+	if ceu.mutation.UserCleared() && len(ceu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseEnrollment.user"`)
+	}
+	return nil
+}
+
+func (ceu *CourseEnrollmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ceu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(courseenrollment.Table, courseenrollment.Columns, sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID))
+	if ps := ceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceu.mutation.CreatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ceu.mutation.UpdatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ceu.mutation.DeletedAt(); ok {
+		_spec.SetField(courseenrollment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ceu.mutation.DeletedAtCleared() {
+		_spec.ClearField(courseenrollment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ceu.mutation.Role(); ok {
+		_spec.SetField(courseenrollment.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := ceu.mutation.Status(); ok {
+		_spec.SetField(courseenrollment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ceu.mutation.EnrolledAt(); ok {
+		_spec.SetField(courseenrollment.FieldEnrolledAt, field.TypeTime, value)
+	}
+	if ceu.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.CourseTable,
+			Columns: []string{courseenrollment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.CourseTable,
+			Columns: []string{courseenrollment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.UserTable,
+			Columns: []string{courseenrollment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.UserTable,
+			Columns: []string{courseenrollment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{courseenrollment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ceu.mutation.done = true
+	return n, nil
+}
+
+// CourseEnrollmentUpdateOne is the builder for updating a single CourseEnrollment entity.
+type CourseEnrollmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CourseEnrollmentMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetCreatedAt(t time.Time) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetCreatedAt(t)
+	return ceuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableCreatedAt(t *time.Time) *CourseEnrollmentUpdateOne {
+	if t != nil {
+		ceuo.SetCreatedAt(*t)
+	}
+	return ceuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetUpdatedAt(t time.Time) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetUpdatedAt(t)
+	return ceuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetDeletedAt(t time.Time) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetDeletedAt(t)
+	return ceuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableDeletedAt(t *time.Time) *CourseEnrollmentUpdateOne {
+	if t != nil {
+		ceuo.SetDeletedAt(*t)
+	}
+	return ceuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ceuo *CourseEnrollmentUpdateOne) ClearDeletedAt() *CourseEnrollmentUpdateOne {
+	ceuo.mutation.ClearDeletedAt()
+	return ceuo
+}
+
+// SetCourseID sets the "course_id" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetCourseID(u uuid.UUID) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetCourseID(u)
+	return ceuo
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableCourseID(u *uuid.UUID) *CourseEnrollmentUpdateOne {
+	if u != nil {
+		ceuo.SetCourseID(*u)
+	}
+	return ceuo
+}
+
+// SetUserID sets the "user_id" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetUserID(u uuid.UUID) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetUserID(u)
+	return ceuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableUserID(u *uuid.UUID) *CourseEnrollmentUpdateOne {
+	if u != nil {
+		ceuo.SetUserID(*u)
+	}
+	return ceuo
+}
+
+// SetRole sets the "role" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetRole(c courseenrollment.Role) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetRole(c)
+	return ceuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableRole(c *courseenrollment.Role) *CourseEnrollmentUpdateOne {
+	if c != nil {
+		ceuo.SetRole(*c)
+	}
+	return ceuo
+}
+
+// SetStatus sets the "status" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetStatus(c courseenrollment.Status) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetStatus(c)
+	return ceuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableStatus(c *courseenrollment.Status) *CourseEnrollmentUpdateOne {
+	if c != nil {
+		ceuo.SetStatus(*c)
+	}
+	return ceuo
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (ceuo *CourseEnrollmentUpdateOne) SetEnrolledAt(t time.Time) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.SetEnrolledAt(t)
+	return ceuo
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (ceuo *CourseEnrollmentUpdateOne) SetNillableEnrolledAt(t *time.Time) *CourseEnrollmentUpdateOne {
+	if t != nil {
+		ceuo.SetEnrolledAt(*t)
+	}
+	return ceuo
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (ceuo *CourseEnrollmentUpdateOne) SetCourse(c *Course) *CourseEnrollmentUpdateOne {
+	return ceuo.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ceuo *CourseEnrollmentUpdateOne) SetUser(u *User) *CourseEnrollmentUpdateOne {
+	return ceuo.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseEnrollmentMutation object of the builder.
+func (ceuo *CourseEnrollmentUpdateOne) Mutation() *CourseEnrollmentMutation {
+	return ceuo.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (ceuo *CourseEnrollmentUpdateOne) ClearCourse() *CourseEnrollmentUpdateOne {
+	ceuo.mutation.ClearCourse()
+	return ceuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ceuo *CourseEnrollmentUpdateOne) ClearUser() *CourseEnrollmentUpdateOne {
+	ceuo.mutation.ClearUser()
+	return ceuo
+}
+
+// Where appends a list predicates to the CourseEnrollmentUpdate builder.
+func (ceuo *CourseEnrollmentUpdateOne) Where(ps ...predicate.CourseEnrollment) *CourseEnrollmentUpdateOne {
+	ceuo.mutation.Where(ps...)
+	return ceuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ceuo *CourseEnrollmentUpdateOne) Select(field string, fields ...string) *CourseEnrollmentUpdateOne {
+	ceuo.fields = append([]string{field}, fields...)
+	return ceuo
+}
+
+// Save executes the query and returns the updated CourseEnrollment entity.
+func (ceuo *CourseEnrollmentUpdateOne) Save(ctx context.Context) (*CourseEnrollment, error) {
+	if err := ceuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ceuo.sqlSave, ceuo.mutation, ceuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceuo *CourseEnrollmentUpdateOne) SaveX(ctx context.Context) *CourseEnrollment {
+	node, err := ceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ceuo *CourseEnrollmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceuo *CourseEnrollmentUpdateOne) ExecX(ctx context.Context) {
+	if err := ceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ceuo *CourseEnrollmentUpdateOne) defaults() error {
+	if _, ok := ceuo.mutation.UpdatedAt(); !ok {
+		if courseenrollment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized courseenrollment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := courseenrollment.UpdateDefaultUpdatedAt()
+		ceuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ceuo *CourseEnrollmentUpdateOne) check() error {
+	if v, ok := ceuo.mutation.Role(); ok {
+		if err := courseenrollment.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.role": %w`, err)}
+		}
+	}
+	if v, ok := ceuo.mutation.Status(); ok {
+		if err := courseenrollment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CourseEnrollment.status": %w`, err)}
+		}
+	}
+	if ceuo.mutation.CourseCleared() && len(ceuo.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseEnrollment.course"`)
+	}
+	if ceuo.mutation.UserCleared() && len(ceuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseEnrollment.user"`)
+	}
+	return nil
+}
+
+func (ceuo *CourseEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *CourseEnrollment, err error) {
+	if err := ceuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(courseenrollment.Table, courseenrollment.Columns, sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID))
+	id, ok := ceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CourseEnrollment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ceuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, courseenrollment.FieldID)
+		for _, f := range fields {
+			if !courseenrollment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != courseenrollment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceuo.mutation.CreatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ceuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(courseenrollment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ceuo.mutation.DeletedAt(); ok {
+		_spec.SetField(courseenrollment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ceuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(courseenrollment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ceuo.mutation.Role(); ok {
+		_spec.SetField(courseenrollment.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := ceuo.mutation.Status(); ok {
+		_spec.SetField(courseenrollment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ceuo.mutation.EnrolledAt(); ok {
+		_spec.SetField(courseenrollment.FieldEnrolledAt, field.TypeTime, value)
+	}
+	if ceuo.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.CourseTable,
+			Columns: []string{courseenrollment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.CourseTable,
+			Columns: []string{courseenrollment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.UserTable,
+			Columns: []string{courseenrollment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseenrollment.UserTable,
+			Columns: []string{courseenrollment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CourseEnrollment{config: ceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{courseenrollment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ceuo.mutation.done = true
+	return _node, nil
+}
@@ -57,9 +57,13 @@ type CourseSectionEdges struct {
 	TestSessions []*TestSession `json:"test_sessions,omitempty"`
 	// Tests holds the value of the tests edge.
 	Tests []*Test `json:"tests,omitempty"`
+	// Prerequisites holds the value of the prerequisites edge.
+	Prerequisites []*CourseSectionPrerequisite `json:"prerequisites,omitempty"`
+	// RequiredBy holds the value of the required_by edge.
+	RequiredBy []*CourseSectionPrerequisite `json:"required_by,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 }
 
 // CourseOrErr returns the Course value or an error if the edge
@@ -129,6 +133,24 @@ func (e CourseSectionEdges) TestsOrErr() ([]*Test, error) {
 	return nil, &NotLoadedError{edge: "tests"}
 }
 
+// PrerequisitesOrErr returns the Prerequisites value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseSectionEdges) PrerequisitesOrErr() ([]*CourseSectionPrerequisite, error) {
+	if e.loadedTypes[7] {
+		return e.Prerequisites, nil
+	}
+	return nil, &NotLoadedError{edge: "prerequisites"}
+}
+
+// RequiredByOrErr returns the RequiredBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseSectionEdges) RequiredByOrErr() ([]*CourseSectionPrerequisite, error) {
+	if e.loadedTypes[8] {
+		return e.RequiredBy, nil
+	}
+	return nil, &NotLoadedError{edge: "required_by"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CourseSection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -264,6 +286,16 @@ func (cs *CourseSection) QueryTests() *TestQuery {
 	return NewCourseSectionClient(cs.config).QueryTests(cs)
 }
 
+// QueryPrerequisites queries the "prerequisites" edge of the CourseSection entity.
+func (cs *CourseSection) QueryPrerequisites() *CourseSectionPrerequisiteQuery {
+	return NewCourseSectionClient(cs.config).QueryPrerequisites(cs)
+}
+
+// QueryRequiredBy queries the "required_by" edge of the CourseSection entity.
+func (cs *CourseSection) QueryRequiredBy() *CourseSectionPrerequisiteQuery {
+	return NewCourseSectionClient(cs.config).QueryRequiredBy(cs)
+}
+
 // Update returns a builder for updating this CourseSection.
 // Note that you need to call CourseSection.Unwrap() before calling this method if this CourseSection
 // was returned from a transaction, and the transaction was committed or rolled back.
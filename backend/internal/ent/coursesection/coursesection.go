@@ -46,6 +46,10 @@ const (
 	EdgeTestSessions = "test_sessions"
 	// EdgeTests holds the string denoting the tests edge name in mutations.
 	EdgeTests = "tests"
+	// EdgePrerequisites holds the string denoting the prerequisites edge name in mutations.
+	EdgePrerequisites = "prerequisites"
+	// EdgeRequiredBy holds the string denoting the required_by edge name in mutations.
+	EdgeRequiredBy = "required_by"
 	// Table holds the table name of the coursesection in the database.
 	Table = "course_sections"
 	// CourseTable is the table that holds the course relation/edge.
@@ -91,6 +95,20 @@ const (
 	TestsInverseTable = "tests"
 	// TestsColumn is the table column denoting the tests relation/edge.
 	TestsColumn = "course_section_id"
+	// PrerequisitesTable is the table that holds the prerequisites relation/edge.
+	PrerequisitesTable = "course_section_prerequisites"
+	// PrerequisitesInverseTable is the table name for the CourseSectionPrerequisite entity.
+	// It exists in this package in order to avoid circular dependency with the "coursesectionprerequisite" package.
+	PrerequisitesInverseTable = "course_section_prerequisites"
+	// PrerequisitesColumn is the table column denoting the prerequisites relation/edge.
+	PrerequisitesColumn = "section_id"
+	// RequiredByTable is the table that holds the required_by relation/edge.
+	RequiredByTable = "course_section_prerequisites"
+	// RequiredByInverseTable is the table name for the CourseSectionPrerequisite entity.
+	// It exists in this package in order to avoid circular dependency with the "coursesectionprerequisite" package.
+	RequiredByInverseTable = "course_section_prerequisites"
+	// RequiredByColumn is the table column denoting the required_by relation/edge.
+	RequiredByColumn = "prerequisite_section_id"
 )
 
 // Columns holds all SQL columns for coursesection fields.
@@ -122,8 +140,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -269,6 +287,34 @@ func ByTests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPrerequisitesCount orders the results by prerequisites count.
+func ByPrerequisitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrerequisitesStep(), opts...)
+	}
+}
+
+// ByPrerequisites orders the results by prerequisites terms.
+func ByPrerequisites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrerequisitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredByCount orders the results by required_by count.
+func ByRequiredByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredByStep(), opts...)
+	}
+}
+
+// ByRequiredBy orders the results by required_by terms.
+func ByRequiredBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCourseStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -318,3 +364,17 @@ func newTestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TestsTable, TestsColumn),
 	)
 }
+func newPrerequisitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrerequisitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrerequisitesTable, PrerequisitesColumn),
+	)
+}
+func newRequiredByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequiredByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RequiredByTable, RequiredByColumn),
+	)
+}
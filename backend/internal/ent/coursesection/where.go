@@ -617,6 +617,52 @@ func HasTestsWith(preds ...predicate.Test) predicate.CourseSection {
 	})
 }
 
+// HasPrerequisites applies the HasEdge predicate on the "prerequisites" edge.
+func HasPrerequisites() predicate.CourseSection {
+	return predicate.CourseSection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrerequisitesTable, PrerequisitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrerequisitesWith applies the HasEdge predicate on the "prerequisites" edge with a given conditions (other predicates).
+func HasPrerequisitesWith(preds ...predicate.CourseSectionPrerequisite) predicate.CourseSection {
+	return predicate.CourseSection(func(s *sql.Selector) {
+		step := newPrerequisitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequiredBy applies the HasEdge predicate on the "required_by" edge.
+func HasRequiredBy() predicate.CourseSection {
+	return predicate.CourseSection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RequiredByTable, RequiredByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequiredByWith applies the HasEdge predicate on the "required_by" edge with a given conditions (other predicates).
+func HasRequiredByWith(preds ...predicate.CourseSectionPrerequisite) predicate.CourseSection {
+	return predicate.CourseSection(func(s *sql.Selector) {
+		step := newRequiredByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CourseSection) predicate.CourseSection {
 	return predicate.CourseSection(sql.AndPredicates(predicates...))
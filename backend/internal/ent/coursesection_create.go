@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
 	"template/internal/ent/testsession"
@@ -235,6 +236,36 @@ func (csc *CourseSectionCreate) AddTests(t ...*Test) *CourseSectionCreate {
 	return csc.AddTestIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the CourseSectionPrerequisite entity by IDs.
+func (csc *CourseSectionCreate) AddPrerequisiteIDs(ids ...uuid.UUID) *CourseSectionCreate {
+	csc.mutation.AddPrerequisiteIDs(ids...)
+	return csc
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the CourseSectionPrerequisite entity.
+func (csc *CourseSectionCreate) AddPrerequisites(c ...*CourseSectionPrerequisite) *CourseSectionCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csc.AddPrerequisiteIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the CourseSectionPrerequisite entity by IDs.
+func (csc *CourseSectionCreate) AddRequiredByIDs(ids ...uuid.UUID) *CourseSectionCreate {
+	csc.mutation.AddRequiredByIDs(ids...)
+	return csc
+}
+
+// AddRequiredBy adds the "required_by" edges to the CourseSectionPrerequisite entity.
+func (csc *CourseSectionCreate) AddRequiredBy(c ...*CourseSectionPrerequisite) *CourseSectionCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csc.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the CourseSectionMutation object of the builder.
 func (csc *CourseSectionCreate) Mutation() *CourseSectionMutation {
 	return csc.mutation
@@ -498,6 +529,38 @@ func (csc *CourseSectionCreate) createSpec() (*CourseSection, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := csc.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := csc.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
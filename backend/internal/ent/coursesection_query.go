@@ -9,6 +9,7 @@ import (
 	"math"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
@@ -16,6 +17,7 @@ import (
 	"template/internal/ent/video"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -36,6 +38,9 @@ type CourseSectionQuery struct {
 	withQuestionCollections *QuestionCollectionQuery
 	withTestSessions        *TestSessionQuery
 	withTests               *TestQuery
+	withPrerequisites       *CourseSectionPrerequisiteQuery
+	withRequiredBy          *CourseSectionPrerequisiteQuery
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -226,6 +231,50 @@ func (csq *CourseSectionQuery) QueryTests() *TestQuery {
 	return query
 }
 
+// QueryPrerequisites chains the current query on the "prerequisites" edge.
+func (csq *CourseSectionQuery) QueryPrerequisites() *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionPrerequisiteClient{config: csq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, selector),
+			sqlgraph.To(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.PrerequisitesTable, coursesection.PrerequisitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequiredBy chains the current query on the "required_by" edge.
+func (csq *CourseSectionQuery) QueryRequiredBy() *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionPrerequisiteClient{config: csq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesection.Table, coursesection.FieldID, selector),
+			sqlgraph.To(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coursesection.RequiredByTable, coursesection.RequiredByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CourseSection entity from the query.
 // Returns a *NotFoundError when no CourseSection was found.
 func (csq *CourseSectionQuery) First(ctx context.Context) (*CourseSection, error) {
@@ -425,6 +474,8 @@ func (csq *CourseSectionQuery) Clone() *CourseSectionQuery {
 		withQuestionCollections: csq.withQuestionCollections.Clone(),
 		withTestSessions:        csq.withTestSessions.Clone(),
 		withTests:               csq.withTests.Clone(),
+		withPrerequisites:       csq.withPrerequisites.Clone(),
+		withRequiredBy:          csq.withRequiredBy.Clone(),
 		// clone intermediate query.
 		sql:  csq.sql.Clone(),
 		path: csq.path,
@@ -508,6 +559,28 @@ func (csq *CourseSectionQuery) WithTests(opts ...func(*TestQuery)) *CourseSectio
 	return csq
 }
 
+// WithPrerequisites tells the query-builder to eager-load the nodes that are connected to
+// the "prerequisites" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *CourseSectionQuery) WithPrerequisites(opts ...func(*CourseSectionPrerequisiteQuery)) *CourseSectionQuery {
+	query := (&CourseSectionPrerequisiteClient{config: csq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withPrerequisites = query
+	return csq
+}
+
+// WithRequiredBy tells the query-builder to eager-load the nodes that are connected to
+// the "required_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *CourseSectionQuery) WithRequiredBy(opts ...func(*CourseSectionPrerequisiteQuery)) *CourseSectionQuery {
+	query := (&CourseSectionPrerequisiteClient{config: csq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withRequiredBy = query
+	return csq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -586,7 +659,7 @@ func (csq *CourseSectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*CourseSection{}
 		_spec       = csq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [9]bool{
 			csq.withCourse != nil,
 			csq.withParent != nil,
 			csq.withChildren != nil,
@@ -594,6 +667,8 @@ func (csq *CourseSectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			csq.withQuestionCollections != nil,
 			csq.withTestSessions != nil,
 			csq.withTests != nil,
+			csq.withPrerequisites != nil,
+			csq.withRequiredBy != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -605,6 +680,9 @@ func (csq *CourseSectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -663,6 +741,24 @@ func (csq *CourseSectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := csq.withPrerequisites; query != nil {
+		if err := csq.loadPrerequisites(ctx, query, nodes,
+			func(n *CourseSection) { n.Edges.Prerequisites = []*CourseSectionPrerequisite{} },
+			func(n *CourseSection, e *CourseSectionPrerequisite) {
+				n.Edges.Prerequisites = append(n.Edges.Prerequisites, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := csq.withRequiredBy; query != nil {
+		if err := csq.loadRequiredBy(ctx, query, nodes,
+			func(n *CourseSection) { n.Edges.RequiredBy = []*CourseSectionPrerequisite{} },
+			func(n *CourseSection, e *CourseSectionPrerequisite) {
+				n.Edges.RequiredBy = append(n.Edges.RequiredBy, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -889,9 +985,72 @@ func (csq *CourseSectionQuery) loadTests(ctx context.Context, query *TestQuery,
 	}
 	return nil
 }
+func (csq *CourseSectionQuery) loadPrerequisites(ctx context.Context, query *CourseSectionPrerequisiteQuery, nodes []*CourseSection, init func(*CourseSection), assign func(*CourseSection, *CourseSectionPrerequisite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*CourseSection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coursesectionprerequisite.FieldSectionID)
+	}
+	query.Where(predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coursesection.PrerequisitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "section_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (csq *CourseSectionQuery) loadRequiredBy(ctx context.Context, query *CourseSectionPrerequisiteQuery, nodes []*CourseSection, init func(*CourseSection), assign func(*CourseSection, *CourseSectionPrerequisite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*CourseSection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coursesectionprerequisite.FieldPrerequisiteSectionID)
+	}
+	query.Where(predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coursesection.RequiredByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PrerequisiteSectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "prerequisite_section_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (csq *CourseSectionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := csq.querySpec()
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
 	_spec.Node.Columns = csq.ctx.Fields
 	if len(csq.ctx.Fields) > 0 {
 		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
@@ -960,6 +1119,9 @@ func (csq *CourseSectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if csq.ctx.Unique != nil && *csq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range csq.modifiers {
+		m(selector)
+	}
 	for _, p := range csq.predicates {
 		p(selector)
 	}
@@ -977,6 +1139,32 @@ func (csq *CourseSectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (csq *CourseSectionQuery) ForUpdate(opts ...sql.LockOption) *CourseSectionQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return csq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (csq *CourseSectionQuery) ForShare(opts ...sql.LockOption) *CourseSectionQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return csq
+}
+
 // CourseSectionGroupBy is the group-by builder for CourseSection entities.
 type CourseSectionGroupBy struct {
 	selector
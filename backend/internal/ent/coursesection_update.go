@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
@@ -262,6 +263,36 @@ func (csu *CourseSectionUpdate) AddTests(t ...*Test) *CourseSectionUpdate {
 	return csu.AddTestIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the CourseSectionPrerequisite entity by IDs.
+func (csu *CourseSectionUpdate) AddPrerequisiteIDs(ids ...uuid.UUID) *CourseSectionUpdate {
+	csu.mutation.AddPrerequisiteIDs(ids...)
+	return csu
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the CourseSectionPrerequisite entity.
+func (csu *CourseSectionUpdate) AddPrerequisites(c ...*CourseSectionPrerequisite) *CourseSectionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.AddPrerequisiteIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the CourseSectionPrerequisite entity by IDs.
+func (csu *CourseSectionUpdate) AddRequiredByIDs(ids ...uuid.UUID) *CourseSectionUpdate {
+	csu.mutation.AddRequiredByIDs(ids...)
+	return csu
+}
+
+// AddRequiredBy adds the "required_by" edges to the CourseSectionPrerequisite entity.
+func (csu *CourseSectionUpdate) AddRequiredBy(c ...*CourseSectionPrerequisite) *CourseSectionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the CourseSectionMutation object of the builder.
 func (csu *CourseSectionUpdate) Mutation() *CourseSectionMutation {
 	return csu.mutation
@@ -384,6 +415,48 @@ func (csu *CourseSectionUpdate) RemoveTests(t ...*Test) *CourseSectionUpdate {
 	return csu.RemoveTestIDs(ids...)
 }
 
+// ClearPrerequisites clears all "prerequisites" edges to the CourseSectionPrerequisite entity.
+func (csu *CourseSectionUpdate) ClearPrerequisites() *CourseSectionUpdate {
+	csu.mutation.ClearPrerequisites()
+	return csu
+}
+
+// RemovePrerequisiteIDs removes the "prerequisites" edge to CourseSectionPrerequisite entities by IDs.
+func (csu *CourseSectionUpdate) RemovePrerequisiteIDs(ids ...uuid.UUID) *CourseSectionUpdate {
+	csu.mutation.RemovePrerequisiteIDs(ids...)
+	return csu
+}
+
+// RemovePrerequisites removes "prerequisites" edges to CourseSectionPrerequisite entities.
+func (csu *CourseSectionUpdate) RemovePrerequisites(c ...*CourseSectionPrerequisite) *CourseSectionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.RemovePrerequisiteIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the CourseSectionPrerequisite entity.
+func (csu *CourseSectionUpdate) ClearRequiredBy() *CourseSectionUpdate {
+	csu.mutation.ClearRequiredBy()
+	return csu
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to CourseSectionPrerequisite entities by IDs.
+func (csu *CourseSectionUpdate) RemoveRequiredByIDs(ids ...uuid.UUID) *CourseSectionUpdate {
+	csu.mutation.RemoveRequiredByIDs(ids...)
+	return csu
+}
+
+// RemoveRequiredBy removes "required_by" edges to CourseSectionPrerequisite entities.
+func (csu *CourseSectionUpdate) RemoveRequiredBy(c ...*CourseSectionPrerequisite) *CourseSectionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.RemoveRequiredByIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (csu *CourseSectionUpdate) Save(ctx context.Context) (int, error) {
 	if err := csu.defaults(); err != nil {
@@ -761,6 +834,96 @@ func (csu *CourseSectionUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if csu.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.RemovedPrerequisitesIDs(); len(nodes) > 0 && !csu.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csu.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !csu.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{coursesection.Label}
@@ -1009,6 +1172,36 @@ func (csuo *CourseSectionUpdateOne) AddTests(t ...*Test) *CourseSectionUpdateOne
 	return csuo.AddTestIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the CourseSectionPrerequisite entity by IDs.
+func (csuo *CourseSectionUpdateOne) AddPrerequisiteIDs(ids ...uuid.UUID) *CourseSectionUpdateOne {
+	csuo.mutation.AddPrerequisiteIDs(ids...)
+	return csuo
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the CourseSectionPrerequisite entity.
+func (csuo *CourseSectionUpdateOne) AddPrerequisites(c ...*CourseSectionPrerequisite) *CourseSectionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.AddPrerequisiteIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the CourseSectionPrerequisite entity by IDs.
+func (csuo *CourseSectionUpdateOne) AddRequiredByIDs(ids ...uuid.UUID) *CourseSectionUpdateOne {
+	csuo.mutation.AddRequiredByIDs(ids...)
+	return csuo
+}
+
+// AddRequiredBy adds the "required_by" edges to the CourseSectionPrerequisite entity.
+func (csuo *CourseSectionUpdateOne) AddRequiredBy(c ...*CourseSectionPrerequisite) *CourseSectionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the CourseSectionMutation object of the builder.
 func (csuo *CourseSectionUpdateOne) Mutation() *CourseSectionMutation {
 	return csuo.mutation
@@ -1131,6 +1324,48 @@ func (csuo *CourseSectionUpdateOne) RemoveTests(t ...*Test) *CourseSectionUpdate
 	return csuo.RemoveTestIDs(ids...)
 }
 
+// ClearPrerequisites clears all "prerequisites" edges to the CourseSectionPrerequisite entity.
+func (csuo *CourseSectionUpdateOne) ClearPrerequisites() *CourseSectionUpdateOne {
+	csuo.mutation.ClearPrerequisites()
+	return csuo
+}
+
+// RemovePrerequisiteIDs removes the "prerequisites" edge to CourseSectionPrerequisite entities by IDs.
+func (csuo *CourseSectionUpdateOne) RemovePrerequisiteIDs(ids ...uuid.UUID) *CourseSectionUpdateOne {
+	csuo.mutation.RemovePrerequisiteIDs(ids...)
+	return csuo
+}
+
+// RemovePrerequisites removes "prerequisites" edges to CourseSectionPrerequisite entities.
+func (csuo *CourseSectionUpdateOne) RemovePrerequisites(c ...*CourseSectionPrerequisite) *CourseSectionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.RemovePrerequisiteIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the CourseSectionPrerequisite entity.
+func (csuo *CourseSectionUpdateOne) ClearRequiredBy() *CourseSectionUpdateOne {
+	csuo.mutation.ClearRequiredBy()
+	return csuo
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to CourseSectionPrerequisite entities by IDs.
+func (csuo *CourseSectionUpdateOne) RemoveRequiredByIDs(ids ...uuid.UUID) *CourseSectionUpdateOne {
+	csuo.mutation.RemoveRequiredByIDs(ids...)
+	return csuo
+}
+
+// RemoveRequiredBy removes "required_by" edges to CourseSectionPrerequisite entities.
+func (csuo *CourseSectionUpdateOne) RemoveRequiredBy(c ...*CourseSectionPrerequisite) *CourseSectionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.RemoveRequiredByIDs(ids...)
+}
+
 // Where appends a list predicates to the CourseSectionUpdate builder.
 func (csuo *CourseSectionUpdateOne) Where(ps ...predicate.CourseSection) *CourseSectionUpdateOne {
 	csuo.mutation.Where(ps...)
@@ -1538,6 +1773,96 @@ func (csuo *CourseSectionUpdateOne) sqlSave(ctx context.Context) (_node *CourseS
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if csuo.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.RemovedPrerequisitesIDs(); len(nodes) > 0 && !csuo.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.PrerequisitesTable,
+			Columns: []string{coursesection.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csuo.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !csuo.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coursesection.RequiredByTable,
+			Columns: []string{coursesection.RequiredByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &CourseSection{config: csuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
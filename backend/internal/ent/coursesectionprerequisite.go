@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CourseSectionPrerequisite is the model entity for the CourseSectionPrerequisite schema.
+type CourseSectionPrerequisite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SectionID holds the value of the "section_id" field.
+	SectionID uuid.UUID `json:"section_id,omitempty"`
+	// PrerequisiteSectionID holds the value of the "prerequisite_section_id" field.
+	PrerequisiteSectionID uuid.UUID `json:"prerequisite_section_id,omitempty"`
+	// Minimum score in percent required on the tests of the prerequisite section
+	MinScorePercent *int `json:"min_score_percent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CourseSectionPrerequisiteQuery when eager-loading is set.
+	Edges        CourseSectionPrerequisiteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CourseSectionPrerequisiteEdges holds the relations/edges for other nodes in the graph.
+type CourseSectionPrerequisiteEdges struct {
+	// Section holds the value of the section edge.
+	Section *CourseSection `json:"section,omitempty"`
+	// PrerequisiteSection holds the value of the prerequisite_section edge.
+	PrerequisiteSection *CourseSection `json:"prerequisite_section,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SectionOrErr returns the Section value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CourseSectionPrerequisiteEdges) SectionOrErr() (*CourseSection, error) {
+	if e.Section != nil {
+		return e.Section, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coursesection.Label}
+	}
+	return nil, &NotLoadedError{edge: "section"}
+}
+
+// PrerequisiteSectionOrErr returns the PrerequisiteSection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CourseSectionPrerequisiteEdges) PrerequisiteSectionOrErr() (*CourseSection, error) {
+	if e.PrerequisiteSection != nil {
+		return e.PrerequisiteSection, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: coursesection.Label}
+	}
+	return nil, &NotLoadedError{edge: "prerequisite_section"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CourseSectionPrerequisite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coursesectionprerequisite.FieldMinScorePercent:
+			values[i] = new(sql.NullInt64)
+		case coursesectionprerequisite.FieldCreatedAt, coursesectionprerequisite.FieldUpdatedAt, coursesectionprerequisite.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case coursesectionprerequisite.FieldID, coursesectionprerequisite.FieldSectionID, coursesectionprerequisite.FieldPrerequisiteSectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CourseSectionPrerequisite fields.
+func (csp *CourseSectionPrerequisite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coursesectionprerequisite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				csp.ID = *value
+			}
+		case coursesectionprerequisite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				csp.CreatedAt = value.Time
+			}
+		case coursesectionprerequisite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				csp.UpdatedAt = value.Time
+			}
+		case coursesectionprerequisite.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				csp.DeletedAt = new(time.Time)
+				*csp.DeletedAt = value.Time
+			}
+		case coursesectionprerequisite.FieldSectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field section_id", values[i])
+			} else if value != nil {
+				csp.SectionID = *value
+			}
+		case coursesectionprerequisite.FieldPrerequisiteSectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field prerequisite_section_id", values[i])
+			} else if value != nil {
+				csp.PrerequisiteSectionID = *value
+			}
+		case coursesectionprerequisite.FieldMinScorePercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_score_percent", values[i])
+			} else if value.Valid {
+				csp.MinScorePercent = new(int)
+				*csp.MinScorePercent = int(value.Int64)
+			}
+		default:
+			csp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CourseSectionPrerequisite.
+// This includes values selected through modifiers, order, etc.
+func (csp *CourseSectionPrerequisite) Value(name string) (ent.Value, error) {
+	return csp.selectValues.Get(name)
+}
+
+// QuerySection queries the "section" edge of the CourseSectionPrerequisite entity.
+func (csp *CourseSectionPrerequisite) QuerySection() *CourseSectionQuery {
+	return NewCourseSectionPrerequisiteClient(csp.config).QuerySection(csp)
+}
+
+// QueryPrerequisiteSection queries the "prerequisite_section" edge of the CourseSectionPrerequisite entity.
+func (csp *CourseSectionPrerequisite) QueryPrerequisiteSection() *CourseSectionQuery {
+	return NewCourseSectionPrerequisiteClient(csp.config).QueryPrerequisiteSection(csp)
+}
+
+// Update returns a builder for updating this CourseSectionPrerequisite.
+// Note that you need to call CourseSectionPrerequisite.Unwrap() before calling this method if this CourseSectionPrerequisite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (csp *CourseSectionPrerequisite) Update() *CourseSectionPrerequisiteUpdateOne {
+	return NewCourseSectionPrerequisiteClient(csp.config).UpdateOne(csp)
+}
+
+// Unwrap unwraps the CourseSectionPrerequisite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (csp *CourseSectionPrerequisite) Unwrap() *CourseSectionPrerequisite {
+	_tx, ok := csp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CourseSectionPrerequisite is not a transactional entity")
+	}
+	csp.config.driver = _tx.drv
+	return csp
+}
+
+// String implements the fmt.Stringer.
+func (csp *CourseSectionPrerequisite) String() string {
+	var builder strings.Builder
+	builder.WriteString("CourseSectionPrerequisite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", csp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(csp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(csp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := csp.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("section_id=")
+	builder.WriteString(fmt.Sprintf("%v", csp.SectionID))
+	builder.WriteString(", ")
+	builder.WriteString("prerequisite_section_id=")
+	builder.WriteString(fmt.Sprintf("%v", csp.PrerequisiteSectionID))
+	builder.WriteString(", ")
+	if v := csp.MinScorePercent; v != nil {
+		builder.WriteString("min_score_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CourseSectionPrerequisites is a parsable slice of CourseSectionPrerequisite.
+type CourseSectionPrerequisites []*CourseSectionPrerequisite
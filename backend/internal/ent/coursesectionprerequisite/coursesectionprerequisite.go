@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coursesectionprerequisite
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the coursesectionprerequisite type in the database.
+	Label = "course_section_prerequisite"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSectionID holds the string denoting the section_id field in the database.
+	FieldSectionID = "section_id"
+	// FieldPrerequisiteSectionID holds the string denoting the prerequisite_section_id field in the database.
+	FieldPrerequisiteSectionID = "prerequisite_section_id"
+	// FieldMinScorePercent holds the string denoting the min_score_percent field in the database.
+	FieldMinScorePercent = "min_score_percent"
+	// EdgeSection holds the string denoting the section edge name in mutations.
+	EdgeSection = "section"
+	// EdgePrerequisiteSection holds the string denoting the prerequisite_section edge name in mutations.
+	EdgePrerequisiteSection = "prerequisite_section"
+	// Table holds the table name of the coursesectionprerequisite in the database.
+	Table = "course_section_prerequisites"
+	// SectionTable is the table that holds the section relation/edge.
+	SectionTable = "course_section_prerequisites"
+	// SectionInverseTable is the table name for the CourseSection entity.
+	// It exists in this package in order to avoid circular dependency with the "coursesection" package.
+	SectionInverseTable = "course_sections"
+	// SectionColumn is the table column denoting the section relation/edge.
+	SectionColumn = "section_id"
+	// PrerequisiteSectionTable is the table that holds the prerequisite_section relation/edge.
+	PrerequisiteSectionTable = "course_section_prerequisites"
+	// PrerequisiteSectionInverseTable is the table name for the CourseSection entity.
+	// It exists in this package in order to avoid circular dependency with the "coursesection" package.
+	PrerequisiteSectionInverseTable = "course_sections"
+	// PrerequisiteSectionColumn is the table column denoting the prerequisite_section relation/edge.
+	PrerequisiteSectionColumn = "prerequisite_section_id"
+)
+
+// Columns holds all SQL columns for coursesectionprerequisite fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldSectionID,
+	FieldPrerequisiteSectionID,
+	FieldMinScorePercent,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// MinScorePercentValidator is a validator for the "min_score_percent" field. It is called by the builders before save.
+	MinScorePercentValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CourseSectionPrerequisite queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySectionID orders the results by the section_id field.
+func BySectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSectionID, opts...).ToFunc()
+}
+
+// ByPrerequisiteSectionID orders the results by the prerequisite_section_id field.
+func ByPrerequisiteSectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrerequisiteSectionID, opts...).ToFunc()
+}
+
+// ByMinScorePercent orders the results by the min_score_percent field.
+func ByMinScorePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinScorePercent, opts...).ToFunc()
+}
+
+// BySectionField orders the results by section field.
+func BySectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPrerequisiteSectionField orders the results by prerequisite_section field.
+func ByPrerequisiteSectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrerequisiteSectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+	)
+}
+func newPrerequisiteSectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrerequisiteSectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PrerequisiteSectionTable, PrerequisiteSectionColumn),
+	)
+}
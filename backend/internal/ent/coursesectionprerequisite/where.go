@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coursesectionprerequisite
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SectionID applies equality check predicate on the "section_id" field. It's identical to SectionIDEQ.
+func SectionID(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldSectionID, v))
+}
+
+// PrerequisiteSectionID applies equality check predicate on the "prerequisite_section_id" field. It's identical to PrerequisiteSectionIDEQ.
+func PrerequisiteSectionID(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldPrerequisiteSectionID, v))
+}
+
+// MinScorePercent applies equality check predicate on the "min_score_percent" field. It's identical to MinScorePercentEQ.
+func MinScorePercent(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldMinScorePercent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SectionIDEQ applies the EQ predicate on the "section_id" field.
+func SectionIDEQ(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldSectionID, v))
+}
+
+// SectionIDNEQ applies the NEQ predicate on the "section_id" field.
+func SectionIDNEQ(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldSectionID, v))
+}
+
+// SectionIDIn applies the In predicate on the "section_id" field.
+func SectionIDIn(vs ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldSectionID, vs...))
+}
+
+// SectionIDNotIn applies the NotIn predicate on the "section_id" field.
+func SectionIDNotIn(vs ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldSectionID, vs...))
+}
+
+// PrerequisiteSectionIDEQ applies the EQ predicate on the "prerequisite_section_id" field.
+func PrerequisiteSectionIDEQ(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldPrerequisiteSectionID, v))
+}
+
+// PrerequisiteSectionIDNEQ applies the NEQ predicate on the "prerequisite_section_id" field.
+func PrerequisiteSectionIDNEQ(v uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldPrerequisiteSectionID, v))
+}
+
+// PrerequisiteSectionIDIn applies the In predicate on the "prerequisite_section_id" field.
+func PrerequisiteSectionIDIn(vs ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldPrerequisiteSectionID, vs...))
+}
+
+// PrerequisiteSectionIDNotIn applies the NotIn predicate on the "prerequisite_section_id" field.
+func PrerequisiteSectionIDNotIn(vs ...uuid.UUID) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldPrerequisiteSectionID, vs...))
+}
+
+// MinScorePercentEQ applies the EQ predicate on the "min_score_percent" field.
+func MinScorePercentEQ(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldEQ(FieldMinScorePercent, v))
+}
+
+// MinScorePercentNEQ applies the NEQ predicate on the "min_score_percent" field.
+func MinScorePercentNEQ(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNEQ(FieldMinScorePercent, v))
+}
+
+// MinScorePercentIn applies the In predicate on the "min_score_percent" field.
+func MinScorePercentIn(vs ...int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIn(FieldMinScorePercent, vs...))
+}
+
+// MinScorePercentNotIn applies the NotIn predicate on the "min_score_percent" field.
+func MinScorePercentNotIn(vs ...int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotIn(FieldMinScorePercent, vs...))
+}
+
+// MinScorePercentGT applies the GT predicate on the "min_score_percent" field.
+func MinScorePercentGT(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGT(FieldMinScorePercent, v))
+}
+
+// MinScorePercentGTE applies the GTE predicate on the "min_score_percent" field.
+func MinScorePercentGTE(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldGTE(FieldMinScorePercent, v))
+}
+
+// MinScorePercentLT applies the LT predicate on the "min_score_percent" field.
+func MinScorePercentLT(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLT(FieldMinScorePercent, v))
+}
+
+// MinScorePercentLTE applies the LTE predicate on the "min_score_percent" field.
+func MinScorePercentLTE(v int) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldLTE(FieldMinScorePercent, v))
+}
+
+// MinScorePercentIsNil applies the IsNil predicate on the "min_score_percent" field.
+func MinScorePercentIsNil() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldIsNull(FieldMinScorePercent))
+}
+
+// MinScorePercentNotNil applies the NotNil predicate on the "min_score_percent" field.
+func MinScorePercentNotNil() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.FieldNotNull(FieldMinScorePercent))
+}
+
+// HasSection applies the HasEdge predicate on the "section" edge.
+func HasSection() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionWith applies the HasEdge predicate on the "section" edge with a given conditions (other predicates).
+func HasSectionWith(preds ...predicate.CourseSection) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		step := newSectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrerequisiteSection applies the HasEdge predicate on the "prerequisite_section" edge.
+func HasPrerequisiteSection() predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PrerequisiteSectionTable, PrerequisiteSectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrerequisiteSectionWith applies the HasEdge predicate on the "prerequisite_section" edge with a given conditions (other predicates).
+func HasPrerequisiteSectionWith(preds ...predicate.CourseSection) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(func(s *sql.Selector) {
+		step := newPrerequisiteSectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CourseSectionPrerequisite) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CourseSectionPrerequisite) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CourseSectionPrerequisite) predicate.CourseSectionPrerequisite {
+	return predicate.CourseSectionPrerequisite(sql.NotPredicates(p))
+}
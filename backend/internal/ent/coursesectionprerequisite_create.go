@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseSectionPrerequisiteCreate is the builder for creating a CourseSectionPrerequisite entity.
+type CourseSectionPrerequisiteCreate struct {
+	config
+	mutation *CourseSectionPrerequisiteMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetCreatedAt(t time.Time) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetCreatedAt(t)
+	return cspc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cspc *CourseSectionPrerequisiteCreate) SetNillableCreatedAt(t *time.Time) *CourseSectionPrerequisiteCreate {
+	if t != nil {
+		cspc.SetCreatedAt(*t)
+	}
+	return cspc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetUpdatedAt(t time.Time) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetUpdatedAt(t)
+	return cspc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cspc *CourseSectionPrerequisiteCreate) SetNillableUpdatedAt(t *time.Time) *CourseSectionPrerequisiteCreate {
+	if t != nil {
+		cspc.SetUpdatedAt(*t)
+	}
+	return cspc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetDeletedAt(t time.Time) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetDeletedAt(t)
+	return cspc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cspc *CourseSectionPrerequisiteCreate) SetNillableDeletedAt(t *time.Time) *CourseSectionPrerequisiteCreate {
+	if t != nil {
+		cspc.SetDeletedAt(*t)
+	}
+	return cspc
+}
+
+// SetSectionID sets the "section_id" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetSectionID(u uuid.UUID) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetSectionID(u)
+	return cspc
+}
+
+// SetPrerequisiteSectionID sets the "prerequisite_section_id" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetPrerequisiteSectionID(u uuid.UUID) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetPrerequisiteSectionID(u)
+	return cspc
+}
+
+// SetMinScorePercent sets the "min_score_percent" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetMinScorePercent(i int) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetMinScorePercent(i)
+	return cspc
+}
+
+// SetNillableMinScorePercent sets the "min_score_percent" field if the given value is not nil.
+func (cspc *CourseSectionPrerequisiteCreate) SetNillableMinScorePercent(i *int) *CourseSectionPrerequisiteCreate {
+	if i != nil {
+		cspc.SetMinScorePercent(*i)
+	}
+	return cspc
+}
+
+// SetID sets the "id" field.
+func (cspc *CourseSectionPrerequisiteCreate) SetID(u uuid.UUID) *CourseSectionPrerequisiteCreate {
+	cspc.mutation.SetID(u)
+	return cspc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cspc *CourseSectionPrerequisiteCreate) SetNillableID(u *uuid.UUID) *CourseSectionPrerequisiteCreate {
+	if u != nil {
+		cspc.SetID(*u)
+	}
+	return cspc
+}
+
+// SetSection sets the "section" edge to the CourseSection entity.
+func (cspc *CourseSectionPrerequisiteCreate) SetSection(c *CourseSection) *CourseSectionPrerequisiteCreate {
+	return cspc.SetSectionID(c.ID)
+}
+
+// SetPrerequisiteSection sets the "prerequisite_section" edge to the CourseSection entity.
+func (cspc *CourseSectionPrerequisiteCreate) SetPrerequisiteSection(c *CourseSection) *CourseSectionPrerequisiteCreate {
+	return cspc.SetPrerequisiteSectionID(c.ID)
+}
+
+// Mutation returns the CourseSectionPrerequisiteMutation object of the builder.
+func (cspc *CourseSectionPrerequisiteCreate) Mutation() *CourseSectionPrerequisiteMutation {
+	return cspc.mutation
+}
+
+// Save creates the CourseSectionPrerequisite in the database.
+func (cspc *CourseSectionPrerequisiteCreate) Save(ctx context.Context) (*CourseSectionPrerequisite, error) {
+	if err := cspc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cspc.sqlSave, cspc.mutation, cspc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cspc *CourseSectionPrerequisiteCreate) SaveX(ctx context.Context) *CourseSectionPrerequisite {
+	v, err := cspc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cspc *CourseSectionPrerequisiteCreate) Exec(ctx context.Context) error {
+	_, err := cspc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspc *CourseSectionPrerequisiteCreate) ExecX(ctx context.Context) {
+	if err := cspc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cspc *CourseSectionPrerequisiteCreate) defaults() error {
+	if _, ok := cspc.mutation.CreatedAt(); !ok {
+		if coursesectionprerequisite.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursesectionprerequisite.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursesectionprerequisite.DefaultCreatedAt()
+		cspc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cspc.mutation.UpdatedAt(); !ok {
+		if coursesectionprerequisite.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursesectionprerequisite.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursesectionprerequisite.DefaultUpdatedAt()
+		cspc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cspc.mutation.ID(); !ok {
+		if coursesectionprerequisite.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coursesectionprerequisite.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coursesectionprerequisite.DefaultID()
+		cspc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cspc *CourseSectionPrerequisiteCreate) check() error {
+	if _, ok := cspc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CourseSectionPrerequisite.created_at"`)}
+	}
+	if _, ok := cspc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CourseSectionPrerequisite.updated_at"`)}
+	}
+	if _, ok := cspc.mutation.SectionID(); !ok {
+		return &ValidationError{Name: "section_id", err: errors.New(`ent: missing required field "CourseSectionPrerequisite.section_id"`)}
+	}
+	if _, ok := cspc.mutation.PrerequisiteSectionID(); !ok {
+		return &ValidationError{Name: "prerequisite_section_id", err: errors.New(`ent: missing required field "CourseSectionPrerequisite.prerequisite_section_id"`)}
+	}
+	if v, ok := cspc.mutation.MinScorePercent(); ok {
+		if err := coursesectionprerequisite.MinScorePercentValidator(v); err != nil {
+			return &ValidationError{Name: "min_score_percent", err: fmt.Errorf(`ent: validator failed for field "CourseSectionPrerequisite.min_score_percent": %w`, err)}
+		}
+	}
+	if len(cspc.mutation.SectionIDs()) == 0 {
+		return &ValidationError{Name: "section", err: errors.New(`ent: missing required edge "CourseSectionPrerequisite.section"`)}
+	}
+	if len(cspc.mutation.PrerequisiteSectionIDs()) == 0 {
+		return &ValidationError{Name: "prerequisite_section", err: errors.New(`ent: missing required edge "CourseSectionPrerequisite.prerequisite_section"`)}
+	}
+	return nil
+}
+
+func (cspc *CourseSectionPrerequisiteCreate) sqlSave(ctx context.Context) (*CourseSectionPrerequisite, error) {
+	if err := cspc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cspc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cspc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cspc.mutation.id = &_node.ID
+	cspc.mutation.done = true
+	return _node, nil
+}
+
+func (cspc *CourseSectionPrerequisiteCreate) createSpec() (*CourseSectionPrerequisite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CourseSectionPrerequisite{config: cspc.config}
+		_spec = sqlgraph.NewCreateSpec(coursesectionprerequisite.Table, sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID))
+	)
+	if id, ok := cspc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cspc.mutation.CreatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cspc.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cspc.mutation.DeletedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cspc.mutation.MinScorePercent(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt, value)
+		_node.MinScorePercent = &value
+	}
+	if nodes := cspc.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.SectionTable,
+			Columns: []string{coursesectionprerequisite.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cspc.mutation.PrerequisiteSectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.PrerequisiteSectionTable,
+			Columns: []string{coursesectionprerequisite.PrerequisiteSectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PrerequisiteSectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CourseSectionPrerequisiteCreateBulk is the builder for creating many CourseSectionPrerequisite entities in bulk.
+type CourseSectionPrerequisiteCreateBulk struct {
+	config
+	err      error
+	builders []*CourseSectionPrerequisiteCreate
+}
+
+// Save creates the CourseSectionPrerequisite entities in the database.
+func (cspcb *CourseSectionPrerequisiteCreateBulk) Save(ctx context.Context) ([]*CourseSectionPrerequisite, error) {
+	if cspcb.err != nil {
+		return nil, cspcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cspcb.builders))
+	nodes := make([]*CourseSectionPrerequisite, len(cspcb.builders))
+	mutators := make([]Mutator, len(cspcb.builders))
+	for i := range cspcb.builders {
+		func(i int, root context.Context) {
+			builder := cspcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CourseSectionPrerequisiteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cspcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cspcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cspcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cspcb *CourseSectionPrerequisiteCreateBulk) SaveX(ctx context.Context) []*CourseSectionPrerequisite {
+	v, err := cspcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cspcb *CourseSectionPrerequisiteCreateBulk) Exec(ctx context.Context) error {
+	_, err := cspcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspcb *CourseSectionPrerequisiteCreateBulk) ExecX(ctx context.Context) {
+	if err := cspcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
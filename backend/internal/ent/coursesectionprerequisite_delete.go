@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CourseSectionPrerequisiteDelete is the builder for deleting a CourseSectionPrerequisite entity.
+type CourseSectionPrerequisiteDelete struct {
+	config
+	hooks    []Hook
+	mutation *CourseSectionPrerequisiteMutation
+}
+
+// Where appends a list predicates to the CourseSectionPrerequisiteDelete builder.
+func (cspd *CourseSectionPrerequisiteDelete) Where(ps ...predicate.CourseSectionPrerequisite) *CourseSectionPrerequisiteDelete {
+	cspd.mutation.Where(ps...)
+	return cspd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cspd *CourseSectionPrerequisiteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cspd.sqlExec, cspd.mutation, cspd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspd *CourseSectionPrerequisiteDelete) ExecX(ctx context.Context) int {
+	n, err := cspd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cspd *CourseSectionPrerequisiteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coursesectionprerequisite.Table, sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID))
+	if ps := cspd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cspd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cspd.mutation.done = true
+	return affected, err
+}
+
+// CourseSectionPrerequisiteDeleteOne is the builder for deleting a single CourseSectionPrerequisite entity.
+type CourseSectionPrerequisiteDeleteOne struct {
+	cspd *CourseSectionPrerequisiteDelete
+}
+
+// Where appends a list predicates to the CourseSectionPrerequisiteDelete builder.
+func (cspdo *CourseSectionPrerequisiteDeleteOne) Where(ps ...predicate.CourseSectionPrerequisite) *CourseSectionPrerequisiteDeleteOne {
+	cspdo.cspd.mutation.Where(ps...)
+	return cspdo
+}
+
+// Exec executes the deletion query.
+func (cspdo *CourseSectionPrerequisiteDeleteOne) Exec(ctx context.Context) error {
+	n, err := cspdo.cspd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coursesectionprerequisite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspdo *CourseSectionPrerequisiteDeleteOne) ExecX(ctx context.Context) {
+	if err := cspdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseSectionPrerequisiteQuery is the builder for querying CourseSectionPrerequisite entities.
+type CourseSectionPrerequisiteQuery struct {
+	config
+	ctx                     *QueryContext
+	order                   []coursesectionprerequisite.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.CourseSectionPrerequisite
+	withSection             *CourseSectionQuery
+	withPrerequisiteSection *CourseSectionQuery
+	modifiers               []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CourseSectionPrerequisiteQuery builder.
+func (cspq *CourseSectionPrerequisiteQuery) Where(ps ...predicate.CourseSectionPrerequisite) *CourseSectionPrerequisiteQuery {
+	cspq.predicates = append(cspq.predicates, ps...)
+	return cspq
+}
+
+// Limit the number of records to be returned by this query.
+func (cspq *CourseSectionPrerequisiteQuery) Limit(limit int) *CourseSectionPrerequisiteQuery {
+	cspq.ctx.Limit = &limit
+	return cspq
+}
+
+// Offset to start from.
+func (cspq *CourseSectionPrerequisiteQuery) Offset(offset int) *CourseSectionPrerequisiteQuery {
+	cspq.ctx.Offset = &offset
+	return cspq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cspq *CourseSectionPrerequisiteQuery) Unique(unique bool) *CourseSectionPrerequisiteQuery {
+	cspq.ctx.Unique = &unique
+	return cspq
+}
+
+// Order specifies how the records should be ordered.
+func (cspq *CourseSectionPrerequisiteQuery) Order(o ...coursesectionprerequisite.OrderOption) *CourseSectionPrerequisiteQuery {
+	cspq.order = append(cspq.order, o...)
+	return cspq
+}
+
+// QuerySection chains the current query on the "section" edge.
+func (cspq *CourseSectionPrerequisiteQuery) QuerySection() *CourseSectionQuery {
+	query := (&CourseSectionClient{config: cspq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cspq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cspq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID, selector),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesectionprerequisite.SectionTable, coursesectionprerequisite.SectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cspq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrerequisiteSection chains the current query on the "prerequisite_section" edge.
+func (cspq *CourseSectionPrerequisiteQuery) QueryPrerequisiteSection() *CourseSectionQuery {
+	query := (&CourseSectionClient{config: cspq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cspq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cspq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursesectionprerequisite.Table, coursesectionprerequisite.FieldID, selector),
+			sqlgraph.To(coursesection.Table, coursesection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursesectionprerequisite.PrerequisiteSectionTable, coursesectionprerequisite.PrerequisiteSectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cspq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CourseSectionPrerequisite entity from the query.
+// Returns a *NotFoundError when no CourseSectionPrerequisite was found.
+func (cspq *CourseSectionPrerequisiteQuery) First(ctx context.Context) (*CourseSectionPrerequisite, error) {
+	nodes, err := cspq.Limit(1).All(setContextOp(ctx, cspq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{coursesectionprerequisite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) FirstX(ctx context.Context) *CourseSectionPrerequisite {
+	node, err := cspq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CourseSectionPrerequisite ID from the query.
+// Returns a *NotFoundError when no CourseSectionPrerequisite ID was found.
+func (cspq *CourseSectionPrerequisiteQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cspq.Limit(1).IDs(setContextOp(ctx, cspq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{coursesectionprerequisite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cspq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CourseSectionPrerequisite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CourseSectionPrerequisite entity is found.
+// Returns a *NotFoundError when no CourseSectionPrerequisite entities are found.
+func (cspq *CourseSectionPrerequisiteQuery) Only(ctx context.Context) (*CourseSectionPrerequisite, error) {
+	nodes, err := cspq.Limit(2).All(setContextOp(ctx, cspq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{coursesectionprerequisite.Label}
+	default:
+		return nil, &NotSingularError{coursesectionprerequisite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) OnlyX(ctx context.Context) *CourseSectionPrerequisite {
+	node, err := cspq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CourseSectionPrerequisite ID in the query.
+// Returns a *NotSingularError when more than one CourseSectionPrerequisite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cspq *CourseSectionPrerequisiteQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cspq.Limit(2).IDs(setContextOp(ctx, cspq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{coursesectionprerequisite.Label}
+	default:
+		err = &NotSingularError{coursesectionprerequisite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cspq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CourseSectionPrerequisites.
+func (cspq *CourseSectionPrerequisiteQuery) All(ctx context.Context) ([]*CourseSectionPrerequisite, error) {
+	ctx = setContextOp(ctx, cspq.ctx, ent.OpQueryAll)
+	if err := cspq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CourseSectionPrerequisite, *CourseSectionPrerequisiteQuery]()
+	return withInterceptors[[]*CourseSectionPrerequisite](ctx, cspq, qr, cspq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) AllX(ctx context.Context) []*CourseSectionPrerequisite {
+	nodes, err := cspq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CourseSectionPrerequisite IDs.
+func (cspq *CourseSectionPrerequisiteQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cspq.ctx.Unique == nil && cspq.path != nil {
+		cspq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cspq.ctx, ent.OpQueryIDs)
+	if err = cspq.Select(coursesectionprerequisite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cspq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cspq *CourseSectionPrerequisiteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cspq.ctx, ent.OpQueryCount)
+	if err := cspq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cspq, querierCount[*CourseSectionPrerequisiteQuery](), cspq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) CountX(ctx context.Context) int {
+	count, err := cspq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cspq *CourseSectionPrerequisiteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cspq.ctx, ent.OpQueryExist)
+	switch _, err := cspq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cspq *CourseSectionPrerequisiteQuery) ExistX(ctx context.Context) bool {
+	exist, err := cspq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CourseSectionPrerequisiteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cspq *CourseSectionPrerequisiteQuery) Clone() *CourseSectionPrerequisiteQuery {
+	if cspq == nil {
+		return nil
+	}
+	return &CourseSectionPrerequisiteQuery{
+		config:                  cspq.config,
+		ctx:                     cspq.ctx.Clone(),
+		order:                   append([]coursesectionprerequisite.OrderOption{}, cspq.order...),
+		inters:                  append([]Interceptor{}, cspq.inters...),
+		predicates:              append([]predicate.CourseSectionPrerequisite{}, cspq.predicates...),
+		withSection:             cspq.withSection.Clone(),
+		withPrerequisiteSection: cspq.withPrerequisiteSection.Clone(),
+		// clone intermediate query.
+		sql:  cspq.sql.Clone(),
+		path: cspq.path,
+	}
+}
+
+// WithSection tells the query-builder to eager-load the nodes that are connected to
+// the "section" edge. The optional arguments are used to configure the query builder of the edge.
+func (cspq *CourseSectionPrerequisiteQuery) WithSection(opts ...func(*CourseSectionQuery)) *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionClient{config: cspq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cspq.withSection = query
+	return cspq
+}
+
+// WithPrerequisiteSection tells the query-builder to eager-load the nodes that are connected to
+// the "prerequisite_section" edge. The optional arguments are used to configure the query builder of the edge.
+func (cspq *CourseSectionPrerequisiteQuery) WithPrerequisiteSection(opts ...func(*CourseSectionQuery)) *CourseSectionPrerequisiteQuery {
+	query := (&CourseSectionClient{config: cspq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cspq.withPrerequisiteSection = query
+	return cspq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CourseSectionPrerequisite.Query().
+//		GroupBy(coursesectionprerequisite.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cspq *CourseSectionPrerequisiteQuery) GroupBy(field string, fields ...string) *CourseSectionPrerequisiteGroupBy {
+	cspq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CourseSectionPrerequisiteGroupBy{build: cspq}
+	grbuild.flds = &cspq.ctx.Fields
+	grbuild.label = coursesectionprerequisite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CourseSectionPrerequisite.Query().
+//		Select(coursesectionprerequisite.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (cspq *CourseSectionPrerequisiteQuery) Select(fields ...string) *CourseSectionPrerequisiteSelect {
+	cspq.ctx.Fields = append(cspq.ctx.Fields, fields...)
+	sbuild := &CourseSectionPrerequisiteSelect{CourseSectionPrerequisiteQuery: cspq}
+	sbuild.label = coursesectionprerequisite.Label
+	sbuild.flds, sbuild.scan = &cspq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CourseSectionPrerequisiteSelect configured with the given aggregations.
+func (cspq *CourseSectionPrerequisiteQuery) Aggregate(fns ...AggregateFunc) *CourseSectionPrerequisiteSelect {
+	return cspq.Select().Aggregate(fns...)
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cspq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cspq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cspq.ctx.Fields {
+		if !coursesectionprerequisite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cspq.path != nil {
+		prev, err := cspq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cspq.sql = prev
+	}
+	return nil
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CourseSectionPrerequisite, error) {
+	var (
+		nodes       = []*CourseSectionPrerequisite{}
+		_spec       = cspq.querySpec()
+		loadedTypes = [2]bool{
+			cspq.withSection != nil,
+			cspq.withPrerequisiteSection != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CourseSectionPrerequisite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CourseSectionPrerequisite{config: cspq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cspq.modifiers) > 0 {
+		_spec.Modifiers = cspq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cspq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cspq.withSection; query != nil {
+		if err := cspq.loadSection(ctx, query, nodes, nil,
+			func(n *CourseSectionPrerequisite, e *CourseSection) { n.Edges.Section = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cspq.withPrerequisiteSection; query != nil {
+		if err := cspq.loadPrerequisiteSection(ctx, query, nodes, nil,
+			func(n *CourseSectionPrerequisite, e *CourseSection) { n.Edges.PrerequisiteSection = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) loadSection(ctx context.Context, query *CourseSectionQuery, nodes []*CourseSectionPrerequisite, init func(*CourseSectionPrerequisite), assign func(*CourseSectionPrerequisite, *CourseSection)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CourseSectionPrerequisite)
+	for i := range nodes {
+		fk := nodes[i].SectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coursesection.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "section_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cspq *CourseSectionPrerequisiteQuery) loadPrerequisiteSection(ctx context.Context, query *CourseSectionQuery, nodes []*CourseSectionPrerequisite, init func(*CourseSectionPrerequisite), assign func(*CourseSectionPrerequisite, *CourseSection)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CourseSectionPrerequisite)
+	for i := range nodes {
+		fk := nodes[i].PrerequisiteSectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coursesection.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "prerequisite_section_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cspq.querySpec()
+	if len(cspq.modifiers) > 0 {
+		_spec.Modifiers = cspq.modifiers
+	}
+	_spec.Node.Columns = cspq.ctx.Fields
+	if len(cspq.ctx.Fields) > 0 {
+		_spec.Unique = cspq.ctx.Unique != nil && *cspq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cspq.driver, _spec)
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(coursesectionprerequisite.Table, coursesectionprerequisite.Columns, sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID))
+	_spec.From = cspq.sql
+	if unique := cspq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cspq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cspq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coursesectionprerequisite.FieldID)
+		for i := range fields {
+			if fields[i] != coursesectionprerequisite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cspq.withSection != nil {
+			_spec.Node.AddColumnOnce(coursesectionprerequisite.FieldSectionID)
+		}
+		if cspq.withPrerequisiteSection != nil {
+			_spec.Node.AddColumnOnce(coursesectionprerequisite.FieldPrerequisiteSectionID)
+		}
+	}
+	if ps := cspq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cspq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cspq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cspq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cspq *CourseSectionPrerequisiteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cspq.driver.Dialect())
+	t1 := builder.Table(coursesectionprerequisite.Table)
+	columns := cspq.ctx.Fields
+	if len(columns) == 0 {
+		columns = coursesectionprerequisite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cspq.sql != nil {
+		selector = cspq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cspq.ctx.Unique != nil && *cspq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cspq.modifiers {
+		m(selector)
+	}
+	for _, p := range cspq.predicates {
+		p(selector)
+	}
+	for _, p := range cspq.order {
+		p(selector)
+	}
+	if offset := cspq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cspq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cspq *CourseSectionPrerequisiteQuery) ForUpdate(opts ...sql.LockOption) *CourseSectionPrerequisiteQuery {
+	if cspq.driver.Dialect() == dialect.Postgres {
+		cspq.Unique(false)
+	}
+	cspq.modifiers = append(cspq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cspq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cspq *CourseSectionPrerequisiteQuery) ForShare(opts ...sql.LockOption) *CourseSectionPrerequisiteQuery {
+	if cspq.driver.Dialect() == dialect.Postgres {
+		cspq.Unique(false)
+	}
+	cspq.modifiers = append(cspq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cspq
+}
+
+// CourseSectionPrerequisiteGroupBy is the group-by builder for CourseSectionPrerequisite entities.
+type CourseSectionPrerequisiteGroupBy struct {
+	selector
+	build *CourseSectionPrerequisiteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cspgb *CourseSectionPrerequisiteGroupBy) Aggregate(fns ...AggregateFunc) *CourseSectionPrerequisiteGroupBy {
+	cspgb.fns = append(cspgb.fns, fns...)
+	return cspgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cspgb *CourseSectionPrerequisiteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cspgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cspgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseSectionPrerequisiteQuery, *CourseSectionPrerequisiteGroupBy](ctx, cspgb.build, cspgb, cspgb.build.inters, v)
+}
+
+func (cspgb *CourseSectionPrerequisiteGroupBy) sqlScan(ctx context.Context, root *CourseSectionPrerequisiteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cspgb.fns))
+	for _, fn := range cspgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cspgb.flds)+len(cspgb.fns))
+		for _, f := range *cspgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cspgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cspgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CourseSectionPrerequisiteSelect is the builder for selecting fields of CourseSectionPrerequisite entities.
+type CourseSectionPrerequisiteSelect struct {
+	*CourseSectionPrerequisiteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (csps *CourseSectionPrerequisiteSelect) Aggregate(fns ...AggregateFunc) *CourseSectionPrerequisiteSelect {
+	csps.fns = append(csps.fns, fns...)
+	return csps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csps *CourseSectionPrerequisiteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csps.ctx, ent.OpQuerySelect)
+	if err := csps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseSectionPrerequisiteQuery, *CourseSectionPrerequisiteSelect](ctx, csps.CourseSectionPrerequisiteQuery, csps, csps.inters, v)
+}
+
+func (csps *CourseSectionPrerequisiteSelect) sqlScan(ctx context.Context, root *CourseSectionPrerequisiteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(csps.fns))
+	for _, fn := range csps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*csps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
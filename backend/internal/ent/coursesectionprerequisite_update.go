@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CourseSectionPrerequisiteUpdate is the builder for updating CourseSectionPrerequisite entities.
+type CourseSectionPrerequisiteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CourseSectionPrerequisiteMutation
+}
+
+// Where appends a list predicates to the CourseSectionPrerequisiteUpdate builder.
+func (cspu *CourseSectionPrerequisiteUpdate) Where(ps ...predicate.CourseSectionPrerequisite) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.Where(ps...)
+	return cspu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetCreatedAt(t time.Time) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.SetCreatedAt(t)
+	return cspu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cspu *CourseSectionPrerequisiteUpdate) SetNillableCreatedAt(t *time.Time) *CourseSectionPrerequisiteUpdate {
+	if t != nil {
+		cspu.SetCreatedAt(*t)
+	}
+	return cspu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetUpdatedAt(t time.Time) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.SetUpdatedAt(t)
+	return cspu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetDeletedAt(t time.Time) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.SetDeletedAt(t)
+	return cspu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cspu *CourseSectionPrerequisiteUpdate) SetNillableDeletedAt(t *time.Time) *CourseSectionPrerequisiteUpdate {
+	if t != nil {
+		cspu.SetDeletedAt(*t)
+	}
+	return cspu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cspu *CourseSectionPrerequisiteUpdate) ClearDeletedAt() *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.ClearDeletedAt()
+	return cspu
+}
+
+// SetSectionID sets the "section_id" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetSectionID(u uuid.UUID) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.SetSectionID(u)
+	return cspu
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (cspu *CourseSectionPrerequisiteUpdate) SetNillableSectionID(u *uuid.UUID) *CourseSectionPrerequisiteUpdate {
+	if u != nil {
+		cspu.SetSectionID(*u)
+	}
+	return cspu
+}
+
+// SetPrerequisiteSectionID sets the "prerequisite_section_id" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetPrerequisiteSectionID(u uuid.UUID) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.SetPrerequisiteSectionID(u)
+	return cspu
+}
+
+// SetNillablePrerequisiteSectionID sets the "prerequisite_section_id" field if the given value is not nil.
+func (cspu *CourseSectionPrerequisiteUpdate) SetNillablePrerequisiteSectionID(u *uuid.UUID) *CourseSectionPrerequisiteUpdate {
+	if u != nil {
+		cspu.SetPrerequisiteSectionID(*u)
+	}
+	return cspu
+}
+
+// SetMinScorePercent sets the "min_score_percent" field.
+func (cspu *CourseSectionPrerequisiteUpdate) SetMinScorePercent(i int) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.ResetMinScorePercent()
+	cspu.mutation.SetMinScorePercent(i)
+	return cspu
+}
+
+// SetNillableMinScorePercent sets the "min_score_percent" field if the given value is not nil.
+func (cspu *CourseSectionPrerequisiteUpdate) SetNillableMinScorePercent(i *int) *CourseSectionPrerequisiteUpdate {
+	if i != nil {
+		cspu.SetMinScorePercent(*i)
+	}
+	return cspu
+}
+
+// AddMinScorePercent adds i to the "min_score_percent" field.
+func (cspu *CourseSectionPrerequisiteUpdate) AddMinScorePercent(i int) *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.AddMinScorePercent(i)
+	return cspu
+}
+
+// ClearMinScorePercent clears the value of the "min_score_percent" field.
+func (cspu *CourseSectionPrerequisiteUpdate) ClearMinScorePercent() *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.ClearMinScorePercent()
+	return cspu
+}
+
+// SetSection sets the "section" edge to the CourseSection entity.
+func (cspu *CourseSectionPrerequisiteUpdate) SetSection(c *CourseSection) *CourseSectionPrerequisiteUpdate {
+	return cspu.SetSectionID(c.ID)
+}
+
+// SetPrerequisiteSection sets the "prerequisite_section" edge to the CourseSection entity.
+func (cspu *CourseSectionPrerequisiteUpdate) SetPrerequisiteSection(c *CourseSection) *CourseSectionPrerequisiteUpdate {
+	return cspu.SetPrerequisiteSectionID(c.ID)
+}
+
+// Mutation returns the CourseSectionPrerequisiteMutation object of the builder.
+func (cspu *CourseSectionPrerequisiteUpdate) Mutation() *CourseSectionPrerequisiteMutation {
+	return cspu.mutation
+}
+
+// ClearSection clears the "section" edge to the CourseSection entity.
+func (cspu *CourseSectionPrerequisiteUpdate) ClearSection() *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.ClearSection()
+	return cspu
+}
+
+// ClearPrerequisiteSection clears the "prerequisite_section" edge to the CourseSection entity.
+func (cspu *CourseSectionPrerequisiteUpdate) ClearPrerequisiteSection() *CourseSectionPrerequisiteUpdate {
+	cspu.mutation.ClearPrerequisiteSection()
+	return cspu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cspu *CourseSectionPrerequisiteUpdate) Save(ctx context.Context) (int, error) {
+	if err := cspu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cspu.sqlSave, cspu.mutation, cspu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cspu *CourseSectionPrerequisiteUpdate) SaveX(ctx context.Context) int {
+	affected, err := cspu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cspu *CourseSectionPrerequisiteUpdate) Exec(ctx context.Context) error {
+	_, err := cspu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspu *CourseSectionPrerequisiteUpdate) ExecX(ctx context.Context) {
+	if err := cspu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cspu *CourseSectionPrerequisiteUpdate) defaults() error {
+	if _, ok := cspu.mutation.UpdatedAt(); !ok {
+		if coursesectionprerequisite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursesectionprerequisite.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursesectionprerequisite.UpdateDefaultUpdatedAt()
+		cspu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cspu *CourseSectionPrerequisiteUpdate) check() error {
+	if v, ok := cspu.mutation.MinScorePercent(); ok {
+		if err := coursesectionprerequisite.MinScorePercentValidator(v); err != nil {
+			return &ValidationError{Name: "min_score_percent", err: fmt.Errorf(`ent: validator failed for field "CourseSectionPrerequisite.min_score_percent": %w`, err)}
+		}
+	}
+	if cspu.mutation.SectionCleared() && len(cspu.mutation.SectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseSectionPrerequisite.section"`)
+	}
+	if cspu.mutation.PrerequisiteSectionCleared() && len(cspu.mutation.PrerequisiteSectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseSectionPrerequisite.prerequisite_section"`)
+	}
+	return nil
+}
+
+func (cspu *CourseSectionPrerequisiteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cspu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coursesectionprerequisite.Table, coursesectionprerequisite.Columns, sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID))
+	if ps := cspu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cspu.mutation.CreatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cspu.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cspu.mutation.DeletedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cspu.mutation.DeletedAtCleared() {
+		_spec.ClearField(coursesectionprerequisite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cspu.mutation.MinScorePercent(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt, value)
+	}
+	if value, ok := cspu.mutation.AddedMinScorePercent(); ok {
+		_spec.AddField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt, value)
+	}
+	if cspu.mutation.MinScorePercentCleared() {
+		_spec.ClearField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt)
+	}
+	if cspu.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.SectionTable,
+			Columns: []string{coursesectionprerequisite.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cspu.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.SectionTable,
+			Columns: []string{coursesectionprerequisite.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cspu.mutation.PrerequisiteSectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.PrerequisiteSectionTable,
+			Columns: []string{coursesectionprerequisite.PrerequisiteSectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cspu.mutation.PrerequisiteSectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.PrerequisiteSectionTable,
+			Columns: []string{coursesectionprerequisite.PrerequisiteSectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cspu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coursesectionprerequisite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cspu.mutation.done = true
+	return n, nil
+}
+
+// CourseSectionPrerequisiteUpdateOne is the builder for updating a single CourseSectionPrerequisite entity.
+type CourseSectionPrerequisiteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CourseSectionPrerequisiteMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetCreatedAt(t time.Time) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.SetCreatedAt(t)
+	return cspuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetNillableCreatedAt(t *time.Time) *CourseSectionPrerequisiteUpdateOne {
+	if t != nil {
+		cspuo.SetCreatedAt(*t)
+	}
+	return cspuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetUpdatedAt(t time.Time) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.SetUpdatedAt(t)
+	return cspuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetDeletedAt(t time.Time) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.SetDeletedAt(t)
+	return cspuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetNillableDeletedAt(t *time.Time) *CourseSectionPrerequisiteUpdateOne {
+	if t != nil {
+		cspuo.SetDeletedAt(*t)
+	}
+	return cspuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) ClearDeletedAt() *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.ClearDeletedAt()
+	return cspuo
+}
+
+// SetSectionID sets the "section_id" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetSectionID(u uuid.UUID) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.SetSectionID(u)
+	return cspuo
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetNillableSectionID(u *uuid.UUID) *CourseSectionPrerequisiteUpdateOne {
+	if u != nil {
+		cspuo.SetSectionID(*u)
+	}
+	return cspuo
+}
+
+// SetPrerequisiteSectionID sets the "prerequisite_section_id" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetPrerequisiteSectionID(u uuid.UUID) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.SetPrerequisiteSectionID(u)
+	return cspuo
+}
+
+// SetNillablePrerequisiteSectionID sets the "prerequisite_section_id" field if the given value is not nil.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetNillablePrerequisiteSectionID(u *uuid.UUID) *CourseSectionPrerequisiteUpdateOne {
+	if u != nil {
+		cspuo.SetPrerequisiteSectionID(*u)
+	}
+	return cspuo
+}
+
+// SetMinScorePercent sets the "min_score_percent" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetMinScorePercent(i int) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.ResetMinScorePercent()
+	cspuo.mutation.SetMinScorePercent(i)
+	return cspuo
+}
+
+// SetNillableMinScorePercent sets the "min_score_percent" field if the given value is not nil.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetNillableMinScorePercent(i *int) *CourseSectionPrerequisiteUpdateOne {
+	if i != nil {
+		cspuo.SetMinScorePercent(*i)
+	}
+	return cspuo
+}
+
+// AddMinScorePercent adds i to the "min_score_percent" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) AddMinScorePercent(i int) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.AddMinScorePercent(i)
+	return cspuo
+}
+
+// ClearMinScorePercent clears the value of the "min_score_percent" field.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) ClearMinScorePercent() *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.ClearMinScorePercent()
+	return cspuo
+}
+
+// SetSection sets the "section" edge to the CourseSection entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetSection(c *CourseSection) *CourseSectionPrerequisiteUpdateOne {
+	return cspuo.SetSectionID(c.ID)
+}
+
+// SetPrerequisiteSection sets the "prerequisite_section" edge to the CourseSection entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SetPrerequisiteSection(c *CourseSection) *CourseSectionPrerequisiteUpdateOne {
+	return cspuo.SetPrerequisiteSectionID(c.ID)
+}
+
+// Mutation returns the CourseSectionPrerequisiteMutation object of the builder.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) Mutation() *CourseSectionPrerequisiteMutation {
+	return cspuo.mutation
+}
+
+// ClearSection clears the "section" edge to the CourseSection entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) ClearSection() *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.ClearSection()
+	return cspuo
+}
+
+// ClearPrerequisiteSection clears the "prerequisite_section" edge to the CourseSection entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) ClearPrerequisiteSection() *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.ClearPrerequisiteSection()
+	return cspuo
+}
+
+// Where appends a list predicates to the CourseSectionPrerequisiteUpdate builder.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) Where(ps ...predicate.CourseSectionPrerequisite) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.mutation.Where(ps...)
+	return cspuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) Select(field string, fields ...string) *CourseSectionPrerequisiteUpdateOne {
+	cspuo.fields = append([]string{field}, fields...)
+	return cspuo
+}
+
+// Save executes the query and returns the updated CourseSectionPrerequisite entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) Save(ctx context.Context) (*CourseSectionPrerequisite, error) {
+	if err := cspuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cspuo.sqlSave, cspuo.mutation, cspuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) SaveX(ctx context.Context) *CourseSectionPrerequisite {
+	node, err := cspuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) Exec(ctx context.Context) error {
+	_, err := cspuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) ExecX(ctx context.Context) {
+	if err := cspuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) defaults() error {
+	if _, ok := cspuo.mutation.UpdatedAt(); !ok {
+		if coursesectionprerequisite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursesectionprerequisite.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursesectionprerequisite.UpdateDefaultUpdatedAt()
+		cspuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cspuo *CourseSectionPrerequisiteUpdateOne) check() error {
+	if v, ok := cspuo.mutation.MinScorePercent(); ok {
+		if err := coursesectionprerequisite.MinScorePercentValidator(v); err != nil {
+			return &ValidationError{Name: "min_score_percent", err: fmt.Errorf(`ent: validator failed for field "CourseSectionPrerequisite.min_score_percent": %w`, err)}
+		}
+	}
+	if cspuo.mutation.SectionCleared() && len(cspuo.mutation.SectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseSectionPrerequisite.section"`)
+	}
+	if cspuo.mutation.PrerequisiteSectionCleared() && len(cspuo.mutation.PrerequisiteSectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CourseSectionPrerequisite.prerequisite_section"`)
+	}
+	return nil
+}
+
+func (cspuo *CourseSectionPrerequisiteUpdateOne) sqlSave(ctx context.Context) (_node *CourseSectionPrerequisite, err error) {
+	if err := cspuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coursesectionprerequisite.Table, coursesectionprerequisite.Columns, sqlgraph.NewFieldSpec(coursesectionprerequisite.FieldID, field.TypeUUID))
+	id, ok := cspuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CourseSectionPrerequisite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cspuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coursesectionprerequisite.FieldID)
+		for _, f := range fields {
+			if !coursesectionprerequisite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coursesectionprerequisite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cspuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cspuo.mutation.CreatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cspuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cspuo.mutation.DeletedAt(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cspuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(coursesectionprerequisite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cspuo.mutation.MinScorePercent(); ok {
+		_spec.SetField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt, value)
+	}
+	if value, ok := cspuo.mutation.AddedMinScorePercent(); ok {
+		_spec.AddField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt, value)
+	}
+	if cspuo.mutation.MinScorePercentCleared() {
+		_spec.ClearField(coursesectionprerequisite.FieldMinScorePercent, field.TypeInt)
+	}
+	if cspuo.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.SectionTable,
+			Columns: []string{coursesectionprerequisite.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cspuo.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.SectionTable,
+			Columns: []string{coursesectionprerequisite.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cspuo.mutation.PrerequisiteSectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.PrerequisiteSectionTable,
+			Columns: []string{coursesectionprerequisite.PrerequisiteSectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cspuo.mutation.PrerequisiteSectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursesectionprerequisite.PrerequisiteSectionTable,
+			Columns: []string{coursesectionprerequisite.PrerequisiteSectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursesection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CourseSectionPrerequisite{config: cspuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cspuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coursesectionprerequisite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cspuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// backfills fill the columns added to existing tables, they run after the migration and only
+// update the rows not filled yet.
+var backfills = []struct {
+	name  string
+	query string
+}{
+	{
+		name: "access grant organizations",
+		query: `UPDATE access_grants g SET organization_id = COALESCE(
+			(SELECT organization_id FROM tests WHERE id = g.test_id),
+			(SELECT organization_id FROM courses WHERE id = g.course_id),
+			(SELECT organization_id FROM question_collections WHERE id = g.question_collection_id)
+		) WHERE g.organization_id IS NULL`,
+	},
+	{
+		name: "test creators",
+		query: `UPDATE tests t SET creator_id = COALESCE(
+			(SELECT creator_id FROM courses WHERE id = t.course_id),
+			(SELECT c.creator_id FROM course_sections s JOIN courses c ON c.id = s.course_id WHERE s.id = t.course_section_id),
+			(SELECT q.creator_id FROM test_question_collections tq JOIN question_collections q ON q.id = tq.question_collection_id
+				WHERE tq.test_id = t.id ORDER BY q.created_at LIMIT 1)
+		) WHERE t.creator_id IS NULL`,
+	},
+	{
+		name: "course statuses",
+		query: `UPDATE courses SET status = 'published', published_at = COALESCE(published_at, updated_at)
+		WHERE is_published AND status = 'draft'`,
+	},
+	{
+		name: "media organizations",
+		query: `UPDATE media m SET organization_id = u.organization_id FROM users u
+		WHERE m.uploader_id = u.id AND m.organization_id IS NULL AND u.organization_id IS NOT NULL`,
+	},
+}
+
+// Backfill runs the backfills of the existing rows.
+func Backfill(ctx context.Context) error {
+	db, err := OpenDB()
+	if err != nil {
+		return err
+	}
+
+	for _, backfill := range backfills {
+		if _, err := db.ExecContext(ctx, backfill.query); err != nil {
+			return fmt.Errorf("failed backfilling %s: %w", backfill.name, err)
+		}
+	}
+	return nil
+}
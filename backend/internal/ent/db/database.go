@@ -22,5 +22,10 @@ func InitDatabase() error {
 		log.Fatalf("failed creating schema resources: %v", err)
 		return err
 	}
+
+	if err := Backfill(context.Background()); err != nil {
+		log.Fatalf("failed backfilling existing rows: %v", err)
+		return err
+	}
 	return nil
 }
@@ -8,22 +8,36 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/permission"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
 	"template/internal/ent/role"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/todo"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"template/internal/ent/video"
 	"template/internal/ent/videoquestiontimestamp"
 
@@ -90,24 +104,38 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			course.Table:                 course.ValidColumn,
-			coursesection.Table:          coursesection.ValidColumn,
-			jwttoken.Table:               jwttoken.ValidColumn,
-			media.Table:                  media.ValidColumn,
-			permission.Table:             permission.ValidColumn,
-			question.Table:               question.ValidColumn,
-			questioncollection.Table:     questioncollection.ValidColumn,
-			questionoption.Table:         questionoption.ValidColumn,
-			role.Table:                   role.ValidColumn,
-			test.Table:                   test.ValidColumn,
-			testignorequestion.Table:     testignorequestion.ValidColumn,
-			testquestioncount.Table:      testquestioncount.ValidColumn,
-			testsession.Table:            testsession.ValidColumn,
-			testsessionanswer.Table:      testsessionanswer.ValidColumn,
-			todo.Table:                   todo.ValidColumn,
-			user.Table:                   user.ValidColumn,
-			video.Table:                  video.ValidColumn,
-			videoquestiontimestamp.Table: videoquestiontimestamp.ValidColumn,
+			accessgrant.Table:               accessgrant.ValidColumn,
+			course.Table:                    course.ValidColumn,
+			courseenrollment.Table:          courseenrollment.ValidColumn,
+			coursesection.Table:             coursesection.ValidColumn,
+			coursesectionprerequisite.Table: coursesectionprerequisite.ValidColumn,
+			group.Table:                     group.ValidColumn,
+			grouptestassignment.Table:       grouptestassignment.ValidColumn,
+			jwttoken.Table:                  jwttoken.ValidColumn,
+			media.Table:                     media.ValidColumn,
+			organization.Table:              organization.ValidColumn,
+			permission.Table:                permission.ValidColumn,
+			question.Table:                  question.ValidColumn,
+			questioncollection.Table:        questioncollection.ValidColumn,
+			questionoption.Table:            questionoption.ValidColumn,
+			questionversion.Table:           questionversion.ValidColumn,
+			questionversionoption.Table:     questionversionoption.ValidColumn,
+			role.Table:                      role.ValidColumn,
+			test.Table:                      test.ValidColumn,
+			testignorequestion.Table:        testignorequestion.ValidColumn,
+			testquestioncount.Table:         testquestioncount.ValidColumn,
+			testsession.Table:               testsession.ValidColumn,
+			testsessionanswer.Table:         testsessionanswer.ValidColumn,
+			testsessionbreak.Table:          testsessionbreak.ValidColumn,
+			testsessionintegrityevent.Table: testsessionintegrityevent.ValidColumn,
+			testsessionregrade.Table:        testsessionregrade.ValidColumn,
+			testsessiontimeextension.Table:  testsessiontimeextension.ValidColumn,
+			todo.Table:                      todo.ValidColumn,
+			user.Table:                      user.ValidColumn,
+			useraccommodation.Table:         useraccommodation.ValidColumn,
+			userinvite.Table:                userinvite.ValidColumn,
+			video.Table:                     video.ValidColumn,
+			videoquestiontimestamp.Table:    videoquestiontimestamp.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,1147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/organization"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupUpdate is the builder for updating Group entities.
+type GroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupMutation
+}
+
+// Where appends a list predicates to the GroupUpdate builder.
+func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gu *GroupUpdate) SetCreatedAt(t time.Time) *GroupUpdate {
+	gu.mutation.SetCreatedAt(t)
+	return gu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableCreatedAt(t *time.Time) *GroupUpdate {
+	if t != nil {
+		gu.SetCreatedAt(*t)
+	}
+	return gu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gu *GroupUpdate) SetUpdatedAt(t time.Time) *GroupUpdate {
+	gu.mutation.SetUpdatedAt(t)
+	return gu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (gu *GroupUpdate) SetDeletedAt(t time.Time) *GroupUpdate {
+	gu.mutation.SetDeletedAt(t)
+	return gu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDeletedAt(t *time.Time) *GroupUpdate {
+	if t != nil {
+		gu.SetDeletedAt(*t)
+	}
+	return gu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (gu *GroupUpdate) ClearDeletedAt() *GroupUpdate {
+	gu.mutation.ClearDeletedAt()
+	return gu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (gu *GroupUpdate) SetOrganizationID(u uuid.UUID) *GroupUpdate {
+	gu.mutation.SetOrganizationID(u)
+	return gu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableOrganizationID(u *uuid.UUID) *GroupUpdate {
+	if u != nil {
+		gu.SetOrganizationID(*u)
+	}
+	return gu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (gu *GroupUpdate) ClearOrganizationID() *GroupUpdate {
+	gu.mutation.ClearOrganizationID()
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GroupUpdate) SetName(s string) *GroupUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableName(s *string) *GroupUpdate {
+	if s != nil {
+		gu.SetName(*s)
+	}
+	return gu
+}
+
+// SetDescription sets the "description" field.
+func (gu *GroupUpdate) SetDescription(s string) *GroupUpdate {
+	gu.mutation.SetDescription(s)
+	return gu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDescription(s *string) *GroupUpdate {
+	if s != nil {
+		gu.SetDescription(*s)
+	}
+	return gu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (gu *GroupUpdate) ClearDescription() *GroupUpdate {
+	gu.mutation.ClearDescription()
+	return gu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (gu *GroupUpdate) SetCreatorID(u uuid.UUID) *GroupUpdate {
+	gu.mutation.SetCreatorID(u)
+	return gu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableCreatorID(u *uuid.UUID) *GroupUpdate {
+	if u != nil {
+		gu.SetCreatorID(*u)
+	}
+	return gu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (gu *GroupUpdate) SetOrganization(o *Organization) *GroupUpdate {
+	return gu.SetOrganizationID(o.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (gu *GroupUpdate) SetCreator(u *User) *GroupUpdate {
+	return gu.SetCreatorID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (gu *GroupUpdate) AddMemberIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (gu *GroupUpdate) AddMembers(u ...*User) *GroupUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (gu *GroupUpdate) AddAccessGrantIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddAccessGrantIDs(ids...)
+	return gu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (gu *GroupUpdate) AddAccessGrants(a ...*AccessGrant) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.AddAccessGrantIDs(ids...)
+}
+
+// AddTestAssignmentIDs adds the "test_assignments" edge to the GroupTestAssignment entity by IDs.
+func (gu *GroupUpdate) AddTestAssignmentIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddTestAssignmentIDs(ids...)
+	return gu
+}
+
+// AddTestAssignments adds the "test_assignments" edges to the GroupTestAssignment entity.
+func (gu *GroupUpdate) AddTestAssignments(g ...*GroupTestAssignment) *GroupUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddTestAssignmentIDs(ids...)
+}
+
+// Mutation returns the GroupMutation object of the builder.
+func (gu *GroupUpdate) Mutation() *GroupMutation {
+	return gu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (gu *GroupUpdate) ClearOrganization() *GroupUpdate {
+	gu.mutation.ClearOrganization()
+	return gu
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (gu *GroupUpdate) ClearCreator() *GroupUpdate {
+	gu.mutation.ClearCreator()
+	return gu
+}
+
+// ClearMembers clears all "members" edges to the User entity.
+func (gu *GroupUpdate) ClearMembers() *GroupUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (gu *GroupUpdate) RemoveMemberIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (gu *GroupUpdate) RemoveMembers(u ...*User) *GroupUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (gu *GroupUpdate) ClearAccessGrants() *GroupUpdate {
+	gu.mutation.ClearAccessGrants()
+	return gu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (gu *GroupUpdate) RemoveAccessGrantIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveAccessGrantIDs(ids...)
+	return gu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (gu *GroupUpdate) RemoveAccessGrants(a ...*AccessGrant) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearTestAssignments clears all "test_assignments" edges to the GroupTestAssignment entity.
+func (gu *GroupUpdate) ClearTestAssignments() *GroupUpdate {
+	gu.mutation.ClearTestAssignments()
+	return gu
+}
+
+// RemoveTestAssignmentIDs removes the "test_assignments" edge to GroupTestAssignment entities by IDs.
+func (gu *GroupUpdate) RemoveTestAssignmentIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveTestAssignmentIDs(ids...)
+	return gu
+}
+
+// RemoveTestAssignments removes "test_assignments" edges to GroupTestAssignment entities.
+func (gu *GroupUpdate) RemoveTestAssignments(g ...*GroupTestAssignment) *GroupUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveTestAssignmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
+	if err := gu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GroupUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GroupUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gu *GroupUpdate) defaults() error {
+	if _, ok := gu.mutation.UpdatedAt(); !ok {
+		if group.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized group.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := group.UpdateDefaultUpdatedAt()
+		gu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupUpdate) check() error {
+	if v, ok := gu.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+		}
+	}
+	if gu.mutation.CreatorCleared() && len(gu.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Group.creator"`)
+	}
+	return nil
+}
+
+func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.CreatedAt(); ok {
+		_spec.SetField(group.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := gu.mutation.UpdatedAt(); ok {
+		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := gu.mutation.DeletedAt(); ok {
+		_spec.SetField(group.FieldDeletedAt, field.TypeTime, value)
+	}
+	if gu.mutation.DeletedAtCleared() {
+		_spec.ClearField(group.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.SetField(group.FieldName, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Description(); ok {
+		_spec.SetField(group.FieldDescription, field.TypeString, value)
+	}
+	if gu.mutation.DescriptionCleared() {
+		_spec.ClearField(group.FieldDescription, field.TypeString)
+	}
+	if gu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.CreatorTable,
+			Columns: []string{group.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.CreatorTable,
+			Columns: []string{group.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !gu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.TestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedTestAssignmentsIDs(); len(nodes) > 0 && !gu.mutation.TestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.TestAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GroupUpdateOne is the builder for updating a single Group entity.
+type GroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (guo *GroupUpdateOne) SetCreatedAt(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetCreatedAt(t)
+	return guo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableCreatedAt(t *time.Time) *GroupUpdateOne {
+	if t != nil {
+		guo.SetCreatedAt(*t)
+	}
+	return guo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (guo *GroupUpdateOne) SetUpdatedAt(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (guo *GroupUpdateOne) SetDeletedAt(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetDeletedAt(t)
+	return guo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDeletedAt(t *time.Time) *GroupUpdateOne {
+	if t != nil {
+		guo.SetDeletedAt(*t)
+	}
+	return guo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (guo *GroupUpdateOne) ClearDeletedAt() *GroupUpdateOne {
+	guo.mutation.ClearDeletedAt()
+	return guo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (guo *GroupUpdateOne) SetOrganizationID(u uuid.UUID) *GroupUpdateOne {
+	guo.mutation.SetOrganizationID(u)
+	return guo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *GroupUpdateOne {
+	if u != nil {
+		guo.SetOrganizationID(*u)
+	}
+	return guo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (guo *GroupUpdateOne) ClearOrganizationID() *GroupUpdateOne {
+	guo.mutation.ClearOrganizationID()
+	return guo
+}
+
+// SetName sets the "name" field.
+func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableName(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetDescription sets the "description" field.
+func (guo *GroupUpdateOne) SetDescription(s string) *GroupUpdateOne {
+	guo.mutation.SetDescription(s)
+	return guo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDescription(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetDescription(*s)
+	}
+	return guo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (guo *GroupUpdateOne) ClearDescription() *GroupUpdateOne {
+	guo.mutation.ClearDescription()
+	return guo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (guo *GroupUpdateOne) SetCreatorID(u uuid.UUID) *GroupUpdateOne {
+	guo.mutation.SetCreatorID(u)
+	return guo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableCreatorID(u *uuid.UUID) *GroupUpdateOne {
+	if u != nil {
+		guo.SetCreatorID(*u)
+	}
+	return guo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (guo *GroupUpdateOne) SetOrganization(o *Organization) *GroupUpdateOne {
+	return guo.SetOrganizationID(o.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (guo *GroupUpdateOne) SetCreator(u *User) *GroupUpdateOne {
+	return guo.SetCreatorID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (guo *GroupUpdateOne) AddMemberIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (guo *GroupUpdateOne) AddMembers(u ...*User) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return guo.AddMemberIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (guo *GroupUpdateOne) AddAccessGrantIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddAccessGrantIDs(ids...)
+	return guo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (guo *GroupUpdateOne) AddAccessGrants(a ...*AccessGrant) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.AddAccessGrantIDs(ids...)
+}
+
+// AddTestAssignmentIDs adds the "test_assignments" edge to the GroupTestAssignment entity by IDs.
+func (guo *GroupUpdateOne) AddTestAssignmentIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddTestAssignmentIDs(ids...)
+	return guo
+}
+
+// AddTestAssignments adds the "test_assignments" edges to the GroupTestAssignment entity.
+func (guo *GroupUpdateOne) AddTestAssignments(g ...*GroupTestAssignment) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddTestAssignmentIDs(ids...)
+}
+
+// Mutation returns the GroupMutation object of the builder.
+func (guo *GroupUpdateOne) Mutation() *GroupMutation {
+	return guo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (guo *GroupUpdateOne) ClearOrganization() *GroupUpdateOne {
+	guo.mutation.ClearOrganization()
+	return guo
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (guo *GroupUpdateOne) ClearCreator() *GroupUpdateOne {
+	guo.mutation.ClearCreator()
+	return guo
+}
+
+// ClearMembers clears all "members" edges to the User entity.
+func (guo *GroupUpdateOne) ClearMembers() *GroupUpdateOne {
+	guo.mutation.ClearMembers()
+	return guo
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (guo *GroupUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
+	return guo
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (guo *GroupUpdateOne) RemoveMembers(u ...*User) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (guo *GroupUpdateOne) ClearAccessGrants() *GroupUpdateOne {
+	guo.mutation.ClearAccessGrants()
+	return guo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (guo *GroupUpdateOne) RemoveAccessGrantIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveAccessGrantIDs(ids...)
+	return guo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (guo *GroupUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearTestAssignments clears all "test_assignments" edges to the GroupTestAssignment entity.
+func (guo *GroupUpdateOne) ClearTestAssignments() *GroupUpdateOne {
+	guo.mutation.ClearTestAssignments()
+	return guo
+}
+
+// RemoveTestAssignmentIDs removes the "test_assignments" edge to GroupTestAssignment entities by IDs.
+func (guo *GroupUpdateOne) RemoveTestAssignmentIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveTestAssignmentIDs(ids...)
+	return guo
+}
+
+// RemoveTestAssignments removes "test_assignments" edges to GroupTestAssignment entities.
+func (guo *GroupUpdateOne) RemoveTestAssignments(g ...*GroupTestAssignment) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveTestAssignmentIDs(ids...)
+}
+
+// Where appends a list predicates to the GroupUpdate builder.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.mutation.Where(ps...)
+	return guo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GroupUpdateOne) Select(field string, fields ...string) *GroupUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Group entity.
+func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+	if err := guo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, guo.sqlSave, guo.mutation, guo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (guo *GroupUpdateOne) defaults() error {
+	if _, ok := guo.mutation.UpdatedAt(); !ok {
+		if group.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized group.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := group.UpdateDefaultUpdatedAt()
+		guo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupUpdateOne) check() error {
+	if v, ok := guo.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+		}
+	}
+	if guo.mutation.CreatorCleared() && len(guo.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Group.creator"`)
+	}
+	return nil
+}
+
+func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error) {
+	if err := guo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, group.FieldID)
+		for _, f := range fields {
+			if !group.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != group.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.CreatedAt(); ok {
+		_spec.SetField(group.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := guo.mutation.UpdatedAt(); ok {
+		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := guo.mutation.DeletedAt(); ok {
+		_spec.SetField(group.FieldDeletedAt, field.TypeTime, value)
+	}
+	if guo.mutation.DeletedAtCleared() {
+		_spec.ClearField(group.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.SetField(group.FieldName, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Description(); ok {
+		_spec.SetField(group.FieldDescription, field.TypeString, value)
+	}
+	if guo.mutation.DescriptionCleared() {
+		_spec.ClearField(group.FieldDescription, field.TypeString)
+	}
+	if guo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.CreatorTable,
+			Columns: []string{group.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.CreatorTable,
+			Columns: []string{group.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !guo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AccessGrantsTable,
+			Columns: []string{group.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.TestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedTestAssignmentsIDs(); len(nodes) > 0 && !guo.mutation.TestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.TestAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.TestAssignmentsTable,
+			Columns: []string{group.TestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Group{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	guo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/test"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignment is the model entity for the GroupTestAssignment schema.
+type GroupTestAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// TestID holds the value of the "test_id" field.
+	TestID uuid.UUID `json:"test_id,omitempty"`
+	// AssignedByID holds the value of the "assigned_by_id" field.
+	AssignedByID *uuid.UUID `json:"assigned_by_id,omitempty"`
+	// Creates a test session for the members added to the group after the assignment
+	IncludeLaterMembers bool `json:"include_later_members,omitempty"`
+	// Expiry time of the created test sessions, the members added later get the same time span from when they join
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// Open time of the created test sessions, stored in UTC
+	OpenAt *time.Time `json:"open_at,omitempty"`
+	// Close time of the created test sessions, stored in UTC
+	CloseAt *time.Time `json:"close_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupTestAssignmentQuery when eager-loading is set.
+	Edges        GroupTestAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupTestAssignmentEdges holds the relations/edges for other nodes in the graph.
+type GroupTestAssignmentEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Test holds the value of the test edge.
+	Test *Test `json:"test,omitempty"`
+	// AssignedBy holds the value of the assigned_by edge.
+	AssignedBy *User `json:"assigned_by,omitempty"`
+	// TestSessions holds the value of the test_sessions edge.
+	TestSessions []*TestSession `json:"test_sessions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupTestAssignmentEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// TestOrErr returns the Test value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupTestAssignmentEdges) TestOrErr() (*Test, error) {
+	if e.Test != nil {
+		return e.Test, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: test.Label}
+	}
+	return nil, &NotLoadedError{edge: "test"}
+}
+
+// AssignedByOrErr returns the AssignedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupTestAssignmentEdges) AssignedByOrErr() (*User, error) {
+	if e.AssignedBy != nil {
+		return e.AssignedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "assigned_by"}
+}
+
+// TestSessionsOrErr returns the TestSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupTestAssignmentEdges) TestSessionsOrErr() ([]*TestSession, error) {
+	if e.loadedTypes[3] {
+		return e.TestSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "test_sessions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupTestAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case grouptestassignment.FieldAssignedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case grouptestassignment.FieldIncludeLaterMembers:
+			values[i] = new(sql.NullBool)
+		case grouptestassignment.FieldCreatedAt, grouptestassignment.FieldUpdatedAt, grouptestassignment.FieldDeletedAt, grouptestassignment.FieldExpiredAt, grouptestassignment.FieldOpenAt, grouptestassignment.FieldCloseAt:
+			values[i] = new(sql.NullTime)
+		case grouptestassignment.FieldID, grouptestassignment.FieldGroupID, grouptestassignment.FieldTestID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupTestAssignment fields.
+func (gta *GroupTestAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case grouptestassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gta.ID = *value
+			}
+		case grouptestassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gta.CreatedAt = value.Time
+			}
+		case grouptestassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gta.UpdatedAt = value.Time
+			}
+		case grouptestassignment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				gta.DeletedAt = new(time.Time)
+				*gta.DeletedAt = value.Time
+			}
+		case grouptestassignment.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				gta.GroupID = *value
+			}
+		case grouptestassignment.FieldTestID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field test_id", values[i])
+			} else if value != nil {
+				gta.TestID = *value
+			}
+		case grouptestassignment.FieldAssignedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by_id", values[i])
+			} else if value.Valid {
+				gta.AssignedByID = new(uuid.UUID)
+				*gta.AssignedByID = *value.S.(*uuid.UUID)
+			}
+		case grouptestassignment.FieldIncludeLaterMembers:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_later_members", values[i])
+			} else if value.Valid {
+				gta.IncludeLaterMembers = value.Bool
+			}
+		case grouptestassignment.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				gta.ExpiredAt = new(time.Time)
+				*gta.ExpiredAt = value.Time
+			}
+		case grouptestassignment.FieldOpenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field open_at", values[i])
+			} else if value.Valid {
+				gta.OpenAt = new(time.Time)
+				*gta.OpenAt = value.Time
+			}
+		case grouptestassignment.FieldCloseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field close_at", values[i])
+			} else if value.Valid {
+				gta.CloseAt = new(time.Time)
+				*gta.CloseAt = value.Time
+			}
+		default:
+			gta.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupTestAssignment.
+// This includes values selected through modifiers, order, etc.
+func (gta *GroupTestAssignment) Value(name string) (ent.Value, error) {
+	return gta.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the GroupTestAssignment entity.
+func (gta *GroupTestAssignment) QueryGroup() *GroupQuery {
+	return NewGroupTestAssignmentClient(gta.config).QueryGroup(gta)
+}
+
+// QueryTest queries the "test" edge of the GroupTestAssignment entity.
+func (gta *GroupTestAssignment) QueryTest() *TestQuery {
+	return NewGroupTestAssignmentClient(gta.config).QueryTest(gta)
+}
+
+// QueryAssignedBy queries the "assigned_by" edge of the GroupTestAssignment entity.
+func (gta *GroupTestAssignment) QueryAssignedBy() *UserQuery {
+	return NewGroupTestAssignmentClient(gta.config).QueryAssignedBy(gta)
+}
+
+// QueryTestSessions queries the "test_sessions" edge of the GroupTestAssignment entity.
+func (gta *GroupTestAssignment) QueryTestSessions() *TestSessionQuery {
+	return NewGroupTestAssignmentClient(gta.config).QueryTestSessions(gta)
+}
+
+// Update returns a builder for updating this GroupTestAssignment.
+// Note that you need to call GroupTestAssignment.Unwrap() before calling this method if this GroupTestAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gta *GroupTestAssignment) Update() *GroupTestAssignmentUpdateOne {
+	return NewGroupTestAssignmentClient(gta.config).UpdateOne(gta)
+}
+
+// Unwrap unwraps the GroupTestAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gta *GroupTestAssignment) Unwrap() *GroupTestAssignment {
+	_tx, ok := gta.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupTestAssignment is not a transactional entity")
+	}
+	gta.config.driver = _tx.drv
+	return gta
+}
+
+// String implements the fmt.Stringer.
+func (gta *GroupTestAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupTestAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gta.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(gta.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gta.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := gta.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gta.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("test_id=")
+	builder.WriteString(fmt.Sprintf("%v", gta.TestID))
+	builder.WriteString(", ")
+	if v := gta.AssignedByID; v != nil {
+		builder.WriteString("assigned_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("include_later_members=")
+	builder.WriteString(fmt.Sprintf("%v", gta.IncludeLaterMembers))
+	builder.WriteString(", ")
+	if v := gta.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := gta.OpenAt; v != nil {
+		builder.WriteString("open_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := gta.CloseAt; v != nil {
+		builder.WriteString("close_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupTestAssignments is a parsable slice of GroupTestAssignment.
+type GroupTestAssignments []*GroupTestAssignment
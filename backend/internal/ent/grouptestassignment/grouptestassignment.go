@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grouptestassignment
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the grouptestassignment type in the database.
+	Label = "group_test_assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldTestID holds the string denoting the test_id field in the database.
+	FieldTestID = "test_id"
+	// FieldAssignedByID holds the string denoting the assigned_by_id field in the database.
+	FieldAssignedByID = "assigned_by_id"
+	// FieldIncludeLaterMembers holds the string denoting the include_later_members field in the database.
+	FieldIncludeLaterMembers = "include_later_members"
+	// FieldExpiredAt holds the string denoting the expired_at field in the database.
+	FieldExpiredAt = "expired_at"
+	// FieldOpenAt holds the string denoting the open_at field in the database.
+	FieldOpenAt = "open_at"
+	// FieldCloseAt holds the string denoting the close_at field in the database.
+	FieldCloseAt = "close_at"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
+	// EdgeTest holds the string denoting the test edge name in mutations.
+	EdgeTest = "test"
+	// EdgeAssignedBy holds the string denoting the assigned_by edge name in mutations.
+	EdgeAssignedBy = "assigned_by"
+	// EdgeTestSessions holds the string denoting the test_sessions edge name in mutations.
+	EdgeTestSessions = "test_sessions"
+	// Table holds the table name of the grouptestassignment in the database.
+	Table = "group_test_assignments"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "group_test_assignments"
+	// GroupInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupInverseTable = "groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_id"
+	// TestTable is the table that holds the test relation/edge.
+	TestTable = "group_test_assignments"
+	// TestInverseTable is the table name for the Test entity.
+	// It exists in this package in order to avoid circular dependency with the "test" package.
+	TestInverseTable = "tests"
+	// TestColumn is the table column denoting the test relation/edge.
+	TestColumn = "test_id"
+	// AssignedByTable is the table that holds the assigned_by relation/edge.
+	AssignedByTable = "group_test_assignments"
+	// AssignedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AssignedByInverseTable = "users"
+	// AssignedByColumn is the table column denoting the assigned_by relation/edge.
+	AssignedByColumn = "assigned_by_id"
+	// TestSessionsTable is the table that holds the test_sessions relation/edge.
+	TestSessionsTable = "test_sessions"
+	// TestSessionsInverseTable is the table name for the TestSession entity.
+	// It exists in this package in order to avoid circular dependency with the "testsession" package.
+	TestSessionsInverseTable = "test_sessions"
+	// TestSessionsColumn is the table column denoting the test_sessions relation/edge.
+	TestSessionsColumn = "group_assignment_id"
+)
+
+// Columns holds all SQL columns for grouptestassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldGroupID,
+	FieldTestID,
+	FieldAssignedByID,
+	FieldIncludeLaterMembers,
+	FieldExpiredAt,
+	FieldOpenAt,
+	FieldCloseAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultIncludeLaterMembers holds the default value on creation for the "include_later_members" field.
+	DefaultIncludeLaterMembers bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GroupTestAssignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByTestID orders the results by the test_id field.
+func ByTestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTestID, opts...).ToFunc()
+}
+
+// ByAssignedByID orders the results by the assigned_by_id field.
+func ByAssignedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedByID, opts...).ToFunc()
+}
+
+// ByIncludeLaterMembers orders the results by the include_later_members field.
+func ByIncludeLaterMembers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeLaterMembers, opts...).ToFunc()
+}
+
+// ByExpiredAt orders the results by the expired_at field.
+func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
+}
+
+// ByOpenAt orders the results by the open_at field.
+func ByOpenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenAt, opts...).ToFunc()
+}
+
+// ByCloseAt orders the results by the close_at field.
+func ByCloseAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseAt, opts...).ToFunc()
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTestField orders the results by test field.
+func ByTestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAssignedByField orders the results by assigned_by field.
+func ByAssignedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTestSessionsCount orders the results by test_sessions count.
+func ByTestSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestSessionsStep(), opts...)
+	}
+}
+
+// ByTestSessions orders the results by test_sessions terms.
+func ByTestSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+	)
+}
+func newTestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TestTable, TestColumn),
+	)
+}
+func newAssignedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AssignedByTable, AssignedByColumn),
+	)
+}
+func newTestSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestSessionsTable, TestSessionsColumn),
+	)
+}
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grouptestassignment
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldGroupID, v))
+}
+
+// TestID applies equality check predicate on the "test_id" field. It's identical to TestIDEQ.
+func TestID(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldTestID, v))
+}
+
+// AssignedByID applies equality check predicate on the "assigned_by_id" field. It's identical to AssignedByIDEQ.
+func AssignedByID(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldAssignedByID, v))
+}
+
+// IncludeLaterMembers applies equality check predicate on the "include_later_members" field. It's identical to IncludeLaterMembersEQ.
+func IncludeLaterMembers(v bool) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldIncludeLaterMembers, v))
+}
+
+// ExpiredAt applies equality check predicate on the "expired_at" field. It's identical to ExpiredAtEQ.
+func ExpiredAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// OpenAt applies equality check predicate on the "open_at" field. It's identical to OpenAtEQ.
+func OpenAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// CloseAt applies equality check predicate on the "close_at" field. It's identical to CloseAtEQ.
+func CloseAt(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldCloseAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// TestIDEQ applies the EQ predicate on the "test_id" field.
+func TestIDEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldTestID, v))
+}
+
+// TestIDNEQ applies the NEQ predicate on the "test_id" field.
+func TestIDNEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldTestID, v))
+}
+
+// TestIDIn applies the In predicate on the "test_id" field.
+func TestIDIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldTestID, vs...))
+}
+
+// TestIDNotIn applies the NotIn predicate on the "test_id" field.
+func TestIDNotIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldTestID, vs...))
+}
+
+// AssignedByIDEQ applies the EQ predicate on the "assigned_by_id" field.
+func AssignedByIDEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldAssignedByID, v))
+}
+
+// AssignedByIDNEQ applies the NEQ predicate on the "assigned_by_id" field.
+func AssignedByIDNEQ(v uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldAssignedByID, v))
+}
+
+// AssignedByIDIn applies the In predicate on the "assigned_by_id" field.
+func AssignedByIDIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldAssignedByID, vs...))
+}
+
+// AssignedByIDNotIn applies the NotIn predicate on the "assigned_by_id" field.
+func AssignedByIDNotIn(vs ...uuid.UUID) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldAssignedByID, vs...))
+}
+
+// AssignedByIDIsNil applies the IsNil predicate on the "assigned_by_id" field.
+func AssignedByIDIsNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIsNull(FieldAssignedByID))
+}
+
+// AssignedByIDNotNil applies the NotNil predicate on the "assigned_by_id" field.
+func AssignedByIDNotNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotNull(FieldAssignedByID))
+}
+
+// IncludeLaterMembersEQ applies the EQ predicate on the "include_later_members" field.
+func IncludeLaterMembersEQ(v bool) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldIncludeLaterMembers, v))
+}
+
+// IncludeLaterMembersNEQ applies the NEQ predicate on the "include_later_members" field.
+func IncludeLaterMembersNEQ(v bool) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldIncludeLaterMembers, v))
+}
+
+// ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
+func ExpiredAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtNEQ applies the NEQ predicate on the "expired_at" field.
+func ExpiredAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtIn applies the In predicate on the "expired_at" field.
+func ExpiredAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtNotIn applies the NotIn predicate on the "expired_at" field.
+func ExpiredAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtGT applies the GT predicate on the "expired_at" field.
+func ExpiredAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldExpiredAt, v))
+}
+
+// ExpiredAtGTE applies the GTE predicate on the "expired_at" field.
+func ExpiredAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtLT applies the LT predicate on the "expired_at" field.
+func ExpiredAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldExpiredAt, v))
+}
+
+// ExpiredAtLTE applies the LTE predicate on the "expired_at" field.
+func ExpiredAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtIsNil applies the IsNil predicate on the "expired_at" field.
+func ExpiredAtIsNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIsNull(FieldExpiredAt))
+}
+
+// ExpiredAtNotNil applies the NotNil predicate on the "expired_at" field.
+func ExpiredAtNotNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotNull(FieldExpiredAt))
+}
+
+// OpenAtEQ applies the EQ predicate on the "open_at" field.
+func OpenAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// OpenAtNEQ applies the NEQ predicate on the "open_at" field.
+func OpenAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldOpenAt, v))
+}
+
+// OpenAtIn applies the In predicate on the "open_at" field.
+func OpenAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldOpenAt, vs...))
+}
+
+// OpenAtNotIn applies the NotIn predicate on the "open_at" field.
+func OpenAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldOpenAt, vs...))
+}
+
+// OpenAtGT applies the GT predicate on the "open_at" field.
+func OpenAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldOpenAt, v))
+}
+
+// OpenAtGTE applies the GTE predicate on the "open_at" field.
+func OpenAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldOpenAt, v))
+}
+
+// OpenAtLT applies the LT predicate on the "open_at" field.
+func OpenAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldOpenAt, v))
+}
+
+// OpenAtLTE applies the LTE predicate on the "open_at" field.
+func OpenAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldOpenAt, v))
+}
+
+// OpenAtIsNil applies the IsNil predicate on the "open_at" field.
+func OpenAtIsNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIsNull(FieldOpenAt))
+}
+
+// OpenAtNotNil applies the NotNil predicate on the "open_at" field.
+func OpenAtNotNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotNull(FieldOpenAt))
+}
+
+// CloseAtEQ applies the EQ predicate on the "close_at" field.
+func CloseAtEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldEQ(FieldCloseAt, v))
+}
+
+// CloseAtNEQ applies the NEQ predicate on the "close_at" field.
+func CloseAtNEQ(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNEQ(FieldCloseAt, v))
+}
+
+// CloseAtIn applies the In predicate on the "close_at" field.
+func CloseAtIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIn(FieldCloseAt, vs...))
+}
+
+// CloseAtNotIn applies the NotIn predicate on the "close_at" field.
+func CloseAtNotIn(vs ...time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotIn(FieldCloseAt, vs...))
+}
+
+// CloseAtGT applies the GT predicate on the "close_at" field.
+func CloseAtGT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGT(FieldCloseAt, v))
+}
+
+// CloseAtGTE applies the GTE predicate on the "close_at" field.
+func CloseAtGTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldGTE(FieldCloseAt, v))
+}
+
+// CloseAtLT applies the LT predicate on the "close_at" field.
+func CloseAtLT(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLT(FieldCloseAt, v))
+}
+
+// CloseAtLTE applies the LTE predicate on the "close_at" field.
+func CloseAtLTE(v time.Time) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldLTE(FieldCloseAt, v))
+}
+
+// CloseAtIsNil applies the IsNil predicate on the "close_at" field.
+func CloseAtIsNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldIsNull(FieldCloseAt))
+}
+
+// CloseAtNotNil applies the NotNil predicate on the "close_at" field.
+func CloseAtNotNil() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.FieldNotNull(FieldCloseAt))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTest applies the HasEdge predicate on the "test" edge.
+func HasTest() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TestTable, TestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestWith applies the HasEdge predicate on the "test" edge with a given conditions (other predicates).
+func HasTestWith(preds ...predicate.Test) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := newTestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignedBy applies the HasEdge predicate on the "assigned_by" edge.
+func HasAssignedBy() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssignedByTable, AssignedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignedByWith applies the HasEdge predicate on the "assigned_by" edge with a given conditions (other predicates).
+func HasAssignedByWith(preds ...predicate.User) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := newAssignedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTestSessions applies the HasEdge predicate on the "test_sessions" edge.
+func HasTestSessions() predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TestSessionsTable, TestSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestSessionsWith applies the HasEdge predicate on the "test_sessions" edge with a given conditions (other predicates).
+func HasTestSessionsWith(preds ...predicate.TestSession) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(func(s *sql.Selector) {
+		step := newTestSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupTestAssignment) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupTestAssignment) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupTestAssignment) predicate.GroupTestAssignment {
+	return predicate.GroupTestAssignment(sql.NotPredicates(p))
+}
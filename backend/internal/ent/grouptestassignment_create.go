@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignmentCreate is the builder for creating a GroupTestAssignment entity.
+type GroupTestAssignmentCreate struct {
+	config
+	mutation *GroupTestAssignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gtac *GroupTestAssignmentCreate) SetCreatedAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetCreatedAt(t)
+	return gtac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableCreatedAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetCreatedAt(*t)
+	}
+	return gtac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtac *GroupTestAssignmentCreate) SetUpdatedAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetUpdatedAt(t)
+	return gtac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableUpdatedAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetUpdatedAt(*t)
+	}
+	return gtac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (gtac *GroupTestAssignmentCreate) SetDeletedAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetDeletedAt(t)
+	return gtac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableDeletedAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetDeletedAt(*t)
+	}
+	return gtac
+}
+
+// SetGroupID sets the "group_id" field.
+func (gtac *GroupTestAssignmentCreate) SetGroupID(u uuid.UUID) *GroupTestAssignmentCreate {
+	gtac.mutation.SetGroupID(u)
+	return gtac
+}
+
+// SetTestID sets the "test_id" field.
+func (gtac *GroupTestAssignmentCreate) SetTestID(u uuid.UUID) *GroupTestAssignmentCreate {
+	gtac.mutation.SetTestID(u)
+	return gtac
+}
+
+// SetAssignedByID sets the "assigned_by_id" field.
+func (gtac *GroupTestAssignmentCreate) SetAssignedByID(u uuid.UUID) *GroupTestAssignmentCreate {
+	gtac.mutation.SetAssignedByID(u)
+	return gtac
+}
+
+// SetNillableAssignedByID sets the "assigned_by_id" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableAssignedByID(u *uuid.UUID) *GroupTestAssignmentCreate {
+	if u != nil {
+		gtac.SetAssignedByID(*u)
+	}
+	return gtac
+}
+
+// SetIncludeLaterMembers sets the "include_later_members" field.
+func (gtac *GroupTestAssignmentCreate) SetIncludeLaterMembers(b bool) *GroupTestAssignmentCreate {
+	gtac.mutation.SetIncludeLaterMembers(b)
+	return gtac
+}
+
+// SetNillableIncludeLaterMembers sets the "include_later_members" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableIncludeLaterMembers(b *bool) *GroupTestAssignmentCreate {
+	if b != nil {
+		gtac.SetIncludeLaterMembers(*b)
+	}
+	return gtac
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gtac *GroupTestAssignmentCreate) SetExpiredAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetExpiredAt(t)
+	return gtac
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableExpiredAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetExpiredAt(*t)
+	}
+	return gtac
+}
+
+// SetOpenAt sets the "open_at" field.
+func (gtac *GroupTestAssignmentCreate) SetOpenAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetOpenAt(t)
+	return gtac
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableOpenAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetOpenAt(*t)
+	}
+	return gtac
+}
+
+// SetCloseAt sets the "close_at" field.
+func (gtac *GroupTestAssignmentCreate) SetCloseAt(t time.Time) *GroupTestAssignmentCreate {
+	gtac.mutation.SetCloseAt(t)
+	return gtac
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableCloseAt(t *time.Time) *GroupTestAssignmentCreate {
+	if t != nil {
+		gtac.SetCloseAt(*t)
+	}
+	return gtac
+}
+
+// SetID sets the "id" field.
+func (gtac *GroupTestAssignmentCreate) SetID(u uuid.UUID) *GroupTestAssignmentCreate {
+	gtac.mutation.SetID(u)
+	return gtac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gtac *GroupTestAssignmentCreate) SetNillableID(u *uuid.UUID) *GroupTestAssignmentCreate {
+	if u != nil {
+		gtac.SetID(*u)
+	}
+	return gtac
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gtac *GroupTestAssignmentCreate) SetGroup(g *Group) *GroupTestAssignmentCreate {
+	return gtac.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (gtac *GroupTestAssignmentCreate) SetTest(t *Test) *GroupTestAssignmentCreate {
+	return gtac.SetTestID(t.ID)
+}
+
+// SetAssignedBy sets the "assigned_by" edge to the User entity.
+func (gtac *GroupTestAssignmentCreate) SetAssignedBy(u *User) *GroupTestAssignmentCreate {
+	return gtac.SetAssignedByID(u.ID)
+}
+
+// AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
+func (gtac *GroupTestAssignmentCreate) AddTestSessionIDs(ids ...uuid.UUID) *GroupTestAssignmentCreate {
+	gtac.mutation.AddTestSessionIDs(ids...)
+	return gtac
+}
+
+// AddTestSessions adds the "test_sessions" edges to the TestSession entity.
+func (gtac *GroupTestAssignmentCreate) AddTestSessions(t ...*TestSession) *GroupTestAssignmentCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gtac.AddTestSessionIDs(ids...)
+}
+
+// Mutation returns the GroupTestAssignmentMutation object of the builder.
+func (gtac *GroupTestAssignmentCreate) Mutation() *GroupTestAssignmentMutation {
+	return gtac.mutation
+}
+
+// Save creates the GroupTestAssignment in the database.
+func (gtac *GroupTestAssignmentCreate) Save(ctx context.Context) (*GroupTestAssignment, error) {
+	if err := gtac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, gtac.sqlSave, gtac.mutation, gtac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gtac *GroupTestAssignmentCreate) SaveX(ctx context.Context) *GroupTestAssignment {
+	v, err := gtac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gtac *GroupTestAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := gtac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtac *GroupTestAssignmentCreate) ExecX(ctx context.Context) {
+	if err := gtac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtac *GroupTestAssignmentCreate) defaults() error {
+	if _, ok := gtac.mutation.CreatedAt(); !ok {
+		if grouptestassignment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized grouptestassignment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := grouptestassignment.DefaultCreatedAt()
+		gtac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gtac.mutation.UpdatedAt(); !ok {
+		if grouptestassignment.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized grouptestassignment.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := grouptestassignment.DefaultUpdatedAt()
+		gtac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := gtac.mutation.IncludeLaterMembers(); !ok {
+		v := grouptestassignment.DefaultIncludeLaterMembers
+		gtac.mutation.SetIncludeLaterMembers(v)
+	}
+	if _, ok := gtac.mutation.ID(); !ok {
+		if grouptestassignment.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized grouptestassignment.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := grouptestassignment.DefaultID()
+		gtac.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gtac *GroupTestAssignmentCreate) check() error {
+	if _, ok := gtac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GroupTestAssignment.created_at"`)}
+	}
+	if _, ok := gtac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GroupTestAssignment.updated_at"`)}
+	}
+	if _, ok := gtac.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupTestAssignment.group_id"`)}
+	}
+	if _, ok := gtac.mutation.TestID(); !ok {
+		return &ValidationError{Name: "test_id", err: errors.New(`ent: missing required field "GroupTestAssignment.test_id"`)}
+	}
+	if _, ok := gtac.mutation.IncludeLaterMembers(); !ok {
+		return &ValidationError{Name: "include_later_members", err: errors.New(`ent: missing required field "GroupTestAssignment.include_later_members"`)}
+	}
+	if len(gtac.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupTestAssignment.group"`)}
+	}
+	if len(gtac.mutation.TestIDs()) == 0 {
+		return &ValidationError{Name: "test", err: errors.New(`ent: missing required edge "GroupTestAssignment.test"`)}
+	}
+	return nil
+}
+
+func (gtac *GroupTestAssignmentCreate) sqlSave(ctx context.Context) (*GroupTestAssignment, error) {
+	if err := gtac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gtac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gtac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	gtac.mutation.id = &_node.ID
+	gtac.mutation.done = true
+	return _node, nil
+}
+
+func (gtac *GroupTestAssignmentCreate) createSpec() (*GroupTestAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupTestAssignment{config: gtac.config}
+		_spec = sqlgraph.NewCreateSpec(grouptestassignment.Table, sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID))
+	)
+	if id, ok := gtac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := gtac.mutation.CreatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gtac.mutation.UpdatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := gtac.mutation.DeletedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := gtac.mutation.IncludeLaterMembers(); ok {
+		_spec.SetField(grouptestassignment.FieldIncludeLaterMembers, field.TypeBool, value)
+		_node.IncludeLaterMembers = value
+	}
+	if value, ok := gtac.mutation.ExpiredAt(); ok {
+		_spec.SetField(grouptestassignment.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	if value, ok := gtac.mutation.OpenAt(); ok {
+		_spec.SetField(grouptestassignment.FieldOpenAt, field.TypeTime, value)
+		_node.OpenAt = &value
+	}
+	if value, ok := gtac.mutation.CloseAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCloseAt, field.TypeTime, value)
+		_node.CloseAt = &value
+	}
+	if nodes := gtac.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.GroupTable,
+			Columns: []string{grouptestassignment.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gtac.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.TestTable,
+			Columns: []string{grouptestassignment.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TestID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gtac.mutation.AssignedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.AssignedByTable,
+			Columns: []string{grouptestassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssignedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gtac.mutation.TestSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupTestAssignmentCreateBulk is the builder for creating many GroupTestAssignment entities in bulk.
+type GroupTestAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*GroupTestAssignmentCreate
+}
+
+// Save creates the GroupTestAssignment entities in the database.
+func (gtacb *GroupTestAssignmentCreateBulk) Save(ctx context.Context) ([]*GroupTestAssignment, error) {
+	if gtacb.err != nil {
+		return nil, gtacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gtacb.builders))
+	nodes := make([]*GroupTestAssignment, len(gtacb.builders))
+	mutators := make([]Mutator, len(gtacb.builders))
+	for i := range gtacb.builders {
+		func(i int, root context.Context) {
+			builder := gtacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupTestAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gtacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gtacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gtacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtacb *GroupTestAssignmentCreateBulk) SaveX(ctx context.Context) []*GroupTestAssignment {
+	v, err := gtacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gtacb *GroupTestAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := gtacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtacb *GroupTestAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := gtacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GroupTestAssignmentDelete is the builder for deleting a GroupTestAssignment entity.
+type GroupTestAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupTestAssignmentMutation
+}
+
+// Where appends a list predicates to the GroupTestAssignmentDelete builder.
+func (gtad *GroupTestAssignmentDelete) Where(ps ...predicate.GroupTestAssignment) *GroupTestAssignmentDelete {
+	gtad.mutation.Where(ps...)
+	return gtad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gtad *GroupTestAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gtad.sqlExec, gtad.mutation, gtad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtad *GroupTestAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := gtad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gtad *GroupTestAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(grouptestassignment.Table, sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID))
+	if ps := gtad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gtad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gtad.mutation.done = true
+	return affected, err
+}
+
+// GroupTestAssignmentDeleteOne is the builder for deleting a single GroupTestAssignment entity.
+type GroupTestAssignmentDeleteOne struct {
+	gtad *GroupTestAssignmentDelete
+}
+
+// Where appends a list predicates to the GroupTestAssignmentDelete builder.
+func (gtado *GroupTestAssignmentDeleteOne) Where(ps ...predicate.GroupTestAssignment) *GroupTestAssignmentDeleteOne {
+	gtado.gtad.mutation.Where(ps...)
+	return gtado
+}
+
+// Exec executes the deletion query.
+func (gtado *GroupTestAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := gtado.gtad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{grouptestassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtado *GroupTestAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := gtado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,875 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/predicate"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignmentQuery is the builder for querying GroupTestAssignment entities.
+type GroupTestAssignmentQuery struct {
+	config
+	ctx              *QueryContext
+	order            []grouptestassignment.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.GroupTestAssignment
+	withGroup        *GroupQuery
+	withTest         *TestQuery
+	withAssignedBy   *UserQuery
+	withTestSessions *TestSessionQuery
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupTestAssignmentQuery builder.
+func (gtaq *GroupTestAssignmentQuery) Where(ps ...predicate.GroupTestAssignment) *GroupTestAssignmentQuery {
+	gtaq.predicates = append(gtaq.predicates, ps...)
+	return gtaq
+}
+
+// Limit the number of records to be returned by this query.
+func (gtaq *GroupTestAssignmentQuery) Limit(limit int) *GroupTestAssignmentQuery {
+	gtaq.ctx.Limit = &limit
+	return gtaq
+}
+
+// Offset to start from.
+func (gtaq *GroupTestAssignmentQuery) Offset(offset int) *GroupTestAssignmentQuery {
+	gtaq.ctx.Offset = &offset
+	return gtaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gtaq *GroupTestAssignmentQuery) Unique(unique bool) *GroupTestAssignmentQuery {
+	gtaq.ctx.Unique = &unique
+	return gtaq
+}
+
+// Order specifies how the records should be ordered.
+func (gtaq *GroupTestAssignmentQuery) Order(o ...grouptestassignment.OrderOption) *GroupTestAssignmentQuery {
+	gtaq.order = append(gtaq.order, o...)
+	return gtaq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (gtaq *GroupTestAssignmentQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: gtaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gtaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gtaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.GroupTable, grouptestassignment.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gtaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTest chains the current query on the "test" edge.
+func (gtaq *GroupTestAssignmentQuery) QueryTest() *TestQuery {
+	query := (&TestClient{config: gtaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gtaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gtaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, selector),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.TestTable, grouptestassignment.TestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gtaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignedBy chains the current query on the "assigned_by" edge.
+func (gtaq *GroupTestAssignmentQuery) QueryAssignedBy() *UserQuery {
+	query := (&UserClient{config: gtaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gtaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gtaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouptestassignment.AssignedByTable, grouptestassignment.AssignedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gtaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTestSessions chains the current query on the "test_sessions" edge.
+func (gtaq *GroupTestAssignmentQuery) QueryTestSessions() *TestSessionQuery {
+	query := (&TestSessionClient{config: gtaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gtaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gtaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouptestassignment.Table, grouptestassignment.FieldID, selector),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grouptestassignment.TestSessionsTable, grouptestassignment.TestSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gtaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GroupTestAssignment entity from the query.
+// Returns a *NotFoundError when no GroupTestAssignment was found.
+func (gtaq *GroupTestAssignmentQuery) First(ctx context.Context) (*GroupTestAssignment, error) {
+	nodes, err := gtaq.Limit(1).All(setContextOp(ctx, gtaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{grouptestassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) FirstX(ctx context.Context) *GroupTestAssignment {
+	node, err := gtaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupTestAssignment ID from the query.
+// Returns a *NotFoundError when no GroupTestAssignment ID was found.
+func (gtaq *GroupTestAssignmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gtaq.Limit(1).IDs(setContextOp(ctx, gtaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{grouptestassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := gtaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupTestAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupTestAssignment entity is found.
+// Returns a *NotFoundError when no GroupTestAssignment entities are found.
+func (gtaq *GroupTestAssignmentQuery) Only(ctx context.Context) (*GroupTestAssignment, error) {
+	nodes, err := gtaq.Limit(2).All(setContextOp(ctx, gtaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{grouptestassignment.Label}
+	default:
+		return nil, &NotSingularError{grouptestassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) OnlyX(ctx context.Context) *GroupTestAssignment {
+	node, err := gtaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupTestAssignment ID in the query.
+// Returns a *NotSingularError when more than one GroupTestAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gtaq *GroupTestAssignmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gtaq.Limit(2).IDs(setContextOp(ctx, gtaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{grouptestassignment.Label}
+	default:
+		err = &NotSingularError{grouptestassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := gtaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupTestAssignments.
+func (gtaq *GroupTestAssignmentQuery) All(ctx context.Context) ([]*GroupTestAssignment, error) {
+	ctx = setContextOp(ctx, gtaq.ctx, ent.OpQueryAll)
+	if err := gtaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupTestAssignment, *GroupTestAssignmentQuery]()
+	return withInterceptors[[]*GroupTestAssignment](ctx, gtaq, qr, gtaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) AllX(ctx context.Context) []*GroupTestAssignment {
+	nodes, err := gtaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupTestAssignment IDs.
+func (gtaq *GroupTestAssignmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if gtaq.ctx.Unique == nil && gtaq.path != nil {
+		gtaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gtaq.ctx, ent.OpQueryIDs)
+	if err = gtaq.Select(grouptestassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := gtaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gtaq *GroupTestAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gtaq.ctx, ent.OpQueryCount)
+	if err := gtaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gtaq, querierCount[*GroupTestAssignmentQuery](), gtaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := gtaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gtaq *GroupTestAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gtaq.ctx, ent.OpQueryExist)
+	switch _, err := gtaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gtaq *GroupTestAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := gtaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupTestAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gtaq *GroupTestAssignmentQuery) Clone() *GroupTestAssignmentQuery {
+	if gtaq == nil {
+		return nil
+	}
+	return &GroupTestAssignmentQuery{
+		config:           gtaq.config,
+		ctx:              gtaq.ctx.Clone(),
+		order:            append([]grouptestassignment.OrderOption{}, gtaq.order...),
+		inters:           append([]Interceptor{}, gtaq.inters...),
+		predicates:       append([]predicate.GroupTestAssignment{}, gtaq.predicates...),
+		withGroup:        gtaq.withGroup.Clone(),
+		withTest:         gtaq.withTest.Clone(),
+		withAssignedBy:   gtaq.withAssignedBy.Clone(),
+		withTestSessions: gtaq.withTestSessions.Clone(),
+		// clone intermediate query.
+		sql:  gtaq.sql.Clone(),
+		path: gtaq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (gtaq *GroupTestAssignmentQuery) WithGroup(opts ...func(*GroupQuery)) *GroupTestAssignmentQuery {
+	query := (&GroupClient{config: gtaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gtaq.withGroup = query
+	return gtaq
+}
+
+// WithTest tells the query-builder to eager-load the nodes that are connected to
+// the "test" edge. The optional arguments are used to configure the query builder of the edge.
+func (gtaq *GroupTestAssignmentQuery) WithTest(opts ...func(*TestQuery)) *GroupTestAssignmentQuery {
+	query := (&TestClient{config: gtaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gtaq.withTest = query
+	return gtaq
+}
+
+// WithAssignedBy tells the query-builder to eager-load the nodes that are connected to
+// the "assigned_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (gtaq *GroupTestAssignmentQuery) WithAssignedBy(opts ...func(*UserQuery)) *GroupTestAssignmentQuery {
+	query := (&UserClient{config: gtaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gtaq.withAssignedBy = query
+	return gtaq
+}
+
+// WithTestSessions tells the query-builder to eager-load the nodes that are connected to
+// the "test_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (gtaq *GroupTestAssignmentQuery) WithTestSessions(opts ...func(*TestSessionQuery)) *GroupTestAssignmentQuery {
+	query := (&TestSessionClient{config: gtaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gtaq.withTestSessions = query
+	return gtaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupTestAssignment.Query().
+//		GroupBy(grouptestassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gtaq *GroupTestAssignmentQuery) GroupBy(field string, fields ...string) *GroupTestAssignmentGroupBy {
+	gtaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupTestAssignmentGroupBy{build: gtaq}
+	grbuild.flds = &gtaq.ctx.Fields
+	grbuild.label = grouptestassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.GroupTestAssignment.Query().
+//		Select(grouptestassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (gtaq *GroupTestAssignmentQuery) Select(fields ...string) *GroupTestAssignmentSelect {
+	gtaq.ctx.Fields = append(gtaq.ctx.Fields, fields...)
+	sbuild := &GroupTestAssignmentSelect{GroupTestAssignmentQuery: gtaq}
+	sbuild.label = grouptestassignment.Label
+	sbuild.flds, sbuild.scan = &gtaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupTestAssignmentSelect configured with the given aggregations.
+func (gtaq *GroupTestAssignmentQuery) Aggregate(fns ...AggregateFunc) *GroupTestAssignmentSelect {
+	return gtaq.Select().Aggregate(fns...)
+}
+
+func (gtaq *GroupTestAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gtaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gtaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gtaq.ctx.Fields {
+		if !grouptestassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gtaq.path != nil {
+		prev, err := gtaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gtaq.sql = prev
+	}
+	return nil
+}
+
+func (gtaq *GroupTestAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupTestAssignment, error) {
+	var (
+		nodes       = []*GroupTestAssignment{}
+		_spec       = gtaq.querySpec()
+		loadedTypes = [4]bool{
+			gtaq.withGroup != nil,
+			gtaq.withTest != nil,
+			gtaq.withAssignedBy != nil,
+			gtaq.withTestSessions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupTestAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupTestAssignment{config: gtaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(gtaq.modifiers) > 0 {
+		_spec.Modifiers = gtaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gtaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gtaq.withGroup; query != nil {
+		if err := gtaq.loadGroup(ctx, query, nodes, nil,
+			func(n *GroupTestAssignment, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gtaq.withTest; query != nil {
+		if err := gtaq.loadTest(ctx, query, nodes, nil,
+			func(n *GroupTestAssignment, e *Test) { n.Edges.Test = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gtaq.withAssignedBy; query != nil {
+		if err := gtaq.loadAssignedBy(ctx, query, nodes, nil,
+			func(n *GroupTestAssignment, e *User) { n.Edges.AssignedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gtaq.withTestSessions; query != nil {
+		if err := gtaq.loadTestSessions(ctx, query, nodes,
+			func(n *GroupTestAssignment) { n.Edges.TestSessions = []*TestSession{} },
+			func(n *GroupTestAssignment, e *TestSession) { n.Edges.TestSessions = append(n.Edges.TestSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gtaq *GroupTestAssignmentQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*GroupTestAssignment, init func(*GroupTestAssignment), assign func(*GroupTestAssignment, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupTestAssignment)
+	for i := range nodes {
+		fk := nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gtaq *GroupTestAssignmentQuery) loadTest(ctx context.Context, query *TestQuery, nodes []*GroupTestAssignment, init func(*GroupTestAssignment), assign func(*GroupTestAssignment, *Test)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupTestAssignment)
+	for i := range nodes {
+		fk := nodes[i].TestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(test.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "test_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gtaq *GroupTestAssignmentQuery) loadAssignedBy(ctx context.Context, query *UserQuery, nodes []*GroupTestAssignment, init func(*GroupTestAssignment), assign func(*GroupTestAssignment, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupTestAssignment)
+	for i := range nodes {
+		if nodes[i].AssignedByID == nil {
+			continue
+		}
+		fk := *nodes[i].AssignedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "assigned_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gtaq *GroupTestAssignmentQuery) loadTestSessions(ctx context.Context, query *TestSessionQuery, nodes []*GroupTestAssignment, init func(*GroupTestAssignment), assign func(*GroupTestAssignment, *TestSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*GroupTestAssignment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsession.FieldGroupAssignmentID)
+	}
+	query.Where(predicate.TestSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(grouptestassignment.TestSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupAssignmentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_assignment_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_assignment_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (gtaq *GroupTestAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gtaq.querySpec()
+	if len(gtaq.modifiers) > 0 {
+		_spec.Modifiers = gtaq.modifiers
+	}
+	_spec.Node.Columns = gtaq.ctx.Fields
+	if len(gtaq.ctx.Fields) > 0 {
+		_spec.Unique = gtaq.ctx.Unique != nil && *gtaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gtaq.driver, _spec)
+}
+
+func (gtaq *GroupTestAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(grouptestassignment.Table, grouptestassignment.Columns, sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID))
+	_spec.From = gtaq.sql
+	if unique := gtaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gtaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gtaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grouptestassignment.FieldID)
+		for i := range fields {
+			if fields[i] != grouptestassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if gtaq.withGroup != nil {
+			_spec.Node.AddColumnOnce(grouptestassignment.FieldGroupID)
+		}
+		if gtaq.withTest != nil {
+			_spec.Node.AddColumnOnce(grouptestassignment.FieldTestID)
+		}
+		if gtaq.withAssignedBy != nil {
+			_spec.Node.AddColumnOnce(grouptestassignment.FieldAssignedByID)
+		}
+	}
+	if ps := gtaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gtaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gtaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gtaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gtaq *GroupTestAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gtaq.driver.Dialect())
+	t1 := builder.Table(grouptestassignment.Table)
+	columns := gtaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = grouptestassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gtaq.sql != nil {
+		selector = gtaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gtaq.ctx.Unique != nil && *gtaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gtaq.modifiers {
+		m(selector)
+	}
+	for _, p := range gtaq.predicates {
+		p(selector)
+	}
+	for _, p := range gtaq.order {
+		p(selector)
+	}
+	if offset := gtaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gtaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gtaq *GroupTestAssignmentQuery) ForUpdate(opts ...sql.LockOption) *GroupTestAssignmentQuery {
+	if gtaq.driver.Dialect() == dialect.Postgres {
+		gtaq.Unique(false)
+	}
+	gtaq.modifiers = append(gtaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gtaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gtaq *GroupTestAssignmentQuery) ForShare(opts ...sql.LockOption) *GroupTestAssignmentQuery {
+	if gtaq.driver.Dialect() == dialect.Postgres {
+		gtaq.Unique(false)
+	}
+	gtaq.modifiers = append(gtaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gtaq
+}
+
+// GroupTestAssignmentGroupBy is the group-by builder for GroupTestAssignment entities.
+type GroupTestAssignmentGroupBy struct {
+	selector
+	build *GroupTestAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gtagb *GroupTestAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *GroupTestAssignmentGroupBy {
+	gtagb.fns = append(gtagb.fns, fns...)
+	return gtagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gtagb *GroupTestAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gtagb.build.ctx, ent.OpQueryGroupBy)
+	if err := gtagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupTestAssignmentQuery, *GroupTestAssignmentGroupBy](ctx, gtagb.build, gtagb, gtagb.build.inters, v)
+}
+
+func (gtagb *GroupTestAssignmentGroupBy) sqlScan(ctx context.Context, root *GroupTestAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gtagb.fns))
+	for _, fn := range gtagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gtagb.flds)+len(gtagb.fns))
+		for _, f := range *gtagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gtagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gtagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupTestAssignmentSelect is the builder for selecting fields of GroupTestAssignment entities.
+type GroupTestAssignmentSelect struct {
+	*GroupTestAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gtas *GroupTestAssignmentSelect) Aggregate(fns ...AggregateFunc) *GroupTestAssignmentSelect {
+	gtas.fns = append(gtas.fns, fns...)
+	return gtas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gtas *GroupTestAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gtas.ctx, ent.OpQuerySelect)
+	if err := gtas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupTestAssignmentQuery, *GroupTestAssignmentSelect](ctx, gtas.GroupTestAssignmentQuery, gtas, gtas.inters, v)
+}
+
+func (gtas *GroupTestAssignmentSelect) sqlScan(ctx context.Context, root *GroupTestAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gtas.fns))
+	for _, fn := range gtas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gtas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gtas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/predicate"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignmentUpdate is the builder for updating GroupTestAssignment entities.
+type GroupTestAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupTestAssignmentMutation
+}
+
+// Where appends a list predicates to the GroupTestAssignmentUpdate builder.
+func (gtau *GroupTestAssignmentUpdate) Where(ps ...predicate.GroupTestAssignment) *GroupTestAssignmentUpdate {
+	gtau.mutation.Where(ps...)
+	return gtau
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetCreatedAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetCreatedAt(t)
+	return gtau
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableCreatedAt(t *time.Time) *GroupTestAssignmentUpdate {
+	if t != nil {
+		gtau.SetCreatedAt(*t)
+	}
+	return gtau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetUpdatedAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetUpdatedAt(t)
+	return gtau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetDeletedAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetDeletedAt(t)
+	return gtau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableDeletedAt(t *time.Time) *GroupTestAssignmentUpdate {
+	if t != nil {
+		gtau.SetDeletedAt(*t)
+	}
+	return gtau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (gtau *GroupTestAssignmentUpdate) ClearDeletedAt() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearDeletedAt()
+	return gtau
+}
+
+// SetGroupID sets the "group_id" field.
+func (gtau *GroupTestAssignmentUpdate) SetGroupID(u uuid.UUID) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetGroupID(u)
+	return gtau
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableGroupID(u *uuid.UUID) *GroupTestAssignmentUpdate {
+	if u != nil {
+		gtau.SetGroupID(*u)
+	}
+	return gtau
+}
+
+// SetTestID sets the "test_id" field.
+func (gtau *GroupTestAssignmentUpdate) SetTestID(u uuid.UUID) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetTestID(u)
+	return gtau
+}
+
+// SetNillableTestID sets the "test_id" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableTestID(u *uuid.UUID) *GroupTestAssignmentUpdate {
+	if u != nil {
+		gtau.SetTestID(*u)
+	}
+	return gtau
+}
+
+// SetAssignedByID sets the "assigned_by_id" field.
+func (gtau *GroupTestAssignmentUpdate) SetAssignedByID(u uuid.UUID) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetAssignedByID(u)
+	return gtau
+}
+
+// SetNillableAssignedByID sets the "assigned_by_id" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableAssignedByID(u *uuid.UUID) *GroupTestAssignmentUpdate {
+	if u != nil {
+		gtau.SetAssignedByID(*u)
+	}
+	return gtau
+}
+
+// ClearAssignedByID clears the value of the "assigned_by_id" field.
+func (gtau *GroupTestAssignmentUpdate) ClearAssignedByID() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearAssignedByID()
+	return gtau
+}
+
+// SetIncludeLaterMembers sets the "include_later_members" field.
+func (gtau *GroupTestAssignmentUpdate) SetIncludeLaterMembers(b bool) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetIncludeLaterMembers(b)
+	return gtau
+}
+
+// SetNillableIncludeLaterMembers sets the "include_later_members" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableIncludeLaterMembers(b *bool) *GroupTestAssignmentUpdate {
+	if b != nil {
+		gtau.SetIncludeLaterMembers(*b)
+	}
+	return gtau
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetExpiredAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetExpiredAt(t)
+	return gtau
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableExpiredAt(t *time.Time) *GroupTestAssignmentUpdate {
+	if t != nil {
+		gtau.SetExpiredAt(*t)
+	}
+	return gtau
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (gtau *GroupTestAssignmentUpdate) ClearExpiredAt() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearExpiredAt()
+	return gtau
+}
+
+// SetOpenAt sets the "open_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetOpenAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetOpenAt(t)
+	return gtau
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableOpenAt(t *time.Time) *GroupTestAssignmentUpdate {
+	if t != nil {
+		gtau.SetOpenAt(*t)
+	}
+	return gtau
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (gtau *GroupTestAssignmentUpdate) ClearOpenAt() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearOpenAt()
+	return gtau
+}
+
+// SetCloseAt sets the "close_at" field.
+func (gtau *GroupTestAssignmentUpdate) SetCloseAt(t time.Time) *GroupTestAssignmentUpdate {
+	gtau.mutation.SetCloseAt(t)
+	return gtau
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (gtau *GroupTestAssignmentUpdate) SetNillableCloseAt(t *time.Time) *GroupTestAssignmentUpdate {
+	if t != nil {
+		gtau.SetCloseAt(*t)
+	}
+	return gtau
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (gtau *GroupTestAssignmentUpdate) ClearCloseAt() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearCloseAt()
+	return gtau
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gtau *GroupTestAssignmentUpdate) SetGroup(g *Group) *GroupTestAssignmentUpdate {
+	return gtau.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (gtau *GroupTestAssignmentUpdate) SetTest(t *Test) *GroupTestAssignmentUpdate {
+	return gtau.SetTestID(t.ID)
+}
+
+// SetAssignedBy sets the "assigned_by" edge to the User entity.
+func (gtau *GroupTestAssignmentUpdate) SetAssignedBy(u *User) *GroupTestAssignmentUpdate {
+	return gtau.SetAssignedByID(u.ID)
+}
+
+// AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
+func (gtau *GroupTestAssignmentUpdate) AddTestSessionIDs(ids ...uuid.UUID) *GroupTestAssignmentUpdate {
+	gtau.mutation.AddTestSessionIDs(ids...)
+	return gtau
+}
+
+// AddTestSessions adds the "test_sessions" edges to the TestSession entity.
+func (gtau *GroupTestAssignmentUpdate) AddTestSessions(t ...*TestSession) *GroupTestAssignmentUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gtau.AddTestSessionIDs(ids...)
+}
+
+// Mutation returns the GroupTestAssignmentMutation object of the builder.
+func (gtau *GroupTestAssignmentUpdate) Mutation() *GroupTestAssignmentMutation {
+	return gtau.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (gtau *GroupTestAssignmentUpdate) ClearGroup() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearGroup()
+	return gtau
+}
+
+// ClearTest clears the "test" edge to the Test entity.
+func (gtau *GroupTestAssignmentUpdate) ClearTest() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearTest()
+	return gtau
+}
+
+// ClearAssignedBy clears the "assigned_by" edge to the User entity.
+func (gtau *GroupTestAssignmentUpdate) ClearAssignedBy() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearAssignedBy()
+	return gtau
+}
+
+// ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
+func (gtau *GroupTestAssignmentUpdate) ClearTestSessions() *GroupTestAssignmentUpdate {
+	gtau.mutation.ClearTestSessions()
+	return gtau
+}
+
+// RemoveTestSessionIDs removes the "test_sessions" edge to TestSession entities by IDs.
+func (gtau *GroupTestAssignmentUpdate) RemoveTestSessionIDs(ids ...uuid.UUID) *GroupTestAssignmentUpdate {
+	gtau.mutation.RemoveTestSessionIDs(ids...)
+	return gtau
+}
+
+// RemoveTestSessions removes "test_sessions" edges to TestSession entities.
+func (gtau *GroupTestAssignmentUpdate) RemoveTestSessions(t ...*TestSession) *GroupTestAssignmentUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gtau.RemoveTestSessionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gtau *GroupTestAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	if err := gtau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, gtau.sqlSave, gtau.mutation, gtau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtau *GroupTestAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := gtau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gtau *GroupTestAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := gtau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtau *GroupTestAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := gtau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtau *GroupTestAssignmentUpdate) defaults() error {
+	if _, ok := gtau.mutation.UpdatedAt(); !ok {
+		if grouptestassignment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized grouptestassignment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := grouptestassignment.UpdateDefaultUpdatedAt()
+		gtau.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gtau *GroupTestAssignmentUpdate) check() error {
+	if gtau.mutation.GroupCleared() && len(gtau.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupTestAssignment.group"`)
+	}
+	if gtau.mutation.TestCleared() && len(gtau.mutation.TestIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupTestAssignment.test"`)
+	}
+	return nil
+}
+
+func (gtau *GroupTestAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gtau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grouptestassignment.Table, grouptestassignment.Columns, sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID))
+	if ps := gtau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gtau.mutation.CreatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := gtau.mutation.UpdatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := gtau.mutation.DeletedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if gtau.mutation.DeletedAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := gtau.mutation.IncludeLaterMembers(); ok {
+		_spec.SetField(grouptestassignment.FieldIncludeLaterMembers, field.TypeBool, value)
+	}
+	if value, ok := gtau.mutation.ExpiredAt(); ok {
+		_spec.SetField(grouptestassignment.FieldExpiredAt, field.TypeTime, value)
+	}
+	if gtau.mutation.ExpiredAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := gtau.mutation.OpenAt(); ok {
+		_spec.SetField(grouptestassignment.FieldOpenAt, field.TypeTime, value)
+	}
+	if gtau.mutation.OpenAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := gtau.mutation.CloseAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCloseAt, field.TypeTime, value)
+	}
+	if gtau.mutation.CloseAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldCloseAt, field.TypeTime)
+	}
+	if gtau.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.GroupTable,
+			Columns: []string{grouptestassignment.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtau.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.GroupTable,
+			Columns: []string{grouptestassignment.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtau.mutation.TestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.TestTable,
+			Columns: []string{grouptestassignment.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtau.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.TestTable,
+			Columns: []string{grouptestassignment.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtau.mutation.AssignedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.AssignedByTable,
+			Columns: []string{grouptestassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtau.mutation.AssignedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.AssignedByTable,
+			Columns: []string{grouptestassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtau.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtau.mutation.RemovedTestSessionsIDs(); len(nodes) > 0 && !gtau.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtau.mutation.TestSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gtau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grouptestassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gtau.mutation.done = true
+	return n, nil
+}
+
+// GroupTestAssignmentUpdateOne is the builder for updating a single GroupTestAssignment entity.
+type GroupTestAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupTestAssignmentMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetCreatedAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetCreatedAt(t)
+	return gtauo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableCreatedAt(t *time.Time) *GroupTestAssignmentUpdateOne {
+	if t != nil {
+		gtauo.SetCreatedAt(*t)
+	}
+	return gtauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetUpdatedAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetUpdatedAt(t)
+	return gtauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetDeletedAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetDeletedAt(t)
+	return gtauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableDeletedAt(t *time.Time) *GroupTestAssignmentUpdateOne {
+	if t != nil {
+		gtauo.SetDeletedAt(*t)
+	}
+	return gtauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearDeletedAt() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearDeletedAt()
+	return gtauo
+}
+
+// SetGroupID sets the "group_id" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetGroupID(u uuid.UUID) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetGroupID(u)
+	return gtauo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableGroupID(u *uuid.UUID) *GroupTestAssignmentUpdateOne {
+	if u != nil {
+		gtauo.SetGroupID(*u)
+	}
+	return gtauo
+}
+
+// SetTestID sets the "test_id" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetTestID(u uuid.UUID) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetTestID(u)
+	return gtauo
+}
+
+// SetNillableTestID sets the "test_id" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableTestID(u *uuid.UUID) *GroupTestAssignmentUpdateOne {
+	if u != nil {
+		gtauo.SetTestID(*u)
+	}
+	return gtauo
+}
+
+// SetAssignedByID sets the "assigned_by_id" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetAssignedByID(u uuid.UUID) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetAssignedByID(u)
+	return gtauo
+}
+
+// SetNillableAssignedByID sets the "assigned_by_id" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableAssignedByID(u *uuid.UUID) *GroupTestAssignmentUpdateOne {
+	if u != nil {
+		gtauo.SetAssignedByID(*u)
+	}
+	return gtauo
+}
+
+// ClearAssignedByID clears the value of the "assigned_by_id" field.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearAssignedByID() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearAssignedByID()
+	return gtauo
+}
+
+// SetIncludeLaterMembers sets the "include_later_members" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetIncludeLaterMembers(b bool) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetIncludeLaterMembers(b)
+	return gtauo
+}
+
+// SetNillableIncludeLaterMembers sets the "include_later_members" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableIncludeLaterMembers(b *bool) *GroupTestAssignmentUpdateOne {
+	if b != nil {
+		gtauo.SetIncludeLaterMembers(*b)
+	}
+	return gtauo
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetExpiredAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetExpiredAt(t)
+	return gtauo
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableExpiredAt(t *time.Time) *GroupTestAssignmentUpdateOne {
+	if t != nil {
+		gtauo.SetExpiredAt(*t)
+	}
+	return gtauo
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearExpiredAt() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearExpiredAt()
+	return gtauo
+}
+
+// SetOpenAt sets the "open_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetOpenAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetOpenAt(t)
+	return gtauo
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableOpenAt(t *time.Time) *GroupTestAssignmentUpdateOne {
+	if t != nil {
+		gtauo.SetOpenAt(*t)
+	}
+	return gtauo
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearOpenAt() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearOpenAt()
+	return gtauo
+}
+
+// SetCloseAt sets the "close_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) SetCloseAt(t time.Time) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.SetCloseAt(t)
+	return gtauo
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (gtauo *GroupTestAssignmentUpdateOne) SetNillableCloseAt(t *time.Time) *GroupTestAssignmentUpdateOne {
+	if t != nil {
+		gtauo.SetCloseAt(*t)
+	}
+	return gtauo
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearCloseAt() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearCloseAt()
+	return gtauo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gtauo *GroupTestAssignmentUpdateOne) SetGroup(g *Group) *GroupTestAssignmentUpdateOne {
+	return gtauo.SetGroupID(g.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (gtauo *GroupTestAssignmentUpdateOne) SetTest(t *Test) *GroupTestAssignmentUpdateOne {
+	return gtauo.SetTestID(t.ID)
+}
+
+// SetAssignedBy sets the "assigned_by" edge to the User entity.
+func (gtauo *GroupTestAssignmentUpdateOne) SetAssignedBy(u *User) *GroupTestAssignmentUpdateOne {
+	return gtauo.SetAssignedByID(u.ID)
+}
+
+// AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
+func (gtauo *GroupTestAssignmentUpdateOne) AddTestSessionIDs(ids ...uuid.UUID) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.AddTestSessionIDs(ids...)
+	return gtauo
+}
+
+// AddTestSessions adds the "test_sessions" edges to the TestSession entity.
+func (gtauo *GroupTestAssignmentUpdateOne) AddTestSessions(t ...*TestSession) *GroupTestAssignmentUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gtauo.AddTestSessionIDs(ids...)
+}
+
+// Mutation returns the GroupTestAssignmentMutation object of the builder.
+func (gtauo *GroupTestAssignmentUpdateOne) Mutation() *GroupTestAssignmentMutation {
+	return gtauo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearGroup() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearGroup()
+	return gtauo
+}
+
+// ClearTest clears the "test" edge to the Test entity.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearTest() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearTest()
+	return gtauo
+}
+
+// ClearAssignedBy clears the "assigned_by" edge to the User entity.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearAssignedBy() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearAssignedBy()
+	return gtauo
+}
+
+// ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
+func (gtauo *GroupTestAssignmentUpdateOne) ClearTestSessions() *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.ClearTestSessions()
+	return gtauo
+}
+
+// RemoveTestSessionIDs removes the "test_sessions" edge to TestSession entities by IDs.
+func (gtauo *GroupTestAssignmentUpdateOne) RemoveTestSessionIDs(ids ...uuid.UUID) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.RemoveTestSessionIDs(ids...)
+	return gtauo
+}
+
+// RemoveTestSessions removes "test_sessions" edges to TestSession entities.
+func (gtauo *GroupTestAssignmentUpdateOne) RemoveTestSessions(t ...*TestSession) *GroupTestAssignmentUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gtauo.RemoveTestSessionIDs(ids...)
+}
+
+// Where appends a list predicates to the GroupTestAssignmentUpdate builder.
+func (gtauo *GroupTestAssignmentUpdateOne) Where(ps ...predicate.GroupTestAssignment) *GroupTestAssignmentUpdateOne {
+	gtauo.mutation.Where(ps...)
+	return gtauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gtauo *GroupTestAssignmentUpdateOne) Select(field string, fields ...string) *GroupTestAssignmentUpdateOne {
+	gtauo.fields = append([]string{field}, fields...)
+	return gtauo
+}
+
+// Save executes the query and returns the updated GroupTestAssignment entity.
+func (gtauo *GroupTestAssignmentUpdateOne) Save(ctx context.Context) (*GroupTestAssignment, error) {
+	if err := gtauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, gtauo.sqlSave, gtauo.mutation, gtauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtauo *GroupTestAssignmentUpdateOne) SaveX(ctx context.Context) *GroupTestAssignment {
+	node, err := gtauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gtauo *GroupTestAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := gtauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtauo *GroupTestAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := gtauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtauo *GroupTestAssignmentUpdateOne) defaults() error {
+	if _, ok := gtauo.mutation.UpdatedAt(); !ok {
+		if grouptestassignment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized grouptestassignment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := grouptestassignment.UpdateDefaultUpdatedAt()
+		gtauo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gtauo *GroupTestAssignmentUpdateOne) check() error {
+	if gtauo.mutation.GroupCleared() && len(gtauo.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupTestAssignment.group"`)
+	}
+	if gtauo.mutation.TestCleared() && len(gtauo.mutation.TestIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupTestAssignment.test"`)
+	}
+	return nil
+}
+
+func (gtauo *GroupTestAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *GroupTestAssignment, err error) {
+	if err := gtauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grouptestassignment.Table, grouptestassignment.Columns, sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID))
+	id, ok := gtauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupTestAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gtauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grouptestassignment.FieldID)
+		for _, f := range fields {
+			if !grouptestassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != grouptestassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gtauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gtauo.mutation.CreatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := gtauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := gtauo.mutation.DeletedAt(); ok {
+		_spec.SetField(grouptestassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if gtauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := gtauo.mutation.IncludeLaterMembers(); ok {
+		_spec.SetField(grouptestassignment.FieldIncludeLaterMembers, field.TypeBool, value)
+	}
+	if value, ok := gtauo.mutation.ExpiredAt(); ok {
+		_spec.SetField(grouptestassignment.FieldExpiredAt, field.TypeTime, value)
+	}
+	if gtauo.mutation.ExpiredAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := gtauo.mutation.OpenAt(); ok {
+		_spec.SetField(grouptestassignment.FieldOpenAt, field.TypeTime, value)
+	}
+	if gtauo.mutation.OpenAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := gtauo.mutation.CloseAt(); ok {
+		_spec.SetField(grouptestassignment.FieldCloseAt, field.TypeTime, value)
+	}
+	if gtauo.mutation.CloseAtCleared() {
+		_spec.ClearField(grouptestassignment.FieldCloseAt, field.TypeTime)
+	}
+	if gtauo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.GroupTable,
+			Columns: []string{grouptestassignment.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtauo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.GroupTable,
+			Columns: []string{grouptestassignment.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtauo.mutation.TestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.TestTable,
+			Columns: []string{grouptestassignment.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtauo.mutation.TestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.TestTable,
+			Columns: []string{grouptestassignment.TestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtauo.mutation.AssignedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.AssignedByTable,
+			Columns: []string{grouptestassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtauo.mutation.AssignedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   grouptestassignment.AssignedByTable,
+			Columns: []string{grouptestassignment.AssignedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gtauo.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtauo.mutation.RemovedTestSessionsIDs(); len(nodes) > 0 && !gtauo.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gtauo.mutation.TestSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grouptestassignment.TestSessionsTable,
+			Columns: []string{grouptestassignment.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupTestAssignment{config: gtauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gtauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grouptestassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gtauo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,18 @@ import (
 	"template/internal/ent"
 )
 
+// The AccessGrantFunc type is an adapter to allow the use of ordinary
+// function as AccessGrant mutator.
+type AccessGrantFunc func(context.Context, *ent.AccessGrantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessGrantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessGrantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessGrantMutation", m)
+}
+
 // The CourseFunc type is an adapter to allow the use of ordinary
 // function as Course mutator.
 type CourseFunc func(context.Context, *ent.CourseMutation) (ent.Value, error)
@@ -20,6 +32,18 @@ func (f CourseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CourseMutation", m)
 }
 
+// The CourseEnrollmentFunc type is an adapter to allow the use of ordinary
+// function as CourseEnrollment mutator.
+type CourseEnrollmentFunc func(context.Context, *ent.CourseEnrollmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CourseEnrollmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CourseEnrollmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CourseEnrollmentMutation", m)
+}
+
 // The CourseSectionFunc type is an adapter to allow the use of ordinary
 // function as CourseSection mutator.
 type CourseSectionFunc func(context.Context, *ent.CourseSectionMutation) (ent.Value, error)
@@ -32,6 +56,42 @@ func (f CourseSectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CourseSectionMutation", m)
 }
 
+// The CourseSectionPrerequisiteFunc type is an adapter to allow the use of ordinary
+// function as CourseSectionPrerequisite mutator.
+type CourseSectionPrerequisiteFunc func(context.Context, *ent.CourseSectionPrerequisiteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CourseSectionPrerequisiteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CourseSectionPrerequisiteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CourseSectionPrerequisiteMutation", m)
+}
+
+// The GroupFunc type is an adapter to allow the use of ordinary
+// function as Group mutator.
+type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMutation", m)
+}
+
+// The GroupTestAssignmentFunc type is an adapter to allow the use of ordinary
+// function as GroupTestAssignment mutator.
+type GroupTestAssignmentFunc func(context.Context, *ent.GroupTestAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupTestAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupTestAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupTestAssignmentMutation", m)
+}
+
 // The JwtTokenFunc type is an adapter to allow the use of ordinary
 // function as JwtToken mutator.
 type JwtTokenFunc func(context.Context, *ent.JwtTokenMutation) (ent.Value, error)
@@ -56,6 +116,18 @@ func (f MediaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaMutation", m)
 }
 
+// The OrganizationFunc type is an adapter to allow the use of ordinary
+// function as Organization mutator.
+type OrganizationFunc func(context.Context, *ent.OrganizationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrganizationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrganizationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationMutation", m)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary
 // function as Permission mutator.
 type PermissionFunc func(context.Context, *ent.PermissionMutation) (ent.Value, error)
@@ -104,6 +176,30 @@ func (f QuestionOptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionOptionMutation", m)
 }
 
+// The QuestionVersionFunc type is an adapter to allow the use of ordinary
+// function as QuestionVersion mutator.
+type QuestionVersionFunc func(context.Context, *ent.QuestionVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestionVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuestionVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionVersionMutation", m)
+}
+
+// The QuestionVersionOptionFunc type is an adapter to allow the use of ordinary
+// function as QuestionVersionOption mutator.
+type QuestionVersionOptionFunc func(context.Context, *ent.QuestionVersionOptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestionVersionOptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuestionVersionOptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionVersionOptionMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -176,6 +272,54 @@ func (f TestSessionAnswerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestSessionAnswerMutation", m)
 }
 
+// The TestSessionBreakFunc type is an adapter to allow the use of ordinary
+// function as TestSessionBreak mutator.
+type TestSessionBreakFunc func(context.Context, *ent.TestSessionBreakMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestSessionBreakFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestSessionBreakMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestSessionBreakMutation", m)
+}
+
+// The TestSessionIntegrityEventFunc type is an adapter to allow the use of ordinary
+// function as TestSessionIntegrityEvent mutator.
+type TestSessionIntegrityEventFunc func(context.Context, *ent.TestSessionIntegrityEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestSessionIntegrityEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestSessionIntegrityEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestSessionIntegrityEventMutation", m)
+}
+
+// The TestSessionRegradeFunc type is an adapter to allow the use of ordinary
+// function as TestSessionRegrade mutator.
+type TestSessionRegradeFunc func(context.Context, *ent.TestSessionRegradeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestSessionRegradeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestSessionRegradeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestSessionRegradeMutation", m)
+}
+
+// The TestSessionTimeExtensionFunc type is an adapter to allow the use of ordinary
+// function as TestSessionTimeExtension mutator.
+type TestSessionTimeExtensionFunc func(context.Context, *ent.TestSessionTimeExtensionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestSessionTimeExtensionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestSessionTimeExtensionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestSessionTimeExtensionMutation", m)
+}
+
 // The TodoFunc type is an adapter to allow the use of ordinary
 // function as Todo mutator.
 type TodoFunc func(context.Context, *ent.TodoMutation) (ent.Value, error)
@@ -200,6 +344,30 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserAccommodationFunc type is an adapter to allow the use of ordinary
+// function as UserAccommodation mutator.
+type UserAccommodationFunc func(context.Context, *ent.UserAccommodationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserAccommodationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserAccommodationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserAccommodationMutation", m)
+}
+
+// The UserInviteFunc type is an adapter to allow the use of ordinary
+// function as UserInvite mutator.
+type UserInviteFunc func(context.Context, *ent.UserInviteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserInviteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserInviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserInviteMutation", m)
+}
+
 // The VideoFunc type is an adapter to allow the use of ordinary
 // function as Video mutator.
 type VideoFunc func(context.Context, *ent.VideoMutation) (ent.Value, error)
@@ -7,23 +7,37 @@ import (
 	"fmt"
 
 	"template/internal/ent"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/permission"
 	"template/internal/ent/predicate"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
 	"template/internal/ent/role"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/todo"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"template/internal/ent/video"
 	"template/internal/ent/videoquestiontimestamp"
 
@@ -86,6 +100,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The AccessGrantFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AccessGrantFunc func(context.Context, *ent.AccessGrantQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AccessGrantFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AccessGrantQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AccessGrantQuery", q)
+}
+
+// The TraverseAccessGrant type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAccessGrant func(context.Context, *ent.AccessGrantQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAccessGrant) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAccessGrant) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AccessGrantQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AccessGrantQuery", q)
+}
+
 // The CourseFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CourseFunc func(context.Context, *ent.CourseQuery) (ent.Value, error)
 
@@ -113,6 +154,33 @@ func (f TraverseCourse) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.CourseQuery", q)
 }
 
+// The CourseEnrollmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CourseEnrollmentFunc func(context.Context, *ent.CourseEnrollmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CourseEnrollmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CourseEnrollmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CourseEnrollmentQuery", q)
+}
+
+// The TraverseCourseEnrollment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCourseEnrollment func(context.Context, *ent.CourseEnrollmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCourseEnrollment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCourseEnrollment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CourseEnrollmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CourseEnrollmentQuery", q)
+}
+
 // The CourseSectionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CourseSectionFunc func(context.Context, *ent.CourseSectionQuery) (ent.Value, error)
 
@@ -140,6 +208,87 @@ func (f TraverseCourseSection) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.CourseSectionQuery", q)
 }
 
+// The CourseSectionPrerequisiteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CourseSectionPrerequisiteFunc func(context.Context, *ent.CourseSectionPrerequisiteQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CourseSectionPrerequisiteFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CourseSectionPrerequisiteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CourseSectionPrerequisiteQuery", q)
+}
+
+// The TraverseCourseSectionPrerequisite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCourseSectionPrerequisite func(context.Context, *ent.CourseSectionPrerequisiteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCourseSectionPrerequisite) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCourseSectionPrerequisite) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CourseSectionPrerequisiteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CourseSectionPrerequisiteQuery", q)
+}
+
+// The GroupFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GroupFunc func(context.Context, *ent.GroupQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GroupFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GroupQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GroupQuery", q)
+}
+
+// The TraverseGroup type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGroup func(context.Context, *ent.GroupQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGroup) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGroup) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GroupQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GroupQuery", q)
+}
+
+// The GroupTestAssignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GroupTestAssignmentFunc func(context.Context, *ent.GroupTestAssignmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GroupTestAssignmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GroupTestAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GroupTestAssignmentQuery", q)
+}
+
+// The TraverseGroupTestAssignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGroupTestAssignment func(context.Context, *ent.GroupTestAssignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGroupTestAssignment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGroupTestAssignment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GroupTestAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GroupTestAssignmentQuery", q)
+}
+
 // The JwtTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
 type JwtTokenFunc func(context.Context, *ent.JwtTokenQuery) (ent.Value, error)
 
@@ -194,6 +343,33 @@ func (f TraverseMedia) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.MediaQuery", q)
 }
 
+// The OrganizationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationFunc func(context.Context, *ent.OrganizationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OrganizationQuery", q)
+}
+
+// The TraverseOrganization type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganization func(context.Context, *ent.OrganizationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganization) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganization) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OrganizationQuery", q)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PermissionFunc func(context.Context, *ent.PermissionQuery) (ent.Value, error)
 
@@ -302,6 +478,60 @@ func (f TraverseQuestionOption) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.QuestionOptionQuery", q)
 }
 
+// The QuestionVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type QuestionVersionFunc func(context.Context, *ent.QuestionVersionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f QuestionVersionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.QuestionVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.QuestionVersionQuery", q)
+}
+
+// The TraverseQuestionVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseQuestionVersion func(context.Context, *ent.QuestionVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseQuestionVersion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseQuestionVersion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.QuestionVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.QuestionVersionQuery", q)
+}
+
+// The QuestionVersionOptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type QuestionVersionOptionFunc func(context.Context, *ent.QuestionVersionOptionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f QuestionVersionOptionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.QuestionVersionOptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.QuestionVersionOptionQuery", q)
+}
+
+// The TraverseQuestionVersionOption type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseQuestionVersionOption func(context.Context, *ent.QuestionVersionOptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseQuestionVersionOption) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseQuestionVersionOption) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.QuestionVersionOptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.QuestionVersionOptionQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *ent.RoleQuery) (ent.Value, error)
 
@@ -464,6 +694,114 @@ func (f TraverseTestSessionAnswer) Traverse(ctx context.Context, q ent.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *ent.TestSessionAnswerQuery", q)
 }
 
+// The TestSessionBreakFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TestSessionBreakFunc func(context.Context, *ent.TestSessionBreakQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TestSessionBreakFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TestSessionBreakQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TestSessionBreakQuery", q)
+}
+
+// The TraverseTestSessionBreak type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTestSessionBreak func(context.Context, *ent.TestSessionBreakQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTestSessionBreak) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTestSessionBreak) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TestSessionBreakQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TestSessionBreakQuery", q)
+}
+
+// The TestSessionIntegrityEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TestSessionIntegrityEventFunc func(context.Context, *ent.TestSessionIntegrityEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TestSessionIntegrityEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TestSessionIntegrityEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TestSessionIntegrityEventQuery", q)
+}
+
+// The TraverseTestSessionIntegrityEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTestSessionIntegrityEvent func(context.Context, *ent.TestSessionIntegrityEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTestSessionIntegrityEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTestSessionIntegrityEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TestSessionIntegrityEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TestSessionIntegrityEventQuery", q)
+}
+
+// The TestSessionRegradeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TestSessionRegradeFunc func(context.Context, *ent.TestSessionRegradeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TestSessionRegradeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TestSessionRegradeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TestSessionRegradeQuery", q)
+}
+
+// The TraverseTestSessionRegrade type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTestSessionRegrade func(context.Context, *ent.TestSessionRegradeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTestSessionRegrade) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTestSessionRegrade) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TestSessionRegradeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TestSessionRegradeQuery", q)
+}
+
+// The TestSessionTimeExtensionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TestSessionTimeExtensionFunc func(context.Context, *ent.TestSessionTimeExtensionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TestSessionTimeExtensionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TestSessionTimeExtensionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TestSessionTimeExtensionQuery", q)
+}
+
+// The TraverseTestSessionTimeExtension type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTestSessionTimeExtension func(context.Context, *ent.TestSessionTimeExtensionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTestSessionTimeExtension) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTestSessionTimeExtension) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TestSessionTimeExtensionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TestSessionTimeExtensionQuery", q)
+}
+
 // The TodoFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TodoFunc func(context.Context, *ent.TodoQuery) (ent.Value, error)
 
@@ -518,6 +856,60 @@ func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
 }
 
+// The UserAccommodationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserAccommodationFunc func(context.Context, *ent.UserAccommodationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserAccommodationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserAccommodationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserAccommodationQuery", q)
+}
+
+// The TraverseUserAccommodation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserAccommodation func(context.Context, *ent.UserAccommodationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserAccommodation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserAccommodation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserAccommodationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserAccommodationQuery", q)
+}
+
+// The UserInviteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserInviteFunc func(context.Context, *ent.UserInviteQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserInviteFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserInviteQuery", q)
+}
+
+// The TraverseUserInvite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserInvite func(context.Context, *ent.UserInviteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserInvite) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserInvite) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserInviteQuery", q)
+}
+
 // The VideoFunc type is an adapter to allow the use of ordinary function as a Querier.
 type VideoFunc func(context.Context, *ent.VideoQuery) (ent.Value, error)
 
@@ -575,14 +967,26 @@ func (f TraverseVideoQuestionTimestamp) Traverse(ctx context.Context, q ent.Quer
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.AccessGrantQuery:
+		return &query[*ent.AccessGrantQuery, predicate.AccessGrant, accessgrant.OrderOption]{typ: ent.TypeAccessGrant, tq: q}, nil
 	case *ent.CourseQuery:
 		return &query[*ent.CourseQuery, predicate.Course, course.OrderOption]{typ: ent.TypeCourse, tq: q}, nil
+	case *ent.CourseEnrollmentQuery:
+		return &query[*ent.CourseEnrollmentQuery, predicate.CourseEnrollment, courseenrollment.OrderOption]{typ: ent.TypeCourseEnrollment, tq: q}, nil
 	case *ent.CourseSectionQuery:
 		return &query[*ent.CourseSectionQuery, predicate.CourseSection, coursesection.OrderOption]{typ: ent.TypeCourseSection, tq: q}, nil
+	case *ent.CourseSectionPrerequisiteQuery:
+		return &query[*ent.CourseSectionPrerequisiteQuery, predicate.CourseSectionPrerequisite, coursesectionprerequisite.OrderOption]{typ: ent.TypeCourseSectionPrerequisite, tq: q}, nil
+	case *ent.GroupQuery:
+		return &query[*ent.GroupQuery, predicate.Group, group.OrderOption]{typ: ent.TypeGroup, tq: q}, nil
+	case *ent.GroupTestAssignmentQuery:
+		return &query[*ent.GroupTestAssignmentQuery, predicate.GroupTestAssignment, grouptestassignment.OrderOption]{typ: ent.TypeGroupTestAssignment, tq: q}, nil
 	case *ent.JwtTokenQuery:
 		return &query[*ent.JwtTokenQuery, predicate.JwtToken, jwttoken.OrderOption]{typ: ent.TypeJwtToken, tq: q}, nil
 	case *ent.MediaQuery:
 		return &query[*ent.MediaQuery, predicate.Media, media.OrderOption]{typ: ent.TypeMedia, tq: q}, nil
+	case *ent.OrganizationQuery:
+		return &query[*ent.OrganizationQuery, predicate.Organization, organization.OrderOption]{typ: ent.TypeOrganization, tq: q}, nil
 	case *ent.PermissionQuery:
 		return &query[*ent.PermissionQuery, predicate.Permission, permission.OrderOption]{typ: ent.TypePermission, tq: q}, nil
 	case *ent.QuestionQuery:
@@ -591,6 +995,10 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.QuestionCollectionQuery, predicate.QuestionCollection, questioncollection.OrderOption]{typ: ent.TypeQuestionCollection, tq: q}, nil
 	case *ent.QuestionOptionQuery:
 		return &query[*ent.QuestionOptionQuery, predicate.QuestionOption, questionoption.OrderOption]{typ: ent.TypeQuestionOption, tq: q}, nil
+	case *ent.QuestionVersionQuery:
+		return &query[*ent.QuestionVersionQuery, predicate.QuestionVersion, questionversion.OrderOption]{typ: ent.TypeQuestionVersion, tq: q}, nil
+	case *ent.QuestionVersionOptionQuery:
+		return &query[*ent.QuestionVersionOptionQuery, predicate.QuestionVersionOption, questionversionoption.OrderOption]{typ: ent.TypeQuestionVersionOption, tq: q}, nil
 	case *ent.RoleQuery:
 		return &query[*ent.RoleQuery, predicate.Role, role.OrderOption]{typ: ent.TypeRole, tq: q}, nil
 	case *ent.TestQuery:
@@ -603,10 +1011,22 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.TestSessionQuery, predicate.TestSession, testsession.OrderOption]{typ: ent.TypeTestSession, tq: q}, nil
 	case *ent.TestSessionAnswerQuery:
 		return &query[*ent.TestSessionAnswerQuery, predicate.TestSessionAnswer, testsessionanswer.OrderOption]{typ: ent.TypeTestSessionAnswer, tq: q}, nil
+	case *ent.TestSessionBreakQuery:
+		return &query[*ent.TestSessionBreakQuery, predicate.TestSessionBreak, testsessionbreak.OrderOption]{typ: ent.TypeTestSessionBreak, tq: q}, nil
+	case *ent.TestSessionIntegrityEventQuery:
+		return &query[*ent.TestSessionIntegrityEventQuery, predicate.TestSessionIntegrityEvent, testsessionintegrityevent.OrderOption]{typ: ent.TypeTestSessionIntegrityEvent, tq: q}, nil
+	case *ent.TestSessionRegradeQuery:
+		return &query[*ent.TestSessionRegradeQuery, predicate.TestSessionRegrade, testsessionregrade.OrderOption]{typ: ent.TypeTestSessionRegrade, tq: q}, nil
+	case *ent.TestSessionTimeExtensionQuery:
+		return &query[*ent.TestSessionTimeExtensionQuery, predicate.TestSessionTimeExtension, testsessiontimeextension.OrderOption]{typ: ent.TypeTestSessionTimeExtension, tq: q}, nil
 	case *ent.TodoQuery:
 		return &query[*ent.TodoQuery, predicate.Todo, todo.OrderOption]{typ: ent.TypeTodo, tq: q}, nil
 	case *ent.UserQuery:
 		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
+	case *ent.UserAccommodationQuery:
+		return &query[*ent.UserAccommodationQuery, predicate.UserAccommodation, useraccommodation.OrderOption]{typ: ent.TypeUserAccommodation, tq: q}, nil
+	case *ent.UserInviteQuery:
+		return &query[*ent.UserInviteQuery, predicate.UserInvite, userinvite.OrderOption]{typ: ent.TypeUserInvite, tq: q}, nil
 	case *ent.VideoQuery:
 		return &query[*ent.VideoQuery, predicate.Video, video.OrderOption]{typ: ent.TypeVideo, tq: q}, nil
 	case *ent.VideoQuestionTimestampQuery:
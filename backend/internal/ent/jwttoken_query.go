@@ -11,6 +11,7 @@ import (
 	"template/internal/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type JwtTokenQuery struct {
 	inters     []Interceptor
 	predicates []predicate.JwtToken
 	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -384,6 +386,9 @@ func (jtq *JwtTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Jw
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(jtq.modifiers) > 0 {
+		_spec.Modifiers = jtq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,6 +439,9 @@ func (jtq *JwtTokenQuery) loadUser(ctx context.Context, query *UserQuery, nodes
 
 func (jtq *JwtTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := jtq.querySpec()
+	if len(jtq.modifiers) > 0 {
+		_spec.Modifiers = jtq.modifiers
+	}
 	_spec.Node.Columns = jtq.ctx.Fields
 	if len(jtq.ctx.Fields) > 0 {
 		_spec.Unique = jtq.ctx.Unique != nil && *jtq.ctx.Unique
@@ -499,6 +507,9 @@ func (jtq *JwtTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if jtq.ctx.Unique != nil && *jtq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range jtq.modifiers {
+		m(selector)
+	}
 	for _, p := range jtq.predicates {
 		p(selector)
 	}
@@ -516,6 +527,32 @@ func (jtq *JwtTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jtq *JwtTokenQuery) ForUpdate(opts ...sql.LockOption) *JwtTokenQuery {
+	if jtq.driver.Dialect() == dialect.Postgres {
+		jtq.Unique(false)
+	}
+	jtq.modifiers = append(jtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jtq *JwtTokenQuery) ForShare(opts ...sql.LockOption) *JwtTokenQuery {
+	if jtq.driver.Dialect() == dialect.Postgres {
+		jtq.Unique(false)
+	}
+	jtq.modifiers = append(jtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jtq
+}
+
 // JwtTokenGroupBy is the group-by builder for JwtToken entities.
 type JwtTokenGroupBy struct {
 	selector
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/user"
 	"time"
 
@@ -26,6 +27,8 @@ type Media struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	// FileName holds the value of the "file_name" field.
 	FileName string `json:"file_name,omitempty"`
 	// FileURL holds the value of the "file_url" field.
@@ -44,6 +47,8 @@ type Media struct {
 
 // MediaEdges holds the relations/edges for other nodes in the graph.
 type MediaEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// UserMedia holds the value of the user_media edge.
 	UserMedia []*User `json:"user_media,omitempty"`
 	// User holds the value of the user edge.
@@ -54,13 +59,24 @@ type MediaEdges struct {
 	VideoMedia []*Video `json:"video_media,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // UserMediaOrErr returns the UserMedia value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) UserMediaOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.UserMedia, nil
 	}
 	return nil, &NotLoadedError{edge: "user_media"}
@@ -71,7 +87,7 @@ func (e MediaEdges) UserMediaOrErr() ([]*User, error) {
 func (e MediaEdges) UserOrErr() (*User, error) {
 	if e.User != nil {
 		return e.User, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
@@ -80,7 +96,7 @@ func (e MediaEdges) UserOrErr() (*User, error) {
 // CourseMediaOrErr returns the CourseMedia value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) CourseMediaOrErr() ([]*Course, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.CourseMedia, nil
 	}
 	return nil, &NotLoadedError{edge: "course_media"}
@@ -89,7 +105,7 @@ func (e MediaEdges) CourseMediaOrErr() ([]*Course, error) {
 // VideoMediaOrErr returns the VideoMedia value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) VideoMediaOrErr() ([]*Video, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.VideoMedia, nil
 	}
 	return nil, &NotLoadedError{edge: "video_media"}
@@ -100,7 +116,7 @@ func (*Media) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case media.FieldUploaderID:
+		case media.FieldOrganizationID, media.FieldUploaderID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case media.FieldMetadata:
 			values[i] = new([]byte)
@@ -150,6 +166,13 @@ func (m *Media) assignValues(columns []string, values []any) error {
 				m.DeletedAt = new(time.Time)
 				*m.DeletedAt = value.Time
 			}
+		case media.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				m.OrganizationID = new(uuid.UUID)
+				*m.OrganizationID = *value.S.(*uuid.UUID)
+			}
 		case media.FieldFileName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field file_name", values[i])
@@ -196,6 +219,11 @@ func (m *Media) Value(name string) (ent.Value, error) {
 	return m.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the Media entity.
+func (m *Media) QueryOrganization() *OrganizationQuery {
+	return NewMediaClient(m.config).QueryOrganization(m)
+}
+
 // QueryUserMedia queries the "user_media" edge of the Media entity.
 func (m *Media) QueryUserMedia() *UserQuery {
 	return NewMediaClient(m.config).QueryUserMedia(m)
@@ -250,6 +278,11 @@ func (m *Media) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("file_name=")
 	builder.WriteString(m.FileName)
 	builder.WriteString(", ")
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"template/internal/ent/course"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/user"
 	"template/internal/ent/video"
 	"time"
@@ -66,6 +67,20 @@ func (mc *MediaCreate) SetNillableDeletedAt(t *time.Time) *MediaCreate {
 	return mc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (mc *MediaCreate) SetOrganizationID(u uuid.UUID) *MediaCreate {
+	mc.mutation.SetOrganizationID(u)
+	return mc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableOrganizationID(u *uuid.UUID) *MediaCreate {
+	if u != nil {
+		mc.SetOrganizationID(*u)
+	}
+	return mc
+}
+
 // SetFileName sets the "file_name" field.
 func (mc *MediaCreate) SetFileName(s string) *MediaCreate {
 	mc.mutation.SetFileName(s)
@@ -118,6 +133,11 @@ func (mc *MediaCreate) SetNillableID(u *uuid.UUID) *MediaCreate {
 	return mc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (mc *MediaCreate) SetOrganization(o *Organization) *MediaCreate {
+	return mc.SetOrganizationID(o.ID)
+}
+
 // AddUserMediumIDs adds the "user_media" edge to the User entity by IDs.
 func (mc *MediaCreate) AddUserMediumIDs(ids ...uuid.UUID) *MediaCreate {
 	mc.mutation.AddUserMediumIDs(ids...)
@@ -338,6 +358,23 @@ func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		_spec.SetField(media.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if nodes := mc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.OrganizationTable,
+			Columns: []string{media.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := mc.mutation.UserMediaIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -9,11 +9,13 @@ import (
 	"math"
 	"template/internal/ent/course"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/user"
 	"template/internal/ent/video"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,14 +25,16 @@ import (
 // MediaQuery is the builder for querying Media entities.
 type MediaQuery struct {
 	config
-	ctx             *QueryContext
-	order           []media.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Media
-	withUserMedia   *UserQuery
-	withUser        *UserQuery
-	withCourseMedia *CourseQuery
-	withVideoMedia  *VideoQuery
+	ctx              *QueryContext
+	order            []media.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Media
+	withOrganization *OrganizationQuery
+	withUserMedia    *UserQuery
+	withUser         *UserQuery
+	withCourseMedia  *CourseQuery
+	withVideoMedia   *VideoQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -67,6 +71,28 @@ func (mq *MediaQuery) Order(o ...media.OrderOption) *MediaQuery {
 	return mq
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (mq *MediaQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.OrganizationTable, media.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUserMedia chains the current query on the "user_media" edge.
 func (mq *MediaQuery) QueryUserMedia() *UserQuery {
 	query := (&UserClient{config: mq.config}).Query()
@@ -342,21 +368,33 @@ func (mq *MediaQuery) Clone() *MediaQuery {
 		return nil
 	}
 	return &MediaQuery{
-		config:          mq.config,
-		ctx:             mq.ctx.Clone(),
-		order:           append([]media.OrderOption{}, mq.order...),
-		inters:          append([]Interceptor{}, mq.inters...),
-		predicates:      append([]predicate.Media{}, mq.predicates...),
-		withUserMedia:   mq.withUserMedia.Clone(),
-		withUser:        mq.withUser.Clone(),
-		withCourseMedia: mq.withCourseMedia.Clone(),
-		withVideoMedia:  mq.withVideoMedia.Clone(),
+		config:           mq.config,
+		ctx:              mq.ctx.Clone(),
+		order:            append([]media.OrderOption{}, mq.order...),
+		inters:           append([]Interceptor{}, mq.inters...),
+		predicates:       append([]predicate.Media{}, mq.predicates...),
+		withOrganization: mq.withOrganization.Clone(),
+		withUserMedia:    mq.withUserMedia.Clone(),
+		withUser:         mq.withUser.Clone(),
+		withCourseMedia:  mq.withCourseMedia.Clone(),
+		withVideoMedia:   mq.withVideoMedia.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithOrganization(opts ...func(*OrganizationQuery)) *MediaQuery {
+	query := (&OrganizationClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withOrganization = query
+	return mq
+}
+
 // WithUserMedia tells the query-builder to eager-load the nodes that are connected to
 // the "user_media" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MediaQuery) WithUserMedia(opts ...func(*UserQuery)) *MediaQuery {
@@ -479,7 +517,8 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	var (
 		nodes       = []*Media{}
 		_spec       = mq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
+			mq.withOrganization != nil,
 			mq.withUserMedia != nil,
 			mq.withUser != nil,
 			mq.withCourseMedia != nil,
@@ -495,6 +534,9 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(mq.modifiers) > 0 {
+		_spec.Modifiers = mq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +546,12 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := mq.withOrganization; query != nil {
+		if err := mq.loadOrganization(ctx, query, nodes, nil,
+			func(n *Media, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := mq.withUserMedia; query != nil {
 		if err := mq.loadUserMedia(ctx, query, nodes,
 			func(n *Media) { n.Edges.UserMedia = []*User{} },
@@ -534,6 +582,38 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	return nodes, nil
 }
 
+func (mq *MediaQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Media, init func(*Media), assign func(*Media, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Media)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (mq *MediaQuery) loadUserMedia(ctx context.Context, query *UserQuery, nodes []*Media, init func(*Media), assign func(*Media, *User)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Media)
@@ -665,6 +745,9 @@ func (mq *MediaQuery) loadVideoMedia(ctx context.Context, query *VideoQuery, nod
 
 func (mq *MediaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
+	if len(mq.modifiers) > 0 {
+		_spec.Modifiers = mq.modifiers
+	}
 	_spec.Node.Columns = mq.ctx.Fields
 	if len(mq.ctx.Fields) > 0 {
 		_spec.Unique = mq.ctx.Unique != nil && *mq.ctx.Unique
@@ -688,6 +771,9 @@ func (mq *MediaQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if mq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(media.FieldOrganizationID)
+		}
 		if mq.withUser != nil {
 			_spec.Node.AddColumnOnce(media.FieldUploaderID)
 		}
@@ -730,6 +816,9 @@ func (mq *MediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if mq.ctx.Unique != nil && *mq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range mq.modifiers {
+		m(selector)
+	}
 	for _, p := range mq.predicates {
 		p(selector)
 	}
@@ -747,6 +836,32 @@ func (mq *MediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mq *MediaQuery) ForUpdate(opts ...sql.LockOption) *MediaQuery {
+	if mq.driver.Dialect() == dialect.Postgres {
+		mq.Unique(false)
+	}
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mq *MediaQuery) ForShare(opts ...sql.LockOption) *MediaQuery {
+	if mq.driver.Dialect() == dialect.Postgres {
+		mq.Unique(false)
+	}
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mq
+}
+
 // MediaGroupBy is the group-by builder for Media entities.
 type MediaGroupBy struct {
 	selector
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"template/internal/ent/course"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/user"
 	"template/internal/ent/video"
@@ -72,6 +73,26 @@ func (mu *MediaUpdate) ClearDeletedAt() *MediaUpdate {
 	return mu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (mu *MediaUpdate) SetOrganizationID(u uuid.UUID) *MediaUpdate {
+	mu.mutation.SetOrganizationID(u)
+	return mu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableOrganizationID(u *uuid.UUID) *MediaUpdate {
+	if u != nil {
+		mu.SetOrganizationID(*u)
+	}
+	return mu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (mu *MediaUpdate) ClearOrganizationID() *MediaUpdate {
+	mu.mutation.ClearOrganizationID()
+	return mu
+}
+
 // SetFileName sets the "file_name" field.
 func (mu *MediaUpdate) SetFileName(s string) *MediaUpdate {
 	mu.mutation.SetFileName(s)
@@ -146,6 +167,11 @@ func (mu *MediaUpdate) ClearMetadata() *MediaUpdate {
 	return mu
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (mu *MediaUpdate) SetOrganization(o *Organization) *MediaUpdate {
+	return mu.SetOrganizationID(o.ID)
+}
+
 // AddUserMediumIDs adds the "user_media" edge to the User entity by IDs.
 func (mu *MediaUpdate) AddUserMediumIDs(ids ...uuid.UUID) *MediaUpdate {
 	mu.mutation.AddUserMediumIDs(ids...)
@@ -215,6 +241,12 @@ func (mu *MediaUpdate) Mutation() *MediaMutation {
 	return mu.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (mu *MediaUpdate) ClearOrganization() *MediaUpdate {
+	mu.mutation.ClearOrganization()
+	return mu
+}
+
 // ClearUserMedia clears all "user_media" edges to the User entity.
 func (mu *MediaUpdate) ClearUserMedia() *MediaUpdate {
 	mu.mutation.ClearUserMedia()
@@ -385,6 +417,35 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.MetadataCleared() {
 		_spec.ClearField(media.FieldMetadata, field.TypeJSON)
 	}
+	if mu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.OrganizationTable,
+			Columns: []string{media.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.OrganizationTable,
+			Columns: []string{media.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if mu.mutation.UserMediaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -609,6 +670,26 @@ func (muo *MediaUpdateOne) ClearDeletedAt() *MediaUpdateOne {
 	return muo
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (muo *MediaUpdateOne) SetOrganizationID(u uuid.UUID) *MediaUpdateOne {
+	muo.mutation.SetOrganizationID(u)
+	return muo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *MediaUpdateOne {
+	if u != nil {
+		muo.SetOrganizationID(*u)
+	}
+	return muo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (muo *MediaUpdateOne) ClearOrganizationID() *MediaUpdateOne {
+	muo.mutation.ClearOrganizationID()
+	return muo
+}
+
 // SetFileName sets the "file_name" field.
 func (muo *MediaUpdateOne) SetFileName(s string) *MediaUpdateOne {
 	muo.mutation.SetFileName(s)
@@ -683,6 +764,11 @@ func (muo *MediaUpdateOne) ClearMetadata() *MediaUpdateOne {
 	return muo
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (muo *MediaUpdateOne) SetOrganization(o *Organization) *MediaUpdateOne {
+	return muo.SetOrganizationID(o.ID)
+}
+
 // AddUserMediumIDs adds the "user_media" edge to the User entity by IDs.
 func (muo *MediaUpdateOne) AddUserMediumIDs(ids ...uuid.UUID) *MediaUpdateOne {
 	muo.mutation.AddUserMediumIDs(ids...)
@@ -752,6 +838,12 @@ func (muo *MediaUpdateOne) Mutation() *MediaMutation {
 	return muo.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (muo *MediaUpdateOne) ClearOrganization() *MediaUpdateOne {
+	muo.mutation.ClearOrganization()
+	return muo
+}
+
 // ClearUserMedia clears all "user_media" edges to the User entity.
 func (muo *MediaUpdateOne) ClearUserMedia() *MediaUpdateOne {
 	muo.mutation.ClearUserMedia()
@@ -952,6 +1044,35 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 	if muo.mutation.MetadataCleared() {
 		_spec.ClearField(media.FieldMetadata, field.TypeJSON)
 	}
+	if muo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.OrganizationTable,
+			Columns: []string{media.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.OrganizationTable,
+			Columns: []string{media.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if muo.mutation.UserMediaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3,11 +3,114 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccessGrantsColumns holds the columns for the "access_grants" table.
+	AccessGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "editor"}},
+		{Name: "course_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "question_collection_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "test_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "granted_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AccessGrantsTable holds the schema information for the "access_grants" table.
+	AccessGrantsTable = &schema.Table{
+		Name:       "access_grants",
+		Columns:    AccessGrantsColumns,
+		PrimaryKey: []*schema.Column{AccessGrantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_grants_courses_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[5]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_grants_groups_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[6]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_grants_organizations_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "access_grants_question_collections_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[8]},
+				RefColumns: []*schema.Column{QuestionCollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_grants_tests_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[9]},
+				RefColumns: []*schema.Column{TestsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_grants_users_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_grants_users_granted_access_grants",
+				Columns:    []*schema.Column{AccessGrantsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accessgrant_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessGrantsColumns[7]},
+			},
+			{
+				Name:    "accessgrant_user_id_test_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[10], AccessGrantsColumns[9]},
+			},
+			{
+				Name:    "accessgrant_user_id_course_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[10], AccessGrantsColumns[5]},
+			},
+			{
+				Name:    "accessgrant_user_id_question_collection_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[10], AccessGrantsColumns[8]},
+			},
+			{
+				Name:    "accessgrant_group_id_test_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[6], AccessGrantsColumns[9]},
+			},
+			{
+				Name:    "accessgrant_group_id_course_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[6], AccessGrantsColumns[5]},
+			},
+			{
+				Name:    "accessgrant_group_id_question_collection_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessGrantsColumns[6], AccessGrantsColumns[8]},
+			},
+		},
+	}
 	// CoursesColumns holds the columns for the "courses" table.
 	CoursesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -17,7 +120,11 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "is_published", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "review", "published", "archived"}, Default: "draft"},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
 		{Name: "media_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "creator_id", Type: field.TypeUUID},
 	}
 	// CoursesTable holds the schema information for the "courses" table.
@@ -28,17 +135,69 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "courses_media_course_media",
-				Columns:    []*schema.Column{CoursesColumns[7]},
+				Columns:    []*schema.Column{CoursesColumns[10]},
 				RefColumns: []*schema.Column{MediaColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "courses_organizations_courses",
+				Columns:    []*schema.Column{CoursesColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "courses_users_course_creator",
-				Columns:    []*schema.Column{CoursesColumns[8]},
+				Columns:    []*schema.Column{CoursesColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "course_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{CoursesColumns[11]},
+			},
+		},
+	}
+	// CourseEnrollmentsColumns holds the columns for the "course_enrollments" table.
+	CourseEnrollmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"learner", "instructor", "teaching_assistant"}, Default: "learner"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "suspended", "completed"}, Default: "active"},
+		{Name: "enrolled_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "course_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CourseEnrollmentsTable holds the schema information for the "course_enrollments" table.
+	CourseEnrollmentsTable = &schema.Table{
+		Name:       "course_enrollments",
+		Columns:    CourseEnrollmentsColumns,
+		PrimaryKey: []*schema.Column{CourseEnrollmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "course_enrollments_courses_enrollments",
+				Columns:    []*schema.Column{CourseEnrollmentsColumns[7]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "course_enrollments_users_course_enrollments",
+				Columns:    []*schema.Column{CourseEnrollmentsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "courseenrollment_course_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CourseEnrollmentsColumns[7], CourseEnrollmentsColumns[8]},
+			},
+		},
 	}
 	// CourseSectionsColumns holds the columns for the "course_sections" table.
 	CourseSectionsColumns = []*schema.Column{
@@ -72,6 +231,128 @@ var (
 			},
 		},
 	}
+	// CourseSectionPrerequisitesColumns holds the columns for the "course_section_prerequisites" table.
+	CourseSectionPrerequisitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "min_score_percent", Type: field.TypeInt, Nullable: true},
+		{Name: "section_id", Type: field.TypeUUID},
+		{Name: "prerequisite_section_id", Type: field.TypeUUID},
+	}
+	// CourseSectionPrerequisitesTable holds the schema information for the "course_section_prerequisites" table.
+	CourseSectionPrerequisitesTable = &schema.Table{
+		Name:       "course_section_prerequisites",
+		Columns:    CourseSectionPrerequisitesColumns,
+		PrimaryKey: []*schema.Column{CourseSectionPrerequisitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "course_section_prerequisites_course_sections_prerequisites",
+				Columns:    []*schema.Column{CourseSectionPrerequisitesColumns[5]},
+				RefColumns: []*schema.Column{CourseSectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "course_section_prerequisites_course_sections_required_by",
+				Columns:    []*schema.Column{CourseSectionPrerequisitesColumns[6]},
+				RefColumns: []*schema.Column{CourseSectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coursesectionprerequisite_section_id_prerequisite_section_id",
+				Unique:  true,
+				Columns: []*schema.Column{CourseSectionPrerequisitesColumns[5], CourseSectionPrerequisitesColumns[6]},
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "creator_id", Type: field.TypeUUID},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "groups_organizations_groups",
+				Columns:    []*schema.Column{GroupsColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "groups_users_created_groups",
+				Columns:    []*schema.Column{GroupsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupsColumns[6]},
+			},
+		},
+	}
+	// GroupTestAssignmentsColumns holds the columns for the "group_test_assignments" table.
+	GroupTestAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "include_later_members", Type: field.TypeBool, Default: false},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "open_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "close_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "test_id", Type: field.TypeUUID},
+		{Name: "assigned_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// GroupTestAssignmentsTable holds the schema information for the "group_test_assignments" table.
+	GroupTestAssignmentsTable = &schema.Table{
+		Name:       "group_test_assignments",
+		Columns:    GroupTestAssignmentsColumns,
+		PrimaryKey: []*schema.Column{GroupTestAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_test_assignments_groups_test_assignments",
+				Columns:    []*schema.Column{GroupTestAssignmentsColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_test_assignments_tests_group_assignments",
+				Columns:    []*schema.Column{GroupTestAssignmentsColumns[9]},
+				RefColumns: []*schema.Column{TestsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_test_assignments_users_group_test_assignments",
+				Columns:    []*schema.Column{GroupTestAssignmentsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "grouptestassignment_group_id_test_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupTestAssignmentsColumns[8], GroupTestAssignmentsColumns[9]},
+			},
+		},
+	}
 	// JwtTokensColumns holds the columns for the "jwt_tokens" table.
 	JwtTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -107,6 +388,7 @@ var (
 		{Name: "file_url", Type: field.TypeString},
 		{Name: "mime_type", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "uploader_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MediaTable holds the schema information for the "media" table.
@@ -116,12 +398,41 @@ var (
 		PrimaryKey: []*schema.Column{MediaColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "media_users_media_uploader",
+				Symbol:     "media_organizations_media",
 				Columns:    []*schema.Column{MediaColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "media_users_media_uploader",
+				Columns:    []*schema.Column{MediaColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "media_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{MediaColumns[8]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
 	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
@@ -146,6 +457,7 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
 		{Name: "question_text", Type: field.TypeString, Size: 2147483647},
 		{Name: "points", Type: field.TypeInt, Default: 0},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "collection_id", Type: field.TypeUUID},
 	}
 	// QuestionsTable holds the schema information for the "questions" table.
@@ -156,7 +468,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "questions_question_collections_questions",
-				Columns:    []*schema.Column{QuestionsColumns[6]},
+				Columns:    []*schema.Column{QuestionsColumns[7]},
 				RefColumns: []*schema.Column{QuestionCollectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -171,6 +483,7 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "course_section_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "creator_id", Type: field.TypeUUID},
 	}
 	// QuestionCollectionsTable holds the schema information for the "question_collections" table.
@@ -186,12 +499,25 @@ var (
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "question_collections_users_question_collections",
+				Symbol:     "question_collections_organizations_question_collections",
 				Columns:    []*schema.Column{QuestionCollectionsColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "question_collections_users_question_collections",
+				Columns:    []*schema.Column{QuestionCollectionsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questioncollection_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionCollectionsColumns[7]},
+			},
+		},
 	}
 	// QuestionOptionsColumns holds the columns for the "question_options" table.
 	QuestionOptionsColumns = []*schema.Column{
@@ -201,6 +527,7 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
 		{Name: "option_text", Type: field.TypeString, Size: 2147483647},
 		{Name: "is_correct", Type: field.TypeBool, Default: false},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "question_id", Type: field.TypeUUID},
 	}
 	// QuestionOptionsTable holds the schema information for the "question_options" table.
@@ -211,12 +538,79 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "question_options_questions_question_options",
-				Columns:    []*schema.Column{QuestionOptionsColumns[6]},
+				Columns:    []*schema.Column{QuestionOptionsColumns[7]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// QuestionVersionsColumns holds the columns for the "question_versions" table.
+	QuestionVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "question_text", Type: field.TypeString, Size: 2147483647},
+		{Name: "points", Type: field.TypeInt},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "question_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// QuestionVersionsTable holds the schema information for the "question_versions" table.
+	QuestionVersionsTable = &schema.Table{
+		Name:       "question_versions",
+		Columns:    QuestionVersionsColumns,
+		PrimaryKey: []*schema.Column{QuestionVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "question_versions_questions_versions",
+				Columns:    []*schema.Column{QuestionVersionsColumns[8]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "question_versions_users_question_versions",
+				Columns:    []*schema.Column{QuestionVersionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionversion_question_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{QuestionVersionsColumns[8], QuestionVersionsColumns[4]},
+			},
+		},
+	}
+	// QuestionVersionOptionsColumns holds the columns for the "question_version_options" table.
+	QuestionVersionOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "option_id", Type: field.TypeUUID},
+		{Name: "option_text", Type: field.TypeString, Size: 2147483647},
+		{Name: "is_correct", Type: field.TypeBool},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "order", Type: field.TypeInt},
+		{Name: "version_id", Type: field.TypeUUID},
+	}
+	// QuestionVersionOptionsTable holds the schema information for the "question_version_options" table.
+	QuestionVersionOptionsTable = &schema.Table{
+		Name:       "question_version_options",
+		Columns:    QuestionVersionOptionsColumns,
+		PrimaryKey: []*schema.Column{QuestionVersionOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "question_version_options_question_versions_options",
+				Columns:    []*schema.Column{QuestionVersionOptionsColumns[9]},
+				RefColumns: []*schema.Column{QuestionVersionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -241,8 +635,16 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "total_points", Type: field.TypeInt, Default: 0},
 		{Name: "total_time", Type: field.TypeInt, Nullable: true},
+		{Name: "open_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "close_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "attempt_cooldown_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "scoring_policy", Type: field.TypeEnum, Enums: []string{"highest", "latest", "average"}, Default: "highest"},
+		{Name: "feedback_release", Type: field.TypeEnum, Enums: []string{"immediately", "after_close", "never"}, Default: "immediately"},
 		{Name: "course_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "course_section_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "creator_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TestsTable holds the schema information for the "tests" table.
 	TestsTable = &schema.Table{
@@ -252,16 +654,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tests_courses_tests",
-				Columns:    []*schema.Column{TestsColumns[7]},
+				Columns:    []*schema.Column{TestsColumns[13]},
 				RefColumns: []*schema.Column{CoursesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "tests_course_sections_tests",
-				Columns:    []*schema.Column{TestsColumns[8]},
+				Columns:    []*schema.Column{TestsColumns[14]},
 				RefColumns: []*schema.Column{CourseSectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "tests_organizations_tests",
+				Columns:    []*schema.Column{TestsColumns[15]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tests_users_created_tests",
+				Columns:    []*schema.Column{TestsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "test_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{TestsColumns[15]},
+			},
 		},
 	}
 	// TestIgnoreQuestionsColumns holds the columns for the "test_ignore_questions" table.
@@ -327,10 +748,20 @@ var (
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "expired_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_activity_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "time_multiplier", Type: field.TypeFloat64, Nullable: true},
+		{Name: "extra_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "allow_rest_breaks", Type: field.TypeBool, Nullable: true},
+		{Name: "max_rest_breaks", Type: field.TypeInt, Nullable: true},
+		{Name: "max_rest_break_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "open_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "close_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
 		{Name: "max_points", Type: field.TypeInt, Default: 0},
 		{Name: "points_earned", Type: field.TypeInt, Default: 0},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "in_progress", "cancelled", "expired"}, Default: "pending"},
 		{Name: "course_section_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_assignment_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "test_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
 	}
@@ -342,19 +773,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "test_sessions_course_sections_test_sessions",
-				Columns:    []*schema.Column{TestSessionsColumns[10]},
+				Columns:    []*schema.Column{TestSessionsColumns[19]},
 				RefColumns: []*schema.Column{CourseSectionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "test_sessions_group_test_assignments_test_sessions",
+				Columns:    []*schema.Column{TestSessionsColumns[20]},
+				RefColumns: []*schema.Column{GroupTestAssignmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "test_sessions_tests_test_sessions",
-				Columns:    []*schema.Column{TestSessionsColumns[11]},
+				Columns:    []*schema.Column{TestSessionsColumns[21]},
 				RefColumns: []*schema.Column{TestsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "test_sessions_users_test_sessions",
-				Columns:    []*schema.Column{TestSessionsColumns[12]},
+				Columns:    []*schema.Column{TestSessionsColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -371,6 +808,7 @@ var (
 		{Name: "is_correct", Type: field.TypeBool, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "question_id", Type: field.TypeUUID},
+		{Name: "question_version_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "session_id", Type: field.TypeUUID},
 	}
 	// TestSessionAnswersTable holds the schema information for the "test_session_answers" table.
@@ -383,16 +821,137 @@ var (
 				Symbol:     "test_session_answers_questions_test_session_answers",
 				Columns:    []*schema.Column{TestSessionAnswersColumns[8]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.Cascade,
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "test_session_answers_test_sessions_test_session_answers",
+				Symbol:     "test_session_answers_question_versions_test_session_answers",
 				Columns:    []*schema.Column{TestSessionAnswersColumns[9]},
+				RefColumns: []*schema.Column{QuestionVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "test_session_answers_test_sessions_test_session_answers",
+				Columns:    []*schema.Column{TestSessionAnswersColumns[10]},
+				RefColumns: []*schema.Column{TestSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TestSessionBreaksColumns holds the columns for the "test_session_breaks" table.
+	TestSessionBreaksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "session_id", Type: field.TypeUUID},
+	}
+	// TestSessionBreaksTable holds the schema information for the "test_session_breaks" table.
+	TestSessionBreaksTable = &schema.Table{
+		Name:       "test_session_breaks",
+		Columns:    TestSessionBreaksColumns,
+		PrimaryKey: []*schema.Column{TestSessionBreaksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_session_breaks_test_sessions_breaks",
+				Columns:    []*schema.Column{TestSessionBreaksColumns[6]},
+				RefColumns: []*schema.Column{TestSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TestSessionIntegrityEventsColumns holds the columns for the "test_session_integrity_events" table.
+	TestSessionIntegrityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"tab_switch", "focus_lost", "fullscreen_exit", "copy_paste", "other"}},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "session_id", Type: field.TypeUUID},
+	}
+	// TestSessionIntegrityEventsTable holds the schema information for the "test_session_integrity_events" table.
+	TestSessionIntegrityEventsTable = &schema.Table{
+		Name:       "test_session_integrity_events",
+		Columns:    TestSessionIntegrityEventsColumns,
+		PrimaryKey: []*schema.Column{TestSessionIntegrityEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_session_integrity_events_test_sessions_integrity_events",
+				Columns:    []*schema.Column{TestSessionIntegrityEventsColumns[7]},
 				RefColumns: []*schema.Column{TestSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// TestSessionRegradesColumns holds the columns for the "test_session_regrades" table.
+	TestSessionRegradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"question", "test"}},
+		{Name: "question_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "points_before", Type: field.TypeInt},
+		{Name: "points_after", Type: field.TypeInt},
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "regraded_by_id", Type: field.TypeUUID},
+	}
+	// TestSessionRegradesTable holds the schema information for the "test_session_regrades" table.
+	TestSessionRegradesTable = &schema.Table{
+		Name:       "test_session_regrades",
+		Columns:    TestSessionRegradesColumns,
+		PrimaryKey: []*schema.Column{TestSessionRegradesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_session_regrades_test_sessions_regrades",
+				Columns:    []*schema.Column{TestSessionRegradesColumns[8]},
+				RefColumns: []*schema.Column{TestSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "test_session_regrades_users_test_session_regrades",
+				Columns:    []*schema.Column{TestSessionRegradesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TestSessionTimeExtensionsColumns holds the columns for the "test_session_time_extensions" table.
+	TestSessionTimeExtensionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "minutes", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "previous_expired_at", Type: field.TypeTime},
+		{Name: "new_expired_at", Type: field.TypeTime},
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "extended_by_id", Type: field.TypeUUID},
+	}
+	// TestSessionTimeExtensionsTable holds the schema information for the "test_session_time_extensions" table.
+	TestSessionTimeExtensionsTable = &schema.Table{
+		Name:       "test_session_time_extensions",
+		Columns:    TestSessionTimeExtensionsColumns,
+		PrimaryKey: []*schema.Column{TestSessionTimeExtensionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_session_time_extensions_test_sessions_time_extensions",
+				Columns:    []*schema.Column{TestSessionTimeExtensionsColumns[8]},
+				RefColumns: []*schema.Column{TestSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "test_session_time_extensions_users_test_session_time_extensions",
+				Columns:    []*schema.Column{TestSessionTimeExtensionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -420,7 +979,9 @@ var (
 		{Name: "first_name", Type: field.TypeString, Nullable: true},
 		{Name: "last_name", Type: field.TypeString, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "must_change_password", Type: field.TypeBool, Default: false},
 		{Name: "avatar_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -430,10 +991,76 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_media_user_media",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[11]},
 				RefColumns: []*schema.Column{MediaColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "users_organizations_users",
+				Columns:    []*schema.Column{UsersColumns[12]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[12]},
+			},
+		},
+	}
+	// UserAccommodationsColumns holds the columns for the "user_accommodations" table.
+	UserAccommodationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "time_multiplier", Type: field.TypeFloat64, Default: 1},
+		{Name: "extra_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "allow_rest_breaks", Type: field.TypeBool, Default: false},
+		{Name: "max_rest_breaks", Type: field.TypeInt, Nullable: true},
+		{Name: "max_rest_break_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+	}
+	// UserAccommodationsTable holds the schema information for the "user_accommodations" table.
+	UserAccommodationsTable = &schema.Table{
+		Name:       "user_accommodations",
+		Columns:    UserAccommodationsColumns,
+		PrimaryKey: []*schema.Column{UserAccommodationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_accommodations_users_accommodation",
+				Columns:    []*schema.Column{UserAccommodationsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserInvitesColumns holds the columns for the "user_invites" table.
+	UserInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp without time zone"}},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// UserInvitesTable holds the schema information for the "user_invites" table.
+	UserInvitesTable = &schema.Table{
+		Name:       "user_invites",
+		Columns:    UserInvitesColumns,
+		PrimaryKey: []*schema.Column{UserInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_invites_users_invites",
+				Columns:    []*schema.Column{UserInvitesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// VideosColumns holds the columns for the "videos" table.
@@ -580,54 +1207,134 @@ var (
 			},
 		},
 	}
+	// UserMemberGroupsColumns holds the columns for the "user_member_groups" table.
+	UserMemberGroupsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// UserMemberGroupsTable holds the schema information for the "user_member_groups" table.
+	UserMemberGroupsTable = &schema.Table{
+		Name:       "user_member_groups",
+		Columns:    UserMemberGroupsColumns,
+		PrimaryKey: []*schema.Column{UserMemberGroupsColumns[0], UserMemberGroupsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_member_groups_user_id",
+				Columns:    []*schema.Column{UserMemberGroupsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_member_groups_group_id",
+				Columns:    []*schema.Column{UserMemberGroupsColumns[1]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessGrantsTable,
 		CoursesTable,
+		CourseEnrollmentsTable,
 		CourseSectionsTable,
+		CourseSectionPrerequisitesTable,
+		GroupsTable,
+		GroupTestAssignmentsTable,
 		JwtTokensTable,
 		MediaTable,
+		OrganizationsTable,
 		PermissionsTable,
 		QuestionsTable,
 		QuestionCollectionsTable,
 		QuestionOptionsTable,
+		QuestionVersionsTable,
+		QuestionVersionOptionsTable,
 		RolesTable,
 		TestsTable,
 		TestIgnoreQuestionsTable,
 		TestQuestionCountsTable,
 		TestSessionsTable,
 		TestSessionAnswersTable,
+		TestSessionBreaksTable,
+		TestSessionIntegrityEventsTable,
+		TestSessionRegradesTable,
+		TestSessionTimeExtensionsTable,
 		TodosTable,
 		UsersTable,
+		UserAccommodationsTable,
+		UserInvitesTable,
 		VideosTable,
 		VideoQuestionTimestampsTable,
 		RolePermissionsTable,
 		TestQuestionCollectionsTable,
 		UserRolesTable,
+		UserMemberGroupsTable,
 	}
 )
 
 func init() {
+	AccessGrantsTable.ForeignKeys[0].RefTable = CoursesTable
+	AccessGrantsTable.ForeignKeys[1].RefTable = GroupsTable
+	AccessGrantsTable.ForeignKeys[2].RefTable = OrganizationsTable
+	AccessGrantsTable.ForeignKeys[3].RefTable = QuestionCollectionsTable
+	AccessGrantsTable.ForeignKeys[4].RefTable = TestsTable
+	AccessGrantsTable.ForeignKeys[5].RefTable = UsersTable
+	AccessGrantsTable.ForeignKeys[6].RefTable = UsersTable
+	AccessGrantsTable.Annotation = &entsql.Annotation{}
+	AccessGrantsTable.Annotation.Checks = map[string]string{
+		"access_grant_single_grantee":  "num_nonnulls(user_id, group_id) = 1",
+		"access_grant_single_resource": "num_nonnulls(test_id, course_id, question_collection_id) = 1",
+	}
 	CoursesTable.ForeignKeys[0].RefTable = MediaTable
-	CoursesTable.ForeignKeys[1].RefTable = UsersTable
+	CoursesTable.ForeignKeys[1].RefTable = OrganizationsTable
+	CoursesTable.ForeignKeys[2].RefTable = UsersTable
+	CourseEnrollmentsTable.ForeignKeys[0].RefTable = CoursesTable
+	CourseEnrollmentsTable.ForeignKeys[1].RefTable = UsersTable
 	CourseSectionsTable.ForeignKeys[0].RefTable = CoursesTable
 	CourseSectionsTable.ForeignKeys[1].RefTable = CourseSectionsTable
+	CourseSectionPrerequisitesTable.ForeignKeys[0].RefTable = CourseSectionsTable
+	CourseSectionPrerequisitesTable.ForeignKeys[1].RefTable = CourseSectionsTable
+	GroupsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	GroupsTable.ForeignKeys[1].RefTable = UsersTable
+	GroupTestAssignmentsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupTestAssignmentsTable.ForeignKeys[1].RefTable = TestsTable
+	GroupTestAssignmentsTable.ForeignKeys[2].RefTable = UsersTable
 	JwtTokensTable.ForeignKeys[0].RefTable = UsersTable
-	MediaTable.ForeignKeys[0].RefTable = UsersTable
+	MediaTable.ForeignKeys[0].RefTable = OrganizationsTable
+	MediaTable.ForeignKeys[1].RefTable = UsersTable
 	QuestionsTable.ForeignKeys[0].RefTable = QuestionCollectionsTable
 	QuestionCollectionsTable.ForeignKeys[0].RefTable = CourseSectionsTable
-	QuestionCollectionsTable.ForeignKeys[1].RefTable = UsersTable
+	QuestionCollectionsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	QuestionCollectionsTable.ForeignKeys[2].RefTable = UsersTable
 	QuestionOptionsTable.ForeignKeys[0].RefTable = QuestionsTable
+	QuestionVersionsTable.ForeignKeys[0].RefTable = QuestionsTable
+	QuestionVersionsTable.ForeignKeys[1].RefTable = UsersTable
+	QuestionVersionOptionsTable.ForeignKeys[0].RefTable = QuestionVersionsTable
 	TestsTable.ForeignKeys[0].RefTable = CoursesTable
 	TestsTable.ForeignKeys[1].RefTable = CourseSectionsTable
+	TestsTable.ForeignKeys[2].RefTable = OrganizationsTable
+	TestsTable.ForeignKeys[3].RefTable = UsersTable
 	TestIgnoreQuestionsTable.ForeignKeys[0].RefTable = TestsTable
 	TestIgnoreQuestionsTable.ForeignKeys[1].RefTable = QuestionsTable
 	TestQuestionCountsTable.ForeignKeys[0].RefTable = TestsTable
 	TestSessionsTable.ForeignKeys[0].RefTable = CourseSectionsTable
-	TestSessionsTable.ForeignKeys[1].RefTable = TestsTable
-	TestSessionsTable.ForeignKeys[2].RefTable = UsersTable
+	TestSessionsTable.ForeignKeys[1].RefTable = GroupTestAssignmentsTable
+	TestSessionsTable.ForeignKeys[2].RefTable = TestsTable
+	TestSessionsTable.ForeignKeys[3].RefTable = UsersTable
 	TestSessionAnswersTable.ForeignKeys[0].RefTable = QuestionsTable
-	TestSessionAnswersTable.ForeignKeys[1].RefTable = TestSessionsTable
+	TestSessionAnswersTable.ForeignKeys[1].RefTable = QuestionVersionsTable
+	TestSessionAnswersTable.ForeignKeys[2].RefTable = TestSessionsTable
+	TestSessionBreaksTable.ForeignKeys[0].RefTable = TestSessionsTable
+	TestSessionIntegrityEventsTable.ForeignKeys[0].RefTable = TestSessionsTable
+	TestSessionRegradesTable.ForeignKeys[0].RefTable = TestSessionsTable
+	TestSessionRegradesTable.ForeignKeys[1].RefTable = UsersTable
+	TestSessionTimeExtensionsTable.ForeignKeys[0].RefTable = TestSessionsTable
+	TestSessionTimeExtensionsTable.ForeignKeys[1].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = MediaTable
+	UsersTable.ForeignKeys[1].RefTable = OrganizationsTable
+	UserAccommodationsTable.ForeignKeys[0].RefTable = UsersTable
+	UserInvitesTable.ForeignKeys[0].RefTable = UsersTable
 	VideosTable.ForeignKeys[0].RefTable = CoursesTable
 	VideosTable.ForeignKeys[1].RefTable = CourseSectionsTable
 	VideosTable.ForeignKeys[2].RefTable = MediaTable
@@ -639,4 +1346,6 @@ func init() {
 	TestQuestionCollectionsTable.ForeignKeys[1].RefTable = QuestionCollectionsTable
 	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
+	UserMemberGroupsTable.ForeignKeys[0].RefTable = UsersTable
+	UserMemberGroupsTable.ForeignKeys[1].RefTable = GroupsTable
 }
@@ -6,18 +6,36 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessGrant is the predicate function for accessgrant builders.
+type AccessGrant func(*sql.Selector)
+
 // Course is the predicate function for course builders.
 type Course func(*sql.Selector)
 
+// CourseEnrollment is the predicate function for courseenrollment builders.
+type CourseEnrollment func(*sql.Selector)
+
 // CourseSection is the predicate function for coursesection builders.
 type CourseSection func(*sql.Selector)
 
+// CourseSectionPrerequisite is the predicate function for coursesectionprerequisite builders.
+type CourseSectionPrerequisite func(*sql.Selector)
+
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
+// GroupTestAssignment is the predicate function for grouptestassignment builders.
+type GroupTestAssignment func(*sql.Selector)
+
 // JwtToken is the predicate function for jwttoken builders.
 type JwtToken func(*sql.Selector)
 
 // Media is the predicate function for media builders.
 type Media func(*sql.Selector)
 
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
@@ -30,6 +48,12 @@ type QuestionCollection func(*sql.Selector)
 // QuestionOption is the predicate function for questionoption builders.
 type QuestionOption func(*sql.Selector)
 
+// QuestionVersion is the predicate function for questionversion builders.
+type QuestionVersion func(*sql.Selector)
+
+// QuestionVersionOption is the predicate function for questionversionoption builders.
+type QuestionVersionOption func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -48,12 +72,30 @@ type TestSession func(*sql.Selector)
 // TestSessionAnswer is the predicate function for testsessionanswer builders.
 type TestSessionAnswer func(*sql.Selector)
 
+// TestSessionBreak is the predicate function for testsessionbreak builders.
+type TestSessionBreak func(*sql.Selector)
+
+// TestSessionIntegrityEvent is the predicate function for testsessionintegrityevent builders.
+type TestSessionIntegrityEvent func(*sql.Selector)
+
+// TestSessionRegrade is the predicate function for testsessionregrade builders.
+type TestSessionRegrade func(*sql.Selector)
+
+// TestSessionTimeExtension is the predicate function for testsessiontimeextension builders.
+type TestSessionTimeExtension func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserAccommodation is the predicate function for useraccommodation builders.
+type UserAccommodation func(*sql.Selector)
+
+// UserInvite is the predicate function for userinvite builders.
+type UserInvite func(*sql.Selector)
+
 // Video is the predicate function for video builders.
 type Video func(*sql.Selector)
 
@@ -31,6 +31,8 @@ type Question struct {
 	QuestionText string `json:"question_text,omitempty"`
 	// Points holds the value of the "points" field.
 	Points int `json:"points,omitempty"`
+	// Markdown explanation of the answer, with its sources
+	Explanation *string `json:"explanation,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionQuery when eager-loading is set.
 	Edges        QuestionEdges `json:"edges"`
@@ -47,11 +49,13 @@ type QuestionEdges struct {
 	VideoQuestionTimestampsQuestion []*VideoQuestionTimestamp `json:"video_question_timestamps_question,omitempty"`
 	// TestSessionAnswers holds the value of the test_session_answers edge.
 	TestSessionAnswers []*TestSessionAnswer `json:"test_session_answers,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*QuestionVersion `json:"versions,omitempty"`
 	// TestIgnoreQuestions holds the value of the test_ignore_questions edge.
 	TestIgnoreQuestions []*TestIgnoreQuestion `json:"test_ignore_questions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // CollectionOrErr returns the Collection value or an error if the edge
@@ -92,10 +96,19 @@ func (e QuestionEdges) TestSessionAnswersOrErr() ([]*TestSessionAnswer, error) {
 	return nil, &NotLoadedError{edge: "test_session_answers"}
 }
 
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionEdges) VersionsOrErr() ([]*QuestionVersion, error) {
+	if e.loadedTypes[4] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
 // TestIgnoreQuestionsOrErr returns the TestIgnoreQuestions value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionEdges) TestIgnoreQuestionsOrErr() ([]*TestIgnoreQuestion, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.TestIgnoreQuestions, nil
 	}
 	return nil, &NotLoadedError{edge: "test_ignore_questions"}
@@ -108,7 +121,7 @@ func (*Question) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case question.FieldPoints:
 			values[i] = new(sql.NullInt64)
-		case question.FieldQuestionText:
+		case question.FieldQuestionText, question.FieldExplanation:
 			values[i] = new(sql.NullString)
 		case question.FieldCreatedAt, question.FieldUpdatedAt, question.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -172,6 +185,13 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.Points = int(value.Int64)
 			}
+		case question.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				q.Explanation = new(string)
+				*q.Explanation = value.String
+			}
 		default:
 			q.selectValues.Set(columns[i], values[i])
 		}
@@ -205,6 +225,11 @@ func (q *Question) QueryTestSessionAnswers() *TestSessionAnswerQuery {
 	return NewQuestionClient(q.config).QueryTestSessionAnswers(q)
 }
 
+// QueryVersions queries the "versions" edge of the Question entity.
+func (q *Question) QueryVersions() *QuestionVersionQuery {
+	return NewQuestionClient(q.config).QueryVersions(q)
+}
+
 // QueryTestIgnoreQuestions queries the "test_ignore_questions" edge of the Question entity.
 func (q *Question) QueryTestIgnoreQuestions() *TestIgnoreQuestionQuery {
 	return NewQuestionClient(q.config).QueryTestIgnoreQuestions(q)
@@ -252,6 +277,11 @@ func (q *Question) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("points=")
 	builder.WriteString(fmt.Sprintf("%v", q.Points))
+	builder.WriteString(", ")
+	if v := q.Explanation; v != nil {
+		builder.WriteString("explanation=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,8 @@ const (
 	FieldQuestionText = "question_text"
 	// FieldPoints holds the string denoting the points field in the database.
 	FieldPoints = "points"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
 	// EdgeCollection holds the string denoting the collection edge name in mutations.
 	EdgeCollection = "collection"
 	// EdgeQuestionOptions holds the string denoting the question_options edge name in mutations.
@@ -36,6 +38,8 @@ const (
 	EdgeVideoQuestionTimestampsQuestion = "video_question_timestamps_question"
 	// EdgeTestSessionAnswers holds the string denoting the test_session_answers edge name in mutations.
 	EdgeTestSessionAnswers = "test_session_answers"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
 	// EdgeTestIgnoreQuestions holds the string denoting the test_ignore_questions edge name in mutations.
 	EdgeTestIgnoreQuestions = "test_ignore_questions"
 	// Table holds the table name of the question in the database.
@@ -68,6 +72,13 @@ const (
 	TestSessionAnswersInverseTable = "test_session_answers"
 	// TestSessionAnswersColumn is the table column denoting the test_session_answers relation/edge.
 	TestSessionAnswersColumn = "question_id"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "question_versions"
+	// VersionsInverseTable is the table name for the QuestionVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "questionversion" package.
+	VersionsInverseTable = "question_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "question_id"
 	// TestIgnoreQuestionsTable is the table that holds the test_ignore_questions relation/edge.
 	TestIgnoreQuestionsTable = "test_ignore_questions"
 	// TestIgnoreQuestionsInverseTable is the table name for the TestIgnoreQuestion entity.
@@ -86,6 +97,7 @@ var Columns = []string{
 	FieldCollectionID,
 	FieldQuestionText,
 	FieldPoints,
+	FieldExplanation,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -104,8 +116,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -160,6 +172,11 @@ func ByPoints(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPoints, opts...).ToFunc()
 }
 
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
 // ByCollectionField orders the results by collection field.
 func ByCollectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -209,6 +226,20 @@ func ByTestSessionAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 	}
 }
 
+// ByVersionsCount orders the results by versions count.
+func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVersionsStep(), opts...)
+	}
+}
+
+// ByVersions orders the results by versions terms.
+func ByVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTestIgnoreQuestionsCount orders the results by test_ignore_questions count.
 func ByTestIgnoreQuestionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -250,6 +281,13 @@ func newTestSessionAnswersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TestSessionAnswersTable, TestSessionAnswersColumn),
 	)
 }
+func newVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+	)
+}
 func newTestIgnoreQuestionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
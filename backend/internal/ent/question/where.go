@@ -86,6 +86,11 @@ func Points(v int) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldPoints, v))
 }
 
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldExplanation, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldCreatedAt, v))
@@ -341,6 +346,81 @@ func PointsLTE(v int) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldPoints, v))
 }
 
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldExplanation, v))
+}
+
 // HasCollection applies the HasEdge predicate on the "collection" edge.
 func HasCollection() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
@@ -433,6 +513,29 @@ func HasTestSessionAnswersWith(preds ...predicate.TestSessionAnswer) predicate.Q
 	})
 }
 
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.QuestionVersion) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newVersionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTestIgnoreQuestions applies the HasEdge predicate on the "test_ignore_questions" edge.
 func HasTestIgnoreQuestions() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testsessionanswer"
 	"template/internal/ent/videoquestiontimestamp"
@@ -94,6 +95,20 @@ func (qc *QuestionCreate) SetNillablePoints(i *int) *QuestionCreate {
 	return qc
 }
 
+// SetExplanation sets the "explanation" field.
+func (qc *QuestionCreate) SetExplanation(s string) *QuestionCreate {
+	qc.mutation.SetExplanation(s)
+	return qc
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableExplanation(s *string) *QuestionCreate {
+	if s != nil {
+		qc.SetExplanation(*s)
+	}
+	return qc
+}
+
 // SetID sets the "id" field.
 func (qc *QuestionCreate) SetID(u uuid.UUID) *QuestionCreate {
 	qc.mutation.SetID(u)
@@ -158,6 +173,21 @@ func (qc *QuestionCreate) AddTestSessionAnswers(t ...*TestSessionAnswer) *Questi
 	return qc.AddTestSessionAnswerIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the QuestionVersion entity by IDs.
+func (qc *QuestionCreate) AddVersionIDs(ids ...uuid.UUID) *QuestionCreate {
+	qc.mutation.AddVersionIDs(ids...)
+	return qc
+}
+
+// AddVersions adds the "versions" edges to the QuestionVersion entity.
+func (qc *QuestionCreate) AddVersions(q ...*QuestionVersion) *QuestionCreate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qc.AddVersionIDs(ids...)
+}
+
 // AddTestIgnoreQuestionIDs adds the "test_ignore_questions" edge to the TestIgnoreQuestion entity by IDs.
 func (qc *QuestionCreate) AddTestIgnoreQuestionIDs(ids ...uuid.UUID) *QuestionCreate {
 	qc.mutation.AddTestIgnoreQuestionIDs(ids...)
@@ -323,6 +353,10 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldPoints, field.TypeInt, value)
 		_node.Points = value
 	}
+	if value, ok := qc.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+		_node.Explanation = &value
+	}
 	if nodes := qc.mutation.CollectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -388,6 +422,22 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qc.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := qc.mutation.TestIgnoreQuestionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
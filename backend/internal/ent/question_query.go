@@ -11,11 +11,13 @@ import (
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testsessionanswer"
 	"template/internal/ent/videoquestiontimestamp"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -33,7 +35,9 @@ type QuestionQuery struct {
 	withQuestionOptions                 *QuestionOptionQuery
 	withVideoQuestionTimestampsQuestion *VideoQuestionTimestampQuery
 	withTestSessionAnswers              *TestSessionAnswerQuery
+	withVersions                        *QuestionVersionQuery
 	withTestIgnoreQuestions             *TestIgnoreQuestionQuery
+	modifiers                           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -158,6 +162,28 @@ func (qq *QuestionQuery) QueryTestSessionAnswers() *TestSessionAnswerQuery {
 	return query
 }
 
+// QueryVersions chains the current query on the "versions" edge.
+func (qq *QuestionQuery) QueryVersions() *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: qq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.VersionsTable, question.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTestIgnoreQuestions chains the current query on the "test_ignore_questions" edge.
 func (qq *QuestionQuery) QueryTestIgnoreQuestions() *TestIgnoreQuestionQuery {
 	query := (&TestIgnoreQuestionClient{config: qq.config}).Query()
@@ -376,6 +402,7 @@ func (qq *QuestionQuery) Clone() *QuestionQuery {
 		withQuestionOptions:                 qq.withQuestionOptions.Clone(),
 		withVideoQuestionTimestampsQuestion: qq.withVideoQuestionTimestampsQuestion.Clone(),
 		withTestSessionAnswers:              qq.withTestSessionAnswers.Clone(),
+		withVersions:                        qq.withVersions.Clone(),
 		withTestIgnoreQuestions:             qq.withTestIgnoreQuestions.Clone(),
 		// clone intermediate query.
 		sql:  qq.sql.Clone(),
@@ -427,6 +454,17 @@ func (qq *QuestionQuery) WithTestSessionAnswers(opts ...func(*TestSessionAnswerQ
 	return qq
 }
 
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (qq *QuestionQuery) WithVersions(opts ...func(*QuestionVersionQuery)) *QuestionQuery {
+	query := (&QuestionVersionClient{config: qq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qq.withVersions = query
+	return qq
+}
+
 // WithTestIgnoreQuestions tells the query-builder to eager-load the nodes that are connected to
 // the "test_ignore_questions" edge. The optional arguments are used to configure the query builder of the edge.
 func (qq *QuestionQuery) WithTestIgnoreQuestions(opts ...func(*TestIgnoreQuestionQuery)) *QuestionQuery {
@@ -516,11 +554,12 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 	var (
 		nodes       = []*Question{}
 		_spec       = qq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			qq.withCollection != nil,
 			qq.withQuestionOptions != nil,
 			qq.withVideoQuestionTimestampsQuestion != nil,
 			qq.withTestSessionAnswers != nil,
+			qq.withVersions != nil,
 			qq.withTestIgnoreQuestions != nil,
 		}
 	)
@@ -533,6 +572,9 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -573,6 +615,13 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 			return nil, err
 		}
 	}
+	if query := qq.withVersions; query != nil {
+		if err := qq.loadVersions(ctx, query, nodes,
+			func(n *Question) { n.Edges.Versions = []*QuestionVersion{} },
+			func(n *Question, e *QuestionVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := qq.withTestIgnoreQuestions; query != nil {
 		if err := qq.loadTestIgnoreQuestions(ctx, query, nodes,
 			func(n *Question) { n.Edges.TestIgnoreQuestions = []*TestIgnoreQuestion{} },
@@ -704,6 +753,36 @@ func (qq *QuestionQuery) loadTestSessionAnswers(ctx context.Context, query *Test
 	}
 	return nil
 }
+func (qq *QuestionQuery) loadVersions(ctx context.Context, query *QuestionVersionQuery, nodes []*Question, init func(*Question), assign func(*Question, *QuestionVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Question)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionversion.FieldQuestionID)
+	}
+	query.Where(predicate.QuestionVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(question.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (qq *QuestionQuery) loadTestIgnoreQuestions(ctx context.Context, query *TestIgnoreQuestionQuery, nodes []*Question, init func(*Question), assign func(*Question, *TestIgnoreQuestion)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Question)
@@ -737,6 +816,9 @@ func (qq *QuestionQuery) loadTestIgnoreQuestions(ctx context.Context, query *Tes
 
 func (qq *QuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qq.querySpec()
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	_spec.Node.Columns = qq.ctx.Fields
 	if len(qq.ctx.Fields) > 0 {
 		_spec.Unique = qq.ctx.Unique != nil && *qq.ctx.Unique
@@ -802,6 +884,9 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if qq.ctx.Unique != nil && *qq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qq.modifiers {
+		m(selector)
+	}
 	for _, p := range qq.predicates {
 		p(selector)
 	}
@@ -819,6 +904,32 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qq *QuestionQuery) ForUpdate(opts ...sql.LockOption) *QuestionQuery {
+	if qq.driver.Dialect() == dialect.Postgres {
+		qq.Unique(false)
+	}
+	qq.modifiers = append(qq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qq *QuestionQuery) ForShare(opts ...sql.LockOption) *QuestionQuery {
+	if qq.driver.Dialect() == dialect.Postgres {
+		qq.Unique(false)
+	}
+	qq.modifiers = append(qq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qq
+}
+
 // QuestionGroupBy is the group-by builder for Question entities.
 type QuestionGroupBy struct {
 	selector
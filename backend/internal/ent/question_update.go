@@ -10,6 +10,7 @@ import (
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testsessionanswer"
 	"template/internal/ent/videoquestiontimestamp"
@@ -123,6 +124,26 @@ func (qu *QuestionUpdate) AddPoints(i int) *QuestionUpdate {
 	return qu
 }
 
+// SetExplanation sets the "explanation" field.
+func (qu *QuestionUpdate) SetExplanation(s string) *QuestionUpdate {
+	qu.mutation.SetExplanation(s)
+	return qu
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableExplanation(s *string) *QuestionUpdate {
+	if s != nil {
+		qu.SetExplanation(*s)
+	}
+	return qu
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (qu *QuestionUpdate) ClearExplanation() *QuestionUpdate {
+	qu.mutation.ClearExplanation()
+	return qu
+}
+
 // SetCollection sets the "collection" edge to the QuestionCollection entity.
 func (qu *QuestionUpdate) SetCollection(q *QuestionCollection) *QuestionUpdate {
 	return qu.SetCollectionID(q.ID)
@@ -173,6 +194,21 @@ func (qu *QuestionUpdate) AddTestSessionAnswers(t ...*TestSessionAnswer) *Questi
 	return qu.AddTestSessionAnswerIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the QuestionVersion entity by IDs.
+func (qu *QuestionUpdate) AddVersionIDs(ids ...uuid.UUID) *QuestionUpdate {
+	qu.mutation.AddVersionIDs(ids...)
+	return qu
+}
+
+// AddVersions adds the "versions" edges to the QuestionVersion entity.
+func (qu *QuestionUpdate) AddVersions(q ...*QuestionVersion) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.AddVersionIDs(ids...)
+}
+
 // AddTestIgnoreQuestionIDs adds the "test_ignore_questions" edge to the TestIgnoreQuestion entity by IDs.
 func (qu *QuestionUpdate) AddTestIgnoreQuestionIDs(ids ...uuid.UUID) *QuestionUpdate {
 	qu.mutation.AddTestIgnoreQuestionIDs(ids...)
@@ -262,6 +298,27 @@ func (qu *QuestionUpdate) RemoveTestSessionAnswers(t ...*TestSessionAnswer) *Que
 	return qu.RemoveTestSessionAnswerIDs(ids...)
 }
 
+// ClearVersions clears all "versions" edges to the QuestionVersion entity.
+func (qu *QuestionUpdate) ClearVersions() *QuestionUpdate {
+	qu.mutation.ClearVersions()
+	return qu
+}
+
+// RemoveVersionIDs removes the "versions" edge to QuestionVersion entities by IDs.
+func (qu *QuestionUpdate) RemoveVersionIDs(ids ...uuid.UUID) *QuestionUpdate {
+	qu.mutation.RemoveVersionIDs(ids...)
+	return qu
+}
+
+// RemoveVersions removes "versions" edges to QuestionVersion entities.
+func (qu *QuestionUpdate) RemoveVersions(q ...*QuestionVersion) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.RemoveVersionIDs(ids...)
+}
+
 // ClearTestIgnoreQuestions clears all "test_ignore_questions" edges to the TestIgnoreQuestion entity.
 func (qu *QuestionUpdate) ClearTestIgnoreQuestions() *QuestionUpdate {
 	qu.mutation.ClearTestIgnoreQuestions()
@@ -376,6 +433,12 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.AddedPoints(); ok {
 		_spec.AddField(question.FieldPoints, field.TypeInt, value)
 	}
+	if value, ok := qu.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+	}
+	if qu.mutation.ExplanationCleared() {
+		_spec.ClearField(question.FieldExplanation, field.TypeString)
+	}
 	if qu.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -540,6 +603,51 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !qu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if qu.mutation.TestIgnoreQuestionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -694,6 +802,26 @@ func (quo *QuestionUpdateOne) AddPoints(i int) *QuestionUpdateOne {
 	return quo
 }
 
+// SetExplanation sets the "explanation" field.
+func (quo *QuestionUpdateOne) SetExplanation(s string) *QuestionUpdateOne {
+	quo.mutation.SetExplanation(s)
+	return quo
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableExplanation(s *string) *QuestionUpdateOne {
+	if s != nil {
+		quo.SetExplanation(*s)
+	}
+	return quo
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (quo *QuestionUpdateOne) ClearExplanation() *QuestionUpdateOne {
+	quo.mutation.ClearExplanation()
+	return quo
+}
+
 // SetCollection sets the "collection" edge to the QuestionCollection entity.
 func (quo *QuestionUpdateOne) SetCollection(q *QuestionCollection) *QuestionUpdateOne {
 	return quo.SetCollectionID(q.ID)
@@ -744,6 +872,21 @@ func (quo *QuestionUpdateOne) AddTestSessionAnswers(t ...*TestSessionAnswer) *Qu
 	return quo.AddTestSessionAnswerIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the QuestionVersion entity by IDs.
+func (quo *QuestionUpdateOne) AddVersionIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	quo.mutation.AddVersionIDs(ids...)
+	return quo
+}
+
+// AddVersions adds the "versions" edges to the QuestionVersion entity.
+func (quo *QuestionUpdateOne) AddVersions(q ...*QuestionVersion) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.AddVersionIDs(ids...)
+}
+
 // AddTestIgnoreQuestionIDs adds the "test_ignore_questions" edge to the TestIgnoreQuestion entity by IDs.
 func (quo *QuestionUpdateOne) AddTestIgnoreQuestionIDs(ids ...uuid.UUID) *QuestionUpdateOne {
 	quo.mutation.AddTestIgnoreQuestionIDs(ids...)
@@ -833,6 +976,27 @@ func (quo *QuestionUpdateOne) RemoveTestSessionAnswers(t ...*TestSessionAnswer)
 	return quo.RemoveTestSessionAnswerIDs(ids...)
 }
 
+// ClearVersions clears all "versions" edges to the QuestionVersion entity.
+func (quo *QuestionUpdateOne) ClearVersions() *QuestionUpdateOne {
+	quo.mutation.ClearVersions()
+	return quo
+}
+
+// RemoveVersionIDs removes the "versions" edge to QuestionVersion entities by IDs.
+func (quo *QuestionUpdateOne) RemoveVersionIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	quo.mutation.RemoveVersionIDs(ids...)
+	return quo
+}
+
+// RemoveVersions removes "versions" edges to QuestionVersion entities.
+func (quo *QuestionUpdateOne) RemoveVersions(q ...*QuestionVersion) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.RemoveVersionIDs(ids...)
+}
+
 // ClearTestIgnoreQuestions clears all "test_ignore_questions" edges to the TestIgnoreQuestion entity.
 func (quo *QuestionUpdateOne) ClearTestIgnoreQuestions() *QuestionUpdateOne {
 	quo.mutation.ClearTestIgnoreQuestions()
@@ -977,6 +1141,12 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if value, ok := quo.mutation.AddedPoints(); ok {
 		_spec.AddField(question.FieldPoints, field.TypeInt, value)
 	}
+	if value, ok := quo.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+	}
+	if quo.mutation.ExplanationCleared() {
+		_spec.ClearField(question.FieldExplanation, field.TypeString)
+	}
 	if quo.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1141,6 +1311,51 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if quo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !quo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.VersionsTable,
+			Columns: []string{question.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if quo.mutation.TestIgnoreQuestionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/organization"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/user"
 	"time"
@@ -26,6 +27,8 @@ type QuestionCollection struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -42,6 +45,8 @@ type QuestionCollection struct {
 
 // QuestionCollectionEdges holds the relations/edges for other nodes in the graph.
 type QuestionCollectionEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Creator holds the value of the creator edge.
 	Creator *User `json:"creator,omitempty"`
 	// Questions holds the value of the questions edge.
@@ -50,9 +55,22 @@ type QuestionCollectionEdges struct {
 	CourseSection *CourseSection `json:"course_section,omitempty"`
 	// Test holds the value of the test edge.
 	Test []*Test `json:"test,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionCollectionEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // CreatorOrErr returns the Creator value or an error if the edge
@@ -60,7 +78,7 @@ type QuestionCollectionEdges struct {
 func (e QuestionCollectionEdges) CreatorOrErr() (*User, error) {
 	if e.Creator != nil {
 		return e.Creator, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "creator"}
@@ -69,7 +87,7 @@ func (e QuestionCollectionEdges) CreatorOrErr() (*User, error) {
 // QuestionsOrErr returns the Questions value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionCollectionEdges) QuestionsOrErr() ([]*Question, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Questions, nil
 	}
 	return nil, &NotLoadedError{edge: "questions"}
@@ -80,7 +98,7 @@ func (e QuestionCollectionEdges) QuestionsOrErr() ([]*Question, error) {
 func (e QuestionCollectionEdges) CourseSectionOrErr() (*CourseSection, error) {
 	if e.CourseSection != nil {
 		return e.CourseSection, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: coursesection.Label}
 	}
 	return nil, &NotLoadedError{edge: "course_section"}
@@ -89,18 +107,27 @@ func (e QuestionCollectionEdges) CourseSectionOrErr() (*CourseSection, error) {
 // TestOrErr returns the Test value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionCollectionEdges) TestOrErr() ([]*Test, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Test, nil
 	}
 	return nil, &NotLoadedError{edge: "test"}
 }
 
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionCollectionEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[5] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*QuestionCollection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case questioncollection.FieldCourseSectionID:
+		case questioncollection.FieldOrganizationID, questioncollection.FieldCourseSectionID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case questioncollection.FieldTitle, questioncollection.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -148,6 +175,13 @@ func (qc *QuestionCollection) assignValues(columns []string, values []any) error
 				qc.DeletedAt = new(time.Time)
 				*qc.DeletedAt = value.Time
 			}
+		case questioncollection.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				qc.OrganizationID = new(uuid.UUID)
+				*qc.OrganizationID = *value.S.(*uuid.UUID)
+			}
 		case questioncollection.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -187,6 +221,11 @@ func (qc *QuestionCollection) Value(name string) (ent.Value, error) {
 	return qc.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the QuestionCollection entity.
+func (qc *QuestionCollection) QueryOrganization() *OrganizationQuery {
+	return NewQuestionCollectionClient(qc.config).QueryOrganization(qc)
+}
+
 // QueryCreator queries the "creator" edge of the QuestionCollection entity.
 func (qc *QuestionCollection) QueryCreator() *UserQuery {
 	return NewQuestionCollectionClient(qc.config).QueryCreator(qc)
@@ -207,6 +246,11 @@ func (qc *QuestionCollection) QueryTest() *TestQuery {
 	return NewQuestionCollectionClient(qc.config).QueryTest(qc)
 }
 
+// QueryAccessGrants queries the "access_grants" edge of the QuestionCollection entity.
+func (qc *QuestionCollection) QueryAccessGrants() *AccessGrantQuery {
+	return NewQuestionCollectionClient(qc.config).QueryAccessGrants(qc)
+}
+
 // Update returns a builder for updating this QuestionCollection.
 // Note that you need to call QuestionCollection.Unwrap() before calling this method if this QuestionCollection
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -241,6 +285,11 @@ func (qc *QuestionCollection) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := qc.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(qc.Title)
 	builder.WriteString(", ")
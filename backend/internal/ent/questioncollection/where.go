@@ -71,6 +71,11 @@ func DeletedAt(v time.Time) predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.FieldEQ(FieldTitle, v))
@@ -221,6 +226,36 @@ func DeletedAtNotNil() predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.QuestionCollection {
+	return predicate.QuestionCollection(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.FieldEQ(FieldTitle, v))
@@ -411,6 +446,29 @@ func CourseSectionIDNotNil() predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.FieldNotNull(FieldCourseSectionID))
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.QuestionCollection {
+	return predicate.QuestionCollection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.QuestionCollection {
+	return predicate.QuestionCollection(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCreator applies the HasEdge predicate on the "creator" edge.
 func HasCreator() predicate.QuestionCollection {
 	return predicate.QuestionCollection(func(s *sql.Selector) {
@@ -503,6 +561,29 @@ func HasTestWith(preds ...predicate.Test) predicate.QuestionCollection {
 	})
 }
 
+// HasAccessGrants applies the HasEdge predicate on the "access_grants" edge.
+func HasAccessGrants() predicate.QuestionCollection {
+	return predicate.QuestionCollection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessGrantsTable, AccessGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessGrantsWith applies the HasEdge predicate on the "access_grants" edge with a given conditions (other predicates).
+func HasAccessGrantsWith(preds ...predicate.AccessGrant) predicate.QuestionCollection {
+	return predicate.QuestionCollection(func(s *sql.Selector) {
+		step := newAccessGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.QuestionCollection) predicate.QuestionCollection {
 	return predicate.QuestionCollection(sql.AndPredicates(predicates...))
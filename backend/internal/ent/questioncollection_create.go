@@ -6,7 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/organization"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
@@ -67,6 +69,20 @@ func (qcc *QuestionCollectionCreate) SetNillableDeletedAt(t *time.Time) *Questio
 	return qcc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (qcc *QuestionCollectionCreate) SetOrganizationID(u uuid.UUID) *QuestionCollectionCreate {
+	qcc.mutation.SetOrganizationID(u)
+	return qcc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (qcc *QuestionCollectionCreate) SetNillableOrganizationID(u *uuid.UUID) *QuestionCollectionCreate {
+	if u != nil {
+		qcc.SetOrganizationID(*u)
+	}
+	return qcc
+}
+
 // SetTitle sets the "title" field.
 func (qcc *QuestionCollectionCreate) SetTitle(s string) *QuestionCollectionCreate {
 	qcc.mutation.SetTitle(s)
@@ -121,6 +137,11 @@ func (qcc *QuestionCollectionCreate) SetNillableID(u *uuid.UUID) *QuestionCollec
 	return qcc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (qcc *QuestionCollectionCreate) SetOrganization(o *Organization) *QuestionCollectionCreate {
+	return qcc.SetOrganizationID(o.ID)
+}
+
 // SetCreator sets the "creator" edge to the User entity.
 func (qcc *QuestionCollectionCreate) SetCreator(u *User) *QuestionCollectionCreate {
 	return qcc.SetCreatorID(u.ID)
@@ -161,6 +182,21 @@ func (qcc *QuestionCollectionCreate) AddTest(t ...*Test) *QuestionCollectionCrea
 	return qcc.AddTestIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (qcc *QuestionCollectionCreate) AddAccessGrantIDs(ids ...uuid.UUID) *QuestionCollectionCreate {
+	qcc.mutation.AddAccessGrantIDs(ids...)
+	return qcc
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (qcc *QuestionCollectionCreate) AddAccessGrants(a ...*AccessGrant) *QuestionCollectionCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qcc.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the QuestionCollectionMutation object of the builder.
 func (qcc *QuestionCollectionCreate) Mutation() *QuestionCollectionMutation {
 	return qcc.mutation
@@ -299,6 +335,23 @@ func (qcc *QuestionCollectionCreate) createSpec() (*QuestionCollection, *sqlgrap
 		_spec.SetField(questioncollection.FieldDescription, field.TypeString, value)
 		_node.Description = &value
 	}
+	if nodes := qcc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questioncollection.OrganizationTable,
+			Columns: []string{questioncollection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := qcc.mutation.CreatorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -365,6 +418,22 @@ func (qcc *QuestionCollectionCreate) createSpec() (*QuestionCollection, *sqlgrap
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qcc.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
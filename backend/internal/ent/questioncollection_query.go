@@ -7,7 +7,9 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
@@ -15,6 +17,7 @@ import (
 	"template/internal/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,10 +31,13 @@ type QuestionCollectionQuery struct {
 	order             []questioncollection.OrderOption
 	inters            []Interceptor
 	predicates        []predicate.QuestionCollection
+	withOrganization  *OrganizationQuery
 	withCreator       *UserQuery
 	withQuestions     *QuestionQuery
 	withCourseSection *CourseSectionQuery
 	withTest          *TestQuery
+	withAccessGrants  *AccessGrantQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -68,6 +74,28 @@ func (qcq *QuestionCollectionQuery) Order(o ...questioncollection.OrderOption) *
 	return qcq
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (qcq *QuestionCollectionQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: qcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questioncollection.OrganizationTable, questioncollection.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCreator chains the current query on the "creator" edge.
 func (qcq *QuestionCollectionQuery) QueryCreator() *UserQuery {
 	query := (&UserClient{config: qcq.config}).Query()
@@ -156,6 +184,28 @@ func (qcq *QuestionCollectionQuery) QueryTest() *TestQuery {
 	return query
 }
 
+// QueryAccessGrants chains the current query on the "access_grants" edge.
+func (qcq *QuestionCollectionQuery) QueryAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: qcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questioncollection.Table, questioncollection.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questioncollection.AccessGrantsTable, questioncollection.AccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first QuestionCollection entity from the query.
 // Returns a *NotFoundError when no QuestionCollection was found.
 func (qcq *QuestionCollectionQuery) First(ctx context.Context) (*QuestionCollection, error) {
@@ -348,16 +398,29 @@ func (qcq *QuestionCollectionQuery) Clone() *QuestionCollectionQuery {
 		order:             append([]questioncollection.OrderOption{}, qcq.order...),
 		inters:            append([]Interceptor{}, qcq.inters...),
 		predicates:        append([]predicate.QuestionCollection{}, qcq.predicates...),
+		withOrganization:  qcq.withOrganization.Clone(),
 		withCreator:       qcq.withCreator.Clone(),
 		withQuestions:     qcq.withQuestions.Clone(),
 		withCourseSection: qcq.withCourseSection.Clone(),
 		withTest:          qcq.withTest.Clone(),
+		withAccessGrants:  qcq.withAccessGrants.Clone(),
 		// clone intermediate query.
 		sql:  qcq.sql.Clone(),
 		path: qcq.path,
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcq *QuestionCollectionQuery) WithOrganization(opts ...func(*OrganizationQuery)) *QuestionCollectionQuery {
+	query := (&OrganizationClient{config: qcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcq.withOrganization = query
+	return qcq
+}
+
 // WithCreator tells the query-builder to eager-load the nodes that are connected to
 // the "creator" edge. The optional arguments are used to configure the query builder of the edge.
 func (qcq *QuestionCollectionQuery) WithCreator(opts ...func(*UserQuery)) *QuestionCollectionQuery {
@@ -402,6 +465,17 @@ func (qcq *QuestionCollectionQuery) WithTest(opts ...func(*TestQuery)) *Question
 	return qcq
 }
 
+// WithAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcq *QuestionCollectionQuery) WithAccessGrants(opts ...func(*AccessGrantQuery)) *QuestionCollectionQuery {
+	query := (&AccessGrantClient{config: qcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcq.withAccessGrants = query
+	return qcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +554,13 @@ func (qcq *QuestionCollectionQuery) sqlAll(ctx context.Context, hooks ...queryHo
 	var (
 		nodes       = []*QuestionCollection{}
 		_spec       = qcq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
+			qcq.withOrganization != nil,
 			qcq.withCreator != nil,
 			qcq.withQuestions != nil,
 			qcq.withCourseSection != nil,
 			qcq.withTest != nil,
+			qcq.withAccessGrants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -496,6 +572,9 @@ func (qcq *QuestionCollectionQuery) sqlAll(ctx context.Context, hooks ...queryHo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qcq.modifiers) > 0 {
+		_spec.Modifiers = qcq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +584,12 @@ func (qcq *QuestionCollectionQuery) sqlAll(ctx context.Context, hooks ...queryHo
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := qcq.withOrganization; query != nil {
+		if err := qcq.loadOrganization(ctx, query, nodes, nil,
+			func(n *QuestionCollection, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := qcq.withCreator; query != nil {
 		if err := qcq.loadCreator(ctx, query, nodes, nil,
 			func(n *QuestionCollection, e *User) { n.Edges.Creator = e }); err != nil {
@@ -531,9 +616,48 @@ func (qcq *QuestionCollectionQuery) sqlAll(ctx context.Context, hooks ...queryHo
 			return nil, err
 		}
 	}
+	if query := qcq.withAccessGrants; query != nil {
+		if err := qcq.loadAccessGrants(ctx, query, nodes,
+			func(n *QuestionCollection) { n.Edges.AccessGrants = []*AccessGrant{} },
+			func(n *QuestionCollection, e *AccessGrant) { n.Edges.AccessGrants = append(n.Edges.AccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (qcq *QuestionCollectionQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*QuestionCollection, init func(*QuestionCollection), assign func(*QuestionCollection, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionCollection)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (qcq *QuestionCollectionQuery) loadCreator(ctx context.Context, query *UserQuery, nodes []*QuestionCollection, init func(*QuestionCollection), assign func(*QuestionCollection, *User)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*QuestionCollection)
@@ -686,9 +810,45 @@ func (qcq *QuestionCollectionQuery) loadTest(ctx context.Context, query *TestQue
 	}
 	return nil
 }
+func (qcq *QuestionCollectionQuery) loadAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*QuestionCollection, init func(*QuestionCollection), assign func(*QuestionCollection, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionCollection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldQuestionCollectionID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questioncollection.AccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QuestionCollectionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "question_collection_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "question_collection_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (qcq *QuestionCollectionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qcq.querySpec()
+	if len(qcq.modifiers) > 0 {
+		_spec.Modifiers = qcq.modifiers
+	}
 	_spec.Node.Columns = qcq.ctx.Fields
 	if len(qcq.ctx.Fields) > 0 {
 		_spec.Unique = qcq.ctx.Unique != nil && *qcq.ctx.Unique
@@ -712,6 +872,9 @@ func (qcq *QuestionCollectionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if qcq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(questioncollection.FieldOrganizationID)
+		}
 		if qcq.withCreator != nil {
 			_spec.Node.AddColumnOnce(questioncollection.FieldCreatorID)
 		}
@@ -757,6 +920,9 @@ func (qcq *QuestionCollectionQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if qcq.ctx.Unique != nil && *qcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qcq.modifiers {
+		m(selector)
+	}
 	for _, p := range qcq.predicates {
 		p(selector)
 	}
@@ -774,6 +940,32 @@ func (qcq *QuestionCollectionQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qcq *QuestionCollectionQuery) ForUpdate(opts ...sql.LockOption) *QuestionCollectionQuery {
+	if qcq.driver.Dialect() == dialect.Postgres {
+		qcq.Unique(false)
+	}
+	qcq.modifiers = append(qcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qcq *QuestionCollectionQuery) ForShare(opts ...sql.LockOption) *QuestionCollectionQuery {
+	if qcq.driver.Dialect() == dialect.Postgres {
+		qcq.Unique(false)
+	}
+	qcq.modifiers = append(qcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qcq
+}
+
 // QuestionCollectionGroupBy is the group-by builder for QuestionCollection entities.
 type QuestionCollectionGroupBy struct {
 	selector
@@ -6,7 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
@@ -73,6 +75,26 @@ func (qcu *QuestionCollectionUpdate) ClearDeletedAt() *QuestionCollectionUpdate
 	return qcu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (qcu *QuestionCollectionUpdate) SetOrganizationID(u uuid.UUID) *QuestionCollectionUpdate {
+	qcu.mutation.SetOrganizationID(u)
+	return qcu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (qcu *QuestionCollectionUpdate) SetNillableOrganizationID(u *uuid.UUID) *QuestionCollectionUpdate {
+	if u != nil {
+		qcu.SetOrganizationID(*u)
+	}
+	return qcu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (qcu *QuestionCollectionUpdate) ClearOrganizationID() *QuestionCollectionUpdate {
+	qcu.mutation.ClearOrganizationID()
+	return qcu
+}
+
 // SetTitle sets the "title" field.
 func (qcu *QuestionCollectionUpdate) SetTitle(s string) *QuestionCollectionUpdate {
 	qcu.mutation.SetTitle(s)
@@ -141,6 +163,11 @@ func (qcu *QuestionCollectionUpdate) ClearCourseSectionID() *QuestionCollectionU
 	return qcu
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (qcu *QuestionCollectionUpdate) SetOrganization(o *Organization) *QuestionCollectionUpdate {
+	return qcu.SetOrganizationID(o.ID)
+}
+
 // SetCreator sets the "creator" edge to the User entity.
 func (qcu *QuestionCollectionUpdate) SetCreator(u *User) *QuestionCollectionUpdate {
 	return qcu.SetCreatorID(u.ID)
@@ -181,11 +208,32 @@ func (qcu *QuestionCollectionUpdate) AddTest(t ...*Test) *QuestionCollectionUpda
 	return qcu.AddTestIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (qcu *QuestionCollectionUpdate) AddAccessGrantIDs(ids ...uuid.UUID) *QuestionCollectionUpdate {
+	qcu.mutation.AddAccessGrantIDs(ids...)
+	return qcu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (qcu *QuestionCollectionUpdate) AddAccessGrants(a ...*AccessGrant) *QuestionCollectionUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qcu.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the QuestionCollectionMutation object of the builder.
 func (qcu *QuestionCollectionUpdate) Mutation() *QuestionCollectionMutation {
 	return qcu.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (qcu *QuestionCollectionUpdate) ClearOrganization() *QuestionCollectionUpdate {
+	qcu.mutation.ClearOrganization()
+	return qcu
+}
+
 // ClearCreator clears the "creator" edge to the User entity.
 func (qcu *QuestionCollectionUpdate) ClearCreator() *QuestionCollectionUpdate {
 	qcu.mutation.ClearCreator()
@@ -240,6 +288,27 @@ func (qcu *QuestionCollectionUpdate) RemoveTest(t ...*Test) *QuestionCollectionU
 	return qcu.RemoveTestIDs(ids...)
 }
 
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (qcu *QuestionCollectionUpdate) ClearAccessGrants() *QuestionCollectionUpdate {
+	qcu.mutation.ClearAccessGrants()
+	return qcu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (qcu *QuestionCollectionUpdate) RemoveAccessGrantIDs(ids ...uuid.UUID) *QuestionCollectionUpdate {
+	qcu.mutation.RemoveAccessGrantIDs(ids...)
+	return qcu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (qcu *QuestionCollectionUpdate) RemoveAccessGrants(a ...*AccessGrant) *QuestionCollectionUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qcu.RemoveAccessGrantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (qcu *QuestionCollectionUpdate) Save(ctx context.Context) (int, error) {
 	if err := qcu.defaults(); err != nil {
@@ -328,6 +397,35 @@ func (qcu *QuestionCollectionUpdate) sqlSave(ctx context.Context) (n int, err er
 	if qcu.mutation.DescriptionCleared() {
 		_spec.ClearField(questioncollection.FieldDescription, field.TypeString)
 	}
+	if qcu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questioncollection.OrganizationTable,
+			Columns: []string{questioncollection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questioncollection.OrganizationTable,
+			Columns: []string{questioncollection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if qcu.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -476,6 +574,51 @@ func (qcu *QuestionCollectionUpdate) sqlSave(ctx context.Context) (n int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qcu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !qcu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, qcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{questioncollection.Label}
@@ -536,6 +679,26 @@ func (qcuo *QuestionCollectionUpdateOne) ClearDeletedAt() *QuestionCollectionUpd
 	return qcuo
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (qcuo *QuestionCollectionUpdateOne) SetOrganizationID(u uuid.UUID) *QuestionCollectionUpdateOne {
+	qcuo.mutation.SetOrganizationID(u)
+	return qcuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (qcuo *QuestionCollectionUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *QuestionCollectionUpdateOne {
+	if u != nil {
+		qcuo.SetOrganizationID(*u)
+	}
+	return qcuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (qcuo *QuestionCollectionUpdateOne) ClearOrganizationID() *QuestionCollectionUpdateOne {
+	qcuo.mutation.ClearOrganizationID()
+	return qcuo
+}
+
 // SetTitle sets the "title" field.
 func (qcuo *QuestionCollectionUpdateOne) SetTitle(s string) *QuestionCollectionUpdateOne {
 	qcuo.mutation.SetTitle(s)
@@ -604,6 +767,11 @@ func (qcuo *QuestionCollectionUpdateOne) ClearCourseSectionID() *QuestionCollect
 	return qcuo
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (qcuo *QuestionCollectionUpdateOne) SetOrganization(o *Organization) *QuestionCollectionUpdateOne {
+	return qcuo.SetOrganizationID(o.ID)
+}
+
 // SetCreator sets the "creator" edge to the User entity.
 func (qcuo *QuestionCollectionUpdateOne) SetCreator(u *User) *QuestionCollectionUpdateOne {
 	return qcuo.SetCreatorID(u.ID)
@@ -644,11 +812,32 @@ func (qcuo *QuestionCollectionUpdateOne) AddTest(t ...*Test) *QuestionCollection
 	return qcuo.AddTestIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (qcuo *QuestionCollectionUpdateOne) AddAccessGrantIDs(ids ...uuid.UUID) *QuestionCollectionUpdateOne {
+	qcuo.mutation.AddAccessGrantIDs(ids...)
+	return qcuo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (qcuo *QuestionCollectionUpdateOne) AddAccessGrants(a ...*AccessGrant) *QuestionCollectionUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qcuo.AddAccessGrantIDs(ids...)
+}
+
 // Mutation returns the QuestionCollectionMutation object of the builder.
 func (qcuo *QuestionCollectionUpdateOne) Mutation() *QuestionCollectionMutation {
 	return qcuo.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (qcuo *QuestionCollectionUpdateOne) ClearOrganization() *QuestionCollectionUpdateOne {
+	qcuo.mutation.ClearOrganization()
+	return qcuo
+}
+
 // ClearCreator clears the "creator" edge to the User entity.
 func (qcuo *QuestionCollectionUpdateOne) ClearCreator() *QuestionCollectionUpdateOne {
 	qcuo.mutation.ClearCreator()
@@ -703,6 +892,27 @@ func (qcuo *QuestionCollectionUpdateOne) RemoveTest(t ...*Test) *QuestionCollect
 	return qcuo.RemoveTestIDs(ids...)
 }
 
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (qcuo *QuestionCollectionUpdateOne) ClearAccessGrants() *QuestionCollectionUpdateOne {
+	qcuo.mutation.ClearAccessGrants()
+	return qcuo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (qcuo *QuestionCollectionUpdateOne) RemoveAccessGrantIDs(ids ...uuid.UUID) *QuestionCollectionUpdateOne {
+	qcuo.mutation.RemoveAccessGrantIDs(ids...)
+	return qcuo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (qcuo *QuestionCollectionUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *QuestionCollectionUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qcuo.RemoveAccessGrantIDs(ids...)
+}
+
 // Where appends a list predicates to the QuestionCollectionUpdate builder.
 func (qcuo *QuestionCollectionUpdateOne) Where(ps ...predicate.QuestionCollection) *QuestionCollectionUpdateOne {
 	qcuo.mutation.Where(ps...)
@@ -821,6 +1031,35 @@ func (qcuo *QuestionCollectionUpdateOne) sqlSave(ctx context.Context) (_node *Qu
 	if qcuo.mutation.DescriptionCleared() {
 		_spec.ClearField(questioncollection.FieldDescription, field.TypeString)
 	}
+	if qcuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questioncollection.OrganizationTable,
+			Columns: []string{questioncollection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questioncollection.OrganizationTable,
+			Columns: []string{questioncollection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if qcuo.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -969,6 +1208,51 @@ func (qcuo *QuestionCollectionUpdateOne) sqlSave(ctx context.Context) (_node *Qu
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qcuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !qcuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questioncollection.AccessGrantsTable,
+			Columns: []string{questioncollection.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &QuestionCollection{config: qcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -31,6 +31,8 @@ type QuestionOption struct {
 	OptionText string `json:"option_text,omitempty"`
 	// IsCorrect holds the value of the "is_correct" field.
 	IsCorrect bool `json:"is_correct,omitempty"`
+	// Markdown explanation of why the option is correct or not
+	Explanation *string `json:"explanation,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionOptionQuery when eager-loading is set.
 	Edges        QuestionOptionEdges `json:"edges"`
@@ -64,7 +66,7 @@ func (*QuestionOption) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case questionoption.FieldIsCorrect:
 			values[i] = new(sql.NullBool)
-		case questionoption.FieldOptionText:
+		case questionoption.FieldOptionText, questionoption.FieldExplanation:
 			values[i] = new(sql.NullString)
 		case questionoption.FieldCreatedAt, questionoption.FieldUpdatedAt, questionoption.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -128,6 +130,13 @@ func (qo *QuestionOption) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				qo.IsCorrect = value.Bool
 			}
+		case questionoption.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				qo.Explanation = new(string)
+				*qo.Explanation = value.String
+			}
 		default:
 			qo.selectValues.Set(columns[i], values[i])
 		}
@@ -188,6 +197,11 @@ func (qo *QuestionOption) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_correct=")
 	builder.WriteString(fmt.Sprintf("%v", qo.IsCorrect))
+	builder.WriteString(", ")
+	if v := qo.Explanation; v != nil {
+		builder.WriteString("explanation=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,8 @@ const (
 	FieldOptionText = "option_text"
 	// FieldIsCorrect holds the string denoting the is_correct field in the database.
 	FieldIsCorrect = "is_correct"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
 	// EdgeQuestion holds the string denoting the question edge name in mutations.
 	EdgeQuestion = "question"
 	// Table holds the table name of the questionoption in the database.
@@ -50,6 +52,7 @@ var Columns = []string{
 	FieldQuestionID,
 	FieldOptionText,
 	FieldIsCorrect,
+	FieldExplanation,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -68,8 +71,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -122,6 +125,11 @@ func ByIsCorrect(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsCorrect, opts...).ToFunc()
 }
 
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
 // ByQuestionField orders the results by question field.
 func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -86,6 +86,11 @@ func IsCorrect(v bool) predicate.QuestionOption {
 	return predicate.QuestionOption(sql.FieldEQ(FieldIsCorrect, v))
 }
 
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldEQ(FieldExplanation, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.QuestionOption {
 	return predicate.QuestionOption(sql.FieldEQ(FieldCreatedAt, v))
@@ -311,6 +316,81 @@ func IsCorrectNEQ(v bool) predicate.QuestionOption {
 	return predicate.QuestionOption(sql.FieldNEQ(FieldIsCorrect, v))
 }
 
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.QuestionOption {
+	return predicate.QuestionOption(sql.FieldContainsFold(FieldExplanation, v))
+}
+
 // HasQuestion applies the HasEdge predicate on the "question" edge.
 func HasQuestion() predicate.QuestionOption {
 	return predicate.QuestionOption(func(s *sql.Selector) {
@@ -90,6 +90,20 @@ func (qoc *QuestionOptionCreate) SetNillableIsCorrect(b *bool) *QuestionOptionCr
 	return qoc
 }
 
+// SetExplanation sets the "explanation" field.
+func (qoc *QuestionOptionCreate) SetExplanation(s string) *QuestionOptionCreate {
+	qoc.mutation.SetExplanation(s)
+	return qoc
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qoc *QuestionOptionCreate) SetNillableExplanation(s *string) *QuestionOptionCreate {
+	if s != nil {
+		qoc.SetExplanation(*s)
+	}
+	return qoc
+}
+
 // SetID sets the "id" field.
 func (qoc *QuestionOptionCreate) SetID(u uuid.UUID) *QuestionOptionCreate {
 	qoc.mutation.SetID(u)
@@ -254,6 +268,10 @@ func (qoc *QuestionOptionCreate) createSpec() (*QuestionOption, *sqlgraph.Create
 		_spec.SetField(questionoption.FieldIsCorrect, field.TypeBool, value)
 		_node.IsCorrect = value
 	}
+	if value, ok := qoc.mutation.Explanation(); ok {
+		_spec.SetField(questionoption.FieldExplanation, field.TypeString, value)
+		_node.Explanation = &value
+	}
 	if nodes := qoc.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -11,6 +11,7 @@ import (
 	"template/internal/ent/questionoption"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type QuestionOptionQuery struct {
 	inters       []Interceptor
 	predicates   []predicate.QuestionOption
 	withQuestion *QuestionQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -384,6 +386,9 @@ func (qoq *QuestionOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qoq.modifiers) > 0 {
+		_spec.Modifiers = qoq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,6 +439,9 @@ func (qoq *QuestionOptionQuery) loadQuestion(ctx context.Context, query *Questio
 
 func (qoq *QuestionOptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qoq.querySpec()
+	if len(qoq.modifiers) > 0 {
+		_spec.Modifiers = qoq.modifiers
+	}
 	_spec.Node.Columns = qoq.ctx.Fields
 	if len(qoq.ctx.Fields) > 0 {
 		_spec.Unique = qoq.ctx.Unique != nil && *qoq.ctx.Unique
@@ -499,6 +507,9 @@ func (qoq *QuestionOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if qoq.ctx.Unique != nil && *qoq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qoq.modifiers {
+		m(selector)
+	}
 	for _, p := range qoq.predicates {
 		p(selector)
 	}
@@ -516,6 +527,32 @@ func (qoq *QuestionOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qoq *QuestionOptionQuery) ForUpdate(opts ...sql.LockOption) *QuestionOptionQuery {
+	if qoq.driver.Dialect() == dialect.Postgres {
+		qoq.Unique(false)
+	}
+	qoq.modifiers = append(qoq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qoq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qoq *QuestionOptionQuery) ForShare(opts ...sql.LockOption) *QuestionOptionQuery {
+	if qoq.driver.Dialect() == dialect.Postgres {
+		qoq.Unique(false)
+	}
+	qoq.modifiers = append(qoq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qoq
+}
+
 // QuestionOptionGroupBy is the group-by builder for QuestionOption entities.
 type QuestionOptionGroupBy struct {
 	selector
@@ -112,6 +112,26 @@ func (qou *QuestionOptionUpdate) SetNillableIsCorrect(b *bool) *QuestionOptionUp
 	return qou
 }
 
+// SetExplanation sets the "explanation" field.
+func (qou *QuestionOptionUpdate) SetExplanation(s string) *QuestionOptionUpdate {
+	qou.mutation.SetExplanation(s)
+	return qou
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qou *QuestionOptionUpdate) SetNillableExplanation(s *string) *QuestionOptionUpdate {
+	if s != nil {
+		qou.SetExplanation(*s)
+	}
+	return qou
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (qou *QuestionOptionUpdate) ClearExplanation() *QuestionOptionUpdate {
+	qou.mutation.ClearExplanation()
+	return qou
+}
+
 // SetQuestion sets the "question" edge to the Question entity.
 func (qou *QuestionOptionUpdate) SetQuestion(q *Question) *QuestionOptionUpdate {
 	return qou.SetQuestionID(q.ID)
@@ -213,6 +233,12 @@ func (qou *QuestionOptionUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := qou.mutation.IsCorrect(); ok {
 		_spec.SetField(questionoption.FieldIsCorrect, field.TypeBool, value)
 	}
+	if value, ok := qou.mutation.Explanation(); ok {
+		_spec.SetField(questionoption.FieldExplanation, field.TypeString, value)
+	}
+	if qou.mutation.ExplanationCleared() {
+		_spec.ClearField(questionoption.FieldExplanation, field.TypeString)
+	}
 	if qou.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -344,6 +370,26 @@ func (qouo *QuestionOptionUpdateOne) SetNillableIsCorrect(b *bool) *QuestionOpti
 	return qouo
 }
 
+// SetExplanation sets the "explanation" field.
+func (qouo *QuestionOptionUpdateOne) SetExplanation(s string) *QuestionOptionUpdateOne {
+	qouo.mutation.SetExplanation(s)
+	return qouo
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qouo *QuestionOptionUpdateOne) SetNillableExplanation(s *string) *QuestionOptionUpdateOne {
+	if s != nil {
+		qouo.SetExplanation(*s)
+	}
+	return qouo
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (qouo *QuestionOptionUpdateOne) ClearExplanation() *QuestionOptionUpdateOne {
+	qouo.mutation.ClearExplanation()
+	return qouo
+}
+
 // SetQuestion sets the "question" edge to the Question entity.
 func (qouo *QuestionOptionUpdateOne) SetQuestion(q *Question) *QuestionOptionUpdateOne {
 	return qouo.SetQuestionID(q.ID)
@@ -475,6 +521,12 @@ func (qouo *QuestionOptionUpdateOne) sqlSave(ctx context.Context) (_node *Questi
 	if value, ok := qouo.mutation.IsCorrect(); ok {
 		_spec.SetField(questionoption.FieldIsCorrect, field.TypeBool, value)
 	}
+	if value, ok := qouo.mutation.Explanation(); ok {
+		_spec.SetField(questionoption.FieldExplanation, field.TypeString, value)
+	}
+	if qouo.mutation.ExplanationCleared() {
+		_spec.ClearField(questionoption.FieldExplanation, field.TypeString)
+	}
 	if qouo.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QuestionVersion is the model entity for the QuestionVersion schema.
+type QuestionVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// QuestionID holds the value of the "question_id" field.
+	QuestionID uuid.UUID `json:"question_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// QuestionText holds the value of the "question_text" field.
+	QuestionText string `json:"question_text,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int `json:"points,omitempty"`
+	// Explanation holds the value of the "explanation" field.
+	Explanation *string `json:"explanation,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionVersionQuery when eager-loading is set.
+	Edges        QuestionVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionVersionEdges holds the relations/edges for other nodes in the graph.
+type QuestionVersionEdges struct {
+	// Question holds the value of the question edge.
+	Question *Question `json:"question,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// Options holds the value of the options edge.
+	Options []*QuestionVersionOption `json:"options,omitempty"`
+	// TestSessionAnswers holds the value of the test_session_answers edge.
+	TestSessionAnswers []*TestSessionAnswer `json:"test_session_answers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// QuestionOrErr returns the Question value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionVersionEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
+		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "question"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionVersionEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// OptionsOrErr returns the Options value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionVersionEdges) OptionsOrErr() ([]*QuestionVersionOption, error) {
+	if e.loadedTypes[2] {
+		return e.Options, nil
+	}
+	return nil, &NotLoadedError{edge: "options"}
+}
+
+// TestSessionAnswersOrErr returns the TestSessionAnswers value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionVersionEdges) TestSessionAnswersOrErr() ([]*TestSessionAnswer, error) {
+	if e.loadedTypes[3] {
+		return e.TestSessionAnswers, nil
+	}
+	return nil, &NotLoadedError{edge: "test_session_answers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionversion.FieldCreatedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case questionversion.FieldVersion, questionversion.FieldPoints:
+			values[i] = new(sql.NullInt64)
+		case questionversion.FieldQuestionText, questionversion.FieldExplanation:
+			values[i] = new(sql.NullString)
+		case questionversion.FieldCreatedAt, questionversion.FieldUpdatedAt, questionversion.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case questionversion.FieldID, questionversion.FieldQuestionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionVersion fields.
+func (qv *QuestionVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				qv.ID = *value
+			}
+		case questionversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qv.CreatedAt = value.Time
+			}
+		case questionversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qv.UpdatedAt = value.Time
+			}
+		case questionversion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				qv.DeletedAt = new(time.Time)
+				*qv.DeletedAt = value.Time
+			}
+		case questionversion.FieldQuestionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value != nil {
+				qv.QuestionID = *value
+			}
+		case questionversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				qv.Version = int(value.Int64)
+			}
+		case questionversion.FieldQuestionText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_text", values[i])
+			} else if value.Valid {
+				qv.QuestionText = value.String
+			}
+		case questionversion.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				qv.Points = int(value.Int64)
+			}
+		case questionversion.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				qv.Explanation = new(string)
+				*qv.Explanation = value.String
+			}
+		case questionversion.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				qv.CreatedByID = new(uuid.UUID)
+				*qv.CreatedByID = *value.S.(*uuid.UUID)
+			}
+		default:
+			qv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionVersion.
+// This includes values selected through modifiers, order, etc.
+func (qv *QuestionVersion) Value(name string) (ent.Value, error) {
+	return qv.selectValues.Get(name)
+}
+
+// QueryQuestion queries the "question" edge of the QuestionVersion entity.
+func (qv *QuestionVersion) QueryQuestion() *QuestionQuery {
+	return NewQuestionVersionClient(qv.config).QueryQuestion(qv)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the QuestionVersion entity.
+func (qv *QuestionVersion) QueryCreatedBy() *UserQuery {
+	return NewQuestionVersionClient(qv.config).QueryCreatedBy(qv)
+}
+
+// QueryOptions queries the "options" edge of the QuestionVersion entity.
+func (qv *QuestionVersion) QueryOptions() *QuestionVersionOptionQuery {
+	return NewQuestionVersionClient(qv.config).QueryOptions(qv)
+}
+
+// QueryTestSessionAnswers queries the "test_session_answers" edge of the QuestionVersion entity.
+func (qv *QuestionVersion) QueryTestSessionAnswers() *TestSessionAnswerQuery {
+	return NewQuestionVersionClient(qv.config).QueryTestSessionAnswers(qv)
+}
+
+// Update returns a builder for updating this QuestionVersion.
+// Note that you need to call QuestionVersion.Unwrap() before calling this method if this QuestionVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qv *QuestionVersion) Update() *QuestionVersionUpdateOne {
+	return NewQuestionVersionClient(qv.config).UpdateOne(qv)
+}
+
+// Unwrap unwraps the QuestionVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qv *QuestionVersion) Unwrap() *QuestionVersion {
+	_tx, ok := qv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionVersion is not a transactional entity")
+	}
+	qv.config.driver = _tx.drv
+	return qv
+}
+
+// String implements the fmt.Stringer.
+func (qv *QuestionVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(qv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(qv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := qv.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("question_id=")
+	builder.WriteString(fmt.Sprintf("%v", qv.QuestionID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", qv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("question_text=")
+	builder.WriteString(qv.QuestionText)
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", qv.Points))
+	builder.WriteString(", ")
+	if v := qv.Explanation; v != nil {
+		builder.WriteString("explanation=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := qv.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionVersions is a parsable slice of QuestionVersion.
+type QuestionVersions []*QuestionVersion
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionversion
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// QuestionID applies equality check predicate on the "question_id" field. It's identical to QuestionIDEQ.
+func QuestionID(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// QuestionText applies equality check predicate on the "question_text" field. It's identical to QuestionTextEQ.
+func QuestionText(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldPoints, v))
+}
+
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldExplanation, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// QuestionIDEQ applies the EQ predicate on the "question_id" field.
+func QuestionIDEQ(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionIDNEQ applies the NEQ predicate on the "question_id" field.
+func QuestionIDNEQ(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldQuestionID, v))
+}
+
+// QuestionIDIn applies the In predicate on the "question_id" field.
+func QuestionIDIn(vs ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDNotIn applies the NotIn predicate on the "question_id" field.
+func QuestionIDNotIn(vs ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldQuestionID, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// QuestionTextEQ applies the EQ predicate on the "question_text" field.
+func QuestionTextEQ(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// QuestionTextNEQ applies the NEQ predicate on the "question_text" field.
+func QuestionTextNEQ(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldQuestionText, v))
+}
+
+// QuestionTextIn applies the In predicate on the "question_text" field.
+func QuestionTextIn(vs ...string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextNotIn applies the NotIn predicate on the "question_text" field.
+func QuestionTextNotIn(vs ...string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextGT applies the GT predicate on the "question_text" field.
+func QuestionTextGT(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldQuestionText, v))
+}
+
+// QuestionTextGTE applies the GTE predicate on the "question_text" field.
+func QuestionTextGTE(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldQuestionText, v))
+}
+
+// QuestionTextLT applies the LT predicate on the "question_text" field.
+func QuestionTextLT(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldQuestionText, v))
+}
+
+// QuestionTextLTE applies the LTE predicate on the "question_text" field.
+func QuestionTextLTE(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldQuestionText, v))
+}
+
+// QuestionTextContains applies the Contains predicate on the "question_text" field.
+func QuestionTextContains(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldContains(FieldQuestionText, v))
+}
+
+// QuestionTextHasPrefix applies the HasPrefix predicate on the "question_text" field.
+func QuestionTextHasPrefix(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldHasPrefix(FieldQuestionText, v))
+}
+
+// QuestionTextHasSuffix applies the HasSuffix predicate on the "question_text" field.
+func QuestionTextHasSuffix(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldHasSuffix(FieldQuestionText, v))
+}
+
+// QuestionTextEqualFold applies the EqualFold predicate on the "question_text" field.
+func QuestionTextEqualFold(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEqualFold(FieldQuestionText, v))
+}
+
+// QuestionTextContainsFold applies the ContainsFold predicate on the "question_text" field.
+func QuestionTextContainsFold(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldContainsFold(FieldQuestionText, v))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldPoints, v))
+}
+
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldContainsFold(FieldExplanation, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...uuid.UUID) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// HasQuestion applies the HasEdge predicate on the "question" edge.
+func HasQuestion() predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionWith applies the HasEdge predicate on the "question" edge with a given conditions (other predicates).
+func HasQuestionWith(preds ...predicate.Question) predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := newQuestionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOptions applies the HasEdge predicate on the "options" edge.
+func HasOptions() predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOptionsWith applies the HasEdge predicate on the "options" edge with a given conditions (other predicates).
+func HasOptionsWith(preds ...predicate.QuestionVersionOption) predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := newOptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTestSessionAnswers applies the HasEdge predicate on the "test_session_answers" edge.
+func HasTestSessionAnswers() predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TestSessionAnswersTable, TestSessionAnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestSessionAnswersWith applies the HasEdge predicate on the "test_session_answers" edge with a given conditions (other predicates).
+func HasTestSessionAnswersWith(preds ...predicate.TestSessionAnswer) predicate.QuestionVersion {
+	return predicate.QuestionVersion(func(s *sql.Selector) {
+		step := newTestSessionAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionVersion) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionVersion) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionVersion) predicate.QuestionVersion {
+	return predicate.QuestionVersion(sql.NotPredicates(p))
+}
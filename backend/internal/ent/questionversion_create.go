@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionCreate is the builder for creating a QuestionVersion entity.
+type QuestionVersionCreate struct {
+	config
+	mutation *QuestionVersionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvc *QuestionVersionCreate) SetCreatedAt(t time.Time) *QuestionVersionCreate {
+	qvc.mutation.SetCreatedAt(t)
+	return qvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableCreatedAt(t *time.Time) *QuestionVersionCreate {
+	if t != nil {
+		qvc.SetCreatedAt(*t)
+	}
+	return qvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvc *QuestionVersionCreate) SetUpdatedAt(t time.Time) *QuestionVersionCreate {
+	qvc.mutation.SetUpdatedAt(t)
+	return qvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableUpdatedAt(t *time.Time) *QuestionVersionCreate {
+	if t != nil {
+		qvc.SetUpdatedAt(*t)
+	}
+	return qvc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvc *QuestionVersionCreate) SetDeletedAt(t time.Time) *QuestionVersionCreate {
+	qvc.mutation.SetDeletedAt(t)
+	return qvc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableDeletedAt(t *time.Time) *QuestionVersionCreate {
+	if t != nil {
+		qvc.SetDeletedAt(*t)
+	}
+	return qvc
+}
+
+// SetQuestionID sets the "question_id" field.
+func (qvc *QuestionVersionCreate) SetQuestionID(u uuid.UUID) *QuestionVersionCreate {
+	qvc.mutation.SetQuestionID(u)
+	return qvc
+}
+
+// SetVersion sets the "version" field.
+func (qvc *QuestionVersionCreate) SetVersion(i int) *QuestionVersionCreate {
+	qvc.mutation.SetVersion(i)
+	return qvc
+}
+
+// SetQuestionText sets the "question_text" field.
+func (qvc *QuestionVersionCreate) SetQuestionText(s string) *QuestionVersionCreate {
+	qvc.mutation.SetQuestionText(s)
+	return qvc
+}
+
+// SetPoints sets the "points" field.
+func (qvc *QuestionVersionCreate) SetPoints(i int) *QuestionVersionCreate {
+	qvc.mutation.SetPoints(i)
+	return qvc
+}
+
+// SetExplanation sets the "explanation" field.
+func (qvc *QuestionVersionCreate) SetExplanation(s string) *QuestionVersionCreate {
+	qvc.mutation.SetExplanation(s)
+	return qvc
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableExplanation(s *string) *QuestionVersionCreate {
+	if s != nil {
+		qvc.SetExplanation(*s)
+	}
+	return qvc
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (qvc *QuestionVersionCreate) SetCreatedByID(u uuid.UUID) *QuestionVersionCreate {
+	qvc.mutation.SetCreatedByID(u)
+	return qvc
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableCreatedByID(u *uuid.UUID) *QuestionVersionCreate {
+	if u != nil {
+		qvc.SetCreatedByID(*u)
+	}
+	return qvc
+}
+
+// SetID sets the "id" field.
+func (qvc *QuestionVersionCreate) SetID(u uuid.UUID) *QuestionVersionCreate {
+	qvc.mutation.SetID(u)
+	return qvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qvc *QuestionVersionCreate) SetNillableID(u *uuid.UUID) *QuestionVersionCreate {
+	if u != nil {
+		qvc.SetID(*u)
+	}
+	return qvc
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (qvc *QuestionVersionCreate) SetQuestion(q *Question) *QuestionVersionCreate {
+	return qvc.SetQuestionID(q.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (qvc *QuestionVersionCreate) SetCreatedBy(u *User) *QuestionVersionCreate {
+	return qvc.SetCreatedByID(u.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the QuestionVersionOption entity by IDs.
+func (qvc *QuestionVersionCreate) AddOptionIDs(ids ...uuid.UUID) *QuestionVersionCreate {
+	qvc.mutation.AddOptionIDs(ids...)
+	return qvc
+}
+
+// AddOptions adds the "options" edges to the QuestionVersionOption entity.
+func (qvc *QuestionVersionCreate) AddOptions(q ...*QuestionVersionOption) *QuestionVersionCreate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qvc.AddOptionIDs(ids...)
+}
+
+// AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
+func (qvc *QuestionVersionCreate) AddTestSessionAnswerIDs(ids ...uuid.UUID) *QuestionVersionCreate {
+	qvc.mutation.AddTestSessionAnswerIDs(ids...)
+	return qvc
+}
+
+// AddTestSessionAnswers adds the "test_session_answers" edges to the TestSessionAnswer entity.
+func (qvc *QuestionVersionCreate) AddTestSessionAnswers(t ...*TestSessionAnswer) *QuestionVersionCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return qvc.AddTestSessionAnswerIDs(ids...)
+}
+
+// Mutation returns the QuestionVersionMutation object of the builder.
+func (qvc *QuestionVersionCreate) Mutation() *QuestionVersionMutation {
+	return qvc.mutation
+}
+
+// Save creates the QuestionVersion in the database.
+func (qvc *QuestionVersionCreate) Save(ctx context.Context) (*QuestionVersion, error) {
+	if err := qvc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, qvc.sqlSave, qvc.mutation, qvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qvc *QuestionVersionCreate) SaveX(ctx context.Context) *QuestionVersion {
+	v, err := qvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qvc *QuestionVersionCreate) Exec(ctx context.Context) error {
+	_, err := qvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvc *QuestionVersionCreate) ExecX(ctx context.Context) {
+	if err := qvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvc *QuestionVersionCreate) defaults() error {
+	if _, ok := qvc.mutation.CreatedAt(); !ok {
+		if questionversion.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversion.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversion.DefaultCreatedAt()
+		qvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qvc.mutation.UpdatedAt(); !ok {
+		if questionversion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversion.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversion.DefaultUpdatedAt()
+		qvc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qvc.mutation.ID(); !ok {
+		if questionversion.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized questionversion.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := questionversion.DefaultID()
+		qvc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvc *QuestionVersionCreate) check() error {
+	if _, ok := qvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuestionVersion.created_at"`)}
+	}
+	if _, ok := qvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuestionVersion.updated_at"`)}
+	}
+	if _, ok := qvc.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "QuestionVersion.question_id"`)}
+	}
+	if _, ok := qvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "QuestionVersion.version"`)}
+	}
+	if v, ok := qvc.mutation.Version(); ok {
+		if err := questionversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "QuestionVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := qvc.mutation.QuestionText(); !ok {
+		return &ValidationError{Name: "question_text", err: errors.New(`ent: missing required field "QuestionVersion.question_text"`)}
+	}
+	if _, ok := qvc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "QuestionVersion.points"`)}
+	}
+	if len(qvc.mutation.QuestionIDs()) == 0 {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "QuestionVersion.question"`)}
+	}
+	return nil
+}
+
+func (qvc *QuestionVersionCreate) sqlSave(ctx context.Context) (*QuestionVersion, error) {
+	if err := qvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qvc.mutation.id = &_node.ID
+	qvc.mutation.done = true
+	return _node, nil
+}
+
+func (qvc *QuestionVersionCreate) createSpec() (*QuestionVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionVersion{config: qvc.config}
+		_spec = sqlgraph.NewCreateSpec(questionversion.Table, sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID))
+	)
+	if id, ok := qvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qvc.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qvc.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversion.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := qvc.mutation.Version(); ok {
+		_spec.SetField(questionversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := qvc.mutation.QuestionText(); ok {
+		_spec.SetField(questionversion.FieldQuestionText, field.TypeString, value)
+		_node.QuestionText = value
+	}
+	if value, ok := qvc.mutation.Points(); ok {
+		_spec.SetField(questionversion.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
+	if value, ok := qvc.mutation.Explanation(); ok {
+		_spec.SetField(questionversion.FieldExplanation, field.TypeString, value)
+		_node.Explanation = &value
+	}
+	if nodes := qvc.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionversion.QuestionTable,
+			Columns: []string{questionversion.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuestionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qvc.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionversion.CreatedByTable,
+			Columns: []string{questionversion.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qvc.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qvc.mutation.TestSessionAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuestionVersionCreateBulk is the builder for creating many QuestionVersion entities in bulk.
+type QuestionVersionCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionVersionCreate
+}
+
+// Save creates the QuestionVersion entities in the database.
+func (qvcb *QuestionVersionCreateBulk) Save(ctx context.Context) ([]*QuestionVersion, error) {
+	if qvcb.err != nil {
+		return nil, qvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qvcb.builders))
+	nodes := make([]*QuestionVersion, len(qvcb.builders))
+	mutators := make([]Mutator, len(qvcb.builders))
+	for i := range qvcb.builders {
+		func(i int, root context.Context) {
+			builder := qvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvcb *QuestionVersionCreateBulk) SaveX(ctx context.Context) []*QuestionVersion {
+	v, err := qvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qvcb *QuestionVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := qvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvcb *QuestionVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := qvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
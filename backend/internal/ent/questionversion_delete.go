@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questionversion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuestionVersionDelete is the builder for deleting a QuestionVersion entity.
+type QuestionVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestionVersionMutation
+}
+
+// Where appends a list predicates to the QuestionVersionDelete builder.
+func (qvd *QuestionVersionDelete) Where(ps ...predicate.QuestionVersion) *QuestionVersionDelete {
+	qvd.mutation.Where(ps...)
+	return qvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qvd *QuestionVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qvd.sqlExec, qvd.mutation, qvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvd *QuestionVersionDelete) ExecX(ctx context.Context) int {
+	n, err := qvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qvd *QuestionVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questionversion.Table, sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID))
+	if ps := qvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qvd.mutation.done = true
+	return affected, err
+}
+
+// QuestionVersionDeleteOne is the builder for deleting a single QuestionVersion entity.
+type QuestionVersionDeleteOne struct {
+	qvd *QuestionVersionDelete
+}
+
+// Where appends a list predicates to the QuestionVersionDelete builder.
+func (qvdo *QuestionVersionDeleteOne) Where(ps ...predicate.QuestionVersion) *QuestionVersionDeleteOne {
+	qvdo.qvd.mutation.Where(ps...)
+	return qvdo
+}
+
+// Exec executes the deletion query.
+func (qvdo *QuestionVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := qvdo.qvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questionversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvdo *QuestionVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := qvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
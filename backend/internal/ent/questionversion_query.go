@@ -0,0 +1,876 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionQuery is the builder for querying QuestionVersion entities.
+type QuestionVersionQuery struct {
+	config
+	ctx                    *QueryContext
+	order                  []questionversion.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.QuestionVersion
+	withQuestion           *QuestionQuery
+	withCreatedBy          *UserQuery
+	withOptions            *QuestionVersionOptionQuery
+	withTestSessionAnswers *TestSessionAnswerQuery
+	modifiers              []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuestionVersionQuery builder.
+func (qvq *QuestionVersionQuery) Where(ps ...predicate.QuestionVersion) *QuestionVersionQuery {
+	qvq.predicates = append(qvq.predicates, ps...)
+	return qvq
+}
+
+// Limit the number of records to be returned by this query.
+func (qvq *QuestionVersionQuery) Limit(limit int) *QuestionVersionQuery {
+	qvq.ctx.Limit = &limit
+	return qvq
+}
+
+// Offset to start from.
+func (qvq *QuestionVersionQuery) Offset(offset int) *QuestionVersionQuery {
+	qvq.ctx.Offset = &offset
+	return qvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qvq *QuestionVersionQuery) Unique(unique bool) *QuestionVersionQuery {
+	qvq.ctx.Unique = &unique
+	return qvq
+}
+
+// Order specifies how the records should be ordered.
+func (qvq *QuestionVersionQuery) Order(o ...questionversion.OrderOption) *QuestionVersionQuery {
+	qvq.order = append(qvq.order, o...)
+	return qvq
+}
+
+// QueryQuestion chains the current query on the "question" edge.
+func (qvq *QuestionVersionQuery) QueryQuestion() *QuestionQuery {
+	query := (&QuestionClient{config: qvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, selector),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversion.QuestionTable, questionversion.QuestionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedBy chains the current query on the "created_by" edge.
+func (qvq *QuestionVersionQuery) QueryCreatedBy() *UserQuery {
+	query := (&UserClient{config: qvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversion.CreatedByTable, questionversion.CreatedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOptions chains the current query on the "options" edge.
+func (qvq *QuestionVersionQuery) QueryOptions() *QuestionVersionOptionQuery {
+	query := (&QuestionVersionOptionClient{config: qvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, selector),
+			sqlgraph.To(questionversionoption.Table, questionversionoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionversion.OptionsTable, questionversion.OptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTestSessionAnswers chains the current query on the "test_session_answers" edge.
+func (qvq *QuestionVersionQuery) QueryTestSessionAnswers() *TestSessionAnswerQuery {
+	query := (&TestSessionAnswerClient{config: qvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionversion.Table, questionversion.FieldID, selector),
+			sqlgraph.To(testsessionanswer.Table, testsessionanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionversion.TestSessionAnswersTable, questionversion.TestSessionAnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuestionVersion entity from the query.
+// Returns a *NotFoundError when no QuestionVersion was found.
+func (qvq *QuestionVersionQuery) First(ctx context.Context) (*QuestionVersion, error) {
+	nodes, err := qvq.Limit(1).All(setContextOp(ctx, qvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{questionversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) FirstX(ctx context.Context) *QuestionVersion {
+	node, err := qvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuestionVersion ID from the query.
+// Returns a *NotFoundError when no QuestionVersion ID was found.
+func (qvq *QuestionVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qvq.Limit(1).IDs(setContextOp(ctx, qvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{questionversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := qvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuestionVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuestionVersion entity is found.
+// Returns a *NotFoundError when no QuestionVersion entities are found.
+func (qvq *QuestionVersionQuery) Only(ctx context.Context) (*QuestionVersion, error) {
+	nodes, err := qvq.Limit(2).All(setContextOp(ctx, qvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{questionversion.Label}
+	default:
+		return nil, &NotSingularError{questionversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) OnlyX(ctx context.Context) *QuestionVersion {
+	node, err := qvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuestionVersion ID in the query.
+// Returns a *NotSingularError when more than one QuestionVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qvq *QuestionVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qvq.Limit(2).IDs(setContextOp(ctx, qvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{questionversion.Label}
+	default:
+		err = &NotSingularError{questionversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := qvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuestionVersions.
+func (qvq *QuestionVersionQuery) All(ctx context.Context) ([]*QuestionVersion, error) {
+	ctx = setContextOp(ctx, qvq.ctx, ent.OpQueryAll)
+	if err := qvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuestionVersion, *QuestionVersionQuery]()
+	return withInterceptors[[]*QuestionVersion](ctx, qvq, qr, qvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) AllX(ctx context.Context) []*QuestionVersion {
+	nodes, err := qvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuestionVersion IDs.
+func (qvq *QuestionVersionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if qvq.ctx.Unique == nil && qvq.path != nil {
+		qvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qvq.ctx, ent.OpQueryIDs)
+	if err = qvq.Select(questionversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := qvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qvq *QuestionVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qvq.ctx, ent.OpQueryCount)
+	if err := qvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qvq, querierCount[*QuestionVersionQuery](), qvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) CountX(ctx context.Context) int {
+	count, err := qvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qvq *QuestionVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qvq.ctx, ent.OpQueryExist)
+	switch _, err := qvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qvq *QuestionVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := qvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuestionVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qvq *QuestionVersionQuery) Clone() *QuestionVersionQuery {
+	if qvq == nil {
+		return nil
+	}
+	return &QuestionVersionQuery{
+		config:                 qvq.config,
+		ctx:                    qvq.ctx.Clone(),
+		order:                  append([]questionversion.OrderOption{}, qvq.order...),
+		inters:                 append([]Interceptor{}, qvq.inters...),
+		predicates:             append([]predicate.QuestionVersion{}, qvq.predicates...),
+		withQuestion:           qvq.withQuestion.Clone(),
+		withCreatedBy:          qvq.withCreatedBy.Clone(),
+		withOptions:            qvq.withOptions.Clone(),
+		withTestSessionAnswers: qvq.withTestSessionAnswers.Clone(),
+		// clone intermediate query.
+		sql:  qvq.sql.Clone(),
+		path: qvq.path,
+	}
+}
+
+// WithQuestion tells the query-builder to eager-load the nodes that are connected to
+// the "question" edge. The optional arguments are used to configure the query builder of the edge.
+func (qvq *QuestionVersionQuery) WithQuestion(opts ...func(*QuestionQuery)) *QuestionVersionQuery {
+	query := (&QuestionClient{config: qvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qvq.withQuestion = query
+	return qvq
+}
+
+// WithCreatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "created_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (qvq *QuestionVersionQuery) WithCreatedBy(opts ...func(*UserQuery)) *QuestionVersionQuery {
+	query := (&UserClient{config: qvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qvq.withCreatedBy = query
+	return qvq
+}
+
+// WithOptions tells the query-builder to eager-load the nodes that are connected to
+// the "options" edge. The optional arguments are used to configure the query builder of the edge.
+func (qvq *QuestionVersionQuery) WithOptions(opts ...func(*QuestionVersionOptionQuery)) *QuestionVersionQuery {
+	query := (&QuestionVersionOptionClient{config: qvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qvq.withOptions = query
+	return qvq
+}
+
+// WithTestSessionAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "test_session_answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (qvq *QuestionVersionQuery) WithTestSessionAnswers(opts ...func(*TestSessionAnswerQuery)) *QuestionVersionQuery {
+	query := (&TestSessionAnswerClient{config: qvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qvq.withTestSessionAnswers = query
+	return qvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuestionVersion.Query().
+//		GroupBy(questionversion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qvq *QuestionVersionQuery) GroupBy(field string, fields ...string) *QuestionVersionGroupBy {
+	qvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuestionVersionGroupBy{build: qvq}
+	grbuild.flds = &qvq.ctx.Fields
+	grbuild.label = questionversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QuestionVersion.Query().
+//		Select(questionversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (qvq *QuestionVersionQuery) Select(fields ...string) *QuestionVersionSelect {
+	qvq.ctx.Fields = append(qvq.ctx.Fields, fields...)
+	sbuild := &QuestionVersionSelect{QuestionVersionQuery: qvq}
+	sbuild.label = questionversion.Label
+	sbuild.flds, sbuild.scan = &qvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuestionVersionSelect configured with the given aggregations.
+func (qvq *QuestionVersionQuery) Aggregate(fns ...AggregateFunc) *QuestionVersionSelect {
+	return qvq.Select().Aggregate(fns...)
+}
+
+func (qvq *QuestionVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qvq.ctx.Fields {
+		if !questionversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qvq.path != nil {
+		prev, err := qvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qvq.sql = prev
+	}
+	return nil
+}
+
+func (qvq *QuestionVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestionVersion, error) {
+	var (
+		nodes       = []*QuestionVersion{}
+		_spec       = qvq.querySpec()
+		loadedTypes = [4]bool{
+			qvq.withQuestion != nil,
+			qvq.withCreatedBy != nil,
+			qvq.withOptions != nil,
+			qvq.withTestSessionAnswers != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuestionVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuestionVersion{config: qvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(qvq.modifiers) > 0 {
+		_spec.Modifiers = qvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qvq.withQuestion; query != nil {
+		if err := qvq.loadQuestion(ctx, query, nodes, nil,
+			func(n *QuestionVersion, e *Question) { n.Edges.Question = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := qvq.withCreatedBy; query != nil {
+		if err := qvq.loadCreatedBy(ctx, query, nodes, nil,
+			func(n *QuestionVersion, e *User) { n.Edges.CreatedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := qvq.withOptions; query != nil {
+		if err := qvq.loadOptions(ctx, query, nodes,
+			func(n *QuestionVersion) { n.Edges.Options = []*QuestionVersionOption{} },
+			func(n *QuestionVersion, e *QuestionVersionOption) { n.Edges.Options = append(n.Edges.Options, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := qvq.withTestSessionAnswers; query != nil {
+		if err := qvq.loadTestSessionAnswers(ctx, query, nodes,
+			func(n *QuestionVersion) { n.Edges.TestSessionAnswers = []*TestSessionAnswer{} },
+			func(n *QuestionVersion, e *TestSessionAnswer) {
+				n.Edges.TestSessionAnswers = append(n.Edges.TestSessionAnswers, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qvq *QuestionVersionQuery) loadQuestion(ctx context.Context, query *QuestionQuery, nodes []*QuestionVersion, init func(*QuestionVersion), assign func(*QuestionVersion, *Question)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionVersion)
+	for i := range nodes {
+		fk := nodes[i].QuestionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(question.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "question_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (qvq *QuestionVersionQuery) loadCreatedBy(ctx context.Context, query *UserQuery, nodes []*QuestionVersion, init func(*QuestionVersion), assign func(*QuestionVersion, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionVersion)
+	for i := range nodes {
+		if nodes[i].CreatedByID == nil {
+			continue
+		}
+		fk := *nodes[i].CreatedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "created_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (qvq *QuestionVersionQuery) loadOptions(ctx context.Context, query *QuestionVersionOptionQuery, nodes []*QuestionVersion, init func(*QuestionVersion), assign func(*QuestionVersion, *QuestionVersionOption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionVersion)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionversionoption.FieldVersionID)
+	}
+	query.Where(predicate.QuestionVersionOption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionversion.OptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VersionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "version_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (qvq *QuestionVersionQuery) loadTestSessionAnswers(ctx context.Context, query *TestSessionAnswerQuery, nodes []*QuestionVersion, init func(*QuestionVersion), assign func(*QuestionVersion, *TestSessionAnswer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionVersion)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessionanswer.FieldQuestionVersionID)
+	}
+	query.Where(predicate.TestSessionAnswer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionversion.TestSessionAnswersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QuestionVersionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "question_version_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "question_version_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (qvq *QuestionVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qvq.querySpec()
+	if len(qvq.modifiers) > 0 {
+		_spec.Modifiers = qvq.modifiers
+	}
+	_spec.Node.Columns = qvq.ctx.Fields
+	if len(qvq.ctx.Fields) > 0 {
+		_spec.Unique = qvq.ctx.Unique != nil && *qvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qvq.driver, _spec)
+}
+
+func (qvq *QuestionVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(questionversion.Table, questionversion.Columns, sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID))
+	_spec.From = qvq.sql
+	if unique := qvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionversion.FieldID)
+		for i := range fields {
+			if fields[i] != questionversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if qvq.withQuestion != nil {
+			_spec.Node.AddColumnOnce(questionversion.FieldQuestionID)
+		}
+		if qvq.withCreatedBy != nil {
+			_spec.Node.AddColumnOnce(questionversion.FieldCreatedByID)
+		}
+	}
+	if ps := qvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qvq *QuestionVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qvq.driver.Dialect())
+	t1 := builder.Table(questionversion.Table)
+	columns := qvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = questionversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qvq.sql != nil {
+		selector = qvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qvq.ctx.Unique != nil && *qvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qvq.modifiers {
+		m(selector)
+	}
+	for _, p := range qvq.predicates {
+		p(selector)
+	}
+	for _, p := range qvq.order {
+		p(selector)
+	}
+	if offset := qvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qvq *QuestionVersionQuery) ForUpdate(opts ...sql.LockOption) *QuestionVersionQuery {
+	if qvq.driver.Dialect() == dialect.Postgres {
+		qvq.Unique(false)
+	}
+	qvq.modifiers = append(qvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qvq *QuestionVersionQuery) ForShare(opts ...sql.LockOption) *QuestionVersionQuery {
+	if qvq.driver.Dialect() == dialect.Postgres {
+		qvq.Unique(false)
+	}
+	qvq.modifiers = append(qvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qvq
+}
+
+// QuestionVersionGroupBy is the group-by builder for QuestionVersion entities.
+type QuestionVersionGroupBy struct {
+	selector
+	build *QuestionVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qvgb *QuestionVersionGroupBy) Aggregate(fns ...AggregateFunc) *QuestionVersionGroupBy {
+	qvgb.fns = append(qvgb.fns, fns...)
+	return qvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qvgb *QuestionVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionVersionQuery, *QuestionVersionGroupBy](ctx, qvgb.build, qvgb, qvgb.build.inters, v)
+}
+
+func (qvgb *QuestionVersionGroupBy) sqlScan(ctx context.Context, root *QuestionVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qvgb.fns))
+	for _, fn := range qvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qvgb.flds)+len(qvgb.fns))
+		for _, f := range *qvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuestionVersionSelect is the builder for selecting fields of QuestionVersion entities.
+type QuestionVersionSelect struct {
+	*QuestionVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qvs *QuestionVersionSelect) Aggregate(fns ...AggregateFunc) *QuestionVersionSelect {
+	qvs.fns = append(qvs.fns, fns...)
+	return qvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qvs *QuestionVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qvs.ctx, ent.OpQuerySelect)
+	if err := qvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionVersionQuery, *QuestionVersionSelect](ctx, qvs.QuestionVersionQuery, qvs, qvs.inters, v)
+}
+
+func (qvs *QuestionVersionSelect) sqlScan(ctx context.Context, root *QuestionVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qvs.fns))
+	for _, fn := range qvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
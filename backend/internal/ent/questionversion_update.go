@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"template/internal/ent/testsessionanswer"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionUpdate is the builder for updating QuestionVersion entities.
+type QuestionVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionVersionMutation
+}
+
+// Where appends a list predicates to the QuestionVersionUpdate builder.
+func (qvu *QuestionVersionUpdate) Where(ps ...predicate.QuestionVersion) *QuestionVersionUpdate {
+	qvu.mutation.Where(ps...)
+	return qvu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvu *QuestionVersionUpdate) SetCreatedAt(t time.Time) *QuestionVersionUpdate {
+	qvu.mutation.SetCreatedAt(t)
+	return qvu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvu *QuestionVersionUpdate) SetNillableCreatedAt(t *time.Time) *QuestionVersionUpdate {
+	if t != nil {
+		qvu.SetCreatedAt(*t)
+	}
+	return qvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvu *QuestionVersionUpdate) SetUpdatedAt(t time.Time) *QuestionVersionUpdate {
+	qvu.mutation.SetUpdatedAt(t)
+	return qvu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvu *QuestionVersionUpdate) SetDeletedAt(t time.Time) *QuestionVersionUpdate {
+	qvu.mutation.SetDeletedAt(t)
+	return qvu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvu *QuestionVersionUpdate) SetNillableDeletedAt(t *time.Time) *QuestionVersionUpdate {
+	if t != nil {
+		qvu.SetDeletedAt(*t)
+	}
+	return qvu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qvu *QuestionVersionUpdate) ClearDeletedAt() *QuestionVersionUpdate {
+	qvu.mutation.ClearDeletedAt()
+	return qvu
+}
+
+// AddOptionIDs adds the "options" edge to the QuestionVersionOption entity by IDs.
+func (qvu *QuestionVersionUpdate) AddOptionIDs(ids ...uuid.UUID) *QuestionVersionUpdate {
+	qvu.mutation.AddOptionIDs(ids...)
+	return qvu
+}
+
+// AddOptions adds the "options" edges to the QuestionVersionOption entity.
+func (qvu *QuestionVersionUpdate) AddOptions(q ...*QuestionVersionOption) *QuestionVersionUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qvu.AddOptionIDs(ids...)
+}
+
+// AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
+func (qvu *QuestionVersionUpdate) AddTestSessionAnswerIDs(ids ...uuid.UUID) *QuestionVersionUpdate {
+	qvu.mutation.AddTestSessionAnswerIDs(ids...)
+	return qvu
+}
+
+// AddTestSessionAnswers adds the "test_session_answers" edges to the TestSessionAnswer entity.
+func (qvu *QuestionVersionUpdate) AddTestSessionAnswers(t ...*TestSessionAnswer) *QuestionVersionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return qvu.AddTestSessionAnswerIDs(ids...)
+}
+
+// Mutation returns the QuestionVersionMutation object of the builder.
+func (qvu *QuestionVersionUpdate) Mutation() *QuestionVersionMutation {
+	return qvu.mutation
+}
+
+// ClearOptions clears all "options" edges to the QuestionVersionOption entity.
+func (qvu *QuestionVersionUpdate) ClearOptions() *QuestionVersionUpdate {
+	qvu.mutation.ClearOptions()
+	return qvu
+}
+
+// RemoveOptionIDs removes the "options" edge to QuestionVersionOption entities by IDs.
+func (qvu *QuestionVersionUpdate) RemoveOptionIDs(ids ...uuid.UUID) *QuestionVersionUpdate {
+	qvu.mutation.RemoveOptionIDs(ids...)
+	return qvu
+}
+
+// RemoveOptions removes "options" edges to QuestionVersionOption entities.
+func (qvu *QuestionVersionUpdate) RemoveOptions(q ...*QuestionVersionOption) *QuestionVersionUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qvu.RemoveOptionIDs(ids...)
+}
+
+// ClearTestSessionAnswers clears all "test_session_answers" edges to the TestSessionAnswer entity.
+func (qvu *QuestionVersionUpdate) ClearTestSessionAnswers() *QuestionVersionUpdate {
+	qvu.mutation.ClearTestSessionAnswers()
+	return qvu
+}
+
+// RemoveTestSessionAnswerIDs removes the "test_session_answers" edge to TestSessionAnswer entities by IDs.
+func (qvu *QuestionVersionUpdate) RemoveTestSessionAnswerIDs(ids ...uuid.UUID) *QuestionVersionUpdate {
+	qvu.mutation.RemoveTestSessionAnswerIDs(ids...)
+	return qvu
+}
+
+// RemoveTestSessionAnswers removes "test_session_answers" edges to TestSessionAnswer entities.
+func (qvu *QuestionVersionUpdate) RemoveTestSessionAnswers(t ...*TestSessionAnswer) *QuestionVersionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return qvu.RemoveTestSessionAnswerIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qvu *QuestionVersionUpdate) Save(ctx context.Context) (int, error) {
+	if err := qvu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, qvu.sqlSave, qvu.mutation, qvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvu *QuestionVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := qvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qvu *QuestionVersionUpdate) Exec(ctx context.Context) error {
+	_, err := qvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvu *QuestionVersionUpdate) ExecX(ctx context.Context) {
+	if err := qvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvu *QuestionVersionUpdate) defaults() error {
+	if _, ok := qvu.mutation.UpdatedAt(); !ok {
+		if questionversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversion.UpdateDefaultUpdatedAt()
+		qvu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvu *QuestionVersionUpdate) check() error {
+	if qvu.mutation.QuestionCleared() && len(qvu.mutation.QuestionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionVersion.question"`)
+	}
+	return nil
+}
+
+func (qvu *QuestionVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionversion.Table, questionversion.Columns, sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID))
+	if ps := qvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qvu.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversion.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvu.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qvu.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionversion.FieldDeletedAt, field.TypeTime)
+	}
+	if qvu.mutation.ExplanationCleared() {
+		_spec.ClearField(questionversion.FieldExplanation, field.TypeString)
+	}
+	if qvu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvu.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !qvu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvu.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qvu.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvu.mutation.RemovedTestSessionAnswersIDs(); len(nodes) > 0 && !qvu.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvu.mutation.TestSessionAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qvu.mutation.done = true
+	return n, nil
+}
+
+// QuestionVersionUpdateOne is the builder for updating a single QuestionVersion entity.
+type QuestionVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionVersionMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvuo *QuestionVersionUpdateOne) SetCreatedAt(t time.Time) *QuestionVersionUpdateOne {
+	qvuo.mutation.SetCreatedAt(t)
+	return qvuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvuo *QuestionVersionUpdateOne) SetNillableCreatedAt(t *time.Time) *QuestionVersionUpdateOne {
+	if t != nil {
+		qvuo.SetCreatedAt(*t)
+	}
+	return qvuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvuo *QuestionVersionUpdateOne) SetUpdatedAt(t time.Time) *QuestionVersionUpdateOne {
+	qvuo.mutation.SetUpdatedAt(t)
+	return qvuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvuo *QuestionVersionUpdateOne) SetDeletedAt(t time.Time) *QuestionVersionUpdateOne {
+	qvuo.mutation.SetDeletedAt(t)
+	return qvuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvuo *QuestionVersionUpdateOne) SetNillableDeletedAt(t *time.Time) *QuestionVersionUpdateOne {
+	if t != nil {
+		qvuo.SetDeletedAt(*t)
+	}
+	return qvuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qvuo *QuestionVersionUpdateOne) ClearDeletedAt() *QuestionVersionUpdateOne {
+	qvuo.mutation.ClearDeletedAt()
+	return qvuo
+}
+
+// AddOptionIDs adds the "options" edge to the QuestionVersionOption entity by IDs.
+func (qvuo *QuestionVersionUpdateOne) AddOptionIDs(ids ...uuid.UUID) *QuestionVersionUpdateOne {
+	qvuo.mutation.AddOptionIDs(ids...)
+	return qvuo
+}
+
+// AddOptions adds the "options" edges to the QuestionVersionOption entity.
+func (qvuo *QuestionVersionUpdateOne) AddOptions(q ...*QuestionVersionOption) *QuestionVersionUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qvuo.AddOptionIDs(ids...)
+}
+
+// AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
+func (qvuo *QuestionVersionUpdateOne) AddTestSessionAnswerIDs(ids ...uuid.UUID) *QuestionVersionUpdateOne {
+	qvuo.mutation.AddTestSessionAnswerIDs(ids...)
+	return qvuo
+}
+
+// AddTestSessionAnswers adds the "test_session_answers" edges to the TestSessionAnswer entity.
+func (qvuo *QuestionVersionUpdateOne) AddTestSessionAnswers(t ...*TestSessionAnswer) *QuestionVersionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return qvuo.AddTestSessionAnswerIDs(ids...)
+}
+
+// Mutation returns the QuestionVersionMutation object of the builder.
+func (qvuo *QuestionVersionUpdateOne) Mutation() *QuestionVersionMutation {
+	return qvuo.mutation
+}
+
+// ClearOptions clears all "options" edges to the QuestionVersionOption entity.
+func (qvuo *QuestionVersionUpdateOne) ClearOptions() *QuestionVersionUpdateOne {
+	qvuo.mutation.ClearOptions()
+	return qvuo
+}
+
+// RemoveOptionIDs removes the "options" edge to QuestionVersionOption entities by IDs.
+func (qvuo *QuestionVersionUpdateOne) RemoveOptionIDs(ids ...uuid.UUID) *QuestionVersionUpdateOne {
+	qvuo.mutation.RemoveOptionIDs(ids...)
+	return qvuo
+}
+
+// RemoveOptions removes "options" edges to QuestionVersionOption entities.
+func (qvuo *QuestionVersionUpdateOne) RemoveOptions(q ...*QuestionVersionOption) *QuestionVersionUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qvuo.RemoveOptionIDs(ids...)
+}
+
+// ClearTestSessionAnswers clears all "test_session_answers" edges to the TestSessionAnswer entity.
+func (qvuo *QuestionVersionUpdateOne) ClearTestSessionAnswers() *QuestionVersionUpdateOne {
+	qvuo.mutation.ClearTestSessionAnswers()
+	return qvuo
+}
+
+// RemoveTestSessionAnswerIDs removes the "test_session_answers" edge to TestSessionAnswer entities by IDs.
+func (qvuo *QuestionVersionUpdateOne) RemoveTestSessionAnswerIDs(ids ...uuid.UUID) *QuestionVersionUpdateOne {
+	qvuo.mutation.RemoveTestSessionAnswerIDs(ids...)
+	return qvuo
+}
+
+// RemoveTestSessionAnswers removes "test_session_answers" edges to TestSessionAnswer entities.
+func (qvuo *QuestionVersionUpdateOne) RemoveTestSessionAnswers(t ...*TestSessionAnswer) *QuestionVersionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return qvuo.RemoveTestSessionAnswerIDs(ids...)
+}
+
+// Where appends a list predicates to the QuestionVersionUpdate builder.
+func (qvuo *QuestionVersionUpdateOne) Where(ps ...predicate.QuestionVersion) *QuestionVersionUpdateOne {
+	qvuo.mutation.Where(ps...)
+	return qvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qvuo *QuestionVersionUpdateOne) Select(field string, fields ...string) *QuestionVersionUpdateOne {
+	qvuo.fields = append([]string{field}, fields...)
+	return qvuo
+}
+
+// Save executes the query and returns the updated QuestionVersion entity.
+func (qvuo *QuestionVersionUpdateOne) Save(ctx context.Context) (*QuestionVersion, error) {
+	if err := qvuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, qvuo.sqlSave, qvuo.mutation, qvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvuo *QuestionVersionUpdateOne) SaveX(ctx context.Context) *QuestionVersion {
+	node, err := qvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qvuo *QuestionVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := qvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvuo *QuestionVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := qvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvuo *QuestionVersionUpdateOne) defaults() error {
+	if _, ok := qvuo.mutation.UpdatedAt(); !ok {
+		if questionversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversion.UpdateDefaultUpdatedAt()
+		qvuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvuo *QuestionVersionUpdateOne) check() error {
+	if qvuo.mutation.QuestionCleared() && len(qvuo.mutation.QuestionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionVersion.question"`)
+	}
+	return nil
+}
+
+func (qvuo *QuestionVersionUpdateOne) sqlSave(ctx context.Context) (_node *QuestionVersion, err error) {
+	if err := qvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionversion.Table, questionversion.Columns, sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID))
+	id, ok := qvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionversion.FieldID)
+		for _, f := range fields {
+			if !questionversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qvuo.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversion.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvuo.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qvuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionversion.FieldDeletedAt, field.TypeTime)
+	}
+	if qvuo.mutation.ExplanationCleared() {
+		_spec.ClearField(questionversion.FieldExplanation, field.TypeString)
+	}
+	if qvuo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvuo.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !qvuo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvuo.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.OptionsTable,
+			Columns: []string{questionversion.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qvuo.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvuo.mutation.RemovedTestSessionAnswersIDs(); len(nodes) > 0 && !qvuo.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qvuo.mutation.TestSessionAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionversion.TestSessionAnswersTable,
+			Columns: []string{questionversion.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QuestionVersion{config: qvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qvuo.mutation.done = true
+	return _node, nil
+}
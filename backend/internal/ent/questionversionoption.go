@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionOption is the model entity for the QuestionVersionOption schema.
+type QuestionVersionOption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// VersionID holds the value of the "version_id" field.
+	VersionID uuid.UUID `json:"version_id,omitempty"`
+	// Question option the copy was taken from
+	OptionID uuid.UUID `json:"option_id,omitempty"`
+	// OptionText holds the value of the "option_text" field.
+	OptionText string `json:"option_text,omitempty"`
+	// IsCorrect holds the value of the "is_correct" field.
+	IsCorrect bool `json:"is_correct,omitempty"`
+	// Explanation holds the value of the "explanation" field.
+	Explanation *string `json:"explanation,omitempty"`
+	// Order holds the value of the "order" field.
+	Order int `json:"order,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionVersionOptionQuery when eager-loading is set.
+	Edges        QuestionVersionOptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionVersionOptionEdges holds the relations/edges for other nodes in the graph.
+type QuestionVersionOptionEdges struct {
+	// Version holds the value of the version edge.
+	Version *QuestionVersion `json:"version,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VersionOrErr returns the Version value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionVersionOptionEdges) VersionOrErr() (*QuestionVersion, error) {
+	if e.Version != nil {
+		return e.Version, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: questionversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "version"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionVersionOption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionversionoption.FieldIsCorrect:
+			values[i] = new(sql.NullBool)
+		case questionversionoption.FieldOrder:
+			values[i] = new(sql.NullInt64)
+		case questionversionoption.FieldOptionText, questionversionoption.FieldExplanation:
+			values[i] = new(sql.NullString)
+		case questionversionoption.FieldCreatedAt, questionversionoption.FieldUpdatedAt, questionversionoption.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case questionversionoption.FieldID, questionversionoption.FieldVersionID, questionversionoption.FieldOptionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionVersionOption fields.
+func (qvo *QuestionVersionOption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionversionoption.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				qvo.ID = *value
+			}
+		case questionversionoption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qvo.CreatedAt = value.Time
+			}
+		case questionversionoption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qvo.UpdatedAt = value.Time
+			}
+		case questionversionoption.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				qvo.DeletedAt = new(time.Time)
+				*qvo.DeletedAt = value.Time
+			}
+		case questionversionoption.FieldVersionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field version_id", values[i])
+			} else if value != nil {
+				qvo.VersionID = *value
+			}
+		case questionversionoption.FieldOptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field option_id", values[i])
+			} else if value != nil {
+				qvo.OptionID = *value
+			}
+		case questionversionoption.FieldOptionText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field option_text", values[i])
+			} else if value.Valid {
+				qvo.OptionText = value.String
+			}
+		case questionversionoption.FieldIsCorrect:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_correct", values[i])
+			} else if value.Valid {
+				qvo.IsCorrect = value.Bool
+			}
+		case questionversionoption.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				qvo.Explanation = new(string)
+				*qvo.Explanation = value.String
+			}
+		case questionversionoption.FieldOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order", values[i])
+			} else if value.Valid {
+				qvo.Order = int(value.Int64)
+			}
+		default:
+			qvo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionVersionOption.
+// This includes values selected through modifiers, order, etc.
+func (qvo *QuestionVersionOption) Value(name string) (ent.Value, error) {
+	return qvo.selectValues.Get(name)
+}
+
+// QueryVersion queries the "version" edge of the QuestionVersionOption entity.
+func (qvo *QuestionVersionOption) QueryVersion() *QuestionVersionQuery {
+	return NewQuestionVersionOptionClient(qvo.config).QueryVersion(qvo)
+}
+
+// Update returns a builder for updating this QuestionVersionOption.
+// Note that you need to call QuestionVersionOption.Unwrap() before calling this method if this QuestionVersionOption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qvo *QuestionVersionOption) Update() *QuestionVersionOptionUpdateOne {
+	return NewQuestionVersionOptionClient(qvo.config).UpdateOne(qvo)
+}
+
+// Unwrap unwraps the QuestionVersionOption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qvo *QuestionVersionOption) Unwrap() *QuestionVersionOption {
+	_tx, ok := qvo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionVersionOption is not a transactional entity")
+	}
+	qvo.config.driver = _tx.drv
+	return qvo
+}
+
+// String implements the fmt.Stringer.
+func (qvo *QuestionVersionOption) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionVersionOption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qvo.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(qvo.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(qvo.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := qvo.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version_id=")
+	builder.WriteString(fmt.Sprintf("%v", qvo.VersionID))
+	builder.WriteString(", ")
+	builder.WriteString("option_id=")
+	builder.WriteString(fmt.Sprintf("%v", qvo.OptionID))
+	builder.WriteString(", ")
+	builder.WriteString("option_text=")
+	builder.WriteString(qvo.OptionText)
+	builder.WriteString(", ")
+	builder.WriteString("is_correct=")
+	builder.WriteString(fmt.Sprintf("%v", qvo.IsCorrect))
+	builder.WriteString(", ")
+	if v := qvo.Explanation; v != nil {
+		builder.WriteString("explanation=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order=")
+	builder.WriteString(fmt.Sprintf("%v", qvo.Order))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionVersionOptions is a parsable slice of QuestionVersionOption.
+type QuestionVersionOptions []*QuestionVersionOption
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionversionoption
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// VersionID applies equality check predicate on the "version_id" field. It's identical to VersionIDEQ.
+func VersionID(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldVersionID, v))
+}
+
+// OptionID applies equality check predicate on the "option_id" field. It's identical to OptionIDEQ.
+func OptionID(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOptionID, v))
+}
+
+// OptionText applies equality check predicate on the "option_text" field. It's identical to OptionTextEQ.
+func OptionText(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOptionText, v))
+}
+
+// IsCorrect applies equality check predicate on the "is_correct" field. It's identical to IsCorrectEQ.
+func IsCorrect(v bool) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldIsCorrect, v))
+}
+
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldExplanation, v))
+}
+
+// Order applies equality check predicate on the "order" field. It's identical to OrderEQ.
+func Order(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOrder, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// VersionIDEQ applies the EQ predicate on the "version_id" field.
+func VersionIDEQ(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldVersionID, v))
+}
+
+// VersionIDNEQ applies the NEQ predicate on the "version_id" field.
+func VersionIDNEQ(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldVersionID, v))
+}
+
+// VersionIDIn applies the In predicate on the "version_id" field.
+func VersionIDIn(vs ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldVersionID, vs...))
+}
+
+// VersionIDNotIn applies the NotIn predicate on the "version_id" field.
+func VersionIDNotIn(vs ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldVersionID, vs...))
+}
+
+// OptionIDEQ applies the EQ predicate on the "option_id" field.
+func OptionIDEQ(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOptionID, v))
+}
+
+// OptionIDNEQ applies the NEQ predicate on the "option_id" field.
+func OptionIDNEQ(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldOptionID, v))
+}
+
+// OptionIDIn applies the In predicate on the "option_id" field.
+func OptionIDIn(vs ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldOptionID, vs...))
+}
+
+// OptionIDNotIn applies the NotIn predicate on the "option_id" field.
+func OptionIDNotIn(vs ...uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldOptionID, vs...))
+}
+
+// OptionIDGT applies the GT predicate on the "option_id" field.
+func OptionIDGT(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldOptionID, v))
+}
+
+// OptionIDGTE applies the GTE predicate on the "option_id" field.
+func OptionIDGTE(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldOptionID, v))
+}
+
+// OptionIDLT applies the LT predicate on the "option_id" field.
+func OptionIDLT(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldOptionID, v))
+}
+
+// OptionIDLTE applies the LTE predicate on the "option_id" field.
+func OptionIDLTE(v uuid.UUID) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldOptionID, v))
+}
+
+// OptionTextEQ applies the EQ predicate on the "option_text" field.
+func OptionTextEQ(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOptionText, v))
+}
+
+// OptionTextNEQ applies the NEQ predicate on the "option_text" field.
+func OptionTextNEQ(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldOptionText, v))
+}
+
+// OptionTextIn applies the In predicate on the "option_text" field.
+func OptionTextIn(vs ...string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldOptionText, vs...))
+}
+
+// OptionTextNotIn applies the NotIn predicate on the "option_text" field.
+func OptionTextNotIn(vs ...string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldOptionText, vs...))
+}
+
+// OptionTextGT applies the GT predicate on the "option_text" field.
+func OptionTextGT(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldOptionText, v))
+}
+
+// OptionTextGTE applies the GTE predicate on the "option_text" field.
+func OptionTextGTE(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldOptionText, v))
+}
+
+// OptionTextLT applies the LT predicate on the "option_text" field.
+func OptionTextLT(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldOptionText, v))
+}
+
+// OptionTextLTE applies the LTE predicate on the "option_text" field.
+func OptionTextLTE(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldOptionText, v))
+}
+
+// OptionTextContains applies the Contains predicate on the "option_text" field.
+func OptionTextContains(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldContains(FieldOptionText, v))
+}
+
+// OptionTextHasPrefix applies the HasPrefix predicate on the "option_text" field.
+func OptionTextHasPrefix(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldHasPrefix(FieldOptionText, v))
+}
+
+// OptionTextHasSuffix applies the HasSuffix predicate on the "option_text" field.
+func OptionTextHasSuffix(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldHasSuffix(FieldOptionText, v))
+}
+
+// OptionTextEqualFold applies the EqualFold predicate on the "option_text" field.
+func OptionTextEqualFold(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEqualFold(FieldOptionText, v))
+}
+
+// OptionTextContainsFold applies the ContainsFold predicate on the "option_text" field.
+func OptionTextContainsFold(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldContainsFold(FieldOptionText, v))
+}
+
+// IsCorrectEQ applies the EQ predicate on the "is_correct" field.
+func IsCorrectEQ(v bool) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldIsCorrect, v))
+}
+
+// IsCorrectNEQ applies the NEQ predicate on the "is_correct" field.
+func IsCorrectNEQ(v bool) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldIsCorrect, v))
+}
+
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldContainsFold(FieldExplanation, v))
+}
+
+// OrderEQ applies the EQ predicate on the "order" field.
+func OrderEQ(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldEQ(FieldOrder, v))
+}
+
+// OrderNEQ applies the NEQ predicate on the "order" field.
+func OrderNEQ(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNEQ(FieldOrder, v))
+}
+
+// OrderIn applies the In predicate on the "order" field.
+func OrderIn(vs ...int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldIn(FieldOrder, vs...))
+}
+
+// OrderNotIn applies the NotIn predicate on the "order" field.
+func OrderNotIn(vs ...int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldNotIn(FieldOrder, vs...))
+}
+
+// OrderGT applies the GT predicate on the "order" field.
+func OrderGT(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGT(FieldOrder, v))
+}
+
+// OrderGTE applies the GTE predicate on the "order" field.
+func OrderGTE(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldGTE(FieldOrder, v))
+}
+
+// OrderLT applies the LT predicate on the "order" field.
+func OrderLT(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLT(FieldOrder, v))
+}
+
+// OrderLTE applies the LTE predicate on the "order" field.
+func OrderLTE(v int) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.FieldLTE(FieldOrder, v))
+}
+
+// HasVersion applies the HasEdge predicate on the "version" edge.
+func HasVersion() predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VersionTable, VersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionWith applies the HasEdge predicate on the "version" edge with a given conditions (other predicates).
+func HasVersionWith(preds ...predicate.QuestionVersion) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(func(s *sql.Selector) {
+		step := newVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionVersionOption) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionVersionOption) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionVersionOption) predicate.QuestionVersionOption {
+	return predicate.QuestionVersionOption(sql.NotPredicates(p))
+}
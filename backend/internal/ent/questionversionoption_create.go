@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionOptionCreate is the builder for creating a QuestionVersionOption entity.
+type QuestionVersionOptionCreate struct {
+	config
+	mutation *QuestionVersionOptionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvoc *QuestionVersionOptionCreate) SetCreatedAt(t time.Time) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetCreatedAt(t)
+	return qvoc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvoc *QuestionVersionOptionCreate) SetNillableCreatedAt(t *time.Time) *QuestionVersionOptionCreate {
+	if t != nil {
+		qvoc.SetCreatedAt(*t)
+	}
+	return qvoc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvoc *QuestionVersionOptionCreate) SetUpdatedAt(t time.Time) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetUpdatedAt(t)
+	return qvoc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qvoc *QuestionVersionOptionCreate) SetNillableUpdatedAt(t *time.Time) *QuestionVersionOptionCreate {
+	if t != nil {
+		qvoc.SetUpdatedAt(*t)
+	}
+	return qvoc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvoc *QuestionVersionOptionCreate) SetDeletedAt(t time.Time) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetDeletedAt(t)
+	return qvoc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvoc *QuestionVersionOptionCreate) SetNillableDeletedAt(t *time.Time) *QuestionVersionOptionCreate {
+	if t != nil {
+		qvoc.SetDeletedAt(*t)
+	}
+	return qvoc
+}
+
+// SetVersionID sets the "version_id" field.
+func (qvoc *QuestionVersionOptionCreate) SetVersionID(u uuid.UUID) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetVersionID(u)
+	return qvoc
+}
+
+// SetOptionID sets the "option_id" field.
+func (qvoc *QuestionVersionOptionCreate) SetOptionID(u uuid.UUID) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetOptionID(u)
+	return qvoc
+}
+
+// SetOptionText sets the "option_text" field.
+func (qvoc *QuestionVersionOptionCreate) SetOptionText(s string) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetOptionText(s)
+	return qvoc
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (qvoc *QuestionVersionOptionCreate) SetIsCorrect(b bool) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetIsCorrect(b)
+	return qvoc
+}
+
+// SetExplanation sets the "explanation" field.
+func (qvoc *QuestionVersionOptionCreate) SetExplanation(s string) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetExplanation(s)
+	return qvoc
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qvoc *QuestionVersionOptionCreate) SetNillableExplanation(s *string) *QuestionVersionOptionCreate {
+	if s != nil {
+		qvoc.SetExplanation(*s)
+	}
+	return qvoc
+}
+
+// SetOrder sets the "order" field.
+func (qvoc *QuestionVersionOptionCreate) SetOrder(i int) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetOrder(i)
+	return qvoc
+}
+
+// SetID sets the "id" field.
+func (qvoc *QuestionVersionOptionCreate) SetID(u uuid.UUID) *QuestionVersionOptionCreate {
+	qvoc.mutation.SetID(u)
+	return qvoc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qvoc *QuestionVersionOptionCreate) SetNillableID(u *uuid.UUID) *QuestionVersionOptionCreate {
+	if u != nil {
+		qvoc.SetID(*u)
+	}
+	return qvoc
+}
+
+// SetVersion sets the "version" edge to the QuestionVersion entity.
+func (qvoc *QuestionVersionOptionCreate) SetVersion(q *QuestionVersion) *QuestionVersionOptionCreate {
+	return qvoc.SetVersionID(q.ID)
+}
+
+// Mutation returns the QuestionVersionOptionMutation object of the builder.
+func (qvoc *QuestionVersionOptionCreate) Mutation() *QuestionVersionOptionMutation {
+	return qvoc.mutation
+}
+
+// Save creates the QuestionVersionOption in the database.
+func (qvoc *QuestionVersionOptionCreate) Save(ctx context.Context) (*QuestionVersionOption, error) {
+	if err := qvoc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, qvoc.sqlSave, qvoc.mutation, qvoc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qvoc *QuestionVersionOptionCreate) SaveX(ctx context.Context) *QuestionVersionOption {
+	v, err := qvoc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qvoc *QuestionVersionOptionCreate) Exec(ctx context.Context) error {
+	_, err := qvoc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvoc *QuestionVersionOptionCreate) ExecX(ctx context.Context) {
+	if err := qvoc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvoc *QuestionVersionOptionCreate) defaults() error {
+	if _, ok := qvoc.mutation.CreatedAt(); !ok {
+		if questionversionoption.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversionoption.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversionoption.DefaultCreatedAt()
+		qvoc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qvoc.mutation.UpdatedAt(); !ok {
+		if questionversionoption.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversionoption.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversionoption.DefaultUpdatedAt()
+		qvoc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qvoc.mutation.ID(); !ok {
+		if questionversionoption.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized questionversionoption.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := questionversionoption.DefaultID()
+		qvoc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvoc *QuestionVersionOptionCreate) check() error {
+	if _, ok := qvoc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuestionVersionOption.created_at"`)}
+	}
+	if _, ok := qvoc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuestionVersionOption.updated_at"`)}
+	}
+	if _, ok := qvoc.mutation.VersionID(); !ok {
+		return &ValidationError{Name: "version_id", err: errors.New(`ent: missing required field "QuestionVersionOption.version_id"`)}
+	}
+	if _, ok := qvoc.mutation.OptionID(); !ok {
+		return &ValidationError{Name: "option_id", err: errors.New(`ent: missing required field "QuestionVersionOption.option_id"`)}
+	}
+	if _, ok := qvoc.mutation.OptionText(); !ok {
+		return &ValidationError{Name: "option_text", err: errors.New(`ent: missing required field "QuestionVersionOption.option_text"`)}
+	}
+	if _, ok := qvoc.mutation.IsCorrect(); !ok {
+		return &ValidationError{Name: "is_correct", err: errors.New(`ent: missing required field "QuestionVersionOption.is_correct"`)}
+	}
+	if _, ok := qvoc.mutation.Order(); !ok {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required field "QuestionVersionOption.order"`)}
+	}
+	if v, ok := qvoc.mutation.Order(); ok {
+		if err := questionversionoption.OrderValidator(v); err != nil {
+			return &ValidationError{Name: "order", err: fmt.Errorf(`ent: validator failed for field "QuestionVersionOption.order": %w`, err)}
+		}
+	}
+	if len(qvoc.mutation.VersionIDs()) == 0 {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required edge "QuestionVersionOption.version"`)}
+	}
+	return nil
+}
+
+func (qvoc *QuestionVersionOptionCreate) sqlSave(ctx context.Context) (*QuestionVersionOption, error) {
+	if err := qvoc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qvoc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qvoc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qvoc.mutation.id = &_node.ID
+	qvoc.mutation.done = true
+	return _node, nil
+}
+
+func (qvoc *QuestionVersionOptionCreate) createSpec() (*QuestionVersionOption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionVersionOption{config: qvoc.config}
+		_spec = sqlgraph.NewCreateSpec(questionversionoption.Table, sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID))
+	)
+	if id, ok := qvoc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qvoc.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qvoc.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qvoc.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversionoption.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := qvoc.mutation.OptionID(); ok {
+		_spec.SetField(questionversionoption.FieldOptionID, field.TypeUUID, value)
+		_node.OptionID = value
+	}
+	if value, ok := qvoc.mutation.OptionText(); ok {
+		_spec.SetField(questionversionoption.FieldOptionText, field.TypeString, value)
+		_node.OptionText = value
+	}
+	if value, ok := qvoc.mutation.IsCorrect(); ok {
+		_spec.SetField(questionversionoption.FieldIsCorrect, field.TypeBool, value)
+		_node.IsCorrect = value
+	}
+	if value, ok := qvoc.mutation.Explanation(); ok {
+		_spec.SetField(questionversionoption.FieldExplanation, field.TypeString, value)
+		_node.Explanation = &value
+	}
+	if value, ok := qvoc.mutation.Order(); ok {
+		_spec.SetField(questionversionoption.FieldOrder, field.TypeInt, value)
+		_node.Order = value
+	}
+	if nodes := qvoc.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionversionoption.VersionTable,
+			Columns: []string{questionversionoption.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VersionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuestionVersionOptionCreateBulk is the builder for creating many QuestionVersionOption entities in bulk.
+type QuestionVersionOptionCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionVersionOptionCreate
+}
+
+// Save creates the QuestionVersionOption entities in the database.
+func (qvocb *QuestionVersionOptionCreateBulk) Save(ctx context.Context) ([]*QuestionVersionOption, error) {
+	if qvocb.err != nil {
+		return nil, qvocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qvocb.builders))
+	nodes := make([]*QuestionVersionOption, len(qvocb.builders))
+	mutators := make([]Mutator, len(qvocb.builders))
+	for i := range qvocb.builders {
+		func(i int, root context.Context) {
+			builder := qvocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionVersionOptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qvocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qvocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qvocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvocb *QuestionVersionOptionCreateBulk) SaveX(ctx context.Context) []*QuestionVersionOption {
+	v, err := qvocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qvocb *QuestionVersionOptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := qvocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvocb *QuestionVersionOptionCreateBulk) ExecX(ctx context.Context) {
+	if err := qvocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questionversionoption"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuestionVersionOptionDelete is the builder for deleting a QuestionVersionOption entity.
+type QuestionVersionOptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestionVersionOptionMutation
+}
+
+// Where appends a list predicates to the QuestionVersionOptionDelete builder.
+func (qvod *QuestionVersionOptionDelete) Where(ps ...predicate.QuestionVersionOption) *QuestionVersionOptionDelete {
+	qvod.mutation.Where(ps...)
+	return qvod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qvod *QuestionVersionOptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qvod.sqlExec, qvod.mutation, qvod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvod *QuestionVersionOptionDelete) ExecX(ctx context.Context) int {
+	n, err := qvod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qvod *QuestionVersionOptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questionversionoption.Table, sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID))
+	if ps := qvod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qvod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qvod.mutation.done = true
+	return affected, err
+}
+
+// QuestionVersionOptionDeleteOne is the builder for deleting a single QuestionVersionOption entity.
+type QuestionVersionOptionDeleteOne struct {
+	qvod *QuestionVersionOptionDelete
+}
+
+// Where appends a list predicates to the QuestionVersionOptionDelete builder.
+func (qvodo *QuestionVersionOptionDeleteOne) Where(ps ...predicate.QuestionVersionOption) *QuestionVersionOptionDeleteOne {
+	qvodo.qvod.mutation.Where(ps...)
+	return qvodo
+}
+
+// Exec executes the deletion query.
+func (qvodo *QuestionVersionOptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := qvodo.qvod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questionversionoption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvodo *QuestionVersionOptionDeleteOne) ExecX(ctx context.Context) {
+	if err := qvodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionOptionQuery is the builder for querying QuestionVersionOption entities.
+type QuestionVersionOptionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []questionversionoption.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.QuestionVersionOption
+	withVersion *QuestionVersionQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuestionVersionOptionQuery builder.
+func (qvoq *QuestionVersionOptionQuery) Where(ps ...predicate.QuestionVersionOption) *QuestionVersionOptionQuery {
+	qvoq.predicates = append(qvoq.predicates, ps...)
+	return qvoq
+}
+
+// Limit the number of records to be returned by this query.
+func (qvoq *QuestionVersionOptionQuery) Limit(limit int) *QuestionVersionOptionQuery {
+	qvoq.ctx.Limit = &limit
+	return qvoq
+}
+
+// Offset to start from.
+func (qvoq *QuestionVersionOptionQuery) Offset(offset int) *QuestionVersionOptionQuery {
+	qvoq.ctx.Offset = &offset
+	return qvoq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qvoq *QuestionVersionOptionQuery) Unique(unique bool) *QuestionVersionOptionQuery {
+	qvoq.ctx.Unique = &unique
+	return qvoq
+}
+
+// Order specifies how the records should be ordered.
+func (qvoq *QuestionVersionOptionQuery) Order(o ...questionversionoption.OrderOption) *QuestionVersionOptionQuery {
+	qvoq.order = append(qvoq.order, o...)
+	return qvoq
+}
+
+// QueryVersion chains the current query on the "version" edge.
+func (qvoq *QuestionVersionOptionQuery) QueryVersion() *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: qvoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qvoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qvoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionversionoption.Table, questionversionoption.FieldID, selector),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionversionoption.VersionTable, questionversionoption.VersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qvoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuestionVersionOption entity from the query.
+// Returns a *NotFoundError when no QuestionVersionOption was found.
+func (qvoq *QuestionVersionOptionQuery) First(ctx context.Context) (*QuestionVersionOption, error) {
+	nodes, err := qvoq.Limit(1).All(setContextOp(ctx, qvoq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{questionversionoption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) FirstX(ctx context.Context) *QuestionVersionOption {
+	node, err := qvoq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuestionVersionOption ID from the query.
+// Returns a *NotFoundError when no QuestionVersionOption ID was found.
+func (qvoq *QuestionVersionOptionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qvoq.Limit(1).IDs(setContextOp(ctx, qvoq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{questionversionoption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := qvoq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuestionVersionOption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuestionVersionOption entity is found.
+// Returns a *NotFoundError when no QuestionVersionOption entities are found.
+func (qvoq *QuestionVersionOptionQuery) Only(ctx context.Context) (*QuestionVersionOption, error) {
+	nodes, err := qvoq.Limit(2).All(setContextOp(ctx, qvoq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{questionversionoption.Label}
+	default:
+		return nil, &NotSingularError{questionversionoption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) OnlyX(ctx context.Context) *QuestionVersionOption {
+	node, err := qvoq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuestionVersionOption ID in the query.
+// Returns a *NotSingularError when more than one QuestionVersionOption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qvoq *QuestionVersionOptionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qvoq.Limit(2).IDs(setContextOp(ctx, qvoq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{questionversionoption.Label}
+	default:
+		err = &NotSingularError{questionversionoption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := qvoq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuestionVersionOptions.
+func (qvoq *QuestionVersionOptionQuery) All(ctx context.Context) ([]*QuestionVersionOption, error) {
+	ctx = setContextOp(ctx, qvoq.ctx, ent.OpQueryAll)
+	if err := qvoq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuestionVersionOption, *QuestionVersionOptionQuery]()
+	return withInterceptors[[]*QuestionVersionOption](ctx, qvoq, qr, qvoq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) AllX(ctx context.Context) []*QuestionVersionOption {
+	nodes, err := qvoq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuestionVersionOption IDs.
+func (qvoq *QuestionVersionOptionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if qvoq.ctx.Unique == nil && qvoq.path != nil {
+		qvoq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qvoq.ctx, ent.OpQueryIDs)
+	if err = qvoq.Select(questionversionoption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := qvoq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qvoq *QuestionVersionOptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qvoq.ctx, ent.OpQueryCount)
+	if err := qvoq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qvoq, querierCount[*QuestionVersionOptionQuery](), qvoq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) CountX(ctx context.Context) int {
+	count, err := qvoq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qvoq *QuestionVersionOptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qvoq.ctx, ent.OpQueryExist)
+	switch _, err := qvoq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qvoq *QuestionVersionOptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := qvoq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuestionVersionOptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qvoq *QuestionVersionOptionQuery) Clone() *QuestionVersionOptionQuery {
+	if qvoq == nil {
+		return nil
+	}
+	return &QuestionVersionOptionQuery{
+		config:      qvoq.config,
+		ctx:         qvoq.ctx.Clone(),
+		order:       append([]questionversionoption.OrderOption{}, qvoq.order...),
+		inters:      append([]Interceptor{}, qvoq.inters...),
+		predicates:  append([]predicate.QuestionVersionOption{}, qvoq.predicates...),
+		withVersion: qvoq.withVersion.Clone(),
+		// clone intermediate query.
+		sql:  qvoq.sql.Clone(),
+		path: qvoq.path,
+	}
+}
+
+// WithVersion tells the query-builder to eager-load the nodes that are connected to
+// the "version" edge. The optional arguments are used to configure the query builder of the edge.
+func (qvoq *QuestionVersionOptionQuery) WithVersion(opts ...func(*QuestionVersionQuery)) *QuestionVersionOptionQuery {
+	query := (&QuestionVersionClient{config: qvoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qvoq.withVersion = query
+	return qvoq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuestionVersionOption.Query().
+//		GroupBy(questionversionoption.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qvoq *QuestionVersionOptionQuery) GroupBy(field string, fields ...string) *QuestionVersionOptionGroupBy {
+	qvoq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuestionVersionOptionGroupBy{build: qvoq}
+	grbuild.flds = &qvoq.ctx.Fields
+	grbuild.label = questionversionoption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QuestionVersionOption.Query().
+//		Select(questionversionoption.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (qvoq *QuestionVersionOptionQuery) Select(fields ...string) *QuestionVersionOptionSelect {
+	qvoq.ctx.Fields = append(qvoq.ctx.Fields, fields...)
+	sbuild := &QuestionVersionOptionSelect{QuestionVersionOptionQuery: qvoq}
+	sbuild.label = questionversionoption.Label
+	sbuild.flds, sbuild.scan = &qvoq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuestionVersionOptionSelect configured with the given aggregations.
+func (qvoq *QuestionVersionOptionQuery) Aggregate(fns ...AggregateFunc) *QuestionVersionOptionSelect {
+	return qvoq.Select().Aggregate(fns...)
+}
+
+func (qvoq *QuestionVersionOptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qvoq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qvoq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qvoq.ctx.Fields {
+		if !questionversionoption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qvoq.path != nil {
+		prev, err := qvoq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qvoq.sql = prev
+	}
+	return nil
+}
+
+func (qvoq *QuestionVersionOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestionVersionOption, error) {
+	var (
+		nodes       = []*QuestionVersionOption{}
+		_spec       = qvoq.querySpec()
+		loadedTypes = [1]bool{
+			qvoq.withVersion != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuestionVersionOption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuestionVersionOption{config: qvoq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(qvoq.modifiers) > 0 {
+		_spec.Modifiers = qvoq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qvoq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qvoq.withVersion; query != nil {
+		if err := qvoq.loadVersion(ctx, query, nodes, nil,
+			func(n *QuestionVersionOption, e *QuestionVersion) { n.Edges.Version = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qvoq *QuestionVersionOptionQuery) loadVersion(ctx context.Context, query *QuestionVersionQuery, nodes []*QuestionVersionOption, init func(*QuestionVersionOption), assign func(*QuestionVersionOption, *QuestionVersion)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionVersionOption)
+	for i := range nodes {
+		fk := nodes[i].VersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(questionversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (qvoq *QuestionVersionOptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qvoq.querySpec()
+	if len(qvoq.modifiers) > 0 {
+		_spec.Modifiers = qvoq.modifiers
+	}
+	_spec.Node.Columns = qvoq.ctx.Fields
+	if len(qvoq.ctx.Fields) > 0 {
+		_spec.Unique = qvoq.ctx.Unique != nil && *qvoq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qvoq.driver, _spec)
+}
+
+func (qvoq *QuestionVersionOptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(questionversionoption.Table, questionversionoption.Columns, sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID))
+	_spec.From = qvoq.sql
+	if unique := qvoq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qvoq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qvoq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionversionoption.FieldID)
+		for i := range fields {
+			if fields[i] != questionversionoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if qvoq.withVersion != nil {
+			_spec.Node.AddColumnOnce(questionversionoption.FieldVersionID)
+		}
+	}
+	if ps := qvoq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qvoq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qvoq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qvoq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qvoq *QuestionVersionOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qvoq.driver.Dialect())
+	t1 := builder.Table(questionversionoption.Table)
+	columns := qvoq.ctx.Fields
+	if len(columns) == 0 {
+		columns = questionversionoption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qvoq.sql != nil {
+		selector = qvoq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qvoq.ctx.Unique != nil && *qvoq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qvoq.modifiers {
+		m(selector)
+	}
+	for _, p := range qvoq.predicates {
+		p(selector)
+	}
+	for _, p := range qvoq.order {
+		p(selector)
+	}
+	if offset := qvoq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qvoq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qvoq *QuestionVersionOptionQuery) ForUpdate(opts ...sql.LockOption) *QuestionVersionOptionQuery {
+	if qvoq.driver.Dialect() == dialect.Postgres {
+		qvoq.Unique(false)
+	}
+	qvoq.modifiers = append(qvoq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qvoq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qvoq *QuestionVersionOptionQuery) ForShare(opts ...sql.LockOption) *QuestionVersionOptionQuery {
+	if qvoq.driver.Dialect() == dialect.Postgres {
+		qvoq.Unique(false)
+	}
+	qvoq.modifiers = append(qvoq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qvoq
+}
+
+// QuestionVersionOptionGroupBy is the group-by builder for QuestionVersionOption entities.
+type QuestionVersionOptionGroupBy struct {
+	selector
+	build *QuestionVersionOptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qvogb *QuestionVersionOptionGroupBy) Aggregate(fns ...AggregateFunc) *QuestionVersionOptionGroupBy {
+	qvogb.fns = append(qvogb.fns, fns...)
+	return qvogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qvogb *QuestionVersionOptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qvogb.build.ctx, ent.OpQueryGroupBy)
+	if err := qvogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionVersionOptionQuery, *QuestionVersionOptionGroupBy](ctx, qvogb.build, qvogb, qvogb.build.inters, v)
+}
+
+func (qvogb *QuestionVersionOptionGroupBy) sqlScan(ctx context.Context, root *QuestionVersionOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qvogb.fns))
+	for _, fn := range qvogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qvogb.flds)+len(qvogb.fns))
+		for _, f := range *qvogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qvogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qvogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuestionVersionOptionSelect is the builder for selecting fields of QuestionVersionOption entities.
+type QuestionVersionOptionSelect struct {
+	*QuestionVersionOptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qvos *QuestionVersionOptionSelect) Aggregate(fns ...AggregateFunc) *QuestionVersionOptionSelect {
+	qvos.fns = append(qvos.fns, fns...)
+	return qvos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qvos *QuestionVersionOptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qvos.ctx, ent.OpQuerySelect)
+	if err := qvos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionVersionOptionQuery, *QuestionVersionOptionSelect](ctx, qvos.QuestionVersionOptionQuery, qvos, qvos.inters, v)
+}
+
+func (qvos *QuestionVersionOptionSelect) sqlScan(ctx context.Context, root *QuestionVersionOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qvos.fns))
+	for _, fn := range qvos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qvos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qvos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
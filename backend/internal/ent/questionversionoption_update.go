@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questionversionoption"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuestionVersionOptionUpdate is the builder for updating QuestionVersionOption entities.
+type QuestionVersionOptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionVersionOptionMutation
+}
+
+// Where appends a list predicates to the QuestionVersionOptionUpdate builder.
+func (qvou *QuestionVersionOptionUpdate) Where(ps ...predicate.QuestionVersionOption) *QuestionVersionOptionUpdate {
+	qvou.mutation.Where(ps...)
+	return qvou
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvou *QuestionVersionOptionUpdate) SetCreatedAt(t time.Time) *QuestionVersionOptionUpdate {
+	qvou.mutation.SetCreatedAt(t)
+	return qvou
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvou *QuestionVersionOptionUpdate) SetNillableCreatedAt(t *time.Time) *QuestionVersionOptionUpdate {
+	if t != nil {
+		qvou.SetCreatedAt(*t)
+	}
+	return qvou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvou *QuestionVersionOptionUpdate) SetUpdatedAt(t time.Time) *QuestionVersionOptionUpdate {
+	qvou.mutation.SetUpdatedAt(t)
+	return qvou
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvou *QuestionVersionOptionUpdate) SetDeletedAt(t time.Time) *QuestionVersionOptionUpdate {
+	qvou.mutation.SetDeletedAt(t)
+	return qvou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvou *QuestionVersionOptionUpdate) SetNillableDeletedAt(t *time.Time) *QuestionVersionOptionUpdate {
+	if t != nil {
+		qvou.SetDeletedAt(*t)
+	}
+	return qvou
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qvou *QuestionVersionOptionUpdate) ClearDeletedAt() *QuestionVersionOptionUpdate {
+	qvou.mutation.ClearDeletedAt()
+	return qvou
+}
+
+// Mutation returns the QuestionVersionOptionMutation object of the builder.
+func (qvou *QuestionVersionOptionUpdate) Mutation() *QuestionVersionOptionMutation {
+	return qvou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qvou *QuestionVersionOptionUpdate) Save(ctx context.Context) (int, error) {
+	if err := qvou.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, qvou.sqlSave, qvou.mutation, qvou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvou *QuestionVersionOptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := qvou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qvou *QuestionVersionOptionUpdate) Exec(ctx context.Context) error {
+	_, err := qvou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvou *QuestionVersionOptionUpdate) ExecX(ctx context.Context) {
+	if err := qvou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvou *QuestionVersionOptionUpdate) defaults() error {
+	if _, ok := qvou.mutation.UpdatedAt(); !ok {
+		if questionversionoption.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversionoption.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversionoption.UpdateDefaultUpdatedAt()
+		qvou.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvou *QuestionVersionOptionUpdate) check() error {
+	if qvou.mutation.VersionCleared() && len(qvou.mutation.VersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionVersionOption.version"`)
+	}
+	return nil
+}
+
+func (qvou *QuestionVersionOptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qvou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionversionoption.Table, questionversionoption.Columns, sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID))
+	if ps := qvou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qvou.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvou.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvou.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversionoption.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qvou.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionversionoption.FieldDeletedAt, field.TypeTime)
+	}
+	if qvou.mutation.ExplanationCleared() {
+		_spec.ClearField(questionversionoption.FieldExplanation, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qvou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionversionoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qvou.mutation.done = true
+	return n, nil
+}
+
+// QuestionVersionOptionUpdateOne is the builder for updating a single QuestionVersionOption entity.
+type QuestionVersionOptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionVersionOptionMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qvouo *QuestionVersionOptionUpdateOne) SetCreatedAt(t time.Time) *QuestionVersionOptionUpdateOne {
+	qvouo.mutation.SetCreatedAt(t)
+	return qvouo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qvouo *QuestionVersionOptionUpdateOne) SetNillableCreatedAt(t *time.Time) *QuestionVersionOptionUpdateOne {
+	if t != nil {
+		qvouo.SetCreatedAt(*t)
+	}
+	return qvouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qvouo *QuestionVersionOptionUpdateOne) SetUpdatedAt(t time.Time) *QuestionVersionOptionUpdateOne {
+	qvouo.mutation.SetUpdatedAt(t)
+	return qvouo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qvouo *QuestionVersionOptionUpdateOne) SetDeletedAt(t time.Time) *QuestionVersionOptionUpdateOne {
+	qvouo.mutation.SetDeletedAt(t)
+	return qvouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qvouo *QuestionVersionOptionUpdateOne) SetNillableDeletedAt(t *time.Time) *QuestionVersionOptionUpdateOne {
+	if t != nil {
+		qvouo.SetDeletedAt(*t)
+	}
+	return qvouo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qvouo *QuestionVersionOptionUpdateOne) ClearDeletedAt() *QuestionVersionOptionUpdateOne {
+	qvouo.mutation.ClearDeletedAt()
+	return qvouo
+}
+
+// Mutation returns the QuestionVersionOptionMutation object of the builder.
+func (qvouo *QuestionVersionOptionUpdateOne) Mutation() *QuestionVersionOptionMutation {
+	return qvouo.mutation
+}
+
+// Where appends a list predicates to the QuestionVersionOptionUpdate builder.
+func (qvouo *QuestionVersionOptionUpdateOne) Where(ps ...predicate.QuestionVersionOption) *QuestionVersionOptionUpdateOne {
+	qvouo.mutation.Where(ps...)
+	return qvouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qvouo *QuestionVersionOptionUpdateOne) Select(field string, fields ...string) *QuestionVersionOptionUpdateOne {
+	qvouo.fields = append([]string{field}, fields...)
+	return qvouo
+}
+
+// Save executes the query and returns the updated QuestionVersionOption entity.
+func (qvouo *QuestionVersionOptionUpdateOne) Save(ctx context.Context) (*QuestionVersionOption, error) {
+	if err := qvouo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, qvouo.sqlSave, qvouo.mutation, qvouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qvouo *QuestionVersionOptionUpdateOne) SaveX(ctx context.Context) *QuestionVersionOption {
+	node, err := qvouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qvouo *QuestionVersionOptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := qvouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qvouo *QuestionVersionOptionUpdateOne) ExecX(ctx context.Context) {
+	if err := qvouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qvouo *QuestionVersionOptionUpdateOne) defaults() error {
+	if _, ok := qvouo.mutation.UpdatedAt(); !ok {
+		if questionversionoption.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized questionversionoption.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := questionversionoption.UpdateDefaultUpdatedAt()
+		qvouo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qvouo *QuestionVersionOptionUpdateOne) check() error {
+	if qvouo.mutation.VersionCleared() && len(qvouo.mutation.VersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionVersionOption.version"`)
+	}
+	return nil
+}
+
+func (qvouo *QuestionVersionOptionUpdateOne) sqlSave(ctx context.Context) (_node *QuestionVersionOption, err error) {
+	if err := qvouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionversionoption.Table, questionversionoption.Columns, sqlgraph.NewFieldSpec(questionversionoption.FieldID, field.TypeUUID))
+	id, ok := qvouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionVersionOption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qvouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionversionoption.FieldID)
+		for _, f := range fields {
+			if !questionversionoption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionversionoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qvouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qvouo.mutation.CreatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionversionoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qvouo.mutation.DeletedAt(); ok {
+		_spec.SetField(questionversionoption.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qvouo.mutation.DeletedAtCleared() {
+		_spec.ClearField(questionversionoption.FieldDeletedAt, field.TypeTime)
+	}
+	if qvouo.mutation.ExplanationCleared() {
+		_spec.ClearField(questionversionoption.FieldExplanation, field.TypeString)
+	}
+	_node = &QuestionVersionOption{config: qvouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qvouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionversionoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qvouo.mutation.done = true
+	return _node, nil
+}
@@ -3,14 +3,22 @@
 package runtime
 
 import (
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/permission"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
 	"template/internal/ent/role"
 	"template/internal/ent/schema"
 	"template/internal/ent/test"
@@ -18,8 +26,14 @@ import (
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/todo"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"template/internal/ent/video"
 	"template/internal/ent/videoquestiontimestamp"
 	"time"
@@ -31,11 +45,44 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accessgrantMixin := schema.AccessGrant{}.Mixin()
+	accessgrantMixinHooks1 := accessgrantMixin[1].Hooks()
+	accessgrantMixinHooks2 := accessgrantMixin[2].Hooks()
+	accessgrant.Hooks[0] = accessgrantMixinHooks1[0]
+	accessgrant.Hooks[1] = accessgrantMixinHooks2[0]
+	accessgrant.Hooks[2] = accessgrantMixinHooks2[1]
+	accessgrantMixinInters1 := accessgrantMixin[1].Interceptors()
+	accessgrantMixinInters2 := accessgrantMixin[2].Interceptors()
+	accessgrant.Interceptors[0] = accessgrantMixinInters1[0]
+	accessgrant.Interceptors[1] = accessgrantMixinInters2[0]
+	accessgrantMixinFields0 := accessgrantMixin[0].Fields()
+	_ = accessgrantMixinFields0
+	accessgrantFields := schema.AccessGrant{}.Fields()
+	_ = accessgrantFields
+	// accessgrantDescCreatedAt is the schema descriptor for created_at field.
+	accessgrantDescCreatedAt := accessgrantMixinFields0[1].Descriptor()
+	// accessgrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accessgrant.DefaultCreatedAt = accessgrantDescCreatedAt.Default.(func() time.Time)
+	// accessgrantDescUpdatedAt is the schema descriptor for updated_at field.
+	accessgrantDescUpdatedAt := accessgrantMixinFields0[2].Descriptor()
+	// accessgrant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accessgrant.DefaultUpdatedAt = accessgrantDescUpdatedAt.Default.(func() time.Time)
+	// accessgrant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accessgrant.UpdateDefaultUpdatedAt = accessgrantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accessgrantDescID is the schema descriptor for id field.
+	accessgrantDescID := accessgrantMixinFields0[0].Descriptor()
+	// accessgrant.DefaultID holds the default value on creation for the id field.
+	accessgrant.DefaultID = accessgrantDescID.Default.(func() uuid.UUID)
 	courseMixin := schema.Course{}.Mixin()
 	courseMixinHooks1 := courseMixin[1].Hooks()
+	courseMixinHooks2 := courseMixin[2].Hooks()
 	course.Hooks[0] = courseMixinHooks1[0]
+	course.Hooks[1] = courseMixinHooks2[0]
+	course.Hooks[2] = courseMixinHooks2[1]
 	courseMixinInters1 := courseMixin[1].Interceptors()
+	courseMixinInters2 := courseMixin[2].Interceptors()
 	course.Interceptors[0] = courseMixinInters1[0]
+	course.Interceptors[1] = courseMixinInters2[0]
 	courseMixinFields0 := courseMixin[0].Fields()
 	_ = courseMixinFields0
 	courseFields := schema.Course{}.Fields()
@@ -62,11 +109,46 @@ func init() {
 	courseDescID := courseMixinFields0[0].Descriptor()
 	// course.DefaultID holds the default value on creation for the id field.
 	course.DefaultID = courseDescID.Default.(func() uuid.UUID)
+	courseenrollmentMixin := schema.CourseEnrollment{}.Mixin()
+	courseenrollmentMixinHooks1 := courseenrollmentMixin[1].Hooks()
+	courseenrollmentMixinHooks2 := courseenrollmentMixin[2].Hooks()
+	courseenrollment.Hooks[0] = courseenrollmentMixinHooks1[0]
+	courseenrollment.Hooks[1] = courseenrollmentMixinHooks2[0]
+	courseenrollmentMixinInters1 := courseenrollmentMixin[1].Interceptors()
+	courseenrollmentMixinInters2 := courseenrollmentMixin[2].Interceptors()
+	courseenrollment.Interceptors[0] = courseenrollmentMixinInters1[0]
+	courseenrollment.Interceptors[1] = courseenrollmentMixinInters2[0]
+	courseenrollmentMixinFields0 := courseenrollmentMixin[0].Fields()
+	_ = courseenrollmentMixinFields0
+	courseenrollmentFields := schema.CourseEnrollment{}.Fields()
+	_ = courseenrollmentFields
+	// courseenrollmentDescCreatedAt is the schema descriptor for created_at field.
+	courseenrollmentDescCreatedAt := courseenrollmentMixinFields0[1].Descriptor()
+	// courseenrollment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	courseenrollment.DefaultCreatedAt = courseenrollmentDescCreatedAt.Default.(func() time.Time)
+	// courseenrollmentDescUpdatedAt is the schema descriptor for updated_at field.
+	courseenrollmentDescUpdatedAt := courseenrollmentMixinFields0[2].Descriptor()
+	// courseenrollment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	courseenrollment.DefaultUpdatedAt = courseenrollmentDescUpdatedAt.Default.(func() time.Time)
+	// courseenrollment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	courseenrollment.UpdateDefaultUpdatedAt = courseenrollmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// courseenrollmentDescEnrolledAt is the schema descriptor for enrolled_at field.
+	courseenrollmentDescEnrolledAt := courseenrollmentFields[4].Descriptor()
+	// courseenrollment.DefaultEnrolledAt holds the default value on creation for the enrolled_at field.
+	courseenrollment.DefaultEnrolledAt = courseenrollmentDescEnrolledAt.Default.(func() time.Time)
+	// courseenrollmentDescID is the schema descriptor for id field.
+	courseenrollmentDescID := courseenrollmentMixinFields0[0].Descriptor()
+	// courseenrollment.DefaultID holds the default value on creation for the id field.
+	courseenrollment.DefaultID = courseenrollmentDescID.Default.(func() uuid.UUID)
 	coursesectionMixin := schema.CourseSection{}.Mixin()
 	coursesectionMixinHooks1 := coursesectionMixin[1].Hooks()
+	coursesectionMixinHooks2 := coursesectionMixin[2].Hooks()
 	coursesection.Hooks[0] = coursesectionMixinHooks1[0]
+	coursesection.Hooks[1] = coursesectionMixinHooks2[0]
 	coursesectionMixinInters1 := coursesectionMixin[1].Interceptors()
+	coursesectionMixinInters2 := coursesectionMixin[2].Interceptors()
 	coursesection.Interceptors[0] = coursesectionMixinInters1[0]
+	coursesection.Interceptors[1] = coursesectionMixinInters2[0]
 	coursesectionMixinFields0 := coursesectionMixin[0].Fields()
 	_ = coursesectionMixinFields0
 	coursesectionFields := schema.CourseSection{}.Fields()
@@ -93,6 +175,114 @@ func init() {
 	coursesectionDescID := coursesectionMixinFields0[0].Descriptor()
 	// coursesection.DefaultID holds the default value on creation for the id field.
 	coursesection.DefaultID = coursesectionDescID.Default.(func() uuid.UUID)
+	coursesectionprerequisiteMixin := schema.CourseSectionPrerequisite{}.Mixin()
+	coursesectionprerequisiteMixinHooks1 := coursesectionprerequisiteMixin[1].Hooks()
+	coursesectionprerequisiteMixinHooks2 := coursesectionprerequisiteMixin[2].Hooks()
+	coursesectionprerequisite.Hooks[0] = coursesectionprerequisiteMixinHooks1[0]
+	coursesectionprerequisite.Hooks[1] = coursesectionprerequisiteMixinHooks2[0]
+	coursesectionprerequisiteMixinInters1 := coursesectionprerequisiteMixin[1].Interceptors()
+	coursesectionprerequisiteMixinInters2 := coursesectionprerequisiteMixin[2].Interceptors()
+	coursesectionprerequisite.Interceptors[0] = coursesectionprerequisiteMixinInters1[0]
+	coursesectionprerequisite.Interceptors[1] = coursesectionprerequisiteMixinInters2[0]
+	coursesectionprerequisiteMixinFields0 := coursesectionprerequisiteMixin[0].Fields()
+	_ = coursesectionprerequisiteMixinFields0
+	coursesectionprerequisiteFields := schema.CourseSectionPrerequisite{}.Fields()
+	_ = coursesectionprerequisiteFields
+	// coursesectionprerequisiteDescCreatedAt is the schema descriptor for created_at field.
+	coursesectionprerequisiteDescCreatedAt := coursesectionprerequisiteMixinFields0[1].Descriptor()
+	// coursesectionprerequisite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coursesectionprerequisite.DefaultCreatedAt = coursesectionprerequisiteDescCreatedAt.Default.(func() time.Time)
+	// coursesectionprerequisiteDescUpdatedAt is the schema descriptor for updated_at field.
+	coursesectionprerequisiteDescUpdatedAt := coursesectionprerequisiteMixinFields0[2].Descriptor()
+	// coursesectionprerequisite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coursesectionprerequisite.DefaultUpdatedAt = coursesectionprerequisiteDescUpdatedAt.Default.(func() time.Time)
+	// coursesectionprerequisite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coursesectionprerequisite.UpdateDefaultUpdatedAt = coursesectionprerequisiteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coursesectionprerequisiteDescMinScorePercent is the schema descriptor for min_score_percent field.
+	coursesectionprerequisiteDescMinScorePercent := coursesectionprerequisiteFields[2].Descriptor()
+	// coursesectionprerequisite.MinScorePercentValidator is a validator for the "min_score_percent" field. It is called by the builders before save.
+	coursesectionprerequisite.MinScorePercentValidator = func() func(int) error {
+		validators := coursesectionprerequisiteDescMinScorePercent.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(min_score_percent int) error {
+			for _, fn := range fns {
+				if err := fn(min_score_percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// coursesectionprerequisiteDescID is the schema descriptor for id field.
+	coursesectionprerequisiteDescID := coursesectionprerequisiteMixinFields0[0].Descriptor()
+	// coursesectionprerequisite.DefaultID holds the default value on creation for the id field.
+	coursesectionprerequisite.DefaultID = coursesectionprerequisiteDescID.Default.(func() uuid.UUID)
+	groupMixin := schema.Group{}.Mixin()
+	groupMixinHooks1 := groupMixin[1].Hooks()
+	groupMixinHooks2 := groupMixin[2].Hooks()
+	group.Hooks[0] = groupMixinHooks1[0]
+	group.Hooks[1] = groupMixinHooks2[0]
+	group.Hooks[2] = groupMixinHooks2[1]
+	groupMixinInters1 := groupMixin[1].Interceptors()
+	groupMixinInters2 := groupMixin[2].Interceptors()
+	group.Interceptors[0] = groupMixinInters1[0]
+	group.Interceptors[1] = groupMixinInters2[0]
+	groupMixinFields0 := groupMixin[0].Fields()
+	_ = groupMixinFields0
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupMixinFields0[1].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupMixinFields0[2].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[0].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	// groupDescID is the schema descriptor for id field.
+	groupDescID := groupMixinFields0[0].Descriptor()
+	// group.DefaultID holds the default value on creation for the id field.
+	group.DefaultID = groupDescID.Default.(func() uuid.UUID)
+	grouptestassignmentMixin := schema.GroupTestAssignment{}.Mixin()
+	grouptestassignmentMixinHooks1 := grouptestassignmentMixin[1].Hooks()
+	grouptestassignmentMixinHooks2 := grouptestassignmentMixin[2].Hooks()
+	grouptestassignment.Hooks[0] = grouptestassignmentMixinHooks1[0]
+	grouptestassignment.Hooks[1] = grouptestassignmentMixinHooks2[0]
+	grouptestassignmentMixinInters1 := grouptestassignmentMixin[1].Interceptors()
+	grouptestassignmentMixinInters2 := grouptestassignmentMixin[2].Interceptors()
+	grouptestassignment.Interceptors[0] = grouptestassignmentMixinInters1[0]
+	grouptestassignment.Interceptors[1] = grouptestassignmentMixinInters2[0]
+	grouptestassignmentMixinFields0 := grouptestassignmentMixin[0].Fields()
+	_ = grouptestassignmentMixinFields0
+	grouptestassignmentFields := schema.GroupTestAssignment{}.Fields()
+	_ = grouptestassignmentFields
+	// grouptestassignmentDescCreatedAt is the schema descriptor for created_at field.
+	grouptestassignmentDescCreatedAt := grouptestassignmentMixinFields0[1].Descriptor()
+	// grouptestassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	grouptestassignment.DefaultCreatedAt = grouptestassignmentDescCreatedAt.Default.(func() time.Time)
+	// grouptestassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	grouptestassignmentDescUpdatedAt := grouptestassignmentMixinFields0[2].Descriptor()
+	// grouptestassignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	grouptestassignment.DefaultUpdatedAt = grouptestassignmentDescUpdatedAt.Default.(func() time.Time)
+	// grouptestassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	grouptestassignment.UpdateDefaultUpdatedAt = grouptestassignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// grouptestassignmentDescIncludeLaterMembers is the schema descriptor for include_later_members field.
+	grouptestassignmentDescIncludeLaterMembers := grouptestassignmentFields[3].Descriptor()
+	// grouptestassignment.DefaultIncludeLaterMembers holds the default value on creation for the include_later_members field.
+	grouptestassignment.DefaultIncludeLaterMembers = grouptestassignmentDescIncludeLaterMembers.Default.(bool)
+	// grouptestassignmentDescID is the schema descriptor for id field.
+	grouptestassignmentDescID := grouptestassignmentMixinFields0[0].Descriptor()
+	// grouptestassignment.DefaultID holds the default value on creation for the id field.
+	grouptestassignment.DefaultID = grouptestassignmentDescID.Default.(func() uuid.UUID)
 	jwttokenMixin := schema.JwtToken{}.Mixin()
 	jwttokenMixinHooks1 := jwttokenMixin[1].Hooks()
 	jwttoken.Hooks[0] = jwttokenMixinHooks1[0]
@@ -118,9 +308,14 @@ func init() {
 	jwttoken.DefaultID = jwttokenDescID.Default.(func() uuid.UUID)
 	mediaMixin := schema.Media{}.Mixin()
 	mediaMixinHooks1 := mediaMixin[1].Hooks()
+	mediaMixinHooks2 := mediaMixin[2].Hooks()
 	media.Hooks[0] = mediaMixinHooks1[0]
+	media.Hooks[1] = mediaMixinHooks2[0]
+	media.Hooks[2] = mediaMixinHooks2[1]
 	mediaMixinInters1 := mediaMixin[1].Interceptors()
+	mediaMixinInters2 := mediaMixin[2].Interceptors()
 	media.Interceptors[0] = mediaMixinInters1[0]
+	media.Interceptors[1] = mediaMixinInters2[0]
 	mediaMixinFields0 := mediaMixin[0].Fields()
 	_ = mediaMixinFields0
 	mediaFields := schema.Media{}.Fields()
@@ -151,6 +346,42 @@ func init() {
 	mediaDescID := mediaMixinFields0[0].Descriptor()
 	// media.DefaultID holds the default value on creation for the id field.
 	media.DefaultID = mediaDescID.Default.(func() uuid.UUID)
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinHooks1 := organizationMixin[1].Hooks()
+	organizationMixinHooks2 := organizationMixin[2].Hooks()
+	organization.Hooks[0] = organizationMixinHooks1[0]
+	organization.Hooks[1] = organizationMixinHooks2[0]
+	organization.Hooks[2] = organizationMixinHooks2[1]
+	organizationMixinInters1 := organizationMixin[1].Interceptors()
+	organizationMixinInters2 := organizationMixin[2].Interceptors()
+	organization.Interceptors[0] = organizationMixinInters1[0]
+	organization.Interceptors[1] = organizationMixinInters2[0]
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationMixinFields0[1].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationMixinFields0[2].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescSlug is the schema descriptor for slug field.
+	organizationDescSlug := organizationFields[1].Descriptor()
+	// organization.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	organization.SlugValidator = organizationDescSlug.Validators[0].(func(string) error)
+	// organizationDescID is the schema descriptor for id field.
+	organizationDescID := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultID holds the default value on creation for the id field.
+	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
 	permissionMixin := schema.Permission{}.Mixin()
 	permissionMixinHooks1 := permissionMixin[1].Hooks()
 	permission.Hooks[0] = permissionMixinHooks1[0]
@@ -180,9 +411,13 @@ func init() {
 	permission.DefaultID = permissionDescID.Default.(func() uuid.UUID)
 	questionMixin := schema.Question{}.Mixin()
 	questionMixinHooks1 := questionMixin[1].Hooks()
+	questionMixinHooks2 := questionMixin[2].Hooks()
 	question.Hooks[0] = questionMixinHooks1[0]
+	question.Hooks[1] = questionMixinHooks2[0]
 	questionMixinInters1 := questionMixin[1].Interceptors()
+	questionMixinInters2 := questionMixin[2].Interceptors()
 	question.Interceptors[0] = questionMixinInters1[0]
+	question.Interceptors[1] = questionMixinInters2[0]
 	questionMixinFields0 := questionMixin[0].Fields()
 	_ = questionMixinFields0
 	questionFields := schema.Question{}.Fields()
@@ -213,9 +448,14 @@ func init() {
 	question.DefaultID = questionDescID.Default.(func() uuid.UUID)
 	questioncollectionMixin := schema.QuestionCollection{}.Mixin()
 	questioncollectionMixinHooks1 := questioncollectionMixin[1].Hooks()
+	questioncollectionMixinHooks2 := questioncollectionMixin[2].Hooks()
 	questioncollection.Hooks[0] = questioncollectionMixinHooks1[0]
+	questioncollection.Hooks[1] = questioncollectionMixinHooks2[0]
+	questioncollection.Hooks[2] = questioncollectionMixinHooks2[1]
 	questioncollectionMixinInters1 := questioncollectionMixin[1].Interceptors()
+	questioncollectionMixinInters2 := questioncollectionMixin[2].Interceptors()
 	questioncollection.Interceptors[0] = questioncollectionMixinInters1[0]
+	questioncollection.Interceptors[1] = questioncollectionMixinInters2[0]
 	questioncollectionMixinFields0 := questioncollectionMixin[0].Fields()
 	_ = questioncollectionMixinFields0
 	questioncollectionFields := schema.QuestionCollection{}.Fields()
@@ -240,9 +480,13 @@ func init() {
 	questioncollection.DefaultID = questioncollectionDescID.Default.(func() uuid.UUID)
 	questionoptionMixin := schema.QuestionOption{}.Mixin()
 	questionoptionMixinHooks1 := questionoptionMixin[1].Hooks()
+	questionoptionMixinHooks2 := questionoptionMixin[2].Hooks()
 	questionoption.Hooks[0] = questionoptionMixinHooks1[0]
+	questionoption.Hooks[1] = questionoptionMixinHooks2[0]
 	questionoptionMixinInters1 := questionoptionMixin[1].Interceptors()
+	questionoptionMixinInters2 := questionoptionMixin[2].Interceptors()
 	questionoption.Interceptors[0] = questionoptionMixinInters1[0]
+	questionoption.Interceptors[1] = questionoptionMixinInters2[0]
 	questionoptionMixinFields0 := questionoptionMixin[0].Fields()
 	_ = questionoptionMixinFields0
 	questionoptionFields := schema.QuestionOption{}.Fields()
@@ -269,6 +513,68 @@ func init() {
 	questionoptionDescID := questionoptionMixinFields0[0].Descriptor()
 	// questionoption.DefaultID holds the default value on creation for the id field.
 	questionoption.DefaultID = questionoptionDescID.Default.(func() uuid.UUID)
+	questionversionMixin := schema.QuestionVersion{}.Mixin()
+	questionversionMixinHooks1 := questionversionMixin[1].Hooks()
+	questionversionMixinHooks2 := questionversionMixin[2].Hooks()
+	questionversion.Hooks[0] = questionversionMixinHooks1[0]
+	questionversion.Hooks[1] = questionversionMixinHooks2[0]
+	questionversionMixinInters1 := questionversionMixin[1].Interceptors()
+	questionversionMixinInters2 := questionversionMixin[2].Interceptors()
+	questionversion.Interceptors[0] = questionversionMixinInters1[0]
+	questionversion.Interceptors[1] = questionversionMixinInters2[0]
+	questionversionMixinFields0 := questionversionMixin[0].Fields()
+	_ = questionversionMixinFields0
+	questionversionFields := schema.QuestionVersion{}.Fields()
+	_ = questionversionFields
+	// questionversionDescCreatedAt is the schema descriptor for created_at field.
+	questionversionDescCreatedAt := questionversionMixinFields0[1].Descriptor()
+	// questionversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questionversion.DefaultCreatedAt = questionversionDescCreatedAt.Default.(func() time.Time)
+	// questionversionDescUpdatedAt is the schema descriptor for updated_at field.
+	questionversionDescUpdatedAt := questionversionMixinFields0[2].Descriptor()
+	// questionversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questionversion.DefaultUpdatedAt = questionversionDescUpdatedAt.Default.(func() time.Time)
+	// questionversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionversion.UpdateDefaultUpdatedAt = questionversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionversionDescVersion is the schema descriptor for version field.
+	questionversionDescVersion := questionversionFields[1].Descriptor()
+	// questionversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	questionversion.VersionValidator = questionversionDescVersion.Validators[0].(func(int) error)
+	// questionversionDescID is the schema descriptor for id field.
+	questionversionDescID := questionversionMixinFields0[0].Descriptor()
+	// questionversion.DefaultID holds the default value on creation for the id field.
+	questionversion.DefaultID = questionversionDescID.Default.(func() uuid.UUID)
+	questionversionoptionMixin := schema.QuestionVersionOption{}.Mixin()
+	questionversionoptionMixinHooks1 := questionversionoptionMixin[1].Hooks()
+	questionversionoptionMixinHooks2 := questionversionoptionMixin[2].Hooks()
+	questionversionoption.Hooks[0] = questionversionoptionMixinHooks1[0]
+	questionversionoption.Hooks[1] = questionversionoptionMixinHooks2[0]
+	questionversionoptionMixinInters1 := questionversionoptionMixin[1].Interceptors()
+	questionversionoptionMixinInters2 := questionversionoptionMixin[2].Interceptors()
+	questionversionoption.Interceptors[0] = questionversionoptionMixinInters1[0]
+	questionversionoption.Interceptors[1] = questionversionoptionMixinInters2[0]
+	questionversionoptionMixinFields0 := questionversionoptionMixin[0].Fields()
+	_ = questionversionoptionMixinFields0
+	questionversionoptionFields := schema.QuestionVersionOption{}.Fields()
+	_ = questionversionoptionFields
+	// questionversionoptionDescCreatedAt is the schema descriptor for created_at field.
+	questionversionoptionDescCreatedAt := questionversionoptionMixinFields0[1].Descriptor()
+	// questionversionoption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questionversionoption.DefaultCreatedAt = questionversionoptionDescCreatedAt.Default.(func() time.Time)
+	// questionversionoptionDescUpdatedAt is the schema descriptor for updated_at field.
+	questionversionoptionDescUpdatedAt := questionversionoptionMixinFields0[2].Descriptor()
+	// questionversionoption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questionversionoption.DefaultUpdatedAt = questionversionoptionDescUpdatedAt.Default.(func() time.Time)
+	// questionversionoption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionversionoption.UpdateDefaultUpdatedAt = questionversionoptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionversionoptionDescOrder is the schema descriptor for order field.
+	questionversionoptionDescOrder := questionversionoptionFields[5].Descriptor()
+	// questionversionoption.OrderValidator is a validator for the "order" field. It is called by the builders before save.
+	questionversionoption.OrderValidator = questionversionoptionDescOrder.Validators[0].(func(int) error)
+	// questionversionoptionDescID is the schema descriptor for id field.
+	questionversionoptionDescID := questionversionoptionMixinFields0[0].Descriptor()
+	// questionversionoption.DefaultID holds the default value on creation for the id field.
+	questionversionoption.DefaultID = questionversionoptionDescID.Default.(func() uuid.UUID)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinHooks1 := roleMixin[1].Hooks()
 	role.Hooks[0] = roleMixinHooks1[0]
@@ -298,9 +604,14 @@ func init() {
 	role.DefaultID = roleDescID.Default.(func() uuid.UUID)
 	testMixin := schema.Test{}.Mixin()
 	testMixinHooks1 := testMixin[1].Hooks()
+	testMixinHooks2 := testMixin[2].Hooks()
 	test.Hooks[0] = testMixinHooks1[0]
+	test.Hooks[1] = testMixinHooks2[0]
+	test.Hooks[2] = testMixinHooks2[1]
 	testMixinInters1 := testMixin[1].Interceptors()
+	testMixinInters2 := testMixin[2].Interceptors()
 	test.Interceptors[0] = testMixinInters1[0]
+	test.Interceptors[1] = testMixinInters2[0]
 	testMixinFields0 := testMixin[0].Fields()
 	_ = testMixinFields0
 	testFields := schema.Test{}.Fields()
@@ -320,20 +631,32 @@ func init() {
 	// test.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	test.NameValidator = testDescName.Validators[0].(func(string) error)
 	// testDescTotalPoints is the schema descriptor for total_points field.
-	testDescTotalPoints := testFields[3].Descriptor()
+	testDescTotalPoints := testFields[4].Descriptor()
 	// test.DefaultTotalPoints holds the default value on creation for the total_points field.
 	test.DefaultTotalPoints = testDescTotalPoints.Default.(int)
 	// test.TotalPointsValidator is a validator for the "total_points" field. It is called by the builders before save.
 	test.TotalPointsValidator = testDescTotalPoints.Validators[0].(func(int) error)
+	// testDescMaxAttempts is the schema descriptor for max_attempts field.
+	testDescMaxAttempts := testFields[8].Descriptor()
+	// test.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	test.MaxAttemptsValidator = testDescMaxAttempts.Validators[0].(func(int) error)
+	// testDescAttemptCooldownMinutes is the schema descriptor for attempt_cooldown_minutes field.
+	testDescAttemptCooldownMinutes := testFields[9].Descriptor()
+	// test.AttemptCooldownMinutesValidator is a validator for the "attempt_cooldown_minutes" field. It is called by the builders before save.
+	test.AttemptCooldownMinutesValidator = testDescAttemptCooldownMinutes.Validators[0].(func(int) error)
 	// testDescID is the schema descriptor for id field.
 	testDescID := testMixinFields0[0].Descriptor()
 	// test.DefaultID holds the default value on creation for the id field.
 	test.DefaultID = testDescID.Default.(func() uuid.UUID)
 	testignorequestionMixin := schema.TestIgnoreQuestion{}.Mixin()
 	testignorequestionMixinHooks1 := testignorequestionMixin[1].Hooks()
+	testignorequestionMixinHooks2 := testignorequestionMixin[2].Hooks()
 	testignorequestion.Hooks[0] = testignorequestionMixinHooks1[0]
+	testignorequestion.Hooks[1] = testignorequestionMixinHooks2[0]
 	testignorequestionMixinInters1 := testignorequestionMixin[1].Interceptors()
+	testignorequestionMixinInters2 := testignorequestionMixin[2].Interceptors()
 	testignorequestion.Interceptors[0] = testignorequestionMixinInters1[0]
+	testignorequestion.Interceptors[1] = testignorequestionMixinInters2[0]
 	testignorequestionMixinFields0 := testignorequestionMixin[0].Fields()
 	_ = testignorequestionMixinFields0
 	testignorequestionFields := schema.TestIgnoreQuestion{}.Fields()
@@ -354,9 +677,13 @@ func init() {
 	testignorequestion.DefaultID = testignorequestionDescID.Default.(func() uuid.UUID)
 	testquestioncountMixin := schema.TestQuestionCount{}.Mixin()
 	testquestioncountMixinHooks1 := testquestioncountMixin[1].Hooks()
+	testquestioncountMixinHooks2 := testquestioncountMixin[2].Hooks()
 	testquestioncount.Hooks[0] = testquestioncountMixinHooks1[0]
+	testquestioncount.Hooks[1] = testquestioncountMixinHooks2[0]
 	testquestioncountMixinInters1 := testquestioncountMixin[1].Interceptors()
+	testquestioncountMixinInters2 := testquestioncountMixin[2].Interceptors()
 	testquestioncount.Interceptors[0] = testquestioncountMixinInters1[0]
+	testquestioncount.Interceptors[1] = testquestioncountMixinInters2[0]
 	testquestioncountMixinFields0 := testquestioncountMixin[0].Fields()
 	_ = testquestioncountMixinFields0
 	testquestioncountFields := schema.TestQuestionCount{}.Fields()
@@ -389,9 +716,13 @@ func init() {
 	testquestioncount.DefaultID = testquestioncountDescID.Default.(func() uuid.UUID)
 	testsessionMixin := schema.TestSession{}.Mixin()
 	testsessionMixinHooks1 := testsessionMixin[1].Hooks()
+	testsessionMixinHooks2 := testsessionMixin[2].Hooks()
 	testsession.Hooks[0] = testsessionMixinHooks1[0]
+	testsession.Hooks[1] = testsessionMixinHooks2[0]
 	testsessionMixinInters1 := testsessionMixin[1].Interceptors()
+	testsessionMixinInters2 := testsessionMixin[2].Interceptors()
 	testsession.Interceptors[0] = testsessionMixinInters1[0]
+	testsession.Interceptors[1] = testsessionMixinInters2[0]
 	testsessionMixinFields0 := testsessionMixin[0].Fields()
 	_ = testsessionMixinFields0
 	testsessionFields := schema.TestSession{}.Fields()
@@ -406,12 +737,28 @@ func init() {
 	testsession.DefaultUpdatedAt = testsessionDescUpdatedAt.Default.(func() time.Time)
 	// testsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	testsession.UpdateDefaultUpdatedAt = testsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// testsessionDescTimeMultiplier is the schema descriptor for time_multiplier field.
+	testsessionDescTimeMultiplier := testsessionFields[9].Descriptor()
+	// testsession.TimeMultiplierValidator is a validator for the "time_multiplier" field. It is called by the builders before save.
+	testsession.TimeMultiplierValidator = testsessionDescTimeMultiplier.Validators[0].(func(float64) error)
+	// testsessionDescExtraMinutes is the schema descriptor for extra_minutes field.
+	testsessionDescExtraMinutes := testsessionFields[10].Descriptor()
+	// testsession.ExtraMinutesValidator is a validator for the "extra_minutes" field. It is called by the builders before save.
+	testsession.ExtraMinutesValidator = testsessionDescExtraMinutes.Validators[0].(func(int) error)
+	// testsessionDescMaxRestBreaks is the schema descriptor for max_rest_breaks field.
+	testsessionDescMaxRestBreaks := testsessionFields[12].Descriptor()
+	// testsession.MaxRestBreaksValidator is a validator for the "max_rest_breaks" field. It is called by the builders before save.
+	testsession.MaxRestBreaksValidator = testsessionDescMaxRestBreaks.Validators[0].(func(int) error)
+	// testsessionDescMaxRestBreakMinutes is the schema descriptor for max_rest_break_minutes field.
+	testsessionDescMaxRestBreakMinutes := testsessionFields[13].Descriptor()
+	// testsession.MaxRestBreakMinutesValidator is a validator for the "max_rest_break_minutes" field. It is called by the builders before save.
+	testsession.MaxRestBreakMinutesValidator = testsessionDescMaxRestBreakMinutes.Validators[0].(func(int) error)
 	// testsessionDescMaxPoints is the schema descriptor for max_points field.
-	testsessionDescMaxPoints := testsessionFields[6].Descriptor()
+	testsessionDescMaxPoints := testsessionFields[16].Descriptor()
 	// testsession.DefaultMaxPoints holds the default value on creation for the max_points field.
 	testsession.DefaultMaxPoints = testsessionDescMaxPoints.Default.(int)
 	// testsessionDescPointsEarned is the schema descriptor for points_earned field.
-	testsessionDescPointsEarned := testsessionFields[7].Descriptor()
+	testsessionDescPointsEarned := testsessionFields[17].Descriptor()
 	// testsession.DefaultPointsEarned holds the default value on creation for the points_earned field.
 	testsession.DefaultPointsEarned = testsessionDescPointsEarned.Default.(int)
 	// testsessionDescID is the schema descriptor for id field.
@@ -420,9 +767,13 @@ func init() {
 	testsession.DefaultID = testsessionDescID.Default.(func() uuid.UUID)
 	testsessionanswerMixin := schema.TestSessionAnswer{}.Mixin()
 	testsessionanswerMixinHooks1 := testsessionanswerMixin[1].Hooks()
+	testsessionanswerMixinHooks2 := testsessionanswerMixin[2].Hooks()
 	testsessionanswer.Hooks[0] = testsessionanswerMixinHooks1[0]
+	testsessionanswer.Hooks[1] = testsessionanswerMixinHooks2[0]
 	testsessionanswerMixinInters1 := testsessionanswerMixin[1].Interceptors()
+	testsessionanswerMixinInters2 := testsessionanswerMixin[2].Interceptors()
 	testsessionanswer.Interceptors[0] = testsessionanswerMixinInters1[0]
+	testsessionanswer.Interceptors[1] = testsessionanswerMixinInters2[0]
 	testsessionanswerMixinFields0 := testsessionanswerMixin[0].Fields()
 	_ = testsessionanswerMixinFields0
 	testsessionanswerFields := schema.TestSessionAnswer{}.Fields()
@@ -438,7 +789,7 @@ func init() {
 	// testsessionanswer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	testsessionanswer.UpdateDefaultUpdatedAt = testsessionanswerDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// testsessionanswerDescOrder is the schema descriptor for order field.
-	testsessionanswerDescOrder := testsessionanswerFields[3].Descriptor()
+	testsessionanswerDescOrder := testsessionanswerFields[4].Descriptor()
 	// testsessionanswer.DefaultOrder holds the default value on creation for the order field.
 	testsessionanswer.DefaultOrder = testsessionanswerDescOrder.Default.(int)
 	// testsessionanswer.OrderValidator is a validator for the "order" field. It is called by the builders before save.
@@ -447,6 +798,122 @@ func init() {
 	testsessionanswerDescID := testsessionanswerMixinFields0[0].Descriptor()
 	// testsessionanswer.DefaultID holds the default value on creation for the id field.
 	testsessionanswer.DefaultID = testsessionanswerDescID.Default.(func() uuid.UUID)
+	testsessionbreakMixin := schema.TestSessionBreak{}.Mixin()
+	testsessionbreakMixinHooks1 := testsessionbreakMixin[1].Hooks()
+	testsessionbreakMixinHooks2 := testsessionbreakMixin[2].Hooks()
+	testsessionbreak.Hooks[0] = testsessionbreakMixinHooks1[0]
+	testsessionbreak.Hooks[1] = testsessionbreakMixinHooks2[0]
+	testsessionbreakMixinInters1 := testsessionbreakMixin[1].Interceptors()
+	testsessionbreakMixinInters2 := testsessionbreakMixin[2].Interceptors()
+	testsessionbreak.Interceptors[0] = testsessionbreakMixinInters1[0]
+	testsessionbreak.Interceptors[1] = testsessionbreakMixinInters2[0]
+	testsessionbreakMixinFields0 := testsessionbreakMixin[0].Fields()
+	_ = testsessionbreakMixinFields0
+	testsessionbreakFields := schema.TestSessionBreak{}.Fields()
+	_ = testsessionbreakFields
+	// testsessionbreakDescCreatedAt is the schema descriptor for created_at field.
+	testsessionbreakDescCreatedAt := testsessionbreakMixinFields0[1].Descriptor()
+	// testsessionbreak.DefaultCreatedAt holds the default value on creation for the created_at field.
+	testsessionbreak.DefaultCreatedAt = testsessionbreakDescCreatedAt.Default.(func() time.Time)
+	// testsessionbreakDescUpdatedAt is the schema descriptor for updated_at field.
+	testsessionbreakDescUpdatedAt := testsessionbreakMixinFields0[2].Descriptor()
+	// testsessionbreak.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	testsessionbreak.DefaultUpdatedAt = testsessionbreakDescUpdatedAt.Default.(func() time.Time)
+	// testsessionbreak.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	testsessionbreak.UpdateDefaultUpdatedAt = testsessionbreakDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// testsessionbreakDescID is the schema descriptor for id field.
+	testsessionbreakDescID := testsessionbreakMixinFields0[0].Descriptor()
+	// testsessionbreak.DefaultID holds the default value on creation for the id field.
+	testsessionbreak.DefaultID = testsessionbreakDescID.Default.(func() uuid.UUID)
+	testsessionintegrityeventMixin := schema.TestSessionIntegrityEvent{}.Mixin()
+	testsessionintegrityeventMixinHooks1 := testsessionintegrityeventMixin[1].Hooks()
+	testsessionintegrityeventMixinHooks2 := testsessionintegrityeventMixin[2].Hooks()
+	testsessionintegrityevent.Hooks[0] = testsessionintegrityeventMixinHooks1[0]
+	testsessionintegrityevent.Hooks[1] = testsessionintegrityeventMixinHooks2[0]
+	testsessionintegrityeventMixinInters1 := testsessionintegrityeventMixin[1].Interceptors()
+	testsessionintegrityeventMixinInters2 := testsessionintegrityeventMixin[2].Interceptors()
+	testsessionintegrityevent.Interceptors[0] = testsessionintegrityeventMixinInters1[0]
+	testsessionintegrityevent.Interceptors[1] = testsessionintegrityeventMixinInters2[0]
+	testsessionintegrityeventMixinFields0 := testsessionintegrityeventMixin[0].Fields()
+	_ = testsessionintegrityeventMixinFields0
+	testsessionintegrityeventFields := schema.TestSessionIntegrityEvent{}.Fields()
+	_ = testsessionintegrityeventFields
+	// testsessionintegrityeventDescCreatedAt is the schema descriptor for created_at field.
+	testsessionintegrityeventDescCreatedAt := testsessionintegrityeventMixinFields0[1].Descriptor()
+	// testsessionintegrityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	testsessionintegrityevent.DefaultCreatedAt = testsessionintegrityeventDescCreatedAt.Default.(func() time.Time)
+	// testsessionintegrityeventDescUpdatedAt is the schema descriptor for updated_at field.
+	testsessionintegrityeventDescUpdatedAt := testsessionintegrityeventMixinFields0[2].Descriptor()
+	// testsessionintegrityevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	testsessionintegrityevent.DefaultUpdatedAt = testsessionintegrityeventDescUpdatedAt.Default.(func() time.Time)
+	// testsessionintegrityevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	testsessionintegrityevent.UpdateDefaultUpdatedAt = testsessionintegrityeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// testsessionintegrityeventDescID is the schema descriptor for id field.
+	testsessionintegrityeventDescID := testsessionintegrityeventMixinFields0[0].Descriptor()
+	// testsessionintegrityevent.DefaultID holds the default value on creation for the id field.
+	testsessionintegrityevent.DefaultID = testsessionintegrityeventDescID.Default.(func() uuid.UUID)
+	testsessionregradeMixin := schema.TestSessionRegrade{}.Mixin()
+	testsessionregradeMixinHooks1 := testsessionregradeMixin[1].Hooks()
+	testsessionregradeMixinHooks2 := testsessionregradeMixin[2].Hooks()
+	testsessionregrade.Hooks[0] = testsessionregradeMixinHooks1[0]
+	testsessionregrade.Hooks[1] = testsessionregradeMixinHooks2[0]
+	testsessionregradeMixinInters1 := testsessionregradeMixin[1].Interceptors()
+	testsessionregradeMixinInters2 := testsessionregradeMixin[2].Interceptors()
+	testsessionregrade.Interceptors[0] = testsessionregradeMixinInters1[0]
+	testsessionregrade.Interceptors[1] = testsessionregradeMixinInters2[0]
+	testsessionregradeMixinFields0 := testsessionregradeMixin[0].Fields()
+	_ = testsessionregradeMixinFields0
+	testsessionregradeFields := schema.TestSessionRegrade{}.Fields()
+	_ = testsessionregradeFields
+	// testsessionregradeDescCreatedAt is the schema descriptor for created_at field.
+	testsessionregradeDescCreatedAt := testsessionregradeMixinFields0[1].Descriptor()
+	// testsessionregrade.DefaultCreatedAt holds the default value on creation for the created_at field.
+	testsessionregrade.DefaultCreatedAt = testsessionregradeDescCreatedAt.Default.(func() time.Time)
+	// testsessionregradeDescUpdatedAt is the schema descriptor for updated_at field.
+	testsessionregradeDescUpdatedAt := testsessionregradeMixinFields0[2].Descriptor()
+	// testsessionregrade.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	testsessionregrade.DefaultUpdatedAt = testsessionregradeDescUpdatedAt.Default.(func() time.Time)
+	// testsessionregrade.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	testsessionregrade.UpdateDefaultUpdatedAt = testsessionregradeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// testsessionregradeDescID is the schema descriptor for id field.
+	testsessionregradeDescID := testsessionregradeMixinFields0[0].Descriptor()
+	// testsessionregrade.DefaultID holds the default value on creation for the id field.
+	testsessionregrade.DefaultID = testsessionregradeDescID.Default.(func() uuid.UUID)
+	testsessiontimeextensionMixin := schema.TestSessionTimeExtension{}.Mixin()
+	testsessiontimeextensionMixinHooks1 := testsessiontimeextensionMixin[1].Hooks()
+	testsessiontimeextensionMixinHooks2 := testsessiontimeextensionMixin[2].Hooks()
+	testsessiontimeextension.Hooks[0] = testsessiontimeextensionMixinHooks1[0]
+	testsessiontimeextension.Hooks[1] = testsessiontimeextensionMixinHooks2[0]
+	testsessiontimeextensionMixinInters1 := testsessiontimeextensionMixin[1].Interceptors()
+	testsessiontimeextensionMixinInters2 := testsessiontimeextensionMixin[2].Interceptors()
+	testsessiontimeextension.Interceptors[0] = testsessiontimeextensionMixinInters1[0]
+	testsessiontimeextension.Interceptors[1] = testsessiontimeextensionMixinInters2[0]
+	testsessiontimeextensionMixinFields0 := testsessiontimeextensionMixin[0].Fields()
+	_ = testsessiontimeextensionMixinFields0
+	testsessiontimeextensionFields := schema.TestSessionTimeExtension{}.Fields()
+	_ = testsessiontimeextensionFields
+	// testsessiontimeextensionDescCreatedAt is the schema descriptor for created_at field.
+	testsessiontimeextensionDescCreatedAt := testsessiontimeextensionMixinFields0[1].Descriptor()
+	// testsessiontimeextension.DefaultCreatedAt holds the default value on creation for the created_at field.
+	testsessiontimeextension.DefaultCreatedAt = testsessiontimeextensionDescCreatedAt.Default.(func() time.Time)
+	// testsessiontimeextensionDescUpdatedAt is the schema descriptor for updated_at field.
+	testsessiontimeextensionDescUpdatedAt := testsessiontimeextensionMixinFields0[2].Descriptor()
+	// testsessiontimeextension.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	testsessiontimeextension.DefaultUpdatedAt = testsessiontimeextensionDescUpdatedAt.Default.(func() time.Time)
+	// testsessiontimeextension.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	testsessiontimeextension.UpdateDefaultUpdatedAt = testsessiontimeextensionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// testsessiontimeextensionDescMinutes is the schema descriptor for minutes field.
+	testsessiontimeextensionDescMinutes := testsessiontimeextensionFields[2].Descriptor()
+	// testsessiontimeextension.MinutesValidator is a validator for the "minutes" field. It is called by the builders before save.
+	testsessiontimeextension.MinutesValidator = testsessiontimeextensionDescMinutes.Validators[0].(func(int) error)
+	// testsessiontimeextensionDescReason is the schema descriptor for reason field.
+	testsessiontimeextensionDescReason := testsessiontimeextensionFields[3].Descriptor()
+	// testsessiontimeextension.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	testsessiontimeextension.ReasonValidator = testsessiontimeextensionDescReason.Validators[0].(func(string) error)
+	// testsessiontimeextensionDescID is the schema descriptor for id field.
+	testsessiontimeextensionDescID := testsessiontimeextensionMixinFields0[0].Descriptor()
+	// testsessiontimeextension.DefaultID holds the default value on creation for the id field.
+	testsessiontimeextension.DefaultID = testsessiontimeextensionDescID.Default.(func() uuid.UUID)
 	todoMixin := schema.Todo{}.Mixin()
 	todoMixinHooks1 := todoMixin[1].Hooks()
 	todo.Hooks[0] = todoMixinHooks1[0]
@@ -472,9 +939,14 @@ func init() {
 	todo.DefaultID = todoDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks1 := userMixin[1].Hooks()
+	userMixinHooks2 := userMixin[2].Hooks()
 	user.Hooks[0] = userMixinHooks1[0]
+	user.Hooks[1] = userMixinHooks2[0]
+	user.Hooks[2] = userMixinHooks2[1]
 	userMixinInters1 := userMixin[1].Interceptors()
+	userMixinInters2 := userMixin[2].Interceptors()
 	user.Interceptors[0] = userMixinInters1[0]
+	user.Interceptors[1] = userMixinInters2[0]
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
 	userFields := schema.User{}.Fields()
@@ -505,15 +977,105 @@ func init() {
 	userDescIsActive := userFields[6].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescMustChangePassword is the schema descriptor for must_change_password field.
+	userDescMustChangePassword := userFields[7].Descriptor()
+	// user.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	user.DefaultMustChangePassword = userDescMustChangePassword.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	useraccommodationMixin := schema.UserAccommodation{}.Mixin()
+	useraccommodationMixinHooks1 := useraccommodationMixin[1].Hooks()
+	useraccommodationMixinHooks2 := useraccommodationMixin[2].Hooks()
+	useraccommodation.Hooks[0] = useraccommodationMixinHooks1[0]
+	useraccommodation.Hooks[1] = useraccommodationMixinHooks2[0]
+	useraccommodationMixinInters1 := useraccommodationMixin[1].Interceptors()
+	useraccommodationMixinInters2 := useraccommodationMixin[2].Interceptors()
+	useraccommodation.Interceptors[0] = useraccommodationMixinInters1[0]
+	useraccommodation.Interceptors[1] = useraccommodationMixinInters2[0]
+	useraccommodationMixinFields0 := useraccommodationMixin[0].Fields()
+	_ = useraccommodationMixinFields0
+	useraccommodationFields := schema.UserAccommodation{}.Fields()
+	_ = useraccommodationFields
+	// useraccommodationDescCreatedAt is the schema descriptor for created_at field.
+	useraccommodationDescCreatedAt := useraccommodationMixinFields0[1].Descriptor()
+	// useraccommodation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useraccommodation.DefaultCreatedAt = useraccommodationDescCreatedAt.Default.(func() time.Time)
+	// useraccommodationDescUpdatedAt is the schema descriptor for updated_at field.
+	useraccommodationDescUpdatedAt := useraccommodationMixinFields0[2].Descriptor()
+	// useraccommodation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useraccommodation.DefaultUpdatedAt = useraccommodationDescUpdatedAt.Default.(func() time.Time)
+	// useraccommodation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useraccommodation.UpdateDefaultUpdatedAt = useraccommodationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// useraccommodationDescTimeMultiplier is the schema descriptor for time_multiplier field.
+	useraccommodationDescTimeMultiplier := useraccommodationFields[1].Descriptor()
+	// useraccommodation.DefaultTimeMultiplier holds the default value on creation for the time_multiplier field.
+	useraccommodation.DefaultTimeMultiplier = useraccommodationDescTimeMultiplier.Default.(float64)
+	// useraccommodation.TimeMultiplierValidator is a validator for the "time_multiplier" field. It is called by the builders before save.
+	useraccommodation.TimeMultiplierValidator = useraccommodationDescTimeMultiplier.Validators[0].(func(float64) error)
+	// useraccommodationDescExtraMinutes is the schema descriptor for extra_minutes field.
+	useraccommodationDescExtraMinutes := useraccommodationFields[2].Descriptor()
+	// useraccommodation.DefaultExtraMinutes holds the default value on creation for the extra_minutes field.
+	useraccommodation.DefaultExtraMinutes = useraccommodationDescExtraMinutes.Default.(int)
+	// useraccommodation.ExtraMinutesValidator is a validator for the "extra_minutes" field. It is called by the builders before save.
+	useraccommodation.ExtraMinutesValidator = useraccommodationDescExtraMinutes.Validators[0].(func(int) error)
+	// useraccommodationDescAllowRestBreaks is the schema descriptor for allow_rest_breaks field.
+	useraccommodationDescAllowRestBreaks := useraccommodationFields[3].Descriptor()
+	// useraccommodation.DefaultAllowRestBreaks holds the default value on creation for the allow_rest_breaks field.
+	useraccommodation.DefaultAllowRestBreaks = useraccommodationDescAllowRestBreaks.Default.(bool)
+	// useraccommodationDescMaxRestBreaks is the schema descriptor for max_rest_breaks field.
+	useraccommodationDescMaxRestBreaks := useraccommodationFields[4].Descriptor()
+	// useraccommodation.MaxRestBreaksValidator is a validator for the "max_rest_breaks" field. It is called by the builders before save.
+	useraccommodation.MaxRestBreaksValidator = useraccommodationDescMaxRestBreaks.Validators[0].(func(int) error)
+	// useraccommodationDescMaxRestBreakMinutes is the schema descriptor for max_rest_break_minutes field.
+	useraccommodationDescMaxRestBreakMinutes := useraccommodationFields[5].Descriptor()
+	// useraccommodation.MaxRestBreakMinutesValidator is a validator for the "max_rest_break_minutes" field. It is called by the builders before save.
+	useraccommodation.MaxRestBreakMinutesValidator = useraccommodationDescMaxRestBreakMinutes.Validators[0].(func(int) error)
+	// useraccommodationDescID is the schema descriptor for id field.
+	useraccommodationDescID := useraccommodationMixinFields0[0].Descriptor()
+	// useraccommodation.DefaultID holds the default value on creation for the id field.
+	useraccommodation.DefaultID = useraccommodationDescID.Default.(func() uuid.UUID)
+	userinviteMixin := schema.UserInvite{}.Mixin()
+	userinviteMixinHooks1 := userinviteMixin[1].Hooks()
+	userinviteMixinHooks2 := userinviteMixin[2].Hooks()
+	userinvite.Hooks[0] = userinviteMixinHooks1[0]
+	userinvite.Hooks[1] = userinviteMixinHooks2[0]
+	userinviteMixinInters1 := userinviteMixin[1].Interceptors()
+	userinviteMixinInters2 := userinviteMixin[2].Interceptors()
+	userinvite.Interceptors[0] = userinviteMixinInters1[0]
+	userinvite.Interceptors[1] = userinviteMixinInters2[0]
+	userinviteMixinFields0 := userinviteMixin[0].Fields()
+	_ = userinviteMixinFields0
+	userinviteFields := schema.UserInvite{}.Fields()
+	_ = userinviteFields
+	// userinviteDescCreatedAt is the schema descriptor for created_at field.
+	userinviteDescCreatedAt := userinviteMixinFields0[1].Descriptor()
+	// userinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userinvite.DefaultCreatedAt = userinviteDescCreatedAt.Default.(func() time.Time)
+	// userinviteDescUpdatedAt is the schema descriptor for updated_at field.
+	userinviteDescUpdatedAt := userinviteMixinFields0[2].Descriptor()
+	// userinvite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userinvite.DefaultUpdatedAt = userinviteDescUpdatedAt.Default.(func() time.Time)
+	// userinvite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userinvite.UpdateDefaultUpdatedAt = userinviteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userinviteDescTokenHash is the schema descriptor for token_hash field.
+	userinviteDescTokenHash := userinviteFields[1].Descriptor()
+	// userinvite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	userinvite.TokenHashValidator = userinviteDescTokenHash.Validators[0].(func(string) error)
+	// userinviteDescID is the schema descriptor for id field.
+	userinviteDescID := userinviteMixinFields0[0].Descriptor()
+	// userinvite.DefaultID holds the default value on creation for the id field.
+	userinvite.DefaultID = userinviteDescID.Default.(func() uuid.UUID)
 	videoMixin := schema.Video{}.Mixin()
 	videoMixinHooks1 := videoMixin[1].Hooks()
+	videoMixinHooks2 := videoMixin[2].Hooks()
 	video.Hooks[0] = videoMixinHooks1[0]
+	video.Hooks[1] = videoMixinHooks2[0]
 	videoMixinInters1 := videoMixin[1].Interceptors()
+	videoMixinInters2 := videoMixin[2].Interceptors()
 	video.Interceptors[0] = videoMixinInters1[0]
+	video.Interceptors[1] = videoMixinInters2[0]
 	videoMixinFields0 := videoMixin[0].Fields()
 	_ = videoMixinFields0
 	videoFields := schema.Video{}.Fields()
@@ -538,9 +1100,13 @@ func init() {
 	video.DefaultID = videoDescID.Default.(func() uuid.UUID)
 	videoquestiontimestampMixin := schema.VideoQuestionTimestamp{}.Mixin()
 	videoquestiontimestampMixinHooks1 := videoquestiontimestampMixin[1].Hooks()
+	videoquestiontimestampMixinHooks2 := videoquestiontimestampMixin[2].Hooks()
 	videoquestiontimestamp.Hooks[0] = videoquestiontimestampMixinHooks1[0]
+	videoquestiontimestamp.Hooks[1] = videoquestiontimestampMixinHooks2[0]
 	videoquestiontimestampMixinInters1 := videoquestiontimestampMixin[1].Interceptors()
+	videoquestiontimestampMixinInters2 := videoquestiontimestampMixin[2].Interceptors()
 	videoquestiontimestamp.Interceptors[0] = videoquestiontimestampMixinInters1[0]
+	videoquestiontimestamp.Interceptors[1] = videoquestiontimestampMixinInters2[0]
 	videoquestiontimestampMixinFields0 := videoquestiontimestampMixin[0].Fields()
 	_ = videoquestiontimestampMixinFields0
 	videoquestiontimestampFields := schema.VideoQuestionTimestamp{}.Fields()
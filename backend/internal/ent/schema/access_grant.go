@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AccessGrant holds the schema definition for the AccessGrant entity.
+// It shares a test, a course or a question collection with a user or with the members of a group.
+// Exactly one of the resources is set, and exactly one of the user and the group.
+type AccessGrant struct {
+	ent.Schema
+}
+
+const (
+	AccessGrantRoleViewer string = "viewer"
+	AccessGrantRoleEditor string = "editor"
+)
+
+// Fields of the AccessGrant.
+func (AccessGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("group_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("role").Values(AccessGrantRoleViewer, AccessGrantRoleEditor).Comment("Viewers can read the resource, editors can also change it"),
+		field.UUID("test_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("course_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("question_collection_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("granted_by_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+// Edges of the AccessGrant.
+func (AccessGrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("access_grants").
+			Field("organization_id").
+			Unique(),
+		edge.From("user", User.Type).
+			Ref("access_grants").
+			Field("user_id").
+			Unique(),
+		edge.From("group", Group.Type).
+			Ref("access_grants").
+			Field("group_id").
+			Unique(),
+		edge.From("test", Test.Type).
+			Ref("access_grants").
+			Field("test_id").
+			Unique(),
+		edge.From("course", Course.Type).
+			Ref("access_grants").
+			Field("course_id").
+			Unique(),
+		edge.From("question_collection", QuestionCollection.Type).
+			Ref("access_grants").
+			Field("question_collection_id").
+			Unique(),
+		edge.From("granted_by", User.Type).
+			Ref("granted_access_grants").
+			Field("granted_by_id").
+			Unique(),
+	}
+}
+
+// Indexes of the AccessGrant.
+func (AccessGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "test_id").Unique(),
+		index.Fields("user_id", "course_id").Unique(),
+		index.Fields("user_id", "question_collection_id").Unique(),
+		index.Fields("group_id", "test_id").Unique(),
+		index.Fields("group_id", "course_id").Unique(),
+		index.Fields("group_id", "question_collection_id").Unique(),
+	}
+}
+
+// Annotations of the AccessGrant.
+func (AccessGrant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Checks(map[string]string{
+			"access_grant_single_resource": "num_nonnulls(test_id, course_id, question_collection_id) = 1",
+			"access_grant_single_grantee":  "num_nonnulls(user_id, group_id) = 1",
+		}),
+	}
+}
+
+func (AccessGrant) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
+}
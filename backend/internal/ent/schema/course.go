@@ -4,6 +4,7 @@ import (
 	"template/internal/ent/schema/mixin"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +16,13 @@ type Course struct {
 	ent.Schema
 }
 
+const (
+	CourseStatusDraft     string = "draft"
+	CourseStatusReview    string = "review"
+	CourseStatusPublished string = "published"
+	CourseStatusArchived  string = "archived"
+)
+
 // Fields of the Course.
 func (Course) Fields() []ent.Field {
 	return []ent.Field{
@@ -23,12 +31,29 @@ func (Course) Fields() []ent.Field {
 		field.UUID("media_id", uuid.UUID{}).Optional().Nillable(),
 		field.UUID("creator_id", uuid.UUID{}),
 		field.Bool("is_published").Default(false),
+		field.Enum("status").Values(CourseStatusDraft, CourseStatusReview, CourseStatusPublished, CourseStatusArchived).Default(CourseStatusDraft),
+		field.Time("published_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}),
 	}
 }
 
 // Edges of the Course.
 func (Course) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("courses").
+			Field("organization_id").
+			Unique(),
 		edge.From("media", Media.Type).
 			Ref("course_media").
 			Field("media_id").
@@ -44,9 +69,13 @@ func (Course) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("tests", Test.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("enrollments", CourseEnrollment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_grants", AccessGrant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (Course) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
 }
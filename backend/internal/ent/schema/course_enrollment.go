@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CourseEnrollment holds the schema definition for the CourseEnrollment entity.
+type CourseEnrollment struct {
+	ent.Schema
+}
+
+const (
+	CourseEnrollmentRoleLearner           string = "learner"
+	CourseEnrollmentRoleInstructor        string = "instructor"
+	CourseEnrollmentRoleTeachingAssistant string = "teaching_assistant"
+)
+
+const (
+	CourseEnrollmentStatusActive    string = "active"
+	CourseEnrollmentStatusSuspended string = "suspended"
+	CourseEnrollmentStatusCompleted string = "completed"
+)
+
+// Fields of the CourseEnrollment.
+func (CourseEnrollment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("course_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("role").Values(CourseEnrollmentRoleLearner, CourseEnrollmentRoleInstructor, CourseEnrollmentRoleTeachingAssistant).Default(CourseEnrollmentRoleLearner),
+		field.Enum("status").Values(CourseEnrollmentStatusActive, CourseEnrollmentStatusSuspended, CourseEnrollmentStatusCompleted).Default(CourseEnrollmentStatusActive),
+		field.Time("enrolled_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}),
+	}
+}
+
+// Edges of the CourseEnrollment.
+func (CourseEnrollment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("enrollments").
+			Field("course_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("course_enrollments").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CourseEnrollment.
+func (CourseEnrollment) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user can only be enrolled once per course
+		index.Fields("course_id", "user_id").Unique(),
+	}
+}
+
+func (CourseEnrollment) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "course_id", Table: "courses"})
+}
@@ -47,9 +47,13 @@ func (CourseSection) Edges() []ent.Edge {
 		edge.To("test_sessions", TestSession.Type),
 		edge.To("tests", Test.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("prerequisites", CourseSectionPrerequisite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("required_by", CourseSectionPrerequisite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (CourseSection) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "course_id", Table: "courses"})
 }
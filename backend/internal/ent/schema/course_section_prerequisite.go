@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CourseSectionPrerequisite holds the schema definition for the CourseSectionPrerequisite entity.
+// A section is locked for a learner until every test of each prerequisite section has been completed,
+// optionally with a minimum score.
+type CourseSectionPrerequisite struct {
+	ent.Schema
+}
+
+// Fields of the CourseSectionPrerequisite.
+func (CourseSectionPrerequisite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("section_id", uuid.UUID{}),
+		field.UUID("prerequisite_section_id", uuid.UUID{}),
+		field.Int("min_score_percent").Optional().Nillable().Min(0).Max(100).Comment("Minimum score in percent required on the tests of the prerequisite section"),
+	}
+}
+
+// Edges of the CourseSectionPrerequisite.
+func (CourseSectionPrerequisite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("section", CourseSection.Type).
+			Ref("prerequisites").
+			Field("section_id").
+			Unique().
+			Required(),
+		edge.From("prerequisite_section", CourseSection.Type).
+			Ref("required_by").
+			Field("prerequisite_section_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CourseSectionPrerequisite.
+func (CourseSectionPrerequisite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("section_id", "prerequisite_section_id").Unique(),
+	}
+}
+
+func (CourseSectionPrerequisite) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "section_id",
+		Table:  "course_sections",
+		Parent: &mixin.TenantParentMixin{Column: "course_id", Table: "courses"},
+	})
+}
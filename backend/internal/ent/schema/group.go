@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Group holds the schema definition for the Group entity.
+// A group gathers users, e.g. a class or a department, so resources can be shared with all of them at once.
+type Group struct {
+	ent.Schema
+}
+
+// Fields of the Group.
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.Text("description").Optional().Nillable(),
+		field.UUID("creator_id", uuid.UUID{}),
+	}
+}
+
+// Edges of the Group.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("groups").
+			Field("organization_id").
+			Unique(),
+		edge.From("creator", User.Type).
+			Ref("created_groups").
+			Field("creator_id").
+			Unique().
+			Required(),
+		edge.From("members", User.Type).
+			Ref("member_groups"),
+		edge.To("access_grants", AccessGrant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("test_assignments", GroupTestAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Group) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignment holds the schema definition for the GroupTestAssignment entity.
+// It assigns a test to the members of a group, a test session is created for each member.
+type GroupTestAssignment struct {
+	ent.Schema
+}
+
+// Fields of the GroupTestAssignment.
+func (GroupTestAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("group_id", uuid.UUID{}),
+		field.UUID("test_id", uuid.UUID{}),
+		field.UUID("assigned_by_id", uuid.UUID{}).Optional().Nillable(),
+		field.Bool("include_later_members").Default(false).Comment("Creates a test session for the members added to the group after the assignment"),
+		field.Time("expired_at").Optional().Nillable().Comment("Expiry time of the created test sessions, the members added later get the same time span from when they join"),
+		field.Time("open_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Open time of the created test sessions, stored in UTC"),
+		field.Time("close_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Close time of the created test sessions, stored in UTC"),
+	}
+}
+
+// Edges of the GroupTestAssignment.
+func (GroupTestAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("group", Group.Type).
+			Ref("test_assignments").
+			Field("group_id").
+			Unique().
+			Required(),
+		edge.From("test", Test.Type).
+			Ref("group_assignments").
+			Field("test_id").
+			Unique().
+			Required(),
+		edge.From("assigned_by", User.Type).
+			Ref("group_test_assignments").
+			Field("assigned_by_id").
+			Unique(),
+		edge.To("test_sessions", TestSession.Type),
+	}
+}
+
+// Indexes of the GroupTestAssignment.
+func (GroupTestAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "test_id").Unique(),
+	}
+}
+
+func (GroupTestAssignment) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "group_id", Table: "groups"})
+}
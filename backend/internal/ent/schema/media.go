@@ -28,6 +28,10 @@ func (Media) Fields() []ent.Field {
 // Edges of the Media.
 func (Media) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("media").
+			Field("organization_id").
+			Unique(),
 		edge.To("user_media", User.Type),
 		edge.From("user", User.Type).
 			Ref("media_uploader").
@@ -39,5 +43,5 @@ func (Media) Edges() []ent.Edge {
 }
 
 func (Media) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
 }
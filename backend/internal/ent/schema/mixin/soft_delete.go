@@ -34,11 +34,27 @@ func (SoftDeleteMixin) Fields() []ent.Field {
 
 type softDeleteKey struct{}
 
+type deletedAtKey struct{}
+
 // WithSoftDelete returns a new context that skips the soft-delete interceptor/mutators.
 func WithSoftDelete(parent context.Context) context.Context {
 	return context.WithValue(parent, softDeleteKey{}, true)
 }
 
+// WithDeletedAt returns a new soft-delete context whose deletions share the deletion time, so the entities
+// deleted along with a parent can be told apart from the ones deleted before and restored with it.
+func WithDeletedAt(parent context.Context, deletedAt time.Time) context.Context {
+	return context.WithValue(WithSoftDelete(parent), deletedAtKey{}, deletedAt)
+}
+
+// deletionTime returns the deletion time shared by the context, or the current time.
+func deletionTime(ctx context.Context) time.Time {
+	if deletedAt, ok := ctx.Value(deletedAtKey{}).(time.Time); ok {
+		return deletedAt
+	}
+	return time.Now().UTC()
+}
+
 // Interceptors of the SoftDeleteMixin.
 func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
 	return []ent.Interceptor{
@@ -74,7 +90,7 @@ func (d SoftDeleteMixin) Hooks() []ent.Hook {
 					}
 					d.P(mx)
 					mx.SetOp(ent.OpUpdate)
-					mx.SetDeletedAt(time.Now())
+					mx.SetDeletedAt(deletionTime(ctx))
 					return mx.Client().Mutate(ctx, m)
 				})
 			},
@@ -0,0 +1,318 @@
+package mixin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"template/internal/ent/hook"
+	"template/internal/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// organizationColumn is the column holding the organization owning a tenant-scoped entity.
+const organizationColumn = "organization_id"
+
+// ErrCrossTenant is returned when a mutation targets an organization other than the tenant of the context.
+var ErrCrossTenant = errors.New("cross-tenant access rejected")
+
+type tenantKey struct{}
+
+type skipTenantKey struct{}
+
+// tenantScope holds the organization the queries of a context are scoped to. It is set once the user is
+// authenticated, so the queries made before, e.g. to validate the token, aren't scoped.
+type tenantScope struct {
+	mu             sync.RWMutex
+	scoped         bool
+	organizationID *uuid.UUID
+}
+
+// WithTenantScope returns a context whose queries are scoped to the tenant set later with SetTenant.
+func WithTenantScope(parent context.Context) context.Context {
+	return context.WithValue(parent, tenantKey{}, &tenantScope{})
+}
+
+// WithTenant returns a context whose queries are scoped to the organization, nil being the users without organization.
+func WithTenant(parent context.Context, organizationID *uuid.UUID) context.Context {
+	return context.WithValue(parent, tenantKey{}, &tenantScope{scoped: true, organizationID: organizationID})
+}
+
+// SetTenant scopes the queries of a context returned by WithTenantScope to the organization.
+func SetTenant(ctx context.Context, organizationID *uuid.UUID) {
+	scope, ok := ctx.Value(tenantKey{}).(*tenantScope)
+	if !ok {
+		return
+	}
+
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.scoped = true
+	scope.organizationID = organizationID
+}
+
+// SkipTenant returns a new context that skips the tenant interceptor/mutators.
+func SkipTenant(parent context.Context) context.Context {
+	return context.WithValue(parent, skipTenantKey{}, true)
+}
+
+// TenantFromContext returns the organization the queries of the context are scoped to, ok is false when they aren't scoped.
+func TenantFromContext(ctx context.Context) (organizationID *uuid.UUID, ok bool) {
+	if skip, _ := ctx.Value(skipTenantKey{}).(bool); skip {
+		return nil, false
+	}
+
+	scope, found := ctx.Value(tenantKey{}).(*tenantScope)
+	if !found {
+		return nil, false
+	}
+
+	scope.mu.RLock()
+	defer scope.mu.RUnlock()
+	return scope.organizationID, scope.scoped
+}
+
+// tenantPredicate matches the rows of the organization, or the rows without organization when it is nil.
+func tenantPredicate(column string, organizationID *uuid.UUID) *sql.Predicate {
+	if organizationID == nil {
+		return sql.IsNull(column)
+	}
+	return sql.EQ(column, *organizationID)
+}
+
+// TenantMixin makes an entity owned by an organization. The queries and mutations of a context scoped to
+// a tenant only see the entities of its organization, and the created entities belong to it.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TenantMixin.
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID(organizationColumn, uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(organizationColumn),
+	}
+}
+
+// Interceptors of the TenantMixin.
+func (d TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if organizationID, ok := TenantFromContext(ctx); ok {
+				d.P(q, organizationID)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the TenantMixin.
+func (d TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					organizationID, ok := TenantFromContext(ctx)
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						OrganizationID() (uuid.UUID, bool)
+						SetOrganizationID(uuid.UUID)
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+
+					// The entity belongs to the tenant, another organization can't be set
+					if id, set := mx.OrganizationID(); set && (organizationID == nil || id != *organizationID) {
+						return nil, ErrCrossTenant
+					}
+					if organizationID != nil && m.Op().Is(ent.OpCreate) {
+						mx.SetOrganizationID(*organizationID)
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					organizationID, ok := TenantFromContext(ctx)
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					d.P(mx, organizationID)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// P adds a storage-level predicate matching the entities of the organization to the queries and mutations.
+func (d TenantMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, organizationID *uuid.UUID) {
+	w.WhereP(func(s *sql.Selector) {
+		s.Where(tenantPredicate(s.C(organizationColumn), organizationID))
+	})
+}
+
+// TenantParentMixin scopes an entity to the organization of the tenant-scoped entity it belongs to,
+// e.g. the sections of a course, so they can't be read, updated or deleted from another organization.
+type TenantParentMixin struct {
+	mixin.Schema
+	// Column is the foreign key to the parent entity, e.g. course_id.
+	Column string
+	// Table is the table of the parent entity, e.g. courses.
+	Table string
+	// Parent scopes the parent entity when it belongs to the organization through its own parent,
+	// e.g. the question of an option belongs to it through its collection. Nil when the parent is tenant-scoped.
+	Parent *TenantParentMixin
+}
+
+// Interceptors of the TenantParentMixin.
+func (d TenantParentMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if organizationID, ok := TenantFromContext(ctx); ok {
+				d.P(q, organizationID)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the TenantParentMixin.
+func (d TenantParentMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					organizationID, ok := TenantFromContext(ctx)
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					d.P(mx, organizationID)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// P adds a storage-level predicate matching the entities whose parent belongs to the organization.
+func (d TenantParentMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, organizationID *uuid.UUID) {
+	w.WhereP(func(s *sql.Selector) {
+		s.Where(d.predicate(s.C(d.Column), organizationID))
+	})
+}
+
+// predicate matches the foreign keys to the parents belonging to the organization.
+func (d TenantParentMixin) predicate(column string, organizationID *uuid.UUID) *sql.Predicate {
+	parent := sql.Table(d.Table)
+	parentPredicate := tenantPredicate(parent.C(organizationColumn), organizationID)
+	if d.Parent != nil {
+		parentPredicate = d.Parent.predicate(parent.C(d.Parent.Column), organizationID)
+	}
+
+	return sql.In(
+		column,
+		sql.Select(parent.C("id")).
+			From(parent).
+			Where(parentPredicate),
+	)
+}
+
+// OrganizationMixin scopes the organizations themselves, a context scoped to a tenant only sees
+// its own organization and can't create another one.
+type OrganizationMixin struct {
+	mixin.Schema
+}
+
+// Interceptors of the OrganizationMixin.
+func (d OrganizationMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if organizationID, ok := TenantFromContext(ctx); ok {
+				d.P(q, organizationID)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the OrganizationMixin.
+func (d OrganizationMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if _, ok := TenantFromContext(ctx); ok {
+						return nil, ErrCrossTenant
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					organizationID, ok := TenantFromContext(ctx)
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					d.P(mx, organizationID)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// P adds a storage-level predicate matching the organization, none for the users without organization.
+func (d OrganizationMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, organizationID *uuid.UUID) {
+	w.WhereP(func(s *sql.Selector) {
+		if organizationID == nil {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.EQ(s.C("id"), *organizationID))
+	})
+}
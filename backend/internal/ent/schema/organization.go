@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization holds the schema definition for the Organization entity.
+// An organization is a tenant, e.g. a school, owning its users, courses, question collections, tests, groups, media and access grants.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("slug").NotEmpty().Unique(),
+		field.Text("description").Optional().Nillable(),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+		edge.To("courses", Course.Type),
+		edge.To("question_collections", QuestionCollection.Type),
+		edge.To("tests", Test.Type),
+		edge.To("groups", Group.Type),
+		edge.To("media", Media.Type),
+		edge.To("access_grants", AccessGrant.Type),
+	}
+}
+
+func (Organization) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.OrganizationMixin{})
+}
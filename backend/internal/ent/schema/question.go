@@ -21,6 +21,7 @@ func (Question) Fields() []ent.Field {
 		field.UUID("collection_id", uuid.UUID{}),
 		field.Text("question_text").NotEmpty(),
 		field.Int("points").Default(0).Min(0),
+		field.Text("explanation").Optional().Nillable().Comment("Markdown explanation of the answer, with its sources"),
 	}
 }
 
@@ -35,12 +36,14 @@ func (Question) Edges() []ent.Edge {
 		edge.To("question_options", QuestionOption.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("video_question_timestamps_question", VideoQuestionTimestamp.Type),
-		edge.To("test_session_answers", TestSessionAnswer.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Answers and versions are kept when a question is deleted so the results of past sessions are retained
+		edge.To("test_session_answers", TestSessionAnswer.Type),
+		edge.To("versions", QuestionVersion.Type),
 		edge.From("test_ignore_questions", TestIgnoreQuestion.Type).
 			Ref("question"),
 	}
 }
 
 func (Question) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "collection_id", Table: "question_collections"})
 }
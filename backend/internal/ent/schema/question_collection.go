@@ -28,6 +28,10 @@ func (QuestionCollection) Fields() []ent.Field {
 // Edges of the QuestionCollection.
 func (QuestionCollection) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("question_collections").
+			Field("organization_id").
+			Unique(),
 		edge.From("creator", User.Type).
 			Ref("question_collections").
 			Field("creator_id").
@@ -42,9 +46,11 @@ func (QuestionCollection) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("test", Test.Type).
 			Ref("question_collections"),
+		edge.To("access_grants", AccessGrant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (QuestionCollection) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
 }
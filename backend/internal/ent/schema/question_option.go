@@ -20,6 +20,7 @@ func (QuestionOption) Fields() []ent.Field {
 		field.UUID("question_id", uuid.UUID{}),
 		field.Text("option_text").NotEmpty(),
 		field.Bool("is_correct").Default(false),
+		field.Text("explanation").Optional().Nillable().Comment("Markdown explanation of why the option is correct or not"),
 	}
 }
 
@@ -35,5 +36,9 @@ func (QuestionOption) Edges() []ent.Edge {
 }
 
 func (QuestionOption) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "question_id",
+		Table:  "questions",
+		Parent: &mixin.TenantParentMixin{Column: "collection_id", Table: "question_collections"},
+	})
 }
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuestionVersion holds the schema definition for the QuestionVersion entity.
+// A version is an immutable copy of a question and its options, a new one is recorded on every edit.
+type QuestionVersion struct {
+	ent.Schema
+}
+
+// Fields of the QuestionVersion.
+func (QuestionVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("question_id", uuid.UUID{}).Immutable(),
+		field.Int("version").Positive().Immutable(),
+		field.Text("question_text").Immutable(),
+		field.Int("points").Immutable(),
+		field.Text("explanation").Optional().Nillable().Immutable(),
+		field.UUID("created_by_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the QuestionVersion.
+func (QuestionVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("question", Question.Type).
+			Ref("versions").
+			Field("question_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("created_by", User.Type).
+			Ref("question_versions").
+			Field("created_by_id").
+			Unique().
+			Immutable(),
+		edge.To("options", QuestionVersionOption.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("test_session_answers", TestSessionAnswer.Type),
+	}
+}
+
+// Indexes of the QuestionVersion.
+func (QuestionVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("question_id", "version").Unique(),
+	}
+}
+
+func (QuestionVersion) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "question_id",
+		Table:  "questions",
+		Parent: &mixin.TenantParentMixin{Column: "collection_id", Table: "question_collections"},
+	})
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionVersionOption holds the schema definition for the QuestionVersionOption entity.
+// It is an immutable copy of a question option within a question version.
+type QuestionVersionOption struct {
+	ent.Schema
+}
+
+// Fields of the QuestionVersionOption.
+func (QuestionVersionOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("version_id", uuid.UUID{}).Immutable(),
+		field.UUID("option_id", uuid.UUID{}).Immutable().Comment("Question option the copy was taken from"),
+		field.Text("option_text").Immutable(),
+		field.Bool("is_correct").Immutable(),
+		field.Text("explanation").Optional().Nillable().Immutable(),
+		field.Int("order").Positive().Immutable(),
+	}
+}
+
+// Edges of the QuestionVersionOption.
+func (QuestionVersionOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("version", QuestionVersion.Type).
+			Ref("options").
+			Field("version_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (QuestionVersionOption) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "version_id",
+		Table:  "question_versions",
+		Parent: &mixin.TenantParentMixin{
+			Column: "question_id",
+			Table:  "questions",
+			Parent: &mixin.TenantParentMixin{Column: "collection_id", Table: "question_collections"},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"template/internal/ent/schema/mixin"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,25 +16,68 @@ type Test struct {
 	ent.Schema
 }
 
+const (
+	TestScoringPolicyHighest string = "highest"
+	TestScoringPolicyLatest  string = "latest"
+	TestScoringPolicyAverage string = "average"
+)
+
+const (
+	TestFeedbackReleaseImmediately string = "immediately"
+	TestFeedbackReleaseAfterClose  string = "after_close"
+	TestFeedbackReleaseNever       string = "never"
+)
+
 // Fields of the Test.
 func (Test) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty(),
 		field.UUID("course_section_id", uuid.UUID{}).Optional().Nillable(),
 		field.UUID("course_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("creator_id", uuid.UUID{}).Optional().Nillable().Comment("User who created the test, backfilled from the course or the question collections for older tests"),
 		field.Int("total_points").Default(0).NonNegative(),
 		field.Int("total_time").Optional().Nillable().Comment("Total time in minutes for the test"),
+		field.Time("open_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Sessions cannot be started before this time, stored in UTC"),
+		field.Time("close_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Sessions cannot be started after this time, stored in UTC"),
+		field.Int("max_attempts").Optional().Nillable().Positive().Comment("Maximum number of sessions a user can start for the test"),
+		field.Int("attempt_cooldown_minutes").Optional().Nillable().NonNegative().Comment("Minutes a user must wait after completing an attempt before starting another"),
+		field.Enum("scoring_policy").Values(TestScoringPolicyHighest, TestScoringPolicyLatest, TestScoringPolicyAverage).Default(TestScoringPolicyHighest).Comment("Which completed attempts count towards the final grade"),
+		field.Enum("feedback_release").Values(TestFeedbackReleaseImmediately, TestFeedbackReleaseAfterClose, TestFeedbackReleaseNever).Default(TestFeedbackReleaseImmediately).Comment("When the correct answers and explanations are shown in the results"),
 	}
 }
 
 // Edges of the Test.
 func (Test) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("tests").
+			Field("organization_id").
+			Unique(),
 		edge.From("course_section", CourseSection.Type).
 			Ref("tests").Field("course_section_id").Unique(),
 		edge.From("course", Course.Type).
 			Ref("tests").
 			Field("course_id").Unique(),
+		edge.From("creator", User.Type).
+			Ref("created_tests").
+			Field("creator_id").
+			Unique(),
+		edge.To("access_grants", AccessGrant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("group_assignments", GroupTestAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("test_sessions", TestSession.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("question_collections", QuestionCollection.Type),
@@ -45,5 +89,5 @@ func (Test) Edges() []ent.Edge {
 }
 
 func (Test) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
 }
@@ -38,5 +38,5 @@ func (TestIgnoreQuestion) Edges() []ent.Edge {
 }
 
 func (TestIgnoreQuestion) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "test_id", Table: "tests"})
 }
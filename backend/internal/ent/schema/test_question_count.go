@@ -36,5 +36,5 @@ func (TestQuestionCount) Edges() []ent.Edge {
 }
 
 func (TestQuestionCount) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "test_id", Table: "tests"})
 }
@@ -4,6 +4,7 @@ import (
 	"template/internal/ent/schema/mixin"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -29,9 +30,31 @@ func (TestSession) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}).Optional().Nillable(),
 		field.UUID("course_section_id", uuid.UUID{}).Optional().Nillable(),
 		field.UUID("test_id", uuid.UUID{}),
+		field.UUID("group_assignment_id", uuid.UUID{}).Optional().Nillable().Comment("Assignment of the test to a group the session was created for"),
 		field.Time("started_at").Optional().Nillable(),
 		field.Time("expired_at").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
+		field.Time("last_activity_at").Optional().Nillable().Comment("Last time the candidate interacted with the session"),
+		field.Time("paused_at").Optional().Nillable().Comment("Start of the running rest break, the clock is stopped while set"),
+		field.Float("time_multiplier").Optional().Nillable().Min(1).Comment("Overrides the time multiplier of the user accommodation"),
+		field.Int("extra_minutes").Optional().Nillable().NonNegative().Comment("Overrides the extra minutes of the user accommodation"),
+		field.Bool("allow_rest_breaks").Optional().Nillable().Comment("Overrides the rest breaks of the user accommodation"),
+		field.Int("max_rest_breaks").Optional().Nillable().NonNegative().Comment("Overrides the rest break count of the user accommodation"),
+		field.Int("max_rest_break_minutes").Optional().Nillable().Positive().Comment("Overrides the rest break length of the user accommodation"),
+		field.Time("open_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Overrides the open time of the test for this assignment, stored in UTC"),
+		field.Time("close_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}).
+			Comment("Overrides the close time of the test for this assignment, stored in UTC"),
 		field.Int("max_points").Default(0),
 		field.Int("points_earned").Default(0),
 		field.Enum("status").Values(TestSessionStatusPending, TestSessionStatusCompleted, TestSessionStatusInProgress, TestSessionStatusCancelled, TestSessionStatusExpired).Default(TestSessionStatusPending),
@@ -54,10 +77,18 @@ func (TestSession) Edges() []ent.Edge {
 			Field("test_id").
 			Unique().
 			Required(),
+		edge.From("group_assignment", GroupTestAssignment.Type).
+			Ref("test_sessions").
+			Field("group_assignment_id").
+			Unique(),
 		edge.To("test_session_answers", TestSessionAnswer.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("integrity_events", TestSessionIntegrityEvent.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("breaks", TestSessionBreak.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("time_extensions", TestSessionTimeExtension.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("regrades", TestSessionRegrade.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (TestSession) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "test_id", Table: "tests"})
 }
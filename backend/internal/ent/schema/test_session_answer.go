@@ -20,6 +20,7 @@ func (TestSessionAnswer) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("question_id", uuid.UUID{}),
 		field.UUID("session_id", uuid.UUID{}),
+		field.UUID("question_version_id", uuid.UUID{}).Optional().Nillable().Comment("Version of the question drawn when the session started"),
 		field.Int("points").Optional().Nillable(),
 		field.Int("order").Default(1).Positive(),
 		field.Bool("is_correct").Optional().Nillable(),
@@ -34,8 +35,11 @@ func (TestSessionAnswer) Edges() []ent.Edge {
 			Ref("test_session_answers").
 			Field("question_id").
 			Unique().
-			Required().
-			Annotations(entsql.OnDelete(entsql.Cascade)),
+			Required(),
+		edge.From("question_version", QuestionVersion.Type).
+			Ref("test_session_answers").
+			Field("question_version_id").
+			Unique(),
 		edge.From("test_session", TestSession.Type).
 			Ref("test_session_answers").
 			Field("session_id").
@@ -46,5 +50,9 @@ func (TestSessionAnswer) Edges() []ent.Edge {
 }
 
 func (TestSessionAnswer) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "session_id",
+		Table:  "test_sessions",
+		Parent: &mixin.TenantParentMixin{Column: "test_id", Table: "tests"},
+	})
 }
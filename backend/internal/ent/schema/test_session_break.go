@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionBreak holds the schema definition for the TestSessionBreak entity.
+// The clock of a session is stopped between the start and the end of a rest break.
+type TestSessionBreak struct {
+	ent.Schema
+}
+
+// Fields of the TestSessionBreak.
+func (TestSessionBreak) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("session_id", uuid.UUID{}),
+		field.Time("started_at"),
+		field.Time("ended_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the TestSessionBreak.
+func (TestSessionBreak) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("test_session", TestSession.Type).
+			Ref("breaks").
+			Field("session_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TestSessionBreak) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "session_id",
+		Table:  "test_sessions",
+		Parent: &mixin.TenantParentMixin{Column: "test_id", Table: "tests"},
+	})
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionIntegrityEvent holds the schema definition for the TestSessionIntegrityEvent entity.
+// Integrity events are reported by the candidate's client while a session is running and shown to proctors.
+type TestSessionIntegrityEvent struct {
+	ent.Schema
+}
+
+const (
+	IntegrityEventTypeTabSwitch      string = "tab_switch"
+	IntegrityEventTypeFocusLost      string = "focus_lost"
+	IntegrityEventTypeFullscreenExit string = "fullscreen_exit"
+	IntegrityEventTypeCopyPaste      string = "copy_paste"
+	IntegrityEventTypeOther          string = "other"
+)
+
+// Fields of the TestSessionIntegrityEvent.
+func (TestSessionIntegrityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("session_id", uuid.UUID{}),
+		field.Enum("event_type").Values(IntegrityEventTypeTabSwitch, IntegrityEventTypeFocusLost, IntegrityEventTypeFullscreenExit, IntegrityEventTypeCopyPaste, IntegrityEventTypeOther),
+		field.Text("details").Optional().Nillable(),
+		field.Time("occurred_at"),
+	}
+}
+
+// Edges of the TestSessionIntegrityEvent.
+func (TestSessionIntegrityEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("test_session", TestSession.Type).
+			Ref("integrity_events").
+			Field("session_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TestSessionIntegrityEvent) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "session_id",
+		Table:  "test_sessions",
+		Parent: &mixin.TenantParentMixin{Column: "test_id", Table: "tests"},
+	})
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionRegrade holds the schema definition for the TestSessionRegrade entity.
+// It records the points of a completed session before and after a regrade that changed them.
+type TestSessionRegrade struct {
+	ent.Schema
+}
+
+const (
+	TestSessionRegradeScopeQuestion string = "question"
+	TestSessionRegradeScopeTest     string = "test"
+)
+
+// Fields of the TestSessionRegrade.
+func (TestSessionRegrade) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("session_id", uuid.UUID{}),
+		field.UUID("regraded_by_id", uuid.UUID{}),
+		field.Enum("scope").Values(TestSessionRegradeScopeQuestion, TestSessionRegradeScopeTest),
+		field.UUID("question_id", uuid.UUID{}).Optional().Nillable().Comment("Regraded question when the scope is question"),
+		field.Int("points_before"),
+		field.Int("points_after"),
+	}
+}
+
+// Edges of the TestSessionRegrade.
+func (TestSessionRegrade) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("test_session", TestSession.Type).
+			Ref("regrades").
+			Field("session_id").
+			Unique().
+			Required(),
+		edge.From("regraded_by", User.Type).
+			Ref("test_session_regrades").
+			Field("regraded_by_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TestSessionRegrade) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "session_id",
+		Table:  "test_sessions",
+		Parent: &mixin.TenantParentMixin{Column: "test_id", Table: "tests"},
+	})
+}
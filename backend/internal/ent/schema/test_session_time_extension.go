@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionTimeExtension holds the schema definition for the TestSessionTimeExtension entity.
+// It is the audit record of a proctor extending the time of a session.
+type TestSessionTimeExtension struct {
+	ent.Schema
+}
+
+// Fields of the TestSessionTimeExtension.
+func (TestSessionTimeExtension) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("session_id", uuid.UUID{}),
+		field.UUID("extended_by_id", uuid.UUID{}),
+		field.Int("minutes").Positive(),
+		field.Text("reason").NotEmpty(),
+		field.Time("previous_expired_at"),
+		field.Time("new_expired_at"),
+	}
+}
+
+// Edges of the TestSessionTimeExtension.
+func (TestSessionTimeExtension) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("test_session", TestSession.Type).
+			Ref("time_extensions").
+			Field("session_id").
+			Unique().
+			Required(),
+		edge.From("extended_by", User.Type).
+			Ref("test_session_time_extensions").
+			Field("extended_by_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TestSessionTimeExtension) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "session_id",
+		Table:  "test_sessions",
+		Parent: &mixin.TenantParentMixin{Column: "test_id", Table: "tests"},
+	})
+}
@@ -25,12 +25,17 @@ func (User) Fields() []ent.Field {
 		field.String("last_name").Optional().Nillable(),
 		field.UUID("avatar_id", uuid.UUID{}).Optional().Nillable(),
 		field.Bool("is_active").Default(true),
+		field.Bool("must_change_password").Default(false).Comment("Set for the temporary passwords, cleared once the user chooses a password"),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("users").
+			Field("organization_id").
+			Unique(),
 		edge.From("media", Media.Type).
 			Ref("user_media").Field("avatar_id").Unique(),
 		edge.To("media_uploader", Media.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
@@ -39,9 +44,21 @@ func (User) Edges() []ent.Edge {
 		edge.To("question_collections", QuestionCollection.Type),
 		edge.To("test_sessions", TestSession.Type),
 		edge.To("jwt_tokens", JwtToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("course_enrollments", CourseEnrollment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("accommodation", UserAccommodation.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("test_session_time_extensions", TestSessionTimeExtension.Type),
+		edge.To("test_session_regrades", TestSessionRegrade.Type),
+		edge.To("question_versions", QuestionVersion.Type),
+		edge.To("access_grants", AccessGrant.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("granted_access_grants", AccessGrant.Type),
+		edge.To("created_tests", Test.Type),
+		edge.To("created_groups", Group.Type),
+		edge.To("member_groups", Group.Type),
+		edge.To("group_test_assignments", GroupTestAssignment.Type),
+		edge.To("invites", UserInvite.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (User) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantMixin{})
 }
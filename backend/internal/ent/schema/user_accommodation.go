@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserAccommodation holds the schema definition for the UserAccommodation entity.
+// It is applied to every test session the user starts, unless the session overrides it.
+type UserAccommodation struct {
+	ent.Schema
+}
+
+// Fields of the UserAccommodation.
+func (UserAccommodation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).Unique(),
+		field.Float("time_multiplier").Default(1).Min(1).Comment("Multiplier applied to the total time of a test"),
+		field.Int("extra_minutes").Default(0).NonNegative().Comment("Fixed minutes added to the total time of a test"),
+		field.Bool("allow_rest_breaks").Default(false),
+		field.Int("max_rest_breaks").Optional().Nillable().NonNegative().Comment("Number of rest breaks allowed per session, unlimited when empty"),
+		field.Int("max_rest_break_minutes").Optional().Nillable().Positive().Comment("Minutes of a rest break that do not count against the session time, unlimited when empty"),
+		field.Text("notes").Optional().Nillable(),
+	}
+}
+
+// Edges of the UserAccommodation.
+func (UserAccommodation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("accommodation").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (UserAccommodation) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "user_id", Table: "users"})
+}
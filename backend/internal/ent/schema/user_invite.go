@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"template/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserInvite holds the schema definition for the UserInvite entity.
+// An invite lets a provisioned user choose their password, only the hash of its token is stored.
+type UserInvite struct {
+	ent.Schema
+}
+
+// Fields of the UserInvite.
+func (UserInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("token_hash").NotEmpty().Unique().Sensitive().Comment("SHA-256 hash of the invite token"),
+		field.Time("expires_at").
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}),
+		field.Time("accepted_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamp without time zone",
+			}),
+	}
+}
+
+// Edges of the UserInvite.
+func (UserInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("invites").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (UserInvite) Mixin() []ent.Mixin {
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "user_id", Table: "users"})
+}
@@ -51,5 +51,5 @@ func (Video) Edges() []ent.Edge {
 }
 
 func (Video) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{Column: "course_id", Table: "courses"})
 }
@@ -40,5 +40,9 @@ func (VideoQuestionTimestamp) Edges() []ent.Edge {
 }
 
 func (VideoQuestionTimestamp) Mixin() []ent.Mixin {
-	return mixin.DefaultMixins()
+	return append(mixin.DefaultMixins(), mixin.TenantParentMixin{
+		Column: "video_id",
+		Table:  "videos",
+		Parent: &mixin.TenantParentMixin{Column: "course_id", Table: "courses"},
+	})
 }
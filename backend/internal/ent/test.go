@@ -7,7 +7,9 @@ import (
 	"strings"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/organization"
 	"template/internal/ent/test"
+	"template/internal/ent/user"
 	"time"
 
 	"entgo.io/ent"
@@ -26,16 +28,32 @@ type Test struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// CourseSectionID holds the value of the "course_section_id" field.
 	CourseSectionID *uuid.UUID `json:"course_section_id,omitempty"`
 	// CourseID holds the value of the "course_id" field.
 	CourseID *uuid.UUID `json:"course_id,omitempty"`
+	// User who created the test, backfilled from the course or the question collections for older tests
+	CreatorID *uuid.UUID `json:"creator_id,omitempty"`
 	// TotalPoints holds the value of the "total_points" field.
 	TotalPoints int `json:"total_points,omitempty"`
 	// Total time in minutes for the test
 	TotalTime *int `json:"total_time,omitempty"`
+	// Sessions cannot be started before this time, stored in UTC
+	OpenAt *time.Time `json:"open_at,omitempty"`
+	// Sessions cannot be started after this time, stored in UTC
+	CloseAt *time.Time `json:"close_at,omitempty"`
+	// Maximum number of sessions a user can start for the test
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// Minutes a user must wait after completing an attempt before starting another
+	AttemptCooldownMinutes *int `json:"attempt_cooldown_minutes,omitempty"`
+	// Which completed attempts count towards the final grade
+	ScoringPolicy test.ScoringPolicy `json:"scoring_policy,omitempty"`
+	// When the correct answers and explanations are shown in the results
+	FeedbackRelease test.FeedbackRelease `json:"feedback_release,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TestQuery when eager-loading is set.
 	Edges        TestEdges `json:"edges"`
@@ -44,10 +62,18 @@ type Test struct {
 
 // TestEdges holds the relations/edges for other nodes in the graph.
 type TestEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// CourseSection holds the value of the course_section edge.
 	CourseSection *CourseSection `json:"course_section,omitempty"`
 	// Course holds the value of the course edge.
 	Course *Course `json:"course,omitempty"`
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
+	// GroupAssignments holds the value of the group_assignments edge.
+	GroupAssignments []*GroupTestAssignment `json:"group_assignments,omitempty"`
 	// TestSessions holds the value of the test_sessions edge.
 	TestSessions []*TestSession `json:"test_sessions,omitempty"`
 	// QuestionCollections holds the value of the question_collections edge.
@@ -58,7 +84,18 @@ type TestEdges struct {
 	TestIgnoreQuestions []*TestIgnoreQuestion `json:"test_ignore_questions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [10]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // CourseSectionOrErr returns the CourseSection value or an error if the edge
@@ -66,7 +103,7 @@ type TestEdges struct {
 func (e TestEdges) CourseSectionOrErr() (*CourseSection, error) {
 	if e.CourseSection != nil {
 		return e.CourseSection, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: coursesection.Label}
 	}
 	return nil, &NotLoadedError{edge: "course_section"}
@@ -77,16 +114,45 @@ func (e TestEdges) CourseSectionOrErr() (*CourseSection, error) {
 func (e TestEdges) CourseOrErr() (*Course, error) {
 	if e.Course != nil {
 		return e.Course, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: course.Label}
 	}
 	return nil, &NotLoadedError{edge: "course"}
 }
 
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[4] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
+// GroupAssignmentsOrErr returns the GroupAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestEdges) GroupAssignmentsOrErr() ([]*GroupTestAssignment, error) {
+	if e.loadedTypes[5] {
+		return e.GroupAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "group_assignments"}
+}
+
 // TestSessionsOrErr returns the TestSessions value or an error if the edge
 // was not loaded in eager-loading.
 func (e TestEdges) TestSessionsOrErr() ([]*TestSession, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[6] {
 		return e.TestSessions, nil
 	}
 	return nil, &NotLoadedError{edge: "test_sessions"}
@@ -95,7 +161,7 @@ func (e TestEdges) TestSessionsOrErr() ([]*TestSession, error) {
 // QuestionCollectionsOrErr returns the QuestionCollections value or an error if the edge
 // was not loaded in eager-loading.
 func (e TestEdges) QuestionCollectionsOrErr() ([]*QuestionCollection, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[7] {
 		return e.QuestionCollections, nil
 	}
 	return nil, &NotLoadedError{edge: "question_collections"}
@@ -104,7 +170,7 @@ func (e TestEdges) QuestionCollectionsOrErr() ([]*QuestionCollection, error) {
 // TestQuestionCountsOrErr returns the TestQuestionCounts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TestEdges) TestQuestionCountsOrErr() ([]*TestQuestionCount, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[8] {
 		return e.TestQuestionCounts, nil
 	}
 	return nil, &NotLoadedError{edge: "test_question_counts"}
@@ -113,7 +179,7 @@ func (e TestEdges) TestQuestionCountsOrErr() ([]*TestQuestionCount, error) {
 // TestIgnoreQuestionsOrErr returns the TestIgnoreQuestions value or an error if the edge
 // was not loaded in eager-loading.
 func (e TestEdges) TestIgnoreQuestionsOrErr() ([]*TestIgnoreQuestion, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[9] {
 		return e.TestIgnoreQuestions, nil
 	}
 	return nil, &NotLoadedError{edge: "test_ignore_questions"}
@@ -124,13 +190,13 @@ func (*Test) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case test.FieldCourseSectionID, test.FieldCourseID:
+		case test.FieldOrganizationID, test.FieldCourseSectionID, test.FieldCourseID, test.FieldCreatorID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case test.FieldTotalPoints, test.FieldTotalTime:
+		case test.FieldTotalPoints, test.FieldTotalTime, test.FieldMaxAttempts, test.FieldAttemptCooldownMinutes:
 			values[i] = new(sql.NullInt64)
-		case test.FieldName:
+		case test.FieldName, test.FieldScoringPolicy, test.FieldFeedbackRelease:
 			values[i] = new(sql.NullString)
-		case test.FieldCreatedAt, test.FieldUpdatedAt, test.FieldDeletedAt:
+		case test.FieldCreatedAt, test.FieldUpdatedAt, test.FieldDeletedAt, test.FieldOpenAt, test.FieldCloseAt:
 			values[i] = new(sql.NullTime)
 		case test.FieldID:
 			values[i] = new(uuid.UUID)
@@ -174,6 +240,13 @@ func (t *Test) assignValues(columns []string, values []any) error {
 				t.DeletedAt = new(time.Time)
 				*t.DeletedAt = value.Time
 			}
+		case test.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				t.OrganizationID = new(uuid.UUID)
+				*t.OrganizationID = *value.S.(*uuid.UUID)
+			}
 		case test.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -194,6 +267,13 @@ func (t *Test) assignValues(columns []string, values []any) error {
 				t.CourseID = new(uuid.UUID)
 				*t.CourseID = *value.S.(*uuid.UUID)
 			}
+		case test.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				t.CreatorID = new(uuid.UUID)
+				*t.CreatorID = *value.S.(*uuid.UUID)
+			}
 		case test.FieldTotalPoints:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_points", values[i])
@@ -207,6 +287,46 @@ func (t *Test) assignValues(columns []string, values []any) error {
 				t.TotalTime = new(int)
 				*t.TotalTime = int(value.Int64)
 			}
+		case test.FieldOpenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field open_at", values[i])
+			} else if value.Valid {
+				t.OpenAt = new(time.Time)
+				*t.OpenAt = value.Time
+			}
+		case test.FieldCloseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field close_at", values[i])
+			} else if value.Valid {
+				t.CloseAt = new(time.Time)
+				*t.CloseAt = value.Time
+			}
+		case test.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				t.MaxAttempts = new(int)
+				*t.MaxAttempts = int(value.Int64)
+			}
+		case test.FieldAttemptCooldownMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_cooldown_minutes", values[i])
+			} else if value.Valid {
+				t.AttemptCooldownMinutes = new(int)
+				*t.AttemptCooldownMinutes = int(value.Int64)
+			}
+		case test.FieldScoringPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scoring_policy", values[i])
+			} else if value.Valid {
+				t.ScoringPolicy = test.ScoringPolicy(value.String)
+			}
+		case test.FieldFeedbackRelease:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feedback_release", values[i])
+			} else if value.Valid {
+				t.FeedbackRelease = test.FeedbackRelease(value.String)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -220,6 +340,11 @@ func (t *Test) Value(name string) (ent.Value, error) {
 	return t.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the Test entity.
+func (t *Test) QueryOrganization() *OrganizationQuery {
+	return NewTestClient(t.config).QueryOrganization(t)
+}
+
 // QueryCourseSection queries the "course_section" edge of the Test entity.
 func (t *Test) QueryCourseSection() *CourseSectionQuery {
 	return NewTestClient(t.config).QueryCourseSection(t)
@@ -230,6 +355,21 @@ func (t *Test) QueryCourse() *CourseQuery {
 	return NewTestClient(t.config).QueryCourse(t)
 }
 
+// QueryCreator queries the "creator" edge of the Test entity.
+func (t *Test) QueryCreator() *UserQuery {
+	return NewTestClient(t.config).QueryCreator(t)
+}
+
+// QueryAccessGrants queries the "access_grants" edge of the Test entity.
+func (t *Test) QueryAccessGrants() *AccessGrantQuery {
+	return NewTestClient(t.config).QueryAccessGrants(t)
+}
+
+// QueryGroupAssignments queries the "group_assignments" edge of the Test entity.
+func (t *Test) QueryGroupAssignments() *GroupTestAssignmentQuery {
+	return NewTestClient(t.config).QueryGroupAssignments(t)
+}
+
 // QueryTestSessions queries the "test_sessions" edge of the Test entity.
 func (t *Test) QueryTestSessions() *TestSessionQuery {
 	return NewTestClient(t.config).QueryTestSessions(t)
@@ -284,6 +424,11 @@ func (t *Test) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := t.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(t.Name)
 	builder.WriteString(", ")
@@ -297,6 +442,11 @@ func (t *Test) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := t.CreatorID; v != nil {
+		builder.WriteString("creator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("total_points=")
 	builder.WriteString(fmt.Sprintf("%v", t.TotalPoints))
 	builder.WriteString(", ")
@@ -304,6 +454,32 @@ func (t *Test) String() string {
 		builder.WriteString("total_time=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := t.OpenAt; v != nil {
+		builder.WriteString("open_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.CloseAt; v != nil {
+		builder.WriteString("close_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.MaxAttempts; v != nil {
+		builder.WriteString("max_attempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.AttemptCooldownMinutes; v != nil {
+		builder.WriteString("attempt_cooldown_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scoring_policy=")
+	builder.WriteString(fmt.Sprintf("%v", t.ScoringPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("feedback_release=")
+	builder.WriteString(fmt.Sprintf("%v", t.FeedbackRelease))
 	builder.WriteByte(')')
 	return builder.String()
 }
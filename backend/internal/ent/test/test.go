@@ -3,6 +3,7 @@
 package test
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent"
@@ -22,20 +23,44 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldCourseSectionID holds the string denoting the course_section_id field in the database.
 	FieldCourseSectionID = "course_section_id"
 	// FieldCourseID holds the string denoting the course_id field in the database.
 	FieldCourseID = "course_id"
+	// FieldCreatorID holds the string denoting the creator_id field in the database.
+	FieldCreatorID = "creator_id"
 	// FieldTotalPoints holds the string denoting the total_points field in the database.
 	FieldTotalPoints = "total_points"
 	// FieldTotalTime holds the string denoting the total_time field in the database.
 	FieldTotalTime = "total_time"
+	// FieldOpenAt holds the string denoting the open_at field in the database.
+	FieldOpenAt = "open_at"
+	// FieldCloseAt holds the string denoting the close_at field in the database.
+	FieldCloseAt = "close_at"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldAttemptCooldownMinutes holds the string denoting the attempt_cooldown_minutes field in the database.
+	FieldAttemptCooldownMinutes = "attempt_cooldown_minutes"
+	// FieldScoringPolicy holds the string denoting the scoring_policy field in the database.
+	FieldScoringPolicy = "scoring_policy"
+	// FieldFeedbackRelease holds the string denoting the feedback_release field in the database.
+	FieldFeedbackRelease = "feedback_release"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// EdgeCourseSection holds the string denoting the course_section edge name in mutations.
 	EdgeCourseSection = "course_section"
 	// EdgeCourse holds the string denoting the course edge name in mutations.
 	EdgeCourse = "course"
+	// EdgeCreator holds the string denoting the creator edge name in mutations.
+	EdgeCreator = "creator"
+	// EdgeAccessGrants holds the string denoting the access_grants edge name in mutations.
+	EdgeAccessGrants = "access_grants"
+	// EdgeGroupAssignments holds the string denoting the group_assignments edge name in mutations.
+	EdgeGroupAssignments = "group_assignments"
 	// EdgeTestSessions holds the string denoting the test_sessions edge name in mutations.
 	EdgeTestSessions = "test_sessions"
 	// EdgeQuestionCollections holds the string denoting the question_collections edge name in mutations.
@@ -46,6 +71,13 @@ const (
 	EdgeTestIgnoreQuestions = "test_ignore_questions"
 	// Table holds the table name of the test in the database.
 	Table = "tests"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "tests"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
 	// CourseSectionTable is the table that holds the course_section relation/edge.
 	CourseSectionTable = "tests"
 	// CourseSectionInverseTable is the table name for the CourseSection entity.
@@ -60,6 +92,27 @@ const (
 	CourseInverseTable = "courses"
 	// CourseColumn is the table column denoting the course relation/edge.
 	CourseColumn = "course_id"
+	// CreatorTable is the table that holds the creator relation/edge.
+	CreatorTable = "tests"
+	// CreatorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CreatorInverseTable = "users"
+	// CreatorColumn is the table column denoting the creator relation/edge.
+	CreatorColumn = "creator_id"
+	// AccessGrantsTable is the table that holds the access_grants relation/edge.
+	AccessGrantsTable = "access_grants"
+	// AccessGrantsInverseTable is the table name for the AccessGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "accessgrant" package.
+	AccessGrantsInverseTable = "access_grants"
+	// AccessGrantsColumn is the table column denoting the access_grants relation/edge.
+	AccessGrantsColumn = "test_id"
+	// GroupAssignmentsTable is the table that holds the group_assignments relation/edge.
+	GroupAssignmentsTable = "group_test_assignments"
+	// GroupAssignmentsInverseTable is the table name for the GroupTestAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "grouptestassignment" package.
+	GroupAssignmentsInverseTable = "group_test_assignments"
+	// GroupAssignmentsColumn is the table column denoting the group_assignments relation/edge.
+	GroupAssignmentsColumn = "test_id"
 	// TestSessionsTable is the table that holds the test_sessions relation/edge.
 	TestSessionsTable = "test_sessions"
 	// TestSessionsInverseTable is the table name for the TestSession entity.
@@ -94,11 +147,19 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldOrganizationID,
 	FieldName,
 	FieldCourseSectionID,
 	FieldCourseID,
+	FieldCreatorID,
 	FieldTotalPoints,
 	FieldTotalTime,
+	FieldOpenAt,
+	FieldCloseAt,
+	FieldMaxAttempts,
+	FieldAttemptCooldownMinutes,
+	FieldScoringPolicy,
+	FieldFeedbackRelease,
 }
 
 var (
@@ -123,8 +184,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [3]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -137,10 +198,68 @@ var (
 	DefaultTotalPoints int
 	// TotalPointsValidator is a validator for the "total_points" field. It is called by the builders before save.
 	TotalPointsValidator func(int) error
+	// MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	MaxAttemptsValidator func(int) error
+	// AttemptCooldownMinutesValidator is a validator for the "attempt_cooldown_minutes" field. It is called by the builders before save.
+	AttemptCooldownMinutesValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// ScoringPolicy defines the type for the "scoring_policy" enum field.
+type ScoringPolicy string
+
+// ScoringPolicyHighest is the default value of the ScoringPolicy enum.
+const DefaultScoringPolicy = ScoringPolicyHighest
+
+// ScoringPolicy values.
+const (
+	ScoringPolicyHighest ScoringPolicy = "highest"
+	ScoringPolicyLatest  ScoringPolicy = "latest"
+	ScoringPolicyAverage ScoringPolicy = "average"
+)
+
+func (sp ScoringPolicy) String() string {
+	return string(sp)
+}
+
+// ScoringPolicyValidator is a validator for the "scoring_policy" field enum values. It is called by the builders before save.
+func ScoringPolicyValidator(sp ScoringPolicy) error {
+	switch sp {
+	case ScoringPolicyHighest, ScoringPolicyLatest, ScoringPolicyAverage:
+		return nil
+	default:
+		return fmt.Errorf("test: invalid enum value for scoring_policy field: %q", sp)
+	}
+}
+
+// FeedbackRelease defines the type for the "feedback_release" enum field.
+type FeedbackRelease string
+
+// FeedbackReleaseImmediately is the default value of the FeedbackRelease enum.
+const DefaultFeedbackRelease = FeedbackReleaseImmediately
+
+// FeedbackRelease values.
+const (
+	FeedbackReleaseImmediately FeedbackRelease = "immediately"
+	FeedbackReleaseAfterClose  FeedbackRelease = "after_close"
+	FeedbackReleaseNever       FeedbackRelease = "never"
+)
+
+func (fr FeedbackRelease) String() string {
+	return string(fr)
+}
+
+// FeedbackReleaseValidator is a validator for the "feedback_release" field enum values. It is called by the builders before save.
+func FeedbackReleaseValidator(fr FeedbackRelease) error {
+	switch fr {
+	case FeedbackReleaseImmediately, FeedbackReleaseAfterClose, FeedbackReleaseNever:
+		return nil
+	default:
+		return fmt.Errorf("test: invalid enum value for feedback_release field: %q", fr)
+	}
+}
+
 // OrderOption defines the ordering options for the Test queries.
 type OrderOption func(*sql.Selector)
 
@@ -164,6 +283,11 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -179,6 +303,11 @@ func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
 }
 
+// ByCreatorID orders the results by the creator_id field.
+func ByCreatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatorID, opts...).ToFunc()
+}
+
 // ByTotalPoints orders the results by the total_points field.
 func ByTotalPoints(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalPoints, opts...).ToFunc()
@@ -189,6 +318,43 @@ func ByTotalTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalTime, opts...).ToFunc()
 }
 
+// ByOpenAt orders the results by the open_at field.
+func ByOpenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenAt, opts...).ToFunc()
+}
+
+// ByCloseAt orders the results by the close_at field.
+func ByCloseAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseAt, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByAttemptCooldownMinutes orders the results by the attempt_cooldown_minutes field.
+func ByAttemptCooldownMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptCooldownMinutes, opts...).ToFunc()
+}
+
+// ByScoringPolicy orders the results by the scoring_policy field.
+func ByScoringPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoringPolicy, opts...).ToFunc()
+}
+
+// ByFeedbackRelease orders the results by the feedback_release field.
+func ByFeedbackRelease(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedbackRelease, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByCourseSectionField orders the results by course_section field.
 func ByCourseSectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -203,6 +369,41 @@ func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByCreatorField orders the results by creator field.
+func ByCreatorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccessGrantsCount orders the results by access_grants count.
+func ByAccessGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessGrantsStep(), opts...)
+	}
+}
+
+// ByAccessGrants orders the results by access_grants terms.
+func ByAccessGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupAssignmentsCount orders the results by group_assignments count.
+func ByGroupAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupAssignmentsStep(), opts...)
+	}
+}
+
+// ByGroupAssignments orders the results by group_assignments terms.
+func ByGroupAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTestSessionsCount orders the results by test_sessions count.
 func ByTestSessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -258,6 +459,13 @@ func ByTestIgnoreQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newTestIgnoreQuestionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
 func newCourseSectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -272,6 +480,27 @@ func newCourseStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
 	)
 }
+func newCreatorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreatorTable, CreatorColumn),
+	)
+}
+func newAccessGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessGrantsTable, AccessGrantsColumn),
+	)
+}
+func newGroupAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupAssignmentsTable, GroupAssignmentsColumn),
+	)
+}
 func newTestSessionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
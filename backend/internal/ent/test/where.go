@@ -71,6 +71,11 @@ func DeletedAt(v time.Time) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldName, v))
@@ -86,6 +91,11 @@ func CourseID(v uuid.UUID) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldCourseID, v))
 }
 
+// CreatorID applies equality check predicate on the "creator_id" field. It's identical to CreatorIDEQ.
+func CreatorID(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldCreatorID, v))
+}
+
 // TotalPoints applies equality check predicate on the "total_points" field. It's identical to TotalPointsEQ.
 func TotalPoints(v int) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldTotalPoints, v))
@@ -96,6 +106,26 @@ func TotalTime(v int) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldTotalTime, v))
 }
 
+// OpenAt applies equality check predicate on the "open_at" field. It's identical to OpenAtEQ.
+func OpenAt(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// CloseAt applies equality check predicate on the "close_at" field. It's identical to CloseAtEQ.
+func CloseAt(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldCloseAt, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// AttemptCooldownMinutes applies equality check predicate on the "attempt_cooldown_minutes" field. It's identical to AttemptCooldownMinutesEQ.
+func AttemptCooldownMinutes(v int) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldAttemptCooldownMinutes, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldCreatedAt, v))
@@ -226,6 +256,36 @@ func DeletedAtNotNil() predicate.Test {
 	return predicate.Test(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldName, v))
@@ -351,6 +411,36 @@ func CourseIDNotNil() predicate.Test {
 	return predicate.Test(sql.FieldNotNull(FieldCourseID))
 }
 
+// CreatorIDEQ applies the EQ predicate on the "creator_id" field.
+func CreatorIDEQ(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// CreatorIDNEQ applies the NEQ predicate on the "creator_id" field.
+func CreatorIDNEQ(v uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldCreatorID, v))
+}
+
+// CreatorIDIn applies the In predicate on the "creator_id" field.
+func CreatorIDIn(vs ...uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDNotIn applies the NotIn predicate on the "creator_id" field.
+func CreatorIDNotIn(vs ...uuid.UUID) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDIsNil applies the IsNil predicate on the "creator_id" field.
+func CreatorIDIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldCreatorID))
+}
+
+// CreatorIDNotNil applies the NotNil predicate on the "creator_id" field.
+func CreatorIDNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldCreatorID))
+}
+
 // TotalPointsEQ applies the EQ predicate on the "total_points" field.
 func TotalPointsEQ(v int) predicate.Test {
 	return predicate.Test(sql.FieldEQ(FieldTotalPoints, v))
@@ -441,6 +531,269 @@ func TotalTimeNotNil() predicate.Test {
 	return predicate.Test(sql.FieldNotNull(FieldTotalTime))
 }
 
+// OpenAtEQ applies the EQ predicate on the "open_at" field.
+func OpenAtEQ(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// OpenAtNEQ applies the NEQ predicate on the "open_at" field.
+func OpenAtNEQ(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldOpenAt, v))
+}
+
+// OpenAtIn applies the In predicate on the "open_at" field.
+func OpenAtIn(vs ...time.Time) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldOpenAt, vs...))
+}
+
+// OpenAtNotIn applies the NotIn predicate on the "open_at" field.
+func OpenAtNotIn(vs ...time.Time) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldOpenAt, vs...))
+}
+
+// OpenAtGT applies the GT predicate on the "open_at" field.
+func OpenAtGT(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldGT(FieldOpenAt, v))
+}
+
+// OpenAtGTE applies the GTE predicate on the "open_at" field.
+func OpenAtGTE(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldGTE(FieldOpenAt, v))
+}
+
+// OpenAtLT applies the LT predicate on the "open_at" field.
+func OpenAtLT(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldLT(FieldOpenAt, v))
+}
+
+// OpenAtLTE applies the LTE predicate on the "open_at" field.
+func OpenAtLTE(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldLTE(FieldOpenAt, v))
+}
+
+// OpenAtIsNil applies the IsNil predicate on the "open_at" field.
+func OpenAtIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldOpenAt))
+}
+
+// OpenAtNotNil applies the NotNil predicate on the "open_at" field.
+func OpenAtNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldOpenAt))
+}
+
+// CloseAtEQ applies the EQ predicate on the "close_at" field.
+func CloseAtEQ(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldCloseAt, v))
+}
+
+// CloseAtNEQ applies the NEQ predicate on the "close_at" field.
+func CloseAtNEQ(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldCloseAt, v))
+}
+
+// CloseAtIn applies the In predicate on the "close_at" field.
+func CloseAtIn(vs ...time.Time) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldCloseAt, vs...))
+}
+
+// CloseAtNotIn applies the NotIn predicate on the "close_at" field.
+func CloseAtNotIn(vs ...time.Time) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldCloseAt, vs...))
+}
+
+// CloseAtGT applies the GT predicate on the "close_at" field.
+func CloseAtGT(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldGT(FieldCloseAt, v))
+}
+
+// CloseAtGTE applies the GTE predicate on the "close_at" field.
+func CloseAtGTE(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldGTE(FieldCloseAt, v))
+}
+
+// CloseAtLT applies the LT predicate on the "close_at" field.
+func CloseAtLT(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldLT(FieldCloseAt, v))
+}
+
+// CloseAtLTE applies the LTE predicate on the "close_at" field.
+func CloseAtLTE(v time.Time) predicate.Test {
+	return predicate.Test(sql.FieldLTE(FieldCloseAt, v))
+}
+
+// CloseAtIsNil applies the IsNil predicate on the "close_at" field.
+func CloseAtIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldCloseAt))
+}
+
+// CloseAtNotNil applies the NotNil predicate on the "close_at" field.
+func CloseAtNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldCloseAt))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.Test {
+	return predicate.Test(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.Test {
+	return predicate.Test(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.Test {
+	return predicate.Test(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.Test {
+	return predicate.Test(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIsNil applies the IsNil predicate on the "max_attempts" field.
+func MaxAttemptsIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldMaxAttempts))
+}
+
+// MaxAttemptsNotNil applies the NotNil predicate on the "max_attempts" field.
+func MaxAttemptsNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldMaxAttempts))
+}
+
+// AttemptCooldownMinutesEQ applies the EQ predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesEQ(v int) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesNEQ applies the NEQ predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesNEQ(v int) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesIn applies the In predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesIn(vs ...int) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldAttemptCooldownMinutes, vs...))
+}
+
+// AttemptCooldownMinutesNotIn applies the NotIn predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesNotIn(vs ...int) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldAttemptCooldownMinutes, vs...))
+}
+
+// AttemptCooldownMinutesGT applies the GT predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesGT(v int) predicate.Test {
+	return predicate.Test(sql.FieldGT(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesGTE applies the GTE predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesGTE(v int) predicate.Test {
+	return predicate.Test(sql.FieldGTE(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesLT applies the LT predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesLT(v int) predicate.Test {
+	return predicate.Test(sql.FieldLT(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesLTE applies the LTE predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesLTE(v int) predicate.Test {
+	return predicate.Test(sql.FieldLTE(FieldAttemptCooldownMinutes, v))
+}
+
+// AttemptCooldownMinutesIsNil applies the IsNil predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesIsNil() predicate.Test {
+	return predicate.Test(sql.FieldIsNull(FieldAttemptCooldownMinutes))
+}
+
+// AttemptCooldownMinutesNotNil applies the NotNil predicate on the "attempt_cooldown_minutes" field.
+func AttemptCooldownMinutesNotNil() predicate.Test {
+	return predicate.Test(sql.FieldNotNull(FieldAttemptCooldownMinutes))
+}
+
+// ScoringPolicyEQ applies the EQ predicate on the "scoring_policy" field.
+func ScoringPolicyEQ(v ScoringPolicy) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldScoringPolicy, v))
+}
+
+// ScoringPolicyNEQ applies the NEQ predicate on the "scoring_policy" field.
+func ScoringPolicyNEQ(v ScoringPolicy) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldScoringPolicy, v))
+}
+
+// ScoringPolicyIn applies the In predicate on the "scoring_policy" field.
+func ScoringPolicyIn(vs ...ScoringPolicy) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldScoringPolicy, vs...))
+}
+
+// ScoringPolicyNotIn applies the NotIn predicate on the "scoring_policy" field.
+func ScoringPolicyNotIn(vs ...ScoringPolicy) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldScoringPolicy, vs...))
+}
+
+// FeedbackReleaseEQ applies the EQ predicate on the "feedback_release" field.
+func FeedbackReleaseEQ(v FeedbackRelease) predicate.Test {
+	return predicate.Test(sql.FieldEQ(FieldFeedbackRelease, v))
+}
+
+// FeedbackReleaseNEQ applies the NEQ predicate on the "feedback_release" field.
+func FeedbackReleaseNEQ(v FeedbackRelease) predicate.Test {
+	return predicate.Test(sql.FieldNEQ(FieldFeedbackRelease, v))
+}
+
+// FeedbackReleaseIn applies the In predicate on the "feedback_release" field.
+func FeedbackReleaseIn(vs ...FeedbackRelease) predicate.Test {
+	return predicate.Test(sql.FieldIn(FieldFeedbackRelease, vs...))
+}
+
+// FeedbackReleaseNotIn applies the NotIn predicate on the "feedback_release" field.
+func FeedbackReleaseNotIn(vs ...FeedbackRelease) predicate.Test {
+	return predicate.Test(sql.FieldNotIn(FieldFeedbackRelease, vs...))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCourseSection applies the HasEdge predicate on the "course_section" edge.
 func HasCourseSection() predicate.Test {
 	return predicate.Test(func(s *sql.Selector) {
@@ -487,6 +840,75 @@ func HasCourseWith(preds ...predicate.Course) predicate.Test {
 	})
 }
 
+// HasCreator applies the HasEdge predicate on the "creator" edge.
+func HasCreator() predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatorTable, CreatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
+func HasCreatorWith(preds ...predicate.User) predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := newCreatorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessGrants applies the HasEdge predicate on the "access_grants" edge.
+func HasAccessGrants() predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessGrantsTable, AccessGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessGrantsWith applies the HasEdge predicate on the "access_grants" edge with a given conditions (other predicates).
+func HasAccessGrantsWith(preds ...predicate.AccessGrant) predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := newAccessGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupAssignments applies the HasEdge predicate on the "group_assignments" edge.
+func HasGroupAssignments() predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GroupAssignmentsTable, GroupAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupAssignmentsWith applies the HasEdge predicate on the "group_assignments" edge with a given conditions (other predicates).
+func HasGroupAssignmentsWith(preds ...predicate.GroupTestAssignment) predicate.Test {
+	return predicate.Test(func(s *sql.Selector) {
+		step := newGroupAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTestSessions applies the HasEdge predicate on the "test_sessions" edge.
 func HasTestSessions() predicate.Test {
 	return predicate.Test(func(s *sql.Selector) {
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/organization"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
+	"template/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,6 +73,20 @@ func (tc *TestCreate) SetNillableDeletedAt(t *time.Time) *TestCreate {
 	return tc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (tc *TestCreate) SetOrganizationID(u uuid.UUID) *TestCreate {
+	tc.mutation.SetOrganizationID(u)
+	return tc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (tc *TestCreate) SetNillableOrganizationID(u *uuid.UUID) *TestCreate {
+	if u != nil {
+		tc.SetOrganizationID(*u)
+	}
+	return tc
+}
+
 // SetName sets the "name" field.
 func (tc *TestCreate) SetName(s string) *TestCreate {
 	tc.mutation.SetName(s)
@@ -103,6 +121,20 @@ func (tc *TestCreate) SetNillableCourseID(u *uuid.UUID) *TestCreate {
 	return tc
 }
 
+// SetCreatorID sets the "creator_id" field.
+func (tc *TestCreate) SetCreatorID(u uuid.UUID) *TestCreate {
+	tc.mutation.SetCreatorID(u)
+	return tc
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tc *TestCreate) SetNillableCreatorID(u *uuid.UUID) *TestCreate {
+	if u != nil {
+		tc.SetCreatorID(*u)
+	}
+	return tc
+}
+
 // SetTotalPoints sets the "total_points" field.
 func (tc *TestCreate) SetTotalPoints(i int) *TestCreate {
 	tc.mutation.SetTotalPoints(i)
@@ -131,6 +163,90 @@ func (tc *TestCreate) SetNillableTotalTime(i *int) *TestCreate {
 	return tc
 }
 
+// SetOpenAt sets the "open_at" field.
+func (tc *TestCreate) SetOpenAt(t time.Time) *TestCreate {
+	tc.mutation.SetOpenAt(t)
+	return tc
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tc *TestCreate) SetNillableOpenAt(t *time.Time) *TestCreate {
+	if t != nil {
+		tc.SetOpenAt(*t)
+	}
+	return tc
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tc *TestCreate) SetCloseAt(t time.Time) *TestCreate {
+	tc.mutation.SetCloseAt(t)
+	return tc
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tc *TestCreate) SetNillableCloseAt(t *time.Time) *TestCreate {
+	if t != nil {
+		tc.SetCloseAt(*t)
+	}
+	return tc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (tc *TestCreate) SetMaxAttempts(i int) *TestCreate {
+	tc.mutation.SetMaxAttempts(i)
+	return tc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (tc *TestCreate) SetNillableMaxAttempts(i *int) *TestCreate {
+	if i != nil {
+		tc.SetMaxAttempts(*i)
+	}
+	return tc
+}
+
+// SetAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field.
+func (tc *TestCreate) SetAttemptCooldownMinutes(i int) *TestCreate {
+	tc.mutation.SetAttemptCooldownMinutes(i)
+	return tc
+}
+
+// SetNillableAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field if the given value is not nil.
+func (tc *TestCreate) SetNillableAttemptCooldownMinutes(i *int) *TestCreate {
+	if i != nil {
+		tc.SetAttemptCooldownMinutes(*i)
+	}
+	return tc
+}
+
+// SetScoringPolicy sets the "scoring_policy" field.
+func (tc *TestCreate) SetScoringPolicy(tp test.ScoringPolicy) *TestCreate {
+	tc.mutation.SetScoringPolicy(tp)
+	return tc
+}
+
+// SetNillableScoringPolicy sets the "scoring_policy" field if the given value is not nil.
+func (tc *TestCreate) SetNillableScoringPolicy(tp *test.ScoringPolicy) *TestCreate {
+	if tp != nil {
+		tc.SetScoringPolicy(*tp)
+	}
+	return tc
+}
+
+// SetFeedbackRelease sets the "feedback_release" field.
+func (tc *TestCreate) SetFeedbackRelease(tr test.FeedbackRelease) *TestCreate {
+	tc.mutation.SetFeedbackRelease(tr)
+	return tc
+}
+
+// SetNillableFeedbackRelease sets the "feedback_release" field if the given value is not nil.
+func (tc *TestCreate) SetNillableFeedbackRelease(tr *test.FeedbackRelease) *TestCreate {
+	if tr != nil {
+		tc.SetFeedbackRelease(*tr)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TestCreate) SetID(u uuid.UUID) *TestCreate {
 	tc.mutation.SetID(u)
@@ -145,6 +261,11 @@ func (tc *TestCreate) SetNillableID(u *uuid.UUID) *TestCreate {
 	return tc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (tc *TestCreate) SetOrganization(o *Organization) *TestCreate {
+	return tc.SetOrganizationID(o.ID)
+}
+
 // SetCourseSection sets the "course_section" edge to the CourseSection entity.
 func (tc *TestCreate) SetCourseSection(c *CourseSection) *TestCreate {
 	return tc.SetCourseSectionID(c.ID)
@@ -155,6 +276,41 @@ func (tc *TestCreate) SetCourse(c *Course) *TestCreate {
 	return tc.SetCourseID(c.ID)
 }
 
+// SetCreator sets the "creator" edge to the User entity.
+func (tc *TestCreate) SetCreator(u *User) *TestCreate {
+	return tc.SetCreatorID(u.ID)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (tc *TestCreate) AddAccessGrantIDs(ids ...uuid.UUID) *TestCreate {
+	tc.mutation.AddAccessGrantIDs(ids...)
+	return tc
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (tc *TestCreate) AddAccessGrants(a ...*AccessGrant) *TestCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tc.AddAccessGrantIDs(ids...)
+}
+
+// AddGroupAssignmentIDs adds the "group_assignments" edge to the GroupTestAssignment entity by IDs.
+func (tc *TestCreate) AddGroupAssignmentIDs(ids ...uuid.UUID) *TestCreate {
+	tc.mutation.AddGroupAssignmentIDs(ids...)
+	return tc
+}
+
+// AddGroupAssignments adds the "group_assignments" edges to the GroupTestAssignment entity.
+func (tc *TestCreate) AddGroupAssignments(g ...*GroupTestAssignment) *TestCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tc.AddGroupAssignmentIDs(ids...)
+}
+
 // AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
 func (tc *TestCreate) AddTestSessionIDs(ids ...uuid.UUID) *TestCreate {
 	tc.mutation.AddTestSessionIDs(ids...)
@@ -270,6 +426,14 @@ func (tc *TestCreate) defaults() error {
 		v := test.DefaultTotalPoints
 		tc.mutation.SetTotalPoints(v)
 	}
+	if _, ok := tc.mutation.ScoringPolicy(); !ok {
+		v := test.DefaultScoringPolicy
+		tc.mutation.SetScoringPolicy(v)
+	}
+	if _, ok := tc.mutation.FeedbackRelease(); !ok {
+		v := test.DefaultFeedbackRelease
+		tc.mutation.SetFeedbackRelease(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		if test.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized test.DefaultID (forgotten import ent/runtime?)")
@@ -304,6 +468,32 @@ func (tc *TestCreate) check() error {
 			return &ValidationError{Name: "total_points", err: fmt.Errorf(`ent: validator failed for field "Test.total_points": %w`, err)}
 		}
 	}
+	if v, ok := tc.mutation.MaxAttempts(); ok {
+		if err := test.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Test.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.AttemptCooldownMinutes(); ok {
+		if err := test.AttemptCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_cooldown_minutes", err: fmt.Errorf(`ent: validator failed for field "Test.attempt_cooldown_minutes": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.ScoringPolicy(); !ok {
+		return &ValidationError{Name: "scoring_policy", err: errors.New(`ent: missing required field "Test.scoring_policy"`)}
+	}
+	if v, ok := tc.mutation.ScoringPolicy(); ok {
+		if err := test.ScoringPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "scoring_policy", err: fmt.Errorf(`ent: validator failed for field "Test.scoring_policy": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.FeedbackRelease(); !ok {
+		return &ValidationError{Name: "feedback_release", err: errors.New(`ent: missing required field "Test.feedback_release"`)}
+	}
+	if v, ok := tc.mutation.FeedbackRelease(); ok {
+		if err := test.FeedbackReleaseValidator(v); err != nil {
+			return &ValidationError{Name: "feedback_release", err: fmt.Errorf(`ent: validator failed for field "Test.feedback_release": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -363,6 +553,47 @@ func (tc *TestCreate) createSpec() (*Test, *sqlgraph.CreateSpec) {
 		_spec.SetField(test.FieldTotalTime, field.TypeInt, value)
 		_node.TotalTime = &value
 	}
+	if value, ok := tc.mutation.OpenAt(); ok {
+		_spec.SetField(test.FieldOpenAt, field.TypeTime, value)
+		_node.OpenAt = &value
+	}
+	if value, ok := tc.mutation.CloseAt(); ok {
+		_spec.SetField(test.FieldCloseAt, field.TypeTime, value)
+		_node.CloseAt = &value
+	}
+	if value, ok := tc.mutation.MaxAttempts(); ok {
+		_spec.SetField(test.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = &value
+	}
+	if value, ok := tc.mutation.AttemptCooldownMinutes(); ok {
+		_spec.SetField(test.FieldAttemptCooldownMinutes, field.TypeInt, value)
+		_node.AttemptCooldownMinutes = &value
+	}
+	if value, ok := tc.mutation.ScoringPolicy(); ok {
+		_spec.SetField(test.FieldScoringPolicy, field.TypeEnum, value)
+		_node.ScoringPolicy = value
+	}
+	if value, ok := tc.mutation.FeedbackRelease(); ok {
+		_spec.SetField(test.FieldFeedbackRelease, field.TypeEnum, value)
+		_node.FeedbackRelease = value
+	}
+	if nodes := tc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.OrganizationTable,
+			Columns: []string{test.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.CourseSectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -397,6 +628,55 @@ func (tc *TestCreate) createSpec() (*Test, *sqlgraph.CreateSpec) {
 		_node.CourseID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.CreatorTable,
+			Columns: []string{test.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.GroupAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.TestSessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -7,16 +7,21 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
+	"template/internal/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -30,12 +35,17 @@ type TestQuery struct {
 	order                   []test.OrderOption
 	inters                  []Interceptor
 	predicates              []predicate.Test
+	withOrganization        *OrganizationQuery
 	withCourseSection       *CourseSectionQuery
 	withCourse              *CourseQuery
+	withCreator             *UserQuery
+	withAccessGrants        *AccessGrantQuery
+	withGroupAssignments    *GroupTestAssignmentQuery
 	withTestSessions        *TestSessionQuery
 	withQuestionCollections *QuestionCollectionQuery
 	withTestQuestionCounts  *TestQuestionCountQuery
 	withTestIgnoreQuestions *TestIgnoreQuestionQuery
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -72,6 +82,28 @@ func (tq *TestQuery) Order(o ...test.OrderOption) *TestQuery {
 	return tq
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (tq *TestQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.OrganizationTable, test.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCourseSection chains the current query on the "course_section" edge.
 func (tq *TestQuery) QueryCourseSection() *CourseSectionQuery {
 	query := (&CourseSectionClient{config: tq.config}).Query()
@@ -116,6 +148,72 @@ func (tq *TestQuery) QueryCourse() *CourseQuery {
 	return query
 }
 
+// QueryCreator chains the current query on the "creator" edge.
+func (tq *TestQuery) QueryCreator() *UserQuery {
+	query := (&UserClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, test.CreatorTable, test.CreatorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessGrants chains the current query on the "access_grants" edge.
+func (tq *TestQuery) QueryAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, test.AccessGrantsTable, test.AccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupAssignments chains the current query on the "group_assignments" edge.
+func (tq *TestQuery) QueryGroupAssignments() *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(test.Table, test.FieldID, selector),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, test.GroupAssignmentsTable, test.GroupAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTestSessions chains the current query on the "test_sessions" edge.
 func (tq *TestQuery) QueryTestSessions() *TestSessionQuery {
 	query := (&TestSessionClient{config: tq.config}).Query()
@@ -396,8 +494,12 @@ func (tq *TestQuery) Clone() *TestQuery {
 		order:                   append([]test.OrderOption{}, tq.order...),
 		inters:                  append([]Interceptor{}, tq.inters...),
 		predicates:              append([]predicate.Test{}, tq.predicates...),
+		withOrganization:        tq.withOrganization.Clone(),
 		withCourseSection:       tq.withCourseSection.Clone(),
 		withCourse:              tq.withCourse.Clone(),
+		withCreator:             tq.withCreator.Clone(),
+		withAccessGrants:        tq.withAccessGrants.Clone(),
+		withGroupAssignments:    tq.withGroupAssignments.Clone(),
 		withTestSessions:        tq.withTestSessions.Clone(),
 		withQuestionCollections: tq.withQuestionCollections.Clone(),
 		withTestQuestionCounts:  tq.withTestQuestionCounts.Clone(),
@@ -408,6 +510,17 @@ func (tq *TestQuery) Clone() *TestQuery {
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TestQuery) WithOrganization(opts ...func(*OrganizationQuery)) *TestQuery {
+	query := (&OrganizationClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withOrganization = query
+	return tq
+}
+
 // WithCourseSection tells the query-builder to eager-load the nodes that are connected to
 // the "course_section" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TestQuery) WithCourseSection(opts ...func(*CourseSectionQuery)) *TestQuery {
@@ -430,6 +543,39 @@ func (tq *TestQuery) WithCourse(opts ...func(*CourseQuery)) *TestQuery {
 	return tq
 }
 
+// WithCreator tells the query-builder to eager-load the nodes that are connected to
+// the "creator" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TestQuery) WithCreator(opts ...func(*UserQuery)) *TestQuery {
+	query := (&UserClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withCreator = query
+	return tq
+}
+
+// WithAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TestQuery) WithAccessGrants(opts ...func(*AccessGrantQuery)) *TestQuery {
+	query := (&AccessGrantClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withAccessGrants = query
+	return tq
+}
+
+// WithGroupAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "group_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TestQuery) WithGroupAssignments(opts ...func(*GroupTestAssignmentQuery)) *TestQuery {
+	query := (&GroupTestAssignmentClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withGroupAssignments = query
+	return tq
+}
+
 // WithTestSessions tells the query-builder to eager-load the nodes that are connected to
 // the "test_sessions" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TestQuery) WithTestSessions(opts ...func(*TestSessionQuery)) *TestQuery {
@@ -552,9 +698,13 @@ func (tq *TestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Test, e
 	var (
 		nodes       = []*Test{}
 		_spec       = tq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [10]bool{
+			tq.withOrganization != nil,
 			tq.withCourseSection != nil,
 			tq.withCourse != nil,
+			tq.withCreator != nil,
+			tq.withAccessGrants != nil,
+			tq.withGroupAssignments != nil,
 			tq.withTestSessions != nil,
 			tq.withQuestionCollections != nil,
 			tq.withTestQuestionCounts != nil,
@@ -570,6 +720,9 @@ func (tq *TestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Test, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -579,6 +732,12 @@ func (tq *TestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Test, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := tq.withOrganization; query != nil {
+		if err := tq.loadOrganization(ctx, query, nodes, nil,
+			func(n *Test, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withCourseSection; query != nil {
 		if err := tq.loadCourseSection(ctx, query, nodes, nil,
 			func(n *Test, e *CourseSection) { n.Edges.CourseSection = e }); err != nil {
@@ -591,6 +750,26 @@ func (tq *TestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Test, e
 			return nil, err
 		}
 	}
+	if query := tq.withCreator; query != nil {
+		if err := tq.loadCreator(ctx, query, nodes, nil,
+			func(n *Test, e *User) { n.Edges.Creator = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withAccessGrants; query != nil {
+		if err := tq.loadAccessGrants(ctx, query, nodes,
+			func(n *Test) { n.Edges.AccessGrants = []*AccessGrant{} },
+			func(n *Test, e *AccessGrant) { n.Edges.AccessGrants = append(n.Edges.AccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withGroupAssignments; query != nil {
+		if err := tq.loadGroupAssignments(ctx, query, nodes,
+			func(n *Test) { n.Edges.GroupAssignments = []*GroupTestAssignment{} },
+			func(n *Test, e *GroupTestAssignment) { n.Edges.GroupAssignments = append(n.Edges.GroupAssignments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withTestSessions; query != nil {
 		if err := tq.loadTestSessions(ctx, query, nodes,
 			func(n *Test) { n.Edges.TestSessions = []*TestSession{} },
@@ -628,6 +807,38 @@ func (tq *TestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Test, e
 	return nodes, nil
 }
 
+func (tq *TestQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Test, init func(*Test), assign func(*Test, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Test)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (tq *TestQuery) loadCourseSection(ctx context.Context, query *CourseSectionQuery, nodes []*Test, init func(*Test), assign func(*Test, *CourseSection)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Test)
@@ -692,6 +903,101 @@ func (tq *TestQuery) loadCourse(ctx context.Context, query *CourseQuery, nodes [
 	}
 	return nil
 }
+func (tq *TestQuery) loadCreator(ctx context.Context, query *UserQuery, nodes []*Test, init func(*Test), assign func(*Test, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Test)
+	for i := range nodes {
+		if nodes[i].CreatorID == nil {
+			continue
+		}
+		fk := *nodes[i].CreatorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "creator_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TestQuery) loadAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*Test, init func(*Test), assign func(*Test, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Test)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldTestID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(test.AccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TestID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "test_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "test_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TestQuery) loadGroupAssignments(ctx context.Context, query *GroupTestAssignmentQuery, nodes []*Test, init func(*Test), assign func(*Test, *GroupTestAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Test)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(grouptestassignment.FieldTestID)
+	}
+	query.Where(predicate.GroupTestAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(test.GroupAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TestID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "test_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TestQuery) loadTestSessions(ctx context.Context, query *TestSessionQuery, nodes []*Test, init func(*Test), assign func(*Test, *TestSession)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Test)
@@ -846,6 +1152,9 @@ func (tq *TestQuery) loadTestIgnoreQuestions(ctx context.Context, query *TestIgn
 
 func (tq *TestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	_spec.Node.Columns = tq.ctx.Fields
 	if len(tq.ctx.Fields) > 0 {
 		_spec.Unique = tq.ctx.Unique != nil && *tq.ctx.Unique
@@ -869,12 +1178,18 @@ func (tq *TestQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if tq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(test.FieldOrganizationID)
+		}
 		if tq.withCourseSection != nil {
 			_spec.Node.AddColumnOnce(test.FieldCourseSectionID)
 		}
 		if tq.withCourse != nil {
 			_spec.Node.AddColumnOnce(test.FieldCourseID)
 		}
+		if tq.withCreator != nil {
+			_spec.Node.AddColumnOnce(test.FieldCreatorID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -914,6 +1229,9 @@ func (tq *TestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tq.ctx.Unique != nil && *tq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	for _, p := range tq.predicates {
 		p(selector)
 	}
@@ -931,6 +1249,32 @@ func (tq *TestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TestQuery) ForUpdate(opts ...sql.LockOption) *TestQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TestQuery) ForShare(opts ...sql.LockOption) *TestQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TestGroupBy is the group-by builder for Test entities.
 type TestGroupBy struct {
 	selector
@@ -6,14 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testquestioncount"
 	"template/internal/ent/testsession"
+	"template/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -75,6 +79,26 @@ func (tu *TestUpdate) ClearDeletedAt() *TestUpdate {
 	return tu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (tu *TestUpdate) SetOrganizationID(u uuid.UUID) *TestUpdate {
+	tu.mutation.SetOrganizationID(u)
+	return tu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableOrganizationID(u *uuid.UUID) *TestUpdate {
+	if u != nil {
+		tu.SetOrganizationID(*u)
+	}
+	return tu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (tu *TestUpdate) ClearOrganizationID() *TestUpdate {
+	tu.mutation.ClearOrganizationID()
+	return tu
+}
+
 // SetName sets the "name" field.
 func (tu *TestUpdate) SetName(s string) *TestUpdate {
 	tu.mutation.SetName(s)
@@ -129,6 +153,26 @@ func (tu *TestUpdate) ClearCourseID() *TestUpdate {
 	return tu
 }
 
+// SetCreatorID sets the "creator_id" field.
+func (tu *TestUpdate) SetCreatorID(u uuid.UUID) *TestUpdate {
+	tu.mutation.SetCreatorID(u)
+	return tu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableCreatorID(u *uuid.UUID) *TestUpdate {
+	if u != nil {
+		tu.SetCreatorID(*u)
+	}
+	return tu
+}
+
+// ClearCreatorID clears the value of the "creator_id" field.
+func (tu *TestUpdate) ClearCreatorID() *TestUpdate {
+	tu.mutation.ClearCreatorID()
+	return tu
+}
+
 // SetTotalPoints sets the "total_points" field.
 func (tu *TestUpdate) SetTotalPoints(i int) *TestUpdate {
 	tu.mutation.ResetTotalPoints()
@@ -177,6 +221,133 @@ func (tu *TestUpdate) ClearTotalTime() *TestUpdate {
 	return tu
 }
 
+// SetOpenAt sets the "open_at" field.
+func (tu *TestUpdate) SetOpenAt(t time.Time) *TestUpdate {
+	tu.mutation.SetOpenAt(t)
+	return tu
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableOpenAt(t *time.Time) *TestUpdate {
+	if t != nil {
+		tu.SetOpenAt(*t)
+	}
+	return tu
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (tu *TestUpdate) ClearOpenAt() *TestUpdate {
+	tu.mutation.ClearOpenAt()
+	return tu
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tu *TestUpdate) SetCloseAt(t time.Time) *TestUpdate {
+	tu.mutation.SetCloseAt(t)
+	return tu
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableCloseAt(t *time.Time) *TestUpdate {
+	if t != nil {
+		tu.SetCloseAt(*t)
+	}
+	return tu
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (tu *TestUpdate) ClearCloseAt() *TestUpdate {
+	tu.mutation.ClearCloseAt()
+	return tu
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (tu *TestUpdate) SetMaxAttempts(i int) *TestUpdate {
+	tu.mutation.ResetMaxAttempts()
+	tu.mutation.SetMaxAttempts(i)
+	return tu
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableMaxAttempts(i *int) *TestUpdate {
+	if i != nil {
+		tu.SetMaxAttempts(*i)
+	}
+	return tu
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (tu *TestUpdate) AddMaxAttempts(i int) *TestUpdate {
+	tu.mutation.AddMaxAttempts(i)
+	return tu
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (tu *TestUpdate) ClearMaxAttempts() *TestUpdate {
+	tu.mutation.ClearMaxAttempts()
+	return tu
+}
+
+// SetAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field.
+func (tu *TestUpdate) SetAttemptCooldownMinutes(i int) *TestUpdate {
+	tu.mutation.ResetAttemptCooldownMinutes()
+	tu.mutation.SetAttemptCooldownMinutes(i)
+	return tu
+}
+
+// SetNillableAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableAttemptCooldownMinutes(i *int) *TestUpdate {
+	if i != nil {
+		tu.SetAttemptCooldownMinutes(*i)
+	}
+	return tu
+}
+
+// AddAttemptCooldownMinutes adds i to the "attempt_cooldown_minutes" field.
+func (tu *TestUpdate) AddAttemptCooldownMinutes(i int) *TestUpdate {
+	tu.mutation.AddAttemptCooldownMinutes(i)
+	return tu
+}
+
+// ClearAttemptCooldownMinutes clears the value of the "attempt_cooldown_minutes" field.
+func (tu *TestUpdate) ClearAttemptCooldownMinutes() *TestUpdate {
+	tu.mutation.ClearAttemptCooldownMinutes()
+	return tu
+}
+
+// SetScoringPolicy sets the "scoring_policy" field.
+func (tu *TestUpdate) SetScoringPolicy(tp test.ScoringPolicy) *TestUpdate {
+	tu.mutation.SetScoringPolicy(tp)
+	return tu
+}
+
+// SetNillableScoringPolicy sets the "scoring_policy" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableScoringPolicy(tp *test.ScoringPolicy) *TestUpdate {
+	if tp != nil {
+		tu.SetScoringPolicy(*tp)
+	}
+	return tu
+}
+
+// SetFeedbackRelease sets the "feedback_release" field.
+func (tu *TestUpdate) SetFeedbackRelease(tr test.FeedbackRelease) *TestUpdate {
+	tu.mutation.SetFeedbackRelease(tr)
+	return tu
+}
+
+// SetNillableFeedbackRelease sets the "feedback_release" field if the given value is not nil.
+func (tu *TestUpdate) SetNillableFeedbackRelease(tr *test.FeedbackRelease) *TestUpdate {
+	if tr != nil {
+		tu.SetFeedbackRelease(*tr)
+	}
+	return tu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (tu *TestUpdate) SetOrganization(o *Organization) *TestUpdate {
+	return tu.SetOrganizationID(o.ID)
+}
+
 // SetCourseSection sets the "course_section" edge to the CourseSection entity.
 func (tu *TestUpdate) SetCourseSection(c *CourseSection) *TestUpdate {
 	return tu.SetCourseSectionID(c.ID)
@@ -187,6 +358,41 @@ func (tu *TestUpdate) SetCourse(c *Course) *TestUpdate {
 	return tu.SetCourseID(c.ID)
 }
 
+// SetCreator sets the "creator" edge to the User entity.
+func (tu *TestUpdate) SetCreator(u *User) *TestUpdate {
+	return tu.SetCreatorID(u.ID)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (tu *TestUpdate) AddAccessGrantIDs(ids ...uuid.UUID) *TestUpdate {
+	tu.mutation.AddAccessGrantIDs(ids...)
+	return tu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (tu *TestUpdate) AddAccessGrants(a ...*AccessGrant) *TestUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddAccessGrantIDs(ids...)
+}
+
+// AddGroupAssignmentIDs adds the "group_assignments" edge to the GroupTestAssignment entity by IDs.
+func (tu *TestUpdate) AddGroupAssignmentIDs(ids ...uuid.UUID) *TestUpdate {
+	tu.mutation.AddGroupAssignmentIDs(ids...)
+	return tu
+}
+
+// AddGroupAssignments adds the "group_assignments" edges to the GroupTestAssignment entity.
+func (tu *TestUpdate) AddGroupAssignments(g ...*GroupTestAssignment) *TestUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.AddGroupAssignmentIDs(ids...)
+}
+
 // AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
 func (tu *TestUpdate) AddTestSessionIDs(ids ...uuid.UUID) *TestUpdate {
 	tu.mutation.AddTestSessionIDs(ids...)
@@ -252,6 +458,12 @@ func (tu *TestUpdate) Mutation() *TestMutation {
 	return tu.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (tu *TestUpdate) ClearOrganization() *TestUpdate {
+	tu.mutation.ClearOrganization()
+	return tu
+}
+
 // ClearCourseSection clears the "course_section" edge to the CourseSection entity.
 func (tu *TestUpdate) ClearCourseSection() *TestUpdate {
 	tu.mutation.ClearCourseSection()
@@ -264,6 +476,54 @@ func (tu *TestUpdate) ClearCourse() *TestUpdate {
 	return tu
 }
 
+// ClearCreator clears the "creator" edge to the User entity.
+func (tu *TestUpdate) ClearCreator() *TestUpdate {
+	tu.mutation.ClearCreator()
+	return tu
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (tu *TestUpdate) ClearAccessGrants() *TestUpdate {
+	tu.mutation.ClearAccessGrants()
+	return tu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (tu *TestUpdate) RemoveAccessGrantIDs(ids ...uuid.UUID) *TestUpdate {
+	tu.mutation.RemoveAccessGrantIDs(ids...)
+	return tu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (tu *TestUpdate) RemoveAccessGrants(a ...*AccessGrant) *TestUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearGroupAssignments clears all "group_assignments" edges to the GroupTestAssignment entity.
+func (tu *TestUpdate) ClearGroupAssignments() *TestUpdate {
+	tu.mutation.ClearGroupAssignments()
+	return tu
+}
+
+// RemoveGroupAssignmentIDs removes the "group_assignments" edge to GroupTestAssignment entities by IDs.
+func (tu *TestUpdate) RemoveGroupAssignmentIDs(ids ...uuid.UUID) *TestUpdate {
+	tu.mutation.RemoveGroupAssignmentIDs(ids...)
+	return tu
+}
+
+// RemoveGroupAssignments removes "group_assignments" edges to GroupTestAssignment entities.
+func (tu *TestUpdate) RemoveGroupAssignments(g ...*GroupTestAssignment) *TestUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.RemoveGroupAssignmentIDs(ids...)
+}
+
 // ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
 func (tu *TestUpdate) ClearTestSessions() *TestUpdate {
 	tu.mutation.ClearTestSessions()
@@ -402,6 +662,26 @@ func (tu *TestUpdate) check() error {
 			return &ValidationError{Name: "total_points", err: fmt.Errorf(`ent: validator failed for field "Test.total_points": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.MaxAttempts(); ok {
+		if err := test.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Test.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.AttemptCooldownMinutes(); ok {
+		if err := test.AttemptCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_cooldown_minutes", err: fmt.Errorf(`ent: validator failed for field "Test.attempt_cooldown_minutes": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.ScoringPolicy(); ok {
+		if err := test.ScoringPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "scoring_policy", err: fmt.Errorf(`ent: validator failed for field "Test.scoring_policy": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.FeedbackRelease(); ok {
+		if err := test.FeedbackReleaseValidator(v); err != nil {
+			return &ValidationError{Name: "feedback_release", err: fmt.Errorf(`ent: validator failed for field "Test.feedback_release": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -447,6 +727,71 @@ func (tu *TestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.TotalTimeCleared() {
 		_spec.ClearField(test.FieldTotalTime, field.TypeInt)
 	}
+	if value, ok := tu.mutation.OpenAt(); ok {
+		_spec.SetField(test.FieldOpenAt, field.TypeTime, value)
+	}
+	if tu.mutation.OpenAtCleared() {
+		_spec.ClearField(test.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.CloseAt(); ok {
+		_spec.SetField(test.FieldCloseAt, field.TypeTime, value)
+	}
+	if tu.mutation.CloseAtCleared() {
+		_spec.ClearField(test.FieldCloseAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.MaxAttempts(); ok {
+		_spec.SetField(test.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(test.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if tu.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(test.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := tu.mutation.AttemptCooldownMinutes(); ok {
+		_spec.SetField(test.FieldAttemptCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedAttemptCooldownMinutes(); ok {
+		_spec.AddField(test.FieldAttemptCooldownMinutes, field.TypeInt, value)
+	}
+	if tu.mutation.AttemptCooldownMinutesCleared() {
+		_spec.ClearField(test.FieldAttemptCooldownMinutes, field.TypeInt)
+	}
+	if value, ok := tu.mutation.ScoringPolicy(); ok {
+		_spec.SetField(test.FieldScoringPolicy, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.FeedbackRelease(); ok {
+		_spec.SetField(test.FieldFeedbackRelease, field.TypeEnum, value)
+	}
+	if tu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.OrganizationTable,
+			Columns: []string{test.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.OrganizationTable,
+			Columns: []string{test.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.CourseSectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -505,6 +850,125 @@ func (tu *TestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.CreatorTable,
+			Columns: []string{test.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.CreatorTable,
+			Columns: []string{test.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !tu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.GroupAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedGroupAssignmentsIDs(); len(nodes) > 0 && !tu.mutation.GroupAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.GroupAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.TestSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -745,6 +1209,26 @@ func (tuo *TestUpdateOne) ClearDeletedAt() *TestUpdateOne {
 	return tuo
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (tuo *TestUpdateOne) SetOrganizationID(u uuid.UUID) *TestUpdateOne {
+	tuo.mutation.SetOrganizationID(u)
+	return tuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *TestUpdateOne {
+	if u != nil {
+		tuo.SetOrganizationID(*u)
+	}
+	return tuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (tuo *TestUpdateOne) ClearOrganizationID() *TestUpdateOne {
+	tuo.mutation.ClearOrganizationID()
+	return tuo
+}
+
 // SetName sets the "name" field.
 func (tuo *TestUpdateOne) SetName(s string) *TestUpdateOne {
 	tuo.mutation.SetName(s)
@@ -799,6 +1283,26 @@ func (tuo *TestUpdateOne) ClearCourseID() *TestUpdateOne {
 	return tuo
 }
 
+// SetCreatorID sets the "creator_id" field.
+func (tuo *TestUpdateOne) SetCreatorID(u uuid.UUID) *TestUpdateOne {
+	tuo.mutation.SetCreatorID(u)
+	return tuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableCreatorID(u *uuid.UUID) *TestUpdateOne {
+	if u != nil {
+		tuo.SetCreatorID(*u)
+	}
+	return tuo
+}
+
+// ClearCreatorID clears the value of the "creator_id" field.
+func (tuo *TestUpdateOne) ClearCreatorID() *TestUpdateOne {
+	tuo.mutation.ClearCreatorID()
+	return tuo
+}
+
 // SetTotalPoints sets the "total_points" field.
 func (tuo *TestUpdateOne) SetTotalPoints(i int) *TestUpdateOne {
 	tuo.mutation.ResetTotalPoints()
@@ -847,6 +1351,133 @@ func (tuo *TestUpdateOne) ClearTotalTime() *TestUpdateOne {
 	return tuo
 }
 
+// SetOpenAt sets the "open_at" field.
+func (tuo *TestUpdateOne) SetOpenAt(t time.Time) *TestUpdateOne {
+	tuo.mutation.SetOpenAt(t)
+	return tuo
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableOpenAt(t *time.Time) *TestUpdateOne {
+	if t != nil {
+		tuo.SetOpenAt(*t)
+	}
+	return tuo
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (tuo *TestUpdateOne) ClearOpenAt() *TestUpdateOne {
+	tuo.mutation.ClearOpenAt()
+	return tuo
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tuo *TestUpdateOne) SetCloseAt(t time.Time) *TestUpdateOne {
+	tuo.mutation.SetCloseAt(t)
+	return tuo
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableCloseAt(t *time.Time) *TestUpdateOne {
+	if t != nil {
+		tuo.SetCloseAt(*t)
+	}
+	return tuo
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (tuo *TestUpdateOne) ClearCloseAt() *TestUpdateOne {
+	tuo.mutation.ClearCloseAt()
+	return tuo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (tuo *TestUpdateOne) SetMaxAttempts(i int) *TestUpdateOne {
+	tuo.mutation.ResetMaxAttempts()
+	tuo.mutation.SetMaxAttempts(i)
+	return tuo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableMaxAttempts(i *int) *TestUpdateOne {
+	if i != nil {
+		tuo.SetMaxAttempts(*i)
+	}
+	return tuo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (tuo *TestUpdateOne) AddMaxAttempts(i int) *TestUpdateOne {
+	tuo.mutation.AddMaxAttempts(i)
+	return tuo
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (tuo *TestUpdateOne) ClearMaxAttempts() *TestUpdateOne {
+	tuo.mutation.ClearMaxAttempts()
+	return tuo
+}
+
+// SetAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field.
+func (tuo *TestUpdateOne) SetAttemptCooldownMinutes(i int) *TestUpdateOne {
+	tuo.mutation.ResetAttemptCooldownMinutes()
+	tuo.mutation.SetAttemptCooldownMinutes(i)
+	return tuo
+}
+
+// SetNillableAttemptCooldownMinutes sets the "attempt_cooldown_minutes" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableAttemptCooldownMinutes(i *int) *TestUpdateOne {
+	if i != nil {
+		tuo.SetAttemptCooldownMinutes(*i)
+	}
+	return tuo
+}
+
+// AddAttemptCooldownMinutes adds i to the "attempt_cooldown_minutes" field.
+func (tuo *TestUpdateOne) AddAttemptCooldownMinutes(i int) *TestUpdateOne {
+	tuo.mutation.AddAttemptCooldownMinutes(i)
+	return tuo
+}
+
+// ClearAttemptCooldownMinutes clears the value of the "attempt_cooldown_minutes" field.
+func (tuo *TestUpdateOne) ClearAttemptCooldownMinutes() *TestUpdateOne {
+	tuo.mutation.ClearAttemptCooldownMinutes()
+	return tuo
+}
+
+// SetScoringPolicy sets the "scoring_policy" field.
+func (tuo *TestUpdateOne) SetScoringPolicy(tp test.ScoringPolicy) *TestUpdateOne {
+	tuo.mutation.SetScoringPolicy(tp)
+	return tuo
+}
+
+// SetNillableScoringPolicy sets the "scoring_policy" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableScoringPolicy(tp *test.ScoringPolicy) *TestUpdateOne {
+	if tp != nil {
+		tuo.SetScoringPolicy(*tp)
+	}
+	return tuo
+}
+
+// SetFeedbackRelease sets the "feedback_release" field.
+func (tuo *TestUpdateOne) SetFeedbackRelease(tr test.FeedbackRelease) *TestUpdateOne {
+	tuo.mutation.SetFeedbackRelease(tr)
+	return tuo
+}
+
+// SetNillableFeedbackRelease sets the "feedback_release" field if the given value is not nil.
+func (tuo *TestUpdateOne) SetNillableFeedbackRelease(tr *test.FeedbackRelease) *TestUpdateOne {
+	if tr != nil {
+		tuo.SetFeedbackRelease(*tr)
+	}
+	return tuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (tuo *TestUpdateOne) SetOrganization(o *Organization) *TestUpdateOne {
+	return tuo.SetOrganizationID(o.ID)
+}
+
 // SetCourseSection sets the "course_section" edge to the CourseSection entity.
 func (tuo *TestUpdateOne) SetCourseSection(c *CourseSection) *TestUpdateOne {
 	return tuo.SetCourseSectionID(c.ID)
@@ -857,6 +1488,41 @@ func (tuo *TestUpdateOne) SetCourse(c *Course) *TestUpdateOne {
 	return tuo.SetCourseID(c.ID)
 }
 
+// SetCreator sets the "creator" edge to the User entity.
+func (tuo *TestUpdateOne) SetCreator(u *User) *TestUpdateOne {
+	return tuo.SetCreatorID(u.ID)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (tuo *TestUpdateOne) AddAccessGrantIDs(ids ...uuid.UUID) *TestUpdateOne {
+	tuo.mutation.AddAccessGrantIDs(ids...)
+	return tuo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (tuo *TestUpdateOne) AddAccessGrants(a ...*AccessGrant) *TestUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.AddAccessGrantIDs(ids...)
+}
+
+// AddGroupAssignmentIDs adds the "group_assignments" edge to the GroupTestAssignment entity by IDs.
+func (tuo *TestUpdateOne) AddGroupAssignmentIDs(ids ...uuid.UUID) *TestUpdateOne {
+	tuo.mutation.AddGroupAssignmentIDs(ids...)
+	return tuo
+}
+
+// AddGroupAssignments adds the "group_assignments" edges to the GroupTestAssignment entity.
+func (tuo *TestUpdateOne) AddGroupAssignments(g ...*GroupTestAssignment) *TestUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.AddGroupAssignmentIDs(ids...)
+}
+
 // AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
 func (tuo *TestUpdateOne) AddTestSessionIDs(ids ...uuid.UUID) *TestUpdateOne {
 	tuo.mutation.AddTestSessionIDs(ids...)
@@ -922,6 +1588,12 @@ func (tuo *TestUpdateOne) Mutation() *TestMutation {
 	return tuo.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (tuo *TestUpdateOne) ClearOrganization() *TestUpdateOne {
+	tuo.mutation.ClearOrganization()
+	return tuo
+}
+
 // ClearCourseSection clears the "course_section" edge to the CourseSection entity.
 func (tuo *TestUpdateOne) ClearCourseSection() *TestUpdateOne {
 	tuo.mutation.ClearCourseSection()
@@ -934,6 +1606,54 @@ func (tuo *TestUpdateOne) ClearCourse() *TestUpdateOne {
 	return tuo
 }
 
+// ClearCreator clears the "creator" edge to the User entity.
+func (tuo *TestUpdateOne) ClearCreator() *TestUpdateOne {
+	tuo.mutation.ClearCreator()
+	return tuo
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (tuo *TestUpdateOne) ClearAccessGrants() *TestUpdateOne {
+	tuo.mutation.ClearAccessGrants()
+	return tuo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (tuo *TestUpdateOne) RemoveAccessGrantIDs(ids ...uuid.UUID) *TestUpdateOne {
+	tuo.mutation.RemoveAccessGrantIDs(ids...)
+	return tuo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (tuo *TestUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *TestUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearGroupAssignments clears all "group_assignments" edges to the GroupTestAssignment entity.
+func (tuo *TestUpdateOne) ClearGroupAssignments() *TestUpdateOne {
+	tuo.mutation.ClearGroupAssignments()
+	return tuo
+}
+
+// RemoveGroupAssignmentIDs removes the "group_assignments" edge to GroupTestAssignment entities by IDs.
+func (tuo *TestUpdateOne) RemoveGroupAssignmentIDs(ids ...uuid.UUID) *TestUpdateOne {
+	tuo.mutation.RemoveGroupAssignmentIDs(ids...)
+	return tuo
+}
+
+// RemoveGroupAssignments removes "group_assignments" edges to GroupTestAssignment entities.
+func (tuo *TestUpdateOne) RemoveGroupAssignments(g ...*GroupTestAssignment) *TestUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.RemoveGroupAssignmentIDs(ids...)
+}
+
 // ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
 func (tuo *TestUpdateOne) ClearTestSessions() *TestUpdateOne {
 	tuo.mutation.ClearTestSessions()
@@ -1085,6 +1805,26 @@ func (tuo *TestUpdateOne) check() error {
 			return &ValidationError{Name: "total_points", err: fmt.Errorf(`ent: validator failed for field "Test.total_points": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.MaxAttempts(); ok {
+		if err := test.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Test.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.AttemptCooldownMinutes(); ok {
+		if err := test.AttemptCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_cooldown_minutes", err: fmt.Errorf(`ent: validator failed for field "Test.attempt_cooldown_minutes": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.ScoringPolicy(); ok {
+		if err := test.ScoringPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "scoring_policy", err: fmt.Errorf(`ent: validator failed for field "Test.scoring_policy": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.FeedbackRelease(); ok {
+		if err := test.FeedbackReleaseValidator(v); err != nil {
+			return &ValidationError{Name: "feedback_release", err: fmt.Errorf(`ent: validator failed for field "Test.feedback_release": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1147,6 +1887,71 @@ func (tuo *TestUpdateOne) sqlSave(ctx context.Context) (_node *Test, err error)
 	if tuo.mutation.TotalTimeCleared() {
 		_spec.ClearField(test.FieldTotalTime, field.TypeInt)
 	}
+	if value, ok := tuo.mutation.OpenAt(); ok {
+		_spec.SetField(test.FieldOpenAt, field.TypeTime, value)
+	}
+	if tuo.mutation.OpenAtCleared() {
+		_spec.ClearField(test.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.CloseAt(); ok {
+		_spec.SetField(test.FieldCloseAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CloseAtCleared() {
+		_spec.ClearField(test.FieldCloseAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.MaxAttempts(); ok {
+		_spec.SetField(test.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(test.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if tuo.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(test.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.AttemptCooldownMinutes(); ok {
+		_spec.SetField(test.FieldAttemptCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedAttemptCooldownMinutes(); ok {
+		_spec.AddField(test.FieldAttemptCooldownMinutes, field.TypeInt, value)
+	}
+	if tuo.mutation.AttemptCooldownMinutesCleared() {
+		_spec.ClearField(test.FieldAttemptCooldownMinutes, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.ScoringPolicy(); ok {
+		_spec.SetField(test.FieldScoringPolicy, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.FeedbackRelease(); ok {
+		_spec.SetField(test.FieldFeedbackRelease, field.TypeEnum, value)
+	}
+	if tuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.OrganizationTable,
+			Columns: []string{test.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.OrganizationTable,
+			Columns: []string{test.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.CourseSectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1205,6 +2010,125 @@ func (tuo *TestUpdateOne) sqlSave(ctx context.Context) (_node *Test, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.CreatorTable,
+			Columns: []string{test.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   test.CreatorTable,
+			Columns: []string{test.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !tuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.AccessGrantsTable,
+			Columns: []string{test.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.GroupAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedGroupAssignmentsIDs(); len(nodes) > 0 && !tuo.mutation.GroupAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.GroupAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   test.GroupAssignmentsTable,
+			Columns: []string{test.GroupAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.TestSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
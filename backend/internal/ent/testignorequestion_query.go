@@ -12,6 +12,7 @@ import (
 	"template/internal/ent/testignorequestion"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type TestIgnoreQuestionQuery struct {
 	predicates   []predicate.TestIgnoreQuestion
 	withTest     *TestQuery
 	withQuestion *QuestionQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -421,6 +423,9 @@ func (tiqq *TestIgnoreQuestionQuery) sqlAll(ctx context.Context, hooks ...queryH
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tiqq.modifiers) > 0 {
+		_spec.Modifiers = tiqq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (tiqq *TestIgnoreQuestionQuery) loadQuestion(ctx context.Context, query *Qu
 
 func (tiqq *TestIgnoreQuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tiqq.querySpec()
+	if len(tiqq.modifiers) > 0 {
+		_spec.Modifiers = tiqq.modifiers
+	}
 	_spec.Node.Columns = tiqq.ctx.Fields
 	if len(tiqq.ctx.Fields) > 0 {
 		_spec.Unique = tiqq.ctx.Unique != nil && *tiqq.ctx.Unique
@@ -574,6 +582,9 @@ func (tiqq *TestIgnoreQuestionQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if tiqq.ctx.Unique != nil && *tiqq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tiqq.modifiers {
+		m(selector)
+	}
 	for _, p := range tiqq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,32 @@ func (tiqq *TestIgnoreQuestionQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tiqq *TestIgnoreQuestionQuery) ForUpdate(opts ...sql.LockOption) *TestIgnoreQuestionQuery {
+	if tiqq.driver.Dialect() == dialect.Postgres {
+		tiqq.Unique(false)
+	}
+	tiqq.modifiers = append(tiqq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tiqq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tiqq *TestIgnoreQuestionQuery) ForShare(opts ...sql.LockOption) *TestIgnoreQuestionQuery {
+	if tiqq.driver.Dialect() == dialect.Postgres {
+		tiqq.Unique(false)
+	}
+	tiqq.modifiers = append(tiqq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tiqq
+}
+
 // TestIgnoreQuestionGroupBy is the group-by builder for TestIgnoreQuestion entities.
 type TestIgnoreQuestionGroupBy struct {
 	selector
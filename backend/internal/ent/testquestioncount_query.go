@@ -11,6 +11,7 @@ import (
 	"template/internal/ent/testquestioncount"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type TestQuestionCountQuery struct {
 	inters     []Interceptor
 	predicates []predicate.TestQuestionCount
 	withTest   *TestQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -384,6 +386,9 @@ func (tqcq *TestQuestionCountQuery) sqlAll(ctx context.Context, hooks ...queryHo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tqcq.modifiers) > 0 {
+		_spec.Modifiers = tqcq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,6 +439,9 @@ func (tqcq *TestQuestionCountQuery) loadTest(ctx context.Context, query *TestQue
 
 func (tqcq *TestQuestionCountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tqcq.querySpec()
+	if len(tqcq.modifiers) > 0 {
+		_spec.Modifiers = tqcq.modifiers
+	}
 	_spec.Node.Columns = tqcq.ctx.Fields
 	if len(tqcq.ctx.Fields) > 0 {
 		_spec.Unique = tqcq.ctx.Unique != nil && *tqcq.ctx.Unique
@@ -499,6 +507,9 @@ func (tqcq *TestQuestionCountQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if tqcq.ctx.Unique != nil && *tqcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tqcq.modifiers {
+		m(selector)
+	}
 	for _, p := range tqcq.predicates {
 		p(selector)
 	}
@@ -516,6 +527,32 @@ func (tqcq *TestQuestionCountQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tqcq *TestQuestionCountQuery) ForUpdate(opts ...sql.LockOption) *TestQuestionCountQuery {
+	if tqcq.driver.Dialect() == dialect.Postgres {
+		tqcq.Unique(false)
+	}
+	tqcq.modifiers = append(tqcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tqcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tqcq *TestQuestionCountQuery) ForShare(opts ...sql.LockOption) *TestQuestionCountQuery {
+	if tqcq.driver.Dialect() == dialect.Postgres {
+		tqcq.Unique(false)
+	}
+	tqcq.modifiers = append(tqcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tqcq
+}
+
 // TestQuestionCountGroupBy is the group-by builder for TestQuestionCount entities.
 type TestQuestionCountGroupBy struct {
 	selector
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/test"
 	"template/internal/ent/testsession"
 	"template/internal/ent/user"
@@ -33,12 +34,32 @@ type TestSession struct {
 	CourseSectionID *uuid.UUID `json:"course_section_id,omitempty"`
 	// TestID holds the value of the "test_id" field.
 	TestID uuid.UUID `json:"test_id,omitempty"`
+	// Assignment of the test to a group the session was created for
+	GroupAssignmentID *uuid.UUID `json:"group_assignment_id,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	// ExpiredAt holds the value of the "expired_at" field.
 	ExpiredAt *time.Time `json:"expired_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Last time the candidate interacted with the session
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+	// Start of the running rest break, the clock is stopped while set
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// Overrides the time multiplier of the user accommodation
+	TimeMultiplier *float64 `json:"time_multiplier,omitempty"`
+	// Overrides the extra minutes of the user accommodation
+	ExtraMinutes *int `json:"extra_minutes,omitempty"`
+	// Overrides the rest breaks of the user accommodation
+	AllowRestBreaks *bool `json:"allow_rest_breaks,omitempty"`
+	// Overrides the rest break count of the user accommodation
+	MaxRestBreaks *int `json:"max_rest_breaks,omitempty"`
+	// Overrides the rest break length of the user accommodation
+	MaxRestBreakMinutes *int `json:"max_rest_break_minutes,omitempty"`
+	// Overrides the open time of the test for this assignment, stored in UTC
+	OpenAt *time.Time `json:"open_at,omitempty"`
+	// Overrides the close time of the test for this assignment, stored in UTC
+	CloseAt *time.Time `json:"close_at,omitempty"`
 	// MaxPoints holds the value of the "max_points" field.
 	MaxPoints int `json:"max_points,omitempty"`
 	// PointsEarned holds the value of the "points_earned" field.
@@ -59,11 +80,21 @@ type TestSessionEdges struct {
 	CourseSection *CourseSection `json:"course_section,omitempty"`
 	// Test holds the value of the test edge.
 	Test *Test `json:"test,omitempty"`
+	// GroupAssignment holds the value of the group_assignment edge.
+	GroupAssignment *GroupTestAssignment `json:"group_assignment,omitempty"`
 	// TestSessionAnswers holds the value of the test_session_answers edge.
 	TestSessionAnswers []*TestSessionAnswer `json:"test_session_answers,omitempty"`
+	// IntegrityEvents holds the value of the integrity_events edge.
+	IntegrityEvents []*TestSessionIntegrityEvent `json:"integrity_events,omitempty"`
+	// Breaks holds the value of the breaks edge.
+	Breaks []*TestSessionBreak `json:"breaks,omitempty"`
+	// TimeExtensions holds the value of the time_extensions edge.
+	TimeExtensions []*TestSessionTimeExtension `json:"time_extensions,omitempty"`
+	// Regrades holds the value of the regrades edge.
+	Regrades []*TestSessionRegrade `json:"regrades,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -99,27 +130,78 @@ func (e TestSessionEdges) TestOrErr() (*Test, error) {
 	return nil, &NotLoadedError{edge: "test"}
 }
 
+// GroupAssignmentOrErr returns the GroupAssignment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionEdges) GroupAssignmentOrErr() (*GroupTestAssignment, error) {
+	if e.GroupAssignment != nil {
+		return e.GroupAssignment, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: grouptestassignment.Label}
+	}
+	return nil, &NotLoadedError{edge: "group_assignment"}
+}
+
 // TestSessionAnswersOrErr returns the TestSessionAnswers value or an error if the edge
 // was not loaded in eager-loading.
 func (e TestSessionEdges) TestSessionAnswersOrErr() ([]*TestSessionAnswer, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.TestSessionAnswers, nil
 	}
 	return nil, &NotLoadedError{edge: "test_session_answers"}
 }
 
+// IntegrityEventsOrErr returns the IntegrityEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestSessionEdges) IntegrityEventsOrErr() ([]*TestSessionIntegrityEvent, error) {
+	if e.loadedTypes[5] {
+		return e.IntegrityEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "integrity_events"}
+}
+
+// BreaksOrErr returns the Breaks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestSessionEdges) BreaksOrErr() ([]*TestSessionBreak, error) {
+	if e.loadedTypes[6] {
+		return e.Breaks, nil
+	}
+	return nil, &NotLoadedError{edge: "breaks"}
+}
+
+// TimeExtensionsOrErr returns the TimeExtensions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestSessionEdges) TimeExtensionsOrErr() ([]*TestSessionTimeExtension, error) {
+	if e.loadedTypes[7] {
+		return e.TimeExtensions, nil
+	}
+	return nil, &NotLoadedError{edge: "time_extensions"}
+}
+
+// RegradesOrErr returns the Regrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e TestSessionEdges) RegradesOrErr() ([]*TestSessionRegrade, error) {
+	if e.loadedTypes[8] {
+		return e.Regrades, nil
+	}
+	return nil, &NotLoadedError{edge: "regrades"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TestSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case testsession.FieldUserID, testsession.FieldCourseSectionID:
+		case testsession.FieldUserID, testsession.FieldCourseSectionID, testsession.FieldGroupAssignmentID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case testsession.FieldMaxPoints, testsession.FieldPointsEarned:
+		case testsession.FieldAllowRestBreaks:
+			values[i] = new(sql.NullBool)
+		case testsession.FieldTimeMultiplier:
+			values[i] = new(sql.NullFloat64)
+		case testsession.FieldExtraMinutes, testsession.FieldMaxRestBreaks, testsession.FieldMaxRestBreakMinutes, testsession.FieldMaxPoints, testsession.FieldPointsEarned:
 			values[i] = new(sql.NullInt64)
 		case testsession.FieldStatus:
 			values[i] = new(sql.NullString)
-		case testsession.FieldCreatedAt, testsession.FieldUpdatedAt, testsession.FieldDeletedAt, testsession.FieldStartedAt, testsession.FieldExpiredAt, testsession.FieldCompletedAt:
+		case testsession.FieldCreatedAt, testsession.FieldUpdatedAt, testsession.FieldDeletedAt, testsession.FieldStartedAt, testsession.FieldExpiredAt, testsession.FieldCompletedAt, testsession.FieldLastActivityAt, testsession.FieldPausedAt, testsession.FieldOpenAt, testsession.FieldCloseAt:
 			values[i] = new(sql.NullTime)
 		case testsession.FieldID, testsession.FieldTestID:
 			values[i] = new(uuid.UUID)
@@ -183,6 +265,13 @@ func (ts *TestSession) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ts.TestID = *value
 			}
+		case testsession.FieldGroupAssignmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_assignment_id", values[i])
+			} else if value.Valid {
+				ts.GroupAssignmentID = new(uuid.UUID)
+				*ts.GroupAssignmentID = *value.S.(*uuid.UUID)
+			}
 		case testsession.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -204,6 +293,69 @@ func (ts *TestSession) assignValues(columns []string, values []any) error {
 				ts.CompletedAt = new(time.Time)
 				*ts.CompletedAt = value.Time
 			}
+		case testsession.FieldLastActivityAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_activity_at", values[i])
+			} else if value.Valid {
+				ts.LastActivityAt = new(time.Time)
+				*ts.LastActivityAt = value.Time
+			}
+		case testsession.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				ts.PausedAt = new(time.Time)
+				*ts.PausedAt = value.Time
+			}
+		case testsession.FieldTimeMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_multiplier", values[i])
+			} else if value.Valid {
+				ts.TimeMultiplier = new(float64)
+				*ts.TimeMultiplier = value.Float64
+			}
+		case testsession.FieldExtraMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_minutes", values[i])
+			} else if value.Valid {
+				ts.ExtraMinutes = new(int)
+				*ts.ExtraMinutes = int(value.Int64)
+			}
+		case testsession.FieldAllowRestBreaks:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_rest_breaks", values[i])
+			} else if value.Valid {
+				ts.AllowRestBreaks = new(bool)
+				*ts.AllowRestBreaks = value.Bool
+			}
+		case testsession.FieldMaxRestBreaks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rest_breaks", values[i])
+			} else if value.Valid {
+				ts.MaxRestBreaks = new(int)
+				*ts.MaxRestBreaks = int(value.Int64)
+			}
+		case testsession.FieldMaxRestBreakMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rest_break_minutes", values[i])
+			} else if value.Valid {
+				ts.MaxRestBreakMinutes = new(int)
+				*ts.MaxRestBreakMinutes = int(value.Int64)
+			}
+		case testsession.FieldOpenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field open_at", values[i])
+			} else if value.Valid {
+				ts.OpenAt = new(time.Time)
+				*ts.OpenAt = value.Time
+			}
+		case testsession.FieldCloseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field close_at", values[i])
+			} else if value.Valid {
+				ts.CloseAt = new(time.Time)
+				*ts.CloseAt = value.Time
+			}
 		case testsession.FieldMaxPoints:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field max_points", values[i])
@@ -250,11 +402,36 @@ func (ts *TestSession) QueryTest() *TestQuery {
 	return NewTestSessionClient(ts.config).QueryTest(ts)
 }
 
+// QueryGroupAssignment queries the "group_assignment" edge of the TestSession entity.
+func (ts *TestSession) QueryGroupAssignment() *GroupTestAssignmentQuery {
+	return NewTestSessionClient(ts.config).QueryGroupAssignment(ts)
+}
+
 // QueryTestSessionAnswers queries the "test_session_answers" edge of the TestSession entity.
 func (ts *TestSession) QueryTestSessionAnswers() *TestSessionAnswerQuery {
 	return NewTestSessionClient(ts.config).QueryTestSessionAnswers(ts)
 }
 
+// QueryIntegrityEvents queries the "integrity_events" edge of the TestSession entity.
+func (ts *TestSession) QueryIntegrityEvents() *TestSessionIntegrityEventQuery {
+	return NewTestSessionClient(ts.config).QueryIntegrityEvents(ts)
+}
+
+// QueryBreaks queries the "breaks" edge of the TestSession entity.
+func (ts *TestSession) QueryBreaks() *TestSessionBreakQuery {
+	return NewTestSessionClient(ts.config).QueryBreaks(ts)
+}
+
+// QueryTimeExtensions queries the "time_extensions" edge of the TestSession entity.
+func (ts *TestSession) QueryTimeExtensions() *TestSessionTimeExtensionQuery {
+	return NewTestSessionClient(ts.config).QueryTimeExtensions(ts)
+}
+
+// QueryRegrades queries the "regrades" edge of the TestSession entity.
+func (ts *TestSession) QueryRegrades() *TestSessionRegradeQuery {
+	return NewTestSessionClient(ts.config).QueryRegrades(ts)
+}
+
 // Update returns a builder for updating this TestSession.
 // Note that you need to call TestSession.Unwrap() before calling this method if this TestSession
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -302,6 +479,11 @@ func (ts *TestSession) String() string {
 	builder.WriteString("test_id=")
 	builder.WriteString(fmt.Sprintf("%v", ts.TestID))
 	builder.WriteString(", ")
+	if v := ts.GroupAssignmentID; v != nil {
+		builder.WriteString("group_assignment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := ts.StartedAt; v != nil {
 		builder.WriteString("started_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -317,6 +499,51 @@ func (ts *TestSession) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := ts.LastActivityAt; v != nil {
+		builder.WriteString("last_activity_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ts.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ts.TimeMultiplier; v != nil {
+		builder.WriteString("time_multiplier=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.ExtraMinutes; v != nil {
+		builder.WriteString("extra_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.AllowRestBreaks; v != nil {
+		builder.WriteString("allow_rest_breaks=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.MaxRestBreaks; v != nil {
+		builder.WriteString("max_rest_breaks=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.MaxRestBreakMinutes; v != nil {
+		builder.WriteString("max_rest_break_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.OpenAt; v != nil {
+		builder.WriteString("open_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ts.CloseAt; v != nil {
+		builder.WriteString("close_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("max_points=")
 	builder.WriteString(fmt.Sprintf("%v", ts.MaxPoints))
 	builder.WriteString(", ")
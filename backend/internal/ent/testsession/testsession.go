@@ -29,12 +29,32 @@ const (
 	FieldCourseSectionID = "course_section_id"
 	// FieldTestID holds the string denoting the test_id field in the database.
 	FieldTestID = "test_id"
+	// FieldGroupAssignmentID holds the string denoting the group_assignment_id field in the database.
+	FieldGroupAssignmentID = "group_assignment_id"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldExpiredAt holds the string denoting the expired_at field in the database.
 	FieldExpiredAt = "expired_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
+	// FieldLastActivityAt holds the string denoting the last_activity_at field in the database.
+	FieldLastActivityAt = "last_activity_at"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldTimeMultiplier holds the string denoting the time_multiplier field in the database.
+	FieldTimeMultiplier = "time_multiplier"
+	// FieldExtraMinutes holds the string denoting the extra_minutes field in the database.
+	FieldExtraMinutes = "extra_minutes"
+	// FieldAllowRestBreaks holds the string denoting the allow_rest_breaks field in the database.
+	FieldAllowRestBreaks = "allow_rest_breaks"
+	// FieldMaxRestBreaks holds the string denoting the max_rest_breaks field in the database.
+	FieldMaxRestBreaks = "max_rest_breaks"
+	// FieldMaxRestBreakMinutes holds the string denoting the max_rest_break_minutes field in the database.
+	FieldMaxRestBreakMinutes = "max_rest_break_minutes"
+	// FieldOpenAt holds the string denoting the open_at field in the database.
+	FieldOpenAt = "open_at"
+	// FieldCloseAt holds the string denoting the close_at field in the database.
+	FieldCloseAt = "close_at"
 	// FieldMaxPoints holds the string denoting the max_points field in the database.
 	FieldMaxPoints = "max_points"
 	// FieldPointsEarned holds the string denoting the points_earned field in the database.
@@ -47,8 +67,18 @@ const (
 	EdgeCourseSection = "course_section"
 	// EdgeTest holds the string denoting the test edge name in mutations.
 	EdgeTest = "test"
+	// EdgeGroupAssignment holds the string denoting the group_assignment edge name in mutations.
+	EdgeGroupAssignment = "group_assignment"
 	// EdgeTestSessionAnswers holds the string denoting the test_session_answers edge name in mutations.
 	EdgeTestSessionAnswers = "test_session_answers"
+	// EdgeIntegrityEvents holds the string denoting the integrity_events edge name in mutations.
+	EdgeIntegrityEvents = "integrity_events"
+	// EdgeBreaks holds the string denoting the breaks edge name in mutations.
+	EdgeBreaks = "breaks"
+	// EdgeTimeExtensions holds the string denoting the time_extensions edge name in mutations.
+	EdgeTimeExtensions = "time_extensions"
+	// EdgeRegrades holds the string denoting the regrades edge name in mutations.
+	EdgeRegrades = "regrades"
 	// Table holds the table name of the testsession in the database.
 	Table = "test_sessions"
 	// UserTable is the table that holds the user relation/edge.
@@ -72,6 +102,13 @@ const (
 	TestInverseTable = "tests"
 	// TestColumn is the table column denoting the test relation/edge.
 	TestColumn = "test_id"
+	// GroupAssignmentTable is the table that holds the group_assignment relation/edge.
+	GroupAssignmentTable = "test_sessions"
+	// GroupAssignmentInverseTable is the table name for the GroupTestAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "grouptestassignment" package.
+	GroupAssignmentInverseTable = "group_test_assignments"
+	// GroupAssignmentColumn is the table column denoting the group_assignment relation/edge.
+	GroupAssignmentColumn = "group_assignment_id"
 	// TestSessionAnswersTable is the table that holds the test_session_answers relation/edge.
 	TestSessionAnswersTable = "test_session_answers"
 	// TestSessionAnswersInverseTable is the table name for the TestSessionAnswer entity.
@@ -79,6 +116,34 @@ const (
 	TestSessionAnswersInverseTable = "test_session_answers"
 	// TestSessionAnswersColumn is the table column denoting the test_session_answers relation/edge.
 	TestSessionAnswersColumn = "session_id"
+	// IntegrityEventsTable is the table that holds the integrity_events relation/edge.
+	IntegrityEventsTable = "test_session_integrity_events"
+	// IntegrityEventsInverseTable is the table name for the TestSessionIntegrityEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessionintegrityevent" package.
+	IntegrityEventsInverseTable = "test_session_integrity_events"
+	// IntegrityEventsColumn is the table column denoting the integrity_events relation/edge.
+	IntegrityEventsColumn = "session_id"
+	// BreaksTable is the table that holds the breaks relation/edge.
+	BreaksTable = "test_session_breaks"
+	// BreaksInverseTable is the table name for the TestSessionBreak entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessionbreak" package.
+	BreaksInverseTable = "test_session_breaks"
+	// BreaksColumn is the table column denoting the breaks relation/edge.
+	BreaksColumn = "session_id"
+	// TimeExtensionsTable is the table that holds the time_extensions relation/edge.
+	TimeExtensionsTable = "test_session_time_extensions"
+	// TimeExtensionsInverseTable is the table name for the TestSessionTimeExtension entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessiontimeextension" package.
+	TimeExtensionsInverseTable = "test_session_time_extensions"
+	// TimeExtensionsColumn is the table column denoting the time_extensions relation/edge.
+	TimeExtensionsColumn = "session_id"
+	// RegradesTable is the table that holds the regrades relation/edge.
+	RegradesTable = "test_session_regrades"
+	// RegradesInverseTable is the table name for the TestSessionRegrade entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessionregrade" package.
+	RegradesInverseTable = "test_session_regrades"
+	// RegradesColumn is the table column denoting the regrades relation/edge.
+	RegradesColumn = "session_id"
 )
 
 // Columns holds all SQL columns for testsession fields.
@@ -90,9 +155,19 @@ var Columns = []string{
 	FieldUserID,
 	FieldCourseSectionID,
 	FieldTestID,
+	FieldGroupAssignmentID,
 	FieldStartedAt,
 	FieldExpiredAt,
 	FieldCompletedAt,
+	FieldLastActivityAt,
+	FieldPausedAt,
+	FieldTimeMultiplier,
+	FieldExtraMinutes,
+	FieldAllowRestBreaks,
+	FieldMaxRestBreaks,
+	FieldMaxRestBreakMinutes,
+	FieldOpenAt,
+	FieldCloseAt,
 	FieldMaxPoints,
 	FieldPointsEarned,
 	FieldStatus,
@@ -114,14 +189,22 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// TimeMultiplierValidator is a validator for the "time_multiplier" field. It is called by the builders before save.
+	TimeMultiplierValidator func(float64) error
+	// ExtraMinutesValidator is a validator for the "extra_minutes" field. It is called by the builders before save.
+	ExtraMinutesValidator func(int) error
+	// MaxRestBreaksValidator is a validator for the "max_rest_breaks" field. It is called by the builders before save.
+	MaxRestBreaksValidator func(int) error
+	// MaxRestBreakMinutesValidator is a validator for the "max_rest_break_minutes" field. It is called by the builders before save.
+	MaxRestBreakMinutesValidator func(int) error
 	// DefaultMaxPoints holds the default value on creation for the "max_points" field.
 	DefaultMaxPoints int
 	// DefaultPointsEarned holds the default value on creation for the "points_earned" field.
@@ -197,6 +280,11 @@ func ByTestID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTestID, opts...).ToFunc()
 }
 
+// ByGroupAssignmentID orders the results by the group_assignment_id field.
+func ByGroupAssignmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupAssignmentID, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -212,6 +300,51 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
 }
 
+// ByLastActivityAt orders the results by the last_activity_at field.
+func ByLastActivityAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastActivityAt, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByTimeMultiplier orders the results by the time_multiplier field.
+func ByTimeMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeMultiplier, opts...).ToFunc()
+}
+
+// ByExtraMinutes orders the results by the extra_minutes field.
+func ByExtraMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtraMinutes, opts...).ToFunc()
+}
+
+// ByAllowRestBreaks orders the results by the allow_rest_breaks field.
+func ByAllowRestBreaks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowRestBreaks, opts...).ToFunc()
+}
+
+// ByMaxRestBreaks orders the results by the max_rest_breaks field.
+func ByMaxRestBreaks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRestBreaks, opts...).ToFunc()
+}
+
+// ByMaxRestBreakMinutes orders the results by the max_rest_break_minutes field.
+func ByMaxRestBreakMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRestBreakMinutes, opts...).ToFunc()
+}
+
+// ByOpenAt orders the results by the open_at field.
+func ByOpenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenAt, opts...).ToFunc()
+}
+
+// ByCloseAt orders the results by the close_at field.
+func ByCloseAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseAt, opts...).ToFunc()
+}
+
 // ByMaxPoints orders the results by the max_points field.
 func ByMaxPoints(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxPoints, opts...).ToFunc()
@@ -248,6 +381,13 @@ func ByTestField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByGroupAssignmentField orders the results by group_assignment field.
+func ByGroupAssignmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupAssignmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByTestSessionAnswersCount orders the results by test_session_answers count.
 func ByTestSessionAnswersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -261,6 +401,62 @@ func ByTestSessionAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newTestSessionAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByIntegrityEventsCount orders the results by integrity_events count.
+func ByIntegrityEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIntegrityEventsStep(), opts...)
+	}
+}
+
+// ByIntegrityEvents orders the results by integrity_events terms.
+func ByIntegrityEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIntegrityEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBreaksCount orders the results by breaks count.
+func ByBreaksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBreaksStep(), opts...)
+	}
+}
+
+// ByBreaks orders the results by breaks terms.
+func ByBreaks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBreaksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTimeExtensionsCount orders the results by time_extensions count.
+func ByTimeExtensionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimeExtensionsStep(), opts...)
+	}
+}
+
+// ByTimeExtensions orders the results by time_extensions terms.
+func ByTimeExtensions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimeExtensionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRegradesCount orders the results by regrades count.
+func ByRegradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegradesStep(), opts...)
+	}
+}
+
+// ByRegrades orders the results by regrades terms.
+func ByRegrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -282,6 +478,13 @@ func newTestStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TestTable, TestColumn),
 	)
 }
+func newGroupAssignmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupAssignmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupAssignmentTable, GroupAssignmentColumn),
+	)
+}
 func newTestSessionAnswersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -289,3 +492,31 @@ func newTestSessionAnswersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TestSessionAnswersTable, TestSessionAnswersColumn),
 	)
 }
+func newIntegrityEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IntegrityEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IntegrityEventsTable, IntegrityEventsColumn),
+	)
+}
+func newBreaksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BreaksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BreaksTable, BreaksColumn),
+	)
+}
+func newTimeExtensionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimeExtensionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimeExtensionsTable, TimeExtensionsColumn),
+	)
+}
+func newRegradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegradesTable, RegradesColumn),
+	)
+}
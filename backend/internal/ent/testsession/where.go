@@ -86,6 +86,11 @@ func TestID(v uuid.UUID) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldTestID, v))
 }
 
+// GroupAssignmentID applies equality check predicate on the "group_assignment_id" field. It's identical to GroupAssignmentIDEQ.
+func GroupAssignmentID(v uuid.UUID) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldGroupAssignmentID, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldStartedAt, v))
@@ -101,6 +106,51 @@ func CompletedAt(v time.Time) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// LastActivityAt applies equality check predicate on the "last_activity_at" field. It's identical to LastActivityAtEQ.
+func LastActivityAt(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// TimeMultiplier applies equality check predicate on the "time_multiplier" field. It's identical to TimeMultiplierEQ.
+func TimeMultiplier(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldTimeMultiplier, v))
+}
+
+// ExtraMinutes applies equality check predicate on the "extra_minutes" field. It's identical to ExtraMinutesEQ.
+func ExtraMinutes(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldExtraMinutes, v))
+}
+
+// AllowRestBreaks applies equality check predicate on the "allow_rest_breaks" field. It's identical to AllowRestBreaksEQ.
+func AllowRestBreaks(v bool) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldAllowRestBreaks, v))
+}
+
+// MaxRestBreaks applies equality check predicate on the "max_rest_breaks" field. It's identical to MaxRestBreaksEQ.
+func MaxRestBreaks(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreakMinutes applies equality check predicate on the "max_rest_break_minutes" field. It's identical to MaxRestBreakMinutesEQ.
+func MaxRestBreakMinutes(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// OpenAt applies equality check predicate on the "open_at" field. It's identical to OpenAtEQ.
+func OpenAt(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// CloseAt applies equality check predicate on the "close_at" field. It's identical to CloseAtEQ.
+func CloseAt(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldCloseAt, v))
+}
+
 // MaxPoints applies equality check predicate on the "max_points" field. It's identical to MaxPointsEQ.
 func MaxPoints(v int) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldMaxPoints, v))
@@ -321,6 +371,36 @@ func TestIDNotIn(vs ...uuid.UUID) predicate.TestSession {
 	return predicate.TestSession(sql.FieldNotIn(FieldTestID, vs...))
 }
 
+// GroupAssignmentIDEQ applies the EQ predicate on the "group_assignment_id" field.
+func GroupAssignmentIDEQ(v uuid.UUID) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldGroupAssignmentID, v))
+}
+
+// GroupAssignmentIDNEQ applies the NEQ predicate on the "group_assignment_id" field.
+func GroupAssignmentIDNEQ(v uuid.UUID) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldGroupAssignmentID, v))
+}
+
+// GroupAssignmentIDIn applies the In predicate on the "group_assignment_id" field.
+func GroupAssignmentIDIn(vs ...uuid.UUID) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldGroupAssignmentID, vs...))
+}
+
+// GroupAssignmentIDNotIn applies the NotIn predicate on the "group_assignment_id" field.
+func GroupAssignmentIDNotIn(vs ...uuid.UUID) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldGroupAssignmentID, vs...))
+}
+
+// GroupAssignmentIDIsNil applies the IsNil predicate on the "group_assignment_id" field.
+func GroupAssignmentIDIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldGroupAssignmentID))
+}
+
+// GroupAssignmentIDNotNil applies the NotNil predicate on the "group_assignment_id" field.
+func GroupAssignmentIDNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldGroupAssignmentID))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldStartedAt, v))
@@ -471,6 +551,426 @@ func CompletedAtNotNil() predicate.TestSession {
 	return predicate.TestSession(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// LastActivityAtEQ applies the EQ predicate on the "last_activity_at" field.
+func LastActivityAtEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtNEQ applies the NEQ predicate on the "last_activity_at" field.
+func LastActivityAtNEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtIn applies the In predicate on the "last_activity_at" field.
+func LastActivityAtIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtNotIn applies the NotIn predicate on the "last_activity_at" field.
+func LastActivityAtNotIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtGT applies the GT predicate on the "last_activity_at" field.
+func LastActivityAtGT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtGTE applies the GTE predicate on the "last_activity_at" field.
+func LastActivityAtGTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLT applies the LT predicate on the "last_activity_at" field.
+func LastActivityAtLT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLTE applies the LTE predicate on the "last_activity_at" field.
+func LastActivityAtLTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldLastActivityAt, v))
+}
+
+// LastActivityAtIsNil applies the IsNil predicate on the "last_activity_at" field.
+func LastActivityAtIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldLastActivityAt))
+}
+
+// LastActivityAtNotNil applies the NotNil predicate on the "last_activity_at" field.
+func LastActivityAtNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldLastActivityAt))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldPausedAt))
+}
+
+// TimeMultiplierEQ applies the EQ predicate on the "time_multiplier" field.
+func TimeMultiplierEQ(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierNEQ applies the NEQ predicate on the "time_multiplier" field.
+func TimeMultiplierNEQ(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierIn applies the In predicate on the "time_multiplier" field.
+func TimeMultiplierIn(vs ...float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldTimeMultiplier, vs...))
+}
+
+// TimeMultiplierNotIn applies the NotIn predicate on the "time_multiplier" field.
+func TimeMultiplierNotIn(vs ...float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldTimeMultiplier, vs...))
+}
+
+// TimeMultiplierGT applies the GT predicate on the "time_multiplier" field.
+func TimeMultiplierGT(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierGTE applies the GTE predicate on the "time_multiplier" field.
+func TimeMultiplierGTE(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierLT applies the LT predicate on the "time_multiplier" field.
+func TimeMultiplierLT(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierLTE applies the LTE predicate on the "time_multiplier" field.
+func TimeMultiplierLTE(v float64) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierIsNil applies the IsNil predicate on the "time_multiplier" field.
+func TimeMultiplierIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldTimeMultiplier))
+}
+
+// TimeMultiplierNotNil applies the NotNil predicate on the "time_multiplier" field.
+func TimeMultiplierNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldTimeMultiplier))
+}
+
+// ExtraMinutesEQ applies the EQ predicate on the "extra_minutes" field.
+func ExtraMinutesEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesNEQ applies the NEQ predicate on the "extra_minutes" field.
+func ExtraMinutesNEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesIn applies the In predicate on the "extra_minutes" field.
+func ExtraMinutesIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldExtraMinutes, vs...))
+}
+
+// ExtraMinutesNotIn applies the NotIn predicate on the "extra_minutes" field.
+func ExtraMinutesNotIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldExtraMinutes, vs...))
+}
+
+// ExtraMinutesGT applies the GT predicate on the "extra_minutes" field.
+func ExtraMinutesGT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesGTE applies the GTE predicate on the "extra_minutes" field.
+func ExtraMinutesGTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesLT applies the LT predicate on the "extra_minutes" field.
+func ExtraMinutesLT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesLTE applies the LTE predicate on the "extra_minutes" field.
+func ExtraMinutesLTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesIsNil applies the IsNil predicate on the "extra_minutes" field.
+func ExtraMinutesIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldExtraMinutes))
+}
+
+// ExtraMinutesNotNil applies the NotNil predicate on the "extra_minutes" field.
+func ExtraMinutesNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldExtraMinutes))
+}
+
+// AllowRestBreaksEQ applies the EQ predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksEQ(v bool) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldAllowRestBreaks, v))
+}
+
+// AllowRestBreaksNEQ applies the NEQ predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksNEQ(v bool) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldAllowRestBreaks, v))
+}
+
+// AllowRestBreaksIsNil applies the IsNil predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldAllowRestBreaks))
+}
+
+// AllowRestBreaksNotNil applies the NotNil predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldAllowRestBreaks))
+}
+
+// MaxRestBreaksEQ applies the EQ predicate on the "max_rest_breaks" field.
+func MaxRestBreaksEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksNEQ applies the NEQ predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksIn applies the In predicate on the "max_rest_breaks" field.
+func MaxRestBreaksIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldMaxRestBreaks, vs...))
+}
+
+// MaxRestBreaksNotIn applies the NotIn predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNotIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldMaxRestBreaks, vs...))
+}
+
+// MaxRestBreaksGT applies the GT predicate on the "max_rest_breaks" field.
+func MaxRestBreaksGT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksGTE applies the GTE predicate on the "max_rest_breaks" field.
+func MaxRestBreaksGTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksLT applies the LT predicate on the "max_rest_breaks" field.
+func MaxRestBreaksLT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksLTE applies the LTE predicate on the "max_rest_breaks" field.
+func MaxRestBreaksLTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksIsNil applies the IsNil predicate on the "max_rest_breaks" field.
+func MaxRestBreaksIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldMaxRestBreaks))
+}
+
+// MaxRestBreaksNotNil applies the NotNil predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldMaxRestBreaks))
+}
+
+// MaxRestBreakMinutesEQ applies the EQ predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesNEQ applies the NEQ predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNEQ(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesIn applies the In predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldMaxRestBreakMinutes, vs...))
+}
+
+// MaxRestBreakMinutesNotIn applies the NotIn predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNotIn(vs ...int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldMaxRestBreakMinutes, vs...))
+}
+
+// MaxRestBreakMinutesGT applies the GT predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesGT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesGTE applies the GTE predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesGTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesLT applies the LT predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesLT(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesLTE applies the LTE predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesLTE(v int) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesIsNil applies the IsNil predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldMaxRestBreakMinutes))
+}
+
+// MaxRestBreakMinutesNotNil applies the NotNil predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldMaxRestBreakMinutes))
+}
+
+// OpenAtEQ applies the EQ predicate on the "open_at" field.
+func OpenAtEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldOpenAt, v))
+}
+
+// OpenAtNEQ applies the NEQ predicate on the "open_at" field.
+func OpenAtNEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldOpenAt, v))
+}
+
+// OpenAtIn applies the In predicate on the "open_at" field.
+func OpenAtIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldOpenAt, vs...))
+}
+
+// OpenAtNotIn applies the NotIn predicate on the "open_at" field.
+func OpenAtNotIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldOpenAt, vs...))
+}
+
+// OpenAtGT applies the GT predicate on the "open_at" field.
+func OpenAtGT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldOpenAt, v))
+}
+
+// OpenAtGTE applies the GTE predicate on the "open_at" field.
+func OpenAtGTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldOpenAt, v))
+}
+
+// OpenAtLT applies the LT predicate on the "open_at" field.
+func OpenAtLT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldOpenAt, v))
+}
+
+// OpenAtLTE applies the LTE predicate on the "open_at" field.
+func OpenAtLTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldOpenAt, v))
+}
+
+// OpenAtIsNil applies the IsNil predicate on the "open_at" field.
+func OpenAtIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldOpenAt))
+}
+
+// OpenAtNotNil applies the NotNil predicate on the "open_at" field.
+func OpenAtNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldOpenAt))
+}
+
+// CloseAtEQ applies the EQ predicate on the "close_at" field.
+func CloseAtEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldEQ(FieldCloseAt, v))
+}
+
+// CloseAtNEQ applies the NEQ predicate on the "close_at" field.
+func CloseAtNEQ(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNEQ(FieldCloseAt, v))
+}
+
+// CloseAtIn applies the In predicate on the "close_at" field.
+func CloseAtIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldIn(FieldCloseAt, vs...))
+}
+
+// CloseAtNotIn applies the NotIn predicate on the "close_at" field.
+func CloseAtNotIn(vs ...time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotIn(FieldCloseAt, vs...))
+}
+
+// CloseAtGT applies the GT predicate on the "close_at" field.
+func CloseAtGT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGT(FieldCloseAt, v))
+}
+
+// CloseAtGTE applies the GTE predicate on the "close_at" field.
+func CloseAtGTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldGTE(FieldCloseAt, v))
+}
+
+// CloseAtLT applies the LT predicate on the "close_at" field.
+func CloseAtLT(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLT(FieldCloseAt, v))
+}
+
+// CloseAtLTE applies the LTE predicate on the "close_at" field.
+func CloseAtLTE(v time.Time) predicate.TestSession {
+	return predicate.TestSession(sql.FieldLTE(FieldCloseAt, v))
+}
+
+// CloseAtIsNil applies the IsNil predicate on the "close_at" field.
+func CloseAtIsNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldIsNull(FieldCloseAt))
+}
+
+// CloseAtNotNil applies the NotNil predicate on the "close_at" field.
+func CloseAtNotNil() predicate.TestSession {
+	return predicate.TestSession(sql.FieldNotNull(FieldCloseAt))
+}
+
 // MaxPointsEQ applies the EQ predicate on the "max_points" field.
 func MaxPointsEQ(v int) predicate.TestSession {
 	return predicate.TestSession(sql.FieldEQ(FieldMaxPoints, v))
@@ -640,6 +1140,29 @@ func HasTestWith(preds ...predicate.Test) predicate.TestSession {
 	})
 }
 
+// HasGroupAssignment applies the HasEdge predicate on the "group_assignment" edge.
+func HasGroupAssignment() predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupAssignmentTable, GroupAssignmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupAssignmentWith applies the HasEdge predicate on the "group_assignment" edge with a given conditions (other predicates).
+func HasGroupAssignmentWith(preds ...predicate.GroupTestAssignment) predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := newGroupAssignmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTestSessionAnswers applies the HasEdge predicate on the "test_session_answers" edge.
 func HasTestSessionAnswers() predicate.TestSession {
 	return predicate.TestSession(func(s *sql.Selector) {
@@ -663,6 +1186,98 @@ func HasTestSessionAnswersWith(preds ...predicate.TestSessionAnswer) predicate.T
 	})
 }
 
+// HasIntegrityEvents applies the HasEdge predicate on the "integrity_events" edge.
+func HasIntegrityEvents() predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IntegrityEventsTable, IntegrityEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIntegrityEventsWith applies the HasEdge predicate on the "integrity_events" edge with a given conditions (other predicates).
+func HasIntegrityEventsWith(preds ...predicate.TestSessionIntegrityEvent) predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := newIntegrityEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBreaks applies the HasEdge predicate on the "breaks" edge.
+func HasBreaks() predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BreaksTable, BreaksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBreaksWith applies the HasEdge predicate on the "breaks" edge with a given conditions (other predicates).
+func HasBreaksWith(preds ...predicate.TestSessionBreak) predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := newBreaksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTimeExtensions applies the HasEdge predicate on the "time_extensions" edge.
+func HasTimeExtensions() predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TimeExtensionsTable, TimeExtensionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTimeExtensionsWith applies the HasEdge predicate on the "time_extensions" edge with a given conditions (other predicates).
+func HasTimeExtensionsWith(preds ...predicate.TestSessionTimeExtension) predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := newTimeExtensionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRegrades applies the HasEdge predicate on the "regrades" edge.
+func HasRegrades() predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RegradesTable, RegradesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegradesWith applies the HasEdge predicate on the "regrades" edge with a given conditions (other predicates).
+func HasRegradesWith(preds ...predicate.TestSessionRegrade) predicate.TestSession {
+	return predicate.TestSession(func(s *sql.Selector) {
+		step := newRegradesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TestSession) predicate.TestSession {
 	return predicate.TestSession(sql.AndPredicates(predicates...))
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/test"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
 	"time"
 
@@ -101,6 +106,20 @@ func (tsc *TestSessionCreate) SetTestID(u uuid.UUID) *TestSessionCreate {
 	return tsc
 }
 
+// SetGroupAssignmentID sets the "group_assignment_id" field.
+func (tsc *TestSessionCreate) SetGroupAssignmentID(u uuid.UUID) *TestSessionCreate {
+	tsc.mutation.SetGroupAssignmentID(u)
+	return tsc
+}
+
+// SetNillableGroupAssignmentID sets the "group_assignment_id" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableGroupAssignmentID(u *uuid.UUID) *TestSessionCreate {
+	if u != nil {
+		tsc.SetGroupAssignmentID(*u)
+	}
+	return tsc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (tsc *TestSessionCreate) SetStartedAt(t time.Time) *TestSessionCreate {
 	tsc.mutation.SetStartedAt(t)
@@ -143,6 +162,132 @@ func (tsc *TestSessionCreate) SetNillableCompletedAt(t *time.Time) *TestSessionC
 	return tsc
 }
 
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tsc *TestSessionCreate) SetLastActivityAt(t time.Time) *TestSessionCreate {
+	tsc.mutation.SetLastActivityAt(t)
+	return tsc
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableLastActivityAt(t *time.Time) *TestSessionCreate {
+	if t != nil {
+		tsc.SetLastActivityAt(*t)
+	}
+	return tsc
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (tsc *TestSessionCreate) SetPausedAt(t time.Time) *TestSessionCreate {
+	tsc.mutation.SetPausedAt(t)
+	return tsc
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillablePausedAt(t *time.Time) *TestSessionCreate {
+	if t != nil {
+		tsc.SetPausedAt(*t)
+	}
+	return tsc
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (tsc *TestSessionCreate) SetTimeMultiplier(f float64) *TestSessionCreate {
+	tsc.mutation.SetTimeMultiplier(f)
+	return tsc
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableTimeMultiplier(f *float64) *TestSessionCreate {
+	if f != nil {
+		tsc.SetTimeMultiplier(*f)
+	}
+	return tsc
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (tsc *TestSessionCreate) SetExtraMinutes(i int) *TestSessionCreate {
+	tsc.mutation.SetExtraMinutes(i)
+	return tsc
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableExtraMinutes(i *int) *TestSessionCreate {
+	if i != nil {
+		tsc.SetExtraMinutes(*i)
+	}
+	return tsc
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (tsc *TestSessionCreate) SetAllowRestBreaks(b bool) *TestSessionCreate {
+	tsc.mutation.SetAllowRestBreaks(b)
+	return tsc
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableAllowRestBreaks(b *bool) *TestSessionCreate {
+	if b != nil {
+		tsc.SetAllowRestBreaks(*b)
+	}
+	return tsc
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (tsc *TestSessionCreate) SetMaxRestBreaks(i int) *TestSessionCreate {
+	tsc.mutation.SetMaxRestBreaks(i)
+	return tsc
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableMaxRestBreaks(i *int) *TestSessionCreate {
+	if i != nil {
+		tsc.SetMaxRestBreaks(*i)
+	}
+	return tsc
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (tsc *TestSessionCreate) SetMaxRestBreakMinutes(i int) *TestSessionCreate {
+	tsc.mutation.SetMaxRestBreakMinutes(i)
+	return tsc
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableMaxRestBreakMinutes(i *int) *TestSessionCreate {
+	if i != nil {
+		tsc.SetMaxRestBreakMinutes(*i)
+	}
+	return tsc
+}
+
+// SetOpenAt sets the "open_at" field.
+func (tsc *TestSessionCreate) SetOpenAt(t time.Time) *TestSessionCreate {
+	tsc.mutation.SetOpenAt(t)
+	return tsc
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableOpenAt(t *time.Time) *TestSessionCreate {
+	if t != nil {
+		tsc.SetOpenAt(*t)
+	}
+	return tsc
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tsc *TestSessionCreate) SetCloseAt(t time.Time) *TestSessionCreate {
+	tsc.mutation.SetCloseAt(t)
+	return tsc
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tsc *TestSessionCreate) SetNillableCloseAt(t *time.Time) *TestSessionCreate {
+	if t != nil {
+		tsc.SetCloseAt(*t)
+	}
+	return tsc
+}
+
 // SetMaxPoints sets the "max_points" field.
 func (tsc *TestSessionCreate) SetMaxPoints(i int) *TestSessionCreate {
 	tsc.mutation.SetMaxPoints(i)
@@ -214,6 +359,11 @@ func (tsc *TestSessionCreate) SetTest(t *Test) *TestSessionCreate {
 	return tsc.SetTestID(t.ID)
 }
 
+// SetGroupAssignment sets the "group_assignment" edge to the GroupTestAssignment entity.
+func (tsc *TestSessionCreate) SetGroupAssignment(g *GroupTestAssignment) *TestSessionCreate {
+	return tsc.SetGroupAssignmentID(g.ID)
+}
+
 // AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
 func (tsc *TestSessionCreate) AddTestSessionAnswerIDs(ids ...uuid.UUID) *TestSessionCreate {
 	tsc.mutation.AddTestSessionAnswerIDs(ids...)
@@ -229,6 +379,66 @@ func (tsc *TestSessionCreate) AddTestSessionAnswers(t ...*TestSessionAnswer) *Te
 	return tsc.AddTestSessionAnswerIDs(ids...)
 }
 
+// AddIntegrityEventIDs adds the "integrity_events" edge to the TestSessionIntegrityEvent entity by IDs.
+func (tsc *TestSessionCreate) AddIntegrityEventIDs(ids ...uuid.UUID) *TestSessionCreate {
+	tsc.mutation.AddIntegrityEventIDs(ids...)
+	return tsc
+}
+
+// AddIntegrityEvents adds the "integrity_events" edges to the TestSessionIntegrityEvent entity.
+func (tsc *TestSessionCreate) AddIntegrityEvents(t ...*TestSessionIntegrityEvent) *TestSessionCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddIntegrityEventIDs(ids...)
+}
+
+// AddBreakIDs adds the "breaks" edge to the TestSessionBreak entity by IDs.
+func (tsc *TestSessionCreate) AddBreakIDs(ids ...uuid.UUID) *TestSessionCreate {
+	tsc.mutation.AddBreakIDs(ids...)
+	return tsc
+}
+
+// AddBreaks adds the "breaks" edges to the TestSessionBreak entity.
+func (tsc *TestSessionCreate) AddBreaks(t ...*TestSessionBreak) *TestSessionCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddBreakIDs(ids...)
+}
+
+// AddTimeExtensionIDs adds the "time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (tsc *TestSessionCreate) AddTimeExtensionIDs(ids ...uuid.UUID) *TestSessionCreate {
+	tsc.mutation.AddTimeExtensionIDs(ids...)
+	return tsc
+}
+
+// AddTimeExtensions adds the "time_extensions" edges to the TestSessionTimeExtension entity.
+func (tsc *TestSessionCreate) AddTimeExtensions(t ...*TestSessionTimeExtension) *TestSessionCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddTimeExtensionIDs(ids...)
+}
+
+// AddRegradeIDs adds the "regrades" edge to the TestSessionRegrade entity by IDs.
+func (tsc *TestSessionCreate) AddRegradeIDs(ids ...uuid.UUID) *TestSessionCreate {
+	tsc.mutation.AddRegradeIDs(ids...)
+	return tsc
+}
+
+// AddRegrades adds the "regrades" edges to the TestSessionRegrade entity.
+func (tsc *TestSessionCreate) AddRegrades(t ...*TestSessionRegrade) *TestSessionCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddRegradeIDs(ids...)
+}
+
 // Mutation returns the TestSessionMutation object of the builder.
 func (tsc *TestSessionCreate) Mutation() *TestSessionMutation {
 	return tsc.mutation
@@ -313,6 +523,26 @@ func (tsc *TestSessionCreate) check() error {
 	if _, ok := tsc.mutation.TestID(); !ok {
 		return &ValidationError{Name: "test_id", err: errors.New(`ent: missing required field "TestSession.test_id"`)}
 	}
+	if v, ok := tsc.mutation.TimeMultiplier(); ok {
+		if err := testsession.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "TestSession.time_multiplier": %w`, err)}
+		}
+	}
+	if v, ok := tsc.mutation.ExtraMinutes(); ok {
+		if err := testsession.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.extra_minutes": %w`, err)}
+		}
+	}
+	if v, ok := tsc.mutation.MaxRestBreaks(); ok {
+		if err := testsession.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := tsc.mutation.MaxRestBreakMinutes(); ok {
+		if err := testsession.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_break_minutes": %w`, err)}
+		}
+	}
 	if _, ok := tsc.mutation.MaxPoints(); !ok {
 		return &ValidationError{Name: "max_points", err: errors.New(`ent: missing required field "TestSession.max_points"`)}
 	}
@@ -389,6 +619,42 @@ func (tsc *TestSessionCreate) createSpec() (*TestSession, *sqlgraph.CreateSpec)
 		_spec.SetField(testsession.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := tsc.mutation.LastActivityAt(); ok {
+		_spec.SetField(testsession.FieldLastActivityAt, field.TypeTime, value)
+		_node.LastActivityAt = &value
+	}
+	if value, ok := tsc.mutation.PausedAt(); ok {
+		_spec.SetField(testsession.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := tsc.mutation.TimeMultiplier(); ok {
+		_spec.SetField(testsession.FieldTimeMultiplier, field.TypeFloat64, value)
+		_node.TimeMultiplier = &value
+	}
+	if value, ok := tsc.mutation.ExtraMinutes(); ok {
+		_spec.SetField(testsession.FieldExtraMinutes, field.TypeInt, value)
+		_node.ExtraMinutes = &value
+	}
+	if value, ok := tsc.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(testsession.FieldAllowRestBreaks, field.TypeBool, value)
+		_node.AllowRestBreaks = &value
+	}
+	if value, ok := tsc.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreaks, field.TypeInt, value)
+		_node.MaxRestBreaks = &value
+	}
+	if value, ok := tsc.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreakMinutes, field.TypeInt, value)
+		_node.MaxRestBreakMinutes = &value
+	}
+	if value, ok := tsc.mutation.OpenAt(); ok {
+		_spec.SetField(testsession.FieldOpenAt, field.TypeTime, value)
+		_node.OpenAt = &value
+	}
+	if value, ok := tsc.mutation.CloseAt(); ok {
+		_spec.SetField(testsession.FieldCloseAt, field.TypeTime, value)
+		_node.CloseAt = &value
+	}
 	if value, ok := tsc.mutation.MaxPoints(); ok {
 		_spec.SetField(testsession.FieldMaxPoints, field.TypeInt, value)
 		_node.MaxPoints = value
@@ -452,6 +718,23 @@ func (tsc *TestSessionCreate) createSpec() (*TestSession, *sqlgraph.CreateSpec)
 		_node.TestID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tsc.mutation.GroupAssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsession.GroupAssignmentTable,
+			Columns: []string{testsession.GroupAssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupAssignmentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tsc.mutation.TestSessionAnswersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -468,6 +751,70 @@ func (tsc *TestSessionCreate) createSpec() (*TestSession, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tsc.mutation.IntegrityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.BreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.TimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.RegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,13 +8,19 @@ import (
 	"fmt"
 	"math"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/predicate"
 	"template/internal/ent/test"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -31,7 +37,13 @@ type TestSessionQuery struct {
 	withUser               *UserQuery
 	withCourseSection      *CourseSectionQuery
 	withTest               *TestQuery
+	withGroupAssignment    *GroupTestAssignmentQuery
 	withTestSessionAnswers *TestSessionAnswerQuery
+	withIntegrityEvents    *TestSessionIntegrityEventQuery
+	withBreaks             *TestSessionBreakQuery
+	withTimeExtensions     *TestSessionTimeExtensionQuery
+	withRegrades           *TestSessionRegradeQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -134,6 +146,28 @@ func (tsq *TestSessionQuery) QueryTest() *TestQuery {
 	return query
 }
 
+// QueryGroupAssignment chains the current query on the "group_assignment" edge.
+func (tsq *TestSessionQuery) QueryGroupAssignment() *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, selector),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsession.GroupAssignmentTable, testsession.GroupAssignmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTestSessionAnswers chains the current query on the "test_session_answers" edge.
 func (tsq *TestSessionQuery) QueryTestSessionAnswers() *TestSessionAnswerQuery {
 	query := (&TestSessionAnswerClient{config: tsq.config}).Query()
@@ -156,6 +190,94 @@ func (tsq *TestSessionQuery) QueryTestSessionAnswers() *TestSessionAnswerQuery {
 	return query
 }
 
+// QueryIntegrityEvents chains the current query on the "integrity_events" edge.
+func (tsq *TestSessionQuery) QueryIntegrityEvents() *TestSessionIntegrityEventQuery {
+	query := (&TestSessionIntegrityEventClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, selector),
+			sqlgraph.To(testsessionintegrityevent.Table, testsessionintegrityevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.IntegrityEventsTable, testsession.IntegrityEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBreaks chains the current query on the "breaks" edge.
+func (tsq *TestSessionQuery) QueryBreaks() *TestSessionBreakQuery {
+	query := (&TestSessionBreakClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, selector),
+			sqlgraph.To(testsessionbreak.Table, testsessionbreak.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.BreaksTable, testsession.BreaksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTimeExtensions chains the current query on the "time_extensions" edge.
+func (tsq *TestSessionQuery) QueryTimeExtensions() *TestSessionTimeExtensionQuery {
+	query := (&TestSessionTimeExtensionClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, selector),
+			sqlgraph.To(testsessiontimeextension.Table, testsessiontimeextension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.TimeExtensionsTable, testsession.TimeExtensionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRegrades chains the current query on the "regrades" edge.
+func (tsq *TestSessionQuery) QueryRegrades() *TestSessionRegradeQuery {
+	query := (&TestSessionRegradeClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsession.Table, testsession.FieldID, selector),
+			sqlgraph.To(testsessionregrade.Table, testsessionregrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, testsession.RegradesTable, testsession.RegradesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TestSession entity from the query.
 // Returns a *NotFoundError when no TestSession was found.
 func (tsq *TestSessionQuery) First(ctx context.Context) (*TestSession, error) {
@@ -351,7 +473,12 @@ func (tsq *TestSessionQuery) Clone() *TestSessionQuery {
 		withUser:               tsq.withUser.Clone(),
 		withCourseSection:      tsq.withCourseSection.Clone(),
 		withTest:               tsq.withTest.Clone(),
+		withGroupAssignment:    tsq.withGroupAssignment.Clone(),
 		withTestSessionAnswers: tsq.withTestSessionAnswers.Clone(),
+		withIntegrityEvents:    tsq.withIntegrityEvents.Clone(),
+		withBreaks:             tsq.withBreaks.Clone(),
+		withTimeExtensions:     tsq.withTimeExtensions.Clone(),
+		withRegrades:           tsq.withRegrades.Clone(),
 		// clone intermediate query.
 		sql:  tsq.sql.Clone(),
 		path: tsq.path,
@@ -391,6 +518,17 @@ func (tsq *TestSessionQuery) WithTest(opts ...func(*TestQuery)) *TestSessionQuer
 	return tsq
 }
 
+// WithGroupAssignment tells the query-builder to eager-load the nodes that are connected to
+// the "group_assignment" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TestSessionQuery) WithGroupAssignment(opts ...func(*GroupTestAssignmentQuery)) *TestSessionQuery {
+	query := (&GroupTestAssignmentClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withGroupAssignment = query
+	return tsq
+}
+
 // WithTestSessionAnswers tells the query-builder to eager-load the nodes that are connected to
 // the "test_session_answers" edge. The optional arguments are used to configure the query builder of the edge.
 func (tsq *TestSessionQuery) WithTestSessionAnswers(opts ...func(*TestSessionAnswerQuery)) *TestSessionQuery {
@@ -402,6 +540,50 @@ func (tsq *TestSessionQuery) WithTestSessionAnswers(opts ...func(*TestSessionAns
 	return tsq
 }
 
+// WithIntegrityEvents tells the query-builder to eager-load the nodes that are connected to
+// the "integrity_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TestSessionQuery) WithIntegrityEvents(opts ...func(*TestSessionIntegrityEventQuery)) *TestSessionQuery {
+	query := (&TestSessionIntegrityEventClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withIntegrityEvents = query
+	return tsq
+}
+
+// WithBreaks tells the query-builder to eager-load the nodes that are connected to
+// the "breaks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TestSessionQuery) WithBreaks(opts ...func(*TestSessionBreakQuery)) *TestSessionQuery {
+	query := (&TestSessionBreakClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withBreaks = query
+	return tsq
+}
+
+// WithTimeExtensions tells the query-builder to eager-load the nodes that are connected to
+// the "time_extensions" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TestSessionQuery) WithTimeExtensions(opts ...func(*TestSessionTimeExtensionQuery)) *TestSessionQuery {
+	query := (&TestSessionTimeExtensionClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withTimeExtensions = query
+	return tsq
+}
+
+// WithRegrades tells the query-builder to eager-load the nodes that are connected to
+// the "regrades" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TestSessionQuery) WithRegrades(opts ...func(*TestSessionRegradeQuery)) *TestSessionQuery {
+	query := (&TestSessionRegradeClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withRegrades = query
+	return tsq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +662,16 @@ func (tsq *TestSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*TestSession{}
 		_spec       = tsq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [9]bool{
 			tsq.withUser != nil,
 			tsq.withCourseSection != nil,
 			tsq.withTest != nil,
+			tsq.withGroupAssignment != nil,
 			tsq.withTestSessionAnswers != nil,
+			tsq.withIntegrityEvents != nil,
+			tsq.withBreaks != nil,
+			tsq.withTimeExtensions != nil,
+			tsq.withRegrades != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -496,6 +683,9 @@ func (tsq *TestSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -523,6 +713,12 @@ func (tsq *TestSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := tsq.withGroupAssignment; query != nil {
+		if err := tsq.loadGroupAssignment(ctx, query, nodes, nil,
+			func(n *TestSession, e *GroupTestAssignment) { n.Edges.GroupAssignment = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tsq.withTestSessionAnswers; query != nil {
 		if err := tsq.loadTestSessionAnswers(ctx, query, nodes,
 			func(n *TestSession) { n.Edges.TestSessionAnswers = []*TestSessionAnswer{} },
@@ -532,6 +728,38 @@ func (tsq *TestSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := tsq.withIntegrityEvents; query != nil {
+		if err := tsq.loadIntegrityEvents(ctx, query, nodes,
+			func(n *TestSession) { n.Edges.IntegrityEvents = []*TestSessionIntegrityEvent{} },
+			func(n *TestSession, e *TestSessionIntegrityEvent) {
+				n.Edges.IntegrityEvents = append(n.Edges.IntegrityEvents, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withBreaks; query != nil {
+		if err := tsq.loadBreaks(ctx, query, nodes,
+			func(n *TestSession) { n.Edges.Breaks = []*TestSessionBreak{} },
+			func(n *TestSession, e *TestSessionBreak) { n.Edges.Breaks = append(n.Edges.Breaks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withTimeExtensions; query != nil {
+		if err := tsq.loadTimeExtensions(ctx, query, nodes,
+			func(n *TestSession) { n.Edges.TimeExtensions = []*TestSessionTimeExtension{} },
+			func(n *TestSession, e *TestSessionTimeExtension) {
+				n.Edges.TimeExtensions = append(n.Edges.TimeExtensions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withRegrades; query != nil {
+		if err := tsq.loadRegrades(ctx, query, nodes,
+			func(n *TestSession) { n.Edges.Regrades = []*TestSessionRegrade{} },
+			func(n *TestSession, e *TestSessionRegrade) { n.Edges.Regrades = append(n.Edges.Regrades, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -628,6 +856,38 @@ func (tsq *TestSessionQuery) loadTest(ctx context.Context, query *TestQuery, nod
 	}
 	return nil
 }
+func (tsq *TestSessionQuery) loadGroupAssignment(ctx context.Context, query *GroupTestAssignmentQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *GroupTestAssignment)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSession)
+	for i := range nodes {
+		if nodes[i].GroupAssignmentID == nil {
+			continue
+		}
+		fk := *nodes[i].GroupAssignmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(grouptestassignment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_assignment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (tsq *TestSessionQuery) loadTestSessionAnswers(ctx context.Context, query *TestSessionAnswerQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *TestSessionAnswer)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*TestSession)
@@ -658,9 +918,132 @@ func (tsq *TestSessionQuery) loadTestSessionAnswers(ctx context.Context, query *
 	}
 	return nil
 }
+func (tsq *TestSessionQuery) loadIntegrityEvents(ctx context.Context, query *TestSessionIntegrityEventQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *TestSessionIntegrityEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TestSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessionintegrityevent.FieldSessionID)
+	}
+	query.Where(predicate.TestSessionIntegrityEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(testsession.IntegrityEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tsq *TestSessionQuery) loadBreaks(ctx context.Context, query *TestSessionBreakQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *TestSessionBreak)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TestSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessionbreak.FieldSessionID)
+	}
+	query.Where(predicate.TestSessionBreak(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(testsession.BreaksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tsq *TestSessionQuery) loadTimeExtensions(ctx context.Context, query *TestSessionTimeExtensionQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *TestSessionTimeExtension)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TestSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessiontimeextension.FieldSessionID)
+	}
+	query.Where(predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(testsession.TimeExtensionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tsq *TestSessionQuery) loadRegrades(ctx context.Context, query *TestSessionRegradeQuery, nodes []*TestSession, init func(*TestSession), assign func(*TestSession, *TestSessionRegrade)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TestSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessionregrade.FieldSessionID)
+	}
+	query.Where(predicate.TestSessionRegrade(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(testsession.RegradesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tsq *TestSessionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tsq.querySpec()
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
 	_spec.Node.Columns = tsq.ctx.Fields
 	if len(tsq.ctx.Fields) > 0 {
 		_spec.Unique = tsq.ctx.Unique != nil && *tsq.ctx.Unique
@@ -693,6 +1076,9 @@ func (tsq *TestSessionQuery) querySpec() *sqlgraph.QuerySpec {
 		if tsq.withTest != nil {
 			_spec.Node.AddColumnOnce(testsession.FieldTestID)
 		}
+		if tsq.withGroupAssignment != nil {
+			_spec.Node.AddColumnOnce(testsession.FieldGroupAssignmentID)
+		}
 	}
 	if ps := tsq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -732,6 +1118,9 @@ func (tsq *TestSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tsq.ctx.Unique != nil && *tsq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tsq.modifiers {
+		m(selector)
+	}
 	for _, p := range tsq.predicates {
 		p(selector)
 	}
@@ -749,6 +1138,32 @@ func (tsq *TestSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsq *TestSessionQuery) ForUpdate(opts ...sql.LockOption) *TestSessionQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsq *TestSessionQuery) ForShare(opts ...sql.LockOption) *TestSessionQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsq
+}
+
 // TestSessionGroupBy is the group-by builder for TestSession entities.
 type TestSessionGroupBy struct {
 	selector
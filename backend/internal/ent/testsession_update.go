@@ -7,10 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"template/internal/ent/coursesection"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/predicate"
 	"template/internal/ent/test"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
 	"time"
 
@@ -127,6 +132,26 @@ func (tsu *TestSessionUpdate) SetNillableTestID(u *uuid.UUID) *TestSessionUpdate
 	return tsu
 }
 
+// SetGroupAssignmentID sets the "group_assignment_id" field.
+func (tsu *TestSessionUpdate) SetGroupAssignmentID(u uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.SetGroupAssignmentID(u)
+	return tsu
+}
+
+// SetNillableGroupAssignmentID sets the "group_assignment_id" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableGroupAssignmentID(u *uuid.UUID) *TestSessionUpdate {
+	if u != nil {
+		tsu.SetGroupAssignmentID(*u)
+	}
+	return tsu
+}
+
+// ClearGroupAssignmentID clears the value of the "group_assignment_id" field.
+func (tsu *TestSessionUpdate) ClearGroupAssignmentID() *TestSessionUpdate {
+	tsu.mutation.ClearGroupAssignmentID()
+	return tsu
+}
+
 // SetStartedAt sets the "started_at" field.
 func (tsu *TestSessionUpdate) SetStartedAt(t time.Time) *TestSessionUpdate {
 	tsu.mutation.SetStartedAt(t)
@@ -187,6 +212,214 @@ func (tsu *TestSessionUpdate) ClearCompletedAt() *TestSessionUpdate {
 	return tsu
 }
 
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tsu *TestSessionUpdate) SetLastActivityAt(t time.Time) *TestSessionUpdate {
+	tsu.mutation.SetLastActivityAt(t)
+	return tsu
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableLastActivityAt(t *time.Time) *TestSessionUpdate {
+	if t != nil {
+		tsu.SetLastActivityAt(*t)
+	}
+	return tsu
+}
+
+// ClearLastActivityAt clears the value of the "last_activity_at" field.
+func (tsu *TestSessionUpdate) ClearLastActivityAt() *TestSessionUpdate {
+	tsu.mutation.ClearLastActivityAt()
+	return tsu
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (tsu *TestSessionUpdate) SetPausedAt(t time.Time) *TestSessionUpdate {
+	tsu.mutation.SetPausedAt(t)
+	return tsu
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillablePausedAt(t *time.Time) *TestSessionUpdate {
+	if t != nil {
+		tsu.SetPausedAt(*t)
+	}
+	return tsu
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (tsu *TestSessionUpdate) ClearPausedAt() *TestSessionUpdate {
+	tsu.mutation.ClearPausedAt()
+	return tsu
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (tsu *TestSessionUpdate) SetTimeMultiplier(f float64) *TestSessionUpdate {
+	tsu.mutation.ResetTimeMultiplier()
+	tsu.mutation.SetTimeMultiplier(f)
+	return tsu
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableTimeMultiplier(f *float64) *TestSessionUpdate {
+	if f != nil {
+		tsu.SetTimeMultiplier(*f)
+	}
+	return tsu
+}
+
+// AddTimeMultiplier adds f to the "time_multiplier" field.
+func (tsu *TestSessionUpdate) AddTimeMultiplier(f float64) *TestSessionUpdate {
+	tsu.mutation.AddTimeMultiplier(f)
+	return tsu
+}
+
+// ClearTimeMultiplier clears the value of the "time_multiplier" field.
+func (tsu *TestSessionUpdate) ClearTimeMultiplier() *TestSessionUpdate {
+	tsu.mutation.ClearTimeMultiplier()
+	return tsu
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (tsu *TestSessionUpdate) SetExtraMinutes(i int) *TestSessionUpdate {
+	tsu.mutation.ResetExtraMinutes()
+	tsu.mutation.SetExtraMinutes(i)
+	return tsu
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableExtraMinutes(i *int) *TestSessionUpdate {
+	if i != nil {
+		tsu.SetExtraMinutes(*i)
+	}
+	return tsu
+}
+
+// AddExtraMinutes adds i to the "extra_minutes" field.
+func (tsu *TestSessionUpdate) AddExtraMinutes(i int) *TestSessionUpdate {
+	tsu.mutation.AddExtraMinutes(i)
+	return tsu
+}
+
+// ClearExtraMinutes clears the value of the "extra_minutes" field.
+func (tsu *TestSessionUpdate) ClearExtraMinutes() *TestSessionUpdate {
+	tsu.mutation.ClearExtraMinutes()
+	return tsu
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (tsu *TestSessionUpdate) SetAllowRestBreaks(b bool) *TestSessionUpdate {
+	tsu.mutation.SetAllowRestBreaks(b)
+	return tsu
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableAllowRestBreaks(b *bool) *TestSessionUpdate {
+	if b != nil {
+		tsu.SetAllowRestBreaks(*b)
+	}
+	return tsu
+}
+
+// ClearAllowRestBreaks clears the value of the "allow_rest_breaks" field.
+func (tsu *TestSessionUpdate) ClearAllowRestBreaks() *TestSessionUpdate {
+	tsu.mutation.ClearAllowRestBreaks()
+	return tsu
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (tsu *TestSessionUpdate) SetMaxRestBreaks(i int) *TestSessionUpdate {
+	tsu.mutation.ResetMaxRestBreaks()
+	tsu.mutation.SetMaxRestBreaks(i)
+	return tsu
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableMaxRestBreaks(i *int) *TestSessionUpdate {
+	if i != nil {
+		tsu.SetMaxRestBreaks(*i)
+	}
+	return tsu
+}
+
+// AddMaxRestBreaks adds i to the "max_rest_breaks" field.
+func (tsu *TestSessionUpdate) AddMaxRestBreaks(i int) *TestSessionUpdate {
+	tsu.mutation.AddMaxRestBreaks(i)
+	return tsu
+}
+
+// ClearMaxRestBreaks clears the value of the "max_rest_breaks" field.
+func (tsu *TestSessionUpdate) ClearMaxRestBreaks() *TestSessionUpdate {
+	tsu.mutation.ClearMaxRestBreaks()
+	return tsu
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (tsu *TestSessionUpdate) SetMaxRestBreakMinutes(i int) *TestSessionUpdate {
+	tsu.mutation.ResetMaxRestBreakMinutes()
+	tsu.mutation.SetMaxRestBreakMinutes(i)
+	return tsu
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableMaxRestBreakMinutes(i *int) *TestSessionUpdate {
+	if i != nil {
+		tsu.SetMaxRestBreakMinutes(*i)
+	}
+	return tsu
+}
+
+// AddMaxRestBreakMinutes adds i to the "max_rest_break_minutes" field.
+func (tsu *TestSessionUpdate) AddMaxRestBreakMinutes(i int) *TestSessionUpdate {
+	tsu.mutation.AddMaxRestBreakMinutes(i)
+	return tsu
+}
+
+// ClearMaxRestBreakMinutes clears the value of the "max_rest_break_minutes" field.
+func (tsu *TestSessionUpdate) ClearMaxRestBreakMinutes() *TestSessionUpdate {
+	tsu.mutation.ClearMaxRestBreakMinutes()
+	return tsu
+}
+
+// SetOpenAt sets the "open_at" field.
+func (tsu *TestSessionUpdate) SetOpenAt(t time.Time) *TestSessionUpdate {
+	tsu.mutation.SetOpenAt(t)
+	return tsu
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableOpenAt(t *time.Time) *TestSessionUpdate {
+	if t != nil {
+		tsu.SetOpenAt(*t)
+	}
+	return tsu
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (tsu *TestSessionUpdate) ClearOpenAt() *TestSessionUpdate {
+	tsu.mutation.ClearOpenAt()
+	return tsu
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tsu *TestSessionUpdate) SetCloseAt(t time.Time) *TestSessionUpdate {
+	tsu.mutation.SetCloseAt(t)
+	return tsu
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tsu *TestSessionUpdate) SetNillableCloseAt(t *time.Time) *TestSessionUpdate {
+	if t != nil {
+		tsu.SetCloseAt(*t)
+	}
+	return tsu
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (tsu *TestSessionUpdate) ClearCloseAt() *TestSessionUpdate {
+	tsu.mutation.ClearCloseAt()
+	return tsu
+}
+
 // SetMaxPoints sets the "max_points" field.
 func (tsu *TestSessionUpdate) SetMaxPoints(i int) *TestSessionUpdate {
 	tsu.mutation.ResetMaxPoints()
@@ -258,6 +491,11 @@ func (tsu *TestSessionUpdate) SetTest(t *Test) *TestSessionUpdate {
 	return tsu.SetTestID(t.ID)
 }
 
+// SetGroupAssignment sets the "group_assignment" edge to the GroupTestAssignment entity.
+func (tsu *TestSessionUpdate) SetGroupAssignment(g *GroupTestAssignment) *TestSessionUpdate {
+	return tsu.SetGroupAssignmentID(g.ID)
+}
+
 // AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
 func (tsu *TestSessionUpdate) AddTestSessionAnswerIDs(ids ...uuid.UUID) *TestSessionUpdate {
 	tsu.mutation.AddTestSessionAnswerIDs(ids...)
@@ -273,6 +511,66 @@ func (tsu *TestSessionUpdate) AddTestSessionAnswers(t ...*TestSessionAnswer) *Te
 	return tsu.AddTestSessionAnswerIDs(ids...)
 }
 
+// AddIntegrityEventIDs adds the "integrity_events" edge to the TestSessionIntegrityEvent entity by IDs.
+func (tsu *TestSessionUpdate) AddIntegrityEventIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.AddIntegrityEventIDs(ids...)
+	return tsu
+}
+
+// AddIntegrityEvents adds the "integrity_events" edges to the TestSessionIntegrityEvent entity.
+func (tsu *TestSessionUpdate) AddIntegrityEvents(t ...*TestSessionIntegrityEvent) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddIntegrityEventIDs(ids...)
+}
+
+// AddBreakIDs adds the "breaks" edge to the TestSessionBreak entity by IDs.
+func (tsu *TestSessionUpdate) AddBreakIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.AddBreakIDs(ids...)
+	return tsu
+}
+
+// AddBreaks adds the "breaks" edges to the TestSessionBreak entity.
+func (tsu *TestSessionUpdate) AddBreaks(t ...*TestSessionBreak) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddBreakIDs(ids...)
+}
+
+// AddTimeExtensionIDs adds the "time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (tsu *TestSessionUpdate) AddTimeExtensionIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.AddTimeExtensionIDs(ids...)
+	return tsu
+}
+
+// AddTimeExtensions adds the "time_extensions" edges to the TestSessionTimeExtension entity.
+func (tsu *TestSessionUpdate) AddTimeExtensions(t ...*TestSessionTimeExtension) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddTimeExtensionIDs(ids...)
+}
+
+// AddRegradeIDs adds the "regrades" edge to the TestSessionRegrade entity by IDs.
+func (tsu *TestSessionUpdate) AddRegradeIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.AddRegradeIDs(ids...)
+	return tsu
+}
+
+// AddRegrades adds the "regrades" edges to the TestSessionRegrade entity.
+func (tsu *TestSessionUpdate) AddRegrades(t ...*TestSessionRegrade) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddRegradeIDs(ids...)
+}
+
 // Mutation returns the TestSessionMutation object of the builder.
 func (tsu *TestSessionUpdate) Mutation() *TestSessionMutation {
 	return tsu.mutation
@@ -296,6 +594,12 @@ func (tsu *TestSessionUpdate) ClearTest() *TestSessionUpdate {
 	return tsu
 }
 
+// ClearGroupAssignment clears the "group_assignment" edge to the GroupTestAssignment entity.
+func (tsu *TestSessionUpdate) ClearGroupAssignment() *TestSessionUpdate {
+	tsu.mutation.ClearGroupAssignment()
+	return tsu
+}
+
 // ClearTestSessionAnswers clears all "test_session_answers" edges to the TestSessionAnswer entity.
 func (tsu *TestSessionUpdate) ClearTestSessionAnswers() *TestSessionUpdate {
 	tsu.mutation.ClearTestSessionAnswers()
@@ -317,6 +621,90 @@ func (tsu *TestSessionUpdate) RemoveTestSessionAnswers(t ...*TestSessionAnswer)
 	return tsu.RemoveTestSessionAnswerIDs(ids...)
 }
 
+// ClearIntegrityEvents clears all "integrity_events" edges to the TestSessionIntegrityEvent entity.
+func (tsu *TestSessionUpdate) ClearIntegrityEvents() *TestSessionUpdate {
+	tsu.mutation.ClearIntegrityEvents()
+	return tsu
+}
+
+// RemoveIntegrityEventIDs removes the "integrity_events" edge to TestSessionIntegrityEvent entities by IDs.
+func (tsu *TestSessionUpdate) RemoveIntegrityEventIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.RemoveIntegrityEventIDs(ids...)
+	return tsu
+}
+
+// RemoveIntegrityEvents removes "integrity_events" edges to TestSessionIntegrityEvent entities.
+func (tsu *TestSessionUpdate) RemoveIntegrityEvents(t ...*TestSessionIntegrityEvent) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveIntegrityEventIDs(ids...)
+}
+
+// ClearBreaks clears all "breaks" edges to the TestSessionBreak entity.
+func (tsu *TestSessionUpdate) ClearBreaks() *TestSessionUpdate {
+	tsu.mutation.ClearBreaks()
+	return tsu
+}
+
+// RemoveBreakIDs removes the "breaks" edge to TestSessionBreak entities by IDs.
+func (tsu *TestSessionUpdate) RemoveBreakIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.RemoveBreakIDs(ids...)
+	return tsu
+}
+
+// RemoveBreaks removes "breaks" edges to TestSessionBreak entities.
+func (tsu *TestSessionUpdate) RemoveBreaks(t ...*TestSessionBreak) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveBreakIDs(ids...)
+}
+
+// ClearTimeExtensions clears all "time_extensions" edges to the TestSessionTimeExtension entity.
+func (tsu *TestSessionUpdate) ClearTimeExtensions() *TestSessionUpdate {
+	tsu.mutation.ClearTimeExtensions()
+	return tsu
+}
+
+// RemoveTimeExtensionIDs removes the "time_extensions" edge to TestSessionTimeExtension entities by IDs.
+func (tsu *TestSessionUpdate) RemoveTimeExtensionIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.RemoveTimeExtensionIDs(ids...)
+	return tsu
+}
+
+// RemoveTimeExtensions removes "time_extensions" edges to TestSessionTimeExtension entities.
+func (tsu *TestSessionUpdate) RemoveTimeExtensions(t ...*TestSessionTimeExtension) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveTimeExtensionIDs(ids...)
+}
+
+// ClearRegrades clears all "regrades" edges to the TestSessionRegrade entity.
+func (tsu *TestSessionUpdate) ClearRegrades() *TestSessionUpdate {
+	tsu.mutation.ClearRegrades()
+	return tsu
+}
+
+// RemoveRegradeIDs removes the "regrades" edge to TestSessionRegrade entities by IDs.
+func (tsu *TestSessionUpdate) RemoveRegradeIDs(ids ...uuid.UUID) *TestSessionUpdate {
+	tsu.mutation.RemoveRegradeIDs(ids...)
+	return tsu
+}
+
+// RemoveRegrades removes "regrades" edges to TestSessionRegrade entities.
+func (tsu *TestSessionUpdate) RemoveRegrades(t ...*TestSessionRegrade) *TestSessionUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveRegradeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tsu *TestSessionUpdate) Save(ctx context.Context) (int, error) {
 	if err := tsu.defaults(); err != nil {
@@ -361,6 +749,26 @@ func (tsu *TestSessionUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (tsu *TestSessionUpdate) check() error {
+	if v, ok := tsu.mutation.TimeMultiplier(); ok {
+		if err := testsession.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "TestSession.time_multiplier": %w`, err)}
+		}
+	}
+	if v, ok := tsu.mutation.ExtraMinutes(); ok {
+		if err := testsession.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.extra_minutes": %w`, err)}
+		}
+	}
+	if v, ok := tsu.mutation.MaxRestBreaks(); ok {
+		if err := testsession.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := tsu.mutation.MaxRestBreakMinutes(); ok {
+		if err := testsession.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_break_minutes": %w`, err)}
+		}
+	}
 	if v, ok := tsu.mutation.Status(); ok {
 		if err := testsession.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TestSession.status": %w`, err)}
@@ -414,6 +822,72 @@ func (tsu *TestSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tsu.mutation.CompletedAtCleared() {
 		_spec.ClearField(testsession.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := tsu.mutation.LastActivityAt(); ok {
+		_spec.SetField(testsession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if tsu.mutation.LastActivityAtCleared() {
+		_spec.ClearField(testsession.FieldLastActivityAt, field.TypeTime)
+	}
+	if value, ok := tsu.mutation.PausedAt(); ok {
+		_spec.SetField(testsession.FieldPausedAt, field.TypeTime, value)
+	}
+	if tsu.mutation.PausedAtCleared() {
+		_spec.ClearField(testsession.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := tsu.mutation.TimeMultiplier(); ok {
+		_spec.SetField(testsession.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := tsu.mutation.AddedTimeMultiplier(); ok {
+		_spec.AddField(testsession.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if tsu.mutation.TimeMultiplierCleared() {
+		_spec.ClearField(testsession.FieldTimeMultiplier, field.TypeFloat64)
+	}
+	if value, ok := tsu.mutation.ExtraMinutes(); ok {
+		_spec.SetField(testsession.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.AddedExtraMinutes(); ok {
+		_spec.AddField(testsession.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if tsu.mutation.ExtraMinutesCleared() {
+		_spec.ClearField(testsession.FieldExtraMinutes, field.TypeInt)
+	}
+	if value, ok := tsu.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(testsession.FieldAllowRestBreaks, field.TypeBool, value)
+	}
+	if tsu.mutation.AllowRestBreaksCleared() {
+		_spec.ClearField(testsession.FieldAllowRestBreaks, field.TypeBool)
+	}
+	if value, ok := tsu.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.AddedMaxRestBreaks(); ok {
+		_spec.AddField(testsession.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if tsu.mutation.MaxRestBreaksCleared() {
+		_spec.ClearField(testsession.FieldMaxRestBreaks, field.TypeInt)
+	}
+	if value, ok := tsu.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.AddedMaxRestBreakMinutes(); ok {
+		_spec.AddField(testsession.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if tsu.mutation.MaxRestBreakMinutesCleared() {
+		_spec.ClearField(testsession.FieldMaxRestBreakMinutes, field.TypeInt)
+	}
+	if value, ok := tsu.mutation.OpenAt(); ok {
+		_spec.SetField(testsession.FieldOpenAt, field.TypeTime, value)
+	}
+	if tsu.mutation.OpenAtCleared() {
+		_spec.ClearField(testsession.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := tsu.mutation.CloseAt(); ok {
+		_spec.SetField(testsession.FieldCloseAt, field.TypeTime, value)
+	}
+	if tsu.mutation.CloseAtCleared() {
+		_spec.ClearField(testsession.FieldCloseAt, field.TypeTime)
+	}
 	if value, ok := tsu.mutation.MaxPoints(); ok {
 		_spec.SetField(testsession.FieldMaxPoints, field.TypeInt, value)
 	}
@@ -516,34 +990,63 @@ func (tsu *TestSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tsu.mutation.TestSessionAnswersCleared() {
+	if tsu.mutation.GroupAssignmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   testsession.TestSessionAnswersTable,
-			Columns: []string{testsession.TestSessionAnswersColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsession.GroupAssignmentTable,
+			Columns: []string{testsession.GroupAssignmentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tsu.mutation.RemovedTestSessionAnswersIDs(); len(nodes) > 0 && !tsu.mutation.TestSessionAnswersCleared() {
+	if nodes := tsu.mutation.GroupAssignmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   testsession.TestSessionAnswersTable,
-			Columns: []string{testsession.TestSessionAnswersColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsession.GroupAssignmentTable,
+			Columns: []string{testsession.GroupAssignmentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TestSessionAnswersTable,
+			Columns: []string{testsession.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedTestSessionAnswersIDs(); len(nodes) > 0 && !tsu.mutation.TestSessionAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TestSessionAnswersTable,
+			Columns: []string{testsession.TestSessionAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := tsu.mutation.TestSessionAnswersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -561,6 +1064,186 @@ func (tsu *TestSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tsu.mutation.IntegrityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedIntegrityEventsIDs(); len(nodes) > 0 && !tsu.mutation.IntegrityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.IntegrityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.BreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedBreaksIDs(); len(nodes) > 0 && !tsu.mutation.BreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.BreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.TimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedTimeExtensionsIDs(); len(nodes) > 0 && !tsu.mutation.TimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.TimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.RegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedRegradesIDs(); len(nodes) > 0 && !tsu.mutation.RegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{testsession.Label}
@@ -675,6 +1358,26 @@ func (tsuo *TestSessionUpdateOne) SetNillableTestID(u *uuid.UUID) *TestSessionUp
 	return tsuo
 }
 
+// SetGroupAssignmentID sets the "group_assignment_id" field.
+func (tsuo *TestSessionUpdateOne) SetGroupAssignmentID(u uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.SetGroupAssignmentID(u)
+	return tsuo
+}
+
+// SetNillableGroupAssignmentID sets the "group_assignment_id" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableGroupAssignmentID(u *uuid.UUID) *TestSessionUpdateOne {
+	if u != nil {
+		tsuo.SetGroupAssignmentID(*u)
+	}
+	return tsuo
+}
+
+// ClearGroupAssignmentID clears the value of the "group_assignment_id" field.
+func (tsuo *TestSessionUpdateOne) ClearGroupAssignmentID() *TestSessionUpdateOne {
+	tsuo.mutation.ClearGroupAssignmentID()
+	return tsuo
+}
+
 // SetStartedAt sets the "started_at" field.
 func (tsuo *TestSessionUpdateOne) SetStartedAt(t time.Time) *TestSessionUpdateOne {
 	tsuo.mutation.SetStartedAt(t)
@@ -735,6 +1438,214 @@ func (tsuo *TestSessionUpdateOne) ClearCompletedAt() *TestSessionUpdateOne {
 	return tsuo
 }
 
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tsuo *TestSessionUpdateOne) SetLastActivityAt(t time.Time) *TestSessionUpdateOne {
+	tsuo.mutation.SetLastActivityAt(t)
+	return tsuo
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableLastActivityAt(t *time.Time) *TestSessionUpdateOne {
+	if t != nil {
+		tsuo.SetLastActivityAt(*t)
+	}
+	return tsuo
+}
+
+// ClearLastActivityAt clears the value of the "last_activity_at" field.
+func (tsuo *TestSessionUpdateOne) ClearLastActivityAt() *TestSessionUpdateOne {
+	tsuo.mutation.ClearLastActivityAt()
+	return tsuo
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (tsuo *TestSessionUpdateOne) SetPausedAt(t time.Time) *TestSessionUpdateOne {
+	tsuo.mutation.SetPausedAt(t)
+	return tsuo
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillablePausedAt(t *time.Time) *TestSessionUpdateOne {
+	if t != nil {
+		tsuo.SetPausedAt(*t)
+	}
+	return tsuo
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (tsuo *TestSessionUpdateOne) ClearPausedAt() *TestSessionUpdateOne {
+	tsuo.mutation.ClearPausedAt()
+	return tsuo
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (tsuo *TestSessionUpdateOne) SetTimeMultiplier(f float64) *TestSessionUpdateOne {
+	tsuo.mutation.ResetTimeMultiplier()
+	tsuo.mutation.SetTimeMultiplier(f)
+	return tsuo
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableTimeMultiplier(f *float64) *TestSessionUpdateOne {
+	if f != nil {
+		tsuo.SetTimeMultiplier(*f)
+	}
+	return tsuo
+}
+
+// AddTimeMultiplier adds f to the "time_multiplier" field.
+func (tsuo *TestSessionUpdateOne) AddTimeMultiplier(f float64) *TestSessionUpdateOne {
+	tsuo.mutation.AddTimeMultiplier(f)
+	return tsuo
+}
+
+// ClearTimeMultiplier clears the value of the "time_multiplier" field.
+func (tsuo *TestSessionUpdateOne) ClearTimeMultiplier() *TestSessionUpdateOne {
+	tsuo.mutation.ClearTimeMultiplier()
+	return tsuo
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (tsuo *TestSessionUpdateOne) SetExtraMinutes(i int) *TestSessionUpdateOne {
+	tsuo.mutation.ResetExtraMinutes()
+	tsuo.mutation.SetExtraMinutes(i)
+	return tsuo
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableExtraMinutes(i *int) *TestSessionUpdateOne {
+	if i != nil {
+		tsuo.SetExtraMinutes(*i)
+	}
+	return tsuo
+}
+
+// AddExtraMinutes adds i to the "extra_minutes" field.
+func (tsuo *TestSessionUpdateOne) AddExtraMinutes(i int) *TestSessionUpdateOne {
+	tsuo.mutation.AddExtraMinutes(i)
+	return tsuo
+}
+
+// ClearExtraMinutes clears the value of the "extra_minutes" field.
+func (tsuo *TestSessionUpdateOne) ClearExtraMinutes() *TestSessionUpdateOne {
+	tsuo.mutation.ClearExtraMinutes()
+	return tsuo
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (tsuo *TestSessionUpdateOne) SetAllowRestBreaks(b bool) *TestSessionUpdateOne {
+	tsuo.mutation.SetAllowRestBreaks(b)
+	return tsuo
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableAllowRestBreaks(b *bool) *TestSessionUpdateOne {
+	if b != nil {
+		tsuo.SetAllowRestBreaks(*b)
+	}
+	return tsuo
+}
+
+// ClearAllowRestBreaks clears the value of the "allow_rest_breaks" field.
+func (tsuo *TestSessionUpdateOne) ClearAllowRestBreaks() *TestSessionUpdateOne {
+	tsuo.mutation.ClearAllowRestBreaks()
+	return tsuo
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (tsuo *TestSessionUpdateOne) SetMaxRestBreaks(i int) *TestSessionUpdateOne {
+	tsuo.mutation.ResetMaxRestBreaks()
+	tsuo.mutation.SetMaxRestBreaks(i)
+	return tsuo
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableMaxRestBreaks(i *int) *TestSessionUpdateOne {
+	if i != nil {
+		tsuo.SetMaxRestBreaks(*i)
+	}
+	return tsuo
+}
+
+// AddMaxRestBreaks adds i to the "max_rest_breaks" field.
+func (tsuo *TestSessionUpdateOne) AddMaxRestBreaks(i int) *TestSessionUpdateOne {
+	tsuo.mutation.AddMaxRestBreaks(i)
+	return tsuo
+}
+
+// ClearMaxRestBreaks clears the value of the "max_rest_breaks" field.
+func (tsuo *TestSessionUpdateOne) ClearMaxRestBreaks() *TestSessionUpdateOne {
+	tsuo.mutation.ClearMaxRestBreaks()
+	return tsuo
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (tsuo *TestSessionUpdateOne) SetMaxRestBreakMinutes(i int) *TestSessionUpdateOne {
+	tsuo.mutation.ResetMaxRestBreakMinutes()
+	tsuo.mutation.SetMaxRestBreakMinutes(i)
+	return tsuo
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableMaxRestBreakMinutes(i *int) *TestSessionUpdateOne {
+	if i != nil {
+		tsuo.SetMaxRestBreakMinutes(*i)
+	}
+	return tsuo
+}
+
+// AddMaxRestBreakMinutes adds i to the "max_rest_break_minutes" field.
+func (tsuo *TestSessionUpdateOne) AddMaxRestBreakMinutes(i int) *TestSessionUpdateOne {
+	tsuo.mutation.AddMaxRestBreakMinutes(i)
+	return tsuo
+}
+
+// ClearMaxRestBreakMinutes clears the value of the "max_rest_break_minutes" field.
+func (tsuo *TestSessionUpdateOne) ClearMaxRestBreakMinutes() *TestSessionUpdateOne {
+	tsuo.mutation.ClearMaxRestBreakMinutes()
+	return tsuo
+}
+
+// SetOpenAt sets the "open_at" field.
+func (tsuo *TestSessionUpdateOne) SetOpenAt(t time.Time) *TestSessionUpdateOne {
+	tsuo.mutation.SetOpenAt(t)
+	return tsuo
+}
+
+// SetNillableOpenAt sets the "open_at" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableOpenAt(t *time.Time) *TestSessionUpdateOne {
+	if t != nil {
+		tsuo.SetOpenAt(*t)
+	}
+	return tsuo
+}
+
+// ClearOpenAt clears the value of the "open_at" field.
+func (tsuo *TestSessionUpdateOne) ClearOpenAt() *TestSessionUpdateOne {
+	tsuo.mutation.ClearOpenAt()
+	return tsuo
+}
+
+// SetCloseAt sets the "close_at" field.
+func (tsuo *TestSessionUpdateOne) SetCloseAt(t time.Time) *TestSessionUpdateOne {
+	tsuo.mutation.SetCloseAt(t)
+	return tsuo
+}
+
+// SetNillableCloseAt sets the "close_at" field if the given value is not nil.
+func (tsuo *TestSessionUpdateOne) SetNillableCloseAt(t *time.Time) *TestSessionUpdateOne {
+	if t != nil {
+		tsuo.SetCloseAt(*t)
+	}
+	return tsuo
+}
+
+// ClearCloseAt clears the value of the "close_at" field.
+func (tsuo *TestSessionUpdateOne) ClearCloseAt() *TestSessionUpdateOne {
+	tsuo.mutation.ClearCloseAt()
+	return tsuo
+}
+
 // SetMaxPoints sets the "max_points" field.
 func (tsuo *TestSessionUpdateOne) SetMaxPoints(i int) *TestSessionUpdateOne {
 	tsuo.mutation.ResetMaxPoints()
@@ -796,29 +1707,94 @@ func (tsuo *TestSessionUpdateOne) SetUser(u *User) *TestSessionUpdateOne {
 	return tsuo.SetUserID(u.ID)
 }
 
-// SetCourseSection sets the "course_section" edge to the CourseSection entity.
-func (tsuo *TestSessionUpdateOne) SetCourseSection(c *CourseSection) *TestSessionUpdateOne {
-	return tsuo.SetCourseSectionID(c.ID)
+// SetCourseSection sets the "course_section" edge to the CourseSection entity.
+func (tsuo *TestSessionUpdateOne) SetCourseSection(c *CourseSection) *TestSessionUpdateOne {
+	return tsuo.SetCourseSectionID(c.ID)
+}
+
+// SetTest sets the "test" edge to the Test entity.
+func (tsuo *TestSessionUpdateOne) SetTest(t *Test) *TestSessionUpdateOne {
+	return tsuo.SetTestID(t.ID)
+}
+
+// SetGroupAssignment sets the "group_assignment" edge to the GroupTestAssignment entity.
+func (tsuo *TestSessionUpdateOne) SetGroupAssignment(g *GroupTestAssignment) *TestSessionUpdateOne {
+	return tsuo.SetGroupAssignmentID(g.ID)
+}
+
+// AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
+func (tsuo *TestSessionUpdateOne) AddTestSessionAnswerIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.AddTestSessionAnswerIDs(ids...)
+	return tsuo
+}
+
+// AddTestSessionAnswers adds the "test_session_answers" edges to the TestSessionAnswer entity.
+func (tsuo *TestSessionUpdateOne) AddTestSessionAnswers(t ...*TestSessionAnswer) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddTestSessionAnswerIDs(ids...)
+}
+
+// AddIntegrityEventIDs adds the "integrity_events" edge to the TestSessionIntegrityEvent entity by IDs.
+func (tsuo *TestSessionUpdateOne) AddIntegrityEventIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.AddIntegrityEventIDs(ids...)
+	return tsuo
+}
+
+// AddIntegrityEvents adds the "integrity_events" edges to the TestSessionIntegrityEvent entity.
+func (tsuo *TestSessionUpdateOne) AddIntegrityEvents(t ...*TestSessionIntegrityEvent) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddIntegrityEventIDs(ids...)
+}
+
+// AddBreakIDs adds the "breaks" edge to the TestSessionBreak entity by IDs.
+func (tsuo *TestSessionUpdateOne) AddBreakIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.AddBreakIDs(ids...)
+	return tsuo
+}
+
+// AddBreaks adds the "breaks" edges to the TestSessionBreak entity.
+func (tsuo *TestSessionUpdateOne) AddBreaks(t ...*TestSessionBreak) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddBreakIDs(ids...)
+}
+
+// AddTimeExtensionIDs adds the "time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (tsuo *TestSessionUpdateOne) AddTimeExtensionIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.AddTimeExtensionIDs(ids...)
+	return tsuo
 }
 
-// SetTest sets the "test" edge to the Test entity.
-func (tsuo *TestSessionUpdateOne) SetTest(t *Test) *TestSessionUpdateOne {
-	return tsuo.SetTestID(t.ID)
+// AddTimeExtensions adds the "time_extensions" edges to the TestSessionTimeExtension entity.
+func (tsuo *TestSessionUpdateOne) AddTimeExtensions(t ...*TestSessionTimeExtension) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddTimeExtensionIDs(ids...)
 }
 
-// AddTestSessionAnswerIDs adds the "test_session_answers" edge to the TestSessionAnswer entity by IDs.
-func (tsuo *TestSessionUpdateOne) AddTestSessionAnswerIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
-	tsuo.mutation.AddTestSessionAnswerIDs(ids...)
+// AddRegradeIDs adds the "regrades" edge to the TestSessionRegrade entity by IDs.
+func (tsuo *TestSessionUpdateOne) AddRegradeIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.AddRegradeIDs(ids...)
 	return tsuo
 }
 
-// AddTestSessionAnswers adds the "test_session_answers" edges to the TestSessionAnswer entity.
-func (tsuo *TestSessionUpdateOne) AddTestSessionAnswers(t ...*TestSessionAnswer) *TestSessionUpdateOne {
+// AddRegrades adds the "regrades" edges to the TestSessionRegrade entity.
+func (tsuo *TestSessionUpdateOne) AddRegrades(t ...*TestSessionRegrade) *TestSessionUpdateOne {
 	ids := make([]uuid.UUID, len(t))
 	for i := range t {
 		ids[i] = t[i].ID
 	}
-	return tsuo.AddTestSessionAnswerIDs(ids...)
+	return tsuo.AddRegradeIDs(ids...)
 }
 
 // Mutation returns the TestSessionMutation object of the builder.
@@ -844,6 +1820,12 @@ func (tsuo *TestSessionUpdateOne) ClearTest() *TestSessionUpdateOne {
 	return tsuo
 }
 
+// ClearGroupAssignment clears the "group_assignment" edge to the GroupTestAssignment entity.
+func (tsuo *TestSessionUpdateOne) ClearGroupAssignment() *TestSessionUpdateOne {
+	tsuo.mutation.ClearGroupAssignment()
+	return tsuo
+}
+
 // ClearTestSessionAnswers clears all "test_session_answers" edges to the TestSessionAnswer entity.
 func (tsuo *TestSessionUpdateOne) ClearTestSessionAnswers() *TestSessionUpdateOne {
 	tsuo.mutation.ClearTestSessionAnswers()
@@ -865,6 +1847,90 @@ func (tsuo *TestSessionUpdateOne) RemoveTestSessionAnswers(t ...*TestSessionAnsw
 	return tsuo.RemoveTestSessionAnswerIDs(ids...)
 }
 
+// ClearIntegrityEvents clears all "integrity_events" edges to the TestSessionIntegrityEvent entity.
+func (tsuo *TestSessionUpdateOne) ClearIntegrityEvents() *TestSessionUpdateOne {
+	tsuo.mutation.ClearIntegrityEvents()
+	return tsuo
+}
+
+// RemoveIntegrityEventIDs removes the "integrity_events" edge to TestSessionIntegrityEvent entities by IDs.
+func (tsuo *TestSessionUpdateOne) RemoveIntegrityEventIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.RemoveIntegrityEventIDs(ids...)
+	return tsuo
+}
+
+// RemoveIntegrityEvents removes "integrity_events" edges to TestSessionIntegrityEvent entities.
+func (tsuo *TestSessionUpdateOne) RemoveIntegrityEvents(t ...*TestSessionIntegrityEvent) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveIntegrityEventIDs(ids...)
+}
+
+// ClearBreaks clears all "breaks" edges to the TestSessionBreak entity.
+func (tsuo *TestSessionUpdateOne) ClearBreaks() *TestSessionUpdateOne {
+	tsuo.mutation.ClearBreaks()
+	return tsuo
+}
+
+// RemoveBreakIDs removes the "breaks" edge to TestSessionBreak entities by IDs.
+func (tsuo *TestSessionUpdateOne) RemoveBreakIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.RemoveBreakIDs(ids...)
+	return tsuo
+}
+
+// RemoveBreaks removes "breaks" edges to TestSessionBreak entities.
+func (tsuo *TestSessionUpdateOne) RemoveBreaks(t ...*TestSessionBreak) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveBreakIDs(ids...)
+}
+
+// ClearTimeExtensions clears all "time_extensions" edges to the TestSessionTimeExtension entity.
+func (tsuo *TestSessionUpdateOne) ClearTimeExtensions() *TestSessionUpdateOne {
+	tsuo.mutation.ClearTimeExtensions()
+	return tsuo
+}
+
+// RemoveTimeExtensionIDs removes the "time_extensions" edge to TestSessionTimeExtension entities by IDs.
+func (tsuo *TestSessionUpdateOne) RemoveTimeExtensionIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.RemoveTimeExtensionIDs(ids...)
+	return tsuo
+}
+
+// RemoveTimeExtensions removes "time_extensions" edges to TestSessionTimeExtension entities.
+func (tsuo *TestSessionUpdateOne) RemoveTimeExtensions(t ...*TestSessionTimeExtension) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveTimeExtensionIDs(ids...)
+}
+
+// ClearRegrades clears all "regrades" edges to the TestSessionRegrade entity.
+func (tsuo *TestSessionUpdateOne) ClearRegrades() *TestSessionUpdateOne {
+	tsuo.mutation.ClearRegrades()
+	return tsuo
+}
+
+// RemoveRegradeIDs removes the "regrades" edge to TestSessionRegrade entities by IDs.
+func (tsuo *TestSessionUpdateOne) RemoveRegradeIDs(ids ...uuid.UUID) *TestSessionUpdateOne {
+	tsuo.mutation.RemoveRegradeIDs(ids...)
+	return tsuo
+}
+
+// RemoveRegrades removes "regrades" edges to TestSessionRegrade entities.
+func (tsuo *TestSessionUpdateOne) RemoveRegrades(t ...*TestSessionRegrade) *TestSessionUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveRegradeIDs(ids...)
+}
+
 // Where appends a list predicates to the TestSessionUpdate builder.
 func (tsuo *TestSessionUpdateOne) Where(ps ...predicate.TestSession) *TestSessionUpdateOne {
 	tsuo.mutation.Where(ps...)
@@ -922,6 +1988,26 @@ func (tsuo *TestSessionUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (tsuo *TestSessionUpdateOne) check() error {
+	if v, ok := tsuo.mutation.TimeMultiplier(); ok {
+		if err := testsession.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "TestSession.time_multiplier": %w`, err)}
+		}
+	}
+	if v, ok := tsuo.mutation.ExtraMinutes(); ok {
+		if err := testsession.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.extra_minutes": %w`, err)}
+		}
+	}
+	if v, ok := tsuo.mutation.MaxRestBreaks(); ok {
+		if err := testsession.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := tsuo.mutation.MaxRestBreakMinutes(); ok {
+		if err := testsession.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "TestSession.max_rest_break_minutes": %w`, err)}
+		}
+	}
 	if v, ok := tsuo.mutation.Status(); ok {
 		if err := testsession.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TestSession.status": %w`, err)}
@@ -992,6 +2078,72 @@ func (tsuo *TestSessionUpdateOne) sqlSave(ctx context.Context) (_node *TestSessi
 	if tsuo.mutation.CompletedAtCleared() {
 		_spec.ClearField(testsession.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := tsuo.mutation.LastActivityAt(); ok {
+		_spec.SetField(testsession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.LastActivityAtCleared() {
+		_spec.ClearField(testsession.FieldLastActivityAt, field.TypeTime)
+	}
+	if value, ok := tsuo.mutation.PausedAt(); ok {
+		_spec.SetField(testsession.FieldPausedAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.PausedAtCleared() {
+		_spec.ClearField(testsession.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := tsuo.mutation.TimeMultiplier(); ok {
+		_spec.SetField(testsession.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := tsuo.mutation.AddedTimeMultiplier(); ok {
+		_spec.AddField(testsession.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if tsuo.mutation.TimeMultiplierCleared() {
+		_spec.ClearField(testsession.FieldTimeMultiplier, field.TypeFloat64)
+	}
+	if value, ok := tsuo.mutation.ExtraMinutes(); ok {
+		_spec.SetField(testsession.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.AddedExtraMinutes(); ok {
+		_spec.AddField(testsession.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if tsuo.mutation.ExtraMinutesCleared() {
+		_spec.ClearField(testsession.FieldExtraMinutes, field.TypeInt)
+	}
+	if value, ok := tsuo.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(testsession.FieldAllowRestBreaks, field.TypeBool, value)
+	}
+	if tsuo.mutation.AllowRestBreaksCleared() {
+		_spec.ClearField(testsession.FieldAllowRestBreaks, field.TypeBool)
+	}
+	if value, ok := tsuo.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.AddedMaxRestBreaks(); ok {
+		_spec.AddField(testsession.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if tsuo.mutation.MaxRestBreaksCleared() {
+		_spec.ClearField(testsession.FieldMaxRestBreaks, field.TypeInt)
+	}
+	if value, ok := tsuo.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(testsession.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.AddedMaxRestBreakMinutes(); ok {
+		_spec.AddField(testsession.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if tsuo.mutation.MaxRestBreakMinutesCleared() {
+		_spec.ClearField(testsession.FieldMaxRestBreakMinutes, field.TypeInt)
+	}
+	if value, ok := tsuo.mutation.OpenAt(); ok {
+		_spec.SetField(testsession.FieldOpenAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.OpenAtCleared() {
+		_spec.ClearField(testsession.FieldOpenAt, field.TypeTime)
+	}
+	if value, ok := tsuo.mutation.CloseAt(); ok {
+		_spec.SetField(testsession.FieldCloseAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.CloseAtCleared() {
+		_spec.ClearField(testsession.FieldCloseAt, field.TypeTime)
+	}
 	if value, ok := tsuo.mutation.MaxPoints(); ok {
 		_spec.SetField(testsession.FieldMaxPoints, field.TypeInt, value)
 	}
@@ -1094,6 +2246,35 @@ func (tsuo *TestSessionUpdateOne) sqlSave(ctx context.Context) (_node *TestSessi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tsuo.mutation.GroupAssignmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsession.GroupAssignmentTable,
+			Columns: []string{testsession.GroupAssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.GroupAssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsession.GroupAssignmentTable,
+			Columns: []string{testsession.GroupAssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tsuo.mutation.TestSessionAnswersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1139,6 +2320,186 @@ func (tsuo *TestSessionUpdateOne) sqlSave(ctx context.Context) (_node *TestSessi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tsuo.mutation.IntegrityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedIntegrityEventsIDs(); len(nodes) > 0 && !tsuo.mutation.IntegrityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.IntegrityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.IntegrityEventsTable,
+			Columns: []string{testsession.IntegrityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.BreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedBreaksIDs(); len(nodes) > 0 && !tsuo.mutation.BreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.BreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.BreaksTable,
+			Columns: []string{testsession.BreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.TimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedTimeExtensionsIDs(); len(nodes) > 0 && !tsuo.mutation.TimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.TimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.TimeExtensionsTable,
+			Columns: []string{testsession.TimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.RegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedRegradesIDs(); len(nodes) > 0 && !tsuo.mutation.RegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   testsession.RegradesTable,
+			Columns: []string{testsession.RegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TestSession{config: tsuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
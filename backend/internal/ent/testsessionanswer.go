@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
 	"time"
@@ -31,6 +32,8 @@ type TestSessionAnswer struct {
 	QuestionID uuid.UUID `json:"question_id,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// Version of the question drawn when the session started
+	QuestionVersionID *uuid.UUID `json:"question_version_id,omitempty"`
 	// Points holds the value of the "points" field.
 	Points *int `json:"points,omitempty"`
 	// Order holds the value of the "order" field.
@@ -49,11 +52,13 @@ type TestSessionAnswer struct {
 type TestSessionAnswerEdges struct {
 	// Question holds the value of the question edge.
 	Question *Question `json:"question,omitempty"`
+	// QuestionVersion holds the value of the question_version edge.
+	QuestionVersion *QuestionVersion `json:"question_version,omitempty"`
 	// TestSession holds the value of the test_session edge.
 	TestSession *TestSession `json:"test_session,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // QuestionOrErr returns the Question value or an error if the edge
@@ -67,12 +72,23 @@ func (e TestSessionAnswerEdges) QuestionOrErr() (*Question, error) {
 	return nil, &NotLoadedError{edge: "question"}
 }
 
+// QuestionVersionOrErr returns the QuestionVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionAnswerEdges) QuestionVersionOrErr() (*QuestionVersion, error) {
+	if e.QuestionVersion != nil {
+		return e.QuestionVersion, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: questionversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "question_version"}
+}
+
 // TestSessionOrErr returns the TestSession value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TestSessionAnswerEdges) TestSessionOrErr() (*TestSession, error) {
 	if e.TestSession != nil {
 		return e.TestSession, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: testsession.Label}
 	}
 	return nil, &NotLoadedError{edge: "test_session"}
@@ -83,6 +99,8 @@ func (*TestSessionAnswer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case testsessionanswer.FieldQuestionVersionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case testsessionanswer.FieldMetadata:
 			values[i] = new([]byte)
 		case testsessionanswer.FieldIsCorrect:
@@ -145,6 +163,13 @@ func (tsa *TestSessionAnswer) assignValues(columns []string, values []any) error
 			} else if value != nil {
 				tsa.SessionID = *value
 			}
+		case testsessionanswer.FieldQuestionVersionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_version_id", values[i])
+			} else if value.Valid {
+				tsa.QuestionVersionID = new(uuid.UUID)
+				*tsa.QuestionVersionID = *value.S.(*uuid.UUID)
+			}
 		case testsessionanswer.FieldPoints:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field points", values[i])
@@ -191,6 +216,11 @@ func (tsa *TestSessionAnswer) QueryQuestion() *QuestionQuery {
 	return NewTestSessionAnswerClient(tsa.config).QueryQuestion(tsa)
 }
 
+// QueryQuestionVersion queries the "question_version" edge of the TestSessionAnswer entity.
+func (tsa *TestSessionAnswer) QueryQuestionVersion() *QuestionVersionQuery {
+	return NewTestSessionAnswerClient(tsa.config).QueryQuestionVersion(tsa)
+}
+
 // QueryTestSession queries the "test_session" edge of the TestSessionAnswer entity.
 func (tsa *TestSessionAnswer) QueryTestSession() *TestSessionQuery {
 	return NewTestSessionAnswerClient(tsa.config).QueryTestSession(tsa)
@@ -236,6 +266,11 @@ func (tsa *TestSessionAnswer) String() string {
 	builder.WriteString("session_id=")
 	builder.WriteString(fmt.Sprintf("%v", tsa.SessionID))
 	builder.WriteString(", ")
+	if v := tsa.QuestionVersionID; v != nil {
+		builder.WriteString("question_version_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := tsa.Points; v != nil {
 		builder.WriteString("points=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -26,6 +26,8 @@ const (
 	FieldQuestionID = "question_id"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
+	// FieldQuestionVersionID holds the string denoting the question_version_id field in the database.
+	FieldQuestionVersionID = "question_version_id"
 	// FieldPoints holds the string denoting the points field in the database.
 	FieldPoints = "points"
 	// FieldOrder holds the string denoting the order field in the database.
@@ -36,6 +38,8 @@ const (
 	FieldMetadata = "metadata"
 	// EdgeQuestion holds the string denoting the question edge name in mutations.
 	EdgeQuestion = "question"
+	// EdgeQuestionVersion holds the string denoting the question_version edge name in mutations.
+	EdgeQuestionVersion = "question_version"
 	// EdgeTestSession holds the string denoting the test_session edge name in mutations.
 	EdgeTestSession = "test_session"
 	// Table holds the table name of the testsessionanswer in the database.
@@ -47,6 +51,13 @@ const (
 	QuestionInverseTable = "questions"
 	// QuestionColumn is the table column denoting the question relation/edge.
 	QuestionColumn = "question_id"
+	// QuestionVersionTable is the table that holds the question_version relation/edge.
+	QuestionVersionTable = "test_session_answers"
+	// QuestionVersionInverseTable is the table name for the QuestionVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "questionversion" package.
+	QuestionVersionInverseTable = "question_versions"
+	// QuestionVersionColumn is the table column denoting the question_version relation/edge.
+	QuestionVersionColumn = "question_version_id"
 	// TestSessionTable is the table that holds the test_session relation/edge.
 	TestSessionTable = "test_session_answers"
 	// TestSessionInverseTable is the table name for the TestSession entity.
@@ -64,6 +75,7 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldQuestionID,
 	FieldSessionID,
+	FieldQuestionVersionID,
 	FieldPoints,
 	FieldOrder,
 	FieldIsCorrect,
@@ -86,8 +98,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -135,6 +147,11 @@ func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
 }
 
+// ByQuestionVersionID orders the results by the question_version_id field.
+func ByQuestionVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionVersionID, opts...).ToFunc()
+}
+
 // ByPoints orders the results by the points field.
 func ByPoints(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPoints, opts...).ToFunc()
@@ -157,6 +174,13 @@ func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByQuestionVersionField orders the results by question_version field.
+func ByQuestionVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionVersionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByTestSessionField orders the results by test_session field.
 func ByTestSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -170,6 +194,13 @@ func newQuestionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
 	)
 }
+func newQuestionVersionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionVersionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionVersionTable, QuestionVersionColumn),
+	)
+}
 func newTestSessionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -81,6 +81,11 @@ func SessionID(v uuid.UUID) predicate.TestSessionAnswer {
 	return predicate.TestSessionAnswer(sql.FieldEQ(FieldSessionID, v))
 }
 
+// QuestionVersionID applies equality check predicate on the "question_version_id" field. It's identical to QuestionVersionIDEQ.
+func QuestionVersionID(v uuid.UUID) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldEQ(FieldQuestionVersionID, v))
+}
+
 // Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
 func Points(v int) predicate.TestSessionAnswer {
 	return predicate.TestSessionAnswer(sql.FieldEQ(FieldPoints, v))
@@ -266,6 +271,36 @@ func SessionIDNotIn(vs ...uuid.UUID) predicate.TestSessionAnswer {
 	return predicate.TestSessionAnswer(sql.FieldNotIn(FieldSessionID, vs...))
 }
 
+// QuestionVersionIDEQ applies the EQ predicate on the "question_version_id" field.
+func QuestionVersionIDEQ(v uuid.UUID) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldEQ(FieldQuestionVersionID, v))
+}
+
+// QuestionVersionIDNEQ applies the NEQ predicate on the "question_version_id" field.
+func QuestionVersionIDNEQ(v uuid.UUID) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldNEQ(FieldQuestionVersionID, v))
+}
+
+// QuestionVersionIDIn applies the In predicate on the "question_version_id" field.
+func QuestionVersionIDIn(vs ...uuid.UUID) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldIn(FieldQuestionVersionID, vs...))
+}
+
+// QuestionVersionIDNotIn applies the NotIn predicate on the "question_version_id" field.
+func QuestionVersionIDNotIn(vs ...uuid.UUID) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldNotIn(FieldQuestionVersionID, vs...))
+}
+
+// QuestionVersionIDIsNil applies the IsNil predicate on the "question_version_id" field.
+func QuestionVersionIDIsNil() predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldIsNull(FieldQuestionVersionID))
+}
+
+// QuestionVersionIDNotNil applies the NotNil predicate on the "question_version_id" field.
+func QuestionVersionIDNotNil() predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(sql.FieldNotNull(FieldQuestionVersionID))
+}
+
 // PointsEQ applies the EQ predicate on the "points" field.
 func PointsEQ(v int) predicate.TestSessionAnswer {
 	return predicate.TestSessionAnswer(sql.FieldEQ(FieldPoints, v))
@@ -409,6 +444,29 @@ func HasQuestionWith(preds ...predicate.Question) predicate.TestSessionAnswer {
 	})
 }
 
+// HasQuestionVersion applies the HasEdge predicate on the "question_version" edge.
+func HasQuestionVersion() predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionVersionTable, QuestionVersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionVersionWith applies the HasEdge predicate on the "question_version" edge with a given conditions (other predicates).
+func HasQuestionVersionWith(preds ...predicate.QuestionVersion) predicate.TestSessionAnswer {
+	return predicate.TestSessionAnswer(func(s *sql.Selector) {
+		step := newQuestionVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTestSession applies the HasEdge predicate on the "test_session" edge.
 func HasTestSession() predicate.TestSessionAnswer {
 	return predicate.TestSessionAnswer(func(s *sql.Selector) {
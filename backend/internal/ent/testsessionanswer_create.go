@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
 	"time"
@@ -77,6 +78,20 @@ func (tsac *TestSessionAnswerCreate) SetSessionID(u uuid.UUID) *TestSessionAnswe
 	return tsac
 }
 
+// SetQuestionVersionID sets the "question_version_id" field.
+func (tsac *TestSessionAnswerCreate) SetQuestionVersionID(u uuid.UUID) *TestSessionAnswerCreate {
+	tsac.mutation.SetQuestionVersionID(u)
+	return tsac
+}
+
+// SetNillableQuestionVersionID sets the "question_version_id" field if the given value is not nil.
+func (tsac *TestSessionAnswerCreate) SetNillableQuestionVersionID(u *uuid.UUID) *TestSessionAnswerCreate {
+	if u != nil {
+		tsac.SetQuestionVersionID(*u)
+	}
+	return tsac
+}
+
 // SetPoints sets the "points" field.
 func (tsac *TestSessionAnswerCreate) SetPoints(i int) *TestSessionAnswerCreate {
 	tsac.mutation.SetPoints(i)
@@ -144,6 +159,11 @@ func (tsac *TestSessionAnswerCreate) SetQuestion(q *Question) *TestSessionAnswer
 	return tsac.SetQuestionID(q.ID)
 }
 
+// SetQuestionVersion sets the "question_version" edge to the QuestionVersion entity.
+func (tsac *TestSessionAnswerCreate) SetQuestionVersion(q *QuestionVersion) *TestSessionAnswerCreate {
+	return tsac.SetQuestionVersionID(q.ID)
+}
+
 // SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
 func (tsac *TestSessionAnswerCreate) SetTestSessionID(id uuid.UUID) *TestSessionAnswerCreate {
 	tsac.mutation.SetTestSessionID(id)
@@ -328,6 +348,23 @@ func (tsac *TestSessionAnswerCreate) createSpec() (*TestSessionAnswer, *sqlgraph
 		_node.QuestionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tsac.mutation.QuestionVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionanswer.QuestionVersionTable,
+			Columns: []string{testsessionanswer.QuestionVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuestionVersionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tsac.mutation.TestSessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
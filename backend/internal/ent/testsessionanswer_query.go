@@ -8,10 +8,12 @@ import (
 	"math"
 	"template/internal/ent/predicate"
 	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -21,12 +23,14 @@ import (
 // TestSessionAnswerQuery is the builder for querying TestSessionAnswer entities.
 type TestSessionAnswerQuery struct {
 	config
-	ctx             *QueryContext
-	order           []testsessionanswer.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.TestSessionAnswer
-	withQuestion    *QuestionQuery
-	withTestSession *TestSessionQuery
+	ctx                 *QueryContext
+	order               []testsessionanswer.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.TestSessionAnswer
+	withQuestion        *QuestionQuery
+	withQuestionVersion *QuestionVersionQuery
+	withTestSession     *TestSessionQuery
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +89,28 @@ func (tsaq *TestSessionAnswerQuery) QueryQuestion() *QuestionQuery {
 	return query
 }
 
+// QueryQuestionVersion chains the current query on the "question_version" edge.
+func (tsaq *TestSessionAnswerQuery) QueryQuestionVersion() *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: tsaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionanswer.Table, testsessionanswer.FieldID, selector),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionanswer.QuestionVersionTable, testsessionanswer.QuestionVersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTestSession chains the current query on the "test_session" edge.
 func (tsaq *TestSessionAnswerQuery) QueryTestSession() *TestSessionQuery {
 	query := (&TestSessionClient{config: tsaq.config}).Query()
@@ -294,13 +320,14 @@ func (tsaq *TestSessionAnswerQuery) Clone() *TestSessionAnswerQuery {
 		return nil
 	}
 	return &TestSessionAnswerQuery{
-		config:          tsaq.config,
-		ctx:             tsaq.ctx.Clone(),
-		order:           append([]testsessionanswer.OrderOption{}, tsaq.order...),
-		inters:          append([]Interceptor{}, tsaq.inters...),
-		predicates:      append([]predicate.TestSessionAnswer{}, tsaq.predicates...),
-		withQuestion:    tsaq.withQuestion.Clone(),
-		withTestSession: tsaq.withTestSession.Clone(),
+		config:              tsaq.config,
+		ctx:                 tsaq.ctx.Clone(),
+		order:               append([]testsessionanswer.OrderOption{}, tsaq.order...),
+		inters:              append([]Interceptor{}, tsaq.inters...),
+		predicates:          append([]predicate.TestSessionAnswer{}, tsaq.predicates...),
+		withQuestion:        tsaq.withQuestion.Clone(),
+		withQuestionVersion: tsaq.withQuestionVersion.Clone(),
+		withTestSession:     tsaq.withTestSession.Clone(),
 		// clone intermediate query.
 		sql:  tsaq.sql.Clone(),
 		path: tsaq.path,
@@ -318,6 +345,17 @@ func (tsaq *TestSessionAnswerQuery) WithQuestion(opts ...func(*QuestionQuery)) *
 	return tsaq
 }
 
+// WithQuestionVersion tells the query-builder to eager-load the nodes that are connected to
+// the "question_version" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsaq *TestSessionAnswerQuery) WithQuestionVersion(opts ...func(*QuestionVersionQuery)) *TestSessionAnswerQuery {
+	query := (&QuestionVersionClient{config: tsaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsaq.withQuestionVersion = query
+	return tsaq
+}
+
 // WithTestSession tells the query-builder to eager-load the nodes that are connected to
 // the "test_session" edge. The optional arguments are used to configure the query builder of the edge.
 func (tsaq *TestSessionAnswerQuery) WithTestSession(opts ...func(*TestSessionQuery)) *TestSessionAnswerQuery {
@@ -407,8 +445,9 @@ func (tsaq *TestSessionAnswerQuery) sqlAll(ctx context.Context, hooks ...queryHo
 	var (
 		nodes       = []*TestSessionAnswer{}
 		_spec       = tsaq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			tsaq.withQuestion != nil,
+			tsaq.withQuestionVersion != nil,
 			tsaq.withTestSession != nil,
 		}
 	)
@@ -421,6 +460,9 @@ func (tsaq *TestSessionAnswerQuery) sqlAll(ctx context.Context, hooks ...queryHo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tsaq.modifiers) > 0 {
+		_spec.Modifiers = tsaq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -436,6 +478,12 @@ func (tsaq *TestSessionAnswerQuery) sqlAll(ctx context.Context, hooks ...queryHo
 			return nil, err
 		}
 	}
+	if query := tsaq.withQuestionVersion; query != nil {
+		if err := tsaq.loadQuestionVersion(ctx, query, nodes, nil,
+			func(n *TestSessionAnswer, e *QuestionVersion) { n.Edges.QuestionVersion = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tsaq.withTestSession; query != nil {
 		if err := tsaq.loadTestSession(ctx, query, nodes, nil,
 			func(n *TestSessionAnswer, e *TestSession) { n.Edges.TestSession = e }); err != nil {
@@ -474,6 +522,38 @@ func (tsaq *TestSessionAnswerQuery) loadQuestion(ctx context.Context, query *Que
 	}
 	return nil
 }
+func (tsaq *TestSessionAnswerQuery) loadQuestionVersion(ctx context.Context, query *QuestionVersionQuery, nodes []*TestSessionAnswer, init func(*TestSessionAnswer), assign func(*TestSessionAnswer, *QuestionVersion)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionAnswer)
+	for i := range nodes {
+		if nodes[i].QuestionVersionID == nil {
+			continue
+		}
+		fk := *nodes[i].QuestionVersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(questionversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "question_version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (tsaq *TestSessionAnswerQuery) loadTestSession(ctx context.Context, query *TestSessionQuery, nodes []*TestSessionAnswer, init func(*TestSessionAnswer), assign func(*TestSessionAnswer, *TestSession)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*TestSessionAnswer)
@@ -506,6 +586,9 @@ func (tsaq *TestSessionAnswerQuery) loadTestSession(ctx context.Context, query *
 
 func (tsaq *TestSessionAnswerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tsaq.querySpec()
+	if len(tsaq.modifiers) > 0 {
+		_spec.Modifiers = tsaq.modifiers
+	}
 	_spec.Node.Columns = tsaq.ctx.Fields
 	if len(tsaq.ctx.Fields) > 0 {
 		_spec.Unique = tsaq.ctx.Unique != nil && *tsaq.ctx.Unique
@@ -532,6 +615,9 @@ func (tsaq *TestSessionAnswerQuery) querySpec() *sqlgraph.QuerySpec {
 		if tsaq.withQuestion != nil {
 			_spec.Node.AddColumnOnce(testsessionanswer.FieldQuestionID)
 		}
+		if tsaq.withQuestionVersion != nil {
+			_spec.Node.AddColumnOnce(testsessionanswer.FieldQuestionVersionID)
+		}
 		if tsaq.withTestSession != nil {
 			_spec.Node.AddColumnOnce(testsessionanswer.FieldSessionID)
 		}
@@ -574,6 +660,9 @@ func (tsaq *TestSessionAnswerQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if tsaq.ctx.Unique != nil && *tsaq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tsaq.modifiers {
+		m(selector)
+	}
 	for _, p := range tsaq.predicates {
 		p(selector)
 	}
@@ -591,6 +680,32 @@ func (tsaq *TestSessionAnswerQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsaq *TestSessionAnswerQuery) ForUpdate(opts ...sql.LockOption) *TestSessionAnswerQuery {
+	if tsaq.driver.Dialect() == dialect.Postgres {
+		tsaq.Unique(false)
+	}
+	tsaq.modifiers = append(tsaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsaq *TestSessionAnswerQuery) ForShare(opts ...sql.LockOption) *TestSessionAnswerQuery {
+	if tsaq.driver.Dialect() == dialect.Postgres {
+		tsaq.Unique(false)
+	}
+	tsaq.modifiers = append(tsaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsaq
+}
+
 // TestSessionAnswerGroupBy is the group-by builder for TestSessionAnswer entities.
 type TestSessionAnswerGroupBy struct {
 	selector
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"template/internal/ent/predicate"
 	"template/internal/ent/question"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/testsession"
 	"template/internal/ent/testsessionanswer"
 	"time"
@@ -99,6 +100,26 @@ func (tsau *TestSessionAnswerUpdate) SetNillableSessionID(u *uuid.UUID) *TestSes
 	return tsau
 }
 
+// SetQuestionVersionID sets the "question_version_id" field.
+func (tsau *TestSessionAnswerUpdate) SetQuestionVersionID(u uuid.UUID) *TestSessionAnswerUpdate {
+	tsau.mutation.SetQuestionVersionID(u)
+	return tsau
+}
+
+// SetNillableQuestionVersionID sets the "question_version_id" field if the given value is not nil.
+func (tsau *TestSessionAnswerUpdate) SetNillableQuestionVersionID(u *uuid.UUID) *TestSessionAnswerUpdate {
+	if u != nil {
+		tsau.SetQuestionVersionID(*u)
+	}
+	return tsau
+}
+
+// ClearQuestionVersionID clears the value of the "question_version_id" field.
+func (tsau *TestSessionAnswerUpdate) ClearQuestionVersionID() *TestSessionAnswerUpdate {
+	tsau.mutation.ClearQuestionVersionID()
+	return tsau
+}
+
 // SetPoints sets the "points" field.
 func (tsau *TestSessionAnswerUpdate) SetPoints(i int) *TestSessionAnswerUpdate {
 	tsau.mutation.ResetPoints()
@@ -184,6 +205,11 @@ func (tsau *TestSessionAnswerUpdate) SetQuestion(q *Question) *TestSessionAnswer
 	return tsau.SetQuestionID(q.ID)
 }
 
+// SetQuestionVersion sets the "question_version" edge to the QuestionVersion entity.
+func (tsau *TestSessionAnswerUpdate) SetQuestionVersion(q *QuestionVersion) *TestSessionAnswerUpdate {
+	return tsau.SetQuestionVersionID(q.ID)
+}
+
 // SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
 func (tsau *TestSessionAnswerUpdate) SetTestSessionID(id uuid.UUID) *TestSessionAnswerUpdate {
 	tsau.mutation.SetTestSessionID(id)
@@ -206,6 +232,12 @@ func (tsau *TestSessionAnswerUpdate) ClearQuestion() *TestSessionAnswerUpdate {
 	return tsau
 }
 
+// ClearQuestionVersion clears the "question_version" edge to the QuestionVersion entity.
+func (tsau *TestSessionAnswerUpdate) ClearQuestionVersion() *TestSessionAnswerUpdate {
+	tsau.mutation.ClearQuestionVersion()
+	return tsau
+}
+
 // ClearTestSession clears the "test_session" edge to the TestSession entity.
 func (tsau *TestSessionAnswerUpdate) ClearTestSession() *TestSessionAnswerUpdate {
 	tsau.mutation.ClearTestSession()
@@ -350,6 +382,35 @@ func (tsau *TestSessionAnswerUpdate) sqlSave(ctx context.Context) (n int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tsau.mutation.QuestionVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionanswer.QuestionVersionTable,
+			Columns: []string{testsessionanswer.QuestionVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsau.mutation.QuestionVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionanswer.QuestionVersionTable,
+			Columns: []string{testsessionanswer.QuestionVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tsau.mutation.TestSessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -467,6 +528,26 @@ func (tsauo *TestSessionAnswerUpdateOne) SetNillableSessionID(u *uuid.UUID) *Tes
 	return tsauo
 }
 
+// SetQuestionVersionID sets the "question_version_id" field.
+func (tsauo *TestSessionAnswerUpdateOne) SetQuestionVersionID(u uuid.UUID) *TestSessionAnswerUpdateOne {
+	tsauo.mutation.SetQuestionVersionID(u)
+	return tsauo
+}
+
+// SetNillableQuestionVersionID sets the "question_version_id" field if the given value is not nil.
+func (tsauo *TestSessionAnswerUpdateOne) SetNillableQuestionVersionID(u *uuid.UUID) *TestSessionAnswerUpdateOne {
+	if u != nil {
+		tsauo.SetQuestionVersionID(*u)
+	}
+	return tsauo
+}
+
+// ClearQuestionVersionID clears the value of the "question_version_id" field.
+func (tsauo *TestSessionAnswerUpdateOne) ClearQuestionVersionID() *TestSessionAnswerUpdateOne {
+	tsauo.mutation.ClearQuestionVersionID()
+	return tsauo
+}
+
 // SetPoints sets the "points" field.
 func (tsauo *TestSessionAnswerUpdateOne) SetPoints(i int) *TestSessionAnswerUpdateOne {
 	tsauo.mutation.ResetPoints()
@@ -552,6 +633,11 @@ func (tsauo *TestSessionAnswerUpdateOne) SetQuestion(q *Question) *TestSessionAn
 	return tsauo.SetQuestionID(q.ID)
 }
 
+// SetQuestionVersion sets the "question_version" edge to the QuestionVersion entity.
+func (tsauo *TestSessionAnswerUpdateOne) SetQuestionVersion(q *QuestionVersion) *TestSessionAnswerUpdateOne {
+	return tsauo.SetQuestionVersionID(q.ID)
+}
+
 // SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
 func (tsauo *TestSessionAnswerUpdateOne) SetTestSessionID(id uuid.UUID) *TestSessionAnswerUpdateOne {
 	tsauo.mutation.SetTestSessionID(id)
@@ -574,6 +660,12 @@ func (tsauo *TestSessionAnswerUpdateOne) ClearQuestion() *TestSessionAnswerUpdat
 	return tsauo
 }
 
+// ClearQuestionVersion clears the "question_version" edge to the QuestionVersion entity.
+func (tsauo *TestSessionAnswerUpdateOne) ClearQuestionVersion() *TestSessionAnswerUpdateOne {
+	tsauo.mutation.ClearQuestionVersion()
+	return tsauo
+}
+
 // ClearTestSession clears the "test_session" edge to the TestSession entity.
 func (tsauo *TestSessionAnswerUpdateOne) ClearTestSession() *TestSessionAnswerUpdateOne {
 	tsauo.mutation.ClearTestSession()
@@ -748,6 +840,35 @@ func (tsauo *TestSessionAnswerUpdateOne) sqlSave(ctx context.Context) (_node *Te
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tsauo.mutation.QuestionVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionanswer.QuestionVersionTable,
+			Columns: []string{testsessionanswer.QuestionVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsauo.mutation.QuestionVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionanswer.QuestionVersionTable,
+			Columns: []string{testsessionanswer.QuestionVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tsauo.mutation.TestSessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
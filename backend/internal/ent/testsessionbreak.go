@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionbreak"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TestSessionBreak is the model entity for the TestSessionBreak schema.
+type TestSessionBreak struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestSessionBreakQuery when eager-loading is set.
+	Edges        TestSessionBreakEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TestSessionBreakEdges holds the relations/edges for other nodes in the graph.
+type TestSessionBreakEdges struct {
+	// TestSession holds the value of the test_session edge.
+	TestSession *TestSession `json:"test_session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TestSessionOrErr returns the TestSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionBreakEdges) TestSessionOrErr() (*TestSession, error) {
+	if e.TestSession != nil {
+		return e.TestSession, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: testsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "test_session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestSessionBreak) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testsessionbreak.FieldCreatedAt, testsessionbreak.FieldUpdatedAt, testsessionbreak.FieldDeletedAt, testsessionbreak.FieldStartedAt, testsessionbreak.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case testsessionbreak.FieldID, testsessionbreak.FieldSessionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestSessionBreak fields.
+func (tsb *TestSessionBreak) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testsessionbreak.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tsb.ID = *value
+			}
+		case testsessionbreak.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsb.CreatedAt = value.Time
+			}
+		case testsessionbreak.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tsb.UpdatedAt = value.Time
+			}
+		case testsessionbreak.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tsb.DeletedAt = new(time.Time)
+				*tsb.DeletedAt = value.Time
+			}
+		case testsessionbreak.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				tsb.SessionID = *value
+			}
+		case testsessionbreak.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				tsb.StartedAt = value.Time
+			}
+		case testsessionbreak.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				tsb.EndedAt = new(time.Time)
+				*tsb.EndedAt = value.Time
+			}
+		default:
+			tsb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestSessionBreak.
+// This includes values selected through modifiers, order, etc.
+func (tsb *TestSessionBreak) Value(name string) (ent.Value, error) {
+	return tsb.selectValues.Get(name)
+}
+
+// QueryTestSession queries the "test_session" edge of the TestSessionBreak entity.
+func (tsb *TestSessionBreak) QueryTestSession() *TestSessionQuery {
+	return NewTestSessionBreakClient(tsb.config).QueryTestSession(tsb)
+}
+
+// Update returns a builder for updating this TestSessionBreak.
+// Note that you need to call TestSessionBreak.Unwrap() before calling this method if this TestSessionBreak
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsb *TestSessionBreak) Update() *TestSessionBreakUpdateOne {
+	return NewTestSessionBreakClient(tsb.config).UpdateOne(tsb)
+}
+
+// Unwrap unwraps the TestSessionBreak entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsb *TestSessionBreak) Unwrap() *TestSessionBreak {
+	_tx, ok := tsb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestSessionBreak is not a transactional entity")
+	}
+	tsb.config.driver = _tx.drv
+	return tsb
+}
+
+// String implements the fmt.Stringer.
+func (tsb *TestSessionBreak) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestSessionBreak(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tsb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tsb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tsb.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsb.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(tsb.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tsb.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestSessionBreaks is a parsable slice of TestSessionBreak.
+type TestSessionBreaks []*TestSessionBreak
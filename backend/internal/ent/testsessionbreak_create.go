@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionbreak"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionBreakCreate is the builder for creating a TestSessionBreak entity.
+type TestSessionBreakCreate struct {
+	config
+	mutation *TestSessionBreakMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsbc *TestSessionBreakCreate) SetCreatedAt(t time.Time) *TestSessionBreakCreate {
+	tsbc.mutation.SetCreatedAt(t)
+	return tsbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsbc *TestSessionBreakCreate) SetNillableCreatedAt(t *time.Time) *TestSessionBreakCreate {
+	if t != nil {
+		tsbc.SetCreatedAt(*t)
+	}
+	return tsbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsbc *TestSessionBreakCreate) SetUpdatedAt(t time.Time) *TestSessionBreakCreate {
+	tsbc.mutation.SetUpdatedAt(t)
+	return tsbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsbc *TestSessionBreakCreate) SetNillableUpdatedAt(t *time.Time) *TestSessionBreakCreate {
+	if t != nil {
+		tsbc.SetUpdatedAt(*t)
+	}
+	return tsbc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsbc *TestSessionBreakCreate) SetDeletedAt(t time.Time) *TestSessionBreakCreate {
+	tsbc.mutation.SetDeletedAt(t)
+	return tsbc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsbc *TestSessionBreakCreate) SetNillableDeletedAt(t *time.Time) *TestSessionBreakCreate {
+	if t != nil {
+		tsbc.SetDeletedAt(*t)
+	}
+	return tsbc
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsbc *TestSessionBreakCreate) SetSessionID(u uuid.UUID) *TestSessionBreakCreate {
+	tsbc.mutation.SetSessionID(u)
+	return tsbc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tsbc *TestSessionBreakCreate) SetStartedAt(t time.Time) *TestSessionBreakCreate {
+	tsbc.mutation.SetStartedAt(t)
+	return tsbc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tsbc *TestSessionBreakCreate) SetEndedAt(t time.Time) *TestSessionBreakCreate {
+	tsbc.mutation.SetEndedAt(t)
+	return tsbc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tsbc *TestSessionBreakCreate) SetNillableEndedAt(t *time.Time) *TestSessionBreakCreate {
+	if t != nil {
+		tsbc.SetEndedAt(*t)
+	}
+	return tsbc
+}
+
+// SetID sets the "id" field.
+func (tsbc *TestSessionBreakCreate) SetID(u uuid.UUID) *TestSessionBreakCreate {
+	tsbc.mutation.SetID(u)
+	return tsbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsbc *TestSessionBreakCreate) SetNillableID(u *uuid.UUID) *TestSessionBreakCreate {
+	if u != nil {
+		tsbc.SetID(*u)
+	}
+	return tsbc
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsbc *TestSessionBreakCreate) SetTestSessionID(id uuid.UUID) *TestSessionBreakCreate {
+	tsbc.mutation.SetTestSessionID(id)
+	return tsbc
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsbc *TestSessionBreakCreate) SetTestSession(t *TestSession) *TestSessionBreakCreate {
+	return tsbc.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionBreakMutation object of the builder.
+func (tsbc *TestSessionBreakCreate) Mutation() *TestSessionBreakMutation {
+	return tsbc.mutation
+}
+
+// Save creates the TestSessionBreak in the database.
+func (tsbc *TestSessionBreakCreate) Save(ctx context.Context) (*TestSessionBreak, error) {
+	if err := tsbc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsbc.sqlSave, tsbc.mutation, tsbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsbc *TestSessionBreakCreate) SaveX(ctx context.Context) *TestSessionBreak {
+	v, err := tsbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsbc *TestSessionBreakCreate) Exec(ctx context.Context) error {
+	_, err := tsbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbc *TestSessionBreakCreate) ExecX(ctx context.Context) {
+	if err := tsbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsbc *TestSessionBreakCreate) defaults() error {
+	if _, ok := tsbc.mutation.CreatedAt(); !ok {
+		if testsessionbreak.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionbreak.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionbreak.DefaultCreatedAt()
+		tsbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsbc.mutation.UpdatedAt(); !ok {
+		if testsessionbreak.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionbreak.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionbreak.DefaultUpdatedAt()
+		tsbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsbc.mutation.ID(); !ok {
+		if testsessionbreak.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized testsessionbreak.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := testsessionbreak.DefaultID()
+		tsbc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsbc *TestSessionBreakCreate) check() error {
+	if _, ok := tsbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TestSessionBreak.created_at"`)}
+	}
+	if _, ok := tsbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TestSessionBreak.updated_at"`)}
+	}
+	if _, ok := tsbc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TestSessionBreak.session_id"`)}
+	}
+	if _, ok := tsbc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "TestSessionBreak.started_at"`)}
+	}
+	if len(tsbc.mutation.TestSessionIDs()) == 0 {
+		return &ValidationError{Name: "test_session", err: errors.New(`ent: missing required edge "TestSessionBreak.test_session"`)}
+	}
+	return nil
+}
+
+func (tsbc *TestSessionBreakCreate) sqlSave(ctx context.Context) (*TestSessionBreak, error) {
+	if err := tsbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsbc.mutation.id = &_node.ID
+	tsbc.mutation.done = true
+	return _node, nil
+}
+
+func (tsbc *TestSessionBreakCreate) createSpec() (*TestSessionBreak, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestSessionBreak{config: tsbc.config}
+		_spec = sqlgraph.NewCreateSpec(testsessionbreak.Table, sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID))
+	)
+	if id, ok := tsbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsbc.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tsbc.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tsbc.mutation.StartedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := tsbc.mutation.EndedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if nodes := tsbc.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionbreak.TestSessionTable,
+			Columns: []string{testsessionbreak.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TestSessionBreakCreateBulk is the builder for creating many TestSessionBreak entities in bulk.
+type TestSessionBreakCreateBulk struct {
+	config
+	err      error
+	builders []*TestSessionBreakCreate
+}
+
+// Save creates the TestSessionBreak entities in the database.
+func (tsbcb *TestSessionBreakCreateBulk) Save(ctx context.Context) ([]*TestSessionBreak, error) {
+	if tsbcb.err != nil {
+		return nil, tsbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsbcb.builders))
+	nodes := make([]*TestSessionBreak, len(tsbcb.builders))
+	mutators := make([]Mutator, len(tsbcb.builders))
+	for i := range tsbcb.builders {
+		func(i int, root context.Context) {
+			builder := tsbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestSessionBreakMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsbcb *TestSessionBreakCreateBulk) SaveX(ctx context.Context) []*TestSessionBreak {
+	v, err := tsbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsbcb *TestSessionBreakCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbcb *TestSessionBreakCreateBulk) ExecX(ctx context.Context) {
+	if err := tsbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsessionbreak"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TestSessionBreakDelete is the builder for deleting a TestSessionBreak entity.
+type TestSessionBreakDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionBreakMutation
+}
+
+// Where appends a list predicates to the TestSessionBreakDelete builder.
+func (tsbd *TestSessionBreakDelete) Where(ps ...predicate.TestSessionBreak) *TestSessionBreakDelete {
+	tsbd.mutation.Where(ps...)
+	return tsbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsbd *TestSessionBreakDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsbd.sqlExec, tsbd.mutation, tsbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbd *TestSessionBreakDelete) ExecX(ctx context.Context) int {
+	n, err := tsbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsbd *TestSessionBreakDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testsessionbreak.Table, sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID))
+	if ps := tsbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsbd.mutation.done = true
+	return affected, err
+}
+
+// TestSessionBreakDeleteOne is the builder for deleting a single TestSessionBreak entity.
+type TestSessionBreakDeleteOne struct {
+	tsbd *TestSessionBreakDelete
+}
+
+// Where appends a list predicates to the TestSessionBreakDelete builder.
+func (tsbdo *TestSessionBreakDeleteOne) Where(ps ...predicate.TestSessionBreak) *TestSessionBreakDeleteOne {
+	tsbdo.tsbd.mutation.Where(ps...)
+	return tsbdo
+}
+
+// Exec executes the deletion query.
+func (tsbdo *TestSessionBreakDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsbdo.tsbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testsessionbreak.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbdo *TestSessionBreakDeleteOne) ExecX(ctx context.Context) {
+	if err := tsbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
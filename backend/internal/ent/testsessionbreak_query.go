@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionbreak"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionBreakQuery is the builder for querying TestSessionBreak entities.
+type TestSessionBreakQuery struct {
+	config
+	ctx             *QueryContext
+	order           []testsessionbreak.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TestSessionBreak
+	withTestSession *TestSessionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestSessionBreakQuery builder.
+func (tsbq *TestSessionBreakQuery) Where(ps ...predicate.TestSessionBreak) *TestSessionBreakQuery {
+	tsbq.predicates = append(tsbq.predicates, ps...)
+	return tsbq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsbq *TestSessionBreakQuery) Limit(limit int) *TestSessionBreakQuery {
+	tsbq.ctx.Limit = &limit
+	return tsbq
+}
+
+// Offset to start from.
+func (tsbq *TestSessionBreakQuery) Offset(offset int) *TestSessionBreakQuery {
+	tsbq.ctx.Offset = &offset
+	return tsbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsbq *TestSessionBreakQuery) Unique(unique bool) *TestSessionBreakQuery {
+	tsbq.ctx.Unique = &unique
+	return tsbq
+}
+
+// Order specifies how the records should be ordered.
+func (tsbq *TestSessionBreakQuery) Order(o ...testsessionbreak.OrderOption) *TestSessionBreakQuery {
+	tsbq.order = append(tsbq.order, o...)
+	return tsbq
+}
+
+// QueryTestSession chains the current query on the "test_session" edge.
+func (tsbq *TestSessionBreakQuery) QueryTestSession() *TestSessionQuery {
+	query := (&TestSessionClient{config: tsbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionbreak.Table, testsessionbreak.FieldID, selector),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionbreak.TestSessionTable, testsessionbreak.TestSessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestSessionBreak entity from the query.
+// Returns a *NotFoundError when no TestSessionBreak was found.
+func (tsbq *TestSessionBreakQuery) First(ctx context.Context) (*TestSessionBreak, error) {
+	nodes, err := tsbq.Limit(1).All(setContextOp(ctx, tsbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testsessionbreak.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) FirstX(ctx context.Context) *TestSessionBreak {
+	node, err := tsbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestSessionBreak ID from the query.
+// Returns a *NotFoundError when no TestSessionBreak ID was found.
+func (tsbq *TestSessionBreakQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsbq.Limit(1).IDs(setContextOp(ctx, tsbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testsessionbreak.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestSessionBreak entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestSessionBreak entity is found.
+// Returns a *NotFoundError when no TestSessionBreak entities are found.
+func (tsbq *TestSessionBreakQuery) Only(ctx context.Context) (*TestSessionBreak, error) {
+	nodes, err := tsbq.Limit(2).All(setContextOp(ctx, tsbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testsessionbreak.Label}
+	default:
+		return nil, &NotSingularError{testsessionbreak.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) OnlyX(ctx context.Context) *TestSessionBreak {
+	node, err := tsbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestSessionBreak ID in the query.
+// Returns a *NotSingularError when more than one TestSessionBreak ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsbq *TestSessionBreakQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsbq.Limit(2).IDs(setContextOp(ctx, tsbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testsessionbreak.Label}
+	default:
+		err = &NotSingularError{testsessionbreak.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestSessionBreaks.
+func (tsbq *TestSessionBreakQuery) All(ctx context.Context) ([]*TestSessionBreak, error) {
+	ctx = setContextOp(ctx, tsbq.ctx, ent.OpQueryAll)
+	if err := tsbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestSessionBreak, *TestSessionBreakQuery]()
+	return withInterceptors[[]*TestSessionBreak](ctx, tsbq, qr, tsbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) AllX(ctx context.Context) []*TestSessionBreak {
+	nodes, err := tsbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestSessionBreak IDs.
+func (tsbq *TestSessionBreakQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsbq.ctx.Unique == nil && tsbq.path != nil {
+		tsbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsbq.ctx, ent.OpQueryIDs)
+	if err = tsbq.Select(testsessionbreak.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsbq *TestSessionBreakQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsbq.ctx, ent.OpQueryCount)
+	if err := tsbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsbq, querierCount[*TestSessionBreakQuery](), tsbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) CountX(ctx context.Context) int {
+	count, err := tsbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsbq *TestSessionBreakQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsbq.ctx, ent.OpQueryExist)
+	switch _, err := tsbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsbq *TestSessionBreakQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestSessionBreakQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsbq *TestSessionBreakQuery) Clone() *TestSessionBreakQuery {
+	if tsbq == nil {
+		return nil
+	}
+	return &TestSessionBreakQuery{
+		config:          tsbq.config,
+		ctx:             tsbq.ctx.Clone(),
+		order:           append([]testsessionbreak.OrderOption{}, tsbq.order...),
+		inters:          append([]Interceptor{}, tsbq.inters...),
+		predicates:      append([]predicate.TestSessionBreak{}, tsbq.predicates...),
+		withTestSession: tsbq.withTestSession.Clone(),
+		// clone intermediate query.
+		sql:  tsbq.sql.Clone(),
+		path: tsbq.path,
+	}
+}
+
+// WithTestSession tells the query-builder to eager-load the nodes that are connected to
+// the "test_session" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsbq *TestSessionBreakQuery) WithTestSession(opts ...func(*TestSessionQuery)) *TestSessionBreakQuery {
+	query := (&TestSessionClient{config: tsbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsbq.withTestSession = query
+	return tsbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestSessionBreak.Query().
+//		GroupBy(testsessionbreak.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsbq *TestSessionBreakQuery) GroupBy(field string, fields ...string) *TestSessionBreakGroupBy {
+	tsbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestSessionBreakGroupBy{build: tsbq}
+	grbuild.flds = &tsbq.ctx.Fields
+	grbuild.label = testsessionbreak.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TestSessionBreak.Query().
+//		Select(testsessionbreak.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tsbq *TestSessionBreakQuery) Select(fields ...string) *TestSessionBreakSelect {
+	tsbq.ctx.Fields = append(tsbq.ctx.Fields, fields...)
+	sbuild := &TestSessionBreakSelect{TestSessionBreakQuery: tsbq}
+	sbuild.label = testsessionbreak.Label
+	sbuild.flds, sbuild.scan = &tsbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestSessionBreakSelect configured with the given aggregations.
+func (tsbq *TestSessionBreakQuery) Aggregate(fns ...AggregateFunc) *TestSessionBreakSelect {
+	return tsbq.Select().Aggregate(fns...)
+}
+
+func (tsbq *TestSessionBreakQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsbq.ctx.Fields {
+		if !testsessionbreak.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsbq.path != nil {
+		prev, err := tsbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsbq.sql = prev
+	}
+	return nil
+}
+
+func (tsbq *TestSessionBreakQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestSessionBreak, error) {
+	var (
+		nodes       = []*TestSessionBreak{}
+		_spec       = tsbq.querySpec()
+		loadedTypes = [1]bool{
+			tsbq.withTestSession != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestSessionBreak).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestSessionBreak{config: tsbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsbq.modifiers) > 0 {
+		_spec.Modifiers = tsbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsbq.withTestSession; query != nil {
+		if err := tsbq.loadTestSession(ctx, query, nodes, nil,
+			func(n *TestSessionBreak, e *TestSession) { n.Edges.TestSession = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsbq *TestSessionBreakQuery) loadTestSession(ctx context.Context, query *TestSessionQuery, nodes []*TestSessionBreak, init func(*TestSessionBreak), assign func(*TestSessionBreak, *TestSession)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionBreak)
+	for i := range nodes {
+		fk := nodes[i].SessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(testsession.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsbq *TestSessionBreakQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsbq.querySpec()
+	if len(tsbq.modifiers) > 0 {
+		_spec.Modifiers = tsbq.modifiers
+	}
+	_spec.Node.Columns = tsbq.ctx.Fields
+	if len(tsbq.ctx.Fields) > 0 {
+		_spec.Unique = tsbq.ctx.Unique != nil && *tsbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsbq.driver, _spec)
+}
+
+func (tsbq *TestSessionBreakQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testsessionbreak.Table, testsessionbreak.Columns, sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID))
+	_spec.From = tsbq.sql
+	if unique := tsbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionbreak.FieldID)
+		for i := range fields {
+			if fields[i] != testsessionbreak.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsbq.withTestSession != nil {
+			_spec.Node.AddColumnOnce(testsessionbreak.FieldSessionID)
+		}
+	}
+	if ps := tsbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsbq *TestSessionBreakQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsbq.driver.Dialect())
+	t1 := builder.Table(testsessionbreak.Table)
+	columns := tsbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testsessionbreak.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsbq.sql != nil {
+		selector = tsbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsbq.ctx.Unique != nil && *tsbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsbq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsbq.predicates {
+		p(selector)
+	}
+	for _, p := range tsbq.order {
+		p(selector)
+	}
+	if offset := tsbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsbq *TestSessionBreakQuery) ForUpdate(opts ...sql.LockOption) *TestSessionBreakQuery {
+	if tsbq.driver.Dialect() == dialect.Postgres {
+		tsbq.Unique(false)
+	}
+	tsbq.modifiers = append(tsbq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsbq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsbq *TestSessionBreakQuery) ForShare(opts ...sql.LockOption) *TestSessionBreakQuery {
+	if tsbq.driver.Dialect() == dialect.Postgres {
+		tsbq.Unique(false)
+	}
+	tsbq.modifiers = append(tsbq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsbq
+}
+
+// TestSessionBreakGroupBy is the group-by builder for TestSessionBreak entities.
+type TestSessionBreakGroupBy struct {
+	selector
+	build *TestSessionBreakQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsbgb *TestSessionBreakGroupBy) Aggregate(fns ...AggregateFunc) *TestSessionBreakGroupBy {
+	tsbgb.fns = append(tsbgb.fns, fns...)
+	return tsbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsbgb *TestSessionBreakGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionBreakQuery, *TestSessionBreakGroupBy](ctx, tsbgb.build, tsbgb, tsbgb.build.inters, v)
+}
+
+func (tsbgb *TestSessionBreakGroupBy) sqlScan(ctx context.Context, root *TestSessionBreakQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsbgb.fns))
+	for _, fn := range tsbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsbgb.flds)+len(tsbgb.fns))
+		for _, f := range *tsbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestSessionBreakSelect is the builder for selecting fields of TestSessionBreak entities.
+type TestSessionBreakSelect struct {
+	*TestSessionBreakQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsbs *TestSessionBreakSelect) Aggregate(fns ...AggregateFunc) *TestSessionBreakSelect {
+	tsbs.fns = append(tsbs.fns, fns...)
+	return tsbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsbs *TestSessionBreakSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsbs.ctx, ent.OpQuerySelect)
+	if err := tsbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionBreakQuery, *TestSessionBreakSelect](ctx, tsbs.TestSessionBreakQuery, tsbs, tsbs.inters, v)
+}
+
+func (tsbs *TestSessionBreakSelect) sqlScan(ctx context.Context, root *TestSessionBreakQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsbs.fns))
+	for _, fn := range tsbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionbreak"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionBreakUpdate is the builder for updating TestSessionBreak entities.
+type TestSessionBreakUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionBreakMutation
+}
+
+// Where appends a list predicates to the TestSessionBreakUpdate builder.
+func (tsbu *TestSessionBreakUpdate) Where(ps ...predicate.TestSessionBreak) *TestSessionBreakUpdate {
+	tsbu.mutation.Where(ps...)
+	return tsbu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsbu *TestSessionBreakUpdate) SetCreatedAt(t time.Time) *TestSessionBreakUpdate {
+	tsbu.mutation.SetCreatedAt(t)
+	return tsbu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsbu *TestSessionBreakUpdate) SetNillableCreatedAt(t *time.Time) *TestSessionBreakUpdate {
+	if t != nil {
+		tsbu.SetCreatedAt(*t)
+	}
+	return tsbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsbu *TestSessionBreakUpdate) SetUpdatedAt(t time.Time) *TestSessionBreakUpdate {
+	tsbu.mutation.SetUpdatedAt(t)
+	return tsbu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsbu *TestSessionBreakUpdate) SetDeletedAt(t time.Time) *TestSessionBreakUpdate {
+	tsbu.mutation.SetDeletedAt(t)
+	return tsbu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsbu *TestSessionBreakUpdate) SetNillableDeletedAt(t *time.Time) *TestSessionBreakUpdate {
+	if t != nil {
+		tsbu.SetDeletedAt(*t)
+	}
+	return tsbu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsbu *TestSessionBreakUpdate) ClearDeletedAt() *TestSessionBreakUpdate {
+	tsbu.mutation.ClearDeletedAt()
+	return tsbu
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsbu *TestSessionBreakUpdate) SetSessionID(u uuid.UUID) *TestSessionBreakUpdate {
+	tsbu.mutation.SetSessionID(u)
+	return tsbu
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsbu *TestSessionBreakUpdate) SetNillableSessionID(u *uuid.UUID) *TestSessionBreakUpdate {
+	if u != nil {
+		tsbu.SetSessionID(*u)
+	}
+	return tsbu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tsbu *TestSessionBreakUpdate) SetStartedAt(t time.Time) *TestSessionBreakUpdate {
+	tsbu.mutation.SetStartedAt(t)
+	return tsbu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tsbu *TestSessionBreakUpdate) SetNillableStartedAt(t *time.Time) *TestSessionBreakUpdate {
+	if t != nil {
+		tsbu.SetStartedAt(*t)
+	}
+	return tsbu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tsbu *TestSessionBreakUpdate) SetEndedAt(t time.Time) *TestSessionBreakUpdate {
+	tsbu.mutation.SetEndedAt(t)
+	return tsbu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tsbu *TestSessionBreakUpdate) SetNillableEndedAt(t *time.Time) *TestSessionBreakUpdate {
+	if t != nil {
+		tsbu.SetEndedAt(*t)
+	}
+	return tsbu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (tsbu *TestSessionBreakUpdate) ClearEndedAt() *TestSessionBreakUpdate {
+	tsbu.mutation.ClearEndedAt()
+	return tsbu
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsbu *TestSessionBreakUpdate) SetTestSessionID(id uuid.UUID) *TestSessionBreakUpdate {
+	tsbu.mutation.SetTestSessionID(id)
+	return tsbu
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsbu *TestSessionBreakUpdate) SetTestSession(t *TestSession) *TestSessionBreakUpdate {
+	return tsbu.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionBreakMutation object of the builder.
+func (tsbu *TestSessionBreakUpdate) Mutation() *TestSessionBreakMutation {
+	return tsbu.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsbu *TestSessionBreakUpdate) ClearTestSession() *TestSessionBreakUpdate {
+	tsbu.mutation.ClearTestSession()
+	return tsbu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsbu *TestSessionBreakUpdate) Save(ctx context.Context) (int, error) {
+	if err := tsbu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tsbu.sqlSave, tsbu.mutation, tsbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsbu *TestSessionBreakUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsbu *TestSessionBreakUpdate) Exec(ctx context.Context) error {
+	_, err := tsbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbu *TestSessionBreakUpdate) ExecX(ctx context.Context) {
+	if err := tsbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsbu *TestSessionBreakUpdate) defaults() error {
+	if _, ok := tsbu.mutation.UpdatedAt(); !ok {
+		if testsessionbreak.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionbreak.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionbreak.UpdateDefaultUpdatedAt()
+		tsbu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsbu *TestSessionBreakUpdate) check() error {
+	if tsbu.mutation.TestSessionCleared() && len(tsbu.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionBreak.test_session"`)
+	}
+	return nil
+}
+
+func (tsbu *TestSessionBreakUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionbreak.Table, testsessionbreak.Columns, sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID))
+	if ps := tsbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsbu.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbu.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsbu.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionbreak.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsbu.mutation.StartedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbu.mutation.EndedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldEndedAt, field.TypeTime, value)
+	}
+	if tsbu.mutation.EndedAtCleared() {
+		_spec.ClearField(testsessionbreak.FieldEndedAt, field.TypeTime)
+	}
+	if tsbu.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionbreak.TestSessionTable,
+			Columns: []string{testsessionbreak.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsbu.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionbreak.TestSessionTable,
+			Columns: []string{testsessionbreak.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionbreak.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsbu.mutation.done = true
+	return n, nil
+}
+
+// TestSessionBreakUpdateOne is the builder for updating a single TestSessionBreak entity.
+type TestSessionBreakUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TestSessionBreakMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetCreatedAt(t time.Time) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetCreatedAt(t)
+	return tsbuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsbuo *TestSessionBreakUpdateOne) SetNillableCreatedAt(t *time.Time) *TestSessionBreakUpdateOne {
+	if t != nil {
+		tsbuo.SetCreatedAt(*t)
+	}
+	return tsbuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetUpdatedAt(t time.Time) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetUpdatedAt(t)
+	return tsbuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetDeletedAt(t time.Time) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetDeletedAt(t)
+	return tsbuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsbuo *TestSessionBreakUpdateOne) SetNillableDeletedAt(t *time.Time) *TestSessionBreakUpdateOne {
+	if t != nil {
+		tsbuo.SetDeletedAt(*t)
+	}
+	return tsbuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) ClearDeletedAt() *TestSessionBreakUpdateOne {
+	tsbuo.mutation.ClearDeletedAt()
+	return tsbuo
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetSessionID(u uuid.UUID) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetSessionID(u)
+	return tsbuo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsbuo *TestSessionBreakUpdateOne) SetNillableSessionID(u *uuid.UUID) *TestSessionBreakUpdateOne {
+	if u != nil {
+		tsbuo.SetSessionID(*u)
+	}
+	return tsbuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetStartedAt(t time.Time) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetStartedAt(t)
+	return tsbuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tsbuo *TestSessionBreakUpdateOne) SetNillableStartedAt(t *time.Time) *TestSessionBreakUpdateOne {
+	if t != nil {
+		tsbuo.SetStartedAt(*t)
+	}
+	return tsbuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) SetEndedAt(t time.Time) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetEndedAt(t)
+	return tsbuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tsbuo *TestSessionBreakUpdateOne) SetNillableEndedAt(t *time.Time) *TestSessionBreakUpdateOne {
+	if t != nil {
+		tsbuo.SetEndedAt(*t)
+	}
+	return tsbuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (tsbuo *TestSessionBreakUpdateOne) ClearEndedAt() *TestSessionBreakUpdateOne {
+	tsbuo.mutation.ClearEndedAt()
+	return tsbuo
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsbuo *TestSessionBreakUpdateOne) SetTestSessionID(id uuid.UUID) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.SetTestSessionID(id)
+	return tsbuo
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsbuo *TestSessionBreakUpdateOne) SetTestSession(t *TestSession) *TestSessionBreakUpdateOne {
+	return tsbuo.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionBreakMutation object of the builder.
+func (tsbuo *TestSessionBreakUpdateOne) Mutation() *TestSessionBreakMutation {
+	return tsbuo.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsbuo *TestSessionBreakUpdateOne) ClearTestSession() *TestSessionBreakUpdateOne {
+	tsbuo.mutation.ClearTestSession()
+	return tsbuo
+}
+
+// Where appends a list predicates to the TestSessionBreakUpdate builder.
+func (tsbuo *TestSessionBreakUpdateOne) Where(ps ...predicate.TestSessionBreak) *TestSessionBreakUpdateOne {
+	tsbuo.mutation.Where(ps...)
+	return tsbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsbuo *TestSessionBreakUpdateOne) Select(field string, fields ...string) *TestSessionBreakUpdateOne {
+	tsbuo.fields = append([]string{field}, fields...)
+	return tsbuo
+}
+
+// Save executes the query and returns the updated TestSessionBreak entity.
+func (tsbuo *TestSessionBreakUpdateOne) Save(ctx context.Context) (*TestSessionBreak, error) {
+	if err := tsbuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsbuo.sqlSave, tsbuo.mutation, tsbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsbuo *TestSessionBreakUpdateOne) SaveX(ctx context.Context) *TestSessionBreak {
+	node, err := tsbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsbuo *TestSessionBreakUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsbuo *TestSessionBreakUpdateOne) ExecX(ctx context.Context) {
+	if err := tsbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsbuo *TestSessionBreakUpdateOne) defaults() error {
+	if _, ok := tsbuo.mutation.UpdatedAt(); !ok {
+		if testsessionbreak.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionbreak.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionbreak.UpdateDefaultUpdatedAt()
+		tsbuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsbuo *TestSessionBreakUpdateOne) check() error {
+	if tsbuo.mutation.TestSessionCleared() && len(tsbuo.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionBreak.test_session"`)
+	}
+	return nil
+}
+
+func (tsbuo *TestSessionBreakUpdateOne) sqlSave(ctx context.Context) (_node *TestSessionBreak, err error) {
+	if err := tsbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionbreak.Table, testsessionbreak.Columns, sqlgraph.NewFieldSpec(testsessionbreak.FieldID, field.TypeUUID))
+	id, ok := tsbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestSessionBreak.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionbreak.FieldID)
+		for _, f := range fields {
+			if !testsessionbreak.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testsessionbreak.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsbuo.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbuo.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsbuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionbreak.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsbuo.mutation.StartedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := tsbuo.mutation.EndedAt(); ok {
+		_spec.SetField(testsessionbreak.FieldEndedAt, field.TypeTime, value)
+	}
+	if tsbuo.mutation.EndedAtCleared() {
+		_spec.ClearField(testsessionbreak.FieldEndedAt, field.TypeTime)
+	}
+	if tsbuo.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionbreak.TestSessionTable,
+			Columns: []string{testsessionbreak.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsbuo.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionbreak.TestSessionTable,
+			Columns: []string{testsessionbreak.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TestSessionBreak{config: tsbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionbreak.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsbuo.mutation.done = true
+	return _node, nil
+}
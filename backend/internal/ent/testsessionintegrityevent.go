@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionintegrityevent"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TestSessionIntegrityEvent is the model entity for the TestSessionIntegrityEvent schema.
+type TestSessionIntegrityEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType testsessionintegrityevent.EventType `json:"event_type,omitempty"`
+	// Details holds the value of the "details" field.
+	Details *string `json:"details,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestSessionIntegrityEventQuery when eager-loading is set.
+	Edges        TestSessionIntegrityEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TestSessionIntegrityEventEdges holds the relations/edges for other nodes in the graph.
+type TestSessionIntegrityEventEdges struct {
+	// TestSession holds the value of the test_session edge.
+	TestSession *TestSession `json:"test_session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TestSessionOrErr returns the TestSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionIntegrityEventEdges) TestSessionOrErr() (*TestSession, error) {
+	if e.TestSession != nil {
+		return e.TestSession, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: testsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "test_session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestSessionIntegrityEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testsessionintegrityevent.FieldEventType, testsessionintegrityevent.FieldDetails:
+			values[i] = new(sql.NullString)
+		case testsessionintegrityevent.FieldCreatedAt, testsessionintegrityevent.FieldUpdatedAt, testsessionintegrityevent.FieldDeletedAt, testsessionintegrityevent.FieldOccurredAt:
+			values[i] = new(sql.NullTime)
+		case testsessionintegrityevent.FieldID, testsessionintegrityevent.FieldSessionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestSessionIntegrityEvent fields.
+func (tsie *TestSessionIntegrityEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testsessionintegrityevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tsie.ID = *value
+			}
+		case testsessionintegrityevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsie.CreatedAt = value.Time
+			}
+		case testsessionintegrityevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tsie.UpdatedAt = value.Time
+			}
+		case testsessionintegrityevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tsie.DeletedAt = new(time.Time)
+				*tsie.DeletedAt = value.Time
+			}
+		case testsessionintegrityevent.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				tsie.SessionID = *value
+			}
+		case testsessionintegrityevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				tsie.EventType = testsessionintegrityevent.EventType(value.String)
+			}
+		case testsessionintegrityevent.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				tsie.Details = new(string)
+				*tsie.Details = value.String
+			}
+		case testsessionintegrityevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				tsie.OccurredAt = value.Time
+			}
+		default:
+			tsie.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestSessionIntegrityEvent.
+// This includes values selected through modifiers, order, etc.
+func (tsie *TestSessionIntegrityEvent) Value(name string) (ent.Value, error) {
+	return tsie.selectValues.Get(name)
+}
+
+// QueryTestSession queries the "test_session" edge of the TestSessionIntegrityEvent entity.
+func (tsie *TestSessionIntegrityEvent) QueryTestSession() *TestSessionQuery {
+	return NewTestSessionIntegrityEventClient(tsie.config).QueryTestSession(tsie)
+}
+
+// Update returns a builder for updating this TestSessionIntegrityEvent.
+// Note that you need to call TestSessionIntegrityEvent.Unwrap() before calling this method if this TestSessionIntegrityEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsie *TestSessionIntegrityEvent) Update() *TestSessionIntegrityEventUpdateOne {
+	return NewTestSessionIntegrityEventClient(tsie.config).UpdateOne(tsie)
+}
+
+// Unwrap unwraps the TestSessionIntegrityEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsie *TestSessionIntegrityEvent) Unwrap() *TestSessionIntegrityEvent {
+	_tx, ok := tsie.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestSessionIntegrityEvent is not a transactional entity")
+	}
+	tsie.config.driver = _tx.drv
+	return tsie
+}
+
+// String implements the fmt.Stringer.
+func (tsie *TestSessionIntegrityEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestSessionIntegrityEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsie.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tsie.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tsie.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tsie.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsie.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(fmt.Sprintf("%v", tsie.EventType))
+	builder.WriteString(", ")
+	if v := tsie.Details; v != nil {
+		builder.WriteString("details=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(tsie.OccurredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestSessionIntegrityEvents is a parsable slice of TestSessionIntegrityEvent.
+type TestSessionIntegrityEvents []*TestSessionIntegrityEvent
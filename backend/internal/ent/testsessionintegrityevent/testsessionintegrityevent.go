@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessionintegrityevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the testsessionintegrityevent type in the database.
+	Label = "test_session_integrity_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// EdgeTestSession holds the string denoting the test_session edge name in mutations.
+	EdgeTestSession = "test_session"
+	// Table holds the table name of the testsessionintegrityevent in the database.
+	Table = "test_session_integrity_events"
+	// TestSessionTable is the table that holds the test_session relation/edge.
+	TestSessionTable = "test_session_integrity_events"
+	// TestSessionInverseTable is the table name for the TestSession entity.
+	// It exists in this package in order to avoid circular dependency with the "testsession" package.
+	TestSessionInverseTable = "test_sessions"
+	// TestSessionColumn is the table column denoting the test_session relation/edge.
+	TestSessionColumn = "session_id"
+)
+
+// Columns holds all SQL columns for testsessionintegrityevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldSessionID,
+	FieldEventType,
+	FieldDetails,
+	FieldOccurredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// EventType defines the type for the "event_type" enum field.
+type EventType string
+
+// EventType values.
+const (
+	EventTypeTabSwitch      EventType = "tab_switch"
+	EventTypeFocusLost      EventType = "focus_lost"
+	EventTypeFullscreenExit EventType = "fullscreen_exit"
+	EventTypeCopyPaste      EventType = "copy_paste"
+	EventTypeOther          EventType = "other"
+)
+
+func (et EventType) String() string {
+	return string(et)
+}
+
+// EventTypeValidator is a validator for the "event_type" field enum values. It is called by the builders before save.
+func EventTypeValidator(et EventType) error {
+	switch et {
+	case EventTypeTabSwitch, EventTypeFocusLost, EventTypeFullscreenExit, EventTypeCopyPaste, EventTypeOther:
+		return nil
+	default:
+		return fmt.Errorf("testsessionintegrityevent: invalid enum value for event_type field: %q", et)
+	}
+}
+
+// OrderOption defines the ordering options for the TestSessionIntegrityEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByDetails orders the results by the details field.
+func ByDetails(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetails, opts...).ToFunc()
+}
+
+// ByOccurredAt orders the results by the occurred_at field.
+func ByOccurredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurredAt, opts...).ToFunc()
+}
+
+// ByTestSessionField orders the results by test_session field.
+func ByTestSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTestSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+	)
+}
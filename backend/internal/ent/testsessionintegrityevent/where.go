@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessionintegrityevent
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// Details applies equality check predicate on the "details" field. It's identical to DetailsEQ.
+func Details(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldDetails, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v EventType) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v EventType) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...EventType) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...EventType) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// DetailsEQ applies the EQ predicate on the "details" field.
+func DetailsEQ(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldDetails, v))
+}
+
+// DetailsNEQ applies the NEQ predicate on the "details" field.
+func DetailsNEQ(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldDetails, v))
+}
+
+// DetailsIn applies the In predicate on the "details" field.
+func DetailsIn(vs ...string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldDetails, vs...))
+}
+
+// DetailsNotIn applies the NotIn predicate on the "details" field.
+func DetailsNotIn(vs ...string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldDetails, vs...))
+}
+
+// DetailsGT applies the GT predicate on the "details" field.
+func DetailsGT(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldDetails, v))
+}
+
+// DetailsGTE applies the GTE predicate on the "details" field.
+func DetailsGTE(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldDetails, v))
+}
+
+// DetailsLT applies the LT predicate on the "details" field.
+func DetailsLT(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldDetails, v))
+}
+
+// DetailsLTE applies the LTE predicate on the "details" field.
+func DetailsLTE(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldDetails, v))
+}
+
+// DetailsContains applies the Contains predicate on the "details" field.
+func DetailsContains(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldContains(FieldDetails, v))
+}
+
+// DetailsHasPrefix applies the HasPrefix predicate on the "details" field.
+func DetailsHasPrefix(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldHasPrefix(FieldDetails, v))
+}
+
+// DetailsHasSuffix applies the HasSuffix predicate on the "details" field.
+func DetailsHasSuffix(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldHasSuffix(FieldDetails, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotNull(FieldDetails))
+}
+
+// DetailsEqualFold applies the EqualFold predicate on the "details" field.
+func DetailsEqualFold(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEqualFold(FieldDetails, v))
+}
+
+// DetailsContainsFold applies the ContainsFold predicate on the "details" field.
+func DetailsContainsFold(v string) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldContainsFold(FieldDetails, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// HasTestSession applies the HasEdge predicate on the "test_session" edge.
+func HasTestSession() predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestSessionWith applies the HasEdge predicate on the "test_session" edge with a given conditions (other predicates).
+func HasTestSessionWith(preds ...predicate.TestSession) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(func(s *sql.Selector) {
+		step := newTestSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TestSessionIntegrityEvent) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TestSessionIntegrityEvent) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TestSessionIntegrityEvent) predicate.TestSessionIntegrityEvent {
+	return predicate.TestSessionIntegrityEvent(sql.NotPredicates(p))
+}
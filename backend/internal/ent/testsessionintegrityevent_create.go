@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionintegrityevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionIntegrityEventCreate is the builder for creating a TestSessionIntegrityEvent entity.
+type TestSessionIntegrityEventCreate struct {
+	config
+	mutation *TestSessionIntegrityEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetCreatedAt(t time.Time) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetCreatedAt(t)
+	return tsiec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsiec *TestSessionIntegrityEventCreate) SetNillableCreatedAt(t *time.Time) *TestSessionIntegrityEventCreate {
+	if t != nil {
+		tsiec.SetCreatedAt(*t)
+	}
+	return tsiec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetUpdatedAt(t time.Time) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetUpdatedAt(t)
+	return tsiec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsiec *TestSessionIntegrityEventCreate) SetNillableUpdatedAt(t *time.Time) *TestSessionIntegrityEventCreate {
+	if t != nil {
+		tsiec.SetUpdatedAt(*t)
+	}
+	return tsiec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetDeletedAt(t time.Time) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetDeletedAt(t)
+	return tsiec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsiec *TestSessionIntegrityEventCreate) SetNillableDeletedAt(t *time.Time) *TestSessionIntegrityEventCreate {
+	if t != nil {
+		tsiec.SetDeletedAt(*t)
+	}
+	return tsiec
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetSessionID(u uuid.UUID) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetSessionID(u)
+	return tsiec
+}
+
+// SetEventType sets the "event_type" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetEventType(tt testsessionintegrityevent.EventType) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetEventType(tt)
+	return tsiec
+}
+
+// SetDetails sets the "details" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetDetails(s string) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetDetails(s)
+	return tsiec
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (tsiec *TestSessionIntegrityEventCreate) SetNillableDetails(s *string) *TestSessionIntegrityEventCreate {
+	if s != nil {
+		tsiec.SetDetails(*s)
+	}
+	return tsiec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetOccurredAt(t time.Time) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetOccurredAt(t)
+	return tsiec
+}
+
+// SetID sets the "id" field.
+func (tsiec *TestSessionIntegrityEventCreate) SetID(u uuid.UUID) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetID(u)
+	return tsiec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsiec *TestSessionIntegrityEventCreate) SetNillableID(u *uuid.UUID) *TestSessionIntegrityEventCreate {
+	if u != nil {
+		tsiec.SetID(*u)
+	}
+	return tsiec
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsiec *TestSessionIntegrityEventCreate) SetTestSessionID(id uuid.UUID) *TestSessionIntegrityEventCreate {
+	tsiec.mutation.SetTestSessionID(id)
+	return tsiec
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsiec *TestSessionIntegrityEventCreate) SetTestSession(t *TestSession) *TestSessionIntegrityEventCreate {
+	return tsiec.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionIntegrityEventMutation object of the builder.
+func (tsiec *TestSessionIntegrityEventCreate) Mutation() *TestSessionIntegrityEventMutation {
+	return tsiec.mutation
+}
+
+// Save creates the TestSessionIntegrityEvent in the database.
+func (tsiec *TestSessionIntegrityEventCreate) Save(ctx context.Context) (*TestSessionIntegrityEvent, error) {
+	if err := tsiec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsiec.sqlSave, tsiec.mutation, tsiec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsiec *TestSessionIntegrityEventCreate) SaveX(ctx context.Context) *TestSessionIntegrityEvent {
+	v, err := tsiec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsiec *TestSessionIntegrityEventCreate) Exec(ctx context.Context) error {
+	_, err := tsiec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsiec *TestSessionIntegrityEventCreate) ExecX(ctx context.Context) {
+	if err := tsiec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsiec *TestSessionIntegrityEventCreate) defaults() error {
+	if _, ok := tsiec.mutation.CreatedAt(); !ok {
+		if testsessionintegrityevent.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionintegrityevent.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionintegrityevent.DefaultCreatedAt()
+		tsiec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsiec.mutation.UpdatedAt(); !ok {
+		if testsessionintegrityevent.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionintegrityevent.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionintegrityevent.DefaultUpdatedAt()
+		tsiec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsiec.mutation.ID(); !ok {
+		if testsessionintegrityevent.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized testsessionintegrityevent.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := testsessionintegrityevent.DefaultID()
+		tsiec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsiec *TestSessionIntegrityEventCreate) check() error {
+	if _, ok := tsiec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TestSessionIntegrityEvent.created_at"`)}
+	}
+	if _, ok := tsiec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TestSessionIntegrityEvent.updated_at"`)}
+	}
+	if _, ok := tsiec.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TestSessionIntegrityEvent.session_id"`)}
+	}
+	if _, ok := tsiec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "TestSessionIntegrityEvent.event_type"`)}
+	}
+	if v, ok := tsiec.mutation.EventType(); ok {
+		if err := testsessionintegrityevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "TestSessionIntegrityEvent.event_type": %w`, err)}
+		}
+	}
+	if _, ok := tsiec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "TestSessionIntegrityEvent.occurred_at"`)}
+	}
+	if len(tsiec.mutation.TestSessionIDs()) == 0 {
+		return &ValidationError{Name: "test_session", err: errors.New(`ent: missing required edge "TestSessionIntegrityEvent.test_session"`)}
+	}
+	return nil
+}
+
+func (tsiec *TestSessionIntegrityEventCreate) sqlSave(ctx context.Context) (*TestSessionIntegrityEvent, error) {
+	if err := tsiec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsiec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsiec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsiec.mutation.id = &_node.ID
+	tsiec.mutation.done = true
+	return _node, nil
+}
+
+func (tsiec *TestSessionIntegrityEventCreate) createSpec() (*TestSessionIntegrityEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestSessionIntegrityEvent{config: tsiec.config}
+		_spec = sqlgraph.NewCreateSpec(testsessionintegrityevent.Table, sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := tsiec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsiec.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsiec.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tsiec.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tsiec.mutation.EventType(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldEventType, field.TypeEnum, value)
+		_node.EventType = value
+	}
+	if value, ok := tsiec.mutation.Details(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDetails, field.TypeString, value)
+		_node.Details = &value
+	}
+	if value, ok := tsiec.mutation.OccurredAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if nodes := tsiec.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionintegrityevent.TestSessionTable,
+			Columns: []string{testsessionintegrityevent.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TestSessionIntegrityEventCreateBulk is the builder for creating many TestSessionIntegrityEvent entities in bulk.
+type TestSessionIntegrityEventCreateBulk struct {
+	config
+	err      error
+	builders []*TestSessionIntegrityEventCreate
+}
+
+// Save creates the TestSessionIntegrityEvent entities in the database.
+func (tsiecb *TestSessionIntegrityEventCreateBulk) Save(ctx context.Context) ([]*TestSessionIntegrityEvent, error) {
+	if tsiecb.err != nil {
+		return nil, tsiecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsiecb.builders))
+	nodes := make([]*TestSessionIntegrityEvent, len(tsiecb.builders))
+	mutators := make([]Mutator, len(tsiecb.builders))
+	for i := range tsiecb.builders {
+		func(i int, root context.Context) {
+			builder := tsiecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestSessionIntegrityEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsiecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsiecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsiecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsiecb *TestSessionIntegrityEventCreateBulk) SaveX(ctx context.Context) []*TestSessionIntegrityEvent {
+	v, err := tsiecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsiecb *TestSessionIntegrityEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsiecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsiecb *TestSessionIntegrityEventCreateBulk) ExecX(ctx context.Context) {
+	if err := tsiecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsessionintegrityevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TestSessionIntegrityEventDelete is the builder for deleting a TestSessionIntegrityEvent entity.
+type TestSessionIntegrityEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionIntegrityEventMutation
+}
+
+// Where appends a list predicates to the TestSessionIntegrityEventDelete builder.
+func (tsied *TestSessionIntegrityEventDelete) Where(ps ...predicate.TestSessionIntegrityEvent) *TestSessionIntegrityEventDelete {
+	tsied.mutation.Where(ps...)
+	return tsied
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsied *TestSessionIntegrityEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsied.sqlExec, tsied.mutation, tsied.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsied *TestSessionIntegrityEventDelete) ExecX(ctx context.Context) int {
+	n, err := tsied.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsied *TestSessionIntegrityEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testsessionintegrityevent.Table, sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID))
+	if ps := tsied.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsied.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsied.mutation.done = true
+	return affected, err
+}
+
+// TestSessionIntegrityEventDeleteOne is the builder for deleting a single TestSessionIntegrityEvent entity.
+type TestSessionIntegrityEventDeleteOne struct {
+	tsied *TestSessionIntegrityEventDelete
+}
+
+// Where appends a list predicates to the TestSessionIntegrityEventDelete builder.
+func (tsiedo *TestSessionIntegrityEventDeleteOne) Where(ps ...predicate.TestSessionIntegrityEvent) *TestSessionIntegrityEventDeleteOne {
+	tsiedo.tsied.mutation.Where(ps...)
+	return tsiedo
+}
+
+// Exec executes the deletion query.
+func (tsiedo *TestSessionIntegrityEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsiedo.tsied.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testsessionintegrityevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsiedo *TestSessionIntegrityEventDeleteOne) ExecX(ctx context.Context) {
+	if err := tsiedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
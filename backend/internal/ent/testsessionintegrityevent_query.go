@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionintegrityevent"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionIntegrityEventQuery is the builder for querying TestSessionIntegrityEvent entities.
+type TestSessionIntegrityEventQuery struct {
+	config
+	ctx             *QueryContext
+	order           []testsessionintegrityevent.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TestSessionIntegrityEvent
+	withTestSession *TestSessionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestSessionIntegrityEventQuery builder.
+func (tsieq *TestSessionIntegrityEventQuery) Where(ps ...predicate.TestSessionIntegrityEvent) *TestSessionIntegrityEventQuery {
+	tsieq.predicates = append(tsieq.predicates, ps...)
+	return tsieq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsieq *TestSessionIntegrityEventQuery) Limit(limit int) *TestSessionIntegrityEventQuery {
+	tsieq.ctx.Limit = &limit
+	return tsieq
+}
+
+// Offset to start from.
+func (tsieq *TestSessionIntegrityEventQuery) Offset(offset int) *TestSessionIntegrityEventQuery {
+	tsieq.ctx.Offset = &offset
+	return tsieq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsieq *TestSessionIntegrityEventQuery) Unique(unique bool) *TestSessionIntegrityEventQuery {
+	tsieq.ctx.Unique = &unique
+	return tsieq
+}
+
+// Order specifies how the records should be ordered.
+func (tsieq *TestSessionIntegrityEventQuery) Order(o ...testsessionintegrityevent.OrderOption) *TestSessionIntegrityEventQuery {
+	tsieq.order = append(tsieq.order, o...)
+	return tsieq
+}
+
+// QueryTestSession chains the current query on the "test_session" edge.
+func (tsieq *TestSessionIntegrityEventQuery) QueryTestSession() *TestSessionQuery {
+	query := (&TestSessionClient{config: tsieq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsieq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsieq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionintegrityevent.Table, testsessionintegrityevent.FieldID, selector),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionintegrityevent.TestSessionTable, testsessionintegrityevent.TestSessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsieq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestSessionIntegrityEvent entity from the query.
+// Returns a *NotFoundError when no TestSessionIntegrityEvent was found.
+func (tsieq *TestSessionIntegrityEventQuery) First(ctx context.Context) (*TestSessionIntegrityEvent, error) {
+	nodes, err := tsieq.Limit(1).All(setContextOp(ctx, tsieq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testsessionintegrityevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) FirstX(ctx context.Context) *TestSessionIntegrityEvent {
+	node, err := tsieq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestSessionIntegrityEvent ID from the query.
+// Returns a *NotFoundError when no TestSessionIntegrityEvent ID was found.
+func (tsieq *TestSessionIntegrityEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsieq.Limit(1).IDs(setContextOp(ctx, tsieq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testsessionintegrityevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsieq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestSessionIntegrityEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestSessionIntegrityEvent entity is found.
+// Returns a *NotFoundError when no TestSessionIntegrityEvent entities are found.
+func (tsieq *TestSessionIntegrityEventQuery) Only(ctx context.Context) (*TestSessionIntegrityEvent, error) {
+	nodes, err := tsieq.Limit(2).All(setContextOp(ctx, tsieq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testsessionintegrityevent.Label}
+	default:
+		return nil, &NotSingularError{testsessionintegrityevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) OnlyX(ctx context.Context) *TestSessionIntegrityEvent {
+	node, err := tsieq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestSessionIntegrityEvent ID in the query.
+// Returns a *NotSingularError when more than one TestSessionIntegrityEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsieq *TestSessionIntegrityEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsieq.Limit(2).IDs(setContextOp(ctx, tsieq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testsessionintegrityevent.Label}
+	default:
+		err = &NotSingularError{testsessionintegrityevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsieq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestSessionIntegrityEvents.
+func (tsieq *TestSessionIntegrityEventQuery) All(ctx context.Context) ([]*TestSessionIntegrityEvent, error) {
+	ctx = setContextOp(ctx, tsieq.ctx, ent.OpQueryAll)
+	if err := tsieq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestSessionIntegrityEvent, *TestSessionIntegrityEventQuery]()
+	return withInterceptors[[]*TestSessionIntegrityEvent](ctx, tsieq, qr, tsieq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) AllX(ctx context.Context) []*TestSessionIntegrityEvent {
+	nodes, err := tsieq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestSessionIntegrityEvent IDs.
+func (tsieq *TestSessionIntegrityEventQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsieq.ctx.Unique == nil && tsieq.path != nil {
+		tsieq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsieq.ctx, ent.OpQueryIDs)
+	if err = tsieq.Select(testsessionintegrityevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsieq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsieq *TestSessionIntegrityEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsieq.ctx, ent.OpQueryCount)
+	if err := tsieq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsieq, querierCount[*TestSessionIntegrityEventQuery](), tsieq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) CountX(ctx context.Context) int {
+	count, err := tsieq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsieq *TestSessionIntegrityEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsieq.ctx, ent.OpQueryExist)
+	switch _, err := tsieq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsieq *TestSessionIntegrityEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsieq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestSessionIntegrityEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsieq *TestSessionIntegrityEventQuery) Clone() *TestSessionIntegrityEventQuery {
+	if tsieq == nil {
+		return nil
+	}
+	return &TestSessionIntegrityEventQuery{
+		config:          tsieq.config,
+		ctx:             tsieq.ctx.Clone(),
+		order:           append([]testsessionintegrityevent.OrderOption{}, tsieq.order...),
+		inters:          append([]Interceptor{}, tsieq.inters...),
+		predicates:      append([]predicate.TestSessionIntegrityEvent{}, tsieq.predicates...),
+		withTestSession: tsieq.withTestSession.Clone(),
+		// clone intermediate query.
+		sql:  tsieq.sql.Clone(),
+		path: tsieq.path,
+	}
+}
+
+// WithTestSession tells the query-builder to eager-load the nodes that are connected to
+// the "test_session" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsieq *TestSessionIntegrityEventQuery) WithTestSession(opts ...func(*TestSessionQuery)) *TestSessionIntegrityEventQuery {
+	query := (&TestSessionClient{config: tsieq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsieq.withTestSession = query
+	return tsieq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestSessionIntegrityEvent.Query().
+//		GroupBy(testsessionintegrityevent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsieq *TestSessionIntegrityEventQuery) GroupBy(field string, fields ...string) *TestSessionIntegrityEventGroupBy {
+	tsieq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestSessionIntegrityEventGroupBy{build: tsieq}
+	grbuild.flds = &tsieq.ctx.Fields
+	grbuild.label = testsessionintegrityevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TestSessionIntegrityEvent.Query().
+//		Select(testsessionintegrityevent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tsieq *TestSessionIntegrityEventQuery) Select(fields ...string) *TestSessionIntegrityEventSelect {
+	tsieq.ctx.Fields = append(tsieq.ctx.Fields, fields...)
+	sbuild := &TestSessionIntegrityEventSelect{TestSessionIntegrityEventQuery: tsieq}
+	sbuild.label = testsessionintegrityevent.Label
+	sbuild.flds, sbuild.scan = &tsieq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestSessionIntegrityEventSelect configured with the given aggregations.
+func (tsieq *TestSessionIntegrityEventQuery) Aggregate(fns ...AggregateFunc) *TestSessionIntegrityEventSelect {
+	return tsieq.Select().Aggregate(fns...)
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsieq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsieq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsieq.ctx.Fields {
+		if !testsessionintegrityevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsieq.path != nil {
+		prev, err := tsieq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsieq.sql = prev
+	}
+	return nil
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestSessionIntegrityEvent, error) {
+	var (
+		nodes       = []*TestSessionIntegrityEvent{}
+		_spec       = tsieq.querySpec()
+		loadedTypes = [1]bool{
+			tsieq.withTestSession != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestSessionIntegrityEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestSessionIntegrityEvent{config: tsieq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsieq.modifiers) > 0 {
+		_spec.Modifiers = tsieq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsieq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsieq.withTestSession; query != nil {
+		if err := tsieq.loadTestSession(ctx, query, nodes, nil,
+			func(n *TestSessionIntegrityEvent, e *TestSession) { n.Edges.TestSession = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) loadTestSession(ctx context.Context, query *TestSessionQuery, nodes []*TestSessionIntegrityEvent, init func(*TestSessionIntegrityEvent), assign func(*TestSessionIntegrityEvent, *TestSession)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionIntegrityEvent)
+	for i := range nodes {
+		fk := nodes[i].SessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(testsession.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsieq.querySpec()
+	if len(tsieq.modifiers) > 0 {
+		_spec.Modifiers = tsieq.modifiers
+	}
+	_spec.Node.Columns = tsieq.ctx.Fields
+	if len(tsieq.ctx.Fields) > 0 {
+		_spec.Unique = tsieq.ctx.Unique != nil && *tsieq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsieq.driver, _spec)
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testsessionintegrityevent.Table, testsessionintegrityevent.Columns, sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID))
+	_spec.From = tsieq.sql
+	if unique := tsieq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsieq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsieq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionintegrityevent.FieldID)
+		for i := range fields {
+			if fields[i] != testsessionintegrityevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsieq.withTestSession != nil {
+			_spec.Node.AddColumnOnce(testsessionintegrityevent.FieldSessionID)
+		}
+	}
+	if ps := tsieq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsieq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsieq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsieq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsieq *TestSessionIntegrityEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsieq.driver.Dialect())
+	t1 := builder.Table(testsessionintegrityevent.Table)
+	columns := tsieq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testsessionintegrityevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsieq.sql != nil {
+		selector = tsieq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsieq.ctx.Unique != nil && *tsieq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsieq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsieq.predicates {
+		p(selector)
+	}
+	for _, p := range tsieq.order {
+		p(selector)
+	}
+	if offset := tsieq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsieq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsieq *TestSessionIntegrityEventQuery) ForUpdate(opts ...sql.LockOption) *TestSessionIntegrityEventQuery {
+	if tsieq.driver.Dialect() == dialect.Postgres {
+		tsieq.Unique(false)
+	}
+	tsieq.modifiers = append(tsieq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsieq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsieq *TestSessionIntegrityEventQuery) ForShare(opts ...sql.LockOption) *TestSessionIntegrityEventQuery {
+	if tsieq.driver.Dialect() == dialect.Postgres {
+		tsieq.Unique(false)
+	}
+	tsieq.modifiers = append(tsieq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsieq
+}
+
+// TestSessionIntegrityEventGroupBy is the group-by builder for TestSessionIntegrityEvent entities.
+type TestSessionIntegrityEventGroupBy struct {
+	selector
+	build *TestSessionIntegrityEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsiegb *TestSessionIntegrityEventGroupBy) Aggregate(fns ...AggregateFunc) *TestSessionIntegrityEventGroupBy {
+	tsiegb.fns = append(tsiegb.fns, fns...)
+	return tsiegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsiegb *TestSessionIntegrityEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsiegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsiegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionIntegrityEventQuery, *TestSessionIntegrityEventGroupBy](ctx, tsiegb.build, tsiegb, tsiegb.build.inters, v)
+}
+
+func (tsiegb *TestSessionIntegrityEventGroupBy) sqlScan(ctx context.Context, root *TestSessionIntegrityEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsiegb.fns))
+	for _, fn := range tsiegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsiegb.flds)+len(tsiegb.fns))
+		for _, f := range *tsiegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsiegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsiegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestSessionIntegrityEventSelect is the builder for selecting fields of TestSessionIntegrityEvent entities.
+type TestSessionIntegrityEventSelect struct {
+	*TestSessionIntegrityEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsies *TestSessionIntegrityEventSelect) Aggregate(fns ...AggregateFunc) *TestSessionIntegrityEventSelect {
+	tsies.fns = append(tsies.fns, fns...)
+	return tsies
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsies *TestSessionIntegrityEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsies.ctx, ent.OpQuerySelect)
+	if err := tsies.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionIntegrityEventQuery, *TestSessionIntegrityEventSelect](ctx, tsies.TestSessionIntegrityEventQuery, tsies, tsies.inters, v)
+}
+
+func (tsies *TestSessionIntegrityEventSelect) sqlScan(ctx context.Context, root *TestSessionIntegrityEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsies.fns))
+	for _, fn := range tsies.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsies.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsies.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
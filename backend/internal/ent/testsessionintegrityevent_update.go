@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionintegrityevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionIntegrityEventUpdate is the builder for updating TestSessionIntegrityEvent entities.
+type TestSessionIntegrityEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionIntegrityEventMutation
+}
+
+// Where appends a list predicates to the TestSessionIntegrityEventUpdate builder.
+func (tsieu *TestSessionIntegrityEventUpdate) Where(ps ...predicate.TestSessionIntegrityEvent) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.Where(ps...)
+	return tsieu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetCreatedAt(t time.Time) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetCreatedAt(t)
+	return tsieu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableCreatedAt(t *time.Time) *TestSessionIntegrityEventUpdate {
+	if t != nil {
+		tsieu.SetCreatedAt(*t)
+	}
+	return tsieu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetUpdatedAt(t time.Time) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetUpdatedAt(t)
+	return tsieu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetDeletedAt(t time.Time) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetDeletedAt(t)
+	return tsieu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableDeletedAt(t *time.Time) *TestSessionIntegrityEventUpdate {
+	if t != nil {
+		tsieu.SetDeletedAt(*t)
+	}
+	return tsieu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsieu *TestSessionIntegrityEventUpdate) ClearDeletedAt() *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.ClearDeletedAt()
+	return tsieu
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetSessionID(u uuid.UUID) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetSessionID(u)
+	return tsieu
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableSessionID(u *uuid.UUID) *TestSessionIntegrityEventUpdate {
+	if u != nil {
+		tsieu.SetSessionID(*u)
+	}
+	return tsieu
+}
+
+// SetEventType sets the "event_type" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetEventType(tt testsessionintegrityevent.EventType) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetEventType(tt)
+	return tsieu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableEventType(tt *testsessionintegrityevent.EventType) *TestSessionIntegrityEventUpdate {
+	if tt != nil {
+		tsieu.SetEventType(*tt)
+	}
+	return tsieu
+}
+
+// SetDetails sets the "details" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetDetails(s string) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetDetails(s)
+	return tsieu
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableDetails(s *string) *TestSessionIntegrityEventUpdate {
+	if s != nil {
+		tsieu.SetDetails(*s)
+	}
+	return tsieu
+}
+
+// ClearDetails clears the value of the "details" field.
+func (tsieu *TestSessionIntegrityEventUpdate) ClearDetails() *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.ClearDetails()
+	return tsieu
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (tsieu *TestSessionIntegrityEventUpdate) SetOccurredAt(t time.Time) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetOccurredAt(t)
+	return tsieu
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (tsieu *TestSessionIntegrityEventUpdate) SetNillableOccurredAt(t *time.Time) *TestSessionIntegrityEventUpdate {
+	if t != nil {
+		tsieu.SetOccurredAt(*t)
+	}
+	return tsieu
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsieu *TestSessionIntegrityEventUpdate) SetTestSessionID(id uuid.UUID) *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.SetTestSessionID(id)
+	return tsieu
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsieu *TestSessionIntegrityEventUpdate) SetTestSession(t *TestSession) *TestSessionIntegrityEventUpdate {
+	return tsieu.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionIntegrityEventMutation object of the builder.
+func (tsieu *TestSessionIntegrityEventUpdate) Mutation() *TestSessionIntegrityEventMutation {
+	return tsieu.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsieu *TestSessionIntegrityEventUpdate) ClearTestSession() *TestSessionIntegrityEventUpdate {
+	tsieu.mutation.ClearTestSession()
+	return tsieu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsieu *TestSessionIntegrityEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := tsieu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tsieu.sqlSave, tsieu.mutation, tsieu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsieu *TestSessionIntegrityEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsieu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsieu *TestSessionIntegrityEventUpdate) Exec(ctx context.Context) error {
+	_, err := tsieu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsieu *TestSessionIntegrityEventUpdate) ExecX(ctx context.Context) {
+	if err := tsieu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsieu *TestSessionIntegrityEventUpdate) defaults() error {
+	if _, ok := tsieu.mutation.UpdatedAt(); !ok {
+		if testsessionintegrityevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionintegrityevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionintegrityevent.UpdateDefaultUpdatedAt()
+		tsieu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsieu *TestSessionIntegrityEventUpdate) check() error {
+	if v, ok := tsieu.mutation.EventType(); ok {
+		if err := testsessionintegrityevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "TestSessionIntegrityEvent.event_type": %w`, err)}
+		}
+	}
+	if tsieu.mutation.TestSessionCleared() && len(tsieu.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionIntegrityEvent.test_session"`)
+	}
+	return nil
+}
+
+func (tsieu *TestSessionIntegrityEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsieu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionintegrityevent.Table, testsessionintegrityevent.Columns, sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID))
+	if ps := tsieu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsieu.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsieu.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsieu.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsieu.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionintegrityevent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsieu.mutation.EventType(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := tsieu.mutation.Details(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDetails, field.TypeString, value)
+	}
+	if tsieu.mutation.DetailsCleared() {
+		_spec.ClearField(testsessionintegrityevent.FieldDetails, field.TypeString)
+	}
+	if value, ok := tsieu.mutation.OccurredAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if tsieu.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionintegrityevent.TestSessionTable,
+			Columns: []string{testsessionintegrityevent.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsieu.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionintegrityevent.TestSessionTable,
+			Columns: []string{testsessionintegrityevent.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsieu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionintegrityevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsieu.mutation.done = true
+	return n, nil
+}
+
+// TestSessionIntegrityEventUpdateOne is the builder for updating a single TestSessionIntegrityEvent entity.
+type TestSessionIntegrityEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TestSessionIntegrityEventMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetCreatedAt(t time.Time) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetCreatedAt(t)
+	return tsieuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableCreatedAt(t *time.Time) *TestSessionIntegrityEventUpdateOne {
+	if t != nil {
+		tsieuo.SetCreatedAt(*t)
+	}
+	return tsieuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetUpdatedAt(t time.Time) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetUpdatedAt(t)
+	return tsieuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetDeletedAt(t time.Time) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetDeletedAt(t)
+	return tsieuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableDeletedAt(t *time.Time) *TestSessionIntegrityEventUpdateOne {
+	if t != nil {
+		tsieuo.SetDeletedAt(*t)
+	}
+	return tsieuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) ClearDeletedAt() *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.ClearDeletedAt()
+	return tsieuo
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetSessionID(u uuid.UUID) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetSessionID(u)
+	return tsieuo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableSessionID(u *uuid.UUID) *TestSessionIntegrityEventUpdateOne {
+	if u != nil {
+		tsieuo.SetSessionID(*u)
+	}
+	return tsieuo
+}
+
+// SetEventType sets the "event_type" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetEventType(tt testsessionintegrityevent.EventType) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetEventType(tt)
+	return tsieuo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableEventType(tt *testsessionintegrityevent.EventType) *TestSessionIntegrityEventUpdateOne {
+	if tt != nil {
+		tsieuo.SetEventType(*tt)
+	}
+	return tsieuo
+}
+
+// SetDetails sets the "details" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetDetails(s string) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetDetails(s)
+	return tsieuo
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableDetails(s *string) *TestSessionIntegrityEventUpdateOne {
+	if s != nil {
+		tsieuo.SetDetails(*s)
+	}
+	return tsieuo
+}
+
+// ClearDetails clears the value of the "details" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) ClearDetails() *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.ClearDetails()
+	return tsieuo
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetOccurredAt(t time.Time) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetOccurredAt(t)
+	return tsieuo
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetNillableOccurredAt(t *time.Time) *TestSessionIntegrityEventUpdateOne {
+	if t != nil {
+		tsieuo.SetOccurredAt(*t)
+	}
+	return tsieuo
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetTestSessionID(id uuid.UUID) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.SetTestSessionID(id)
+	return tsieuo
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SetTestSession(t *TestSession) *TestSessionIntegrityEventUpdateOne {
+	return tsieuo.SetTestSessionID(t.ID)
+}
+
+// Mutation returns the TestSessionIntegrityEventMutation object of the builder.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) Mutation() *TestSessionIntegrityEventMutation {
+	return tsieuo.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) ClearTestSession() *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.ClearTestSession()
+	return tsieuo
+}
+
+// Where appends a list predicates to the TestSessionIntegrityEventUpdate builder.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) Where(ps ...predicate.TestSessionIntegrityEvent) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.mutation.Where(ps...)
+	return tsieuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) Select(field string, fields ...string) *TestSessionIntegrityEventUpdateOne {
+	tsieuo.fields = append([]string{field}, fields...)
+	return tsieuo
+}
+
+// Save executes the query and returns the updated TestSessionIntegrityEvent entity.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) Save(ctx context.Context) (*TestSessionIntegrityEvent, error) {
+	if err := tsieuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsieuo.sqlSave, tsieuo.mutation, tsieuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) SaveX(ctx context.Context) *TestSessionIntegrityEvent {
+	node, err := tsieuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsieuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) ExecX(ctx context.Context) {
+	if err := tsieuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) defaults() error {
+	if _, ok := tsieuo.mutation.UpdatedAt(); !ok {
+		if testsessionintegrityevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionintegrityevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionintegrityevent.UpdateDefaultUpdatedAt()
+		tsieuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsieuo *TestSessionIntegrityEventUpdateOne) check() error {
+	if v, ok := tsieuo.mutation.EventType(); ok {
+		if err := testsessionintegrityevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "TestSessionIntegrityEvent.event_type": %w`, err)}
+		}
+	}
+	if tsieuo.mutation.TestSessionCleared() && len(tsieuo.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionIntegrityEvent.test_session"`)
+	}
+	return nil
+}
+
+func (tsieuo *TestSessionIntegrityEventUpdateOne) sqlSave(ctx context.Context) (_node *TestSessionIntegrityEvent, err error) {
+	if err := tsieuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionintegrityevent.Table, testsessionintegrityevent.Columns, sqlgraph.NewFieldSpec(testsessionintegrityevent.FieldID, field.TypeUUID))
+	id, ok := tsieuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestSessionIntegrityEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsieuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionintegrityevent.FieldID)
+		for _, f := range fields {
+			if !testsessionintegrityevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testsessionintegrityevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsieuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsieuo.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsieuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsieuo.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsieuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionintegrityevent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsieuo.mutation.EventType(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := tsieuo.mutation.Details(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldDetails, field.TypeString, value)
+	}
+	if tsieuo.mutation.DetailsCleared() {
+		_spec.ClearField(testsessionintegrityevent.FieldDetails, field.TypeString)
+	}
+	if value, ok := tsieuo.mutation.OccurredAt(); ok {
+		_spec.SetField(testsessionintegrityevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if tsieuo.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionintegrityevent.TestSessionTable,
+			Columns: []string{testsessionintegrityevent.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsieuo.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionintegrityevent.TestSessionTable,
+			Columns: []string{testsessionintegrityevent.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TestSessionIntegrityEvent{config: tsieuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsieuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionintegrityevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsieuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TestSessionRegrade is the model entity for the TestSessionRegrade schema.
+type TestSessionRegrade struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// RegradedByID holds the value of the "regraded_by_id" field.
+	RegradedByID uuid.UUID `json:"regraded_by_id,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope testsessionregrade.Scope `json:"scope,omitempty"`
+	// Regraded question when the scope is question
+	QuestionID *uuid.UUID `json:"question_id,omitempty"`
+	// PointsBefore holds the value of the "points_before" field.
+	PointsBefore int `json:"points_before,omitempty"`
+	// PointsAfter holds the value of the "points_after" field.
+	PointsAfter int `json:"points_after,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestSessionRegradeQuery when eager-loading is set.
+	Edges        TestSessionRegradeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TestSessionRegradeEdges holds the relations/edges for other nodes in the graph.
+type TestSessionRegradeEdges struct {
+	// TestSession holds the value of the test_session edge.
+	TestSession *TestSession `json:"test_session,omitempty"`
+	// RegradedBy holds the value of the regraded_by edge.
+	RegradedBy *User `json:"regraded_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TestSessionOrErr returns the TestSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionRegradeEdges) TestSessionOrErr() (*TestSession, error) {
+	if e.TestSession != nil {
+		return e.TestSession, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: testsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "test_session"}
+}
+
+// RegradedByOrErr returns the RegradedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionRegradeEdges) RegradedByOrErr() (*User, error) {
+	if e.RegradedBy != nil {
+		return e.RegradedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "regraded_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestSessionRegrade) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testsessionregrade.FieldQuestionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case testsessionregrade.FieldPointsBefore, testsessionregrade.FieldPointsAfter:
+			values[i] = new(sql.NullInt64)
+		case testsessionregrade.FieldScope:
+			values[i] = new(sql.NullString)
+		case testsessionregrade.FieldCreatedAt, testsessionregrade.FieldUpdatedAt, testsessionregrade.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case testsessionregrade.FieldID, testsessionregrade.FieldSessionID, testsessionregrade.FieldRegradedByID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestSessionRegrade fields.
+func (tsr *TestSessionRegrade) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testsessionregrade.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tsr.ID = *value
+			}
+		case testsessionregrade.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsr.CreatedAt = value.Time
+			}
+		case testsessionregrade.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tsr.UpdatedAt = value.Time
+			}
+		case testsessionregrade.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tsr.DeletedAt = new(time.Time)
+				*tsr.DeletedAt = value.Time
+			}
+		case testsessionregrade.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				tsr.SessionID = *value
+			}
+		case testsessionregrade.FieldRegradedByID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field regraded_by_id", values[i])
+			} else if value != nil {
+				tsr.RegradedByID = *value
+			}
+		case testsessionregrade.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				tsr.Scope = testsessionregrade.Scope(value.String)
+			}
+		case testsessionregrade.FieldQuestionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value.Valid {
+				tsr.QuestionID = new(uuid.UUID)
+				*tsr.QuestionID = *value.S.(*uuid.UUID)
+			}
+		case testsessionregrade.FieldPointsBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points_before", values[i])
+			} else if value.Valid {
+				tsr.PointsBefore = int(value.Int64)
+			}
+		case testsessionregrade.FieldPointsAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points_after", values[i])
+			} else if value.Valid {
+				tsr.PointsAfter = int(value.Int64)
+			}
+		default:
+			tsr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestSessionRegrade.
+// This includes values selected through modifiers, order, etc.
+func (tsr *TestSessionRegrade) Value(name string) (ent.Value, error) {
+	return tsr.selectValues.Get(name)
+}
+
+// QueryTestSession queries the "test_session" edge of the TestSessionRegrade entity.
+func (tsr *TestSessionRegrade) QueryTestSession() *TestSessionQuery {
+	return NewTestSessionRegradeClient(tsr.config).QueryTestSession(tsr)
+}
+
+// QueryRegradedBy queries the "regraded_by" edge of the TestSessionRegrade entity.
+func (tsr *TestSessionRegrade) QueryRegradedBy() *UserQuery {
+	return NewTestSessionRegradeClient(tsr.config).QueryRegradedBy(tsr)
+}
+
+// Update returns a builder for updating this TestSessionRegrade.
+// Note that you need to call TestSessionRegrade.Unwrap() before calling this method if this TestSessionRegrade
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsr *TestSessionRegrade) Update() *TestSessionRegradeUpdateOne {
+	return NewTestSessionRegradeClient(tsr.config).UpdateOne(tsr)
+}
+
+// Unwrap unwraps the TestSessionRegrade entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsr *TestSessionRegrade) Unwrap() *TestSessionRegrade {
+	_tx, ok := tsr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestSessionRegrade is not a transactional entity")
+	}
+	tsr.config.driver = _tx.drv
+	return tsr
+}
+
+// String implements the fmt.Stringer.
+func (tsr *TestSessionRegrade) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestSessionRegrade(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tsr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tsr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tsr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsr.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("regraded_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsr.RegradedByID))
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", tsr.Scope))
+	builder.WriteString(", ")
+	if v := tsr.QuestionID; v != nil {
+		builder.WriteString("question_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("points_before=")
+	builder.WriteString(fmt.Sprintf("%v", tsr.PointsBefore))
+	builder.WriteString(", ")
+	builder.WriteString("points_after=")
+	builder.WriteString(fmt.Sprintf("%v", tsr.PointsAfter))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestSessionRegrades is a parsable slice of TestSessionRegrade.
+type TestSessionRegrades []*TestSessionRegrade
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessionregrade
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the testsessionregrade type in the database.
+	Label = "test_session_regrade"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldRegradedByID holds the string denoting the regraded_by_id field in the database.
+	FieldRegradedByID = "regraded_by_id"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldQuestionID holds the string denoting the question_id field in the database.
+	FieldQuestionID = "question_id"
+	// FieldPointsBefore holds the string denoting the points_before field in the database.
+	FieldPointsBefore = "points_before"
+	// FieldPointsAfter holds the string denoting the points_after field in the database.
+	FieldPointsAfter = "points_after"
+	// EdgeTestSession holds the string denoting the test_session edge name in mutations.
+	EdgeTestSession = "test_session"
+	// EdgeRegradedBy holds the string denoting the regraded_by edge name in mutations.
+	EdgeRegradedBy = "regraded_by"
+	// Table holds the table name of the testsessionregrade in the database.
+	Table = "test_session_regrades"
+	// TestSessionTable is the table that holds the test_session relation/edge.
+	TestSessionTable = "test_session_regrades"
+	// TestSessionInverseTable is the table name for the TestSession entity.
+	// It exists in this package in order to avoid circular dependency with the "testsession" package.
+	TestSessionInverseTable = "test_sessions"
+	// TestSessionColumn is the table column denoting the test_session relation/edge.
+	TestSessionColumn = "session_id"
+	// RegradedByTable is the table that holds the regraded_by relation/edge.
+	RegradedByTable = "test_session_regrades"
+	// RegradedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	RegradedByInverseTable = "users"
+	// RegradedByColumn is the table column denoting the regraded_by relation/edge.
+	RegradedByColumn = "regraded_by_id"
+)
+
+// Columns holds all SQL columns for testsessionregrade fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldSessionID,
+	FieldRegradedByID,
+	FieldScope,
+	FieldQuestionID,
+	FieldPointsBefore,
+	FieldPointsAfter,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Scope defines the type for the "scope" enum field.
+type Scope string
+
+// Scope values.
+const (
+	ScopeQuestion Scope = "question"
+	ScopeTest     Scope = "test"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopeQuestion, ScopeTest:
+		return nil
+	default:
+		return fmt.Errorf("testsessionregrade: invalid enum value for scope field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the TestSessionRegrade queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByRegradedByID orders the results by the regraded_by_id field.
+func ByRegradedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegradedByID, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByQuestionID orders the results by the question_id field.
+func ByQuestionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionID, opts...).ToFunc()
+}
+
+// ByPointsBefore orders the results by the points_before field.
+func ByPointsBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPointsBefore, opts...).ToFunc()
+}
+
+// ByPointsAfter orders the results by the points_after field.
+func ByPointsAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPointsAfter, opts...).ToFunc()
+}
+
+// ByTestSessionField orders the results by test_session field.
+func ByTestSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRegradedByField orders the results by regraded_by field.
+func ByRegradedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegradedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTestSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+	)
+}
+func newRegradedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegradedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RegradedByTable, RegradedByColumn),
+	)
+}
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessionregrade
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldSessionID, v))
+}
+
+// RegradedByID applies equality check predicate on the "regraded_by_id" field. It's identical to RegradedByIDEQ.
+func RegradedByID(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldRegradedByID, v))
+}
+
+// QuestionID applies equality check predicate on the "question_id" field. It's identical to QuestionIDEQ.
+func QuestionID(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// PointsBefore applies equality check predicate on the "points_before" field. It's identical to PointsBeforeEQ.
+func PointsBefore(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldPointsBefore, v))
+}
+
+// PointsAfter applies equality check predicate on the "points_after" field. It's identical to PointsAfterEQ.
+func PointsAfter(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldPointsAfter, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// RegradedByIDEQ applies the EQ predicate on the "regraded_by_id" field.
+func RegradedByIDEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldRegradedByID, v))
+}
+
+// RegradedByIDNEQ applies the NEQ predicate on the "regraded_by_id" field.
+func RegradedByIDNEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldRegradedByID, v))
+}
+
+// RegradedByIDIn applies the In predicate on the "regraded_by_id" field.
+func RegradedByIDIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldRegradedByID, vs...))
+}
+
+// RegradedByIDNotIn applies the NotIn predicate on the "regraded_by_id" field.
+func RegradedByIDNotIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldRegradedByID, vs...))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// QuestionIDEQ applies the EQ predicate on the "question_id" field.
+func QuestionIDEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionIDNEQ applies the NEQ predicate on the "question_id" field.
+func QuestionIDNEQ(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldQuestionID, v))
+}
+
+// QuestionIDIn applies the In predicate on the "question_id" field.
+func QuestionIDIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDNotIn applies the NotIn predicate on the "question_id" field.
+func QuestionIDNotIn(vs ...uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDGT applies the GT predicate on the "question_id" field.
+func QuestionIDGT(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldQuestionID, v))
+}
+
+// QuestionIDGTE applies the GTE predicate on the "question_id" field.
+func QuestionIDGTE(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldQuestionID, v))
+}
+
+// QuestionIDLT applies the LT predicate on the "question_id" field.
+func QuestionIDLT(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldQuestionID, v))
+}
+
+// QuestionIDLTE applies the LTE predicate on the "question_id" field.
+func QuestionIDLTE(v uuid.UUID) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldQuestionID, v))
+}
+
+// QuestionIDIsNil applies the IsNil predicate on the "question_id" field.
+func QuestionIDIsNil() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIsNull(FieldQuestionID))
+}
+
+// QuestionIDNotNil applies the NotNil predicate on the "question_id" field.
+func QuestionIDNotNil() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotNull(FieldQuestionID))
+}
+
+// PointsBeforeEQ applies the EQ predicate on the "points_before" field.
+func PointsBeforeEQ(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldPointsBefore, v))
+}
+
+// PointsBeforeNEQ applies the NEQ predicate on the "points_before" field.
+func PointsBeforeNEQ(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldPointsBefore, v))
+}
+
+// PointsBeforeIn applies the In predicate on the "points_before" field.
+func PointsBeforeIn(vs ...int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldPointsBefore, vs...))
+}
+
+// PointsBeforeNotIn applies the NotIn predicate on the "points_before" field.
+func PointsBeforeNotIn(vs ...int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldPointsBefore, vs...))
+}
+
+// PointsBeforeGT applies the GT predicate on the "points_before" field.
+func PointsBeforeGT(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldPointsBefore, v))
+}
+
+// PointsBeforeGTE applies the GTE predicate on the "points_before" field.
+func PointsBeforeGTE(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldPointsBefore, v))
+}
+
+// PointsBeforeLT applies the LT predicate on the "points_before" field.
+func PointsBeforeLT(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldPointsBefore, v))
+}
+
+// PointsBeforeLTE applies the LTE predicate on the "points_before" field.
+func PointsBeforeLTE(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldPointsBefore, v))
+}
+
+// PointsAfterEQ applies the EQ predicate on the "points_after" field.
+func PointsAfterEQ(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldEQ(FieldPointsAfter, v))
+}
+
+// PointsAfterNEQ applies the NEQ predicate on the "points_after" field.
+func PointsAfterNEQ(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNEQ(FieldPointsAfter, v))
+}
+
+// PointsAfterIn applies the In predicate on the "points_after" field.
+func PointsAfterIn(vs ...int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldIn(FieldPointsAfter, vs...))
+}
+
+// PointsAfterNotIn applies the NotIn predicate on the "points_after" field.
+func PointsAfterNotIn(vs ...int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldNotIn(FieldPointsAfter, vs...))
+}
+
+// PointsAfterGT applies the GT predicate on the "points_after" field.
+func PointsAfterGT(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGT(FieldPointsAfter, v))
+}
+
+// PointsAfterGTE applies the GTE predicate on the "points_after" field.
+func PointsAfterGTE(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldGTE(FieldPointsAfter, v))
+}
+
+// PointsAfterLT applies the LT predicate on the "points_after" field.
+func PointsAfterLT(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLT(FieldPointsAfter, v))
+}
+
+// PointsAfterLTE applies the LTE predicate on the "points_after" field.
+func PointsAfterLTE(v int) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.FieldLTE(FieldPointsAfter, v))
+}
+
+// HasTestSession applies the HasEdge predicate on the "test_session" edge.
+func HasTestSession() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestSessionWith applies the HasEdge predicate on the "test_session" edge with a given conditions (other predicates).
+func HasTestSessionWith(preds ...predicate.TestSession) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(func(s *sql.Selector) {
+		step := newTestSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRegradedBy applies the HasEdge predicate on the "regraded_by" edge.
+func HasRegradedBy() predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RegradedByTable, RegradedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegradedByWith applies the HasEdge predicate on the "regraded_by" edge with a given conditions (other predicates).
+func HasRegradedByWith(preds ...predicate.User) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(func(s *sql.Selector) {
+		step := newRegradedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TestSessionRegrade) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TestSessionRegrade) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TestSessionRegrade) predicate.TestSessionRegrade {
+	return predicate.TestSessionRegrade(sql.NotPredicates(p))
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionRegradeCreate is the builder for creating a TestSessionRegrade entity.
+type TestSessionRegradeCreate struct {
+	config
+	mutation *TestSessionRegradeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsrc *TestSessionRegradeCreate) SetCreatedAt(t time.Time) *TestSessionRegradeCreate {
+	tsrc.mutation.SetCreatedAt(t)
+	return tsrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsrc *TestSessionRegradeCreate) SetNillableCreatedAt(t *time.Time) *TestSessionRegradeCreate {
+	if t != nil {
+		tsrc.SetCreatedAt(*t)
+	}
+	return tsrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsrc *TestSessionRegradeCreate) SetUpdatedAt(t time.Time) *TestSessionRegradeCreate {
+	tsrc.mutation.SetUpdatedAt(t)
+	return tsrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsrc *TestSessionRegradeCreate) SetNillableUpdatedAt(t *time.Time) *TestSessionRegradeCreate {
+	if t != nil {
+		tsrc.SetUpdatedAt(*t)
+	}
+	return tsrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsrc *TestSessionRegradeCreate) SetDeletedAt(t time.Time) *TestSessionRegradeCreate {
+	tsrc.mutation.SetDeletedAt(t)
+	return tsrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsrc *TestSessionRegradeCreate) SetNillableDeletedAt(t *time.Time) *TestSessionRegradeCreate {
+	if t != nil {
+		tsrc.SetDeletedAt(*t)
+	}
+	return tsrc
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsrc *TestSessionRegradeCreate) SetSessionID(u uuid.UUID) *TestSessionRegradeCreate {
+	tsrc.mutation.SetSessionID(u)
+	return tsrc
+}
+
+// SetRegradedByID sets the "regraded_by_id" field.
+func (tsrc *TestSessionRegradeCreate) SetRegradedByID(u uuid.UUID) *TestSessionRegradeCreate {
+	tsrc.mutation.SetRegradedByID(u)
+	return tsrc
+}
+
+// SetScope sets the "scope" field.
+func (tsrc *TestSessionRegradeCreate) SetScope(t testsessionregrade.Scope) *TestSessionRegradeCreate {
+	tsrc.mutation.SetScope(t)
+	return tsrc
+}
+
+// SetQuestionID sets the "question_id" field.
+func (tsrc *TestSessionRegradeCreate) SetQuestionID(u uuid.UUID) *TestSessionRegradeCreate {
+	tsrc.mutation.SetQuestionID(u)
+	return tsrc
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (tsrc *TestSessionRegradeCreate) SetNillableQuestionID(u *uuid.UUID) *TestSessionRegradeCreate {
+	if u != nil {
+		tsrc.SetQuestionID(*u)
+	}
+	return tsrc
+}
+
+// SetPointsBefore sets the "points_before" field.
+func (tsrc *TestSessionRegradeCreate) SetPointsBefore(i int) *TestSessionRegradeCreate {
+	tsrc.mutation.SetPointsBefore(i)
+	return tsrc
+}
+
+// SetPointsAfter sets the "points_after" field.
+func (tsrc *TestSessionRegradeCreate) SetPointsAfter(i int) *TestSessionRegradeCreate {
+	tsrc.mutation.SetPointsAfter(i)
+	return tsrc
+}
+
+// SetID sets the "id" field.
+func (tsrc *TestSessionRegradeCreate) SetID(u uuid.UUID) *TestSessionRegradeCreate {
+	tsrc.mutation.SetID(u)
+	return tsrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsrc *TestSessionRegradeCreate) SetNillableID(u *uuid.UUID) *TestSessionRegradeCreate {
+	if u != nil {
+		tsrc.SetID(*u)
+	}
+	return tsrc
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsrc *TestSessionRegradeCreate) SetTestSessionID(id uuid.UUID) *TestSessionRegradeCreate {
+	tsrc.mutation.SetTestSessionID(id)
+	return tsrc
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsrc *TestSessionRegradeCreate) SetTestSession(t *TestSession) *TestSessionRegradeCreate {
+	return tsrc.SetTestSessionID(t.ID)
+}
+
+// SetRegradedBy sets the "regraded_by" edge to the User entity.
+func (tsrc *TestSessionRegradeCreate) SetRegradedBy(u *User) *TestSessionRegradeCreate {
+	return tsrc.SetRegradedByID(u.ID)
+}
+
+// Mutation returns the TestSessionRegradeMutation object of the builder.
+func (tsrc *TestSessionRegradeCreate) Mutation() *TestSessionRegradeMutation {
+	return tsrc.mutation
+}
+
+// Save creates the TestSessionRegrade in the database.
+func (tsrc *TestSessionRegradeCreate) Save(ctx context.Context) (*TestSessionRegrade, error) {
+	if err := tsrc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsrc.sqlSave, tsrc.mutation, tsrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsrc *TestSessionRegradeCreate) SaveX(ctx context.Context) *TestSessionRegrade {
+	v, err := tsrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsrc *TestSessionRegradeCreate) Exec(ctx context.Context) error {
+	_, err := tsrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsrc *TestSessionRegradeCreate) ExecX(ctx context.Context) {
+	if err := tsrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsrc *TestSessionRegradeCreate) defaults() error {
+	if _, ok := tsrc.mutation.CreatedAt(); !ok {
+		if testsessionregrade.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionregrade.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionregrade.DefaultCreatedAt()
+		tsrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsrc.mutation.UpdatedAt(); !ok {
+		if testsessionregrade.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionregrade.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionregrade.DefaultUpdatedAt()
+		tsrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsrc.mutation.ID(); !ok {
+		if testsessionregrade.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized testsessionregrade.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := testsessionregrade.DefaultID()
+		tsrc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsrc *TestSessionRegradeCreate) check() error {
+	if _, ok := tsrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TestSessionRegrade.created_at"`)}
+	}
+	if _, ok := tsrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TestSessionRegrade.updated_at"`)}
+	}
+	if _, ok := tsrc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TestSessionRegrade.session_id"`)}
+	}
+	if _, ok := tsrc.mutation.RegradedByID(); !ok {
+		return &ValidationError{Name: "regraded_by_id", err: errors.New(`ent: missing required field "TestSessionRegrade.regraded_by_id"`)}
+	}
+	if _, ok := tsrc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "TestSessionRegrade.scope"`)}
+	}
+	if v, ok := tsrc.mutation.Scope(); ok {
+		if err := testsessionregrade.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "TestSessionRegrade.scope": %w`, err)}
+		}
+	}
+	if _, ok := tsrc.mutation.PointsBefore(); !ok {
+		return &ValidationError{Name: "points_before", err: errors.New(`ent: missing required field "TestSessionRegrade.points_before"`)}
+	}
+	if _, ok := tsrc.mutation.PointsAfter(); !ok {
+		return &ValidationError{Name: "points_after", err: errors.New(`ent: missing required field "TestSessionRegrade.points_after"`)}
+	}
+	if len(tsrc.mutation.TestSessionIDs()) == 0 {
+		return &ValidationError{Name: "test_session", err: errors.New(`ent: missing required edge "TestSessionRegrade.test_session"`)}
+	}
+	if len(tsrc.mutation.RegradedByIDs()) == 0 {
+		return &ValidationError{Name: "regraded_by", err: errors.New(`ent: missing required edge "TestSessionRegrade.regraded_by"`)}
+	}
+	return nil
+}
+
+func (tsrc *TestSessionRegradeCreate) sqlSave(ctx context.Context) (*TestSessionRegrade, error) {
+	if err := tsrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsrc.mutation.id = &_node.ID
+	tsrc.mutation.done = true
+	return _node, nil
+}
+
+func (tsrc *TestSessionRegradeCreate) createSpec() (*TestSessionRegrade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestSessionRegrade{config: tsrc.config}
+		_spec = sqlgraph.NewCreateSpec(testsessionregrade.Table, sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID))
+	)
+	if id, ok := tsrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsrc.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tsrc.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tsrc.mutation.Scope(); ok {
+		_spec.SetField(testsessionregrade.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := tsrc.mutation.QuestionID(); ok {
+		_spec.SetField(testsessionregrade.FieldQuestionID, field.TypeUUID, value)
+		_node.QuestionID = &value
+	}
+	if value, ok := tsrc.mutation.PointsBefore(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsBefore, field.TypeInt, value)
+		_node.PointsBefore = value
+	}
+	if value, ok := tsrc.mutation.PointsAfter(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsAfter, field.TypeInt, value)
+		_node.PointsAfter = value
+	}
+	if nodes := tsrc.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.TestSessionTable,
+			Columns: []string{testsessionregrade.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsrc.mutation.RegradedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.RegradedByTable,
+			Columns: []string{testsessionregrade.RegradedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RegradedByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TestSessionRegradeCreateBulk is the builder for creating many TestSessionRegrade entities in bulk.
+type TestSessionRegradeCreateBulk struct {
+	config
+	err      error
+	builders []*TestSessionRegradeCreate
+}
+
+// Save creates the TestSessionRegrade entities in the database.
+func (tsrcb *TestSessionRegradeCreateBulk) Save(ctx context.Context) ([]*TestSessionRegrade, error) {
+	if tsrcb.err != nil {
+		return nil, tsrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsrcb.builders))
+	nodes := make([]*TestSessionRegrade, len(tsrcb.builders))
+	mutators := make([]Mutator, len(tsrcb.builders))
+	for i := range tsrcb.builders {
+		func(i int, root context.Context) {
+			builder := tsrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestSessionRegradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsrcb *TestSessionRegradeCreateBulk) SaveX(ctx context.Context) []*TestSessionRegrade {
+	v, err := tsrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsrcb *TestSessionRegradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsrcb *TestSessionRegradeCreateBulk) ExecX(ctx context.Context) {
+	if err := tsrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsessionregrade"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TestSessionRegradeDelete is the builder for deleting a TestSessionRegrade entity.
+type TestSessionRegradeDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionRegradeMutation
+}
+
+// Where appends a list predicates to the TestSessionRegradeDelete builder.
+func (tsrd *TestSessionRegradeDelete) Where(ps ...predicate.TestSessionRegrade) *TestSessionRegradeDelete {
+	tsrd.mutation.Where(ps...)
+	return tsrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsrd *TestSessionRegradeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsrd.sqlExec, tsrd.mutation, tsrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsrd *TestSessionRegradeDelete) ExecX(ctx context.Context) int {
+	n, err := tsrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsrd *TestSessionRegradeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testsessionregrade.Table, sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID))
+	if ps := tsrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsrd.mutation.done = true
+	return affected, err
+}
+
+// TestSessionRegradeDeleteOne is the builder for deleting a single TestSessionRegrade entity.
+type TestSessionRegradeDeleteOne struct {
+	tsrd *TestSessionRegradeDelete
+}
+
+// Where appends a list predicates to the TestSessionRegradeDelete builder.
+func (tsrdo *TestSessionRegradeDeleteOne) Where(ps ...predicate.TestSessionRegrade) *TestSessionRegradeDeleteOne {
+	tsrdo.tsrd.mutation.Where(ps...)
+	return tsrdo
+}
+
+// Exec executes the deletion query.
+func (tsrdo *TestSessionRegradeDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsrdo.tsrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testsessionregrade.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsrdo *TestSessionRegradeDeleteOne) ExecX(ctx context.Context) {
+	if err := tsrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionRegradeQuery is the builder for querying TestSessionRegrade entities.
+type TestSessionRegradeQuery struct {
+	config
+	ctx             *QueryContext
+	order           []testsessionregrade.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TestSessionRegrade
+	withTestSession *TestSessionQuery
+	withRegradedBy  *UserQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestSessionRegradeQuery builder.
+func (tsrq *TestSessionRegradeQuery) Where(ps ...predicate.TestSessionRegrade) *TestSessionRegradeQuery {
+	tsrq.predicates = append(tsrq.predicates, ps...)
+	return tsrq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsrq *TestSessionRegradeQuery) Limit(limit int) *TestSessionRegradeQuery {
+	tsrq.ctx.Limit = &limit
+	return tsrq
+}
+
+// Offset to start from.
+func (tsrq *TestSessionRegradeQuery) Offset(offset int) *TestSessionRegradeQuery {
+	tsrq.ctx.Offset = &offset
+	return tsrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsrq *TestSessionRegradeQuery) Unique(unique bool) *TestSessionRegradeQuery {
+	tsrq.ctx.Unique = &unique
+	return tsrq
+}
+
+// Order specifies how the records should be ordered.
+func (tsrq *TestSessionRegradeQuery) Order(o ...testsessionregrade.OrderOption) *TestSessionRegradeQuery {
+	tsrq.order = append(tsrq.order, o...)
+	return tsrq
+}
+
+// QueryTestSession chains the current query on the "test_session" edge.
+func (tsrq *TestSessionRegradeQuery) QueryTestSession() *TestSessionQuery {
+	query := (&TestSessionClient{config: tsrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionregrade.Table, testsessionregrade.FieldID, selector),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionregrade.TestSessionTable, testsessionregrade.TestSessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRegradedBy chains the current query on the "regraded_by" edge.
+func (tsrq *TestSessionRegradeQuery) QueryRegradedBy() *UserQuery {
+	query := (&UserClient{config: tsrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessionregrade.Table, testsessionregrade.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessionregrade.RegradedByTable, testsessionregrade.RegradedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestSessionRegrade entity from the query.
+// Returns a *NotFoundError when no TestSessionRegrade was found.
+func (tsrq *TestSessionRegradeQuery) First(ctx context.Context) (*TestSessionRegrade, error) {
+	nodes, err := tsrq.Limit(1).All(setContextOp(ctx, tsrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testsessionregrade.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) FirstX(ctx context.Context) *TestSessionRegrade {
+	node, err := tsrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestSessionRegrade ID from the query.
+// Returns a *NotFoundError when no TestSessionRegrade ID was found.
+func (tsrq *TestSessionRegradeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsrq.Limit(1).IDs(setContextOp(ctx, tsrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testsessionregrade.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestSessionRegrade entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestSessionRegrade entity is found.
+// Returns a *NotFoundError when no TestSessionRegrade entities are found.
+func (tsrq *TestSessionRegradeQuery) Only(ctx context.Context) (*TestSessionRegrade, error) {
+	nodes, err := tsrq.Limit(2).All(setContextOp(ctx, tsrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testsessionregrade.Label}
+	default:
+		return nil, &NotSingularError{testsessionregrade.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) OnlyX(ctx context.Context) *TestSessionRegrade {
+	node, err := tsrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestSessionRegrade ID in the query.
+// Returns a *NotSingularError when more than one TestSessionRegrade ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsrq *TestSessionRegradeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsrq.Limit(2).IDs(setContextOp(ctx, tsrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testsessionregrade.Label}
+	default:
+		err = &NotSingularError{testsessionregrade.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestSessionRegrades.
+func (tsrq *TestSessionRegradeQuery) All(ctx context.Context) ([]*TestSessionRegrade, error) {
+	ctx = setContextOp(ctx, tsrq.ctx, ent.OpQueryAll)
+	if err := tsrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestSessionRegrade, *TestSessionRegradeQuery]()
+	return withInterceptors[[]*TestSessionRegrade](ctx, tsrq, qr, tsrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) AllX(ctx context.Context) []*TestSessionRegrade {
+	nodes, err := tsrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestSessionRegrade IDs.
+func (tsrq *TestSessionRegradeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsrq.ctx.Unique == nil && tsrq.path != nil {
+		tsrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsrq.ctx, ent.OpQueryIDs)
+	if err = tsrq.Select(testsessionregrade.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsrq *TestSessionRegradeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsrq.ctx, ent.OpQueryCount)
+	if err := tsrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsrq, querierCount[*TestSessionRegradeQuery](), tsrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) CountX(ctx context.Context) int {
+	count, err := tsrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsrq *TestSessionRegradeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsrq.ctx, ent.OpQueryExist)
+	switch _, err := tsrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsrq *TestSessionRegradeQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestSessionRegradeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsrq *TestSessionRegradeQuery) Clone() *TestSessionRegradeQuery {
+	if tsrq == nil {
+		return nil
+	}
+	return &TestSessionRegradeQuery{
+		config:          tsrq.config,
+		ctx:             tsrq.ctx.Clone(),
+		order:           append([]testsessionregrade.OrderOption{}, tsrq.order...),
+		inters:          append([]Interceptor{}, tsrq.inters...),
+		predicates:      append([]predicate.TestSessionRegrade{}, tsrq.predicates...),
+		withTestSession: tsrq.withTestSession.Clone(),
+		withRegradedBy:  tsrq.withRegradedBy.Clone(),
+		// clone intermediate query.
+		sql:  tsrq.sql.Clone(),
+		path: tsrq.path,
+	}
+}
+
+// WithTestSession tells the query-builder to eager-load the nodes that are connected to
+// the "test_session" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsrq *TestSessionRegradeQuery) WithTestSession(opts ...func(*TestSessionQuery)) *TestSessionRegradeQuery {
+	query := (&TestSessionClient{config: tsrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsrq.withTestSession = query
+	return tsrq
+}
+
+// WithRegradedBy tells the query-builder to eager-load the nodes that are connected to
+// the "regraded_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsrq *TestSessionRegradeQuery) WithRegradedBy(opts ...func(*UserQuery)) *TestSessionRegradeQuery {
+	query := (&UserClient{config: tsrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsrq.withRegradedBy = query
+	return tsrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestSessionRegrade.Query().
+//		GroupBy(testsessionregrade.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsrq *TestSessionRegradeQuery) GroupBy(field string, fields ...string) *TestSessionRegradeGroupBy {
+	tsrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestSessionRegradeGroupBy{build: tsrq}
+	grbuild.flds = &tsrq.ctx.Fields
+	grbuild.label = testsessionregrade.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TestSessionRegrade.Query().
+//		Select(testsessionregrade.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tsrq *TestSessionRegradeQuery) Select(fields ...string) *TestSessionRegradeSelect {
+	tsrq.ctx.Fields = append(tsrq.ctx.Fields, fields...)
+	sbuild := &TestSessionRegradeSelect{TestSessionRegradeQuery: tsrq}
+	sbuild.label = testsessionregrade.Label
+	sbuild.flds, sbuild.scan = &tsrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestSessionRegradeSelect configured with the given aggregations.
+func (tsrq *TestSessionRegradeQuery) Aggregate(fns ...AggregateFunc) *TestSessionRegradeSelect {
+	return tsrq.Select().Aggregate(fns...)
+}
+
+func (tsrq *TestSessionRegradeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsrq.ctx.Fields {
+		if !testsessionregrade.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsrq.path != nil {
+		prev, err := tsrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsrq.sql = prev
+	}
+	return nil
+}
+
+func (tsrq *TestSessionRegradeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestSessionRegrade, error) {
+	var (
+		nodes       = []*TestSessionRegrade{}
+		_spec       = tsrq.querySpec()
+		loadedTypes = [2]bool{
+			tsrq.withTestSession != nil,
+			tsrq.withRegradedBy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestSessionRegrade).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestSessionRegrade{config: tsrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsrq.modifiers) > 0 {
+		_spec.Modifiers = tsrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsrq.withTestSession; query != nil {
+		if err := tsrq.loadTestSession(ctx, query, nodes, nil,
+			func(n *TestSessionRegrade, e *TestSession) { n.Edges.TestSession = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsrq.withRegradedBy; query != nil {
+		if err := tsrq.loadRegradedBy(ctx, query, nodes, nil,
+			func(n *TestSessionRegrade, e *User) { n.Edges.RegradedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsrq *TestSessionRegradeQuery) loadTestSession(ctx context.Context, query *TestSessionQuery, nodes []*TestSessionRegrade, init func(*TestSessionRegrade), assign func(*TestSessionRegrade, *TestSession)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionRegrade)
+	for i := range nodes {
+		fk := nodes[i].SessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(testsession.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tsrq *TestSessionRegradeQuery) loadRegradedBy(ctx context.Context, query *UserQuery, nodes []*TestSessionRegrade, init func(*TestSessionRegrade), assign func(*TestSessionRegrade, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionRegrade)
+	for i := range nodes {
+		fk := nodes[i].RegradedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "regraded_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsrq *TestSessionRegradeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsrq.querySpec()
+	if len(tsrq.modifiers) > 0 {
+		_spec.Modifiers = tsrq.modifiers
+	}
+	_spec.Node.Columns = tsrq.ctx.Fields
+	if len(tsrq.ctx.Fields) > 0 {
+		_spec.Unique = tsrq.ctx.Unique != nil && *tsrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsrq.driver, _spec)
+}
+
+func (tsrq *TestSessionRegradeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testsessionregrade.Table, testsessionregrade.Columns, sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID))
+	_spec.From = tsrq.sql
+	if unique := tsrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionregrade.FieldID)
+		for i := range fields {
+			if fields[i] != testsessionregrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsrq.withTestSession != nil {
+			_spec.Node.AddColumnOnce(testsessionregrade.FieldSessionID)
+		}
+		if tsrq.withRegradedBy != nil {
+			_spec.Node.AddColumnOnce(testsessionregrade.FieldRegradedByID)
+		}
+	}
+	if ps := tsrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsrq *TestSessionRegradeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsrq.driver.Dialect())
+	t1 := builder.Table(testsessionregrade.Table)
+	columns := tsrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testsessionregrade.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsrq.sql != nil {
+		selector = tsrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsrq.ctx.Unique != nil && *tsrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsrq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsrq.predicates {
+		p(selector)
+	}
+	for _, p := range tsrq.order {
+		p(selector)
+	}
+	if offset := tsrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsrq *TestSessionRegradeQuery) ForUpdate(opts ...sql.LockOption) *TestSessionRegradeQuery {
+	if tsrq.driver.Dialect() == dialect.Postgres {
+		tsrq.Unique(false)
+	}
+	tsrq.modifiers = append(tsrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsrq *TestSessionRegradeQuery) ForShare(opts ...sql.LockOption) *TestSessionRegradeQuery {
+	if tsrq.driver.Dialect() == dialect.Postgres {
+		tsrq.Unique(false)
+	}
+	tsrq.modifiers = append(tsrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsrq
+}
+
+// TestSessionRegradeGroupBy is the group-by builder for TestSessionRegrade entities.
+type TestSessionRegradeGroupBy struct {
+	selector
+	build *TestSessionRegradeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsrgb *TestSessionRegradeGroupBy) Aggregate(fns ...AggregateFunc) *TestSessionRegradeGroupBy {
+	tsrgb.fns = append(tsrgb.fns, fns...)
+	return tsrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsrgb *TestSessionRegradeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionRegradeQuery, *TestSessionRegradeGroupBy](ctx, tsrgb.build, tsrgb, tsrgb.build.inters, v)
+}
+
+func (tsrgb *TestSessionRegradeGroupBy) sqlScan(ctx context.Context, root *TestSessionRegradeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsrgb.fns))
+	for _, fn := range tsrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsrgb.flds)+len(tsrgb.fns))
+		for _, f := range *tsrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestSessionRegradeSelect is the builder for selecting fields of TestSessionRegrade entities.
+type TestSessionRegradeSelect struct {
+	*TestSessionRegradeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsrs *TestSessionRegradeSelect) Aggregate(fns ...AggregateFunc) *TestSessionRegradeSelect {
+	tsrs.fns = append(tsrs.fns, fns...)
+	return tsrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsrs *TestSessionRegradeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsrs.ctx, ent.OpQuerySelect)
+	if err := tsrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionRegradeQuery, *TestSessionRegradeSelect](ctx, tsrs.TestSessionRegradeQuery, tsrs, tsrs.inters, v)
+}
+
+func (tsrs *TestSessionRegradeSelect) sqlScan(ctx context.Context, root *TestSessionRegradeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsrs.fns))
+	for _, fn := range tsrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
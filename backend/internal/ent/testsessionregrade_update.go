@@ -0,0 +1,773 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionRegradeUpdate is the builder for updating TestSessionRegrade entities.
+type TestSessionRegradeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionRegradeMutation
+}
+
+// Where appends a list predicates to the TestSessionRegradeUpdate builder.
+func (tsru *TestSessionRegradeUpdate) Where(ps ...predicate.TestSessionRegrade) *TestSessionRegradeUpdate {
+	tsru.mutation.Where(ps...)
+	return tsru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsru *TestSessionRegradeUpdate) SetCreatedAt(t time.Time) *TestSessionRegradeUpdate {
+	tsru.mutation.SetCreatedAt(t)
+	return tsru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableCreatedAt(t *time.Time) *TestSessionRegradeUpdate {
+	if t != nil {
+		tsru.SetCreatedAt(*t)
+	}
+	return tsru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsru *TestSessionRegradeUpdate) SetUpdatedAt(t time.Time) *TestSessionRegradeUpdate {
+	tsru.mutation.SetUpdatedAt(t)
+	return tsru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsru *TestSessionRegradeUpdate) SetDeletedAt(t time.Time) *TestSessionRegradeUpdate {
+	tsru.mutation.SetDeletedAt(t)
+	return tsru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableDeletedAt(t *time.Time) *TestSessionRegradeUpdate {
+	if t != nil {
+		tsru.SetDeletedAt(*t)
+	}
+	return tsru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsru *TestSessionRegradeUpdate) ClearDeletedAt() *TestSessionRegradeUpdate {
+	tsru.mutation.ClearDeletedAt()
+	return tsru
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsru *TestSessionRegradeUpdate) SetSessionID(u uuid.UUID) *TestSessionRegradeUpdate {
+	tsru.mutation.SetSessionID(u)
+	return tsru
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableSessionID(u *uuid.UUID) *TestSessionRegradeUpdate {
+	if u != nil {
+		tsru.SetSessionID(*u)
+	}
+	return tsru
+}
+
+// SetRegradedByID sets the "regraded_by_id" field.
+func (tsru *TestSessionRegradeUpdate) SetRegradedByID(u uuid.UUID) *TestSessionRegradeUpdate {
+	tsru.mutation.SetRegradedByID(u)
+	return tsru
+}
+
+// SetNillableRegradedByID sets the "regraded_by_id" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableRegradedByID(u *uuid.UUID) *TestSessionRegradeUpdate {
+	if u != nil {
+		tsru.SetRegradedByID(*u)
+	}
+	return tsru
+}
+
+// SetScope sets the "scope" field.
+func (tsru *TestSessionRegradeUpdate) SetScope(t testsessionregrade.Scope) *TestSessionRegradeUpdate {
+	tsru.mutation.SetScope(t)
+	return tsru
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableScope(t *testsessionregrade.Scope) *TestSessionRegradeUpdate {
+	if t != nil {
+		tsru.SetScope(*t)
+	}
+	return tsru
+}
+
+// SetQuestionID sets the "question_id" field.
+func (tsru *TestSessionRegradeUpdate) SetQuestionID(u uuid.UUID) *TestSessionRegradeUpdate {
+	tsru.mutation.SetQuestionID(u)
+	return tsru
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillableQuestionID(u *uuid.UUID) *TestSessionRegradeUpdate {
+	if u != nil {
+		tsru.SetQuestionID(*u)
+	}
+	return tsru
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (tsru *TestSessionRegradeUpdate) ClearQuestionID() *TestSessionRegradeUpdate {
+	tsru.mutation.ClearQuestionID()
+	return tsru
+}
+
+// SetPointsBefore sets the "points_before" field.
+func (tsru *TestSessionRegradeUpdate) SetPointsBefore(i int) *TestSessionRegradeUpdate {
+	tsru.mutation.ResetPointsBefore()
+	tsru.mutation.SetPointsBefore(i)
+	return tsru
+}
+
+// SetNillablePointsBefore sets the "points_before" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillablePointsBefore(i *int) *TestSessionRegradeUpdate {
+	if i != nil {
+		tsru.SetPointsBefore(*i)
+	}
+	return tsru
+}
+
+// AddPointsBefore adds i to the "points_before" field.
+func (tsru *TestSessionRegradeUpdate) AddPointsBefore(i int) *TestSessionRegradeUpdate {
+	tsru.mutation.AddPointsBefore(i)
+	return tsru
+}
+
+// SetPointsAfter sets the "points_after" field.
+func (tsru *TestSessionRegradeUpdate) SetPointsAfter(i int) *TestSessionRegradeUpdate {
+	tsru.mutation.ResetPointsAfter()
+	tsru.mutation.SetPointsAfter(i)
+	return tsru
+}
+
+// SetNillablePointsAfter sets the "points_after" field if the given value is not nil.
+func (tsru *TestSessionRegradeUpdate) SetNillablePointsAfter(i *int) *TestSessionRegradeUpdate {
+	if i != nil {
+		tsru.SetPointsAfter(*i)
+	}
+	return tsru
+}
+
+// AddPointsAfter adds i to the "points_after" field.
+func (tsru *TestSessionRegradeUpdate) AddPointsAfter(i int) *TestSessionRegradeUpdate {
+	tsru.mutation.AddPointsAfter(i)
+	return tsru
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsru *TestSessionRegradeUpdate) SetTestSessionID(id uuid.UUID) *TestSessionRegradeUpdate {
+	tsru.mutation.SetTestSessionID(id)
+	return tsru
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsru *TestSessionRegradeUpdate) SetTestSession(t *TestSession) *TestSessionRegradeUpdate {
+	return tsru.SetTestSessionID(t.ID)
+}
+
+// SetRegradedBy sets the "regraded_by" edge to the User entity.
+func (tsru *TestSessionRegradeUpdate) SetRegradedBy(u *User) *TestSessionRegradeUpdate {
+	return tsru.SetRegradedByID(u.ID)
+}
+
+// Mutation returns the TestSessionRegradeMutation object of the builder.
+func (tsru *TestSessionRegradeUpdate) Mutation() *TestSessionRegradeMutation {
+	return tsru.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsru *TestSessionRegradeUpdate) ClearTestSession() *TestSessionRegradeUpdate {
+	tsru.mutation.ClearTestSession()
+	return tsru
+}
+
+// ClearRegradedBy clears the "regraded_by" edge to the User entity.
+func (tsru *TestSessionRegradeUpdate) ClearRegradedBy() *TestSessionRegradeUpdate {
+	tsru.mutation.ClearRegradedBy()
+	return tsru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsru *TestSessionRegradeUpdate) Save(ctx context.Context) (int, error) {
+	if err := tsru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tsru.sqlSave, tsru.mutation, tsru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsru *TestSessionRegradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsru *TestSessionRegradeUpdate) Exec(ctx context.Context) error {
+	_, err := tsru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsru *TestSessionRegradeUpdate) ExecX(ctx context.Context) {
+	if err := tsru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsru *TestSessionRegradeUpdate) defaults() error {
+	if _, ok := tsru.mutation.UpdatedAt(); !ok {
+		if testsessionregrade.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionregrade.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionregrade.UpdateDefaultUpdatedAt()
+		tsru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsru *TestSessionRegradeUpdate) check() error {
+	if v, ok := tsru.mutation.Scope(); ok {
+		if err := testsessionregrade.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "TestSessionRegrade.scope": %w`, err)}
+		}
+	}
+	if tsru.mutation.TestSessionCleared() && len(tsru.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionRegrade.test_session"`)
+	}
+	if tsru.mutation.RegradedByCleared() && len(tsru.mutation.RegradedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionRegrade.regraded_by"`)
+	}
+	return nil
+}
+
+func (tsru *TestSessionRegradeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionregrade.Table, testsessionregrade.Columns, sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID))
+	if ps := tsru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsru.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsru.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsru.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsru.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionregrade.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsru.mutation.Scope(); ok {
+		_spec.SetField(testsessionregrade.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := tsru.mutation.QuestionID(); ok {
+		_spec.SetField(testsessionregrade.FieldQuestionID, field.TypeUUID, value)
+	}
+	if tsru.mutation.QuestionIDCleared() {
+		_spec.ClearField(testsessionregrade.FieldQuestionID, field.TypeUUID)
+	}
+	if value, ok := tsru.mutation.PointsBefore(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsBefore, field.TypeInt, value)
+	}
+	if value, ok := tsru.mutation.AddedPointsBefore(); ok {
+		_spec.AddField(testsessionregrade.FieldPointsBefore, field.TypeInt, value)
+	}
+	if value, ok := tsru.mutation.PointsAfter(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsAfter, field.TypeInt, value)
+	}
+	if value, ok := tsru.mutation.AddedPointsAfter(); ok {
+		_spec.AddField(testsessionregrade.FieldPointsAfter, field.TypeInt, value)
+	}
+	if tsru.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.TestSessionTable,
+			Columns: []string{testsessionregrade.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsru.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.TestSessionTable,
+			Columns: []string{testsessionregrade.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsru.mutation.RegradedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.RegradedByTable,
+			Columns: []string{testsessionregrade.RegradedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsru.mutation.RegradedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.RegradedByTable,
+			Columns: []string{testsessionregrade.RegradedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionregrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsru.mutation.done = true
+	return n, nil
+}
+
+// TestSessionRegradeUpdateOne is the builder for updating a single TestSessionRegrade entity.
+type TestSessionRegradeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TestSessionRegradeMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetCreatedAt(t time.Time) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetCreatedAt(t)
+	return tsruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableCreatedAt(t *time.Time) *TestSessionRegradeUpdateOne {
+	if t != nil {
+		tsruo.SetCreatedAt(*t)
+	}
+	return tsruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetUpdatedAt(t time.Time) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetUpdatedAt(t)
+	return tsruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetDeletedAt(t time.Time) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetDeletedAt(t)
+	return tsruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableDeletedAt(t *time.Time) *TestSessionRegradeUpdateOne {
+	if t != nil {
+		tsruo.SetDeletedAt(*t)
+	}
+	return tsruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsruo *TestSessionRegradeUpdateOne) ClearDeletedAt() *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ClearDeletedAt()
+	return tsruo
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetSessionID(u uuid.UUID) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetSessionID(u)
+	return tsruo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableSessionID(u *uuid.UUID) *TestSessionRegradeUpdateOne {
+	if u != nil {
+		tsruo.SetSessionID(*u)
+	}
+	return tsruo
+}
+
+// SetRegradedByID sets the "regraded_by_id" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetRegradedByID(u uuid.UUID) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetRegradedByID(u)
+	return tsruo
+}
+
+// SetNillableRegradedByID sets the "regraded_by_id" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableRegradedByID(u *uuid.UUID) *TestSessionRegradeUpdateOne {
+	if u != nil {
+		tsruo.SetRegradedByID(*u)
+	}
+	return tsruo
+}
+
+// SetScope sets the "scope" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetScope(t testsessionregrade.Scope) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetScope(t)
+	return tsruo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableScope(t *testsessionregrade.Scope) *TestSessionRegradeUpdateOne {
+	if t != nil {
+		tsruo.SetScope(*t)
+	}
+	return tsruo
+}
+
+// SetQuestionID sets the "question_id" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetQuestionID(u uuid.UUID) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetQuestionID(u)
+	return tsruo
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillableQuestionID(u *uuid.UUID) *TestSessionRegradeUpdateOne {
+	if u != nil {
+		tsruo.SetQuestionID(*u)
+	}
+	return tsruo
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (tsruo *TestSessionRegradeUpdateOne) ClearQuestionID() *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ClearQuestionID()
+	return tsruo
+}
+
+// SetPointsBefore sets the "points_before" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetPointsBefore(i int) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ResetPointsBefore()
+	tsruo.mutation.SetPointsBefore(i)
+	return tsruo
+}
+
+// SetNillablePointsBefore sets the "points_before" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillablePointsBefore(i *int) *TestSessionRegradeUpdateOne {
+	if i != nil {
+		tsruo.SetPointsBefore(*i)
+	}
+	return tsruo
+}
+
+// AddPointsBefore adds i to the "points_before" field.
+func (tsruo *TestSessionRegradeUpdateOne) AddPointsBefore(i int) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.AddPointsBefore(i)
+	return tsruo
+}
+
+// SetPointsAfter sets the "points_after" field.
+func (tsruo *TestSessionRegradeUpdateOne) SetPointsAfter(i int) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ResetPointsAfter()
+	tsruo.mutation.SetPointsAfter(i)
+	return tsruo
+}
+
+// SetNillablePointsAfter sets the "points_after" field if the given value is not nil.
+func (tsruo *TestSessionRegradeUpdateOne) SetNillablePointsAfter(i *int) *TestSessionRegradeUpdateOne {
+	if i != nil {
+		tsruo.SetPointsAfter(*i)
+	}
+	return tsruo
+}
+
+// AddPointsAfter adds i to the "points_after" field.
+func (tsruo *TestSessionRegradeUpdateOne) AddPointsAfter(i int) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.AddPointsAfter(i)
+	return tsruo
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsruo *TestSessionRegradeUpdateOne) SetTestSessionID(id uuid.UUID) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.SetTestSessionID(id)
+	return tsruo
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsruo *TestSessionRegradeUpdateOne) SetTestSession(t *TestSession) *TestSessionRegradeUpdateOne {
+	return tsruo.SetTestSessionID(t.ID)
+}
+
+// SetRegradedBy sets the "regraded_by" edge to the User entity.
+func (tsruo *TestSessionRegradeUpdateOne) SetRegradedBy(u *User) *TestSessionRegradeUpdateOne {
+	return tsruo.SetRegradedByID(u.ID)
+}
+
+// Mutation returns the TestSessionRegradeMutation object of the builder.
+func (tsruo *TestSessionRegradeUpdateOne) Mutation() *TestSessionRegradeMutation {
+	return tsruo.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsruo *TestSessionRegradeUpdateOne) ClearTestSession() *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ClearTestSession()
+	return tsruo
+}
+
+// ClearRegradedBy clears the "regraded_by" edge to the User entity.
+func (tsruo *TestSessionRegradeUpdateOne) ClearRegradedBy() *TestSessionRegradeUpdateOne {
+	tsruo.mutation.ClearRegradedBy()
+	return tsruo
+}
+
+// Where appends a list predicates to the TestSessionRegradeUpdate builder.
+func (tsruo *TestSessionRegradeUpdateOne) Where(ps ...predicate.TestSessionRegrade) *TestSessionRegradeUpdateOne {
+	tsruo.mutation.Where(ps...)
+	return tsruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsruo *TestSessionRegradeUpdateOne) Select(field string, fields ...string) *TestSessionRegradeUpdateOne {
+	tsruo.fields = append([]string{field}, fields...)
+	return tsruo
+}
+
+// Save executes the query and returns the updated TestSessionRegrade entity.
+func (tsruo *TestSessionRegradeUpdateOne) Save(ctx context.Context) (*TestSessionRegrade, error) {
+	if err := tsruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsruo.sqlSave, tsruo.mutation, tsruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsruo *TestSessionRegradeUpdateOne) SaveX(ctx context.Context) *TestSessionRegrade {
+	node, err := tsruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsruo *TestSessionRegradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsruo *TestSessionRegradeUpdateOne) ExecX(ctx context.Context) {
+	if err := tsruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsruo *TestSessionRegradeUpdateOne) defaults() error {
+	if _, ok := tsruo.mutation.UpdatedAt(); !ok {
+		if testsessionregrade.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessionregrade.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessionregrade.UpdateDefaultUpdatedAt()
+		tsruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsruo *TestSessionRegradeUpdateOne) check() error {
+	if v, ok := tsruo.mutation.Scope(); ok {
+		if err := testsessionregrade.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "TestSessionRegrade.scope": %w`, err)}
+		}
+	}
+	if tsruo.mutation.TestSessionCleared() && len(tsruo.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionRegrade.test_session"`)
+	}
+	if tsruo.mutation.RegradedByCleared() && len(tsruo.mutation.RegradedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionRegrade.regraded_by"`)
+	}
+	return nil
+}
+
+func (tsruo *TestSessionRegradeUpdateOne) sqlSave(ctx context.Context) (_node *TestSessionRegrade, err error) {
+	if err := tsruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessionregrade.Table, testsessionregrade.Columns, sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID))
+	id, ok := tsruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestSessionRegrade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessionregrade.FieldID)
+		for _, f := range fields {
+			if !testsessionregrade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testsessionregrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsruo.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsruo.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessionregrade.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessionregrade.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsruo.mutation.Scope(); ok {
+		_spec.SetField(testsessionregrade.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := tsruo.mutation.QuestionID(); ok {
+		_spec.SetField(testsessionregrade.FieldQuestionID, field.TypeUUID, value)
+	}
+	if tsruo.mutation.QuestionIDCleared() {
+		_spec.ClearField(testsessionregrade.FieldQuestionID, field.TypeUUID)
+	}
+	if value, ok := tsruo.mutation.PointsBefore(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsBefore, field.TypeInt, value)
+	}
+	if value, ok := tsruo.mutation.AddedPointsBefore(); ok {
+		_spec.AddField(testsessionregrade.FieldPointsBefore, field.TypeInt, value)
+	}
+	if value, ok := tsruo.mutation.PointsAfter(); ok {
+		_spec.SetField(testsessionregrade.FieldPointsAfter, field.TypeInt, value)
+	}
+	if value, ok := tsruo.mutation.AddedPointsAfter(); ok {
+		_spec.AddField(testsessionregrade.FieldPointsAfter, field.TypeInt, value)
+	}
+	if tsruo.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.TestSessionTable,
+			Columns: []string{testsessionregrade.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsruo.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.TestSessionTable,
+			Columns: []string{testsessionregrade.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsruo.mutation.RegradedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.RegradedByTable,
+			Columns: []string{testsessionregrade.RegradedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsruo.mutation.RegradedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessionregrade.RegradedByTable,
+			Columns: []string{testsessionregrade.RegradedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TestSessionRegrade{config: tsruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessionregrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsruo.mutation.done = true
+	return _node, nil
+}
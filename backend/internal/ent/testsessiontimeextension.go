@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessiontimeextension"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TestSessionTimeExtension is the model entity for the TestSessionTimeExtension schema.
+type TestSessionTimeExtension struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// ExtendedByID holds the value of the "extended_by_id" field.
+	ExtendedByID uuid.UUID `json:"extended_by_id,omitempty"`
+	// Minutes holds the value of the "minutes" field.
+	Minutes int `json:"minutes,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// PreviousExpiredAt holds the value of the "previous_expired_at" field.
+	PreviousExpiredAt time.Time `json:"previous_expired_at,omitempty"`
+	// NewExpiredAt holds the value of the "new_expired_at" field.
+	NewExpiredAt time.Time `json:"new_expired_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestSessionTimeExtensionQuery when eager-loading is set.
+	Edges        TestSessionTimeExtensionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TestSessionTimeExtensionEdges holds the relations/edges for other nodes in the graph.
+type TestSessionTimeExtensionEdges struct {
+	// TestSession holds the value of the test_session edge.
+	TestSession *TestSession `json:"test_session,omitempty"`
+	// ExtendedBy holds the value of the extended_by edge.
+	ExtendedBy *User `json:"extended_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TestSessionOrErr returns the TestSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionTimeExtensionEdges) TestSessionOrErr() (*TestSession, error) {
+	if e.TestSession != nil {
+		return e.TestSession, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: testsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "test_session"}
+}
+
+// ExtendedByOrErr returns the ExtendedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestSessionTimeExtensionEdges) ExtendedByOrErr() (*User, error) {
+	if e.ExtendedBy != nil {
+		return e.ExtendedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "extended_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestSessionTimeExtension) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testsessiontimeextension.FieldMinutes:
+			values[i] = new(sql.NullInt64)
+		case testsessiontimeextension.FieldReason:
+			values[i] = new(sql.NullString)
+		case testsessiontimeextension.FieldCreatedAt, testsessiontimeextension.FieldUpdatedAt, testsessiontimeextension.FieldDeletedAt, testsessiontimeextension.FieldPreviousExpiredAt, testsessiontimeextension.FieldNewExpiredAt:
+			values[i] = new(sql.NullTime)
+		case testsessiontimeextension.FieldID, testsessiontimeextension.FieldSessionID, testsessiontimeextension.FieldExtendedByID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestSessionTimeExtension fields.
+func (tste *TestSessionTimeExtension) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testsessiontimeextension.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tste.ID = *value
+			}
+		case testsessiontimeextension.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tste.CreatedAt = value.Time
+			}
+		case testsessiontimeextension.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tste.UpdatedAt = value.Time
+			}
+		case testsessiontimeextension.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tste.DeletedAt = new(time.Time)
+				*tste.DeletedAt = value.Time
+			}
+		case testsessiontimeextension.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				tste.SessionID = *value
+			}
+		case testsessiontimeextension.FieldExtendedByID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field extended_by_id", values[i])
+			} else if value != nil {
+				tste.ExtendedByID = *value
+			}
+		case testsessiontimeextension.FieldMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minutes", values[i])
+			} else if value.Valid {
+				tste.Minutes = int(value.Int64)
+			}
+		case testsessiontimeextension.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				tste.Reason = value.String
+			}
+		case testsessiontimeextension.FieldPreviousExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_expired_at", values[i])
+			} else if value.Valid {
+				tste.PreviousExpiredAt = value.Time
+			}
+		case testsessiontimeextension.FieldNewExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field new_expired_at", values[i])
+			} else if value.Valid {
+				tste.NewExpiredAt = value.Time
+			}
+		default:
+			tste.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestSessionTimeExtension.
+// This includes values selected through modifiers, order, etc.
+func (tste *TestSessionTimeExtension) Value(name string) (ent.Value, error) {
+	return tste.selectValues.Get(name)
+}
+
+// QueryTestSession queries the "test_session" edge of the TestSessionTimeExtension entity.
+func (tste *TestSessionTimeExtension) QueryTestSession() *TestSessionQuery {
+	return NewTestSessionTimeExtensionClient(tste.config).QueryTestSession(tste)
+}
+
+// QueryExtendedBy queries the "extended_by" edge of the TestSessionTimeExtension entity.
+func (tste *TestSessionTimeExtension) QueryExtendedBy() *UserQuery {
+	return NewTestSessionTimeExtensionClient(tste.config).QueryExtendedBy(tste)
+}
+
+// Update returns a builder for updating this TestSessionTimeExtension.
+// Note that you need to call TestSessionTimeExtension.Unwrap() before calling this method if this TestSessionTimeExtension
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tste *TestSessionTimeExtension) Update() *TestSessionTimeExtensionUpdateOne {
+	return NewTestSessionTimeExtensionClient(tste.config).UpdateOne(tste)
+}
+
+// Unwrap unwraps the TestSessionTimeExtension entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tste *TestSessionTimeExtension) Unwrap() *TestSessionTimeExtension {
+	_tx, ok := tste.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestSessionTimeExtension is not a transactional entity")
+	}
+	tste.config.driver = _tx.drv
+	return tste
+}
+
+// String implements the fmt.Stringer.
+func (tste *TestSessionTimeExtension) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestSessionTimeExtension(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tste.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tste.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tste.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tste.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", tste.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("extended_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", tste.ExtendedByID))
+	builder.WriteString(", ")
+	builder.WriteString("minutes=")
+	builder.WriteString(fmt.Sprintf("%v", tste.Minutes))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(tste.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("previous_expired_at=")
+	builder.WriteString(tste.PreviousExpiredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("new_expired_at=")
+	builder.WriteString(tste.NewExpiredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestSessionTimeExtensions is a parsable slice of TestSessionTimeExtension.
+type TestSessionTimeExtensions []*TestSessionTimeExtension
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessiontimeextension
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the testsessiontimeextension type in the database.
+	Label = "test_session_time_extension"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldExtendedByID holds the string denoting the extended_by_id field in the database.
+	FieldExtendedByID = "extended_by_id"
+	// FieldMinutes holds the string denoting the minutes field in the database.
+	FieldMinutes = "minutes"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldPreviousExpiredAt holds the string denoting the previous_expired_at field in the database.
+	FieldPreviousExpiredAt = "previous_expired_at"
+	// FieldNewExpiredAt holds the string denoting the new_expired_at field in the database.
+	FieldNewExpiredAt = "new_expired_at"
+	// EdgeTestSession holds the string denoting the test_session edge name in mutations.
+	EdgeTestSession = "test_session"
+	// EdgeExtendedBy holds the string denoting the extended_by edge name in mutations.
+	EdgeExtendedBy = "extended_by"
+	// Table holds the table name of the testsessiontimeextension in the database.
+	Table = "test_session_time_extensions"
+	// TestSessionTable is the table that holds the test_session relation/edge.
+	TestSessionTable = "test_session_time_extensions"
+	// TestSessionInverseTable is the table name for the TestSession entity.
+	// It exists in this package in order to avoid circular dependency with the "testsession" package.
+	TestSessionInverseTable = "test_sessions"
+	// TestSessionColumn is the table column denoting the test_session relation/edge.
+	TestSessionColumn = "session_id"
+	// ExtendedByTable is the table that holds the extended_by relation/edge.
+	ExtendedByTable = "test_session_time_extensions"
+	// ExtendedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ExtendedByInverseTable = "users"
+	// ExtendedByColumn is the table column denoting the extended_by relation/edge.
+	ExtendedByColumn = "extended_by_id"
+)
+
+// Columns holds all SQL columns for testsessiontimeextension fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldSessionID,
+	FieldExtendedByID,
+	FieldMinutes,
+	FieldReason,
+	FieldPreviousExpiredAt,
+	FieldNewExpiredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// MinutesValidator is a validator for the "minutes" field. It is called by the builders before save.
+	MinutesValidator func(int) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TestSessionTimeExtension queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByExtendedByID orders the results by the extended_by_id field.
+func ByExtendedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtendedByID, opts...).ToFunc()
+}
+
+// ByMinutes orders the results by the minutes field.
+func ByMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinutes, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByPreviousExpiredAt orders the results by the previous_expired_at field.
+func ByPreviousExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousExpiredAt, opts...).ToFunc()
+}
+
+// ByNewExpiredAt orders the results by the new_expired_at field.
+func ByNewExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewExpiredAt, opts...).ToFunc()
+}
+
+// ByTestSessionField orders the results by test_session field.
+func ByTestSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByExtendedByField orders the results by extended_by field.
+func ByExtendedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExtendedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTestSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+	)
+}
+func newExtendedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExtendedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ExtendedByTable, ExtendedByColumn),
+	)
+}
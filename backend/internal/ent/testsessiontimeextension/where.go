@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testsessiontimeextension
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldSessionID, v))
+}
+
+// ExtendedByID applies equality check predicate on the "extended_by_id" field. It's identical to ExtendedByIDEQ.
+func ExtendedByID(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldExtendedByID, v))
+}
+
+// Minutes applies equality check predicate on the "minutes" field. It's identical to MinutesEQ.
+func Minutes(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldMinutes, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldReason, v))
+}
+
+// PreviousExpiredAt applies equality check predicate on the "previous_expired_at" field. It's identical to PreviousExpiredAtEQ.
+func PreviousExpiredAt(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldPreviousExpiredAt, v))
+}
+
+// NewExpiredAt applies equality check predicate on the "new_expired_at" field. It's identical to NewExpiredAtEQ.
+func NewExpiredAt(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldNewExpiredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// ExtendedByIDEQ applies the EQ predicate on the "extended_by_id" field.
+func ExtendedByIDEQ(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldExtendedByID, v))
+}
+
+// ExtendedByIDNEQ applies the NEQ predicate on the "extended_by_id" field.
+func ExtendedByIDNEQ(v uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldExtendedByID, v))
+}
+
+// ExtendedByIDIn applies the In predicate on the "extended_by_id" field.
+func ExtendedByIDIn(vs ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldExtendedByID, vs...))
+}
+
+// ExtendedByIDNotIn applies the NotIn predicate on the "extended_by_id" field.
+func ExtendedByIDNotIn(vs ...uuid.UUID) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldExtendedByID, vs...))
+}
+
+// MinutesEQ applies the EQ predicate on the "minutes" field.
+func MinutesEQ(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldMinutes, v))
+}
+
+// MinutesNEQ applies the NEQ predicate on the "minutes" field.
+func MinutesNEQ(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldMinutes, v))
+}
+
+// MinutesIn applies the In predicate on the "minutes" field.
+func MinutesIn(vs ...int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldMinutes, vs...))
+}
+
+// MinutesNotIn applies the NotIn predicate on the "minutes" field.
+func MinutesNotIn(vs ...int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldMinutes, vs...))
+}
+
+// MinutesGT applies the GT predicate on the "minutes" field.
+func MinutesGT(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldMinutes, v))
+}
+
+// MinutesGTE applies the GTE predicate on the "minutes" field.
+func MinutesGTE(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldMinutes, v))
+}
+
+// MinutesLT applies the LT predicate on the "minutes" field.
+func MinutesLT(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldMinutes, v))
+}
+
+// MinutesLTE applies the LTE predicate on the "minutes" field.
+func MinutesLTE(v int) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldMinutes, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldContainsFold(FieldReason, v))
+}
+
+// PreviousExpiredAtEQ applies the EQ predicate on the "previous_expired_at" field.
+func PreviousExpiredAtEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldPreviousExpiredAt, v))
+}
+
+// PreviousExpiredAtNEQ applies the NEQ predicate on the "previous_expired_at" field.
+func PreviousExpiredAtNEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldPreviousExpiredAt, v))
+}
+
+// PreviousExpiredAtIn applies the In predicate on the "previous_expired_at" field.
+func PreviousExpiredAtIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldPreviousExpiredAt, vs...))
+}
+
+// PreviousExpiredAtNotIn applies the NotIn predicate on the "previous_expired_at" field.
+func PreviousExpiredAtNotIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldPreviousExpiredAt, vs...))
+}
+
+// PreviousExpiredAtGT applies the GT predicate on the "previous_expired_at" field.
+func PreviousExpiredAtGT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldPreviousExpiredAt, v))
+}
+
+// PreviousExpiredAtGTE applies the GTE predicate on the "previous_expired_at" field.
+func PreviousExpiredAtGTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldPreviousExpiredAt, v))
+}
+
+// PreviousExpiredAtLT applies the LT predicate on the "previous_expired_at" field.
+func PreviousExpiredAtLT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldPreviousExpiredAt, v))
+}
+
+// PreviousExpiredAtLTE applies the LTE predicate on the "previous_expired_at" field.
+func PreviousExpiredAtLTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldPreviousExpiredAt, v))
+}
+
+// NewExpiredAtEQ applies the EQ predicate on the "new_expired_at" field.
+func NewExpiredAtEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldEQ(FieldNewExpiredAt, v))
+}
+
+// NewExpiredAtNEQ applies the NEQ predicate on the "new_expired_at" field.
+func NewExpiredAtNEQ(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNEQ(FieldNewExpiredAt, v))
+}
+
+// NewExpiredAtIn applies the In predicate on the "new_expired_at" field.
+func NewExpiredAtIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldIn(FieldNewExpiredAt, vs...))
+}
+
+// NewExpiredAtNotIn applies the NotIn predicate on the "new_expired_at" field.
+func NewExpiredAtNotIn(vs ...time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldNotIn(FieldNewExpiredAt, vs...))
+}
+
+// NewExpiredAtGT applies the GT predicate on the "new_expired_at" field.
+func NewExpiredAtGT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGT(FieldNewExpiredAt, v))
+}
+
+// NewExpiredAtGTE applies the GTE predicate on the "new_expired_at" field.
+func NewExpiredAtGTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldGTE(FieldNewExpiredAt, v))
+}
+
+// NewExpiredAtLT applies the LT predicate on the "new_expired_at" field.
+func NewExpiredAtLT(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLT(FieldNewExpiredAt, v))
+}
+
+// NewExpiredAtLTE applies the LTE predicate on the "new_expired_at" field.
+func NewExpiredAtLTE(v time.Time) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.FieldLTE(FieldNewExpiredAt, v))
+}
+
+// HasTestSession applies the HasEdge predicate on the "test_session" edge.
+func HasTestSession() predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TestSessionTable, TestSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestSessionWith applies the HasEdge predicate on the "test_session" edge with a given conditions (other predicates).
+func HasTestSessionWith(preds ...predicate.TestSession) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		step := newTestSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExtendedBy applies the HasEdge predicate on the "extended_by" edge.
+func HasExtendedBy() predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExtendedByTable, ExtendedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExtendedByWith applies the HasEdge predicate on the "extended_by" edge with a given conditions (other predicates).
+func HasExtendedByWith(preds ...predicate.User) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		step := newExtendedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TestSessionTimeExtension) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TestSessionTimeExtension) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TestSessionTimeExtension) predicate.TestSessionTimeExtension {
+	return predicate.TestSessionTimeExtension(sql.NotPredicates(p))
+}
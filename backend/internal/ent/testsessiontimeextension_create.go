@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessiontimeextension"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionTimeExtensionCreate is the builder for creating a TestSessionTimeExtension entity.
+type TestSessionTimeExtensionCreate struct {
+	config
+	mutation *TestSessionTimeExtensionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tstec *TestSessionTimeExtensionCreate) SetCreatedAt(t time.Time) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetCreatedAt(t)
+	return tstec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tstec *TestSessionTimeExtensionCreate) SetNillableCreatedAt(t *time.Time) *TestSessionTimeExtensionCreate {
+	if t != nil {
+		tstec.SetCreatedAt(*t)
+	}
+	return tstec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tstec *TestSessionTimeExtensionCreate) SetUpdatedAt(t time.Time) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetUpdatedAt(t)
+	return tstec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tstec *TestSessionTimeExtensionCreate) SetNillableUpdatedAt(t *time.Time) *TestSessionTimeExtensionCreate {
+	if t != nil {
+		tstec.SetUpdatedAt(*t)
+	}
+	return tstec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tstec *TestSessionTimeExtensionCreate) SetDeletedAt(t time.Time) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetDeletedAt(t)
+	return tstec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tstec *TestSessionTimeExtensionCreate) SetNillableDeletedAt(t *time.Time) *TestSessionTimeExtensionCreate {
+	if t != nil {
+		tstec.SetDeletedAt(*t)
+	}
+	return tstec
+}
+
+// SetSessionID sets the "session_id" field.
+func (tstec *TestSessionTimeExtensionCreate) SetSessionID(u uuid.UUID) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetSessionID(u)
+	return tstec
+}
+
+// SetExtendedByID sets the "extended_by_id" field.
+func (tstec *TestSessionTimeExtensionCreate) SetExtendedByID(u uuid.UUID) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetExtendedByID(u)
+	return tstec
+}
+
+// SetMinutes sets the "minutes" field.
+func (tstec *TestSessionTimeExtensionCreate) SetMinutes(i int) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetMinutes(i)
+	return tstec
+}
+
+// SetReason sets the "reason" field.
+func (tstec *TestSessionTimeExtensionCreate) SetReason(s string) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetReason(s)
+	return tstec
+}
+
+// SetPreviousExpiredAt sets the "previous_expired_at" field.
+func (tstec *TestSessionTimeExtensionCreate) SetPreviousExpiredAt(t time.Time) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetPreviousExpiredAt(t)
+	return tstec
+}
+
+// SetNewExpiredAt sets the "new_expired_at" field.
+func (tstec *TestSessionTimeExtensionCreate) SetNewExpiredAt(t time.Time) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetNewExpiredAt(t)
+	return tstec
+}
+
+// SetID sets the "id" field.
+func (tstec *TestSessionTimeExtensionCreate) SetID(u uuid.UUID) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetID(u)
+	return tstec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tstec *TestSessionTimeExtensionCreate) SetNillableID(u *uuid.UUID) *TestSessionTimeExtensionCreate {
+	if u != nil {
+		tstec.SetID(*u)
+	}
+	return tstec
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tstec *TestSessionTimeExtensionCreate) SetTestSessionID(id uuid.UUID) *TestSessionTimeExtensionCreate {
+	tstec.mutation.SetTestSessionID(id)
+	return tstec
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tstec *TestSessionTimeExtensionCreate) SetTestSession(t *TestSession) *TestSessionTimeExtensionCreate {
+	return tstec.SetTestSessionID(t.ID)
+}
+
+// SetExtendedBy sets the "extended_by" edge to the User entity.
+func (tstec *TestSessionTimeExtensionCreate) SetExtendedBy(u *User) *TestSessionTimeExtensionCreate {
+	return tstec.SetExtendedByID(u.ID)
+}
+
+// Mutation returns the TestSessionTimeExtensionMutation object of the builder.
+func (tstec *TestSessionTimeExtensionCreate) Mutation() *TestSessionTimeExtensionMutation {
+	return tstec.mutation
+}
+
+// Save creates the TestSessionTimeExtension in the database.
+func (tstec *TestSessionTimeExtensionCreate) Save(ctx context.Context) (*TestSessionTimeExtension, error) {
+	if err := tstec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tstec.sqlSave, tstec.mutation, tstec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tstec *TestSessionTimeExtensionCreate) SaveX(ctx context.Context) *TestSessionTimeExtension {
+	v, err := tstec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tstec *TestSessionTimeExtensionCreate) Exec(ctx context.Context) error {
+	_, err := tstec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tstec *TestSessionTimeExtensionCreate) ExecX(ctx context.Context) {
+	if err := tstec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tstec *TestSessionTimeExtensionCreate) defaults() error {
+	if _, ok := tstec.mutation.CreatedAt(); !ok {
+		if testsessiontimeextension.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessiontimeextension.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessiontimeextension.DefaultCreatedAt()
+		tstec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tstec.mutation.UpdatedAt(); !ok {
+		if testsessiontimeextension.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessiontimeextension.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessiontimeextension.DefaultUpdatedAt()
+		tstec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tstec.mutation.ID(); !ok {
+		if testsessiontimeextension.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized testsessiontimeextension.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := testsessiontimeextension.DefaultID()
+		tstec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tstec *TestSessionTimeExtensionCreate) check() error {
+	if _, ok := tstec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TestSessionTimeExtension.created_at"`)}
+	}
+	if _, ok := tstec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TestSessionTimeExtension.updated_at"`)}
+	}
+	if _, ok := tstec.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TestSessionTimeExtension.session_id"`)}
+	}
+	if _, ok := tstec.mutation.ExtendedByID(); !ok {
+		return &ValidationError{Name: "extended_by_id", err: errors.New(`ent: missing required field "TestSessionTimeExtension.extended_by_id"`)}
+	}
+	if _, ok := tstec.mutation.Minutes(); !ok {
+		return &ValidationError{Name: "minutes", err: errors.New(`ent: missing required field "TestSessionTimeExtension.minutes"`)}
+	}
+	if v, ok := tstec.mutation.Minutes(); ok {
+		if err := testsessiontimeextension.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.minutes": %w`, err)}
+		}
+	}
+	if _, ok := tstec.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "TestSessionTimeExtension.reason"`)}
+	}
+	if v, ok := tstec.mutation.Reason(); ok {
+		if err := testsessiontimeextension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.reason": %w`, err)}
+		}
+	}
+	if _, ok := tstec.mutation.PreviousExpiredAt(); !ok {
+		return &ValidationError{Name: "previous_expired_at", err: errors.New(`ent: missing required field "TestSessionTimeExtension.previous_expired_at"`)}
+	}
+	if _, ok := tstec.mutation.NewExpiredAt(); !ok {
+		return &ValidationError{Name: "new_expired_at", err: errors.New(`ent: missing required field "TestSessionTimeExtension.new_expired_at"`)}
+	}
+	if len(tstec.mutation.TestSessionIDs()) == 0 {
+		return &ValidationError{Name: "test_session", err: errors.New(`ent: missing required edge "TestSessionTimeExtension.test_session"`)}
+	}
+	if len(tstec.mutation.ExtendedByIDs()) == 0 {
+		return &ValidationError{Name: "extended_by", err: errors.New(`ent: missing required edge "TestSessionTimeExtension.extended_by"`)}
+	}
+	return nil
+}
+
+func (tstec *TestSessionTimeExtensionCreate) sqlSave(ctx context.Context) (*TestSessionTimeExtension, error) {
+	if err := tstec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tstec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tstec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tstec.mutation.id = &_node.ID
+	tstec.mutation.done = true
+	return _node, nil
+}
+
+func (tstec *TestSessionTimeExtensionCreate) createSpec() (*TestSessionTimeExtension, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestSessionTimeExtension{config: tstec.config}
+		_spec = sqlgraph.NewCreateSpec(testsessiontimeextension.Table, sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID))
+	)
+	if id, ok := tstec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tstec.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tstec.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tstec.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tstec.mutation.Minutes(); ok {
+		_spec.SetField(testsessiontimeextension.FieldMinutes, field.TypeInt, value)
+		_node.Minutes = value
+	}
+	if value, ok := tstec.mutation.Reason(); ok {
+		_spec.SetField(testsessiontimeextension.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := tstec.mutation.PreviousExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldPreviousExpiredAt, field.TypeTime, value)
+		_node.PreviousExpiredAt = value
+	}
+	if value, ok := tstec.mutation.NewExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldNewExpiredAt, field.TypeTime, value)
+		_node.NewExpiredAt = value
+	}
+	if nodes := tstec.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.TestSessionTable,
+			Columns: []string{testsessiontimeextension.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tstec.mutation.ExtendedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.ExtendedByTable,
+			Columns: []string{testsessiontimeextension.ExtendedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ExtendedByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TestSessionTimeExtensionCreateBulk is the builder for creating many TestSessionTimeExtension entities in bulk.
+type TestSessionTimeExtensionCreateBulk struct {
+	config
+	err      error
+	builders []*TestSessionTimeExtensionCreate
+}
+
+// Save creates the TestSessionTimeExtension entities in the database.
+func (tstecb *TestSessionTimeExtensionCreateBulk) Save(ctx context.Context) ([]*TestSessionTimeExtension, error) {
+	if tstecb.err != nil {
+		return nil, tstecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tstecb.builders))
+	nodes := make([]*TestSessionTimeExtension, len(tstecb.builders))
+	mutators := make([]Mutator, len(tstecb.builders))
+	for i := range tstecb.builders {
+		func(i int, root context.Context) {
+			builder := tstecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestSessionTimeExtensionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tstecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tstecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tstecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tstecb *TestSessionTimeExtensionCreateBulk) SaveX(ctx context.Context) []*TestSessionTimeExtension {
+	v, err := tstecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tstecb *TestSessionTimeExtensionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tstecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tstecb *TestSessionTimeExtensionCreateBulk) ExecX(ctx context.Context) {
+	if err := tstecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
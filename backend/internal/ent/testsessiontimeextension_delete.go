@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsessiontimeextension"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TestSessionTimeExtensionDelete is the builder for deleting a TestSessionTimeExtension entity.
+type TestSessionTimeExtensionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionTimeExtensionMutation
+}
+
+// Where appends a list predicates to the TestSessionTimeExtensionDelete builder.
+func (tsted *TestSessionTimeExtensionDelete) Where(ps ...predicate.TestSessionTimeExtension) *TestSessionTimeExtensionDelete {
+	tsted.mutation.Where(ps...)
+	return tsted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsted *TestSessionTimeExtensionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsted.sqlExec, tsted.mutation, tsted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsted *TestSessionTimeExtensionDelete) ExecX(ctx context.Context) int {
+	n, err := tsted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsted *TestSessionTimeExtensionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testsessiontimeextension.Table, sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID))
+	if ps := tsted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsted.mutation.done = true
+	return affected, err
+}
+
+// TestSessionTimeExtensionDeleteOne is the builder for deleting a single TestSessionTimeExtension entity.
+type TestSessionTimeExtensionDeleteOne struct {
+	tsted *TestSessionTimeExtensionDelete
+}
+
+// Where appends a list predicates to the TestSessionTimeExtensionDelete builder.
+func (tstedo *TestSessionTimeExtensionDeleteOne) Where(ps ...predicate.TestSessionTimeExtension) *TestSessionTimeExtensionDeleteOne {
+	tstedo.tsted.mutation.Where(ps...)
+	return tstedo
+}
+
+// Exec executes the deletion query.
+func (tstedo *TestSessionTimeExtensionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tstedo.tsted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testsessiontimeextension.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tstedo *TestSessionTimeExtensionDeleteOne) ExecX(ctx context.Context) {
+	if err := tstedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
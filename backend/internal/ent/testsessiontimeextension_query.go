@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessiontimeextension"
+	"template/internal/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionTimeExtensionQuery is the builder for querying TestSessionTimeExtension entities.
+type TestSessionTimeExtensionQuery struct {
+	config
+	ctx             *QueryContext
+	order           []testsessiontimeextension.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TestSessionTimeExtension
+	withTestSession *TestSessionQuery
+	withExtendedBy  *UserQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestSessionTimeExtensionQuery builder.
+func (tsteq *TestSessionTimeExtensionQuery) Where(ps ...predicate.TestSessionTimeExtension) *TestSessionTimeExtensionQuery {
+	tsteq.predicates = append(tsteq.predicates, ps...)
+	return tsteq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsteq *TestSessionTimeExtensionQuery) Limit(limit int) *TestSessionTimeExtensionQuery {
+	tsteq.ctx.Limit = &limit
+	return tsteq
+}
+
+// Offset to start from.
+func (tsteq *TestSessionTimeExtensionQuery) Offset(offset int) *TestSessionTimeExtensionQuery {
+	tsteq.ctx.Offset = &offset
+	return tsteq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsteq *TestSessionTimeExtensionQuery) Unique(unique bool) *TestSessionTimeExtensionQuery {
+	tsteq.ctx.Unique = &unique
+	return tsteq
+}
+
+// Order specifies how the records should be ordered.
+func (tsteq *TestSessionTimeExtensionQuery) Order(o ...testsessiontimeextension.OrderOption) *TestSessionTimeExtensionQuery {
+	tsteq.order = append(tsteq.order, o...)
+	return tsteq
+}
+
+// QueryTestSession chains the current query on the "test_session" edge.
+func (tsteq *TestSessionTimeExtensionQuery) QueryTestSession() *TestSessionQuery {
+	query := (&TestSessionClient{config: tsteq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsteq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsteq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessiontimeextension.Table, testsessiontimeextension.FieldID, selector),
+			sqlgraph.To(testsession.Table, testsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessiontimeextension.TestSessionTable, testsessiontimeextension.TestSessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsteq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExtendedBy chains the current query on the "extended_by" edge.
+func (tsteq *TestSessionTimeExtensionQuery) QueryExtendedBy() *UserQuery {
+	query := (&UserClient{config: tsteq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsteq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsteq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testsessiontimeextension.Table, testsessiontimeextension.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testsessiontimeextension.ExtendedByTable, testsessiontimeextension.ExtendedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsteq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestSessionTimeExtension entity from the query.
+// Returns a *NotFoundError when no TestSessionTimeExtension was found.
+func (tsteq *TestSessionTimeExtensionQuery) First(ctx context.Context) (*TestSessionTimeExtension, error) {
+	nodes, err := tsteq.Limit(1).All(setContextOp(ctx, tsteq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testsessiontimeextension.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) FirstX(ctx context.Context) *TestSessionTimeExtension {
+	node, err := tsteq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestSessionTimeExtension ID from the query.
+// Returns a *NotFoundError when no TestSessionTimeExtension ID was found.
+func (tsteq *TestSessionTimeExtensionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsteq.Limit(1).IDs(setContextOp(ctx, tsteq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testsessiontimeextension.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsteq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestSessionTimeExtension entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestSessionTimeExtension entity is found.
+// Returns a *NotFoundError when no TestSessionTimeExtension entities are found.
+func (tsteq *TestSessionTimeExtensionQuery) Only(ctx context.Context) (*TestSessionTimeExtension, error) {
+	nodes, err := tsteq.Limit(2).All(setContextOp(ctx, tsteq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testsessiontimeextension.Label}
+	default:
+		return nil, &NotSingularError{testsessiontimeextension.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) OnlyX(ctx context.Context) *TestSessionTimeExtension {
+	node, err := tsteq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestSessionTimeExtension ID in the query.
+// Returns a *NotSingularError when more than one TestSessionTimeExtension ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsteq *TestSessionTimeExtensionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsteq.Limit(2).IDs(setContextOp(ctx, tsteq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testsessiontimeextension.Label}
+	default:
+		err = &NotSingularError{testsessiontimeextension.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsteq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestSessionTimeExtensions.
+func (tsteq *TestSessionTimeExtensionQuery) All(ctx context.Context) ([]*TestSessionTimeExtension, error) {
+	ctx = setContextOp(ctx, tsteq.ctx, ent.OpQueryAll)
+	if err := tsteq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestSessionTimeExtension, *TestSessionTimeExtensionQuery]()
+	return withInterceptors[[]*TestSessionTimeExtension](ctx, tsteq, qr, tsteq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) AllX(ctx context.Context) []*TestSessionTimeExtension {
+	nodes, err := tsteq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestSessionTimeExtension IDs.
+func (tsteq *TestSessionTimeExtensionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsteq.ctx.Unique == nil && tsteq.path != nil {
+		tsteq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsteq.ctx, ent.OpQueryIDs)
+	if err = tsteq.Select(testsessiontimeextension.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsteq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsteq *TestSessionTimeExtensionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsteq.ctx, ent.OpQueryCount)
+	if err := tsteq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsteq, querierCount[*TestSessionTimeExtensionQuery](), tsteq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) CountX(ctx context.Context) int {
+	count, err := tsteq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsteq *TestSessionTimeExtensionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsteq.ctx, ent.OpQueryExist)
+	switch _, err := tsteq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsteq *TestSessionTimeExtensionQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsteq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestSessionTimeExtensionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsteq *TestSessionTimeExtensionQuery) Clone() *TestSessionTimeExtensionQuery {
+	if tsteq == nil {
+		return nil
+	}
+	return &TestSessionTimeExtensionQuery{
+		config:          tsteq.config,
+		ctx:             tsteq.ctx.Clone(),
+		order:           append([]testsessiontimeextension.OrderOption{}, tsteq.order...),
+		inters:          append([]Interceptor{}, tsteq.inters...),
+		predicates:      append([]predicate.TestSessionTimeExtension{}, tsteq.predicates...),
+		withTestSession: tsteq.withTestSession.Clone(),
+		withExtendedBy:  tsteq.withExtendedBy.Clone(),
+		// clone intermediate query.
+		sql:  tsteq.sql.Clone(),
+		path: tsteq.path,
+	}
+}
+
+// WithTestSession tells the query-builder to eager-load the nodes that are connected to
+// the "test_session" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsteq *TestSessionTimeExtensionQuery) WithTestSession(opts ...func(*TestSessionQuery)) *TestSessionTimeExtensionQuery {
+	query := (&TestSessionClient{config: tsteq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsteq.withTestSession = query
+	return tsteq
+}
+
+// WithExtendedBy tells the query-builder to eager-load the nodes that are connected to
+// the "extended_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsteq *TestSessionTimeExtensionQuery) WithExtendedBy(opts ...func(*UserQuery)) *TestSessionTimeExtensionQuery {
+	query := (&UserClient{config: tsteq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsteq.withExtendedBy = query
+	return tsteq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestSessionTimeExtension.Query().
+//		GroupBy(testsessiontimeextension.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsteq *TestSessionTimeExtensionQuery) GroupBy(field string, fields ...string) *TestSessionTimeExtensionGroupBy {
+	tsteq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestSessionTimeExtensionGroupBy{build: tsteq}
+	grbuild.flds = &tsteq.ctx.Fields
+	grbuild.label = testsessiontimeextension.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TestSessionTimeExtension.Query().
+//		Select(testsessiontimeextension.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tsteq *TestSessionTimeExtensionQuery) Select(fields ...string) *TestSessionTimeExtensionSelect {
+	tsteq.ctx.Fields = append(tsteq.ctx.Fields, fields...)
+	sbuild := &TestSessionTimeExtensionSelect{TestSessionTimeExtensionQuery: tsteq}
+	sbuild.label = testsessiontimeextension.Label
+	sbuild.flds, sbuild.scan = &tsteq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestSessionTimeExtensionSelect configured with the given aggregations.
+func (tsteq *TestSessionTimeExtensionQuery) Aggregate(fns ...AggregateFunc) *TestSessionTimeExtensionSelect {
+	return tsteq.Select().Aggregate(fns...)
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsteq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsteq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsteq.ctx.Fields {
+		if !testsessiontimeextension.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsteq.path != nil {
+		prev, err := tsteq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsteq.sql = prev
+	}
+	return nil
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestSessionTimeExtension, error) {
+	var (
+		nodes       = []*TestSessionTimeExtension{}
+		_spec       = tsteq.querySpec()
+		loadedTypes = [2]bool{
+			tsteq.withTestSession != nil,
+			tsteq.withExtendedBy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestSessionTimeExtension).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestSessionTimeExtension{config: tsteq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsteq.modifiers) > 0 {
+		_spec.Modifiers = tsteq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsteq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsteq.withTestSession; query != nil {
+		if err := tsteq.loadTestSession(ctx, query, nodes, nil,
+			func(n *TestSessionTimeExtension, e *TestSession) { n.Edges.TestSession = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsteq.withExtendedBy; query != nil {
+		if err := tsteq.loadExtendedBy(ctx, query, nodes, nil,
+			func(n *TestSessionTimeExtension, e *User) { n.Edges.ExtendedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) loadTestSession(ctx context.Context, query *TestSessionQuery, nodes []*TestSessionTimeExtension, init func(*TestSessionTimeExtension), assign func(*TestSessionTimeExtension, *TestSession)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionTimeExtension)
+	for i := range nodes {
+		fk := nodes[i].SessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(testsession.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tsteq *TestSessionTimeExtensionQuery) loadExtendedBy(ctx context.Context, query *UserQuery, nodes []*TestSessionTimeExtension, init func(*TestSessionTimeExtension), assign func(*TestSessionTimeExtension, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TestSessionTimeExtension)
+	for i := range nodes {
+		fk := nodes[i].ExtendedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "extended_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsteq.querySpec()
+	if len(tsteq.modifiers) > 0 {
+		_spec.Modifiers = tsteq.modifiers
+	}
+	_spec.Node.Columns = tsteq.ctx.Fields
+	if len(tsteq.ctx.Fields) > 0 {
+		_spec.Unique = tsteq.ctx.Unique != nil && *tsteq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsteq.driver, _spec)
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testsessiontimeextension.Table, testsessiontimeextension.Columns, sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID))
+	_spec.From = tsteq.sql
+	if unique := tsteq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsteq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsteq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessiontimeextension.FieldID)
+		for i := range fields {
+			if fields[i] != testsessiontimeextension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsteq.withTestSession != nil {
+			_spec.Node.AddColumnOnce(testsessiontimeextension.FieldSessionID)
+		}
+		if tsteq.withExtendedBy != nil {
+			_spec.Node.AddColumnOnce(testsessiontimeextension.FieldExtendedByID)
+		}
+	}
+	if ps := tsteq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsteq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsteq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsteq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsteq *TestSessionTimeExtensionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsteq.driver.Dialect())
+	t1 := builder.Table(testsessiontimeextension.Table)
+	columns := tsteq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testsessiontimeextension.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsteq.sql != nil {
+		selector = tsteq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsteq.ctx.Unique != nil && *tsteq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsteq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsteq.predicates {
+		p(selector)
+	}
+	for _, p := range tsteq.order {
+		p(selector)
+	}
+	if offset := tsteq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsteq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsteq *TestSessionTimeExtensionQuery) ForUpdate(opts ...sql.LockOption) *TestSessionTimeExtensionQuery {
+	if tsteq.driver.Dialect() == dialect.Postgres {
+		tsteq.Unique(false)
+	}
+	tsteq.modifiers = append(tsteq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsteq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsteq *TestSessionTimeExtensionQuery) ForShare(opts ...sql.LockOption) *TestSessionTimeExtensionQuery {
+	if tsteq.driver.Dialect() == dialect.Postgres {
+		tsteq.Unique(false)
+	}
+	tsteq.modifiers = append(tsteq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsteq
+}
+
+// TestSessionTimeExtensionGroupBy is the group-by builder for TestSessionTimeExtension entities.
+type TestSessionTimeExtensionGroupBy struct {
+	selector
+	build *TestSessionTimeExtensionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tstegb *TestSessionTimeExtensionGroupBy) Aggregate(fns ...AggregateFunc) *TestSessionTimeExtensionGroupBy {
+	tstegb.fns = append(tstegb.fns, fns...)
+	return tstegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tstegb *TestSessionTimeExtensionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tstegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tstegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionTimeExtensionQuery, *TestSessionTimeExtensionGroupBy](ctx, tstegb.build, tstegb, tstegb.build.inters, v)
+}
+
+func (tstegb *TestSessionTimeExtensionGroupBy) sqlScan(ctx context.Context, root *TestSessionTimeExtensionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tstegb.fns))
+	for _, fn := range tstegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tstegb.flds)+len(tstegb.fns))
+		for _, f := range *tstegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tstegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tstegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestSessionTimeExtensionSelect is the builder for selecting fields of TestSessionTimeExtension entities.
+type TestSessionTimeExtensionSelect struct {
+	*TestSessionTimeExtensionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tstes *TestSessionTimeExtensionSelect) Aggregate(fns ...AggregateFunc) *TestSessionTimeExtensionSelect {
+	tstes.fns = append(tstes.fns, fns...)
+	return tstes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tstes *TestSessionTimeExtensionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tstes.ctx, ent.OpQuerySelect)
+	if err := tstes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestSessionTimeExtensionQuery, *TestSessionTimeExtensionSelect](ctx, tstes.TestSessionTimeExtensionQuery, tstes, tstes.inters, v)
+}
+
+func (tstes *TestSessionTimeExtensionSelect) sqlScan(ctx context.Context, root *TestSessionTimeExtensionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tstes.fns))
+	for _, fn := range tstes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tstes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tstes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessiontimeextension"
+	"template/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TestSessionTimeExtensionUpdate is the builder for updating TestSessionTimeExtension entities.
+type TestSessionTimeExtensionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TestSessionTimeExtensionMutation
+}
+
+// Where appends a list predicates to the TestSessionTimeExtensionUpdate builder.
+func (tsteu *TestSessionTimeExtensionUpdate) Where(ps ...predicate.TestSessionTimeExtension) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.Where(ps...)
+	return tsteu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetCreatedAt(t time.Time) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetCreatedAt(t)
+	return tsteu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableCreatedAt(t *time.Time) *TestSessionTimeExtensionUpdate {
+	if t != nil {
+		tsteu.SetCreatedAt(*t)
+	}
+	return tsteu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetUpdatedAt(t time.Time) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetUpdatedAt(t)
+	return tsteu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetDeletedAt(t time.Time) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetDeletedAt(t)
+	return tsteu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableDeletedAt(t *time.Time) *TestSessionTimeExtensionUpdate {
+	if t != nil {
+		tsteu.SetDeletedAt(*t)
+	}
+	return tsteu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) ClearDeletedAt() *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.ClearDeletedAt()
+	return tsteu
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetSessionID(u uuid.UUID) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetSessionID(u)
+	return tsteu
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableSessionID(u *uuid.UUID) *TestSessionTimeExtensionUpdate {
+	if u != nil {
+		tsteu.SetSessionID(*u)
+	}
+	return tsteu
+}
+
+// SetExtendedByID sets the "extended_by_id" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetExtendedByID(u uuid.UUID) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetExtendedByID(u)
+	return tsteu
+}
+
+// SetNillableExtendedByID sets the "extended_by_id" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableExtendedByID(u *uuid.UUID) *TestSessionTimeExtensionUpdate {
+	if u != nil {
+		tsteu.SetExtendedByID(*u)
+	}
+	return tsteu
+}
+
+// SetMinutes sets the "minutes" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetMinutes(i int) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.ResetMinutes()
+	tsteu.mutation.SetMinutes(i)
+	return tsteu
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableMinutes(i *int) *TestSessionTimeExtensionUpdate {
+	if i != nil {
+		tsteu.SetMinutes(*i)
+	}
+	return tsteu
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (tsteu *TestSessionTimeExtensionUpdate) AddMinutes(i int) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.AddMinutes(i)
+	return tsteu
+}
+
+// SetReason sets the "reason" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetReason(s string) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetReason(s)
+	return tsteu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableReason(s *string) *TestSessionTimeExtensionUpdate {
+	if s != nil {
+		tsteu.SetReason(*s)
+	}
+	return tsteu
+}
+
+// SetPreviousExpiredAt sets the "previous_expired_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetPreviousExpiredAt(t time.Time) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetPreviousExpiredAt(t)
+	return tsteu
+}
+
+// SetNillablePreviousExpiredAt sets the "previous_expired_at" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillablePreviousExpiredAt(t *time.Time) *TestSessionTimeExtensionUpdate {
+	if t != nil {
+		tsteu.SetPreviousExpiredAt(*t)
+	}
+	return tsteu
+}
+
+// SetNewExpiredAt sets the "new_expired_at" field.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNewExpiredAt(t time.Time) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetNewExpiredAt(t)
+	return tsteu
+}
+
+// SetNillableNewExpiredAt sets the "new_expired_at" field if the given value is not nil.
+func (tsteu *TestSessionTimeExtensionUpdate) SetNillableNewExpiredAt(t *time.Time) *TestSessionTimeExtensionUpdate {
+	if t != nil {
+		tsteu.SetNewExpiredAt(*t)
+	}
+	return tsteu
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsteu *TestSessionTimeExtensionUpdate) SetTestSessionID(id uuid.UUID) *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.SetTestSessionID(id)
+	return tsteu
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsteu *TestSessionTimeExtensionUpdate) SetTestSession(t *TestSession) *TestSessionTimeExtensionUpdate {
+	return tsteu.SetTestSessionID(t.ID)
+}
+
+// SetExtendedBy sets the "extended_by" edge to the User entity.
+func (tsteu *TestSessionTimeExtensionUpdate) SetExtendedBy(u *User) *TestSessionTimeExtensionUpdate {
+	return tsteu.SetExtendedByID(u.ID)
+}
+
+// Mutation returns the TestSessionTimeExtensionMutation object of the builder.
+func (tsteu *TestSessionTimeExtensionUpdate) Mutation() *TestSessionTimeExtensionMutation {
+	return tsteu.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsteu *TestSessionTimeExtensionUpdate) ClearTestSession() *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.ClearTestSession()
+	return tsteu
+}
+
+// ClearExtendedBy clears the "extended_by" edge to the User entity.
+func (tsteu *TestSessionTimeExtensionUpdate) ClearExtendedBy() *TestSessionTimeExtensionUpdate {
+	tsteu.mutation.ClearExtendedBy()
+	return tsteu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsteu *TestSessionTimeExtensionUpdate) Save(ctx context.Context) (int, error) {
+	if err := tsteu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tsteu.sqlSave, tsteu.mutation, tsteu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsteu *TestSessionTimeExtensionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsteu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsteu *TestSessionTimeExtensionUpdate) Exec(ctx context.Context) error {
+	_, err := tsteu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsteu *TestSessionTimeExtensionUpdate) ExecX(ctx context.Context) {
+	if err := tsteu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsteu *TestSessionTimeExtensionUpdate) defaults() error {
+	if _, ok := tsteu.mutation.UpdatedAt(); !ok {
+		if testsessiontimeextension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessiontimeextension.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessiontimeextension.UpdateDefaultUpdatedAt()
+		tsteu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsteu *TestSessionTimeExtensionUpdate) check() error {
+	if v, ok := tsteu.mutation.Minutes(); ok {
+		if err := testsessiontimeextension.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.minutes": %w`, err)}
+		}
+	}
+	if v, ok := tsteu.mutation.Reason(); ok {
+		if err := testsessiontimeextension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.reason": %w`, err)}
+		}
+	}
+	if tsteu.mutation.TestSessionCleared() && len(tsteu.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionTimeExtension.test_session"`)
+	}
+	if tsteu.mutation.ExtendedByCleared() && len(tsteu.mutation.ExtendedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionTimeExtension.extended_by"`)
+	}
+	return nil
+}
+
+func (tsteu *TestSessionTimeExtensionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsteu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessiontimeextension.Table, testsessiontimeextension.Columns, sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID))
+	if ps := tsteu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsteu.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsteu.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsteu.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsteu.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessiontimeextension.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsteu.mutation.Minutes(); ok {
+		_spec.SetField(testsessiontimeextension.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsteu.mutation.AddedMinutes(); ok {
+		_spec.AddField(testsessiontimeextension.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsteu.mutation.Reason(); ok {
+		_spec.SetField(testsessiontimeextension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := tsteu.mutation.PreviousExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldPreviousExpiredAt, field.TypeTime, value)
+	}
+	if value, ok := tsteu.mutation.NewExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldNewExpiredAt, field.TypeTime, value)
+	}
+	if tsteu.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.TestSessionTable,
+			Columns: []string{testsessiontimeextension.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsteu.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.TestSessionTable,
+			Columns: []string{testsessiontimeextension.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsteu.mutation.ExtendedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.ExtendedByTable,
+			Columns: []string{testsessiontimeextension.ExtendedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsteu.mutation.ExtendedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.ExtendedByTable,
+			Columns: []string{testsessiontimeextension.ExtendedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsteu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessiontimeextension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsteu.mutation.done = true
+	return n, nil
+}
+
+// TestSessionTimeExtensionUpdateOne is the builder for updating a single TestSessionTimeExtension entity.
+type TestSessionTimeExtensionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TestSessionTimeExtensionMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetCreatedAt(t time.Time) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetCreatedAt(t)
+	return tsteuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableCreatedAt(t *time.Time) *TestSessionTimeExtensionUpdateOne {
+	if t != nil {
+		tsteuo.SetCreatedAt(*t)
+	}
+	return tsteuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetUpdatedAt(t time.Time) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetUpdatedAt(t)
+	return tsteuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetDeletedAt(t time.Time) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetDeletedAt(t)
+	return tsteuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableDeletedAt(t *time.Time) *TestSessionTimeExtensionUpdateOne {
+	if t != nil {
+		tsteuo.SetDeletedAt(*t)
+	}
+	return tsteuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) ClearDeletedAt() *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.ClearDeletedAt()
+	return tsteuo
+}
+
+// SetSessionID sets the "session_id" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetSessionID(u uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetSessionID(u)
+	return tsteuo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableSessionID(u *uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	if u != nil {
+		tsteuo.SetSessionID(*u)
+	}
+	return tsteuo
+}
+
+// SetExtendedByID sets the "extended_by_id" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetExtendedByID(u uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetExtendedByID(u)
+	return tsteuo
+}
+
+// SetNillableExtendedByID sets the "extended_by_id" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableExtendedByID(u *uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	if u != nil {
+		tsteuo.SetExtendedByID(*u)
+	}
+	return tsteuo
+}
+
+// SetMinutes sets the "minutes" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetMinutes(i int) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.ResetMinutes()
+	tsteuo.mutation.SetMinutes(i)
+	return tsteuo
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableMinutes(i *int) *TestSessionTimeExtensionUpdateOne {
+	if i != nil {
+		tsteuo.SetMinutes(*i)
+	}
+	return tsteuo
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) AddMinutes(i int) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.AddMinutes(i)
+	return tsteuo
+}
+
+// SetReason sets the "reason" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetReason(s string) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetReason(s)
+	return tsteuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableReason(s *string) *TestSessionTimeExtensionUpdateOne {
+	if s != nil {
+		tsteuo.SetReason(*s)
+	}
+	return tsteuo
+}
+
+// SetPreviousExpiredAt sets the "previous_expired_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetPreviousExpiredAt(t time.Time) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetPreviousExpiredAt(t)
+	return tsteuo
+}
+
+// SetNillablePreviousExpiredAt sets the "previous_expired_at" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillablePreviousExpiredAt(t *time.Time) *TestSessionTimeExtensionUpdateOne {
+	if t != nil {
+		tsteuo.SetPreviousExpiredAt(*t)
+	}
+	return tsteuo
+}
+
+// SetNewExpiredAt sets the "new_expired_at" field.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNewExpiredAt(t time.Time) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetNewExpiredAt(t)
+	return tsteuo
+}
+
+// SetNillableNewExpiredAt sets the "new_expired_at" field if the given value is not nil.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetNillableNewExpiredAt(t *time.Time) *TestSessionTimeExtensionUpdateOne {
+	if t != nil {
+		tsteuo.SetNewExpiredAt(*t)
+	}
+	return tsteuo
+}
+
+// SetTestSessionID sets the "test_session" edge to the TestSession entity by ID.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetTestSessionID(id uuid.UUID) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.SetTestSessionID(id)
+	return tsteuo
+}
+
+// SetTestSession sets the "test_session" edge to the TestSession entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetTestSession(t *TestSession) *TestSessionTimeExtensionUpdateOne {
+	return tsteuo.SetTestSessionID(t.ID)
+}
+
+// SetExtendedBy sets the "extended_by" edge to the User entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SetExtendedBy(u *User) *TestSessionTimeExtensionUpdateOne {
+	return tsteuo.SetExtendedByID(u.ID)
+}
+
+// Mutation returns the TestSessionTimeExtensionMutation object of the builder.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) Mutation() *TestSessionTimeExtensionMutation {
+	return tsteuo.mutation
+}
+
+// ClearTestSession clears the "test_session" edge to the TestSession entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) ClearTestSession() *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.ClearTestSession()
+	return tsteuo
+}
+
+// ClearExtendedBy clears the "extended_by" edge to the User entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) ClearExtendedBy() *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.ClearExtendedBy()
+	return tsteuo
+}
+
+// Where appends a list predicates to the TestSessionTimeExtensionUpdate builder.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) Where(ps ...predicate.TestSessionTimeExtension) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.mutation.Where(ps...)
+	return tsteuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) Select(field string, fields ...string) *TestSessionTimeExtensionUpdateOne {
+	tsteuo.fields = append([]string{field}, fields...)
+	return tsteuo
+}
+
+// Save executes the query and returns the updated TestSessionTimeExtension entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) Save(ctx context.Context) (*TestSessionTimeExtension, error) {
+	if err := tsteuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tsteuo.sqlSave, tsteuo.mutation, tsteuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) SaveX(ctx context.Context) *TestSessionTimeExtension {
+	node, err := tsteuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsteuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) ExecX(ctx context.Context) {
+	if err := tsteuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) defaults() error {
+	if _, ok := tsteuo.mutation.UpdatedAt(); !ok {
+		if testsessiontimeextension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized testsessiontimeextension.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := testsessiontimeextension.UpdateDefaultUpdatedAt()
+		tsteuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsteuo *TestSessionTimeExtensionUpdateOne) check() error {
+	if v, ok := tsteuo.mutation.Minutes(); ok {
+		if err := testsessiontimeextension.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.minutes": %w`, err)}
+		}
+	}
+	if v, ok := tsteuo.mutation.Reason(); ok {
+		if err := testsessiontimeextension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TestSessionTimeExtension.reason": %w`, err)}
+		}
+	}
+	if tsteuo.mutation.TestSessionCleared() && len(tsteuo.mutation.TestSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionTimeExtension.test_session"`)
+	}
+	if tsteuo.mutation.ExtendedByCleared() && len(tsteuo.mutation.ExtendedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TestSessionTimeExtension.extended_by"`)
+	}
+	return nil
+}
+
+func (tsteuo *TestSessionTimeExtensionUpdateOne) sqlSave(ctx context.Context) (_node *TestSessionTimeExtension, err error) {
+	if err := tsteuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testsessiontimeextension.Table, testsessiontimeextension.Columns, sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID))
+	id, ok := tsteuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestSessionTimeExtension.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsteuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testsessiontimeextension.FieldID)
+		for _, f := range fields {
+			if !testsessiontimeextension.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testsessiontimeextension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsteuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsteuo.mutation.CreatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsteuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsteuo.mutation.DeletedAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tsteuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(testsessiontimeextension.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tsteuo.mutation.Minutes(); ok {
+		_spec.SetField(testsessiontimeextension.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsteuo.mutation.AddedMinutes(); ok {
+		_spec.AddField(testsessiontimeextension.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := tsteuo.mutation.Reason(); ok {
+		_spec.SetField(testsessiontimeextension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := tsteuo.mutation.PreviousExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldPreviousExpiredAt, field.TypeTime, value)
+	}
+	if value, ok := tsteuo.mutation.NewExpiredAt(); ok {
+		_spec.SetField(testsessiontimeextension.FieldNewExpiredAt, field.TypeTime, value)
+	}
+	if tsteuo.mutation.TestSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.TestSessionTable,
+			Columns: []string{testsessiontimeextension.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsteuo.mutation.TestSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.TestSessionTable,
+			Columns: []string{testsessiontimeextension.TestSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsteuo.mutation.ExtendedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.ExtendedByTable,
+			Columns: []string{testsessiontimeextension.ExtendedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsteuo.mutation.ExtendedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testsessiontimeextension.ExtendedByTable,
+			Columns: []string{testsessiontimeextension.ExtendedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TestSessionTimeExtension{config: tsteuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsteuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testsessiontimeextension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsteuo.mutation.done = true
+	return _node, nil
+}
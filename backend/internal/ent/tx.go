@@ -12,14 +12,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessGrant is the client for interacting with the AccessGrant builders.
+	AccessGrant *AccessGrantClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
+	// CourseEnrollment is the client for interacting with the CourseEnrollment builders.
+	CourseEnrollment *CourseEnrollmentClient
 	// CourseSection is the client for interacting with the CourseSection builders.
 	CourseSection *CourseSectionClient
+	// CourseSectionPrerequisite is the client for interacting with the CourseSectionPrerequisite builders.
+	CourseSectionPrerequisite *CourseSectionPrerequisiteClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
+	// GroupTestAssignment is the client for interacting with the GroupTestAssignment builders.
+	GroupTestAssignment *GroupTestAssignmentClient
 	// JwtToken is the client for interacting with the JwtToken builders.
 	JwtToken *JwtTokenClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Question is the client for interacting with the Question builders.
@@ -28,6 +40,10 @@ type Tx struct {
 	QuestionCollection *QuestionCollectionClient
 	// QuestionOption is the client for interacting with the QuestionOption builders.
 	QuestionOption *QuestionOptionClient
+	// QuestionVersion is the client for interacting with the QuestionVersion builders.
+	QuestionVersion *QuestionVersionClient
+	// QuestionVersionOption is the client for interacting with the QuestionVersionOption builders.
+	QuestionVersionOption *QuestionVersionOptionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// Test is the client for interacting with the Test builders.
@@ -40,10 +56,22 @@ type Tx struct {
 	TestSession *TestSessionClient
 	// TestSessionAnswer is the client for interacting with the TestSessionAnswer builders.
 	TestSessionAnswer *TestSessionAnswerClient
+	// TestSessionBreak is the client for interacting with the TestSessionBreak builders.
+	TestSessionBreak *TestSessionBreakClient
+	// TestSessionIntegrityEvent is the client for interacting with the TestSessionIntegrityEvent builders.
+	TestSessionIntegrityEvent *TestSessionIntegrityEventClient
+	// TestSessionRegrade is the client for interacting with the TestSessionRegrade builders.
+	TestSessionRegrade *TestSessionRegradeClient
+	// TestSessionTimeExtension is the client for interacting with the TestSessionTimeExtension builders.
+	TestSessionTimeExtension *TestSessionTimeExtensionClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserAccommodation is the client for interacting with the UserAccommodation builders.
+	UserAccommodation *UserAccommodationClient
+	// UserInvite is the client for interacting with the UserInvite builders.
+	UserInvite *UserInviteClient
 	// Video is the client for interacting with the Video builders.
 	Video *VideoClient
 	// VideoQuestionTimestamp is the client for interacting with the VideoQuestionTimestamp builders.
@@ -179,22 +207,36 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessGrant = NewAccessGrantClient(tx.config)
 	tx.Course = NewCourseClient(tx.config)
+	tx.CourseEnrollment = NewCourseEnrollmentClient(tx.config)
 	tx.CourseSection = NewCourseSectionClient(tx.config)
+	tx.CourseSectionPrerequisite = NewCourseSectionPrerequisiteClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
+	tx.GroupTestAssignment = NewGroupTestAssignmentClient(tx.config)
 	tx.JwtToken = NewJwtTokenClient(tx.config)
 	tx.Media = NewMediaClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
 	tx.QuestionCollection = NewQuestionCollectionClient(tx.config)
 	tx.QuestionOption = NewQuestionOptionClient(tx.config)
+	tx.QuestionVersion = NewQuestionVersionClient(tx.config)
+	tx.QuestionVersionOption = NewQuestionVersionOptionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.Test = NewTestClient(tx.config)
 	tx.TestIgnoreQuestion = NewTestIgnoreQuestionClient(tx.config)
 	tx.TestQuestionCount = NewTestQuestionCountClient(tx.config)
 	tx.TestSession = NewTestSessionClient(tx.config)
 	tx.TestSessionAnswer = NewTestSessionAnswerClient(tx.config)
+	tx.TestSessionBreak = NewTestSessionBreakClient(tx.config)
+	tx.TestSessionIntegrityEvent = NewTestSessionIntegrityEventClient(tx.config)
+	tx.TestSessionRegrade = NewTestSessionRegradeClient(tx.config)
+	tx.TestSessionTimeExtension = NewTestSessionTimeExtensionClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserAccommodation = NewUserAccommodationClient(tx.config)
+	tx.UserInvite = NewUserInviteClient(tx.config)
 	tx.Video = NewVideoClient(tx.config)
 	tx.VideoQuestionTimestamp = NewVideoQuestionTimestampClient(tx.config)
 }
@@ -206,7 +248,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Course.QueryXXX(), the query will be executed
+// applies a query, for example: AccessGrant.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
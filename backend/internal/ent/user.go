@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
 	"time"
 
 	"entgo.io/ent"
@@ -25,6 +27,8 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// Email holds the value of the "email" field.
@@ -39,6 +43,8 @@ type User struct {
 	AvatarID *uuid.UUID `json:"avatar_id,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Set for the temporary passwords, cleared once the user chooses a password
+	MustChangePassword bool `json:"must_change_password,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -47,6 +53,8 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Media holds the value of the media edge.
 	Media *Media `json:"media,omitempty"`
 	// MediaUploader holds the value of the media_uploader edge.
@@ -61,9 +69,44 @@ type UserEdges struct {
 	TestSessions []*TestSession `json:"test_sessions,omitempty"`
 	// JwtTokens holds the value of the jwt_tokens edge.
 	JwtTokens []*JwtToken `json:"jwt_tokens,omitempty"`
+	// CourseEnrollments holds the value of the course_enrollments edge.
+	CourseEnrollments []*CourseEnrollment `json:"course_enrollments,omitempty"`
+	// Accommodation holds the value of the accommodation edge.
+	Accommodation *UserAccommodation `json:"accommodation,omitempty"`
+	// TestSessionTimeExtensions holds the value of the test_session_time_extensions edge.
+	TestSessionTimeExtensions []*TestSessionTimeExtension `json:"test_session_time_extensions,omitempty"`
+	// TestSessionRegrades holds the value of the test_session_regrades edge.
+	TestSessionRegrades []*TestSessionRegrade `json:"test_session_regrades,omitempty"`
+	// QuestionVersions holds the value of the question_versions edge.
+	QuestionVersions []*QuestionVersion `json:"question_versions,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
+	// GrantedAccessGrants holds the value of the granted_access_grants edge.
+	GrantedAccessGrants []*AccessGrant `json:"granted_access_grants,omitempty"`
+	// CreatedTests holds the value of the created_tests edge.
+	CreatedTests []*Test `json:"created_tests,omitempty"`
+	// CreatedGroups holds the value of the created_groups edge.
+	CreatedGroups []*Group `json:"created_groups,omitempty"`
+	// MemberGroups holds the value of the member_groups edge.
+	MemberGroups []*Group `json:"member_groups,omitempty"`
+	// GroupTestAssignments holds the value of the group_test_assignments edge.
+	GroupTestAssignments []*GroupTestAssignment `json:"group_test_assignments,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*UserInvite `json:"invites,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [20]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // MediaOrErr returns the Media value or an error if the edge
@@ -71,7 +114,7 @@ type UserEdges struct {
 func (e UserEdges) MediaOrErr() (*Media, error) {
 	if e.Media != nil {
 		return e.Media, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: media.Label}
 	}
 	return nil, &NotLoadedError{edge: "media"}
@@ -80,7 +123,7 @@ func (e UserEdges) MediaOrErr() (*Media, error) {
 // MediaUploaderOrErr returns the MediaUploader value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) MediaUploaderOrErr() ([]*Media, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.MediaUploader, nil
 	}
 	return nil, &NotLoadedError{edge: "media_uploader"}
@@ -89,7 +132,7 @@ func (e UserEdges) MediaUploaderOrErr() ([]*Media, error) {
 // RolesOrErr returns the Roles value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RolesOrErr() ([]*Role, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Roles, nil
 	}
 	return nil, &NotLoadedError{edge: "roles"}
@@ -98,7 +141,7 @@ func (e UserEdges) RolesOrErr() ([]*Role, error) {
 // CourseCreatorOrErr returns the CourseCreator value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CourseCreatorOrErr() ([]*Course, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.CourseCreator, nil
 	}
 	return nil, &NotLoadedError{edge: "course_creator"}
@@ -107,7 +150,7 @@ func (e UserEdges) CourseCreatorOrErr() ([]*Course, error) {
 // QuestionCollectionsOrErr returns the QuestionCollections value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) QuestionCollectionsOrErr() ([]*QuestionCollection, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.QuestionCollections, nil
 	}
 	return nil, &NotLoadedError{edge: "question_collections"}
@@ -116,7 +159,7 @@ func (e UserEdges) QuestionCollectionsOrErr() ([]*QuestionCollection, error) {
 // TestSessionsOrErr returns the TestSessions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TestSessionsOrErr() ([]*TestSession, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.TestSessions, nil
 	}
 	return nil, &NotLoadedError{edge: "test_sessions"}
@@ -125,20 +168,130 @@ func (e UserEdges) TestSessionsOrErr() ([]*TestSession, error) {
 // JwtTokensOrErr returns the JwtTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) JwtTokensOrErr() ([]*JwtToken, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.JwtTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "jwt_tokens"}
 }
 
+// CourseEnrollmentsOrErr returns the CourseEnrollments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CourseEnrollmentsOrErr() ([]*CourseEnrollment, error) {
+	if e.loadedTypes[8] {
+		return e.CourseEnrollments, nil
+	}
+	return nil, &NotLoadedError{edge: "course_enrollments"}
+}
+
+// AccommodationOrErr returns the Accommodation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) AccommodationOrErr() (*UserAccommodation, error) {
+	if e.Accommodation != nil {
+		return e.Accommodation, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: useraccommodation.Label}
+	}
+	return nil, &NotLoadedError{edge: "accommodation"}
+}
+
+// TestSessionTimeExtensionsOrErr returns the TestSessionTimeExtensions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TestSessionTimeExtensionsOrErr() ([]*TestSessionTimeExtension, error) {
+	if e.loadedTypes[10] {
+		return e.TestSessionTimeExtensions, nil
+	}
+	return nil, &NotLoadedError{edge: "test_session_time_extensions"}
+}
+
+// TestSessionRegradesOrErr returns the TestSessionRegrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TestSessionRegradesOrErr() ([]*TestSessionRegrade, error) {
+	if e.loadedTypes[11] {
+		return e.TestSessionRegrades, nil
+	}
+	return nil, &NotLoadedError{edge: "test_session_regrades"}
+}
+
+// QuestionVersionsOrErr returns the QuestionVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) QuestionVersionsOrErr() ([]*QuestionVersion, error) {
+	if e.loadedTypes[12] {
+		return e.QuestionVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "question_versions"}
+}
+
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[13] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
+// GrantedAccessGrantsOrErr returns the GrantedAccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GrantedAccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[14] {
+		return e.GrantedAccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "granted_access_grants"}
+}
+
+// CreatedTestsOrErr returns the CreatedTests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreatedTestsOrErr() ([]*Test, error) {
+	if e.loadedTypes[15] {
+		return e.CreatedTests, nil
+	}
+	return nil, &NotLoadedError{edge: "created_tests"}
+}
+
+// CreatedGroupsOrErr returns the CreatedGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreatedGroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[16] {
+		return e.CreatedGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "created_groups"}
+}
+
+// MemberGroupsOrErr returns the MemberGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MemberGroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[17] {
+		return e.MemberGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "member_groups"}
+}
+
+// GroupTestAssignmentsOrErr returns the GroupTestAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupTestAssignmentsOrErr() ([]*GroupTestAssignment, error) {
+	if e.loadedTypes[18] {
+		return e.GroupTestAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "group_test_assignments"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitesOrErr() ([]*UserInvite, error) {
+	if e.loadedTypes[19] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldAvatarID:
+		case user.FieldOrganizationID, user.FieldAvatarID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case user.FieldIsActive:
+		case user.FieldIsActive, user.FieldMustChangePassword:
 			values[i] = new(sql.NullBool)
 		case user.FieldUsername, user.FieldEmail, user.FieldPasswordHash, user.FieldFirstName, user.FieldLastName:
 			values[i] = new(sql.NullString)
@@ -186,6 +339,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.DeletedAt = new(time.Time)
 				*u.DeletedAt = value.Time
 			}
+		case user.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				u.OrganizationID = new(uuid.UUID)
+				*u.OrganizationID = *value.S.(*uuid.UUID)
+			}
 		case user.FieldUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
@@ -231,6 +391,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IsActive = value.Bool
 			}
+		case user.FieldMustChangePassword:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field must_change_password", values[i])
+			} else if value.Valid {
+				u.MustChangePassword = value.Bool
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -244,6 +410,11 @@ func (u *User) Value(name string) (ent.Value, error) {
 	return u.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the User entity.
+func (u *User) QueryOrganization() *OrganizationQuery {
+	return NewUserClient(u.config).QueryOrganization(u)
+}
+
 // QueryMedia queries the "media" edge of the User entity.
 func (u *User) QueryMedia() *MediaQuery {
 	return NewUserClient(u.config).QueryMedia(u)
@@ -279,6 +450,66 @@ func (u *User) QueryJwtTokens() *JwtTokenQuery {
 	return NewUserClient(u.config).QueryJwtTokens(u)
 }
 
+// QueryCourseEnrollments queries the "course_enrollments" edge of the User entity.
+func (u *User) QueryCourseEnrollments() *CourseEnrollmentQuery {
+	return NewUserClient(u.config).QueryCourseEnrollments(u)
+}
+
+// QueryAccommodation queries the "accommodation" edge of the User entity.
+func (u *User) QueryAccommodation() *UserAccommodationQuery {
+	return NewUserClient(u.config).QueryAccommodation(u)
+}
+
+// QueryTestSessionTimeExtensions queries the "test_session_time_extensions" edge of the User entity.
+func (u *User) QueryTestSessionTimeExtensions() *TestSessionTimeExtensionQuery {
+	return NewUserClient(u.config).QueryTestSessionTimeExtensions(u)
+}
+
+// QueryTestSessionRegrades queries the "test_session_regrades" edge of the User entity.
+func (u *User) QueryTestSessionRegrades() *TestSessionRegradeQuery {
+	return NewUserClient(u.config).QueryTestSessionRegrades(u)
+}
+
+// QueryQuestionVersions queries the "question_versions" edge of the User entity.
+func (u *User) QueryQuestionVersions() *QuestionVersionQuery {
+	return NewUserClient(u.config).QueryQuestionVersions(u)
+}
+
+// QueryAccessGrants queries the "access_grants" edge of the User entity.
+func (u *User) QueryAccessGrants() *AccessGrantQuery {
+	return NewUserClient(u.config).QueryAccessGrants(u)
+}
+
+// QueryGrantedAccessGrants queries the "granted_access_grants" edge of the User entity.
+func (u *User) QueryGrantedAccessGrants() *AccessGrantQuery {
+	return NewUserClient(u.config).QueryGrantedAccessGrants(u)
+}
+
+// QueryCreatedTests queries the "created_tests" edge of the User entity.
+func (u *User) QueryCreatedTests() *TestQuery {
+	return NewUserClient(u.config).QueryCreatedTests(u)
+}
+
+// QueryCreatedGroups queries the "created_groups" edge of the User entity.
+func (u *User) QueryCreatedGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryCreatedGroups(u)
+}
+
+// QueryMemberGroups queries the "member_groups" edge of the User entity.
+func (u *User) QueryMemberGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryMemberGroups(u)
+}
+
+// QueryGroupTestAssignments queries the "group_test_assignments" edge of the User entity.
+func (u *User) QueryGroupTestAssignments() *GroupTestAssignmentQuery {
+	return NewUserClient(u.config).QueryGroupTestAssignments(u)
+}
+
+// QueryInvites queries the "invites" edge of the User entity.
+func (u *User) QueryInvites() *UserInviteQuery {
+	return NewUserClient(u.config).QueryInvites(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -313,6 +544,11 @@ func (u *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := u.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
 	builder.WriteString(", ")
@@ -339,6 +575,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", u.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("must_change_password=")
+	builder.WriteString(fmt.Sprintf("%v", u.MustChangePassword))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
 	// FieldEmail holds the string denoting the email field in the database.
@@ -36,6 +38,10 @@ const (
 	FieldAvatarID = "avatar_id"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldMustChangePassword holds the string denoting the must_change_password field in the database.
+	FieldMustChangePassword = "must_change_password"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// EdgeMedia holds the string denoting the media edge name in mutations.
 	EdgeMedia = "media"
 	// EdgeMediaUploader holds the string denoting the media_uploader edge name in mutations.
@@ -50,8 +56,39 @@ const (
 	EdgeTestSessions = "test_sessions"
 	// EdgeJwtTokens holds the string denoting the jwt_tokens edge name in mutations.
 	EdgeJwtTokens = "jwt_tokens"
+	// EdgeCourseEnrollments holds the string denoting the course_enrollments edge name in mutations.
+	EdgeCourseEnrollments = "course_enrollments"
+	// EdgeAccommodation holds the string denoting the accommodation edge name in mutations.
+	EdgeAccommodation = "accommodation"
+	// EdgeTestSessionTimeExtensions holds the string denoting the test_session_time_extensions edge name in mutations.
+	EdgeTestSessionTimeExtensions = "test_session_time_extensions"
+	// EdgeTestSessionRegrades holds the string denoting the test_session_regrades edge name in mutations.
+	EdgeTestSessionRegrades = "test_session_regrades"
+	// EdgeQuestionVersions holds the string denoting the question_versions edge name in mutations.
+	EdgeQuestionVersions = "question_versions"
+	// EdgeAccessGrants holds the string denoting the access_grants edge name in mutations.
+	EdgeAccessGrants = "access_grants"
+	// EdgeGrantedAccessGrants holds the string denoting the granted_access_grants edge name in mutations.
+	EdgeGrantedAccessGrants = "granted_access_grants"
+	// EdgeCreatedTests holds the string denoting the created_tests edge name in mutations.
+	EdgeCreatedTests = "created_tests"
+	// EdgeCreatedGroups holds the string denoting the created_groups edge name in mutations.
+	EdgeCreatedGroups = "created_groups"
+	// EdgeMemberGroups holds the string denoting the member_groups edge name in mutations.
+	EdgeMemberGroups = "member_groups"
+	// EdgeGroupTestAssignments holds the string denoting the group_test_assignments edge name in mutations.
+	EdgeGroupTestAssignments = "group_test_assignments"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "users"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
 	// MediaTable is the table that holds the media relation/edge.
 	MediaTable = "users"
 	// MediaInverseTable is the table name for the Media entity.
@@ -99,6 +136,88 @@ const (
 	JwtTokensInverseTable = "jwt_tokens"
 	// JwtTokensColumn is the table column denoting the jwt_tokens relation/edge.
 	JwtTokensColumn = "user_id"
+	// CourseEnrollmentsTable is the table that holds the course_enrollments relation/edge.
+	CourseEnrollmentsTable = "course_enrollments"
+	// CourseEnrollmentsInverseTable is the table name for the CourseEnrollment entity.
+	// It exists in this package in order to avoid circular dependency with the "courseenrollment" package.
+	CourseEnrollmentsInverseTable = "course_enrollments"
+	// CourseEnrollmentsColumn is the table column denoting the course_enrollments relation/edge.
+	CourseEnrollmentsColumn = "user_id"
+	// AccommodationTable is the table that holds the accommodation relation/edge.
+	AccommodationTable = "user_accommodations"
+	// AccommodationInverseTable is the table name for the UserAccommodation entity.
+	// It exists in this package in order to avoid circular dependency with the "useraccommodation" package.
+	AccommodationInverseTable = "user_accommodations"
+	// AccommodationColumn is the table column denoting the accommodation relation/edge.
+	AccommodationColumn = "user_id"
+	// TestSessionTimeExtensionsTable is the table that holds the test_session_time_extensions relation/edge.
+	TestSessionTimeExtensionsTable = "test_session_time_extensions"
+	// TestSessionTimeExtensionsInverseTable is the table name for the TestSessionTimeExtension entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessiontimeextension" package.
+	TestSessionTimeExtensionsInverseTable = "test_session_time_extensions"
+	// TestSessionTimeExtensionsColumn is the table column denoting the test_session_time_extensions relation/edge.
+	TestSessionTimeExtensionsColumn = "extended_by_id"
+	// TestSessionRegradesTable is the table that holds the test_session_regrades relation/edge.
+	TestSessionRegradesTable = "test_session_regrades"
+	// TestSessionRegradesInverseTable is the table name for the TestSessionRegrade entity.
+	// It exists in this package in order to avoid circular dependency with the "testsessionregrade" package.
+	TestSessionRegradesInverseTable = "test_session_regrades"
+	// TestSessionRegradesColumn is the table column denoting the test_session_regrades relation/edge.
+	TestSessionRegradesColumn = "regraded_by_id"
+	// QuestionVersionsTable is the table that holds the question_versions relation/edge.
+	QuestionVersionsTable = "question_versions"
+	// QuestionVersionsInverseTable is the table name for the QuestionVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "questionversion" package.
+	QuestionVersionsInverseTable = "question_versions"
+	// QuestionVersionsColumn is the table column denoting the question_versions relation/edge.
+	QuestionVersionsColumn = "created_by_id"
+	// AccessGrantsTable is the table that holds the access_grants relation/edge.
+	AccessGrantsTable = "access_grants"
+	// AccessGrantsInverseTable is the table name for the AccessGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "accessgrant" package.
+	AccessGrantsInverseTable = "access_grants"
+	// AccessGrantsColumn is the table column denoting the access_grants relation/edge.
+	AccessGrantsColumn = "user_id"
+	// GrantedAccessGrantsTable is the table that holds the granted_access_grants relation/edge.
+	GrantedAccessGrantsTable = "access_grants"
+	// GrantedAccessGrantsInverseTable is the table name for the AccessGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "accessgrant" package.
+	GrantedAccessGrantsInverseTable = "access_grants"
+	// GrantedAccessGrantsColumn is the table column denoting the granted_access_grants relation/edge.
+	GrantedAccessGrantsColumn = "granted_by_id"
+	// CreatedTestsTable is the table that holds the created_tests relation/edge.
+	CreatedTestsTable = "tests"
+	// CreatedTestsInverseTable is the table name for the Test entity.
+	// It exists in this package in order to avoid circular dependency with the "test" package.
+	CreatedTestsInverseTable = "tests"
+	// CreatedTestsColumn is the table column denoting the created_tests relation/edge.
+	CreatedTestsColumn = "creator_id"
+	// CreatedGroupsTable is the table that holds the created_groups relation/edge.
+	CreatedGroupsTable = "groups"
+	// CreatedGroupsInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	CreatedGroupsInverseTable = "groups"
+	// CreatedGroupsColumn is the table column denoting the created_groups relation/edge.
+	CreatedGroupsColumn = "creator_id"
+	// MemberGroupsTable is the table that holds the member_groups relation/edge. The primary key declared below.
+	MemberGroupsTable = "user_member_groups"
+	// MemberGroupsInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	MemberGroupsInverseTable = "groups"
+	// GroupTestAssignmentsTable is the table that holds the group_test_assignments relation/edge.
+	GroupTestAssignmentsTable = "group_test_assignments"
+	// GroupTestAssignmentsInverseTable is the table name for the GroupTestAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "grouptestassignment" package.
+	GroupTestAssignmentsInverseTable = "group_test_assignments"
+	// GroupTestAssignmentsColumn is the table column denoting the group_test_assignments relation/edge.
+	GroupTestAssignmentsColumn = "assigned_by_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "user_invites"
+	// InvitesInverseTable is the table name for the UserInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "userinvite" package.
+	InvitesInverseTable = "user_invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -107,6 +226,7 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldOrganizationID,
 	FieldUsername,
 	FieldEmail,
 	FieldPasswordHash,
@@ -114,12 +234,16 @@ var Columns = []string{
 	FieldLastName,
 	FieldAvatarID,
 	FieldIsActive,
+	FieldMustChangePassword,
 }
 
 var (
 	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
 	// primary key for the roles relation (M2M).
 	RolesPrimaryKey = []string{"user_id", "role_id"}
+	// MemberGroupsPrimaryKey and MemberGroupsColumn2 are the table columns denoting the
+	// primary key for the member_groups relation (M2M).
+	MemberGroupsPrimaryKey = []string{"user_id", "group_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -138,8 +262,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "template/internal/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [3]ent.Hook
+	Interceptors [2]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -154,6 +278,8 @@ var (
 	PasswordHashValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultMustChangePassword holds the default value on creation for the "must_change_password" field.
+	DefaultMustChangePassword bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -181,6 +307,11 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // ByUsername orders the results by the username field.
 func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
@@ -216,6 +347,18 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByMustChangePassword orders the results by the must_change_password field.
+func ByMustChangePassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMustChangePassword, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByMediaField orders the results by media field.
 func ByMediaField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -306,6 +449,174 @@ func ByJwtTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newJwtTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCourseEnrollmentsCount orders the results by course_enrollments count.
+func ByCourseEnrollmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCourseEnrollmentsStep(), opts...)
+	}
+}
+
+// ByCourseEnrollments orders the results by course_enrollments terms.
+func ByCourseEnrollments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseEnrollmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccommodationField orders the results by accommodation field.
+func ByAccommodationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccommodationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTestSessionTimeExtensionsCount orders the results by test_session_time_extensions count.
+func ByTestSessionTimeExtensionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestSessionTimeExtensionsStep(), opts...)
+	}
+}
+
+// ByTestSessionTimeExtensions orders the results by test_session_time_extensions terms.
+func ByTestSessionTimeExtensions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionTimeExtensionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTestSessionRegradesCount orders the results by test_session_regrades count.
+func ByTestSessionRegradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestSessionRegradesStep(), opts...)
+	}
+}
+
+// ByTestSessionRegrades orders the results by test_session_regrades terms.
+func ByTestSessionRegrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestSessionRegradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuestionVersionsCount orders the results by question_versions count.
+func ByQuestionVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuestionVersionsStep(), opts...)
+	}
+}
+
+// ByQuestionVersions orders the results by question_versions terms.
+func ByQuestionVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessGrantsCount orders the results by access_grants count.
+func ByAccessGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessGrantsStep(), opts...)
+	}
+}
+
+// ByAccessGrants orders the results by access_grants terms.
+func ByAccessGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGrantedAccessGrantsCount orders the results by granted_access_grants count.
+func ByGrantedAccessGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGrantedAccessGrantsStep(), opts...)
+	}
+}
+
+// ByGrantedAccessGrants orders the results by granted_access_grants terms.
+func ByGrantedAccessGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGrantedAccessGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCreatedTestsCount orders the results by created_tests count.
+func ByCreatedTestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreatedTestsStep(), opts...)
+	}
+}
+
+// ByCreatedTests orders the results by created_tests terms.
+func ByCreatedTests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedTestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCreatedGroupsCount orders the results by created_groups count.
+func ByCreatedGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreatedGroupsStep(), opts...)
+	}
+}
+
+// ByCreatedGroups orders the results by created_groups terms.
+func ByCreatedGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberGroupsCount orders the results by member_groups count.
+func ByMemberGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberGroupsStep(), opts...)
+	}
+}
+
+// ByMemberGroups orders the results by member_groups terms.
+func ByMemberGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupTestAssignmentsCount orders the results by group_test_assignments count.
+func ByGroupTestAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupTestAssignmentsStep(), opts...)
+	}
+}
+
+// ByGroupTestAssignments orders the results by group_test_assignments terms.
+func ByGroupTestAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupTestAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
 func newMediaStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -355,3 +666,87 @@ func newJwtTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JwtTokensTable, JwtTokensColumn),
 	)
 }
+func newCourseEnrollmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseEnrollmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CourseEnrollmentsTable, CourseEnrollmentsColumn),
+	)
+}
+func newAccommodationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccommodationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AccommodationTable, AccommodationColumn),
+	)
+}
+func newTestSessionTimeExtensionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionTimeExtensionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestSessionTimeExtensionsTable, TestSessionTimeExtensionsColumn),
+	)
+}
+func newTestSessionRegradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestSessionRegradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestSessionRegradesTable, TestSessionRegradesColumn),
+	)
+}
+func newQuestionVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionVersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuestionVersionsTable, QuestionVersionsColumn),
+	)
+}
+func newAccessGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessGrantsTable, AccessGrantsColumn),
+	)
+}
+func newGrantedAccessGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GrantedAccessGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GrantedAccessGrantsTable, GrantedAccessGrantsColumn),
+	)
+}
+func newCreatedTestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedTestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreatedTestsTable, CreatedTestsColumn),
+	)
+}
+func newCreatedGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreatedGroupsTable, CreatedGroupsColumn),
+	)
+}
+func newMemberGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MemberGroupsTable, MemberGroupsPrimaryKey...),
+	)
+}
+func newGroupTestAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupTestAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupTestAssignmentsTable, GroupTestAssignmentsColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
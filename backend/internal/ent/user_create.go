@@ -6,13 +6,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/role"
+	"template/internal/ent/test"
 	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,6 +80,20 @@ func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (uc *UserCreate) SetOrganizationID(u uuid.UUID) *UserCreate {
+	uc.mutation.SetOrganizationID(u)
+	return uc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOrganizationID(u *uuid.UUID) *UserCreate {
+	if u != nil {
+		uc.SetOrganizationID(*u)
+	}
+	return uc
+}
+
 // SetUsername sets the "username" field.
 func (uc *UserCreate) SetUsername(s string) *UserCreate {
 	uc.mutation.SetUsername(s)
@@ -143,6 +168,20 @@ func (uc *UserCreate) SetNillableIsActive(b *bool) *UserCreate {
 	return uc
 }
 
+// SetMustChangePassword sets the "must_change_password" field.
+func (uc *UserCreate) SetMustChangePassword(b bool) *UserCreate {
+	uc.mutation.SetMustChangePassword(b)
+	return uc
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMustChangePassword(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetMustChangePassword(*b)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -157,6 +196,11 @@ func (uc *UserCreate) SetNillableID(u *uuid.UUID) *UserCreate {
 	return uc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (uc *UserCreate) SetOrganization(o *Organization) *UserCreate {
+	return uc.SetOrganizationID(o.ID)
+}
+
 // SetMediaID sets the "media" edge to the Media entity by ID.
 func (uc *UserCreate) SetMediaID(id uuid.UUID) *UserCreate {
 	uc.mutation.SetMediaID(id)
@@ -266,6 +310,190 @@ func (uc *UserCreate) AddJwtTokens(j ...*JwtToken) *UserCreate {
 	return uc.AddJwtTokenIDs(ids...)
 }
 
+// AddCourseEnrollmentIDs adds the "course_enrollments" edge to the CourseEnrollment entity by IDs.
+func (uc *UserCreate) AddCourseEnrollmentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddCourseEnrollmentIDs(ids...)
+	return uc
+}
+
+// AddCourseEnrollments adds the "course_enrollments" edges to the CourseEnrollment entity.
+func (uc *UserCreate) AddCourseEnrollments(c ...*CourseEnrollment) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCourseEnrollmentIDs(ids...)
+}
+
+// SetAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID.
+func (uc *UserCreate) SetAccommodationID(id uuid.UUID) *UserCreate {
+	uc.mutation.SetAccommodationID(id)
+	return uc
+}
+
+// SetNillableAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableAccommodationID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		uc = uc.SetAccommodationID(*id)
+	}
+	return uc
+}
+
+// SetAccommodation sets the "accommodation" edge to the UserAccommodation entity.
+func (uc *UserCreate) SetAccommodation(u *UserAccommodation) *UserCreate {
+	return uc.SetAccommodationID(u.ID)
+}
+
+// AddTestSessionTimeExtensionIDs adds the "test_session_time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (uc *UserCreate) AddTestSessionTimeExtensionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddTestSessionTimeExtensionIDs(ids...)
+	return uc
+}
+
+// AddTestSessionTimeExtensions adds the "test_session_time_extensions" edges to the TestSessionTimeExtension entity.
+func (uc *UserCreate) AddTestSessionTimeExtensions(t ...*TestSessionTimeExtension) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTestSessionTimeExtensionIDs(ids...)
+}
+
+// AddTestSessionRegradeIDs adds the "test_session_regrades" edge to the TestSessionRegrade entity by IDs.
+func (uc *UserCreate) AddTestSessionRegradeIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddTestSessionRegradeIDs(ids...)
+	return uc
+}
+
+// AddTestSessionRegrades adds the "test_session_regrades" edges to the TestSessionRegrade entity.
+func (uc *UserCreate) AddTestSessionRegrades(t ...*TestSessionRegrade) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTestSessionRegradeIDs(ids...)
+}
+
+// AddQuestionVersionIDs adds the "question_versions" edge to the QuestionVersion entity by IDs.
+func (uc *UserCreate) AddQuestionVersionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddQuestionVersionIDs(ids...)
+	return uc
+}
+
+// AddQuestionVersions adds the "question_versions" edges to the QuestionVersion entity.
+func (uc *UserCreate) AddQuestionVersions(q ...*QuestionVersion) *UserCreate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uc.AddQuestionVersionIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (uc *UserCreate) AddAccessGrantIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddAccessGrantIDs(ids...)
+	return uc
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (uc *UserCreate) AddAccessGrants(a ...*AccessGrant) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAccessGrantIDs(ids...)
+}
+
+// AddGrantedAccessGrantIDs adds the "granted_access_grants" edge to the AccessGrant entity by IDs.
+func (uc *UserCreate) AddGrantedAccessGrantIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddGrantedAccessGrantIDs(ids...)
+	return uc
+}
+
+// AddGrantedAccessGrants adds the "granted_access_grants" edges to the AccessGrant entity.
+func (uc *UserCreate) AddGrantedAccessGrants(a ...*AccessGrant) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddGrantedAccessGrantIDs(ids...)
+}
+
+// AddCreatedTestIDs adds the "created_tests" edge to the Test entity by IDs.
+func (uc *UserCreate) AddCreatedTestIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddCreatedTestIDs(ids...)
+	return uc
+}
+
+// AddCreatedTests adds the "created_tests" edges to the Test entity.
+func (uc *UserCreate) AddCreatedTests(t ...*Test) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddCreatedTestIDs(ids...)
+}
+
+// AddCreatedGroupIDs adds the "created_groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddCreatedGroupIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddCreatedGroupIDs(ids...)
+	return uc
+}
+
+// AddCreatedGroups adds the "created_groups" edges to the Group entity.
+func (uc *UserCreate) AddCreatedGroups(g ...*Group) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddCreatedGroupIDs(ids...)
+}
+
+// AddMemberGroupIDs adds the "member_groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddMemberGroupIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMemberGroupIDs(ids...)
+	return uc
+}
+
+// AddMemberGroups adds the "member_groups" edges to the Group entity.
+func (uc *UserCreate) AddMemberGroups(g ...*Group) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddMemberGroupIDs(ids...)
+}
+
+// AddGroupTestAssignmentIDs adds the "group_test_assignments" edge to the GroupTestAssignment entity by IDs.
+func (uc *UserCreate) AddGroupTestAssignmentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddGroupTestAssignmentIDs(ids...)
+	return uc
+}
+
+// AddGroupTestAssignments adds the "group_test_assignments" edges to the GroupTestAssignment entity.
+func (uc *UserCreate) AddGroupTestAssignments(g ...*GroupTestAssignment) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupTestAssignmentIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the UserInvite entity by IDs.
+func (uc *UserCreate) AddInviteIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddInviteIDs(ids...)
+	return uc
+}
+
+// AddInvites adds the "invites" edges to the UserInvite entity.
+func (uc *UserCreate) AddInvites(u ...*UserInvite) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddInviteIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -321,6 +549,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultIsActive
 		uc.mutation.SetIsActive(v)
 	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		v := user.DefaultMustChangePassword
+		uc.mutation.SetMustChangePassword(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		if user.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized user.DefaultID (forgotten import ent/runtime?)")
@@ -366,6 +598,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "User.is_active"`)}
 	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		return &ValidationError{Name: "must_change_password", err: errors.New(`ent: missing required field "User.must_change_password"`)}
+	}
 	return nil
 }
 
@@ -437,6 +672,27 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := uc.mutation.MustChangePassword(); ok {
+		_spec.SetField(user.FieldMustChangePassword, field.TypeBool, value)
+		_node.MustChangePassword = value
+	}
+	if nodes := uc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.MediaIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -550,6 +806,198 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CourseEnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AccommodationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AccommodationTable,
+			Columns: []string{user.AccommodationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TestSessionTimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TestSessionRegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.QuestionVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GrantedAccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CreatedTestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CreatedGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MemberGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupTestAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
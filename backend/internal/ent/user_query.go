@@ -7,16 +7,28 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/role"
+	"template/internal/ent/test"
 	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,17 +38,31 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []user.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.User
-	withMedia               *MediaQuery
-	withMediaUploader       *MediaQuery
-	withRoles               *RoleQuery
-	withCourseCreator       *CourseQuery
-	withQuestionCollections *QuestionCollectionQuery
-	withTestSessions        *TestSessionQuery
-	withJwtTokens           *JwtTokenQuery
+	ctx                           *QueryContext
+	order                         []user.OrderOption
+	inters                        []Interceptor
+	predicates                    []predicate.User
+	withOrganization              *OrganizationQuery
+	withMedia                     *MediaQuery
+	withMediaUploader             *MediaQuery
+	withRoles                     *RoleQuery
+	withCourseCreator             *CourseQuery
+	withQuestionCollections       *QuestionCollectionQuery
+	withTestSessions              *TestSessionQuery
+	withJwtTokens                 *JwtTokenQuery
+	withCourseEnrollments         *CourseEnrollmentQuery
+	withAccommodation             *UserAccommodationQuery
+	withTestSessionTimeExtensions *TestSessionTimeExtensionQuery
+	withTestSessionRegrades       *TestSessionRegradeQuery
+	withQuestionVersions          *QuestionVersionQuery
+	withAccessGrants              *AccessGrantQuery
+	withGrantedAccessGrants       *AccessGrantQuery
+	withCreatedTests              *TestQuery
+	withCreatedGroups             *GroupQuery
+	withMemberGroups              *GroupQuery
+	withGroupTestAssignments      *GroupTestAssignmentQuery
+	withInvites                   *UserInviteQuery
+	modifiers                     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,6 +99,28 @@ func (uq *UserQuery) Order(o ...user.OrderOption) *UserQuery {
 	return uq
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (uq *UserQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.OrganizationTable, user.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMedia chains the current query on the "media" edge.
 func (uq *UserQuery) QueryMedia() *MediaQuery {
 	query := (&MediaClient{config: uq.config}).Query()
@@ -227,6 +275,270 @@ func (uq *UserQuery) QueryJwtTokens() *JwtTokenQuery {
 	return query
 }
 
+// QueryCourseEnrollments chains the current query on the "course_enrollments" edge.
+func (uq *UserQuery) QueryCourseEnrollments() *CourseEnrollmentQuery {
+	query := (&CourseEnrollmentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(courseenrollment.Table, courseenrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CourseEnrollmentsTable, user.CourseEnrollmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccommodation chains the current query on the "accommodation" edge.
+func (uq *UserQuery) QueryAccommodation() *UserAccommodationQuery {
+	query := (&UserAccommodationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useraccommodation.Table, useraccommodation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.AccommodationTable, user.AccommodationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTestSessionTimeExtensions chains the current query on the "test_session_time_extensions" edge.
+func (uq *UserQuery) QueryTestSessionTimeExtensions() *TestSessionTimeExtensionQuery {
+	query := (&TestSessionTimeExtensionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(testsessiontimeextension.Table, testsessiontimeextension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionTimeExtensionsTable, user.TestSessionTimeExtensionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTestSessionRegrades chains the current query on the "test_session_regrades" edge.
+func (uq *UserQuery) QueryTestSessionRegrades() *TestSessionRegradeQuery {
+	query := (&TestSessionRegradeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(testsessionregrade.Table, testsessionregrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TestSessionRegradesTable, user.TestSessionRegradesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionVersions chains the current query on the "question_versions" edge.
+func (uq *UserQuery) QueryQuestionVersions() *QuestionVersionQuery {
+	query := (&QuestionVersionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(questionversion.Table, questionversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuestionVersionsTable, user.QuestionVersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessGrants chains the current query on the "access_grants" edge.
+func (uq *UserQuery) QueryAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccessGrantsTable, user.AccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGrantedAccessGrants chains the current query on the "granted_access_grants" edge.
+func (uq *UserQuery) QueryGrantedAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GrantedAccessGrantsTable, user.GrantedAccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedTests chains the current query on the "created_tests" edge.
+func (uq *UserQuery) QueryCreatedTests() *TestQuery {
+	query := (&TestClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(test.Table, test.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedTestsTable, user.CreatedTestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedGroups chains the current query on the "created_groups" edge.
+func (uq *UserQuery) QueryCreatedGroups() *GroupQuery {
+	query := (&GroupClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedGroupsTable, user.CreatedGroupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberGroups chains the current query on the "member_groups" edge.
+func (uq *UserQuery) QueryMemberGroups() *GroupQuery {
+	query := (&GroupClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.MemberGroupsTable, user.MemberGroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupTestAssignments chains the current query on the "group_test_assignments" edge.
+func (uq *UserQuery) QueryGroupTestAssignments() *GroupTestAssignmentQuery {
+	query := (&GroupTestAssignmentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(grouptestassignment.Table, grouptestassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupTestAssignmentsTable, user.GroupTestAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (uq *UserQuery) QueryInvites() *UserInviteQuery {
+	query := (&UserInviteClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(userinvite.Table, userinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitesTable, user.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -414,24 +726,48 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:                  uq.config,
-		ctx:                     uq.ctx.Clone(),
-		order:                   append([]user.OrderOption{}, uq.order...),
-		inters:                  append([]Interceptor{}, uq.inters...),
-		predicates:              append([]predicate.User{}, uq.predicates...),
-		withMedia:               uq.withMedia.Clone(),
-		withMediaUploader:       uq.withMediaUploader.Clone(),
-		withRoles:               uq.withRoles.Clone(),
-		withCourseCreator:       uq.withCourseCreator.Clone(),
-		withQuestionCollections: uq.withQuestionCollections.Clone(),
-		withTestSessions:        uq.withTestSessions.Clone(),
-		withJwtTokens:           uq.withJwtTokens.Clone(),
+		config:                        uq.config,
+		ctx:                           uq.ctx.Clone(),
+		order:                         append([]user.OrderOption{}, uq.order...),
+		inters:                        append([]Interceptor{}, uq.inters...),
+		predicates:                    append([]predicate.User{}, uq.predicates...),
+		withOrganization:              uq.withOrganization.Clone(),
+		withMedia:                     uq.withMedia.Clone(),
+		withMediaUploader:             uq.withMediaUploader.Clone(),
+		withRoles:                     uq.withRoles.Clone(),
+		withCourseCreator:             uq.withCourseCreator.Clone(),
+		withQuestionCollections:       uq.withQuestionCollections.Clone(),
+		withTestSessions:              uq.withTestSessions.Clone(),
+		withJwtTokens:                 uq.withJwtTokens.Clone(),
+		withCourseEnrollments:         uq.withCourseEnrollments.Clone(),
+		withAccommodation:             uq.withAccommodation.Clone(),
+		withTestSessionTimeExtensions: uq.withTestSessionTimeExtensions.Clone(),
+		withTestSessionRegrades:       uq.withTestSessionRegrades.Clone(),
+		withQuestionVersions:          uq.withQuestionVersions.Clone(),
+		withAccessGrants:              uq.withAccessGrants.Clone(),
+		withGrantedAccessGrants:       uq.withGrantedAccessGrants.Clone(),
+		withCreatedTests:              uq.withCreatedTests.Clone(),
+		withCreatedGroups:             uq.withCreatedGroups.Clone(),
+		withMemberGroups:              uq.withMemberGroups.Clone(),
+		withGroupTestAssignments:      uq.withGroupTestAssignments.Clone(),
+		withInvites:                   uq.withInvites.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithOrganization(opts ...func(*OrganizationQuery)) *UserQuery {
+	query := (&OrganizationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrganization = query
+	return uq
+}
+
 // WithMedia tells the query-builder to eager-load the nodes that are connected to
 // the "media" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithMedia(opts ...func(*MediaQuery)) *UserQuery {
@@ -509,33 +845,165 @@ func (uq *UserQuery) WithJwtTokens(opts ...func(*JwtTokenQuery)) *UserQuery {
 	return uq
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldCreatedAt).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	uq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: uq}
-	grbuild.flds = &uq.ctx.Fields
-	grbuild.label = user.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
+// WithCourseEnrollments tells the query-builder to eager-load the nodes that are connected to
+// the "course_enrollments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCourseEnrollments(opts ...func(*CourseEnrollmentQuery)) *UserQuery {
+	query := (&CourseEnrollmentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCourseEnrollments = query
+	return uq
 }
 
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
+// WithAccommodation tells the query-builder to eager-load the nodes that are connected to
+// the "accommodation" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAccommodation(opts ...func(*UserAccommodationQuery)) *UserQuery {
+	query := (&UserAccommodationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAccommodation = query
+	return uq
+}
+
+// WithTestSessionTimeExtensions tells the query-builder to eager-load the nodes that are connected to
+// the "test_session_time_extensions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTestSessionTimeExtensions(opts ...func(*TestSessionTimeExtensionQuery)) *UserQuery {
+	query := (&TestSessionTimeExtensionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTestSessionTimeExtensions = query
+	return uq
+}
+
+// WithTestSessionRegrades tells the query-builder to eager-load the nodes that are connected to
+// the "test_session_regrades" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTestSessionRegrades(opts ...func(*TestSessionRegradeQuery)) *UserQuery {
+	query := (&TestSessionRegradeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTestSessionRegrades = query
+	return uq
+}
+
+// WithQuestionVersions tells the query-builder to eager-load the nodes that are connected to
+// the "question_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithQuestionVersions(opts ...func(*QuestionVersionQuery)) *UserQuery {
+	query := (&QuestionVersionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withQuestionVersions = query
+	return uq
+}
+
+// WithAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAccessGrants(opts ...func(*AccessGrantQuery)) *UserQuery {
+	query := (&AccessGrantClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAccessGrants = query
+	return uq
+}
+
+// WithGrantedAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "granted_access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGrantedAccessGrants(opts ...func(*AccessGrantQuery)) *UserQuery {
+	query := (&AccessGrantClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGrantedAccessGrants = query
+	return uq
+}
+
+// WithCreatedTests tells the query-builder to eager-load the nodes that are connected to
+// the "created_tests" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCreatedTests(opts ...func(*TestQuery)) *UserQuery {
+	query := (&TestClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCreatedTests = query
+	return uq
+}
+
+// WithCreatedGroups tells the query-builder to eager-load the nodes that are connected to
+// the "created_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCreatedGroups(opts ...func(*GroupQuery)) *UserQuery {
+	query := (&GroupClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCreatedGroups = query
+	return uq
+}
+
+// WithMemberGroups tells the query-builder to eager-load the nodes that are connected to
+// the "member_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMemberGroups(opts ...func(*GroupQuery)) *UserQuery {
+	query := (&GroupClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMemberGroups = query
+	return uq
+}
+
+// WithGroupTestAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "group_test_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGroupTestAssignments(opts ...func(*GroupTestAssignmentQuery)) *UserQuery {
+	query := (&GroupTestAssignmentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupTestAssignments = query
+	return uq
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvites(opts ...func(*UserInviteQuery)) *UserQuery {
+	query := (&UserInviteClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvites = query
+	return uq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	uq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: uq}
+	grbuild.flds = &uq.ctx.Fields
+	grbuild.label = user.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
 //
 //	var v []struct {
 //		CreatedAt time.Time `json:"created_at,omitempty"`
@@ -587,7 +1055,8 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [20]bool{
+			uq.withOrganization != nil,
 			uq.withMedia != nil,
 			uq.withMediaUploader != nil,
 			uq.withRoles != nil,
@@ -595,6 +1064,18 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			uq.withQuestionCollections != nil,
 			uq.withTestSessions != nil,
 			uq.withJwtTokens != nil,
+			uq.withCourseEnrollments != nil,
+			uq.withAccommodation != nil,
+			uq.withTestSessionTimeExtensions != nil,
+			uq.withTestSessionRegrades != nil,
+			uq.withQuestionVersions != nil,
+			uq.withAccessGrants != nil,
+			uq.withGrantedAccessGrants != nil,
+			uq.withCreatedTests != nil,
+			uq.withCreatedGroups != nil,
+			uq.withMemberGroups != nil,
+			uq.withGroupTestAssignments != nil,
+			uq.withInvites != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -606,6 +1087,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -615,6 +1099,12 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := uq.withOrganization; query != nil {
+		if err := uq.loadOrganization(ctx, query, nodes, nil,
+			func(n *User, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withMedia; query != nil {
 		if err := uq.loadMedia(ctx, query, nodes, nil,
 			func(n *User, e *Media) { n.Edges.Media = e }); err != nil {
@@ -665,9 +1155,130 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withCourseEnrollments; query != nil {
+		if err := uq.loadCourseEnrollments(ctx, query, nodes,
+			func(n *User) { n.Edges.CourseEnrollments = []*CourseEnrollment{} },
+			func(n *User, e *CourseEnrollment) { n.Edges.CourseEnrollments = append(n.Edges.CourseEnrollments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAccommodation; query != nil {
+		if err := uq.loadAccommodation(ctx, query, nodes, nil,
+			func(n *User, e *UserAccommodation) { n.Edges.Accommodation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTestSessionTimeExtensions; query != nil {
+		if err := uq.loadTestSessionTimeExtensions(ctx, query, nodes,
+			func(n *User) { n.Edges.TestSessionTimeExtensions = []*TestSessionTimeExtension{} },
+			func(n *User, e *TestSessionTimeExtension) {
+				n.Edges.TestSessionTimeExtensions = append(n.Edges.TestSessionTimeExtensions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTestSessionRegrades; query != nil {
+		if err := uq.loadTestSessionRegrades(ctx, query, nodes,
+			func(n *User) { n.Edges.TestSessionRegrades = []*TestSessionRegrade{} },
+			func(n *User, e *TestSessionRegrade) {
+				n.Edges.TestSessionRegrades = append(n.Edges.TestSessionRegrades, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withQuestionVersions; query != nil {
+		if err := uq.loadQuestionVersions(ctx, query, nodes,
+			func(n *User) { n.Edges.QuestionVersions = []*QuestionVersion{} },
+			func(n *User, e *QuestionVersion) { n.Edges.QuestionVersions = append(n.Edges.QuestionVersions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAccessGrants; query != nil {
+		if err := uq.loadAccessGrants(ctx, query, nodes,
+			func(n *User) { n.Edges.AccessGrants = []*AccessGrant{} },
+			func(n *User, e *AccessGrant) { n.Edges.AccessGrants = append(n.Edges.AccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGrantedAccessGrants; query != nil {
+		if err := uq.loadGrantedAccessGrants(ctx, query, nodes,
+			func(n *User) { n.Edges.GrantedAccessGrants = []*AccessGrant{} },
+			func(n *User, e *AccessGrant) { n.Edges.GrantedAccessGrants = append(n.Edges.GrantedAccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCreatedTests; query != nil {
+		if err := uq.loadCreatedTests(ctx, query, nodes,
+			func(n *User) { n.Edges.CreatedTests = []*Test{} },
+			func(n *User, e *Test) { n.Edges.CreatedTests = append(n.Edges.CreatedTests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCreatedGroups; query != nil {
+		if err := uq.loadCreatedGroups(ctx, query, nodes,
+			func(n *User) { n.Edges.CreatedGroups = []*Group{} },
+			func(n *User, e *Group) { n.Edges.CreatedGroups = append(n.Edges.CreatedGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMemberGroups; query != nil {
+		if err := uq.loadMemberGroups(ctx, query, nodes,
+			func(n *User) { n.Edges.MemberGroups = []*Group{} },
+			func(n *User, e *Group) { n.Edges.MemberGroups = append(n.Edges.MemberGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroupTestAssignments; query != nil {
+		if err := uq.loadGroupTestAssignments(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupTestAssignments = []*GroupTestAssignment{} },
+			func(n *User, e *GroupTestAssignment) {
+				n.Edges.GroupTestAssignments = append(n.Edges.GroupTestAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withInvites; query != nil {
+		if err := uq.loadInvites(ctx, query, nodes,
+			func(n *User) { n.Edges.Invites = []*UserInvite{} },
+			func(n *User, e *UserInvite) { n.Edges.Invites = append(n.Edges.Invites, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (uq *UserQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*User, init func(*User), assign func(*User, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*User)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (uq *UserQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*User, init func(*User), assign func(*User, *Media)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*User)
@@ -917,9 +1528,415 @@ func (uq *UserQuery) loadJwtTokens(ctx context.Context, query *JwtTokenQuery, no
 	}
 	return nil
 }
+func (uq *UserQuery) loadCourseEnrollments(ctx context.Context, query *CourseEnrollmentQuery, nodes []*User, init func(*User), assign func(*User, *CourseEnrollment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(courseenrollment.FieldUserID)
+	}
+	query.Where(predicate.CourseEnrollment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CourseEnrollmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAccommodation(ctx context.Context, query *UserAccommodationQuery, nodes []*User, init func(*User), assign func(*User, *UserAccommodation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useraccommodation.FieldUserID)
+	}
+	query.Where(predicate.UserAccommodation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AccommodationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTestSessionTimeExtensions(ctx context.Context, query *TestSessionTimeExtensionQuery, nodes []*User, init func(*User), assign func(*User, *TestSessionTimeExtension)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessiontimeextension.FieldExtendedByID)
+	}
+	query.Where(predicate.TestSessionTimeExtension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TestSessionTimeExtensionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ExtendedByID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "extended_by_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTestSessionRegrades(ctx context.Context, query *TestSessionRegradeQuery, nodes []*User, init func(*User), assign func(*User, *TestSessionRegrade)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testsessionregrade.FieldRegradedByID)
+	}
+	query.Where(predicate.TestSessionRegrade(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TestSessionRegradesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RegradedByID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "regraded_by_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadQuestionVersions(ctx context.Context, query *QuestionVersionQuery, nodes []*User, init func(*User), assign func(*User, *QuestionVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionversion.FieldCreatedByID)
+	}
+	query.Where(predicate.QuestionVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.QuestionVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*User, init func(*User), assign func(*User, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldUserID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGrantedAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*User, init func(*User), assign func(*User, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldGrantedByID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GrantedAccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GrantedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "granted_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "granted_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadCreatedTests(ctx context.Context, query *TestQuery, nodes []*User, init func(*User), assign func(*User, *Test)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(test.FieldCreatorID)
+	}
+	query.Where(predicate.Test(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CreatedTestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "creator_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "creator_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadCreatedGroups(ctx context.Context, query *GroupQuery, nodes []*User, init func(*User), assign func(*User, *Group)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(group.FieldCreatorID)
+	}
+	query.Where(predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CreatedGroupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "creator_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadMemberGroups(ctx context.Context, query *GroupQuery, nodes []*User, init func(*User), assign func(*User, *Group)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.MemberGroupsTable)
+		s.Join(joinT).On(s.C(group.FieldID), joinT.C(user.MemberGroupsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(user.MemberGroupsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.MemberGroupsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Group](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "member_groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadGroupTestAssignments(ctx context.Context, query *GroupTestAssignmentQuery, nodes []*User, init func(*User), assign func(*User, *GroupTestAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(grouptestassignment.FieldAssignedByID)
+	}
+	query.Where(predicate.GroupTestAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupTestAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AssignedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "assigned_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "assigned_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadInvites(ctx context.Context, query *UserInviteQuery, nodes []*User, init func(*User), assign func(*User, *UserInvite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userinvite.FieldUserID)
+	}
+	query.Where(predicate.UserInvite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.InvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -943,6 +1960,9 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if uq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(user.FieldOrganizationID)
+		}
 		if uq.withMedia != nil {
 			_spec.Node.AddColumnOnce(user.FieldAvatarID)
 		}
@@ -985,6 +2005,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -1002,6 +2025,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
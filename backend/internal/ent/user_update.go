@@ -6,14 +6,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"template/internal/ent/accessgrant"
 	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
 	"template/internal/ent/jwttoken"
 	"template/internal/ent/media"
+	"template/internal/ent/organization"
 	"template/internal/ent/predicate"
 	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionversion"
 	"template/internal/ent/role"
+	"template/internal/ent/test"
 	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/ent/testsessiontimeextension"
 	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"template/internal/ent/userinvite"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -75,6 +86,26 @@ func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
 	return uu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (uu *UserUpdate) SetOrganizationID(u uuid.UUID) *UserUpdate {
+	uu.mutation.SetOrganizationID(u)
+	return uu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableOrganizationID(u *uuid.UUID) *UserUpdate {
+	if u != nil {
+		uu.SetOrganizationID(*u)
+	}
+	return uu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (uu *UserUpdate) ClearOrganizationID() *UserUpdate {
+	uu.mutation.ClearOrganizationID()
+	return uu
+}
+
 // SetUsername sets the "username" field.
 func (uu *UserUpdate) SetUsername(s string) *UserUpdate {
 	uu.mutation.SetUsername(s)
@@ -191,6 +222,25 @@ func (uu *UserUpdate) SetNillableIsActive(b *bool) *UserUpdate {
 	return uu
 }
 
+// SetMustChangePassword sets the "must_change_password" field.
+func (uu *UserUpdate) SetMustChangePassword(b bool) *UserUpdate {
+	uu.mutation.SetMustChangePassword(b)
+	return uu
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMustChangePassword(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetMustChangePassword(*b)
+	}
+	return uu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (uu *UserUpdate) SetOrganization(o *Organization) *UserUpdate {
+	return uu.SetOrganizationID(o.ID)
+}
+
 // SetMediaID sets the "media" edge to the Media entity by ID.
 func (uu *UserUpdate) SetMediaID(id uuid.UUID) *UserUpdate {
 	uu.mutation.SetMediaID(id)
@@ -300,11 +350,201 @@ func (uu *UserUpdate) AddJwtTokens(j ...*JwtToken) *UserUpdate {
 	return uu.AddJwtTokenIDs(ids...)
 }
 
+// AddCourseEnrollmentIDs adds the "course_enrollments" edge to the CourseEnrollment entity by IDs.
+func (uu *UserUpdate) AddCourseEnrollmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddCourseEnrollmentIDs(ids...)
+	return uu
+}
+
+// AddCourseEnrollments adds the "course_enrollments" edges to the CourseEnrollment entity.
+func (uu *UserUpdate) AddCourseEnrollments(c ...*CourseEnrollment) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCourseEnrollmentIDs(ids...)
+}
+
+// SetAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID.
+func (uu *UserUpdate) SetAccommodationID(id uuid.UUID) *UserUpdate {
+	uu.mutation.SetAccommodationID(id)
+	return uu
+}
+
+// SetNillableAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableAccommodationID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		uu = uu.SetAccommodationID(*id)
+	}
+	return uu
+}
+
+// SetAccommodation sets the "accommodation" edge to the UserAccommodation entity.
+func (uu *UserUpdate) SetAccommodation(u *UserAccommodation) *UserUpdate {
+	return uu.SetAccommodationID(u.ID)
+}
+
+// AddTestSessionTimeExtensionIDs adds the "test_session_time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (uu *UserUpdate) AddTestSessionTimeExtensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddTestSessionTimeExtensionIDs(ids...)
+	return uu
+}
+
+// AddTestSessionTimeExtensions adds the "test_session_time_extensions" edges to the TestSessionTimeExtension entity.
+func (uu *UserUpdate) AddTestSessionTimeExtensions(t ...*TestSessionTimeExtension) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddTestSessionTimeExtensionIDs(ids...)
+}
+
+// AddTestSessionRegradeIDs adds the "test_session_regrades" edge to the TestSessionRegrade entity by IDs.
+func (uu *UserUpdate) AddTestSessionRegradeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddTestSessionRegradeIDs(ids...)
+	return uu
+}
+
+// AddTestSessionRegrades adds the "test_session_regrades" edges to the TestSessionRegrade entity.
+func (uu *UserUpdate) AddTestSessionRegrades(t ...*TestSessionRegrade) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddTestSessionRegradeIDs(ids...)
+}
+
+// AddQuestionVersionIDs adds the "question_versions" edge to the QuestionVersion entity by IDs.
+func (uu *UserUpdate) AddQuestionVersionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddQuestionVersionIDs(ids...)
+	return uu
+}
+
+// AddQuestionVersions adds the "question_versions" edges to the QuestionVersion entity.
+func (uu *UserUpdate) AddQuestionVersions(q ...*QuestionVersion) *UserUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.AddQuestionVersionIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (uu *UserUpdate) AddAccessGrantIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddAccessGrantIDs(ids...)
+	return uu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (uu *UserUpdate) AddAccessGrants(a ...*AccessGrant) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAccessGrantIDs(ids...)
+}
+
+// AddGrantedAccessGrantIDs adds the "granted_access_grants" edge to the AccessGrant entity by IDs.
+func (uu *UserUpdate) AddGrantedAccessGrantIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddGrantedAccessGrantIDs(ids...)
+	return uu
+}
+
+// AddGrantedAccessGrants adds the "granted_access_grants" edges to the AccessGrant entity.
+func (uu *UserUpdate) AddGrantedAccessGrants(a ...*AccessGrant) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddGrantedAccessGrantIDs(ids...)
+}
+
+// AddCreatedTestIDs adds the "created_tests" edge to the Test entity by IDs.
+func (uu *UserUpdate) AddCreatedTestIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddCreatedTestIDs(ids...)
+	return uu
+}
+
+// AddCreatedTests adds the "created_tests" edges to the Test entity.
+func (uu *UserUpdate) AddCreatedTests(t ...*Test) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddCreatedTestIDs(ids...)
+}
+
+// AddCreatedGroupIDs adds the "created_groups" edge to the Group entity by IDs.
+func (uu *UserUpdate) AddCreatedGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddCreatedGroupIDs(ids...)
+	return uu
+}
+
+// AddCreatedGroups adds the "created_groups" edges to the Group entity.
+func (uu *UserUpdate) AddCreatedGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddCreatedGroupIDs(ids...)
+}
+
+// AddMemberGroupIDs adds the "member_groups" edge to the Group entity by IDs.
+func (uu *UserUpdate) AddMemberGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMemberGroupIDs(ids...)
+	return uu
+}
+
+// AddMemberGroups adds the "member_groups" edges to the Group entity.
+func (uu *UserUpdate) AddMemberGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddMemberGroupIDs(ids...)
+}
+
+// AddGroupTestAssignmentIDs adds the "group_test_assignments" edge to the GroupTestAssignment entity by IDs.
+func (uu *UserUpdate) AddGroupTestAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddGroupTestAssignmentIDs(ids...)
+	return uu
+}
+
+// AddGroupTestAssignments adds the "group_test_assignments" edges to the GroupTestAssignment entity.
+func (uu *UserUpdate) AddGroupTestAssignments(g ...*GroupTestAssignment) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupTestAssignmentIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the UserInvite entity by IDs.
+func (uu *UserUpdate) AddInviteIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddInviteIDs(ids...)
+	return uu
+}
+
+// AddInvites adds the "invites" edges to the UserInvite entity.
+func (uu *UserUpdate) AddInvites(u ...*UserInvite) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddInviteIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (uu *UserUpdate) ClearOrganization() *UserUpdate {
+	uu.mutation.ClearOrganization()
+	return uu
+}
+
 // ClearMedia clears the "media" edge to the Media entity.
 func (uu *UserUpdate) ClearMedia() *UserUpdate {
 	uu.mutation.ClearMedia()
@@ -437,6 +677,243 @@ func (uu *UserUpdate) RemoveJwtTokens(j ...*JwtToken) *UserUpdate {
 	return uu.RemoveJwtTokenIDs(ids...)
 }
 
+// ClearCourseEnrollments clears all "course_enrollments" edges to the CourseEnrollment entity.
+func (uu *UserUpdate) ClearCourseEnrollments() *UserUpdate {
+	uu.mutation.ClearCourseEnrollments()
+	return uu
+}
+
+// RemoveCourseEnrollmentIDs removes the "course_enrollments" edge to CourseEnrollment entities by IDs.
+func (uu *UserUpdate) RemoveCourseEnrollmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveCourseEnrollmentIDs(ids...)
+	return uu
+}
+
+// RemoveCourseEnrollments removes "course_enrollments" edges to CourseEnrollment entities.
+func (uu *UserUpdate) RemoveCourseEnrollments(c ...*CourseEnrollment) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCourseEnrollmentIDs(ids...)
+}
+
+// ClearAccommodation clears the "accommodation" edge to the UserAccommodation entity.
+func (uu *UserUpdate) ClearAccommodation() *UserUpdate {
+	uu.mutation.ClearAccommodation()
+	return uu
+}
+
+// ClearTestSessionTimeExtensions clears all "test_session_time_extensions" edges to the TestSessionTimeExtension entity.
+func (uu *UserUpdate) ClearTestSessionTimeExtensions() *UserUpdate {
+	uu.mutation.ClearTestSessionTimeExtensions()
+	return uu
+}
+
+// RemoveTestSessionTimeExtensionIDs removes the "test_session_time_extensions" edge to TestSessionTimeExtension entities by IDs.
+func (uu *UserUpdate) RemoveTestSessionTimeExtensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveTestSessionTimeExtensionIDs(ids...)
+	return uu
+}
+
+// RemoveTestSessionTimeExtensions removes "test_session_time_extensions" edges to TestSessionTimeExtension entities.
+func (uu *UserUpdate) RemoveTestSessionTimeExtensions(t ...*TestSessionTimeExtension) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveTestSessionTimeExtensionIDs(ids...)
+}
+
+// ClearTestSessionRegrades clears all "test_session_regrades" edges to the TestSessionRegrade entity.
+func (uu *UserUpdate) ClearTestSessionRegrades() *UserUpdate {
+	uu.mutation.ClearTestSessionRegrades()
+	return uu
+}
+
+// RemoveTestSessionRegradeIDs removes the "test_session_regrades" edge to TestSessionRegrade entities by IDs.
+func (uu *UserUpdate) RemoveTestSessionRegradeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveTestSessionRegradeIDs(ids...)
+	return uu
+}
+
+// RemoveTestSessionRegrades removes "test_session_regrades" edges to TestSessionRegrade entities.
+func (uu *UserUpdate) RemoveTestSessionRegrades(t ...*TestSessionRegrade) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveTestSessionRegradeIDs(ids...)
+}
+
+// ClearQuestionVersions clears all "question_versions" edges to the QuestionVersion entity.
+func (uu *UserUpdate) ClearQuestionVersions() *UserUpdate {
+	uu.mutation.ClearQuestionVersions()
+	return uu
+}
+
+// RemoveQuestionVersionIDs removes the "question_versions" edge to QuestionVersion entities by IDs.
+func (uu *UserUpdate) RemoveQuestionVersionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveQuestionVersionIDs(ids...)
+	return uu
+}
+
+// RemoveQuestionVersions removes "question_versions" edges to QuestionVersion entities.
+func (uu *UserUpdate) RemoveQuestionVersions(q ...*QuestionVersion) *UserUpdate {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.RemoveQuestionVersionIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (uu *UserUpdate) ClearAccessGrants() *UserUpdate {
+	uu.mutation.ClearAccessGrants()
+	return uu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (uu *UserUpdate) RemoveAccessGrantIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveAccessGrantIDs(ids...)
+	return uu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (uu *UserUpdate) RemoveAccessGrants(a ...*AccessGrant) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearGrantedAccessGrants clears all "granted_access_grants" edges to the AccessGrant entity.
+func (uu *UserUpdate) ClearGrantedAccessGrants() *UserUpdate {
+	uu.mutation.ClearGrantedAccessGrants()
+	return uu
+}
+
+// RemoveGrantedAccessGrantIDs removes the "granted_access_grants" edge to AccessGrant entities by IDs.
+func (uu *UserUpdate) RemoveGrantedAccessGrantIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveGrantedAccessGrantIDs(ids...)
+	return uu
+}
+
+// RemoveGrantedAccessGrants removes "granted_access_grants" edges to AccessGrant entities.
+func (uu *UserUpdate) RemoveGrantedAccessGrants(a ...*AccessGrant) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveGrantedAccessGrantIDs(ids...)
+}
+
+// ClearCreatedTests clears all "created_tests" edges to the Test entity.
+func (uu *UserUpdate) ClearCreatedTests() *UserUpdate {
+	uu.mutation.ClearCreatedTests()
+	return uu
+}
+
+// RemoveCreatedTestIDs removes the "created_tests" edge to Test entities by IDs.
+func (uu *UserUpdate) RemoveCreatedTestIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveCreatedTestIDs(ids...)
+	return uu
+}
+
+// RemoveCreatedTests removes "created_tests" edges to Test entities.
+func (uu *UserUpdate) RemoveCreatedTests(t ...*Test) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveCreatedTestIDs(ids...)
+}
+
+// ClearCreatedGroups clears all "created_groups" edges to the Group entity.
+func (uu *UserUpdate) ClearCreatedGroups() *UserUpdate {
+	uu.mutation.ClearCreatedGroups()
+	return uu
+}
+
+// RemoveCreatedGroupIDs removes the "created_groups" edge to Group entities by IDs.
+func (uu *UserUpdate) RemoveCreatedGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveCreatedGroupIDs(ids...)
+	return uu
+}
+
+// RemoveCreatedGroups removes "created_groups" edges to Group entities.
+func (uu *UserUpdate) RemoveCreatedGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveCreatedGroupIDs(ids...)
+}
+
+// ClearMemberGroups clears all "member_groups" edges to the Group entity.
+func (uu *UserUpdate) ClearMemberGroups() *UserUpdate {
+	uu.mutation.ClearMemberGroups()
+	return uu
+}
+
+// RemoveMemberGroupIDs removes the "member_groups" edge to Group entities by IDs.
+func (uu *UserUpdate) RemoveMemberGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMemberGroupIDs(ids...)
+	return uu
+}
+
+// RemoveMemberGroups removes "member_groups" edges to Group entities.
+func (uu *UserUpdate) RemoveMemberGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveMemberGroupIDs(ids...)
+}
+
+// ClearGroupTestAssignments clears all "group_test_assignments" edges to the GroupTestAssignment entity.
+func (uu *UserUpdate) ClearGroupTestAssignments() *UserUpdate {
+	uu.mutation.ClearGroupTestAssignments()
+	return uu
+}
+
+// RemoveGroupTestAssignmentIDs removes the "group_test_assignments" edge to GroupTestAssignment entities by IDs.
+func (uu *UserUpdate) RemoveGroupTestAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveGroupTestAssignmentIDs(ids...)
+	return uu
+}
+
+// RemoveGroupTestAssignments removes "group_test_assignments" edges to GroupTestAssignment entities.
+func (uu *UserUpdate) RemoveGroupTestAssignments(g ...*GroupTestAssignment) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupTestAssignmentIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the UserInvite entity.
+func (uu *UserUpdate) ClearInvites() *UserUpdate {
+	uu.mutation.ClearInvites()
+	return uu
+}
+
+// RemoveInviteIDs removes the "invites" edge to UserInvite entities by IDs.
+func (uu *UserUpdate) RemoveInviteIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveInviteIDs(ids...)
+	return uu
+}
+
+// RemoveInvites removes "invites" edges to UserInvite entities.
+func (uu *UserUpdate) RemoveInvites(u ...*UserInvite) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveInviteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -547,6 +1024,38 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := uu.mutation.MustChangePassword(); ok {
+		_spec.SetField(user.FieldMustChangePassword, field.TypeBool, value)
+	}
+	if uu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.MediaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -846,590 +1355,2091 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.CourseEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetCreatedAt(t)
-	return uuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetCreatedAt(*t)
+	if nodes := uu.mutation.RemovedCourseEnrollmentsIDs(); len(nodes) > 0 && !uu.mutation.CourseEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetDeletedAt(t)
-	return uuo
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetDeletedAt(*t)
+	if nodes := uu.mutation.CourseEnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
-	uuo.mutation.ClearDeletedAt()
-	return uuo
-}
-
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
-	return uuo
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetUsername(*s)
+	if uu.mutation.AccommodationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AccommodationTable,
+			Columns: []string{user.AccommodationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.AccommodationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AccommodationTable,
+			Columns: []string{user.AccommodationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (uuo *UserUpdateOne) SetPasswordHash(s string) *UserUpdateOne {
-	uuo.mutation.SetPasswordHash(s)
-	return uuo
-}
-
-// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePasswordHash(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPasswordHash(*s)
+	if uu.mutation.TestSessionTimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetFirstName sets the "first_name" field.
-func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
-	uuo.mutation.SetFirstName(s)
-	return uuo
-}
-
-// SetNillableFirstName sets the "first_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetFirstName(*s)
+	if nodes := uu.mutation.RemovedTestSessionTimeExtensionsIDs(); len(nodes) > 0 && !uu.mutation.TestSessionTimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearFirstName clears the value of the "first_name" field.
-func (uuo *UserUpdateOne) ClearFirstName() *UserUpdateOne {
-	uuo.mutation.ClearFirstName()
-	return uuo
-}
-
-// SetLastName sets the "last_name" field.
-func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
-	uuo.mutation.SetLastName(s)
-	return uuo
-}
-
-// SetNillableLastName sets the "last_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetLastName(*s)
+	if nodes := uu.mutation.TestSessionTimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearLastName clears the value of the "last_name" field.
-func (uuo *UserUpdateOne) ClearLastName() *UserUpdateOne {
-	uuo.mutation.ClearLastName()
-	return uuo
-}
-
-// SetAvatarID sets the "avatar_id" field.
-func (uuo *UserUpdateOne) SetAvatarID(u uuid.UUID) *UserUpdateOne {
-	uuo.mutation.SetAvatarID(u)
-	return uuo
-}
-
-// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvatarID(u *uuid.UUID) *UserUpdateOne {
-	if u != nil {
-		uuo.SetAvatarID(*u)
+	if uu.mutation.TestSessionRegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearAvatarID clears the value of the "avatar_id" field.
-func (uuo *UserUpdateOne) ClearAvatarID() *UserUpdateOne {
-	uuo.mutation.ClearAvatarID()
-	return uuo
-}
-
-// SetIsActive sets the "is_active" field.
-func (uuo *UserUpdateOne) SetIsActive(b bool) *UserUpdateOne {
-	uuo.mutation.SetIsActive(b)
-	return uuo
-}
-
-// SetNillableIsActive sets the "is_active" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIsActive(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetIsActive(*b)
+	if nodes := uu.mutation.RemovedTestSessionRegradesIDs(); len(nodes) > 0 && !uu.mutation.TestSessionRegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetMediaID sets the "media" edge to the Media entity by ID.
-func (uuo *UserUpdateOne) SetMediaID(id uuid.UUID) *UserUpdateOne {
-	uuo.mutation.SetMediaID(id)
-	return uuo
-}
-
-// SetNillableMediaID sets the "media" edge to the Media entity by ID if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableMediaID(id *uuid.UUID) *UserUpdateOne {
-	if id != nil {
-		uuo = uuo.SetMediaID(*id)
+	if nodes := uu.mutation.TestSessionRegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetMedia sets the "media" edge to the Media entity.
-func (uuo *UserUpdateOne) SetMedia(m *Media) *UserUpdateOne {
-	return uuo.SetMediaID(m.ID)
-}
-
-// AddMediaUploaderIDs adds the "media_uploader" edge to the Media entity by IDs.
-func (uuo *UserUpdateOne) AddMediaUploaderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddMediaUploaderIDs(ids...)
-	return uuo
-}
-
-// AddMediaUploader adds the "media_uploader" edges to the Media entity.
-func (uuo *UserUpdateOne) AddMediaUploader(m ...*Media) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uu.mutation.QuestionVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddMediaUploaderIDs(ids...)
-}
-
-// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
-func (uuo *UserUpdateOne) AddRoleIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddRoleIDs(ids...)
-	return uuo
-}
-
-// AddRoles adds the "roles" edges to the Role entity.
-func (uuo *UserUpdateOne) AddRoles(r ...*Role) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := uu.mutation.RemovedQuestionVersionsIDs(); len(nodes) > 0 && !uu.mutation.QuestionVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddRoleIDs(ids...)
-}
-
-// AddCourseCreatorIDs adds the "course_creator" edge to the Course entity by IDs.
-func (uuo *UserUpdateOne) AddCourseCreatorIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddCourseCreatorIDs(ids...)
-	return uuo
-}
-
-// AddCourseCreator adds the "course_creator" edges to the Course entity.
-func (uuo *UserUpdateOne) AddCourseCreator(c ...*Course) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.QuestionVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddCourseCreatorIDs(ids...)
-}
-
-// AddQuestionCollectionIDs adds the "question_collections" edge to the QuestionCollection entity by IDs.
-func (uuo *UserUpdateOne) AddQuestionCollectionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddQuestionCollectionIDs(ids...)
-	return uuo
-}
-
-// AddQuestionCollections adds the "question_collections" edges to the QuestionCollection entity.
-func (uuo *UserUpdateOne) AddQuestionCollections(q ...*QuestionCollection) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
+	if uu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddQuestionCollectionIDs(ids...)
-}
-
-// AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
-func (uuo *UserUpdateOne) AddTestSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddTestSessionIDs(ids...)
-	return uuo
-}
-
-// AddTestSessions adds the "test_sessions" edges to the TestSession entity.
-func (uuo *UserUpdateOne) AddTestSessions(t ...*TestSession) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := uu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !uu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddTestSessionIDs(ids...)
-}
-
-// AddJwtTokenIDs adds the "jwt_tokens" edge to the JwtToken entity by IDs.
-func (uuo *UserUpdateOne) AddJwtTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddJwtTokenIDs(ids...)
-	return uuo
-}
-
-// AddJwtTokens adds the "jwt_tokens" edges to the JwtToken entity.
-func (uuo *UserUpdateOne) AddJwtTokens(j ...*JwtToken) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(j))
-	for i := range j {
-		ids[i] = j[i].ID
+	if nodes := uu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddJwtTokenIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearMedia clears the "media" edge to the Media entity.
-func (uuo *UserUpdateOne) ClearMedia() *UserUpdateOne {
-	uuo.mutation.ClearMedia()
-	return uuo
-}
-
-// ClearMediaUploader clears all "media_uploader" edges to the Media entity.
-func (uuo *UserUpdateOne) ClearMediaUploader() *UserUpdateOne {
-	uuo.mutation.ClearMediaUploader()
-	return uuo
-}
-
-// RemoveMediaUploaderIDs removes the "media_uploader" edge to Media entities by IDs.
-func (uuo *UserUpdateOne) RemoveMediaUploaderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveMediaUploaderIDs(ids...)
-	return uuo
-}
-
-// RemoveMediaUploader removes "media_uploader" edges to Media entities.
-func (uuo *UserUpdateOne) RemoveMediaUploader(m ...*Media) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uu.mutation.GrantedAccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveMediaUploaderIDs(ids...)
-}
-
-// ClearRoles clears all "roles" edges to the Role entity.
-func (uuo *UserUpdateOne) ClearRoles() *UserUpdateOne {
-	uuo.mutation.ClearRoles()
-	return uuo
-}
-
-// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
-func (uuo *UserUpdateOne) RemoveRoleIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveRoleIDs(ids...)
-	return uuo
-}
-
-// RemoveRoles removes "roles" edges to Role entities.
-func (uuo *UserUpdateOne) RemoveRoles(r ...*Role) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := uu.mutation.RemovedGrantedAccessGrantsIDs(); len(nodes) > 0 && !uu.mutation.GrantedAccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveRoleIDs(ids...)
-}
-
-// ClearCourseCreator clears all "course_creator" edges to the Course entity.
-func (uuo *UserUpdateOne) ClearCourseCreator() *UserUpdateOne {
-	uuo.mutation.ClearCourseCreator()
-	return uuo
-}
-
-// RemoveCourseCreatorIDs removes the "course_creator" edge to Course entities by IDs.
-func (uuo *UserUpdateOne) RemoveCourseCreatorIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveCourseCreatorIDs(ids...)
-	return uuo
-}
-
-// RemoveCourseCreator removes "course_creator" edges to Course entities.
-func (uuo *UserUpdateOne) RemoveCourseCreator(c ...*Course) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.GrantedAccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveCourseCreatorIDs(ids...)
+	if uu.mutation.CreatedTestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCreatedTestsIDs(); len(nodes) > 0 && !uu.mutation.CreatedTestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CreatedTestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CreatedGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCreatedGroupsIDs(); len(nodes) > 0 && !uu.mutation.CreatedGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CreatedGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MemberGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMemberGroupsIDs(); len(nodes) > 0 && !uu.mutation.MemberGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MemberGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.GroupTestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGroupTestAssignmentsIDs(); len(nodes) > 0 && !uu.mutation.GroupTestAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GroupTestAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !uu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
 }
 
-// ClearQuestionCollections clears all "question_collections" edges to the QuestionCollection entity.
-func (uuo *UserUpdateOne) ClearQuestionCollections() *UserUpdateOne {
-	uuo.mutation.ClearQuestionCollections()
-	return uuo
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
 }
 
-// RemoveQuestionCollectionIDs removes the "question_collections" edge to QuestionCollection entities by IDs.
-func (uuo *UserUpdateOne) RemoveQuestionCollectionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveQuestionCollectionIDs(ids...)
+// SetCreatedAt sets the "created_at" field.
+func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetCreatedAt(t)
 	return uuo
 }
 
-// RemoveQuestionCollections removes "question_collections" edges to QuestionCollection entities.
-func (uuo *UserUpdateOne) RemoveQuestionCollections(q ...*QuestionCollection) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetCreatedAt(*t)
 	}
-	return uuo.RemoveQuestionCollectionIDs(ids...)
+	return uuo
 }
 
-// ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
-func (uuo *UserUpdateOne) ClearTestSessions() *UserUpdateOne {
-	uuo.mutation.ClearTestSessions()
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
 	return uuo
 }
 
-// RemoveTestSessionIDs removes the "test_sessions" edge to TestSession entities by IDs.
-func (uuo *UserUpdateOne) RemoveTestSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveTestSessionIDs(ids...)
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
 	return uuo
 }
 
-// RemoveTestSessions removes "test_sessions" edges to TestSession entities.
-func (uuo *UserUpdateOne) RemoveTestSessions(t ...*TestSession) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
 	}
-	return uuo.RemoveTestSessionIDs(ids...)
+	return uuo
 }
 
-// ClearJwtTokens clears all "jwt_tokens" edges to the JwtToken entity.
-func (uuo *UserUpdateOne) ClearJwtTokens() *UserUpdateOne {
-	uuo.mutation.ClearJwtTokens()
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
 	return uuo
 }
 
-// RemoveJwtTokenIDs removes the "jwt_tokens" edge to JwtToken entities by IDs.
-func (uuo *UserUpdateOne) RemoveJwtTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveJwtTokenIDs(ids...)
+// SetOrganizationID sets the "organization_id" field.
+func (uuo *UserUpdateOne) SetOrganizationID(u uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetOrganizationID(u)
 	return uuo
 }
 
-// RemoveJwtTokens removes "jwt_tokens" edges to JwtToken entities.
-func (uuo *UserUpdateOne) RemoveJwtTokens(j ...*JwtToken) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(j))
-	for i := range j {
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *UserUpdateOne {
+	if u != nil {
+		uuo.SetOrganizationID(*u)
+	}
+	return uuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (uuo *UserUpdateOne) ClearOrganizationID() *UserUpdateOne {
+	uuo.mutation.ClearOrganizationID()
+	return uuo
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (uuo *UserUpdateOne) SetPasswordHash(s string) *UserUpdateOne {
+	uuo.mutation.SetPasswordHash(s)
+	return uuo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPasswordHash(*s)
+	}
+	return uuo
+}
+
+// SetFirstName sets the "first_name" field.
+func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
+	uuo.mutation.SetFirstName(s)
+	return uuo
+}
+
+// SetNillableFirstName sets the "first_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetFirstName(*s)
+	}
+	return uuo
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (uuo *UserUpdateOne) ClearFirstName() *UserUpdateOne {
+	uuo.mutation.ClearFirstName()
+	return uuo
+}
+
+// SetLastName sets the "last_name" field.
+func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
+	uuo.mutation.SetLastName(s)
+	return uuo
+}
+
+// SetNillableLastName sets the "last_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLastName(*s)
+	}
+	return uuo
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (uuo *UserUpdateOne) ClearLastName() *UserUpdateOne {
+	uuo.mutation.ClearLastName()
+	return uuo
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (uuo *UserUpdateOne) SetAvatarID(u uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetAvatarID(u)
+	return uuo
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarID(u *uuid.UUID) *UserUpdateOne {
+	if u != nil {
+		uuo.SetAvatarID(*u)
+	}
+	return uuo
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (uuo *UserUpdateOne) ClearAvatarID() *UserUpdateOne {
+	uuo.mutation.ClearAvatarID()
+	return uuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (uuo *UserUpdateOne) SetIsActive(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsActive(b)
+	return uuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsActive(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsActive(*b)
+	}
+	return uuo
+}
+
+// SetMustChangePassword sets the "must_change_password" field.
+func (uuo *UserUpdateOne) SetMustChangePassword(b bool) *UserUpdateOne {
+	uuo.mutation.SetMustChangePassword(b)
+	return uuo
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMustChangePassword(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetMustChangePassword(*b)
+	}
+	return uuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (uuo *UserUpdateOne) SetOrganization(o *Organization) *UserUpdateOne {
+	return uuo.SetOrganizationID(o.ID)
+}
+
+// SetMediaID sets the "media" edge to the Media entity by ID.
+func (uuo *UserUpdateOne) SetMediaID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetMediaID(id)
+	return uuo
+}
+
+// SetNillableMediaID sets the "media" edge to the Media entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMediaID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetMediaID(*id)
+	}
+	return uuo
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (uuo *UserUpdateOne) SetMedia(m *Media) *UserUpdateOne {
+	return uuo.SetMediaID(m.ID)
+}
+
+// AddMediaUploaderIDs adds the "media_uploader" edge to the Media entity by IDs.
+func (uuo *UserUpdateOne) AddMediaUploaderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMediaUploaderIDs(ids...)
+	return uuo
+}
+
+// AddMediaUploader adds the "media_uploader" edges to the Media entity.
+func (uuo *UserUpdateOne) AddMediaUploader(m ...*Media) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMediaUploaderIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uuo *UserUpdateOne) AddRoleIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddRoleIDs(ids...)
+	return uuo
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) AddRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoleIDs(ids...)
+}
+
+// AddCourseCreatorIDs adds the "course_creator" edge to the Course entity by IDs.
+func (uuo *UserUpdateOne) AddCourseCreatorIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCourseCreatorIDs(ids...)
+	return uuo
+}
+
+// AddCourseCreator adds the "course_creator" edges to the Course entity.
+func (uuo *UserUpdateOne) AddCourseCreator(c ...*Course) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCourseCreatorIDs(ids...)
+}
+
+// AddQuestionCollectionIDs adds the "question_collections" edge to the QuestionCollection entity by IDs.
+func (uuo *UserUpdateOne) AddQuestionCollectionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddQuestionCollectionIDs(ids...)
+	return uuo
+}
+
+// AddQuestionCollections adds the "question_collections" edges to the QuestionCollection entity.
+func (uuo *UserUpdateOne) AddQuestionCollections(q ...*QuestionCollection) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.AddQuestionCollectionIDs(ids...)
+}
+
+// AddTestSessionIDs adds the "test_sessions" edge to the TestSession entity by IDs.
+func (uuo *UserUpdateOne) AddTestSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddTestSessionIDs(ids...)
+	return uuo
+}
+
+// AddTestSessions adds the "test_sessions" edges to the TestSession entity.
+func (uuo *UserUpdateOne) AddTestSessions(t ...*TestSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTestSessionIDs(ids...)
+}
+
+// AddJwtTokenIDs adds the "jwt_tokens" edge to the JwtToken entity by IDs.
+func (uuo *UserUpdateOne) AddJwtTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddJwtTokenIDs(ids...)
+	return uuo
+}
+
+// AddJwtTokens adds the "jwt_tokens" edges to the JwtToken entity.
+func (uuo *UserUpdateOne) AddJwtTokens(j ...*JwtToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
 		ids[i] = j[i].ID
 	}
-	return uuo.RemoveJwtTokenIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if err := uuo.defaults(); err != nil {
-		return nil, err
+	return uuo.AddJwtTokenIDs(ids...)
+}
+
+// AddCourseEnrollmentIDs adds the "course_enrollments" edge to the CourseEnrollment entity by IDs.
+func (uuo *UserUpdateOne) AddCourseEnrollmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCourseEnrollmentIDs(ids...)
+	return uuo
+}
+
+// AddCourseEnrollments adds the "course_enrollments" edges to the CourseEnrollment entity.
+func (uuo *UserUpdateOne) AddCourseEnrollments(c ...*CourseEnrollment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCourseEnrollmentIDs(ids...)
+}
+
+// SetAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID.
+func (uuo *UserUpdateOne) SetAccommodationID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetAccommodationID(id)
+	return uuo
+}
+
+// SetNillableAccommodationID sets the "accommodation" edge to the UserAccommodation entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAccommodationID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetAccommodationID(*id)
+	}
+	return uuo
+}
+
+// SetAccommodation sets the "accommodation" edge to the UserAccommodation entity.
+func (uuo *UserUpdateOne) SetAccommodation(u *UserAccommodation) *UserUpdateOne {
+	return uuo.SetAccommodationID(u.ID)
+}
+
+// AddTestSessionTimeExtensionIDs adds the "test_session_time_extensions" edge to the TestSessionTimeExtension entity by IDs.
+func (uuo *UserUpdateOne) AddTestSessionTimeExtensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddTestSessionTimeExtensionIDs(ids...)
+	return uuo
+}
+
+// AddTestSessionTimeExtensions adds the "test_session_time_extensions" edges to the TestSessionTimeExtension entity.
+func (uuo *UserUpdateOne) AddTestSessionTimeExtensions(t ...*TestSessionTimeExtension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTestSessionTimeExtensionIDs(ids...)
+}
+
+// AddTestSessionRegradeIDs adds the "test_session_regrades" edge to the TestSessionRegrade entity by IDs.
+func (uuo *UserUpdateOne) AddTestSessionRegradeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddTestSessionRegradeIDs(ids...)
+	return uuo
+}
+
+// AddTestSessionRegrades adds the "test_session_regrades" edges to the TestSessionRegrade entity.
+func (uuo *UserUpdateOne) AddTestSessionRegrades(t ...*TestSessionRegrade) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTestSessionRegradeIDs(ids...)
+}
+
+// AddQuestionVersionIDs adds the "question_versions" edge to the QuestionVersion entity by IDs.
+func (uuo *UserUpdateOne) AddQuestionVersionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddQuestionVersionIDs(ids...)
+	return uuo
+}
+
+// AddQuestionVersions adds the "question_versions" edges to the QuestionVersion entity.
+func (uuo *UserUpdateOne) AddQuestionVersions(q ...*QuestionVersion) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.AddQuestionVersionIDs(ids...)
+}
+
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (uuo *UserUpdateOne) AddAccessGrantIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddAccessGrantIDs(ids...)
+	return uuo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (uuo *UserUpdateOne) AddAccessGrants(a ...*AccessGrant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAccessGrantIDs(ids...)
+}
+
+// AddGrantedAccessGrantIDs adds the "granted_access_grants" edge to the AccessGrant entity by IDs.
+func (uuo *UserUpdateOne) AddGrantedAccessGrantIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddGrantedAccessGrantIDs(ids...)
+	return uuo
+}
+
+// AddGrantedAccessGrants adds the "granted_access_grants" edges to the AccessGrant entity.
+func (uuo *UserUpdateOne) AddGrantedAccessGrants(a ...*AccessGrant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddGrantedAccessGrantIDs(ids...)
+}
+
+// AddCreatedTestIDs adds the "created_tests" edge to the Test entity by IDs.
+func (uuo *UserUpdateOne) AddCreatedTestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCreatedTestIDs(ids...)
+	return uuo
+}
+
+// AddCreatedTests adds the "created_tests" edges to the Test entity.
+func (uuo *UserUpdateOne) AddCreatedTests(t ...*Test) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddCreatedTestIDs(ids...)
+}
+
+// AddCreatedGroupIDs adds the "created_groups" edge to the Group entity by IDs.
+func (uuo *UserUpdateOne) AddCreatedGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCreatedGroupIDs(ids...)
+	return uuo
+}
+
+// AddCreatedGroups adds the "created_groups" edges to the Group entity.
+func (uuo *UserUpdateOne) AddCreatedGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddCreatedGroupIDs(ids...)
+}
+
+// AddMemberGroupIDs adds the "member_groups" edge to the Group entity by IDs.
+func (uuo *UserUpdateOne) AddMemberGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMemberGroupIDs(ids...)
+	return uuo
+}
+
+// AddMemberGroups adds the "member_groups" edges to the Group entity.
+func (uuo *UserUpdateOne) AddMemberGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddMemberGroupIDs(ids...)
+}
+
+// AddGroupTestAssignmentIDs adds the "group_test_assignments" edge to the GroupTestAssignment entity by IDs.
+func (uuo *UserUpdateOne) AddGroupTestAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddGroupTestAssignmentIDs(ids...)
+	return uuo
+}
+
+// AddGroupTestAssignments adds the "group_test_assignments" edges to the GroupTestAssignment entity.
+func (uuo *UserUpdateOne) AddGroupTestAssignments(g ...*GroupTestAssignment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupTestAssignmentIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the UserInvite entity by IDs.
+func (uuo *UserUpdateOne) AddInviteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddInviteIDs(ids...)
+	return uuo
+}
+
+// AddInvites adds the "invites" edges to the UserInvite entity.
+func (uuo *UserUpdateOne) AddInvites(u ...*UserInvite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddInviteIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (uuo *UserUpdateOne) ClearOrganization() *UserUpdateOne {
+	uuo.mutation.ClearOrganization()
+	return uuo
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (uuo *UserUpdateOne) ClearMedia() *UserUpdateOne {
+	uuo.mutation.ClearMedia()
+	return uuo
+}
+
+// ClearMediaUploader clears all "media_uploader" edges to the Media entity.
+func (uuo *UserUpdateOne) ClearMediaUploader() *UserUpdateOne {
+	uuo.mutation.ClearMediaUploader()
+	return uuo
+}
+
+// RemoveMediaUploaderIDs removes the "media_uploader" edge to Media entities by IDs.
+func (uuo *UserUpdateOne) RemoveMediaUploaderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMediaUploaderIDs(ids...)
+	return uuo
+}
+
+// RemoveMediaUploader removes "media_uploader" edges to Media entities.
+func (uuo *UserUpdateOne) RemoveMediaUploader(m ...*Media) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMediaUploaderIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) ClearRoles() *UserUpdateOne {
+	uuo.mutation.ClearRoles()
+	return uuo
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoleIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveRoleIDs(ids...)
+	return uuo
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (uuo *UserUpdateOne) RemoveRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoleIDs(ids...)
+}
+
+// ClearCourseCreator clears all "course_creator" edges to the Course entity.
+func (uuo *UserUpdateOne) ClearCourseCreator() *UserUpdateOne {
+	uuo.mutation.ClearCourseCreator()
+	return uuo
+}
+
+// RemoveCourseCreatorIDs removes the "course_creator" edge to Course entities by IDs.
+func (uuo *UserUpdateOne) RemoveCourseCreatorIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCourseCreatorIDs(ids...)
+	return uuo
+}
+
+// RemoveCourseCreator removes "course_creator" edges to Course entities.
+func (uuo *UserUpdateOne) RemoveCourseCreator(c ...*Course) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCourseCreatorIDs(ids...)
+}
+
+// ClearQuestionCollections clears all "question_collections" edges to the QuestionCollection entity.
+func (uuo *UserUpdateOne) ClearQuestionCollections() *UserUpdateOne {
+	uuo.mutation.ClearQuestionCollections()
+	return uuo
+}
+
+// RemoveQuestionCollectionIDs removes the "question_collections" edge to QuestionCollection entities by IDs.
+func (uuo *UserUpdateOne) RemoveQuestionCollectionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveQuestionCollectionIDs(ids...)
+	return uuo
+}
+
+// RemoveQuestionCollections removes "question_collections" edges to QuestionCollection entities.
+func (uuo *UserUpdateOne) RemoveQuestionCollections(q ...*QuestionCollection) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.RemoveQuestionCollectionIDs(ids...)
+}
+
+// ClearTestSessions clears all "test_sessions" edges to the TestSession entity.
+func (uuo *UserUpdateOne) ClearTestSessions() *UserUpdateOne {
+	uuo.mutation.ClearTestSessions()
+	return uuo
+}
+
+// RemoveTestSessionIDs removes the "test_sessions" edge to TestSession entities by IDs.
+func (uuo *UserUpdateOne) RemoveTestSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveTestSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveTestSessions removes "test_sessions" edges to TestSession entities.
+func (uuo *UserUpdateOne) RemoveTestSessions(t ...*TestSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTestSessionIDs(ids...)
+}
+
+// ClearJwtTokens clears all "jwt_tokens" edges to the JwtToken entity.
+func (uuo *UserUpdateOne) ClearJwtTokens() *UserUpdateOne {
+	uuo.mutation.ClearJwtTokens()
+	return uuo
+}
+
+// RemoveJwtTokenIDs removes the "jwt_tokens" edge to JwtToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveJwtTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveJwtTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveJwtTokens removes "jwt_tokens" edges to JwtToken entities.
+func (uuo *UserUpdateOne) RemoveJwtTokens(j ...*JwtToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uuo.RemoveJwtTokenIDs(ids...)
+}
+
+// ClearCourseEnrollments clears all "course_enrollments" edges to the CourseEnrollment entity.
+func (uuo *UserUpdateOne) ClearCourseEnrollments() *UserUpdateOne {
+	uuo.mutation.ClearCourseEnrollments()
+	return uuo
+}
+
+// RemoveCourseEnrollmentIDs removes the "course_enrollments" edge to CourseEnrollment entities by IDs.
+func (uuo *UserUpdateOne) RemoveCourseEnrollmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCourseEnrollmentIDs(ids...)
+	return uuo
+}
+
+// RemoveCourseEnrollments removes "course_enrollments" edges to CourseEnrollment entities.
+func (uuo *UserUpdateOne) RemoveCourseEnrollments(c ...*CourseEnrollment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCourseEnrollmentIDs(ids...)
+}
+
+// ClearAccommodation clears the "accommodation" edge to the UserAccommodation entity.
+func (uuo *UserUpdateOne) ClearAccommodation() *UserUpdateOne {
+	uuo.mutation.ClearAccommodation()
+	return uuo
+}
+
+// ClearTestSessionTimeExtensions clears all "test_session_time_extensions" edges to the TestSessionTimeExtension entity.
+func (uuo *UserUpdateOne) ClearTestSessionTimeExtensions() *UserUpdateOne {
+	uuo.mutation.ClearTestSessionTimeExtensions()
+	return uuo
+}
+
+// RemoveTestSessionTimeExtensionIDs removes the "test_session_time_extensions" edge to TestSessionTimeExtension entities by IDs.
+func (uuo *UserUpdateOne) RemoveTestSessionTimeExtensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveTestSessionTimeExtensionIDs(ids...)
+	return uuo
+}
+
+// RemoveTestSessionTimeExtensions removes "test_session_time_extensions" edges to TestSessionTimeExtension entities.
+func (uuo *UserUpdateOne) RemoveTestSessionTimeExtensions(t ...*TestSessionTimeExtension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTestSessionTimeExtensionIDs(ids...)
+}
+
+// ClearTestSessionRegrades clears all "test_session_regrades" edges to the TestSessionRegrade entity.
+func (uuo *UserUpdateOne) ClearTestSessionRegrades() *UserUpdateOne {
+	uuo.mutation.ClearTestSessionRegrades()
+	return uuo
+}
+
+// RemoveTestSessionRegradeIDs removes the "test_session_regrades" edge to TestSessionRegrade entities by IDs.
+func (uuo *UserUpdateOne) RemoveTestSessionRegradeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveTestSessionRegradeIDs(ids...)
+	return uuo
+}
+
+// RemoveTestSessionRegrades removes "test_session_regrades" edges to TestSessionRegrade entities.
+func (uuo *UserUpdateOne) RemoveTestSessionRegrades(t ...*TestSessionRegrade) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTestSessionRegradeIDs(ids...)
+}
+
+// ClearQuestionVersions clears all "question_versions" edges to the QuestionVersion entity.
+func (uuo *UserUpdateOne) ClearQuestionVersions() *UserUpdateOne {
+	uuo.mutation.ClearQuestionVersions()
+	return uuo
+}
+
+// RemoveQuestionVersionIDs removes the "question_versions" edge to QuestionVersion entities by IDs.
+func (uuo *UserUpdateOne) RemoveQuestionVersionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveQuestionVersionIDs(ids...)
+	return uuo
+}
+
+// RemoveQuestionVersions removes "question_versions" edges to QuestionVersion entities.
+func (uuo *UserUpdateOne) RemoveQuestionVersions(q ...*QuestionVersion) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.RemoveQuestionVersionIDs(ids...)
+}
+
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (uuo *UserUpdateOne) ClearAccessGrants() *UserUpdateOne {
+	uuo.mutation.ClearAccessGrants()
+	return uuo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (uuo *UserUpdateOne) RemoveAccessGrantIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveAccessGrantIDs(ids...)
+	return uuo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (uuo *UserUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearGrantedAccessGrants clears all "granted_access_grants" edges to the AccessGrant entity.
+func (uuo *UserUpdateOne) ClearGrantedAccessGrants() *UserUpdateOne {
+	uuo.mutation.ClearGrantedAccessGrants()
+	return uuo
+}
+
+// RemoveGrantedAccessGrantIDs removes the "granted_access_grants" edge to AccessGrant entities by IDs.
+func (uuo *UserUpdateOne) RemoveGrantedAccessGrantIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveGrantedAccessGrantIDs(ids...)
+	return uuo
+}
+
+// RemoveGrantedAccessGrants removes "granted_access_grants" edges to AccessGrant entities.
+func (uuo *UserUpdateOne) RemoveGrantedAccessGrants(a ...*AccessGrant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveGrantedAccessGrantIDs(ids...)
+}
+
+// ClearCreatedTests clears all "created_tests" edges to the Test entity.
+func (uuo *UserUpdateOne) ClearCreatedTests() *UserUpdateOne {
+	uuo.mutation.ClearCreatedTests()
+	return uuo
+}
+
+// RemoveCreatedTestIDs removes the "created_tests" edge to Test entities by IDs.
+func (uuo *UserUpdateOne) RemoveCreatedTestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCreatedTestIDs(ids...)
+	return uuo
+}
+
+// RemoveCreatedTests removes "created_tests" edges to Test entities.
+func (uuo *UserUpdateOne) RemoveCreatedTests(t ...*Test) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveCreatedTestIDs(ids...)
+}
+
+// ClearCreatedGroups clears all "created_groups" edges to the Group entity.
+func (uuo *UserUpdateOne) ClearCreatedGroups() *UserUpdateOne {
+	uuo.mutation.ClearCreatedGroups()
+	return uuo
+}
+
+// RemoveCreatedGroupIDs removes the "created_groups" edge to Group entities by IDs.
+func (uuo *UserUpdateOne) RemoveCreatedGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCreatedGroupIDs(ids...)
+	return uuo
+}
+
+// RemoveCreatedGroups removes "created_groups" edges to Group entities.
+func (uuo *UserUpdateOne) RemoveCreatedGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveCreatedGroupIDs(ids...)
+}
+
+// ClearMemberGroups clears all "member_groups" edges to the Group entity.
+func (uuo *UserUpdateOne) ClearMemberGroups() *UserUpdateOne {
+	uuo.mutation.ClearMemberGroups()
+	return uuo
+}
+
+// RemoveMemberGroupIDs removes the "member_groups" edge to Group entities by IDs.
+func (uuo *UserUpdateOne) RemoveMemberGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMemberGroupIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberGroups removes "member_groups" edges to Group entities.
+func (uuo *UserUpdateOne) RemoveMemberGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveMemberGroupIDs(ids...)
+}
+
+// ClearGroupTestAssignments clears all "group_test_assignments" edges to the GroupTestAssignment entity.
+func (uuo *UserUpdateOne) ClearGroupTestAssignments() *UserUpdateOne {
+	uuo.mutation.ClearGroupTestAssignments()
+	return uuo
+}
+
+// RemoveGroupTestAssignmentIDs removes the "group_test_assignments" edge to GroupTestAssignment entities by IDs.
+func (uuo *UserUpdateOne) RemoveGroupTestAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveGroupTestAssignmentIDs(ids...)
+	return uuo
+}
+
+// RemoveGroupTestAssignments removes "group_test_assignments" edges to GroupTestAssignment entities.
+func (uuo *UserUpdateOne) RemoveGroupTestAssignments(g ...*GroupTestAssignment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupTestAssignmentIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the UserInvite entity.
+func (uuo *UserUpdateOne) ClearInvites() *UserUpdateOne {
+	uuo.mutation.ClearInvites()
+	return uuo
+}
+
+// RemoveInviteIDs removes the "invites" edge to UserInvite entities by IDs.
+func (uuo *UserUpdateOne) RemoveInviteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveInviteIDs(ids...)
+	return uuo
+}
+
+// RemoveInvites removes "invites" edges to UserInvite entities.
+func (uuo *UserUpdateOne) RemoveInvites(u ...*UserInvite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveInviteIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if err := uuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() error {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.PasswordHash(); ok {
+		if err := user.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.FirstName(); ok {
+		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	}
+	if uuo.mutation.FirstNameCleared() {
+		_spec.ClearField(user.FieldFirstName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.LastName(); ok {
+		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	}
+	if uuo.mutation.LastNameCleared() {
+		_spec.ClearField(user.FieldLastName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.IsActive(); ok {
+		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.MustChangePassword(); ok {
+		_spec.SetField(user.FieldMustChangePassword, field.TypeBool, value)
+	}
+	if uuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MediaTable,
+			Columns: []string{user.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MediaTable,
+			Columns: []string{user.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MediaUploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MediaUploaderTable,
+			Columns: []string{user.MediaUploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMediaUploaderIDs(); len(nodes) > 0 && !uuo.mutation.MediaUploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MediaUploaderTable,
+			Columns: []string{user.MediaUploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MediaUploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MediaUploaderTable,
+			Columns: []string{user.MediaUploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.CourseCreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseCreatorTable,
+			Columns: []string{user.CourseCreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCourseCreatorIDs(); len(nodes) > 0 && !uuo.mutation.CourseCreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseCreatorTable,
+			Columns: []string{user.CourseCreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CourseCreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseCreatorTable,
+			Columns: []string{user.CourseCreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if uuo.mutation.QuestionCollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionCollectionsTable,
+			Columns: []string{user.QuestionCollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := uuo.mutation.RemovedQuestionCollectionsIDs(); len(nodes) > 0 && !uuo.mutation.QuestionCollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionCollectionsTable,
+			Columns: []string{user.QuestionCollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() error {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok {
-		if user.UpdateDefaultUpdatedAt == nil {
-			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+	if nodes := uuo.mutation.QuestionCollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionCollectionsTable,
+			Columns: []string{user.QuestionCollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+			},
 		}
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	if uuo.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionsTable,
+			Columns: []string{user.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := uuo.mutation.RemovedTestSessionsIDs(); len(nodes) > 0 && !uuo.mutation.TestSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionsTable,
+			Columns: []string{user.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.PasswordHash(); ok {
-		if err := user.PasswordHashValidator(v); err != nil {
-			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+	if nodes := uuo.mutation.TestSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionsTable,
+			Columns: []string{user.TestSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if uuo.mutation.JwtTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JwtTokensTable,
+			Columns: []string{user.JwtTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if nodes := uuo.mutation.RemovedJwtTokensIDs(); len(nodes) > 0 && !uuo.mutation.JwtTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JwtTokensTable,
+			Columns: []string{user.JwtTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.JwtTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JwtTokensTable,
+			Columns: []string{user.JwtTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if uuo.mutation.CourseEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCourseEnrollmentsIDs(); len(nodes) > 0 && !uuo.mutation.CourseEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.CreatedAt(); ok {
-		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := uuo.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.CourseEnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseEnrollmentsTable,
+			Columns: []string{user.CourseEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseenrollment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.DeletedAtCleared() {
-		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	if uuo.mutation.AccommodationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AccommodationTable,
+			Columns: []string{user.AccommodationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	if nodes := uuo.mutation.AccommodationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AccommodationTable,
+			Columns: []string{user.AccommodationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if uuo.mutation.TestSessionTimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.PasswordHash(); ok {
-		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedTestSessionTimeExtensionsIDs(); len(nodes) > 0 && !uuo.mutation.TestSessionTimeExtensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.FirstName(); ok {
-		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	if nodes := uuo.mutation.TestSessionTimeExtensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionTimeExtensionsTable,
+			Columns: []string{user.TestSessionTimeExtensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessiontimeextension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FirstNameCleared() {
-		_spec.ClearField(user.FieldFirstName, field.TypeString)
+	if uuo.mutation.TestSessionRegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LastName(); ok {
-		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedTestSessionRegradesIDs(); len(nodes) > 0 && !uuo.mutation.TestSessionRegradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.LastNameCleared() {
-		_spec.ClearField(user.FieldLastName, field.TypeString)
+	if nodes := uuo.mutation.TestSessionRegradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TestSessionRegradesTable,
+			Columns: []string{user.TestSessionRegradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testsessionregrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.IsActive(); ok {
-		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	if uuo.mutation.QuestionVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.MediaCleared() {
+	if nodes := uuo.mutation.RemovedQuestionVersionsIDs(); len(nodes) > 0 && !uuo.mutation.QuestionVersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   user.MediaTable,
-			Columns: []string{user.MediaColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MediaIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.QuestionVersionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   user.MediaTable,
-			Columns: []string{user.MediaColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuestionVersionsTable,
+			Columns: []string{user.QuestionVersionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionversion.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1437,28 +3447,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.MediaUploaderCleared() {
+	if uuo.mutation.AccessGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MediaUploaderTable,
-			Columns: []string{user.MediaUploaderColumn},
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedMediaUploaderIDs(); len(nodes) > 0 && !uuo.mutation.MediaUploaderCleared() {
+	if nodes := uuo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !uuo.mutation.AccessGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MediaUploaderTable,
-			Columns: []string{user.MediaUploaderColumn},
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1466,15 +3476,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MediaUploaderIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MediaUploaderTable,
-			Columns: []string{user.MediaUploaderColumn},
+			Table:   user.AccessGrantsTable,
+			Columns: []string{user.AccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1482,28 +3492,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.RolesCleared() {
+	if uuo.mutation.GrantedAccessGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RolesTable,
-			Columns: user.RolesPrimaryKey,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uuo.mutation.RolesCleared() {
+	if nodes := uuo.mutation.RemovedGrantedAccessGrantsIDs(); len(nodes) > 0 && !uuo.mutation.GrantedAccessGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RolesTable,
-			Columns: user.RolesPrimaryKey,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1511,15 +3521,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RolesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.GrantedAccessGrantsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RolesTable,
-			Columns: user.RolesPrimaryKey,
+			Table:   user.GrantedAccessGrantsTable,
+			Columns: []string{user.GrantedAccessGrantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1527,28 +3537,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.CourseCreatorCleared() {
+	if uuo.mutation.CreatedTestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CourseCreatorTable,
-			Columns: []string{user.CourseCreatorColumn},
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedCourseCreatorIDs(); len(nodes) > 0 && !uuo.mutation.CourseCreatorCleared() {
+	if nodes := uuo.mutation.RemovedCreatedTestsIDs(); len(nodes) > 0 && !uuo.mutation.CreatedTestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CourseCreatorTable,
-			Columns: []string{user.CourseCreatorColumn},
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1556,15 +3566,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.CourseCreatorIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.CreatedTestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CourseCreatorTable,
-			Columns: []string{user.CourseCreatorColumn},
+			Table:   user.CreatedTestsTable,
+			Columns: []string{user.CreatedTestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(test.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1572,28 +3582,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.QuestionCollectionsCleared() {
+	if uuo.mutation.CreatedGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.QuestionCollectionsTable,
-			Columns: []string{user.QuestionCollectionsColumn},
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedQuestionCollectionsIDs(); len(nodes) > 0 && !uuo.mutation.QuestionCollectionsCleared() {
+	if nodes := uuo.mutation.RemovedCreatedGroupsIDs(); len(nodes) > 0 && !uuo.mutation.CreatedGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.QuestionCollectionsTable,
-			Columns: []string{user.QuestionCollectionsColumn},
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1601,15 +3611,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.QuestionCollectionsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.CreatedGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.QuestionCollectionsTable,
-			Columns: []string{user.QuestionCollectionsColumn},
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(questioncollection.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1617,28 +3627,73 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.TestSessionsCleared() {
+	if uuo.mutation.MemberGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMemberGroupsIDs(); len(nodes) > 0 && !uuo.mutation.MemberGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MemberGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MemberGroupsTable,
+			Columns: user.MemberGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.GroupTestAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TestSessionsTable,
-			Columns: []string{user.TestSessionsColumn},
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedTestSessionsIDs(); len(nodes) > 0 && !uuo.mutation.TestSessionsCleared() {
+	if nodes := uuo.mutation.RemovedGroupTestAssignmentsIDs(); len(nodes) > 0 && !uuo.mutation.GroupTestAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TestSessionsTable,
-			Columns: []string{user.TestSessionsColumn},
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1646,15 +3701,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.TestSessionsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.GroupTestAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TestSessionsTable,
-			Columns: []string{user.TestSessionsColumn},
+			Table:   user.GroupTestAssignmentsTable,
+			Columns: []string{user.GroupTestAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(testsession.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(grouptestassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1662,28 +3717,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.JwtTokensCleared() {
+	if uuo.mutation.InvitesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.JwtTokensTable,
-			Columns: []string{user.JwtTokensColumn},
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedJwtTokensIDs(); len(nodes) > 0 && !uuo.mutation.JwtTokensCleared() {
+	if nodes := uuo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !uuo.mutation.InvitesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.JwtTokensTable,
-			Columns: []string{user.JwtTokensColumn},
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1691,15 +3746,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.JwtTokensIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.InvitesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.JwtTokensTable,
-			Columns: []string{user.JwtTokensColumn},
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jwttoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
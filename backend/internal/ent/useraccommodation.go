@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// UserAccommodation is the model entity for the UserAccommodation schema.
+type UserAccommodation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Multiplier applied to the total time of a test
+	TimeMultiplier float64 `json:"time_multiplier,omitempty"`
+	// Fixed minutes added to the total time of a test
+	ExtraMinutes int `json:"extra_minutes,omitempty"`
+	// AllowRestBreaks holds the value of the "allow_rest_breaks" field.
+	AllowRestBreaks bool `json:"allow_rest_breaks,omitempty"`
+	// Number of rest breaks allowed per session, unlimited when empty
+	MaxRestBreaks *int `json:"max_rest_breaks,omitempty"`
+	// Minutes of a rest break that do not count against the session time, unlimited when empty
+	MaxRestBreakMinutes *int `json:"max_rest_break_minutes,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserAccommodationQuery when eager-loading is set.
+	Edges        UserAccommodationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserAccommodationEdges holds the relations/edges for other nodes in the graph.
+type UserAccommodationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserAccommodationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserAccommodation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case useraccommodation.FieldAllowRestBreaks:
+			values[i] = new(sql.NullBool)
+		case useraccommodation.FieldTimeMultiplier:
+			values[i] = new(sql.NullFloat64)
+		case useraccommodation.FieldExtraMinutes, useraccommodation.FieldMaxRestBreaks, useraccommodation.FieldMaxRestBreakMinutes:
+			values[i] = new(sql.NullInt64)
+		case useraccommodation.FieldNotes:
+			values[i] = new(sql.NullString)
+		case useraccommodation.FieldCreatedAt, useraccommodation.FieldUpdatedAt, useraccommodation.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case useraccommodation.FieldID, useraccommodation.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserAccommodation fields.
+func (ua *UserAccommodation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case useraccommodation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ua.ID = *value
+			}
+		case useraccommodation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ua.CreatedAt = value.Time
+			}
+		case useraccommodation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ua.UpdatedAt = value.Time
+			}
+		case useraccommodation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ua.DeletedAt = new(time.Time)
+				*ua.DeletedAt = value.Time
+			}
+		case useraccommodation.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ua.UserID = *value
+			}
+		case useraccommodation.FieldTimeMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_multiplier", values[i])
+			} else if value.Valid {
+				ua.TimeMultiplier = value.Float64
+			}
+		case useraccommodation.FieldExtraMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_minutes", values[i])
+			} else if value.Valid {
+				ua.ExtraMinutes = int(value.Int64)
+			}
+		case useraccommodation.FieldAllowRestBreaks:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_rest_breaks", values[i])
+			} else if value.Valid {
+				ua.AllowRestBreaks = value.Bool
+			}
+		case useraccommodation.FieldMaxRestBreaks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rest_breaks", values[i])
+			} else if value.Valid {
+				ua.MaxRestBreaks = new(int)
+				*ua.MaxRestBreaks = int(value.Int64)
+			}
+		case useraccommodation.FieldMaxRestBreakMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rest_break_minutes", values[i])
+			} else if value.Valid {
+				ua.MaxRestBreakMinutes = new(int)
+				*ua.MaxRestBreakMinutes = int(value.Int64)
+			}
+		case useraccommodation.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				ua.Notes = new(string)
+				*ua.Notes = value.String
+			}
+		default:
+			ua.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserAccommodation.
+// This includes values selected through modifiers, order, etc.
+func (ua *UserAccommodation) Value(name string) (ent.Value, error) {
+	return ua.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserAccommodation entity.
+func (ua *UserAccommodation) QueryUser() *UserQuery {
+	return NewUserAccommodationClient(ua.config).QueryUser(ua)
+}
+
+// Update returns a builder for updating this UserAccommodation.
+// Note that you need to call UserAccommodation.Unwrap() before calling this method if this UserAccommodation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ua *UserAccommodation) Update() *UserAccommodationUpdateOne {
+	return NewUserAccommodationClient(ua.config).UpdateOne(ua)
+}
+
+// Unwrap unwraps the UserAccommodation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ua *UserAccommodation) Unwrap() *UserAccommodation {
+	_tx, ok := ua.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserAccommodation is not a transactional entity")
+	}
+	ua.config.driver = _tx.drv
+	return ua
+}
+
+// String implements the fmt.Stringer.
+func (ua *UserAccommodation) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserAccommodation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ua.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ua.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ua.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ua.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ua.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("time_multiplier=")
+	builder.WriteString(fmt.Sprintf("%v", ua.TimeMultiplier))
+	builder.WriteString(", ")
+	builder.WriteString("extra_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", ua.ExtraMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("allow_rest_breaks=")
+	builder.WriteString(fmt.Sprintf("%v", ua.AllowRestBreaks))
+	builder.WriteString(", ")
+	if v := ua.MaxRestBreaks; v != nil {
+		builder.WriteString("max_rest_breaks=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ua.MaxRestBreakMinutes; v != nil {
+		builder.WriteString("max_rest_break_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ua.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserAccommodations is a parsable slice of UserAccommodation.
+type UserAccommodations []*UserAccommodation
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package useraccommodation
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the useraccommodation type in the database.
+	Label = "user_accommodation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTimeMultiplier holds the string denoting the time_multiplier field in the database.
+	FieldTimeMultiplier = "time_multiplier"
+	// FieldExtraMinutes holds the string denoting the extra_minutes field in the database.
+	FieldExtraMinutes = "extra_minutes"
+	// FieldAllowRestBreaks holds the string denoting the allow_rest_breaks field in the database.
+	FieldAllowRestBreaks = "allow_rest_breaks"
+	// FieldMaxRestBreaks holds the string denoting the max_rest_breaks field in the database.
+	FieldMaxRestBreaks = "max_rest_breaks"
+	// FieldMaxRestBreakMinutes holds the string denoting the max_rest_break_minutes field in the database.
+	FieldMaxRestBreakMinutes = "max_rest_break_minutes"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the useraccommodation in the database.
+	Table = "user_accommodations"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "user_accommodations"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for useraccommodation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldUserID,
+	FieldTimeMultiplier,
+	FieldExtraMinutes,
+	FieldAllowRestBreaks,
+	FieldMaxRestBreaks,
+	FieldMaxRestBreakMinutes,
+	FieldNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "template/internal/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTimeMultiplier holds the default value on creation for the "time_multiplier" field.
+	DefaultTimeMultiplier float64
+	// TimeMultiplierValidator is a validator for the "time_multiplier" field. It is called by the builders before save.
+	TimeMultiplierValidator func(float64) error
+	// DefaultExtraMinutes holds the default value on creation for the "extra_minutes" field.
+	DefaultExtraMinutes int
+	// ExtraMinutesValidator is a validator for the "extra_minutes" field. It is called by the builders before save.
+	ExtraMinutesValidator func(int) error
+	// DefaultAllowRestBreaks holds the default value on creation for the "allow_rest_breaks" field.
+	DefaultAllowRestBreaks bool
+	// MaxRestBreaksValidator is a validator for the "max_rest_breaks" field. It is called by the builders before save.
+	MaxRestBreaksValidator func(int) error
+	// MaxRestBreakMinutesValidator is a validator for the "max_rest_break_minutes" field. It is called by the builders before save.
+	MaxRestBreakMinutesValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the UserAccommodation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTimeMultiplier orders the results by the time_multiplier field.
+func ByTimeMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeMultiplier, opts...).ToFunc()
+}
+
+// ByExtraMinutes orders the results by the extra_minutes field.
+func ByExtraMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtraMinutes, opts...).ToFunc()
+}
+
+// ByAllowRestBreaks orders the results by the allow_rest_breaks field.
+func ByAllowRestBreaks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowRestBreaks, opts...).ToFunc()
+}
+
+// ByMaxRestBreaks orders the results by the max_rest_breaks field.
+func ByMaxRestBreaks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRestBreaks, opts...).ToFunc()
+}
+
+// ByMaxRestBreakMinutes orders the results by the max_rest_break_minutes field.
+func ByMaxRestBreakMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRestBreakMinutes, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package useraccommodation
+
+import (
+	"template/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldUserID, v))
+}
+
+// TimeMultiplier applies equality check predicate on the "time_multiplier" field. It's identical to TimeMultiplierEQ.
+func TimeMultiplier(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldTimeMultiplier, v))
+}
+
+// ExtraMinutes applies equality check predicate on the "extra_minutes" field. It's identical to ExtraMinutesEQ.
+func ExtraMinutes(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldExtraMinutes, v))
+}
+
+// AllowRestBreaks applies equality check predicate on the "allow_rest_breaks" field. It's identical to AllowRestBreaksEQ.
+func AllowRestBreaks(v bool) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldAllowRestBreaks, v))
+}
+
+// MaxRestBreaks applies equality check predicate on the "max_rest_breaks" field. It's identical to MaxRestBreaksEQ.
+func MaxRestBreaks(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreakMinutes applies equality check predicate on the "max_rest_break_minutes" field. It's identical to MaxRestBreakMinutesEQ.
+func MaxRestBreakMinutes(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TimeMultiplierEQ applies the EQ predicate on the "time_multiplier" field.
+func TimeMultiplierEQ(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierNEQ applies the NEQ predicate on the "time_multiplier" field.
+func TimeMultiplierNEQ(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierIn applies the In predicate on the "time_multiplier" field.
+func TimeMultiplierIn(vs ...float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldTimeMultiplier, vs...))
+}
+
+// TimeMultiplierNotIn applies the NotIn predicate on the "time_multiplier" field.
+func TimeMultiplierNotIn(vs ...float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldTimeMultiplier, vs...))
+}
+
+// TimeMultiplierGT applies the GT predicate on the "time_multiplier" field.
+func TimeMultiplierGT(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierGTE applies the GTE predicate on the "time_multiplier" field.
+func TimeMultiplierGTE(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierLT applies the LT predicate on the "time_multiplier" field.
+func TimeMultiplierLT(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldTimeMultiplier, v))
+}
+
+// TimeMultiplierLTE applies the LTE predicate on the "time_multiplier" field.
+func TimeMultiplierLTE(v float64) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldTimeMultiplier, v))
+}
+
+// ExtraMinutesEQ applies the EQ predicate on the "extra_minutes" field.
+func ExtraMinutesEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesNEQ applies the NEQ predicate on the "extra_minutes" field.
+func ExtraMinutesNEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesIn applies the In predicate on the "extra_minutes" field.
+func ExtraMinutesIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldExtraMinutes, vs...))
+}
+
+// ExtraMinutesNotIn applies the NotIn predicate on the "extra_minutes" field.
+func ExtraMinutesNotIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldExtraMinutes, vs...))
+}
+
+// ExtraMinutesGT applies the GT predicate on the "extra_minutes" field.
+func ExtraMinutesGT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesGTE applies the GTE predicate on the "extra_minutes" field.
+func ExtraMinutesGTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesLT applies the LT predicate on the "extra_minutes" field.
+func ExtraMinutesLT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldExtraMinutes, v))
+}
+
+// ExtraMinutesLTE applies the LTE predicate on the "extra_minutes" field.
+func ExtraMinutesLTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldExtraMinutes, v))
+}
+
+// AllowRestBreaksEQ applies the EQ predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksEQ(v bool) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldAllowRestBreaks, v))
+}
+
+// AllowRestBreaksNEQ applies the NEQ predicate on the "allow_rest_breaks" field.
+func AllowRestBreaksNEQ(v bool) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldAllowRestBreaks, v))
+}
+
+// MaxRestBreaksEQ applies the EQ predicate on the "max_rest_breaks" field.
+func MaxRestBreaksEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksNEQ applies the NEQ predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksIn applies the In predicate on the "max_rest_breaks" field.
+func MaxRestBreaksIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldMaxRestBreaks, vs...))
+}
+
+// MaxRestBreaksNotIn applies the NotIn predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNotIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldMaxRestBreaks, vs...))
+}
+
+// MaxRestBreaksGT applies the GT predicate on the "max_rest_breaks" field.
+func MaxRestBreaksGT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksGTE applies the GTE predicate on the "max_rest_breaks" field.
+func MaxRestBreaksGTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksLT applies the LT predicate on the "max_rest_breaks" field.
+func MaxRestBreaksLT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksLTE applies the LTE predicate on the "max_rest_breaks" field.
+func MaxRestBreaksLTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldMaxRestBreaks, v))
+}
+
+// MaxRestBreaksIsNil applies the IsNil predicate on the "max_rest_breaks" field.
+func MaxRestBreaksIsNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIsNull(FieldMaxRestBreaks))
+}
+
+// MaxRestBreaksNotNil applies the NotNil predicate on the "max_rest_breaks" field.
+func MaxRestBreaksNotNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotNull(FieldMaxRestBreaks))
+}
+
+// MaxRestBreakMinutesEQ applies the EQ predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesNEQ applies the NEQ predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNEQ(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesIn applies the In predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldMaxRestBreakMinutes, vs...))
+}
+
+// MaxRestBreakMinutesNotIn applies the NotIn predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNotIn(vs ...int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldMaxRestBreakMinutes, vs...))
+}
+
+// MaxRestBreakMinutesGT applies the GT predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesGT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesGTE applies the GTE predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesGTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesLT applies the LT predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesLT(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesLTE applies the LTE predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesLTE(v int) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldMaxRestBreakMinutes, v))
+}
+
+// MaxRestBreakMinutesIsNil applies the IsNil predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesIsNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIsNull(FieldMaxRestBreakMinutes))
+}
+
+// MaxRestBreakMinutesNotNil applies the NotNil predicate on the "max_rest_break_minutes" field.
+func MaxRestBreakMinutesNotNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotNull(FieldMaxRestBreakMinutes))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserAccommodation {
+	return predicate.UserAccommodation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserAccommodation {
+	return predicate.UserAccommodation(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserAccommodation) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserAccommodation) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserAccommodation) predicate.UserAccommodation {
+	return predicate.UserAccommodation(sql.NotPredicates(p))
+}
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserAccommodationCreate is the builder for creating a UserAccommodation entity.
+type UserAccommodationCreate struct {
+	config
+	mutation *UserAccommodationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uac *UserAccommodationCreate) SetCreatedAt(t time.Time) *UserAccommodationCreate {
+	uac.mutation.SetCreatedAt(t)
+	return uac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableCreatedAt(t *time.Time) *UserAccommodationCreate {
+	if t != nil {
+		uac.SetCreatedAt(*t)
+	}
+	return uac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uac *UserAccommodationCreate) SetUpdatedAt(t time.Time) *UserAccommodationCreate {
+	uac.mutation.SetUpdatedAt(t)
+	return uac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableUpdatedAt(t *time.Time) *UserAccommodationCreate {
+	if t != nil {
+		uac.SetUpdatedAt(*t)
+	}
+	return uac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uac *UserAccommodationCreate) SetDeletedAt(t time.Time) *UserAccommodationCreate {
+	uac.mutation.SetDeletedAt(t)
+	return uac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableDeletedAt(t *time.Time) *UserAccommodationCreate {
+	if t != nil {
+		uac.SetDeletedAt(*t)
+	}
+	return uac
+}
+
+// SetUserID sets the "user_id" field.
+func (uac *UserAccommodationCreate) SetUserID(u uuid.UUID) *UserAccommodationCreate {
+	uac.mutation.SetUserID(u)
+	return uac
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (uac *UserAccommodationCreate) SetTimeMultiplier(f float64) *UserAccommodationCreate {
+	uac.mutation.SetTimeMultiplier(f)
+	return uac
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableTimeMultiplier(f *float64) *UserAccommodationCreate {
+	if f != nil {
+		uac.SetTimeMultiplier(*f)
+	}
+	return uac
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (uac *UserAccommodationCreate) SetExtraMinutes(i int) *UserAccommodationCreate {
+	uac.mutation.SetExtraMinutes(i)
+	return uac
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableExtraMinutes(i *int) *UserAccommodationCreate {
+	if i != nil {
+		uac.SetExtraMinutes(*i)
+	}
+	return uac
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (uac *UserAccommodationCreate) SetAllowRestBreaks(b bool) *UserAccommodationCreate {
+	uac.mutation.SetAllowRestBreaks(b)
+	return uac
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableAllowRestBreaks(b *bool) *UserAccommodationCreate {
+	if b != nil {
+		uac.SetAllowRestBreaks(*b)
+	}
+	return uac
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (uac *UserAccommodationCreate) SetMaxRestBreaks(i int) *UserAccommodationCreate {
+	uac.mutation.SetMaxRestBreaks(i)
+	return uac
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableMaxRestBreaks(i *int) *UserAccommodationCreate {
+	if i != nil {
+		uac.SetMaxRestBreaks(*i)
+	}
+	return uac
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (uac *UserAccommodationCreate) SetMaxRestBreakMinutes(i int) *UserAccommodationCreate {
+	uac.mutation.SetMaxRestBreakMinutes(i)
+	return uac
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableMaxRestBreakMinutes(i *int) *UserAccommodationCreate {
+	if i != nil {
+		uac.SetMaxRestBreakMinutes(*i)
+	}
+	return uac
+}
+
+// SetNotes sets the "notes" field.
+func (uac *UserAccommodationCreate) SetNotes(s string) *UserAccommodationCreate {
+	uac.mutation.SetNotes(s)
+	return uac
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableNotes(s *string) *UserAccommodationCreate {
+	if s != nil {
+		uac.SetNotes(*s)
+	}
+	return uac
+}
+
+// SetID sets the "id" field.
+func (uac *UserAccommodationCreate) SetID(u uuid.UUID) *UserAccommodationCreate {
+	uac.mutation.SetID(u)
+	return uac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (uac *UserAccommodationCreate) SetNillableID(u *uuid.UUID) *UserAccommodationCreate {
+	if u != nil {
+		uac.SetID(*u)
+	}
+	return uac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uac *UserAccommodationCreate) SetUser(u *User) *UserAccommodationCreate {
+	return uac.SetUserID(u.ID)
+}
+
+// Mutation returns the UserAccommodationMutation object of the builder.
+func (uac *UserAccommodationCreate) Mutation() *UserAccommodationMutation {
+	return uac.mutation
+}
+
+// Save creates the UserAccommodation in the database.
+func (uac *UserAccommodationCreate) Save(ctx context.Context) (*UserAccommodation, error) {
+	if err := uac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uac.sqlSave, uac.mutation, uac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uac *UserAccommodationCreate) SaveX(ctx context.Context) *UserAccommodation {
+	v, err := uac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uac *UserAccommodationCreate) Exec(ctx context.Context) error {
+	_, err := uac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uac *UserAccommodationCreate) ExecX(ctx context.Context) {
+	if err := uac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uac *UserAccommodationCreate) defaults() error {
+	if _, ok := uac.mutation.CreatedAt(); !ok {
+		if useraccommodation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useraccommodation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := useraccommodation.DefaultCreatedAt()
+		uac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uac.mutation.UpdatedAt(); !ok {
+		if useraccommodation.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useraccommodation.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useraccommodation.DefaultUpdatedAt()
+		uac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uac.mutation.TimeMultiplier(); !ok {
+		v := useraccommodation.DefaultTimeMultiplier
+		uac.mutation.SetTimeMultiplier(v)
+	}
+	if _, ok := uac.mutation.ExtraMinutes(); !ok {
+		v := useraccommodation.DefaultExtraMinutes
+		uac.mutation.SetExtraMinutes(v)
+	}
+	if _, ok := uac.mutation.AllowRestBreaks(); !ok {
+		v := useraccommodation.DefaultAllowRestBreaks
+		uac.mutation.SetAllowRestBreaks(v)
+	}
+	if _, ok := uac.mutation.ID(); !ok {
+		if useraccommodation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized useraccommodation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := useraccommodation.DefaultID()
+		uac.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uac *UserAccommodationCreate) check() error {
+	if _, ok := uac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserAccommodation.created_at"`)}
+	}
+	if _, ok := uac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserAccommodation.updated_at"`)}
+	}
+	if _, ok := uac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserAccommodation.user_id"`)}
+	}
+	if _, ok := uac.mutation.TimeMultiplier(); !ok {
+		return &ValidationError{Name: "time_multiplier", err: errors.New(`ent: missing required field "UserAccommodation.time_multiplier"`)}
+	}
+	if v, ok := uac.mutation.TimeMultiplier(); ok {
+		if err := useraccommodation.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.time_multiplier": %w`, err)}
+		}
+	}
+	if _, ok := uac.mutation.ExtraMinutes(); !ok {
+		return &ValidationError{Name: "extra_minutes", err: errors.New(`ent: missing required field "UserAccommodation.extra_minutes"`)}
+	}
+	if v, ok := uac.mutation.ExtraMinutes(); ok {
+		if err := useraccommodation.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.extra_minutes": %w`, err)}
+		}
+	}
+	if _, ok := uac.mutation.AllowRestBreaks(); !ok {
+		return &ValidationError{Name: "allow_rest_breaks", err: errors.New(`ent: missing required field "UserAccommodation.allow_rest_breaks"`)}
+	}
+	if v, ok := uac.mutation.MaxRestBreaks(); ok {
+		if err := useraccommodation.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := uac.mutation.MaxRestBreakMinutes(); ok {
+		if err := useraccommodation.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_break_minutes": %w`, err)}
+		}
+	}
+	if len(uac.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserAccommodation.user"`)}
+	}
+	return nil
+}
+
+func (uac *UserAccommodationCreate) sqlSave(ctx context.Context) (*UserAccommodation, error) {
+	if err := uac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	uac.mutation.id = &_node.ID
+	uac.mutation.done = true
+	return _node, nil
+}
+
+func (uac *UserAccommodationCreate) createSpec() (*UserAccommodation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserAccommodation{config: uac.config}
+		_spec = sqlgraph.NewCreateSpec(useraccommodation.Table, sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID))
+	)
+	if id, ok := uac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := uac.mutation.CreatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uac.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uac.mutation.DeletedAt(); ok {
+		_spec.SetField(useraccommodation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := uac.mutation.TimeMultiplier(); ok {
+		_spec.SetField(useraccommodation.FieldTimeMultiplier, field.TypeFloat64, value)
+		_node.TimeMultiplier = value
+	}
+	if value, ok := uac.mutation.ExtraMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldExtraMinutes, field.TypeInt, value)
+		_node.ExtraMinutes = value
+	}
+	if value, ok := uac.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldAllowRestBreaks, field.TypeBool, value)
+		_node.AllowRestBreaks = value
+	}
+	if value, ok := uac.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreaks, field.TypeInt, value)
+		_node.MaxRestBreaks = &value
+	}
+	if value, ok := uac.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt, value)
+		_node.MaxRestBreakMinutes = &value
+	}
+	if value, ok := uac.mutation.Notes(); ok {
+		_spec.SetField(useraccommodation.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if nodes := uac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   useraccommodation.UserTable,
+			Columns: []string{useraccommodation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserAccommodationCreateBulk is the builder for creating many UserAccommodation entities in bulk.
+type UserAccommodationCreateBulk struct {
+	config
+	err      error
+	builders []*UserAccommodationCreate
+}
+
+// Save creates the UserAccommodation entities in the database.
+func (uacb *UserAccommodationCreateBulk) Save(ctx context.Context) ([]*UserAccommodation, error) {
+	if uacb.err != nil {
+		return nil, uacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uacb.builders))
+	nodes := make([]*UserAccommodation, len(uacb.builders))
+	mutators := make([]Mutator, len(uacb.builders))
+	for i := range uacb.builders {
+		func(i int, root context.Context) {
+			builder := uacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserAccommodationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uacb *UserAccommodationCreateBulk) SaveX(ctx context.Context) []*UserAccommodation {
+	v, err := uacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uacb *UserAccommodationCreateBulk) Exec(ctx context.Context) error {
+	_, err := uacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uacb *UserAccommodationCreateBulk) ExecX(ctx context.Context) {
+	if err := uacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/useraccommodation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserAccommodationDelete is the builder for deleting a UserAccommodation entity.
+type UserAccommodationDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserAccommodationMutation
+}
+
+// Where appends a list predicates to the UserAccommodationDelete builder.
+func (uad *UserAccommodationDelete) Where(ps ...predicate.UserAccommodation) *UserAccommodationDelete {
+	uad.mutation.Where(ps...)
+	return uad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uad *UserAccommodationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uad.sqlExec, uad.mutation, uad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uad *UserAccommodationDelete) ExecX(ctx context.Context) int {
+	n, err := uad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uad *UserAccommodationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(useraccommodation.Table, sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID))
+	if ps := uad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uad.mutation.done = true
+	return affected, err
+}
+
+// UserAccommodationDeleteOne is the builder for deleting a single UserAccommodation entity.
+type UserAccommodationDeleteOne struct {
+	uad *UserAccommodationDelete
+}
+
+// Where appends a list predicates to the UserAccommodationDelete builder.
+func (uado *UserAccommodationDeleteOne) Where(ps ...predicate.UserAccommodation) *UserAccommodationDeleteOne {
+	uado.uad.mutation.Where(ps...)
+	return uado
+}
+
+// Exec executes the deletion query.
+func (uado *UserAccommodationDeleteOne) Exec(ctx context.Context) error {
+	n, err := uado.uad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{useraccommodation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uado *UserAccommodationDeleteOne) ExecX(ctx context.Context) {
+	if err := uado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserAccommodationQuery is the builder for querying UserAccommodation entities.
+type UserAccommodationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []useraccommodation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserAccommodation
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserAccommodationQuery builder.
+func (uaq *UserAccommodationQuery) Where(ps ...predicate.UserAccommodation) *UserAccommodationQuery {
+	uaq.predicates = append(uaq.predicates, ps...)
+	return uaq
+}
+
+// Limit the number of records to be returned by this query.
+func (uaq *UserAccommodationQuery) Limit(limit int) *UserAccommodationQuery {
+	uaq.ctx.Limit = &limit
+	return uaq
+}
+
+// Offset to start from.
+func (uaq *UserAccommodationQuery) Offset(offset int) *UserAccommodationQuery {
+	uaq.ctx.Offset = &offset
+	return uaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uaq *UserAccommodationQuery) Unique(unique bool) *UserAccommodationQuery {
+	uaq.ctx.Unique = &unique
+	return uaq
+}
+
+// Order specifies how the records should be ordered.
+func (uaq *UserAccommodationQuery) Order(o ...useraccommodation.OrderOption) *UserAccommodationQuery {
+	uaq.order = append(uaq.order, o...)
+	return uaq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (uaq *UserAccommodationQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: uaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraccommodation.Table, useraccommodation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, useraccommodation.UserTable, useraccommodation.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UserAccommodation entity from the query.
+// Returns a *NotFoundError when no UserAccommodation was found.
+func (uaq *UserAccommodationQuery) First(ctx context.Context) (*UserAccommodation, error) {
+	nodes, err := uaq.Limit(1).All(setContextOp(ctx, uaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{useraccommodation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) FirstX(ctx context.Context) *UserAccommodation {
+	node, err := uaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserAccommodation ID from the query.
+// Returns a *NotFoundError when no UserAccommodation ID was found.
+func (uaq *UserAccommodationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uaq.Limit(1).IDs(setContextOp(ctx, uaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{useraccommodation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := uaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserAccommodation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserAccommodation entity is found.
+// Returns a *NotFoundError when no UserAccommodation entities are found.
+func (uaq *UserAccommodationQuery) Only(ctx context.Context) (*UserAccommodation, error) {
+	nodes, err := uaq.Limit(2).All(setContextOp(ctx, uaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{useraccommodation.Label}
+	default:
+		return nil, &NotSingularError{useraccommodation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) OnlyX(ctx context.Context) *UserAccommodation {
+	node, err := uaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserAccommodation ID in the query.
+// Returns a *NotSingularError when more than one UserAccommodation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uaq *UserAccommodationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uaq.Limit(2).IDs(setContextOp(ctx, uaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{useraccommodation.Label}
+	default:
+		err = &NotSingularError{useraccommodation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := uaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserAccommodations.
+func (uaq *UserAccommodationQuery) All(ctx context.Context) ([]*UserAccommodation, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryAll)
+	if err := uaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserAccommodation, *UserAccommodationQuery]()
+	return withInterceptors[[]*UserAccommodation](ctx, uaq, qr, uaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) AllX(ctx context.Context) []*UserAccommodation {
+	nodes, err := uaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserAccommodation IDs.
+func (uaq *UserAccommodationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if uaq.ctx.Unique == nil && uaq.path != nil {
+		uaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryIDs)
+	if err = uaq.Select(useraccommodation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := uaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uaq *UserAccommodationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryCount)
+	if err := uaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, uaq, querierCount[*UserAccommodationQuery](), uaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) CountX(ctx context.Context) int {
+	count, err := uaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uaq *UserAccommodationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryExist)
+	switch _, err := uaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uaq *UserAccommodationQuery) ExistX(ctx context.Context) bool {
+	exist, err := uaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserAccommodationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uaq *UserAccommodationQuery) Clone() *UserAccommodationQuery {
+	if uaq == nil {
+		return nil
+	}
+	return &UserAccommodationQuery{
+		config:     uaq.config,
+		ctx:        uaq.ctx.Clone(),
+		order:      append([]useraccommodation.OrderOption{}, uaq.order...),
+		inters:     append([]Interceptor{}, uaq.inters...),
+		predicates: append([]predicate.UserAccommodation{}, uaq.predicates...),
+		withUser:   uaq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  uaq.sql.Clone(),
+		path: uaq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (uaq *UserAccommodationQuery) WithUser(opts ...func(*UserQuery)) *UserAccommodationQuery {
+	query := (&UserClient{config: uaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uaq.withUser = query
+	return uaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserAccommodation.Query().
+//		GroupBy(useraccommodation.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uaq *UserAccommodationQuery) GroupBy(field string, fields ...string) *UserAccommodationGroupBy {
+	uaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserAccommodationGroupBy{build: uaq}
+	grbuild.flds = &uaq.ctx.Fields
+	grbuild.label = useraccommodation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.UserAccommodation.Query().
+//		Select(useraccommodation.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (uaq *UserAccommodationQuery) Select(fields ...string) *UserAccommodationSelect {
+	uaq.ctx.Fields = append(uaq.ctx.Fields, fields...)
+	sbuild := &UserAccommodationSelect{UserAccommodationQuery: uaq}
+	sbuild.label = useraccommodation.Label
+	sbuild.flds, sbuild.scan = &uaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserAccommodationSelect configured with the given aggregations.
+func (uaq *UserAccommodationQuery) Aggregate(fns ...AggregateFunc) *UserAccommodationSelect {
+	return uaq.Select().Aggregate(fns...)
+}
+
+func (uaq *UserAccommodationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range uaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uaq.ctx.Fields {
+		if !useraccommodation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uaq.path != nil {
+		prev, err := uaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uaq.sql = prev
+	}
+	return nil
+}
+
+func (uaq *UserAccommodationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserAccommodation, error) {
+	var (
+		nodes       = []*UserAccommodation{}
+		_spec       = uaq.querySpec()
+		loadedTypes = [1]bool{
+			uaq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserAccommodation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserAccommodation{config: uaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(uaq.modifiers) > 0 {
+		_spec.Modifiers = uaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := uaq.withUser; query != nil {
+		if err := uaq.loadUser(ctx, query, nodes, nil,
+			func(n *UserAccommodation, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uaq *UserAccommodationQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserAccommodation, init func(*UserAccommodation), assign func(*UserAccommodation, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UserAccommodation)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (uaq *UserAccommodationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uaq.querySpec()
+	if len(uaq.modifiers) > 0 {
+		_spec.Modifiers = uaq.modifiers
+	}
+	_spec.Node.Columns = uaq.ctx.Fields
+	if len(uaq.ctx.Fields) > 0 {
+		_spec.Unique = uaq.ctx.Unique != nil && *uaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uaq.driver, _spec)
+}
+
+func (uaq *UserAccommodationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(useraccommodation.Table, useraccommodation.Columns, sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID))
+	_spec.From = uaq.sql
+	if unique := uaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if uaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := uaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useraccommodation.FieldID)
+		for i := range fields {
+			if fields[i] != useraccommodation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if uaq.withUser != nil {
+			_spec.Node.AddColumnOnce(useraccommodation.FieldUserID)
+		}
+	}
+	if ps := uaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uaq *UserAccommodationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uaq.driver.Dialect())
+	t1 := builder.Table(useraccommodation.Table)
+	columns := uaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = useraccommodation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uaq.sql != nil {
+		selector = uaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uaq.ctx.Unique != nil && *uaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range uaq.modifiers {
+		m(selector)
+	}
+	for _, p := range uaq.predicates {
+		p(selector)
+	}
+	for _, p := range uaq.order {
+		p(selector)
+	}
+	if offset := uaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uaq *UserAccommodationQuery) ForUpdate(opts ...sql.LockOption) *UserAccommodationQuery {
+	if uaq.driver.Dialect() == dialect.Postgres {
+		uaq.Unique(false)
+	}
+	uaq.modifiers = append(uaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uaq *UserAccommodationQuery) ForShare(opts ...sql.LockOption) *UserAccommodationQuery {
+	if uaq.driver.Dialect() == dialect.Postgres {
+		uaq.Unique(false)
+	}
+	uaq.modifiers = append(uaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uaq
+}
+
+// UserAccommodationGroupBy is the group-by builder for UserAccommodation entities.
+type UserAccommodationGroupBy struct {
+	selector
+	build *UserAccommodationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uagb *UserAccommodationGroupBy) Aggregate(fns ...AggregateFunc) *UserAccommodationGroupBy {
+	uagb.fns = append(uagb.fns, fns...)
+	return uagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uagb *UserAccommodationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uagb.build.ctx, ent.OpQueryGroupBy)
+	if err := uagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserAccommodationQuery, *UserAccommodationGroupBy](ctx, uagb.build, uagb, uagb.build.inters, v)
+}
+
+func (uagb *UserAccommodationGroupBy) sqlScan(ctx context.Context, root *UserAccommodationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uagb.fns))
+	for _, fn := range uagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uagb.flds)+len(uagb.fns))
+		for _, f := range *uagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserAccommodationSelect is the builder for selecting fields of UserAccommodation entities.
+type UserAccommodationSelect struct {
+	*UserAccommodationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uas *UserAccommodationSelect) Aggregate(fns ...AggregateFunc) *UserAccommodationSelect {
+	uas.fns = append(uas.fns, fns...)
+	return uas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uas *UserAccommodationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uas.ctx, ent.OpQuerySelect)
+	if err := uas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserAccommodationQuery, *UserAccommodationSelect](ctx, uas.UserAccommodationQuery, uas, uas.inters, v)
+}
+
+func (uas *UserAccommodationSelect) sqlScan(ctx context.Context, root *UserAccommodationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uas.fns))
+	for _, fn := range uas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
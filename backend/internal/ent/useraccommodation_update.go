@@ -0,0 +1,820 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"template/internal/ent/useraccommodation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserAccommodationUpdate is the builder for updating UserAccommodation entities.
+type UserAccommodationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserAccommodationMutation
+}
+
+// Where appends a list predicates to the UserAccommodationUpdate builder.
+func (uau *UserAccommodationUpdate) Where(ps ...predicate.UserAccommodation) *UserAccommodationUpdate {
+	uau.mutation.Where(ps...)
+	return uau
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uau *UserAccommodationUpdate) SetCreatedAt(t time.Time) *UserAccommodationUpdate {
+	uau.mutation.SetCreatedAt(t)
+	return uau
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableCreatedAt(t *time.Time) *UserAccommodationUpdate {
+	if t != nil {
+		uau.SetCreatedAt(*t)
+	}
+	return uau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uau *UserAccommodationUpdate) SetUpdatedAt(t time.Time) *UserAccommodationUpdate {
+	uau.mutation.SetUpdatedAt(t)
+	return uau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uau *UserAccommodationUpdate) SetDeletedAt(t time.Time) *UserAccommodationUpdate {
+	uau.mutation.SetDeletedAt(t)
+	return uau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableDeletedAt(t *time.Time) *UserAccommodationUpdate {
+	if t != nil {
+		uau.SetDeletedAt(*t)
+	}
+	return uau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uau *UserAccommodationUpdate) ClearDeletedAt() *UserAccommodationUpdate {
+	uau.mutation.ClearDeletedAt()
+	return uau
+}
+
+// SetUserID sets the "user_id" field.
+func (uau *UserAccommodationUpdate) SetUserID(u uuid.UUID) *UserAccommodationUpdate {
+	uau.mutation.SetUserID(u)
+	return uau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableUserID(u *uuid.UUID) *UserAccommodationUpdate {
+	if u != nil {
+		uau.SetUserID(*u)
+	}
+	return uau
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (uau *UserAccommodationUpdate) SetTimeMultiplier(f float64) *UserAccommodationUpdate {
+	uau.mutation.ResetTimeMultiplier()
+	uau.mutation.SetTimeMultiplier(f)
+	return uau
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableTimeMultiplier(f *float64) *UserAccommodationUpdate {
+	if f != nil {
+		uau.SetTimeMultiplier(*f)
+	}
+	return uau
+}
+
+// AddTimeMultiplier adds f to the "time_multiplier" field.
+func (uau *UserAccommodationUpdate) AddTimeMultiplier(f float64) *UserAccommodationUpdate {
+	uau.mutation.AddTimeMultiplier(f)
+	return uau
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (uau *UserAccommodationUpdate) SetExtraMinutes(i int) *UserAccommodationUpdate {
+	uau.mutation.ResetExtraMinutes()
+	uau.mutation.SetExtraMinutes(i)
+	return uau
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableExtraMinutes(i *int) *UserAccommodationUpdate {
+	if i != nil {
+		uau.SetExtraMinutes(*i)
+	}
+	return uau
+}
+
+// AddExtraMinutes adds i to the "extra_minutes" field.
+func (uau *UserAccommodationUpdate) AddExtraMinutes(i int) *UserAccommodationUpdate {
+	uau.mutation.AddExtraMinutes(i)
+	return uau
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (uau *UserAccommodationUpdate) SetAllowRestBreaks(b bool) *UserAccommodationUpdate {
+	uau.mutation.SetAllowRestBreaks(b)
+	return uau
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableAllowRestBreaks(b *bool) *UserAccommodationUpdate {
+	if b != nil {
+		uau.SetAllowRestBreaks(*b)
+	}
+	return uau
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (uau *UserAccommodationUpdate) SetMaxRestBreaks(i int) *UserAccommodationUpdate {
+	uau.mutation.ResetMaxRestBreaks()
+	uau.mutation.SetMaxRestBreaks(i)
+	return uau
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableMaxRestBreaks(i *int) *UserAccommodationUpdate {
+	if i != nil {
+		uau.SetMaxRestBreaks(*i)
+	}
+	return uau
+}
+
+// AddMaxRestBreaks adds i to the "max_rest_breaks" field.
+func (uau *UserAccommodationUpdate) AddMaxRestBreaks(i int) *UserAccommodationUpdate {
+	uau.mutation.AddMaxRestBreaks(i)
+	return uau
+}
+
+// ClearMaxRestBreaks clears the value of the "max_rest_breaks" field.
+func (uau *UserAccommodationUpdate) ClearMaxRestBreaks() *UserAccommodationUpdate {
+	uau.mutation.ClearMaxRestBreaks()
+	return uau
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (uau *UserAccommodationUpdate) SetMaxRestBreakMinutes(i int) *UserAccommodationUpdate {
+	uau.mutation.ResetMaxRestBreakMinutes()
+	uau.mutation.SetMaxRestBreakMinutes(i)
+	return uau
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableMaxRestBreakMinutes(i *int) *UserAccommodationUpdate {
+	if i != nil {
+		uau.SetMaxRestBreakMinutes(*i)
+	}
+	return uau
+}
+
+// AddMaxRestBreakMinutes adds i to the "max_rest_break_minutes" field.
+func (uau *UserAccommodationUpdate) AddMaxRestBreakMinutes(i int) *UserAccommodationUpdate {
+	uau.mutation.AddMaxRestBreakMinutes(i)
+	return uau
+}
+
+// ClearMaxRestBreakMinutes clears the value of the "max_rest_break_minutes" field.
+func (uau *UserAccommodationUpdate) ClearMaxRestBreakMinutes() *UserAccommodationUpdate {
+	uau.mutation.ClearMaxRestBreakMinutes()
+	return uau
+}
+
+// SetNotes sets the "notes" field.
+func (uau *UserAccommodationUpdate) SetNotes(s string) *UserAccommodationUpdate {
+	uau.mutation.SetNotes(s)
+	return uau
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (uau *UserAccommodationUpdate) SetNillableNotes(s *string) *UserAccommodationUpdate {
+	if s != nil {
+		uau.SetNotes(*s)
+	}
+	return uau
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (uau *UserAccommodationUpdate) ClearNotes() *UserAccommodationUpdate {
+	uau.mutation.ClearNotes()
+	return uau
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uau *UserAccommodationUpdate) SetUser(u *User) *UserAccommodationUpdate {
+	return uau.SetUserID(u.ID)
+}
+
+// Mutation returns the UserAccommodationMutation object of the builder.
+func (uau *UserAccommodationUpdate) Mutation() *UserAccommodationMutation {
+	return uau.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uau *UserAccommodationUpdate) ClearUser() *UserAccommodationUpdate {
+	uau.mutation.ClearUser()
+	return uau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uau *UserAccommodationUpdate) Save(ctx context.Context) (int, error) {
+	if err := uau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, uau.sqlSave, uau.mutation, uau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uau *UserAccommodationUpdate) SaveX(ctx context.Context) int {
+	affected, err := uau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uau *UserAccommodationUpdate) Exec(ctx context.Context) error {
+	_, err := uau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uau *UserAccommodationUpdate) ExecX(ctx context.Context) {
+	if err := uau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uau *UserAccommodationUpdate) defaults() error {
+	if _, ok := uau.mutation.UpdatedAt(); !ok {
+		if useraccommodation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useraccommodation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useraccommodation.UpdateDefaultUpdatedAt()
+		uau.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uau *UserAccommodationUpdate) check() error {
+	if v, ok := uau.mutation.TimeMultiplier(); ok {
+		if err := useraccommodation.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.time_multiplier": %w`, err)}
+		}
+	}
+	if v, ok := uau.mutation.ExtraMinutes(); ok {
+		if err := useraccommodation.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.extra_minutes": %w`, err)}
+		}
+	}
+	if v, ok := uau.mutation.MaxRestBreaks(); ok {
+		if err := useraccommodation.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := uau.mutation.MaxRestBreakMinutes(); ok {
+		if err := useraccommodation.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_break_minutes": %w`, err)}
+		}
+	}
+	if uau.mutation.UserCleared() && len(uau.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserAccommodation.user"`)
+	}
+	return nil
+}
+
+func (uau *UserAccommodationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useraccommodation.Table, useraccommodation.Columns, sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID))
+	if ps := uau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uau.mutation.CreatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uau.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uau.mutation.DeletedAt(); ok {
+		_spec.SetField(useraccommodation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uau.mutation.DeletedAtCleared() {
+		_spec.ClearField(useraccommodation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uau.mutation.TimeMultiplier(); ok {
+		_spec.SetField(useraccommodation.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := uau.mutation.AddedTimeMultiplier(); ok {
+		_spec.AddField(useraccommodation.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := uau.mutation.ExtraMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := uau.mutation.AddedExtraMinutes(); ok {
+		_spec.AddField(useraccommodation.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := uau.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldAllowRestBreaks, field.TypeBool, value)
+	}
+	if value, ok := uau.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if value, ok := uau.mutation.AddedMaxRestBreaks(); ok {
+		_spec.AddField(useraccommodation.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if uau.mutation.MaxRestBreaksCleared() {
+		_spec.ClearField(useraccommodation.FieldMaxRestBreaks, field.TypeInt)
+	}
+	if value, ok := uau.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := uau.mutation.AddedMaxRestBreakMinutes(); ok {
+		_spec.AddField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if uau.mutation.MaxRestBreakMinutesCleared() {
+		_spec.ClearField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt)
+	}
+	if value, ok := uau.mutation.Notes(); ok {
+		_spec.SetField(useraccommodation.FieldNotes, field.TypeString, value)
+	}
+	if uau.mutation.NotesCleared() {
+		_spec.ClearField(useraccommodation.FieldNotes, field.TypeString)
+	}
+	if uau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   useraccommodation.UserTable,
+			Columns: []string{useraccommodation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   useraccommodation.UserTable,
+			Columns: []string{useraccommodation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useraccommodation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uau.mutation.done = true
+	return n, nil
+}
+
+// UserAccommodationUpdateOne is the builder for updating a single UserAccommodation entity.
+type UserAccommodationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserAccommodationMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uauo *UserAccommodationUpdateOne) SetCreatedAt(t time.Time) *UserAccommodationUpdateOne {
+	uauo.mutation.SetCreatedAt(t)
+	return uauo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableCreatedAt(t *time.Time) *UserAccommodationUpdateOne {
+	if t != nil {
+		uauo.SetCreatedAt(*t)
+	}
+	return uauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uauo *UserAccommodationUpdateOne) SetUpdatedAt(t time.Time) *UserAccommodationUpdateOne {
+	uauo.mutation.SetUpdatedAt(t)
+	return uauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uauo *UserAccommodationUpdateOne) SetDeletedAt(t time.Time) *UserAccommodationUpdateOne {
+	uauo.mutation.SetDeletedAt(t)
+	return uauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableDeletedAt(t *time.Time) *UserAccommodationUpdateOne {
+	if t != nil {
+		uauo.SetDeletedAt(*t)
+	}
+	return uauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uauo *UserAccommodationUpdateOne) ClearDeletedAt() *UserAccommodationUpdateOne {
+	uauo.mutation.ClearDeletedAt()
+	return uauo
+}
+
+// SetUserID sets the "user_id" field.
+func (uauo *UserAccommodationUpdateOne) SetUserID(u uuid.UUID) *UserAccommodationUpdateOne {
+	uauo.mutation.SetUserID(u)
+	return uauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableUserID(u *uuid.UUID) *UserAccommodationUpdateOne {
+	if u != nil {
+		uauo.SetUserID(*u)
+	}
+	return uauo
+}
+
+// SetTimeMultiplier sets the "time_multiplier" field.
+func (uauo *UserAccommodationUpdateOne) SetTimeMultiplier(f float64) *UserAccommodationUpdateOne {
+	uauo.mutation.ResetTimeMultiplier()
+	uauo.mutation.SetTimeMultiplier(f)
+	return uauo
+}
+
+// SetNillableTimeMultiplier sets the "time_multiplier" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableTimeMultiplier(f *float64) *UserAccommodationUpdateOne {
+	if f != nil {
+		uauo.SetTimeMultiplier(*f)
+	}
+	return uauo
+}
+
+// AddTimeMultiplier adds f to the "time_multiplier" field.
+func (uauo *UserAccommodationUpdateOne) AddTimeMultiplier(f float64) *UserAccommodationUpdateOne {
+	uauo.mutation.AddTimeMultiplier(f)
+	return uauo
+}
+
+// SetExtraMinutes sets the "extra_minutes" field.
+func (uauo *UserAccommodationUpdateOne) SetExtraMinutes(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.ResetExtraMinutes()
+	uauo.mutation.SetExtraMinutes(i)
+	return uauo
+}
+
+// SetNillableExtraMinutes sets the "extra_minutes" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableExtraMinutes(i *int) *UserAccommodationUpdateOne {
+	if i != nil {
+		uauo.SetExtraMinutes(*i)
+	}
+	return uauo
+}
+
+// AddExtraMinutes adds i to the "extra_minutes" field.
+func (uauo *UserAccommodationUpdateOne) AddExtraMinutes(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.AddExtraMinutes(i)
+	return uauo
+}
+
+// SetAllowRestBreaks sets the "allow_rest_breaks" field.
+func (uauo *UserAccommodationUpdateOne) SetAllowRestBreaks(b bool) *UserAccommodationUpdateOne {
+	uauo.mutation.SetAllowRestBreaks(b)
+	return uauo
+}
+
+// SetNillableAllowRestBreaks sets the "allow_rest_breaks" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableAllowRestBreaks(b *bool) *UserAccommodationUpdateOne {
+	if b != nil {
+		uauo.SetAllowRestBreaks(*b)
+	}
+	return uauo
+}
+
+// SetMaxRestBreaks sets the "max_rest_breaks" field.
+func (uauo *UserAccommodationUpdateOne) SetMaxRestBreaks(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.ResetMaxRestBreaks()
+	uauo.mutation.SetMaxRestBreaks(i)
+	return uauo
+}
+
+// SetNillableMaxRestBreaks sets the "max_rest_breaks" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableMaxRestBreaks(i *int) *UserAccommodationUpdateOne {
+	if i != nil {
+		uauo.SetMaxRestBreaks(*i)
+	}
+	return uauo
+}
+
+// AddMaxRestBreaks adds i to the "max_rest_breaks" field.
+func (uauo *UserAccommodationUpdateOne) AddMaxRestBreaks(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.AddMaxRestBreaks(i)
+	return uauo
+}
+
+// ClearMaxRestBreaks clears the value of the "max_rest_breaks" field.
+func (uauo *UserAccommodationUpdateOne) ClearMaxRestBreaks() *UserAccommodationUpdateOne {
+	uauo.mutation.ClearMaxRestBreaks()
+	return uauo
+}
+
+// SetMaxRestBreakMinutes sets the "max_rest_break_minutes" field.
+func (uauo *UserAccommodationUpdateOne) SetMaxRestBreakMinutes(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.ResetMaxRestBreakMinutes()
+	uauo.mutation.SetMaxRestBreakMinutes(i)
+	return uauo
+}
+
+// SetNillableMaxRestBreakMinutes sets the "max_rest_break_minutes" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableMaxRestBreakMinutes(i *int) *UserAccommodationUpdateOne {
+	if i != nil {
+		uauo.SetMaxRestBreakMinutes(*i)
+	}
+	return uauo
+}
+
+// AddMaxRestBreakMinutes adds i to the "max_rest_break_minutes" field.
+func (uauo *UserAccommodationUpdateOne) AddMaxRestBreakMinutes(i int) *UserAccommodationUpdateOne {
+	uauo.mutation.AddMaxRestBreakMinutes(i)
+	return uauo
+}
+
+// ClearMaxRestBreakMinutes clears the value of the "max_rest_break_minutes" field.
+func (uauo *UserAccommodationUpdateOne) ClearMaxRestBreakMinutes() *UserAccommodationUpdateOne {
+	uauo.mutation.ClearMaxRestBreakMinutes()
+	return uauo
+}
+
+// SetNotes sets the "notes" field.
+func (uauo *UserAccommodationUpdateOne) SetNotes(s string) *UserAccommodationUpdateOne {
+	uauo.mutation.SetNotes(s)
+	return uauo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (uauo *UserAccommodationUpdateOne) SetNillableNotes(s *string) *UserAccommodationUpdateOne {
+	if s != nil {
+		uauo.SetNotes(*s)
+	}
+	return uauo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (uauo *UserAccommodationUpdateOne) ClearNotes() *UserAccommodationUpdateOne {
+	uauo.mutation.ClearNotes()
+	return uauo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uauo *UserAccommodationUpdateOne) SetUser(u *User) *UserAccommodationUpdateOne {
+	return uauo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserAccommodationMutation object of the builder.
+func (uauo *UserAccommodationUpdateOne) Mutation() *UserAccommodationMutation {
+	return uauo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uauo *UserAccommodationUpdateOne) ClearUser() *UserAccommodationUpdateOne {
+	uauo.mutation.ClearUser()
+	return uauo
+}
+
+// Where appends a list predicates to the UserAccommodationUpdate builder.
+func (uauo *UserAccommodationUpdateOne) Where(ps ...predicate.UserAccommodation) *UserAccommodationUpdateOne {
+	uauo.mutation.Where(ps...)
+	return uauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uauo *UserAccommodationUpdateOne) Select(field string, fields ...string) *UserAccommodationUpdateOne {
+	uauo.fields = append([]string{field}, fields...)
+	return uauo
+}
+
+// Save executes the query and returns the updated UserAccommodation entity.
+func (uauo *UserAccommodationUpdateOne) Save(ctx context.Context) (*UserAccommodation, error) {
+	if err := uauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uauo.sqlSave, uauo.mutation, uauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uauo *UserAccommodationUpdateOne) SaveX(ctx context.Context) *UserAccommodation {
+	node, err := uauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uauo *UserAccommodationUpdateOne) Exec(ctx context.Context) error {
+	_, err := uauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uauo *UserAccommodationUpdateOne) ExecX(ctx context.Context) {
+	if err := uauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uauo *UserAccommodationUpdateOne) defaults() error {
+	if _, ok := uauo.mutation.UpdatedAt(); !ok {
+		if useraccommodation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useraccommodation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useraccommodation.UpdateDefaultUpdatedAt()
+		uauo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uauo *UserAccommodationUpdateOne) check() error {
+	if v, ok := uauo.mutation.TimeMultiplier(); ok {
+		if err := useraccommodation.TimeMultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "time_multiplier", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.time_multiplier": %w`, err)}
+		}
+	}
+	if v, ok := uauo.mutation.ExtraMinutes(); ok {
+		if err := useraccommodation.ExtraMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "extra_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.extra_minutes": %w`, err)}
+		}
+	}
+	if v, ok := uauo.mutation.MaxRestBreaks(); ok {
+		if err := useraccommodation.MaxRestBreaksValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_breaks", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_breaks": %w`, err)}
+		}
+	}
+	if v, ok := uauo.mutation.MaxRestBreakMinutes(); ok {
+		if err := useraccommodation.MaxRestBreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_rest_break_minutes", err: fmt.Errorf(`ent: validator failed for field "UserAccommodation.max_rest_break_minutes": %w`, err)}
+		}
+	}
+	if uauo.mutation.UserCleared() && len(uauo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserAccommodation.user"`)
+	}
+	return nil
+}
+
+func (uauo *UserAccommodationUpdateOne) sqlSave(ctx context.Context) (_node *UserAccommodation, err error) {
+	if err := uauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useraccommodation.Table, useraccommodation.Columns, sqlgraph.NewFieldSpec(useraccommodation.FieldID, field.TypeUUID))
+	id, ok := uauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserAccommodation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useraccommodation.FieldID)
+		for _, f := range fields {
+			if !useraccommodation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != useraccommodation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uauo.mutation.CreatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraccommodation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uauo.mutation.DeletedAt(); ok {
+		_spec.SetField(useraccommodation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(useraccommodation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uauo.mutation.TimeMultiplier(); ok {
+		_spec.SetField(useraccommodation.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := uauo.mutation.AddedTimeMultiplier(); ok {
+		_spec.AddField(useraccommodation.FieldTimeMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := uauo.mutation.ExtraMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := uauo.mutation.AddedExtraMinutes(); ok {
+		_spec.AddField(useraccommodation.FieldExtraMinutes, field.TypeInt, value)
+	}
+	if value, ok := uauo.mutation.AllowRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldAllowRestBreaks, field.TypeBool, value)
+	}
+	if value, ok := uauo.mutation.MaxRestBreaks(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if value, ok := uauo.mutation.AddedMaxRestBreaks(); ok {
+		_spec.AddField(useraccommodation.FieldMaxRestBreaks, field.TypeInt, value)
+	}
+	if uauo.mutation.MaxRestBreaksCleared() {
+		_spec.ClearField(useraccommodation.FieldMaxRestBreaks, field.TypeInt)
+	}
+	if value, ok := uauo.mutation.MaxRestBreakMinutes(); ok {
+		_spec.SetField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := uauo.mutation.AddedMaxRestBreakMinutes(); ok {
+		_spec.AddField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt, value)
+	}
+	if uauo.mutation.MaxRestBreakMinutesCleared() {
+		_spec.ClearField(useraccommodation.FieldMaxRestBreakMinutes, field.TypeInt)
+	}
+	if value, ok := uauo.mutation.Notes(); ok {
+		_spec.SetField(useraccommodation.FieldNotes, field.TypeString, value)
+	}
+	if uauo.mutation.NotesCleared() {
+		_spec.ClearField(useraccommodation.FieldNotes, field.TypeString)
+	}
+	if uauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   useraccommodation.UserTable,
+			Columns: []string{useraccommodation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   useraccommodation.UserTable,
+			Columns: []string{useraccommodation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserAccommodation{config: uauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useraccommodation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"template/internal/ent/user"
+	"template/internal/ent/userinvite"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// UserInvite is the model entity for the UserInvite schema.
+type UserInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// SHA-256 hash of the invite token
+	TokenHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserInviteQuery when eager-loading is set.
+	Edges        UserInviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserInviteEdges holds the relations/edges for other nodes in the graph.
+type UserInviteEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserInviteEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userinvite.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case userinvite.FieldCreatedAt, userinvite.FieldUpdatedAt, userinvite.FieldDeletedAt, userinvite.FieldExpiresAt, userinvite.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		case userinvite.FieldID, userinvite.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserInvite fields.
+func (ui *UserInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userinvite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ui.ID = *value
+			}
+		case userinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ui.CreatedAt = value.Time
+			}
+		case userinvite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ui.UpdatedAt = value.Time
+			}
+		case userinvite.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ui.DeletedAt = new(time.Time)
+				*ui.DeletedAt = value.Time
+			}
+		case userinvite.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ui.UserID = *value
+			}
+		case userinvite.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				ui.TokenHash = value.String
+			}
+		case userinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ui.ExpiresAt = value.Time
+			}
+		case userinvite.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				ui.AcceptedAt = new(time.Time)
+				*ui.AcceptedAt = value.Time
+			}
+		default:
+			ui.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserInvite.
+// This includes values selected through modifiers, order, etc.
+func (ui *UserInvite) Value(name string) (ent.Value, error) {
+	return ui.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserInvite entity.
+func (ui *UserInvite) QueryUser() *UserQuery {
+	return NewUserInviteClient(ui.config).QueryUser(ui)
+}
+
+// Update returns a builder for updating this UserInvite.
+// Note that you need to call UserInvite.Unwrap() before calling this method if this UserInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ui *UserInvite) Update() *UserInviteUpdateOne {
+	return NewUserInviteClient(ui.config).UpdateOne(ui)
+}
+
+// Unwrap unwraps the UserInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ui *UserInvite) Unwrap() *UserInvite {
+	_tx, ok := ui.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserInvite is not a transactional entity")
+	}
+	ui.config.driver = _tx.drv
+	return ui
+}
+
+// String implements the fmt.Stringer.
+func (ui *UserInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ui.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ui.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ui.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ui.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ui.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ui.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ui.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserInvites is a parsable slice of UserInvite.
+type UserInvites []*UserInvite
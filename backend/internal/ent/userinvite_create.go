@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/user"
+	"template/internal/ent/userinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserInviteCreate is the builder for creating a UserInvite entity.
+type UserInviteCreate struct {
+	config
+	mutation *UserInviteMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uic *UserInviteCreate) SetCreatedAt(t time.Time) *UserInviteCreate {
+	uic.mutation.SetCreatedAt(t)
+	return uic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uic *UserInviteCreate) SetNillableCreatedAt(t *time.Time) *UserInviteCreate {
+	if t != nil {
+		uic.SetCreatedAt(*t)
+	}
+	return uic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uic *UserInviteCreate) SetUpdatedAt(t time.Time) *UserInviteCreate {
+	uic.mutation.SetUpdatedAt(t)
+	return uic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uic *UserInviteCreate) SetNillableUpdatedAt(t *time.Time) *UserInviteCreate {
+	if t != nil {
+		uic.SetUpdatedAt(*t)
+	}
+	return uic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uic *UserInviteCreate) SetDeletedAt(t time.Time) *UserInviteCreate {
+	uic.mutation.SetDeletedAt(t)
+	return uic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uic *UserInviteCreate) SetNillableDeletedAt(t *time.Time) *UserInviteCreate {
+	if t != nil {
+		uic.SetDeletedAt(*t)
+	}
+	return uic
+}
+
+// SetUserID sets the "user_id" field.
+func (uic *UserInviteCreate) SetUserID(u uuid.UUID) *UserInviteCreate {
+	uic.mutation.SetUserID(u)
+	return uic
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (uic *UserInviteCreate) SetTokenHash(s string) *UserInviteCreate {
+	uic.mutation.SetTokenHash(s)
+	return uic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uic *UserInviteCreate) SetExpiresAt(t time.Time) *UserInviteCreate {
+	uic.mutation.SetExpiresAt(t)
+	return uic
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (uic *UserInviteCreate) SetAcceptedAt(t time.Time) *UserInviteCreate {
+	uic.mutation.SetAcceptedAt(t)
+	return uic
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (uic *UserInviteCreate) SetNillableAcceptedAt(t *time.Time) *UserInviteCreate {
+	if t != nil {
+		uic.SetAcceptedAt(*t)
+	}
+	return uic
+}
+
+// SetID sets the "id" field.
+func (uic *UserInviteCreate) SetID(u uuid.UUID) *UserInviteCreate {
+	uic.mutation.SetID(u)
+	return uic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (uic *UserInviteCreate) SetNillableID(u *uuid.UUID) *UserInviteCreate {
+	if u != nil {
+		uic.SetID(*u)
+	}
+	return uic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uic *UserInviteCreate) SetUser(u *User) *UserInviteCreate {
+	return uic.SetUserID(u.ID)
+}
+
+// Mutation returns the UserInviteMutation object of the builder.
+func (uic *UserInviteCreate) Mutation() *UserInviteMutation {
+	return uic.mutation
+}
+
+// Save creates the UserInvite in the database.
+func (uic *UserInviteCreate) Save(ctx context.Context) (*UserInvite, error) {
+	if err := uic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uic.sqlSave, uic.mutation, uic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uic *UserInviteCreate) SaveX(ctx context.Context) *UserInvite {
+	v, err := uic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uic *UserInviteCreate) Exec(ctx context.Context) error {
+	_, err := uic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uic *UserInviteCreate) ExecX(ctx context.Context) {
+	if err := uic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uic *UserInviteCreate) defaults() error {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		if userinvite.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userinvite.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := userinvite.DefaultCreatedAt()
+		uic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		if userinvite.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userinvite.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := userinvite.DefaultUpdatedAt()
+		uic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uic.mutation.ID(); !ok {
+		if userinvite.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized userinvite.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := userinvite.DefaultID()
+		uic.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uic *UserInviteCreate) check() error {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserInvite.created_at"`)}
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserInvite.updated_at"`)}
+	}
+	if _, ok := uic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserInvite.user_id"`)}
+	}
+	if _, ok := uic.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "UserInvite.token_hash"`)}
+	}
+	if v, ok := uic.mutation.TokenHash(); ok {
+		if err := userinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "UserInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := uic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UserInvite.expires_at"`)}
+	}
+	if len(uic.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserInvite.user"`)}
+	}
+	return nil
+}
+
+func (uic *UserInviteCreate) sqlSave(ctx context.Context) (*UserInvite, error) {
+	if err := uic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	uic.mutation.id = &_node.ID
+	uic.mutation.done = true
+	return _node, nil
+}
+
+func (uic *UserInviteCreate) createSpec() (*UserInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserInvite{config: uic.config}
+		_spec = sqlgraph.NewCreateSpec(userinvite.Table, sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID))
+	)
+	if id, ok := uic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := uic.mutation.CreatedAt(); ok {
+		_spec.SetField(userinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uic.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uic.mutation.DeletedAt(); ok {
+		_spec.SetField(userinvite.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := uic.mutation.TokenHash(); ok {
+		_spec.SetField(userinvite.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := uic.mutation.ExpiresAt(); ok {
+		_spec.SetField(userinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := uic.mutation.AcceptedAt(); ok {
+		_spec.SetField(userinvite.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if nodes := uic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userinvite.UserTable,
+			Columns: []string{userinvite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserInviteCreateBulk is the builder for creating many UserInvite entities in bulk.
+type UserInviteCreateBulk struct {
+	config
+	err      error
+	builders []*UserInviteCreate
+}
+
+// Save creates the UserInvite entities in the database.
+func (uicb *UserInviteCreateBulk) Save(ctx context.Context) ([]*UserInvite, error) {
+	if uicb.err != nil {
+		return nil, uicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uicb.builders))
+	nodes := make([]*UserInvite, len(uicb.builders))
+	mutators := make([]Mutator, len(uicb.builders))
+	for i := range uicb.builders {
+		func(i int, root context.Context) {
+			builder := uicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uicb *UserInviteCreateBulk) SaveX(ctx context.Context) []*UserInvite {
+	v, err := uicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uicb *UserInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := uicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uicb *UserInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := uicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"template/internal/ent/predicate"
+	"template/internal/ent/userinvite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserInviteDelete is the builder for deleting a UserInvite entity.
+type UserInviteDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserInviteMutation
+}
+
+// Where appends a list predicates to the UserInviteDelete builder.
+func (uid *UserInviteDelete) Where(ps ...predicate.UserInvite) *UserInviteDelete {
+	uid.mutation.Where(ps...)
+	return uid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uid *UserInviteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uid.sqlExec, uid.mutation, uid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uid *UserInviteDelete) ExecX(ctx context.Context) int {
+	n, err := uid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uid *UserInviteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userinvite.Table, sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID))
+	if ps := uid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uid.mutation.done = true
+	return affected, err
+}
+
+// UserInviteDeleteOne is the builder for deleting a single UserInvite entity.
+type UserInviteDeleteOne struct {
+	uid *UserInviteDelete
+}
+
+// Where appends a list predicates to the UserInviteDelete builder.
+func (uido *UserInviteDeleteOne) Where(ps ...predicate.UserInvite) *UserInviteDeleteOne {
+	uido.uid.mutation.Where(ps...)
+	return uido
+}
+
+// Exec executes the deletion query.
+func (uido *UserInviteDeleteOne) Exec(ctx context.Context) error {
+	n, err := uido.uid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userinvite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uido *UserInviteDeleteOne) ExecX(ctx context.Context) {
+	if err := uido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"template/internal/ent/userinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserInviteUpdate is the builder for updating UserInvite entities.
+type UserInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserInviteMutation
+}
+
+// Where appends a list predicates to the UserInviteUpdate builder.
+func (uiu *UserInviteUpdate) Where(ps ...predicate.UserInvite) *UserInviteUpdate {
+	uiu.mutation.Where(ps...)
+	return uiu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uiu *UserInviteUpdate) SetCreatedAt(t time.Time) *UserInviteUpdate {
+	uiu.mutation.SetCreatedAt(t)
+	return uiu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableCreatedAt(t *time.Time) *UserInviteUpdate {
+	if t != nil {
+		uiu.SetCreatedAt(*t)
+	}
+	return uiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uiu *UserInviteUpdate) SetUpdatedAt(t time.Time) *UserInviteUpdate {
+	uiu.mutation.SetUpdatedAt(t)
+	return uiu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uiu *UserInviteUpdate) SetDeletedAt(t time.Time) *UserInviteUpdate {
+	uiu.mutation.SetDeletedAt(t)
+	return uiu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableDeletedAt(t *time.Time) *UserInviteUpdate {
+	if t != nil {
+		uiu.SetDeletedAt(*t)
+	}
+	return uiu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uiu *UserInviteUpdate) ClearDeletedAt() *UserInviteUpdate {
+	uiu.mutation.ClearDeletedAt()
+	return uiu
+}
+
+// SetUserID sets the "user_id" field.
+func (uiu *UserInviteUpdate) SetUserID(u uuid.UUID) *UserInviteUpdate {
+	uiu.mutation.SetUserID(u)
+	return uiu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableUserID(u *uuid.UUID) *UserInviteUpdate {
+	if u != nil {
+		uiu.SetUserID(*u)
+	}
+	return uiu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (uiu *UserInviteUpdate) SetTokenHash(s string) *UserInviteUpdate {
+	uiu.mutation.SetTokenHash(s)
+	return uiu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableTokenHash(s *string) *UserInviteUpdate {
+	if s != nil {
+		uiu.SetTokenHash(*s)
+	}
+	return uiu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uiu *UserInviteUpdate) SetExpiresAt(t time.Time) *UserInviteUpdate {
+	uiu.mutation.SetExpiresAt(t)
+	return uiu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableExpiresAt(t *time.Time) *UserInviteUpdate {
+	if t != nil {
+		uiu.SetExpiresAt(*t)
+	}
+	return uiu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (uiu *UserInviteUpdate) SetAcceptedAt(t time.Time) *UserInviteUpdate {
+	uiu.mutation.SetAcceptedAt(t)
+	return uiu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (uiu *UserInviteUpdate) SetNillableAcceptedAt(t *time.Time) *UserInviteUpdate {
+	if t != nil {
+		uiu.SetAcceptedAt(*t)
+	}
+	return uiu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (uiu *UserInviteUpdate) ClearAcceptedAt() *UserInviteUpdate {
+	uiu.mutation.ClearAcceptedAt()
+	return uiu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uiu *UserInviteUpdate) SetUser(u *User) *UserInviteUpdate {
+	return uiu.SetUserID(u.ID)
+}
+
+// Mutation returns the UserInviteMutation object of the builder.
+func (uiu *UserInviteUpdate) Mutation() *UserInviteMutation {
+	return uiu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uiu *UserInviteUpdate) ClearUser() *UserInviteUpdate {
+	uiu.mutation.ClearUser()
+	return uiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uiu *UserInviteUpdate) Save(ctx context.Context) (int, error) {
+	if err := uiu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, uiu.sqlSave, uiu.mutation, uiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiu *UserInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := uiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uiu *UserInviteUpdate) Exec(ctx context.Context) error {
+	_, err := uiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiu *UserInviteUpdate) ExecX(ctx context.Context) {
+	if err := uiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiu *UserInviteUpdate) defaults() error {
+	if _, ok := uiu.mutation.UpdatedAt(); !ok {
+		if userinvite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userinvite.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := userinvite.UpdateDefaultUpdatedAt()
+		uiu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uiu *UserInviteUpdate) check() error {
+	if v, ok := uiu.mutation.TokenHash(); ok {
+		if err := userinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "UserInvite.token_hash": %w`, err)}
+		}
+	}
+	if uiu.mutation.UserCleared() && len(uiu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserInvite.user"`)
+	}
+	return nil
+}
+
+func (uiu *UserInviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userinvite.Table, userinvite.Columns, sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID))
+	if ps := uiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiu.mutation.CreatedAt(); ok {
+		_spec.SetField(userinvite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiu.mutation.DeletedAt(); ok {
+		_spec.SetField(userinvite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uiu.mutation.DeletedAtCleared() {
+		_spec.ClearField(userinvite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uiu.mutation.TokenHash(); ok {
+		_spec.SetField(userinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := uiu.mutation.ExpiresAt(); ok {
+		_spec.SetField(userinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := uiu.mutation.AcceptedAt(); ok {
+		_spec.SetField(userinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if uiu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(userinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if uiu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userinvite.UserTable,
+			Columns: []string{userinvite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userinvite.UserTable,
+			Columns: []string{userinvite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uiu.mutation.done = true
+	return n, nil
+}
+
+// UserInviteUpdateOne is the builder for updating a single UserInvite entity.
+type UserInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserInviteMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uiuo *UserInviteUpdateOne) SetCreatedAt(t time.Time) *UserInviteUpdateOne {
+	uiuo.mutation.SetCreatedAt(t)
+	return uiuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableCreatedAt(t *time.Time) *UserInviteUpdateOne {
+	if t != nil {
+		uiuo.SetCreatedAt(*t)
+	}
+	return uiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uiuo *UserInviteUpdateOne) SetUpdatedAt(t time.Time) *UserInviteUpdateOne {
+	uiuo.mutation.SetUpdatedAt(t)
+	return uiuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uiuo *UserInviteUpdateOne) SetDeletedAt(t time.Time) *UserInviteUpdateOne {
+	uiuo.mutation.SetDeletedAt(t)
+	return uiuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableDeletedAt(t *time.Time) *UserInviteUpdateOne {
+	if t != nil {
+		uiuo.SetDeletedAt(*t)
+	}
+	return uiuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uiuo *UserInviteUpdateOne) ClearDeletedAt() *UserInviteUpdateOne {
+	uiuo.mutation.ClearDeletedAt()
+	return uiuo
+}
+
+// SetUserID sets the "user_id" field.
+func (uiuo *UserInviteUpdateOne) SetUserID(u uuid.UUID) *UserInviteUpdateOne {
+	uiuo.mutation.SetUserID(u)
+	return uiuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableUserID(u *uuid.UUID) *UserInviteUpdateOne {
+	if u != nil {
+		uiuo.SetUserID(*u)
+	}
+	return uiuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (uiuo *UserInviteUpdateOne) SetTokenHash(s string) *UserInviteUpdateOne {
+	uiuo.mutation.SetTokenHash(s)
+	return uiuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableTokenHash(s *string) *UserInviteUpdateOne {
+	if s != nil {
+		uiuo.SetTokenHash(*s)
+	}
+	return uiuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uiuo *UserInviteUpdateOne) SetExpiresAt(t time.Time) *UserInviteUpdateOne {
+	uiuo.mutation.SetExpiresAt(t)
+	return uiuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableExpiresAt(t *time.Time) *UserInviteUpdateOne {
+	if t != nil {
+		uiuo.SetExpiresAt(*t)
+	}
+	return uiuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (uiuo *UserInviteUpdateOne) SetAcceptedAt(t time.Time) *UserInviteUpdateOne {
+	uiuo.mutation.SetAcceptedAt(t)
+	return uiuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (uiuo *UserInviteUpdateOne) SetNillableAcceptedAt(t *time.Time) *UserInviteUpdateOne {
+	if t != nil {
+		uiuo.SetAcceptedAt(*t)
+	}
+	return uiuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (uiuo *UserInviteUpdateOne) ClearAcceptedAt() *UserInviteUpdateOne {
+	uiuo.mutation.ClearAcceptedAt()
+	return uiuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uiuo *UserInviteUpdateOne) SetUser(u *User) *UserInviteUpdateOne {
+	return uiuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserInviteMutation object of the builder.
+func (uiuo *UserInviteUpdateOne) Mutation() *UserInviteMutation {
+	return uiuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uiuo *UserInviteUpdateOne) ClearUser() *UserInviteUpdateOne {
+	uiuo.mutation.ClearUser()
+	return uiuo
+}
+
+// Where appends a list predicates to the UserInviteUpdate builder.
+func (uiuo *UserInviteUpdateOne) Where(ps ...predicate.UserInvite) *UserInviteUpdateOne {
+	uiuo.mutation.Where(ps...)
+	return uiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uiuo *UserInviteUpdateOne) Select(field string, fields ...string) *UserInviteUpdateOne {
+	uiuo.fields = append([]string{field}, fields...)
+	return uiuo
+}
+
+// Save executes the query and returns the updated UserInvite entity.
+func (uiuo *UserInviteUpdateOne) Save(ctx context.Context) (*UserInvite, error) {
+	if err := uiuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uiuo.sqlSave, uiuo.mutation, uiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiuo *UserInviteUpdateOne) SaveX(ctx context.Context) *UserInvite {
+	node, err := uiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uiuo *UserInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := uiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiuo *UserInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := uiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiuo *UserInviteUpdateOne) defaults() error {
+	if _, ok := uiuo.mutation.UpdatedAt(); !ok {
+		if userinvite.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userinvite.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := userinvite.UpdateDefaultUpdatedAt()
+		uiuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uiuo *UserInviteUpdateOne) check() error {
+	if v, ok := uiuo.mutation.TokenHash(); ok {
+		if err := userinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "UserInvite.token_hash": %w`, err)}
+		}
+	}
+	if uiuo.mutation.UserCleared() && len(uiuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserInvite.user"`)
+	}
+	return nil
+}
+
+func (uiuo *UserInviteUpdateOne) sqlSave(ctx context.Context) (_node *UserInvite, err error) {
+	if err := uiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userinvite.Table, userinvite.Columns, sqlgraph.NewFieldSpec(userinvite.FieldID, field.TypeUUID))
+	id, ok := uiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userinvite.FieldID)
+		for _, f := range fields {
+			if !userinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiuo.mutation.CreatedAt(); ok {
+		_spec.SetField(userinvite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiuo.mutation.DeletedAt(); ok {
+		_spec.SetField(userinvite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uiuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(userinvite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uiuo.mutation.TokenHash(); ok {
+		_spec.SetField(userinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := uiuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(userinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := uiuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(userinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if uiuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(userinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if uiuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userinvite.UserTable,
+			Columns: []string{userinvite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userinvite.UserTable,
+			Columns: []string{userinvite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserInvite{config: uiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uiuo.mutation.done = true
+	return _node, nil
+}
@@ -15,6 +15,7 @@ import (
 	"template/internal/ent/videoquestiontimestamp"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -32,6 +33,7 @@ type VideoQuery struct {
 	withMedia                        *MediaQuery
 	withCourse                       *CourseQuery
 	withVideoQuestionTimestampsVideo *VideoQuestionTimestampQuery
+	modifiers                        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -496,6 +498,9 @@ func (vq *VideoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Video,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -655,6 +660,9 @@ func (vq *VideoQuery) loadVideoQuestionTimestampsVideo(ctx context.Context, quer
 
 func (vq *VideoQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := vq.querySpec()
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
 	_spec.Node.Columns = vq.ctx.Fields
 	if len(vq.ctx.Fields) > 0 {
 		_spec.Unique = vq.ctx.Unique != nil && *vq.ctx.Unique
@@ -726,6 +734,9 @@ func (vq *VideoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if vq.ctx.Unique != nil && *vq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range vq.modifiers {
+		m(selector)
+	}
 	for _, p := range vq.predicates {
 		p(selector)
 	}
@@ -743,6 +754,32 @@ func (vq *VideoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vq *VideoQuery) ForUpdate(opts ...sql.LockOption) *VideoQuery {
+	if vq.driver.Dialect() == dialect.Postgres {
+		vq.Unique(false)
+	}
+	vq.modifiers = append(vq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vq *VideoQuery) ForShare(opts ...sql.LockOption) *VideoQuery {
+	if vq.driver.Dialect() == dialect.Postgres {
+		vq.Unique(false)
+	}
+	vq.modifiers = append(vq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vq
+}
+
 // VideoGroupBy is the group-by builder for Video entities.
 type VideoGroupBy struct {
 	selector
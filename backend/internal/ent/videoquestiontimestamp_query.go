@@ -12,6 +12,7 @@ import (
 	"template/internal/ent/videoquestiontimestamp"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type VideoQuestionTimestampQuery struct {
 	predicates   []predicate.VideoQuestionTimestamp
 	withVideo    *VideoQuery
 	withQuestion *QuestionQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -421,6 +423,9 @@ func (vqtq *VideoQuestionTimestampQuery) sqlAll(ctx context.Context, hooks ...qu
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(vqtq.modifiers) > 0 {
+		_spec.Modifiers = vqtq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (vqtq *VideoQuestionTimestampQuery) loadQuestion(ctx context.Context, query
 
 func (vqtq *VideoQuestionTimestampQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := vqtq.querySpec()
+	if len(vqtq.modifiers) > 0 {
+		_spec.Modifiers = vqtq.modifiers
+	}
 	_spec.Node.Columns = vqtq.ctx.Fields
 	if len(vqtq.ctx.Fields) > 0 {
 		_spec.Unique = vqtq.ctx.Unique != nil && *vqtq.ctx.Unique
@@ -574,6 +582,9 @@ func (vqtq *VideoQuestionTimestampQuery) sqlQuery(ctx context.Context) *sql.Sele
 	if vqtq.ctx.Unique != nil && *vqtq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range vqtq.modifiers {
+		m(selector)
+	}
 	for _, p := range vqtq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,32 @@ func (vqtq *VideoQuestionTimestampQuery) sqlQuery(ctx context.Context) *sql.Sele
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vqtq *VideoQuestionTimestampQuery) ForUpdate(opts ...sql.LockOption) *VideoQuestionTimestampQuery {
+	if vqtq.driver.Dialect() == dialect.Postgres {
+		vqtq.Unique(false)
+	}
+	vqtq.modifiers = append(vqtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vqtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vqtq *VideoQuestionTimestampQuery) ForShare(opts ...sql.LockOption) *VideoQuestionTimestampQuery {
+	if vqtq.driver.Dialect() == dialect.Postgres {
+		vqtq.Unique(false)
+	}
+	vqtq.modifiers = append(vqtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vqtq
+}
+
 // VideoQuestionTimestampGroupBy is the group-by builder for VideoQuestionTimestamp entities.
 type VideoQuestionTimestampGroupBy struct {
 	selector
@@ -0,0 +1,81 @@
+package access
+
+import (
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/course"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/group"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/test"
+	"template/internal/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// Level is the access a user needs on a resource.
+type Level int
+
+const (
+	// LevelViewer allows reading the resource.
+	LevelViewer Level = iota
+	// LevelEditor allows reading and changing the resource.
+	LevelEditor
+)
+
+// grantRoles returns the grant roles giving at least the access level.
+func grantRoles(level Level) []accessgrant.Role {
+	if level == LevelEditor {
+		return []accessgrant.Role{accessgrant.RoleEditor}
+	}
+	return []accessgrant.Role{accessgrant.RoleViewer, accessgrant.RoleEditor}
+}
+
+// GrantedTo returns a predicate matching the grants giving the user at least the access level,
+// shared with the user directly or with a group they are a member of. The groups in the trash are ignored.
+func GrantedTo(userId uuid.UUID, level Level) predicate.AccessGrant {
+	return accessgrant.And(
+		accessgrant.Or(
+			accessgrant.UserID(userId),
+			accessgrant.HasGroupWith(group.DeletedAtIsNil(), group.HasMembersWith(user.ID(userId))),
+		),
+		accessgrant.RoleIn(grantRoles(level)...),
+	)
+}
+
+// CourseAccess returns a predicate matching the courses the user created or was granted the access level on.
+func CourseAccess(userId uuid.UUID, level Level) predicate.Course {
+	return course.Or(
+		course.CreatorID(userId),
+		course.HasAccessGrantsWith(GrantedTo(userId, level)),
+	)
+}
+
+// TestAccess returns a predicate matching the tests the user created or was granted the access level on,
+// directly or through the course the test belongs to.
+func TestAccess(userId uuid.UUID, level Level) predicate.Test {
+	return test.Or(
+		test.CreatorID(userId),
+		test.HasAccessGrantsWith(GrantedTo(userId, level)),
+		test.HasCourseWith(CourseAccess(userId, level)),
+		test.HasCourseSectionWith(coursesection.HasCourseWith(CourseAccess(userId, level))),
+	)
+}
+
+// GroupAccess returns a predicate matching the groups the user created or is a member of,
+// the groups the user can see and share resources with.
+func GroupAccess(userId uuid.UUID) predicate.Group {
+	return group.Or(
+		group.CreatorID(userId),
+		group.HasMembersWith(user.ID(userId)),
+	)
+}
+
+// QuestionCollectionAccess returns a predicate matching the question collections the user created
+// or was granted the access level on.
+func QuestionCollectionAccess(userId uuid.UUID, level Level) predicate.QuestionCollection {
+	return questioncollection.Or(
+		questioncollection.CreatorID(userId),
+		questioncollection.HasAccessGrantsWith(GrantedTo(userId, level)),
+	)
+}
@@ -0,0 +1,213 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/accessgrant"
+	"template/internal/ent/course"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/db"
+	"template/internal/ent/group"
+	"template/internal/ent/predicate"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/test"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// ShareResource grants a user or the members of a group viewer or editor access to a test, a course or a question collection,
+// only if the user sharing it owns the resource or is an admin, and can see the group. Sharing again with the same user or group changes the role.
+func ShareResource(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.ShareResourceInput) (*ent.AccessGrant, error) {
+	if (input.UserID == nil) == (input.GroupID == nil) {
+		return nil, errors.New("exactly one of userId and groupId must be provided")
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIsResourceOwner(ctx, tx.Client(), userId, isAdminOrOwner, input.ResourceType, input.ResourceID); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	var granteePredicate predicate.AccessGrant
+	if input.UserID != nil {
+		grantee, err := tx.User.Get(ctx, *input.UserID)
+		if err != nil {
+			return nil, db.Rollback(tx, errors.New("user not found"))
+		}
+		if err := checkIsResourceOwner(ctx, tx.Client(), grantee.ID, false, input.ResourceType, input.ResourceID); err == nil {
+			return nil, db.Rollback(tx, errors.New("the resource is already owned by the user"))
+		}
+		granteePredicate = accessgrant.UserID(grantee.ID)
+	} else {
+		groupQuery := tx.Group.Query().Where(group.ID(*input.GroupID))
+		if !isAdminOrOwner {
+			groupQuery = groupQuery.Where(GroupAccess(userId))
+		}
+		exists, err := groupQuery.Exist(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		if !exists {
+			return nil, db.Rollback(tx, errors.New("group not found or unauthorized"))
+		}
+		granteePredicate = accessgrant.GroupID(*input.GroupID)
+	}
+
+	role := model.ConvertAccessRoleToEnt(input.Role)
+	existing, err := tx.AccessGrant.Query().
+		Where(granteePredicate, resourcePredicate(input.ResourceType, input.ResourceID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, db.Rollback(tx, err)
+	}
+
+	var grant *ent.AccessGrant
+	if existing != nil {
+		grant, err = tx.AccessGrant.UpdateOne(existing).
+			SetRole(role).
+			SetGrantedByID(userId).
+			Save(ctx)
+	} else {
+		create := tx.AccessGrant.Create().
+			SetNillableUserID(input.UserID).
+			SetNillableGroupID(input.GroupID).
+			SetRole(role).
+			SetGrantedByID(userId)
+		switch input.ResourceType {
+		case model.AccessResourceTypeTest:
+			create = create.SetTestID(input.ResourceID)
+		case model.AccessResourceTypeCourse:
+			create = create.SetCourseID(input.ResourceID)
+		case model.AccessResourceTypeQuestionCollection:
+			create = create.SetQuestionCollectionID(input.ResourceID)
+		}
+		grant, err = create.Save(ctx)
+	}
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+// RevokeAccessGrant removes a grant, only if the user owns the shared resource, is an admin or is the user grantee leaving it.
+func RevokeAccessGrant(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, grantId uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	grant, err := tx.AccessGrant.Get(ctx, grantId)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("access grant not found or unauthorized"))
+	}
+
+	if grant.UserID == nil || *grant.UserID != userId {
+		resourceType, resourceId := grantResource(grant)
+		if err := checkIsResourceOwner(ctx, tx.Client(), userId, isAdminOrOwner, resourceType, resourceId); err != nil {
+			return false, db.Rollback(tx, errors.New("access grant not found or unauthorized"))
+		}
+	}
+
+	err = tx.AccessGrant.DeleteOneID(grantId).Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetAccessGrants returns the grants of a resource, only if the user owns the resource or is an admin.
+func GetAccessGrants(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, resourceType model.AccessResourceType, resourceId uuid.UUID) ([]*ent.AccessGrant, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIsResourceOwner(ctx, client, userId, isAdminOrOwner, resourceType, resourceId); err != nil {
+		return nil, err
+	}
+
+	return client.AccessGrant.Query().
+		Where(resourcePredicate(resourceType, resourceId)).
+		Order(ent.Asc(accessgrant.FieldCreatedAt)).
+		All(ctx)
+}
+
+// checkIsResourceOwner makes sure the user is an admin or the owner of the resource. The owner of a test
+// is its creator or the creator of its course.
+func checkIsResourceOwner(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, resourceType model.AccessResourceType, resourceId uuid.UUID) error {
+	var exists bool
+	var err error
+	switch resourceType {
+	case model.AccessResourceTypeTest:
+		query := client.Test.Query().Where(test.ID(resourceId))
+		if !isAdminOrOwner {
+			query = query.Where(test.Or(
+				test.CreatorID(userId),
+				test.HasCourseWith(course.CreatorID(userId)),
+				test.HasCourseSectionWith(coursesection.HasCourseWith(course.CreatorID(userId))),
+			))
+		}
+		exists, err = query.Exist(ctx)
+	case model.AccessResourceTypeCourse:
+		query := client.Course.Query().Where(course.ID(resourceId))
+		if !isAdminOrOwner {
+			query = query.Where(course.CreatorID(userId))
+		}
+		exists, err = query.Exist(ctx)
+	case model.AccessResourceTypeQuestionCollection:
+		query := client.QuestionCollection.Query().Where(questioncollection.ID(resourceId))
+		if !isAdminOrOwner {
+			query = query.Where(questioncollection.CreatorID(userId))
+		}
+		exists, err = query.Exist(ctx)
+	default:
+		return errors.New("invalid resource type")
+	}
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("resource not found or unauthorized")
+	}
+
+	return nil
+}
+
+// resourcePredicate returns a predicate matching the grants of a resource.
+func resourcePredicate(resourceType model.AccessResourceType, resourceId uuid.UUID) predicate.AccessGrant {
+	switch resourceType {
+	case model.AccessResourceTypeTest:
+		return accessgrant.TestID(resourceId)
+	case model.AccessResourceTypeCourse:
+		return accessgrant.CourseID(resourceId)
+	default:
+		return accessgrant.QuestionCollectionID(resourceId)
+	}
+}
+
+// grantResource returns the type and the ID of the resource shared by a grant.
+func grantResource(grant *ent.AccessGrant) (model.AccessResourceType, uuid.UUID) {
+	switch {
+	case grant.TestID != nil:
+		return model.AccessResourceTypeTest, *grant.TestID
+	case grant.CourseID != nil:
+		return model.AccessResourceTypeCourse, *grant.CourseID
+	default:
+		return model.AccessResourceTypeQuestionCollection, *grant.QuestionCollectionID
+	}
+}
@@ -6,11 +6,17 @@ import (
 	"fmt"
 	"template/internal/ent"
 	"template/internal/ent/db"
+	"template/internal/ent/jwttoken"
 	"template/internal/ent/role"
 	"template/internal/ent/user"
+	"template/internal/ent/userinvite"
 	roleFeat "template/internal/features/role"
+	userFeat "template/internal/features/user"
 	"template/internal/graph/model"
+	"template/internal/shared/utilities/secret"
+	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -71,6 +77,15 @@ func Register(ctx context.Context, input model.RegisterInput) (bool, error) {
 		return false, db.Rollback(tx, errors.New("default user role not found"))
 	}
 
+	// Use email as username if not provided
+	username := input.Email
+	if input.Username != nil {
+		if err := userFeat.ValidateUsername(ctx, tx.Client(), uuid.Nil, *input.Username); err != nil {
+			return false, db.Rollback(tx, err)
+		}
+		username = *input.Username
+	}
+
 	// Hash the password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -80,7 +95,7 @@ func Register(ctx context.Context, input model.RegisterInput) (bool, error) {
 	// Create the user with the hashed password and assign default role
 	_, err = tx.User.Create().
 		SetEmail(input.Email).
-		SetUsername(input.Email). // Use email as username if not provided
+		SetUsername(username).
 		SetPasswordHash(string(hashedPassword)).
 		AddRoleIDs(userRole.ID). // Assign default user role
 		Save(ctx)
@@ -94,3 +109,110 @@ func Register(ctx context.Context, input model.RegisterInput) (bool, error) {
 
 	return true, nil
 }
+
+// AcceptInvite sets the password of an invited user, an invite can only be accepted once before it expires.
+func AcceptInvite(ctx context.Context, input model.AcceptInviteInput) (bool, error) {
+	if input.Password == "" {
+		return false, errors.New("password cannot be empty")
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	invite, err := tx.UserInvite.Query().
+		Where(
+			userinvite.TokenHash(secret.HashToken(input.Token)),
+			userinvite.AcceptedAtIsNil(),
+			userinvite.ExpiresAtGT(time.Now().UTC()),
+		).
+		Only(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("invalid or expired invite"))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return false, db.Rollback(tx, fmt.Errorf("failed to hash password"))
+	}
+
+	err = tx.User.UpdateOneID(invite.UserID).
+		SetPasswordHash(string(hashedPassword)).
+		SetMustChangePassword(false).
+		Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	err = tx.UserInvite.UpdateOne(invite).
+		SetAcceptedAt(time.Now().UTC()).
+		Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	roleFeat.InvalidatePrincipals(invite.UserID)
+
+	return true, nil
+}
+
+// ChangePassword changes the password of a user after checking the current one, and revokes the tokens
+// of their other sessions, keeping the one of the request.
+func ChangePassword(ctx context.Context, userId uuid.UUID, accessToken string, input model.ChangePasswordInput) (bool, error) {
+	if input.NewPassword == "" {
+		return false, errors.New("password cannot be empty")
+	}
+	if input.NewPassword == input.CurrentPassword {
+		return false, errors.New("the new password must be different from the current one")
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	currentUser, err := tx.User.Get(ctx, userId)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("user not found"))
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(currentUser.PasswordHash), []byte(input.CurrentPassword))
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("invalid current password"))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return false, db.Rollback(tx, fmt.Errorf("failed to hash password"))
+	}
+
+	err = tx.User.UpdateOneID(userId).
+		SetPasswordHash(string(hashedPassword)).
+		SetMustChangePassword(false).
+		Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	_, err = tx.JwtToken.Delete().
+		Where(
+			jwttoken.UserIDEQ(userId),
+			jwttoken.AccessTokenNEQ(accessToken),
+			jwttoken.DeletedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	roleFeat.InvalidatePrincipals(userId)
+
+	return true, nil
+}
@@ -0,0 +1,16 @@
+package common
+
+// BadRequestError is returned by features when a request cannot be fulfilled in the current state,
+// it is presented to GraphQL clients with the BAD_REQUEST code.
+type BadRequestError struct {
+	Message string
+}
+
+func (e *BadRequestError) Error() string {
+	return e.Message
+}
+
+// NewBadRequestError creates a new bad request error
+func NewBadRequestError(message string) *BadRequestError {
+	return &BadRequestError{Message: message}
+}
@@ -0,0 +1,24 @@
+package common
+
+import (
+	"errors"
+	"time"
+)
+
+// ToUTC converts an optional time to UTC.
+// Columns declared as timestamp without time zone drop the offset, so times must be stored in UTC.
+func ToUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// ValidateTimeWindow makes sure an optional open/close window closes after it opens.
+func ValidateTimeWindow(openAt *time.Time, closeAt *time.Time) error {
+	if openAt != nil && closeAt != nil && !closeAt.After(*openAt) {
+		return errors.New("closeAt must be after openAt")
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package course
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/test"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// CloneCourse deep copies a course into a new draft course owned by the user.
+// The section tree with its prerequisites, the question collections linked to the course (with their questions,
+// options and explanations) and the tests (with their settings, question requirements and ignored questions)
+// are copied in a single transaction.
+// Test sessions are never copied.
+func CloneCourse(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID, newTitle string) (*ent.Course, error) {
+	if newTitle == "" {
+		return nil, errors.New("title is required")
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := tx.Course.Get(ctx, courseID)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !isAdminOrOwner && source.CreatorID != userId {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only the creator can clone this course"))
+	}
+
+	clonedCourse, err := tx.Course.Create().
+		SetTitle(newTitle).
+		SetNillableDescription(source.Description).
+		SetNillableMediaID(source.MediaID).
+		SetCreatorID(userId).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	sectionIDMap, err := cloneSections(ctx, tx, source.ID, clonedCourse.ID)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	sourceSectionIDs := make([]uuid.UUID, 0, len(sectionIDMap))
+	for id := range sectionIDMap {
+		sourceSectionIDs = append(sourceSectionIDs, id)
+	}
+
+	tests, err := tx.Test.Query().
+		Where(test.Or(
+			test.CourseID(source.ID),
+			test.CourseSectionIDIn(sourceSectionIDs...),
+		)).
+		WithQuestionCollections().
+		WithTestQuestionCounts().
+		WithTestIgnoreQuestions().
+		All(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	// Collections belonging to the sections of the course, plus the ones used by its tests
+	collectionIDs, err := tx.QuestionCollection.Query().
+		Where(questioncollection.CourseSectionIDIn(sourceSectionIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	for _, t := range tests {
+		for _, collection := range t.Edges.QuestionCollections {
+			collectionIDs = append(collectionIDs, collection.ID)
+		}
+	}
+	collectionIDs = slice.Unique(collectionIDs)
+
+	collectionIDMap, questionIDMap, err := cloneQuestionCollections(ctx, tx, userId, collectionIDs, sectionIDMap)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := cloneTests(ctx, tx, userId, tests, clonedCourse.ID, sectionIDMap, collectionIDMap, questionIDMap); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return clonedCourse, nil
+}
+
+// cloneSections copies the section tree of a course, parents first, and returns a map of source to cloned section IDs.
+func cloneSections(ctx context.Context, tx *ent.Tx, sourceCourseID uuid.UUID, targetCourseID uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	sections, err := tx.CourseSection.Query().
+		Where(coursesection.CourseID(sourceCourseID)).
+		Order(ent.Asc(coursesection.FieldOrder)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sectionIDMap := make(map[uuid.UUID]uuid.UUID, len(sections))
+	remaining := sections
+	for len(remaining) > 0 {
+		var pending []*ent.CourseSection
+		for _, section := range remaining {
+			var parentID *uuid.UUID
+			if section.SectionID != nil {
+				clonedParentID, ok := sectionIDMap[*section.SectionID]
+				if !ok {
+					// The parent has not been cloned yet, retry on the next pass
+					pending = append(pending, section)
+					continue
+				}
+				parentID = &clonedParentID
+			}
+
+			cloned, err := tx.CourseSection.Create().
+				SetCourseID(targetCourseID).
+				SetNillableSectionID(parentID).
+				SetTitle(section.Title).
+				SetNillableDescription(section.Description).
+				SetOrder(section.Order).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sectionIDMap[section.ID] = cloned.ID
+		}
+
+		if len(pending) == len(remaining) {
+			return nil, errors.New("course sections reference a parent outside of the course")
+		}
+		remaining = pending
+	}
+
+	if err := cloneSectionPrerequisites(ctx, tx, sectionIDMap); err != nil {
+		return nil, err
+	}
+
+	return sectionIDMap, nil
+}
+
+// cloneSectionPrerequisites copies the prerequisites between the sections of a course onto the cloned sections.
+func cloneSectionPrerequisites(ctx context.Context, tx *ent.Tx, sectionIDMap map[uuid.UUID]uuid.UUID) error {
+	sourceSectionIDs := make([]uuid.UUID, 0, len(sectionIDMap))
+	for id := range sectionIDMap {
+		sourceSectionIDs = append(sourceSectionIDs, id)
+	}
+
+	prerequisites, err := tx.CourseSectionPrerequisite.Query().
+		Where(coursesectionprerequisite.SectionIDIn(sourceSectionIDs...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var prerequisiteCreates []*ent.CourseSectionPrerequisiteCreate
+	for _, prerequisite := range prerequisites {
+		clonedPrerequisiteID, ok := sectionIDMap[prerequisite.PrerequisiteSectionID]
+		if !ok {
+			continue
+		}
+		prerequisiteCreates = append(prerequisiteCreates, tx.CourseSectionPrerequisite.Create().
+			SetSectionID(sectionIDMap[prerequisite.SectionID]).
+			SetPrerequisiteSectionID(clonedPrerequisiteID).
+			SetNillableMinScorePercent(prerequisite.MinScorePercent))
+	}
+	if len(prerequisiteCreates) > 0 {
+		if _, err := tx.CourseSectionPrerequisite.CreateBulk(prerequisiteCreates...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneQuestionCollections copies the given collections with their questions and options.
+// It returns the maps of source to cloned collection IDs and source to cloned question IDs.
+func cloneQuestionCollections(ctx context.Context, tx *ent.Tx, userId uuid.UUID, collectionIDs []uuid.UUID, sectionIDMap map[uuid.UUID]uuid.UUID) (map[uuid.UUID]uuid.UUID, map[uuid.UUID]uuid.UUID, error) {
+	collectionIDMap := make(map[uuid.UUID]uuid.UUID, len(collectionIDs))
+	questionIDMap := make(map[uuid.UUID]uuid.UUID)
+
+	collections, err := tx.QuestionCollection.Query().
+		Where(questioncollection.IDIn(collectionIDs...)).
+		WithQuestions(func(qq *ent.QuestionQuery) {
+			qq.Order(ent.Asc(question.FieldCreatedAt))
+			qq.WithQuestionOptions(func(oq *ent.QuestionOptionQuery) {
+				oq.Order(ent.Asc(questionoption.FieldCreatedAt))
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, collection := range collections {
+		create := tx.QuestionCollection.Create().
+			SetTitle(collection.Title).
+			SetNillableDescription(collection.Description).
+			SetCreatorID(userId)
+		if collection.CourseSectionID != nil {
+			if clonedSectionID, ok := sectionIDMap[*collection.CourseSectionID]; ok {
+				create.SetCourseSectionID(clonedSectionID)
+			}
+		}
+
+		clonedCollection, err := create.Save(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		collectionIDMap[collection.ID] = clonedCollection.ID
+
+		for _, q := range collection.Edges.Questions {
+			clonedQuestion, err := tx.Question.Create().
+				SetCollectionID(clonedCollection.ID).
+				SetQuestionText(q.QuestionText).
+				SetPoints(q.Points).
+				SetNillableExplanation(q.Explanation).
+				Save(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			questionIDMap[q.ID] = clonedQuestion.ID
+
+			optionCreates := slice.Map(q.Edges.QuestionOptions, func(option *ent.QuestionOption) *ent.QuestionOptionCreate {
+				return tx.QuestionOption.Create().
+					SetQuestionID(clonedQuestion.ID).
+					SetOptionText(option.OptionText).
+					SetIsCorrect(option.IsCorrect).
+					SetNillableExplanation(option.Explanation)
+			})
+			if len(optionCreates) > 0 {
+				if _, err := tx.QuestionOption.CreateBulk(optionCreates...).Save(ctx); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	return collectionIDMap, questionIDMap, nil
+}
+
+// cloneTests copies the tests of a course as tests created by userId, pointing them at the cloned sections, collections and questions.
+func cloneTests(ctx context.Context, tx *ent.Tx, userId uuid.UUID, tests []*ent.Test, targetCourseID uuid.UUID, sectionIDMap, collectionIDMap, questionIDMap map[uuid.UUID]uuid.UUID) error {
+	for _, t := range tests {
+		create := tx.Test.Create().
+			SetName(t.Name).
+			SetCreatorID(userId).
+			SetTotalPoints(t.TotalPoints).
+			SetNillableTotalTime(t.TotalTime).
+			SetNillableOpenAt(t.OpenAt).
+			SetNillableCloseAt(t.CloseAt).
+			SetNillableMaxAttempts(t.MaxAttempts).
+			SetNillableAttemptCooldownMinutes(t.AttemptCooldownMinutes).
+			SetScoringPolicy(t.ScoringPolicy).
+			SetFeedbackRelease(t.FeedbackRelease)
+		if t.CourseID != nil {
+			create.SetCourseID(targetCourseID)
+		}
+		if t.CourseSectionID != nil {
+			if clonedSectionID, ok := sectionIDMap[*t.CourseSectionID]; ok {
+				create.SetCourseSectionID(clonedSectionID)
+			}
+		}
+		create.AddQuestionCollectionIDs(slice.Map(t.Edges.QuestionCollections, func(collection *ent.QuestionCollection) uuid.UUID {
+			return collectionIDMap[collection.ID]
+		})...)
+
+		clonedTest, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		countCreates := slice.Map(t.Edges.TestQuestionCounts, func(count *ent.TestQuestionCount) *ent.TestQuestionCountCreate {
+			return tx.TestQuestionCount.Create().
+				SetTestID(clonedTest.ID).
+				SetNumberOfQuestions(count.NumberOfQuestions).
+				SetPoints(count.Points)
+		})
+		if len(countCreates) > 0 {
+			if _, err := tx.TestQuestionCount.CreateBulk(countCreates...).Save(ctx); err != nil {
+				return err
+			}
+		}
+
+		ignoreCreates := slice.Map(t.Edges.TestIgnoreQuestions, func(ignore *ent.TestIgnoreQuestion) *ent.TestIgnoreQuestionCreate {
+			questionID := ignore.QuestionID
+			if clonedQuestionID, ok := questionIDMap[ignore.QuestionID]; ok {
+				questionID = clonedQuestionID
+			}
+			return tx.TestIgnoreQuestion.Create().
+				SetTestID(clonedTest.ID).
+				SetQuestionID(questionID).
+				SetNillableReason(ignore.Reason)
+		})
+		if len(ignoreCreates) > 0 {
+			if _, err := tx.TestIgnoreQuestion.CreateBulk(ignoreCreates...).Save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/course"
 	"template/internal/ent/db"
+	"template/internal/features/access"
 	"template/internal/features/common"
+	"template/internal/features/trash"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
@@ -22,6 +24,7 @@ func CreateCourse(ctx context.Context, userId uuid.UUID, input model.CreateCours
 	course, err := client.Course.Create().
 		SetTitle(input.Title).
 		SetNillableDescription(input.Description).
+		SetNillableMediaID(input.MediaID).
 		SetCreatorID(userId).
 		Save(ctx)
 	if err != nil {
@@ -41,50 +44,66 @@ func GetCourseByID(ctx context.Context, courseID uuid.UUID) (*ent.Course, error)
 	return client.Course.Get(ctx, courseID)
 }
 
-// UpdateCourse updates a course by its ID with the provided input, only if the user is the creator.
+// UpdateCourse updates a course by its ID with the provided input, only if the user is the creator or an editor.
 func UpdateCourse(ctx context.Context, userId uuid.UUID, courseID uuid.UUID, input model.UpdateCourseInput) (*ent.Course, error) {
 	client, err := db.OpenClient()
 	if err != nil {
 		return nil, err
 	}
 
-	course, err := client.Course.Get(ctx, courseID)
+	if _, err := client.Course.Get(ctx, courseID); err != nil {
+		return nil, err
+	}
+	canEdit, err := client.Course.Query().
+		Where(course.ID(courseID), access.CourseAccess(userId, access.LevelEditor)).
+		Exist(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if course.CreatorID != userId {
-		return nil, errors.New("unauthorized: only the creator can update this course")
+	if !canEdit {
+		return nil, errors.New("unauthorized: only the creator or an editor can update this course")
 	}
 
 	update := client.Course.UpdateOneID(courseID)
 	update.SetNillableTitle(input.Title)
 	update.SetNillableDescription(input.Description)
+	update.SetNillableMediaID(input.MediaID)
 	return update.Save(ctx)
 }
 
-// RemoveCourse deletes a course by its ID, only if the user is the creator.
-func RemoveCourse(ctx context.Context, userId uuid.UUID, courseID uuid.UUID) (bool, error) {
-	client, err := db.OpenClient()
+// RemoveCourse moves a course to the trash with its sections, videos, enrollments and tests,
+// only if the user can edit the course or is an admin.
+func RemoveCourse(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	crs, err := client.Course.Get(ctx, courseID)
+	query := tx.Course.Query().Where(course.ID(courseID))
+	if !isAdminOrOwner {
+		query = query.Where(access.CourseAccess(userId, access.LevelEditor))
+	}
+	exists, err := query.Exist(ctx)
 	if err != nil {
-		return false, err
+		return false, db.Rollback(tx, err)
 	}
-	if crs.CreatorID != userId {
-		return false, errors.New("unauthorized: only the creator can delete this course")
+	if !exists {
+		return false, db.Rollback(tx, errors.New("course not found or unauthorized"))
 	}
 
-	err = client.Course.DeleteOneID(courseID).Exec(ctx)
+	err = trash.SoftDelete(ctx, tx.Client(), model.TrashEntityTypeCourse, courseID)
 	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-// PaginatedCourses returns a paginated list of courses.
+// PaginatedCourses returns a paginated list of the courses visible to the user.
+// Unpublished courses are only listed for their authors and instructors.
 func PaginatedCourses(ctx context.Context, userId uuid.UUID, input *model.PaginationInput) (*common.PaginatedResult[*ent.Course], error) {
 	client, err := db.OpenClient()
 	if err != nil {
@@ -92,7 +111,7 @@ func PaginatedCourses(ctx context.Context, userId uuid.UUID, input *model.Pagina
 	}
 
 	query := client.Course.Query()
-	query = query.Where(course.CreatorID(userId))
+	query = query.Where(VisibleToUser(userId))
 	if input != nil && input.Search != nil && *input.Search != "" {
 		query = query.Where(course.TitleContains(*input.Search))
 	}
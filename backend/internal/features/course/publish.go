@@ -0,0 +1,108 @@
+package course
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/db"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// allowedStatusTransitions describes the course lifecycle: draft -> review -> published -> archived.
+// A draft can be published directly, and an archived course can be brought back as a draft.
+var allowedStatusTransitions = map[course.Status][]course.Status{
+	course.StatusDraft:     {course.StatusReview, course.StatusPublished, course.StatusArchived},
+	course.StatusReview:    {course.StatusDraft, course.StatusPublished, course.StatusArchived},
+	course.StatusPublished: {course.StatusArchived},
+	course.StatusArchived:  {course.StatusDraft},
+}
+
+// SubmitCourseForReview moves a draft course to review, only if the user is the creator.
+func SubmitCourseForReview(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID) (*ent.Course, error) {
+	return transitionCourseStatus(ctx, userId, isAdminOrOwner, courseID, course.StatusReview)
+}
+
+// PublishCourse publishes a course, only if the user is the creator.
+// A course needs at least one section and a cover media to be published.
+func PublishCourse(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID) (*ent.Course, error) {
+	return transitionCourseStatus(ctx, userId, isAdminOrOwner, courseID, course.StatusPublished)
+}
+
+// ArchiveCourse archives a course, hiding it from learners, only if the user is the creator.
+func ArchiveCourse(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID) (*ent.Course, error) {
+	return transitionCourseStatus(ctx, userId, isAdminOrOwner, courseID, course.StatusArchived)
+}
+
+func transitionCourseStatus(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID, nextStatus course.Status) (*ent.Course, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	crs, err := tx.Course.Get(ctx, courseID)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !isAdminOrOwner && crs.CreatorID != userId {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only the creator can change the status of this course"))
+	}
+
+	if !slice.Contains(allowedStatusTransitions[crs.Status], nextStatus) {
+		return nil, db.Rollback(tx, fmt.Errorf("cannot change course status from %s to %s", crs.Status, nextStatus))
+	}
+
+	if nextStatus == course.StatusPublished {
+		if err := validateCourseForPublishing(ctx, tx.Client(), crs); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	now := time.Now().UTC()
+	update := tx.Course.UpdateOneID(courseID).
+		SetStatus(nextStatus).
+		SetIsPublished(nextStatus == course.StatusPublished)
+	switch nextStatus {
+	case course.StatusPublished:
+		update.SetPublishedAt(now).ClearArchivedAt()
+	case course.StatusArchived:
+		update.SetArchivedAt(now)
+	case course.StatusDraft:
+		update.ClearArchivedAt()
+	}
+
+	updatedCourse, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updatedCourse, nil
+}
+
+// validateCourseForPublishing checks that a course has the content required to be published.
+func validateCourseForPublishing(ctx context.Context, client *ent.Client, crs *ent.Course) error {
+	if crs.MediaID == nil {
+		return errors.New("course must have a media before it can be published")
+	}
+
+	hasSection, err := client.CourseSection.Query().
+		Where(coursesection.CourseID(crs.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !hasSection {
+		return errors.New("course must have at least one section before it can be published")
+	}
+
+	return nil
+}
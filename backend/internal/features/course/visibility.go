@@ -0,0 +1,60 @@
+package course
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/db"
+	"template/internal/ent/predicate"
+	"template/internal/features/access"
+
+	"github.com/google/uuid"
+)
+
+// VisibleToUser returns a predicate matching the courses a user is allowed to see:
+// courses they created or were shared with them, courses they teach (instructor or teaching assistant),
+// and published courses they are enrolled in as a learner.
+func VisibleToUser(userId uuid.UUID) predicate.Course {
+	return course.Or(
+		access.CourseAccess(userId, access.LevelViewer),
+		course.HasEnrollmentsWith(
+			courseenrollment.UserID(userId),
+			courseenrollment.RoleIn(courseenrollment.RoleInstructor, courseenrollment.RoleTeachingAssistant),
+			courseenrollment.StatusNEQ(courseenrollment.StatusSuspended),
+		),
+		course.And(
+			course.IsPublished(true),
+			course.HasEnrollmentsWith(
+				courseenrollment.UserID(userId),
+				courseenrollment.StatusNEQ(courseenrollment.StatusSuspended),
+			),
+		),
+	)
+}
+
+// GetVisibleCourseByID fetches a course by its ID, only if the user is allowed to see it.
+// Admins and owners can see every course.
+func GetVisibleCourseByID(ctx context.Context, userId uuid.UUID, courseID uuid.UUID, isAdminOrOwner bool) (*ent.Course, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := client.Course.Query().Where(course.ID(courseID))
+	if !isAdminOrOwner {
+		query = query.Where(VisibleToUser(userId))
+	}
+
+	return query.Only(ctx)
+}
+
+// GetCoursesByIDs fetches multiple courses by their IDs.
+func GetCoursesByIDs(ctx context.Context, courseIDs []uuid.UUID) ([]*ent.Course, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Course.Query().Where(course.IDIn(courseIDs...)).All(ctx)
+}
@@ -0,0 +1,192 @@
+package course_enrollment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"template/internal/ent"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/db"
+	"template/internal/ent/predicate"
+	"template/internal/ent/user"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// BulkEnrollResult holds the enrolments created by a bulk enrol and the emails that did not match any user.
+type BulkEnrollResult struct {
+	Enrollments   []*ent.CourseEnrollment
+	UnknownEmails []string
+}
+
+// EnrollUser enrols a user in a course. If the user is already enrolled, the role and status are updated instead.
+func EnrollUser(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.EnrollCourseInput) (*ent.CourseEnrollment, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canManage, err := CanManageCourse(ctx, tx.Client(), userId, input.CourseID, isAdminOrOwner)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !canManage {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only course managers can enroll users"))
+	}
+
+	_, err = tx.User.Get(ctx, input.UserID)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	role := courseenrollment.RoleLearner
+	if input.Role != nil {
+		role = convertRoleToEntRole(*input.Role)
+	}
+	status := courseenrollment.StatusActive
+	if input.Status != nil {
+		status = convertStatusToEntStatus(*input.Status)
+	}
+
+	enrollment, err := upsertEnrollment(ctx, tx, input.CourseID, input.UserID, role, status)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return enrollment, nil
+}
+
+// BulkEnrollByEmails enrols every user matching the given emails in a course.
+// Emails that do not belong to any user are reported back instead of failing the whole operation.
+func BulkEnrollByEmails(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.BulkEnrollCourseInput) (*BulkEnrollResult, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canManage, err := CanManageCourse(ctx, tx.Client(), userId, input.CourseID, isAdminOrOwner)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !canManage {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only course managers can enroll users"))
+	}
+
+	// Emails are matched regardless of their case, like the user import does
+	emails := []string{}
+	seenEmails := make(map[string]bool)
+	for _, email := range input.Emails {
+		email = strings.TrimSpace(email)
+		if email == "" || seenEmails[strings.ToLower(email)] {
+			continue
+		}
+		seenEmails[strings.ToLower(email)] = true
+		emails = append(emails, email)
+	}
+
+	users := []*ent.User{}
+	if len(emails) > 0 {
+		users, err = tx.User.Query().Where(user.Or(slice.Map(emails, func(email string) predicate.User {
+			return user.EmailEqualFold(email)
+		})...)).All(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+	usersByEmail := slice.ToMap(users, func(u *ent.User) (string, *ent.User) {
+		return strings.ToLower(u.Email), u
+	})
+
+	role := courseenrollment.RoleLearner
+	if input.Role != nil {
+		role = convertRoleToEntRole(*input.Role)
+	}
+
+	result := &BulkEnrollResult{
+		Enrollments:   []*ent.CourseEnrollment{},
+		UnknownEmails: []string{},
+	}
+	for _, email := range emails {
+		u, ok := usersByEmail[strings.ToLower(email)]
+		if !ok {
+			result.UnknownEmails = append(result.UnknownEmails, email)
+			continue
+		}
+
+		enrollment, err := upsertEnrollment(ctx, tx, input.CourseID, u.ID, role, courseenrollment.StatusActive)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		result.Enrollments = append(result.Enrollments, enrollment)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Unenroll removes a user from a course.
+func Unenroll(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID, targetUserID uuid.UUID) (bool, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return false, err
+	}
+
+	canManage, err := CanManageCourse(ctx, client, userId, courseID, isAdminOrOwner)
+	if err != nil {
+		return false, err
+	}
+	if !canManage {
+		return false, errors.New("unauthorized: only course managers can unenroll users")
+	}
+
+	deleted, err := client.CourseEnrollment.Delete().
+		Where(
+			courseenrollment.CourseID(courseID),
+			courseenrollment.UserID(targetUserID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	if deleted == 0 {
+		return false, errors.New("enrollment not found")
+	}
+
+	return true, nil
+}
+
+// upsertEnrollment creates the enrolment of a user in a course, or updates its role and status when it already exists.
+func upsertEnrollment(ctx context.Context, tx *ent.Tx, courseID uuid.UUID, userID uuid.UUID, role courseenrollment.Role, status courseenrollment.Status) (*ent.CourseEnrollment, error) {
+	existing, err := tx.CourseEnrollment.Query().
+		Where(
+			courseenrollment.CourseID(courseID),
+			courseenrollment.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing != nil {
+		return existing.Update().
+			SetRole(role).
+			SetStatus(status).
+			Save(ctx)
+	}
+
+	return tx.CourseEnrollment.Create().
+		SetCourseID(courseID).
+		SetUserID(userID).
+		SetRole(role).
+		SetStatus(status).
+		Save(ctx)
+}
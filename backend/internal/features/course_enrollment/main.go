@@ -0,0 +1,129 @@
+package course_enrollment
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/db"
+	"template/internal/features/common"
+	courseFeat "template/internal/features/course"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// CanManageCourse reports whether the user can manage the roster of a course.
+// The course creator, active instructors of the course, admins and owners can manage it.
+func CanManageCourse(ctx context.Context, client *ent.Client, userId uuid.UUID, courseID uuid.UUID, isAdminOrOwner bool) (bool, error) {
+	crs, err := client.Course.Get(ctx, courseID)
+	if err != nil {
+		return false, err
+	}
+	if isAdminOrOwner || crs.CreatorID == userId {
+		return true, nil
+	}
+
+	return client.CourseEnrollment.Query().
+		Where(
+			courseenrollment.CourseID(courseID),
+			courseenrollment.UserID(userId),
+			courseenrollment.RoleEQ(courseenrollment.RoleInstructor),
+			courseenrollment.StatusEQ(courseenrollment.StatusActive),
+		).
+		Exist(ctx)
+}
+
+// PaginatedCourseEnrollments returns the paginated roster of a course, only if the user can manage the course.
+func PaginatedCourseEnrollments(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseID uuid.UUID, input *model.PaginationInput, filterInput *model.CourseEnrollmentFilterInput) (*common.PaginatedResult[*ent.CourseEnrollment], error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	canManage, err := CanManageCourse(ctx, client, userId, courseID, isAdminOrOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("unauthorized: only course managers can view the roster")
+	}
+
+	query := client.CourseEnrollment.Query().
+		Where(courseenrollment.CourseID(courseID)).
+		Order(ent.Asc(courseenrollment.FieldEnrolledAt))
+
+	if filterInput != nil && len(filterInput.Roles) > 0 {
+		query = query.Where(courseenrollment.RoleIn(ConvertRolesToEntRoles(filterInput.Roles)...))
+	}
+	if filterInput != nil && len(filterInput.Statuses) > 0 {
+		query = query.Where(courseenrollment.StatusIn(ConvertStatusesToEntStatuses(filterInput.Statuses)...))
+	}
+
+	newInput := common.FallbackValue(input, common.DefaultPaginationInput)
+
+	return common.EntQueryPaginated(ctx, query, *newInput.Page, *newInput.Limit)
+}
+
+// MyCourses returns a paginated list of courses the user is enrolled in.
+// Learners only see published courses, while instructors and teaching assistants also see drafts.
+func MyCourses(ctx context.Context, userId uuid.UUID, input *model.PaginationInput) (*common.PaginatedResult[*ent.Course], error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := client.Course.Query().
+		Where(
+			course.HasEnrollmentsWith(courseenrollment.UserID(userId)),
+			courseFeat.VisibleToUser(userId),
+		)
+	if input != nil && input.Search != nil && *input.Search != "" {
+		query = query.Where(course.TitleContains(*input.Search))
+	}
+
+	newInput := common.FallbackValue(input, common.DefaultPaginationInput)
+
+	return common.EntQueryPaginated(ctx, query, *newInput.Page, *newInput.Limit)
+}
+
+// ConvertRolesToEntRoles converts GraphQL CourseEnrollmentRole values to ent enrollment roles.
+func ConvertRolesToEntRoles(roles []model.CourseEnrollmentRole) []courseenrollment.Role {
+	result := make([]courseenrollment.Role, len(roles))
+	for i, role := range roles {
+		result[i] = convertRoleToEntRole(role)
+	}
+	return result
+}
+
+// ConvertStatusesToEntStatuses converts GraphQL CourseEnrollmentStatus values to ent enrollment statuses.
+func ConvertStatusesToEntStatuses(statuses []model.CourseEnrollmentStatus) []courseenrollment.Status {
+	result := make([]courseenrollment.Status, len(statuses))
+	for i, status := range statuses {
+		result[i] = convertStatusToEntStatus(status)
+	}
+	return result
+}
+
+func convertRoleToEntRole(role model.CourseEnrollmentRole) courseenrollment.Role {
+	switch role {
+	case model.CourseEnrollmentRoleInstructor:
+		return courseenrollment.RoleInstructor
+	case model.CourseEnrollmentRoleTeachingAssistant:
+		return courseenrollment.RoleTeachingAssistant
+	default:
+		return courseenrollment.RoleLearner
+	}
+}
+
+func convertStatusToEntStatus(status model.CourseEnrollmentStatus) courseenrollment.Status {
+	switch status {
+	case model.CourseEnrollmentStatusSuspended:
+		return courseenrollment.StatusSuspended
+	case model.CourseEnrollmentStatusCompleted:
+		return courseenrollment.StatusCompleted
+	default:
+		return courseenrollment.StatusActive
+	}
+}
@@ -6,25 +6,26 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
 )
 
-// CreateCourseSection creates a new course section for a course, only if the user is the course creator.
+// CreateCourseSection creates a new course section for a course, only if the user can edit the course.
 func CreateCourseSection(ctx context.Context, userId uuid.UUID, courseId uuid.UUID, input model.CreateCourseSectionInput) (*ent.CourseSection, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the user is the course creator
-	crs, err := tx.Course.Get(ctx, courseId)
+	// Check if the user can edit the course
+	canEdit, err := hasCourseAccess(ctx, tx.Client(), userId, courseId, access.LevelEditor)
 	if err != nil {
 		return nil, db.Rollback(tx, err)
 	}
-	if crs.CreatorID != userId {
-		return nil, db.Rollback(tx, errors.New("unauthorized: only the course creator can add sections to this course"))
+	if !canEdit {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only the course creator or an editor can add sections to this course"))
 	}
 
 	// Validate sectionId if provided
@@ -3,14 +3,14 @@ package course_section
 import (
 	"context"
 	"template/internal/ent"
-	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
+	"template/internal/features/access"
 
 	"github.com/google/uuid"
 )
 
-// GetCourseSectionByID fetches a course section by its ID, only if the user is the course creator.
+// GetCourseSectionByID fetches a course section by its ID, only if the user can view the course.
 func GetCourseSectionByID(ctx context.Context, userId uuid.UUID, sectionId uuid.UUID) (*ent.CourseSection, error) {
 	client, err := db.OpenClient()
 	if err != nil {
@@ -18,7 +18,7 @@ func GetCourseSectionByID(ctx context.Context, userId uuid.UUID, sectionId uuid.
 	}
 
 	section, err := client.CourseSection.Query().
-		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(course.CreatorID(userId))).
+		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelViewer))).
 		Select(coursesection.FieldID, coursesection.FieldTitle, coursesection.FieldDescription, coursesection.FieldOrder).
 		First(ctx)
 	if err != nil {
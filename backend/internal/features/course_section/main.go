@@ -1 +1,22 @@
 package course_section
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/features/access"
+
+	"github.com/google/uuid"
+)
+
+// hasCourseAccess reports whether the user has the access level on the course, as its creator or through a grant.
+// It fails when the course doesn't exist.
+func hasCourseAccess(ctx context.Context, client *ent.Client, userId uuid.UUID, courseId uuid.UUID, level access.Level) (bool, error) {
+	if _, err := client.Course.Get(ctx, courseId); err != nil {
+		return false, err
+	}
+
+	return client.Course.Query().
+		Where(course.ID(courseId), access.CourseAccess(userId, level)).
+		Exist(ctx)
+}
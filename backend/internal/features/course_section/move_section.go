@@ -0,0 +1,205 @@
+package course_section
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/db"
+	"template/internal/features/access"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// MoveCourseSection moves a section under a new parent (or to the root when newParentId is nil) at the given position,
+// only if the user can edit the course. Siblings of both the old and the new parent are renumbered.
+func MoveCourseSection(ctx context.Context, userId uuid.UUID, sectionId uuid.UUID, newParentId *uuid.UUID, position int) (*ent.CourseSection, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := tx.CourseSection.Query().
+		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelEditor))).
+		First(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("course section not found or unauthorized"))
+	}
+
+	if newParentId != nil {
+		if err := validateNewParent(ctx, tx, section, *newParentId); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	oldParentId := section.SectionID
+
+	siblings, err := querySiblings(ctx, tx, section.CourseID, newParentId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	siblings = slice.Filter(siblings, func(s *ent.CourseSection) bool {
+		return s.ID != sectionId
+	})
+
+	// Clamp the position into the range of the new siblings
+	if position < 0 {
+		position = 0
+	}
+	if position > len(siblings) {
+		position = len(siblings)
+	}
+
+	orderedIds := slice.Map(siblings, func(s *ent.CourseSection) uuid.UUID {
+		return s.ID
+	})
+	orderedIds = append(orderedIds[:position], append([]uuid.UUID{sectionId}, orderedIds[position:]...)...)
+
+	update := tx.CourseSection.UpdateOneID(sectionId)
+	if newParentId != nil {
+		update.SetSectionID(*newParentId)
+	} else {
+		update.ClearSectionID()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := renumberSections(ctx, tx, orderedIds); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	// Close the gap left in the old parent when the section changed parent
+	if !isSameParent(oldParentId, newParentId) {
+		oldSiblings, err := querySiblings(ctx, tx, section.CourseID, oldParentId)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		oldSiblingIds := slice.Map(oldSiblings, func(s *ent.CourseSection) uuid.UUID {
+			return s.ID
+		})
+		if err := renumberSections(ctx, tx, oldSiblingIds); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	movedSection, err := tx.CourseSection.Get(ctx, sectionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return movedSection, nil
+}
+
+// ReorderCourseSections renumbers the children of a parent section (or the root sections of the course when parentId is nil)
+// following orderedIds, only if the user can edit the course. orderedIds must contain every sibling exactly once.
+func ReorderCourseSections(ctx context.Context, userId uuid.UUID, courseId uuid.UUID, parentId *uuid.UUID, orderedIds []uuid.UUID) ([]*ent.CourseSection, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canEdit, err := hasCourseAccess(ctx, tx.Client(), userId, courseId, access.LevelEditor)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !canEdit {
+		return nil, db.Rollback(tx, errors.New("unauthorized: only the course creator or an editor can reorder sections"))
+	}
+
+	if slice.HasDuplicates(orderedIds) {
+		return nil, db.Rollback(tx, errors.New("orderedIds must not contain duplicates"))
+	}
+
+	siblings, err := querySiblings(ctx, tx, courseId, parentId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if len(siblings) != len(orderedIds) || !slice.Every(siblings, func(s *ent.CourseSection) bool {
+		return slice.Contains(orderedIds, s.ID)
+	}) {
+		return nil, db.Rollback(tx, errors.New("orderedIds must contain every section of the parent exactly once"))
+	}
+
+	if err := renumberSections(ctx, tx, orderedIds); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	sections, err := querySiblings(ctx, tx, courseId, parentId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// validateNewParent checks that the new parent is a root section of the same course and that the section has no children,
+// sections are nested one level deep so moving a section can't create a cycle.
+func validateNewParent(ctx context.Context, tx *ent.Tx, section *ent.CourseSection, newParentId uuid.UUID) error {
+	if newParentId == section.ID {
+		return errors.New("the section cannot be the child of itself")
+	}
+
+	parentSection, err := tx.CourseSection.Get(ctx, newParentId)
+	if err != nil {
+		return errors.New("invalid sectionId: section not found")
+	}
+	if parentSection.CourseID != section.CourseID {
+		return errors.New("sectionId must belong to the same course")
+	}
+
+	if parentSection.SectionID != nil {
+		return errors.New("the section cannot be the child of a non-root section")
+	}
+
+	hasChildren, err := tx.CourseSection.Query().
+		Where(coursesection.SectionID(section.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if hasChildren {
+		return errors.New("a section with children cannot be moved under another section")
+	}
+
+	return nil
+}
+
+// querySiblings returns the sections sharing the same parent, ordered by their current order.
+func querySiblings(ctx context.Context, tx *ent.Tx, courseId uuid.UUID, parentId *uuid.UUID) ([]*ent.CourseSection, error) {
+	query := tx.CourseSection.Query().Where(coursesection.CourseID(courseId))
+	if parentId != nil {
+		query = query.Where(coursesection.SectionID(*parentId))
+	} else {
+		query = query.Where(coursesection.SectionIDIsNil())
+	}
+
+	return query.Order(ent.Asc(coursesection.FieldOrder), ent.Asc(coursesection.FieldCreatedAt)).All(ctx)
+}
+
+// renumberSections sets the order of each section to its index in sectionIds.
+func renumberSections(ctx context.Context, tx *ent.Tx, sectionIds []uuid.UUID) error {
+	for i, id := range sectionIds {
+		if err := tx.CourseSection.UpdateOneID(id).SetOrder(i).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSameParent(a *uuid.UUID, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
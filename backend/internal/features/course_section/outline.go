@@ -0,0 +1,62 @@
+package course_section
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/db"
+	courseFeat "template/internal/features/course"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// GetCourseOutline returns the full section tree of a course, with every level sorted by order.
+// The sections are fetched in a single query and assembled in memory.
+func GetCourseOutline(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, courseId uuid.UUID) ([]*model.CourseOutlineSection, error) {
+	_, err := courseFeat.GetVisibleCourseByID(ctx, userId, courseId, isAdminOrOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := client.CourseSection.Query().
+		Where(coursesection.CourseID(courseId)).
+		Order(ent.Asc(coursesection.FieldOrder), ent.Asc(coursesection.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[uuid.UUID]*model.CourseOutlineSection, len(sections))
+	for _, section := range sections {
+		nodes[section.ID] = &model.CourseOutlineSection{
+			ID:          section.ID,
+			Title:       section.Title,
+			Description: section.Description,
+			CourseID:    section.CourseID,
+			SectionID:   section.SectionID,
+			Order:       section.Order,
+			Children:    []*model.CourseOutlineSection{},
+		}
+	}
+
+	// Sections are already sorted, so appending keeps every level in order
+	roots := []*model.CourseOutlineSection{}
+	for _, section := range sections {
+		node := nodes[section.ID]
+		if section.SectionID == nil {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[*section.SectionID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return roots, nil
+}
@@ -0,0 +1,235 @@
+package course_section
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/coursesectionprerequisite"
+	"template/internal/ent/db"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/features/access"
+	courseFeat "template/internal/features/course"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// SetSectionPrerequisites replaces the prerequisites of a section, only if the user can edit the course.
+// Prerequisite sections must belong to the same course and must not create a cycle.
+func SetSectionPrerequisites(ctx context.Context, userId uuid.UUID, sectionId uuid.UUID, input []*model.SectionPrerequisiteInput) ([]*ent.CourseSectionPrerequisite, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := tx.CourseSection.Query().
+		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelEditor))).
+		First(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("course section not found or unauthorized"))
+	}
+
+	prerequisiteIds := slice.Map(input, func(i *model.SectionPrerequisiteInput) uuid.UUID {
+		return i.PrerequisiteSectionID
+	})
+	if slice.HasDuplicates(prerequisiteIds) {
+		return nil, db.Rollback(tx, errors.New("prerequisite sections must not contain duplicates"))
+	}
+	if slice.Contains(prerequisiteIds, sectionId) {
+		return nil, db.Rollback(tx, errors.New("a section cannot be a prerequisite of itself"))
+	}
+
+	sameCourseCount, err := tx.CourseSection.Query().
+		Where(coursesection.IDIn(prerequisiteIds...), coursesection.CourseID(section.CourseID)).
+		Count(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if sameCourseCount != len(prerequisiteIds) {
+		return nil, db.Rollback(tx, errors.New("prerequisite sections must belong to the same course"))
+	}
+
+	for _, i := range input {
+		if i.MinScorePercent != nil && (*i.MinScorePercent < 0 || *i.MinScorePercent > 100) {
+			return nil, db.Rollback(tx, errors.New("minScorePercent must be between 0 and 100"))
+		}
+	}
+
+	_, err = tx.CourseSectionPrerequisite.Delete().
+		Where(coursesectionprerequisite.SectionID(sectionId)).
+		Exec(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := validateNoPrerequisiteCycle(ctx, tx, section.CourseID, sectionId, prerequisiteIds); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	creates := slice.Map(input, func(i *model.SectionPrerequisiteInput) *ent.CourseSectionPrerequisiteCreate {
+		return tx.CourseSectionPrerequisite.Create().
+			SetSectionID(sectionId).
+			SetPrerequisiteSectionID(i.PrerequisiteSectionID).
+			SetNillableMinScorePercent(i.MinScorePercent)
+	})
+	prerequisites, err := tx.CourseSectionPrerequisite.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return prerequisites, nil
+}
+
+// GetSectionPrerequisites returns the prerequisites of a section visible to the user.
+func GetSectionPrerequisites(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sectionId uuid.UUID) ([]*ent.CourseSectionPrerequisite, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := client.CourseSection.Get(ctx, sectionId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := courseFeat.GetVisibleCourseByID(ctx, userId, section.CourseID, isAdminOrOwner); err != nil {
+		return nil, err
+	}
+
+	return client.CourseSectionPrerequisite.Query().
+		Where(coursesectionprerequisite.SectionID(sectionId)).
+		All(ctx)
+}
+
+// IsSectionUnlocked reports whether the user has met every prerequisite of a section.
+func IsSectionUnlocked(ctx context.Context, client *ent.Client, userId uuid.UUID, sectionId uuid.UUID) (bool, error) {
+	unlocked, err := getUnlockedSections(ctx, client, userId, []uuid.UUID{sectionId})
+	if err != nil {
+		return false, err
+	}
+	return unlocked[sectionId], nil
+}
+
+// GetUnlockedSections reports for each section whether the user has met every prerequisite of it.
+func GetUnlockedSections(ctx context.Context, userId uuid.UUID, sectionIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return getUnlockedSections(ctx, client, userId, sectionIds)
+}
+
+// getUnlockedSections reports for each section whether the user has met every prerequisite of it.
+// A prerequisite is met when each test of the prerequisite section has a completed session for the user
+// whose score reaches the minimum score. Prerequisite sections without tests are always met.
+func getUnlockedSections(ctx context.Context, client *ent.Client, userId uuid.UUID, sectionIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	unlocked := make(map[uuid.UUID]bool, len(sectionIds))
+	for _, sectionId := range sectionIds {
+		unlocked[sectionId] = true
+	}
+
+	prerequisites, err := client.CourseSectionPrerequisite.Query().
+		Where(coursesectionprerequisite.SectionIDIn(sectionIds...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(prerequisites) == 0 {
+		return unlocked, nil
+	}
+
+	prerequisiteSectionIds := slice.Unique(slice.Map(prerequisites, func(p *ent.CourseSectionPrerequisite) uuid.UUID {
+		return p.PrerequisiteSectionID
+	}))
+
+	tests, err := client.Test.Query().
+		Where(test.CourseSectionIDIn(prerequisiteSectionIds...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tests) == 0 {
+		return unlocked, nil
+	}
+
+	testIds := slice.Map(tests, func(t *ent.Test) uuid.UUID {
+		return t.ID
+	})
+	sessions, err := client.TestSession.Query().
+		Where(
+			testsession.UserID(userId),
+			testsession.TestIDIn(testIds...),
+			testsession.StatusEQ(testsession.StatusCompleted),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prerequisite := range prerequisites {
+		if !unlocked[prerequisite.SectionID] {
+			continue
+		}
+		sectionTests := slice.Filter(tests, func(t *ent.Test) bool {
+			return t.CourseSectionID != nil && *t.CourseSectionID == prerequisite.PrerequisiteSectionID
+		})
+		for _, t := range sectionTests {
+			passed := slice.Some(sessions, func(s *ent.TestSession) bool {
+				return s.TestID == t.ID && hasReachedMinScore(s, prerequisite.MinScorePercent)
+			})
+			if !passed {
+				unlocked[prerequisite.SectionID] = false
+				break
+			}
+		}
+	}
+
+	return unlocked, nil
+}
+
+// hasReachedMinScore reports whether a completed session reaches the minimum score in percent.
+func hasReachedMinScore(session *ent.TestSession, minScorePercent *int) bool {
+	if minScorePercent == nil || session.MaxPoints == 0 {
+		return true
+	}
+	return session.PointsEarned*100 >= *minScorePercent*session.MaxPoints
+}
+
+// validateNoPrerequisiteCycle makes sure none of the new prerequisites depends, directly or not, on the section itself.
+func validateNoPrerequisiteCycle(ctx context.Context, tx *ent.Tx, courseId uuid.UUID, sectionId uuid.UUID, prerequisiteIds []uuid.UUID) error {
+	existing, err := tx.CourseSectionPrerequisite.Query().
+		Where(coursesectionprerequisite.HasSectionWith(coursesection.CourseID(courseId))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	graph := make(map[uuid.UUID][]uuid.UUID)
+	for _, p := range existing {
+		graph[p.SectionID] = append(graph[p.SectionID], p.PrerequisiteSectionID)
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	stack := append([]uuid.UUID{}, prerequisiteIds...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == sectionId {
+			return errors.New("prerequisites must not create a cycle")
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		stack = append(stack, graph[current]...)
+	}
+
+	return nil
+}
@@ -3,14 +3,18 @@ package course_section
 import (
 	"context"
 	"errors"
-	"template/internal/ent/course"
+	"template/internal/ent"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
+	"template/internal/features/access"
+	"template/internal/features/trash"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
 
 	"github.com/google/uuid"
 )
 
-// RemoveCourseSection deletes a course section and all its children, only if the user is the course creator.
+// RemoveCourseSection moves a course section and all its children to the trash, only if the user can edit the course.
 func RemoveCourseSection(ctx context.Context, userId uuid.UUID, sectionId uuid.UUID) (bool, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
@@ -18,39 +22,33 @@ func RemoveCourseSection(ctx context.Context, userId uuid.UUID, sectionId uuid.U
 	}
 
 	// Validate root section ownership
-	exists, err := tx.CourseSection.Query().
-		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(course.CreatorID(userId))).
-		Exist(ctx)
+	section, err := tx.CourseSection.Query().
+		Where(coursesection.ID(sectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelEditor))).
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, db.Rollback(tx, errors.New("course section not found or unauthorized"))
+		}
 		return false, db.Rollback(tx, err)
 	}
-	if !exists {
-		return false, db.Rollback(tx, errors.New("course section not found or unauthorized"))
-	}
 
-	// Fetch children sections
-	children, err := tx.CourseSection.Query().
-		Where(coursesection.SectionID(sectionId)).
-		IDs(ctx)
+	// Move the section to the trash with its children and tests, the sessions of the tests are kept
+	err = trash.SoftDelete(ctx, tx.Client(), model.TrashEntityTypeCourseSection, sectionId)
 	if err != nil {
 		return false, db.Rollback(tx, err)
 	}
 
-	// Batch delete children sections
-	if len(children) > 0 {
-		_, err = tx.CourseSection.Delete().
-			Where(coursesection.IDIn(children...)).
-			Exec(ctx)
-		if err != nil {
-			return false, db.Rollback(tx, err)
-		}
-	}
-
-	// Delete root section
-	err = tx.CourseSection.DeleteOneID(sectionId).Exec(ctx)
+	// Close the gap left among the remaining siblings
+	siblings, err := querySiblings(ctx, tx, section.CourseID, section.SectionID)
 	if err != nil {
 		return false, db.Rollback(tx, err)
 	}
+	siblingIds := slice.Map(siblings, func(s *ent.CourseSection) uuid.UUID {
+		return s.ID
+	})
+	if err := renumberSections(ctx, tx, siblingIds); err != nil {
+		return false, db.Rollback(tx, err)
+	}
 
 	// Commit transaction
 	err = tx.Commit()
@@ -7,6 +7,7 @@ import (
 	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
@@ -21,19 +22,19 @@ func GetCourseSectionsByCourseID(ctx context.Context, userId uuid.UUID, courseId
 		return nil, err
 	}
 
-	// Check if the user is the course creator
-	crs, err := client.Course.Query().Select(course.FieldCreatorID).Where(course.ID(courseId)).First(ctx)
+	// Check if the user can view the course
+	canView, err := hasCourseAccess(ctx, client, userId, courseId, access.LevelViewer)
 	if err != nil {
 		return nil, err
 	}
-	if crs.CreatorID != userId {
-		return nil, errors.New("unauthorized: only the course creator can view sections")
+	if !canView {
+		return nil, errors.New("unauthorized: only the course creator or a user it is shared with can view sections")
 	}
 
 	// Build the query
 	query := client.CourseSection.Query().
 		Where(
-			coursesection.HasCourseWith(course.ID(courseId), course.CreatorID(userId)),
+			coursesection.HasCourseWith(course.ID(courseId), access.CourseAccess(userId, access.LevelViewer)),
 		)
 
 	// Apply filter if provided
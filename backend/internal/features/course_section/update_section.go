@@ -4,22 +4,23 @@ import (
 	"context"
 	"errors"
 	"template/internal/ent"
-	"template/internal/ent/course"
 	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
+	"template/internal/features/access"
 	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
 
 	"github.com/google/uuid"
 )
 
-// UpdateCourseSection updates a course section, only if the user is the course creator.
+// UpdateCourseSection updates a course section, only if the user can edit the course.
 func UpdateCourseSection(ctx context.Context, userId uuid.UUID, sectionId uuid.UUID, input model.UpdateCourseSectionInput) (*ent.CourseSection, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	section, err := tx.CourseSection.Query().Where(coursesection.ID(sectionId), coursesection.HasCourseWith(course.CreatorID(userId))).First(ctx)
+	section, err := tx.CourseSection.Query().Where(coursesection.ID(sectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelEditor))).First(ctx)
 	if err != nil {
 		return nil, db.Rollback(tx, err)
 	}
@@ -56,15 +57,40 @@ func UpdateCourseSection(ctx context.Context, userId uuid.UUID, sectionId uuid.U
 		}
 	}
 
-	section, err = section.Update().
+	oldParentId := section.SectionID
+	update := section.Update().
 		SetNillableTitle(input.Title).
 		SetNillableDescription(input.Description).
-		SetNillableSectionID(input.SectionID).
-		Save(ctx)
+		SetNillableSectionID(input.SectionID)
+
+	// Append the section at the end of its new siblings when the parent changes
+	parentChanged := input.SectionID != nil && !isSameParent(oldParentId, input.SectionID)
+	if parentChanged {
+		newSiblings, err := querySiblings(ctx, tx, section.CourseID, input.SectionID)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		update.SetOrder(len(newSiblings))
+	}
+
+	section, err = update.Save(ctx)
 	if err != nil {
 		return nil, db.Rollback(tx, err)
 	}
 
+	if parentChanged {
+		oldSiblings, err := querySiblings(ctx, tx, section.CourseID, oldParentId)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		oldSiblingIds := slice.Map(oldSiblings, func(s *ent.CourseSection) uuid.UUID {
+			return s.ID
+		})
+		if err := renumberSections(ctx, tx, oldSiblingIds); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, db.Rollback(tx, err)
 	}
@@ -0,0 +1,263 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entGroup "template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/ent/user"
+	"template/internal/features/access"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssignTestToGroup assigns a test to a group and creates a test session for each of its members,
+// only if the user manages the group and can edit the test, or is an admin.
+func AssignTestToGroup(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.AssignTestToGroupInput) (*ent.GroupTestAssignment, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, input.GroupID); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if err := checkCanAssignTest(ctx, tx.Client(), userId, isAdminOrOwner, input.TestID); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	exists, err := tx.GroupTestAssignment.Query().
+		Where(grouptestassignment.GroupID(input.GroupID), grouptestassignment.TestID(input.TestID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if exists {
+		return nil, db.Rollback(tx, errors.New("the test is already assigned to the group"))
+	}
+
+	assignment, err := tx.GroupTestAssignment.Create().
+		SetGroupID(input.GroupID).
+		SetTestID(input.TestID).
+		SetAssignedByID(userId).
+		SetIncludeLaterMembers(input.IncludeLaterMembers != nil && *input.IncludeLaterMembers).
+		SetNillableExpiredAt(input.ExpiredTime).
+		SetNillableOpenAt(input.OpenAt).
+		SetNillableCloseAt(input.CloseAt).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	memberIds, err := tx.Group.Query().
+		Where(entGroup.ID(input.GroupID)).
+		QueryMembers().
+		IDs(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := createAssignmentSessions(ctx, tx, assignment, memberIds, assignment.ExpiredAt); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// UnassignTestFromGroup removes the assignment of a test to a group, only if the user manages the group or is an admin.
+// The sessions already created are kept.
+func UnassignTestFromGroup(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, assignmentId uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	assignment, err := tx.GroupTestAssignment.Get(ctx, assignmentId)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("assignment not found"))
+	}
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, assignment.GroupID); err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	err = tx.GroupTestAssignment.DeleteOneID(assignmentId).Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetGroupTestAssignments fetches the tests assigned to a group, only if the user created it, is a member of it or is an admin.
+func GetGroupTestAssignments(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID) ([]*ent.GroupTestAssignment, error) {
+	if _, err := GetGroupByID(ctx, userId, isAdminOrOwner, groupId); err != nil {
+		return nil, err
+	}
+
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GroupTestAssignment.Query().
+		Where(grouptestassignment.GroupID(groupId)).
+		Order(ent.Desc(grouptestassignment.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetGroupTestResultSummary summarizes the results of the sessions created for an assignment,
+// only if the user manages the group or is an admin.
+func GetGroupTestResultSummary(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, assignmentId uuid.UUID) (*model.GroupTestResultSummary, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := client.GroupTestAssignment.Get(ctx, assignmentId)
+	if err != nil {
+		return nil, errors.New("assignment not found")
+	}
+	if err := checkCanManageGroup(ctx, client, userId, isAdminOrOwner, assignment.GroupID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := client.TestSession.Query().
+		Where(testsession.GroupAssignmentID(assignmentId)).
+		Order(testsession.ByUserField(user.FieldEmail)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &model.GroupTestResultSummary{
+		Assignment:   model.ConvertGroupTestAssignmentToModel(assignment),
+		SessionCount: len(sessions),
+		Sessions:     slice.Map(sessions, model.ConvertTestSessionToModel),
+	}
+
+	scores := []float64{}
+	for _, session := range sessions {
+		switch session.Status {
+		case testsession.StatusPending:
+			summary.PendingCount++
+		case testsession.StatusInProgress:
+			summary.InProgressCount++
+		case testsession.StatusCompleted:
+			summary.CompletedCount++
+			if session.MaxPoints > 0 {
+				scores = append(scores, float64(session.PointsEarned)*100/float64(session.MaxPoints))
+			}
+		case testsession.StatusExpired, testsession.StatusCancelled:
+			summary.MissedCount++
+		}
+	}
+
+	if len(scores) > 0 {
+		total, highest, lowest := 0.0, scores[0], scores[0]
+		for _, score := range scores {
+			total += score
+			highest = max(highest, score)
+			lowest = min(lowest, score)
+		}
+		average := total / float64(len(scores))
+		summary.AverageScorePercent = &average
+		summary.HighestScorePercent = &highest
+		summary.LowestScorePercent = &lowest
+	}
+
+	return summary, nil
+}
+
+// createSessionsForLaterMembers creates the sessions of the new members of a group for the assignments including later members.
+func createSessionsForLaterMembers(ctx context.Context, tx *ent.Tx, groupId uuid.UUID, memberIds []uuid.UUID) error {
+	if len(memberIds) == 0 {
+		return nil
+	}
+
+	assignments, err := tx.GroupTestAssignment.Query().
+		Where(grouptestassignment.GroupID(groupId), grouptestassignment.IncludeLaterMembers(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		if err := createAssignmentSessions(ctx, tx, assignment, memberIds, laterMemberExpiredAt(assignment)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// laterMemberExpiredAt returns the expiry time of the session of a member added after the assignment,
+// who gets the same time span from now as the members had from the assignment.
+func laterMemberExpiredAt(assignment *ent.GroupTestAssignment) *time.Time {
+	if assignment.ExpiredAt == nil {
+		return nil
+	}
+	expiredAt := time.Now().Add(assignment.ExpiredAt.Sub(assignment.CreatedAt))
+	return &expiredAt
+}
+
+// createAssignmentSessions creates the sessions of an assignment expiring at expiredAt for the members who don't have one yet,
+// e.g. a member removed from the group and added again.
+func createAssignmentSessions(ctx context.Context, tx *ent.Tx, assignment *ent.GroupTestAssignment, memberIds []uuid.UUID, expiredAt *time.Time) error {
+	existingUserIds, err := tx.TestSession.Query().
+		Where(testsession.GroupAssignmentID(assignment.ID), testsession.UserIDIn(memberIds...)).
+		QueryUser().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	userIds := slice.Filter(memberIds, func(id uuid.UUID) bool {
+		return !slice.Contains(existingUserIds, id)
+	})
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	_, err = test_session.CreateSessions(ctx, tx, model.CreateTestSessionInput{
+		TestID:      assignment.TestID,
+		UserIds:     userIds,
+		ExpiredTime: expiredAt,
+		OpenAt:      assignment.OpenAt,
+		CloseAt:     assignment.CloseAt,
+	}, &assignment.ID)
+	return err
+}
+
+// checkCanAssignTest makes sure the user is an admin or can edit the test.
+func checkCanAssignTest(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID) error {
+	query := client.Test.Query().Where(test.ID(testId))
+	if !isAdminOrOwner {
+		query = query.Where(access.TestAccess(userId, access.LevelEditor))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("test not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entGroup "template/internal/ent/group"
+	"template/internal/ent/user"
+	"template/internal/features/access"
+	"template/internal/features/common"
+	"template/internal/features/trash"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// CreateGroup creates a new group with the given input and userId as the creator.
+func CreateGroup(ctx context.Context, userId uuid.UUID, input model.CreateGroupInput) (*ent.Group, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := InsertGroup(ctx, tx, userId, input)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// InsertGroup creates a group within a transaction with userId as the creator.
+func InsertGroup(ctx context.Context, tx *ent.Tx, userId uuid.UUID, input model.CreateGroupInput) (*ent.Group, error) {
+	memberIds, err := getExistingUserIds(ctx, tx.Client(), input.MemberIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Group.Create().
+		SetName(input.Name).
+		SetNillableDescription(input.Description).
+		SetCreatorID(userId).
+		AddMemberIDs(memberIds...).
+		Save(ctx)
+}
+
+// GetGroupByID fetches a group by its ID, only if the user created it, is a member of it or is an admin.
+func GetGroupByID(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID) (*ent.Group, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := client.Group.Query().Where(entGroup.ID(groupId))
+	if !isAdminOrOwner {
+		query = query.Where(access.GroupAccess(userId))
+	}
+
+	group, err := query.Only(ctx)
+	if err != nil {
+		return nil, errors.New("group not found or unauthorized")
+	}
+	return group, nil
+}
+
+// UpdateGroup updates the name and description of a group, only if the user created it or is an admin.
+func UpdateGroup(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID, input model.UpdateGroupInput) (*ent.Group, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, groupId); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	group, err := tx.Group.UpdateOneID(groupId).
+		SetNillableName(input.Name).
+		SetNillableDescription(input.Description).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// DeleteGroup moves a group to the trash with its test assignments, only if the user created it or is an admin.
+// The grants shared with the group no longer give access until it is restored.
+func DeleteGroup(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, groupId); err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	err = trash.SoftDelete(ctx, tx.Client(), model.TrashEntityTypeGroup, groupId)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddGroupMembers adds users to a group, only if the user created it or is an admin. Existing members are kept,
+// the new members get a test session for the tests assigned to the group including later members.
+func AddGroupMembers(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID, userIds []uuid.UUID) (*ent.Group, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := InsertGroupMembers(ctx, tx, userId, isAdminOrOwner, groupId, userIds); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	group, err := tx.Group.Get(ctx, groupId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// InsertGroupMembers adds users to a group within a transaction, only if the user created it or is an admin,
+// and creates the sessions of the assignments including later members. It returns the IDs of the new members.
+func InsertGroupMembers(ctx context.Context, tx *ent.Tx, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID, userIds []uuid.UUID) ([]uuid.UUID, error) {
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, groupId); err != nil {
+		return nil, err
+	}
+
+	memberIds, err := getExistingUserIds(ctx, tx.Client(), userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	existingMemberIds, err := tx.Group.Query().
+		Where(entGroup.ID(groupId)).
+		QueryMembers().
+		Where(user.IDIn(memberIds...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newMemberIds := slice.Filter(memberIds, func(id uuid.UUID) bool {
+		return !slice.Contains(existingMemberIds, id)
+	})
+	if len(newMemberIds) == 0 {
+		return newMemberIds, nil
+	}
+
+	if err := tx.Group.UpdateOneID(groupId).AddMemberIDs(newMemberIds...).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := createSessionsForLaterMembers(ctx, tx, groupId, newMemberIds); err != nil {
+		return nil, err
+	}
+
+	return newMemberIds, nil
+}
+
+// RemoveGroupMembers removes users from a group, only if the user created it or is an admin.
+func RemoveGroupMembers(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID, userIds []uuid.UUID) (*ent.Group, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanManageGroup(ctx, tx.Client(), userId, isAdminOrOwner, groupId); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	group, err := tx.Group.UpdateOneID(groupId).
+		RemoveMemberIDs(slice.Unique(userIds)...).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// PaginatedGroups returns a paginated list of the groups the user created or is a member of.
+// Admins can see every group.
+func PaginatedGroups(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input *model.PaginationInput) (*common.PaginatedResult[*ent.Group], error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := client.Group.Query()
+	if !isAdminOrOwner {
+		query = query.Where(access.GroupAccess(userId))
+	}
+	if input != nil && input.Search != nil && *input.Search != "" {
+		query = query.Where(entGroup.NameContainsFold(*input.Search))
+	}
+
+	newInput := common.FallbackValue(input, common.DefaultPaginationInput)
+
+	return common.EntQueryPaginated(ctx, query, *newInput.Page, *newInput.Limit)
+}
+
+// GetMembersByGroupIDs fetches the members of multiple groups and returns a map with group ID as key and members as value
+func GetMembersByGroupIDs(ctx context.Context, groupIds []uuid.UUID) (map[uuid.UUID][]*ent.User, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := client.Group.Query().
+		Where(entGroup.IDIn(groupIds...)).
+		WithMembers(func(uq *ent.UserQuery) {
+			uq.Order(ent.Asc(user.FieldEmail))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMembersMap := make(map[uuid.UUID][]*ent.User)
+	for _, group := range groups {
+		groupMembersMap[group.ID] = group.Edges.Members
+	}
+
+	return groupMembersMap, nil
+}
+
+// checkCanManageGroup makes sure the user is an admin or the creator of the group.
+func checkCanManageGroup(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, groupId uuid.UUID) error {
+	query := client.Group.Query().Where(entGroup.ID(groupId))
+	if !isAdminOrOwner {
+		query = query.Where(entGroup.CreatorID(userId))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("group not found or unauthorized")
+	}
+
+	return nil
+}
+
+// getExistingUserIds returns the unique user IDs, failing when one of the users doesn't exist.
+func getExistingUserIds(ctx context.Context, client *ent.Client, userIds []uuid.UUID) ([]uuid.UUID, error) {
+	userIds = slice.Unique(userIds)
+	if len(userIds) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	count, err := client.User.Query().Where(user.IDIn(userIds...)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count != len(userIds) {
+		return nil, errors.New("one or more users not found")
+	}
+
+	return userIds, nil
+}
+
+// GetGroupsByIDs fetches multiple groups by their IDs.
+func GetGroupsByIDs(ctx context.Context, groupIds []uuid.UUID) ([]*ent.Group, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Group.Query().Where(entGroup.IDIn(groupIds...)).All(ctx)
+}
@@ -0,0 +1,251 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entOrganization "template/internal/ent/organization"
+	entRole "template/internal/ent/role"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/user"
+	"template/internal/features/common"
+	"template/internal/features/role"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// CreateOrganization creates a new organization, the slug must be unique.
+func CreateOrganization(ctx context.Context, input model.CreateOrganizationInput) (*ent.Organization, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSlugIsFree(ctx, tx.Client(), input.Slug, nil); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	organization, err := tx.Organization.Create().
+		SetName(input.Name).
+		SetSlug(input.Slug).
+		SetNillableDescription(input.Description).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// GetOrganizationByID fetches an organization by its ID.
+func GetOrganizationByID(ctx context.Context, organizationId uuid.UUID) (*ent.Organization, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := client.Organization.Get(ctx, organizationId)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+	return organization, nil
+}
+
+// GetUserOrganization fetches the organization of a user, nil when the user doesn't belong to any organization.
+func GetUserOrganization(ctx context.Context, userId uuid.UUID) (*ent.Organization, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := client.User.Query().
+		Where(user.ID(userId)).
+		QueryOrganization().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return organization, err
+}
+
+// UpdateOrganization updates the name, slug and description of an organization.
+func UpdateOrganization(ctx context.Context, organizationId uuid.UUID, input model.UpdateOrganizationInput) (*ent.Organization, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Slug != nil {
+		if err := checkSlugIsFree(ctx, tx.Client(), *input.Slug, &organizationId); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	organization, err := tx.Organization.UpdateOneID(organizationId).
+		SetNillableName(input.Name).
+		SetNillableSlug(input.Slug).
+		SetNillableDescription(input.Description).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, db.Rollback(tx, errors.New("organization not found"))
+		}
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// DeleteOrganization deletes an organization, only once no user belongs to it anymore.
+func DeleteOrganization(ctx context.Context, organizationId uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	hasUsers, err := tx.Organization.Query().
+		Where(entOrganization.ID(organizationId), entOrganization.HasUsers()).
+		Exist(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+	if hasUsers {
+		return false, db.Rollback(tx, errors.New("the organization still has users"))
+	}
+
+	err = tx.Organization.DeleteOneID(organizationId).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, db.Rollback(tx, errors.New("organization not found"))
+		}
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AssignOrganizationUsers moves users to an organization, optionally making them admins of it.
+// The resources they created before stay in their previous organization. Owners access every organization
+// and can't be assigned to one.
+func AssignOrganizationUsers(ctx context.Context, input model.AssignOrganizationUsersInput) (*ent.Organization, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := tx.Organization.Get(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("organization not found"))
+	}
+
+	userIds := slice.Unique(input.UserIds)
+	count, err := tx.User.Query().Where(user.IDIn(userIds...)).Count(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if count != len(userIds) {
+		return nil, db.Rollback(tx, errors.New("user not found"))
+	}
+
+	hasOwner, err := tx.User.Query().
+		Where(user.IDIn(userIds...), user.HasRolesWith(entRole.Name(role.RoleOwner))).
+		Exist(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if hasOwner {
+		return nil, db.Rollback(tx, errors.New("owners can't be assigned to an organization"))
+	}
+
+	err = tx.User.Update().
+		Where(user.IDIn(userIds...)).
+		SetOrganizationID(organization.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if input.AsAdmin != nil && *input.AsAdmin {
+		adminRole, err := tx.Role.Query().Where(entRole.Name(role.RoleAdmin)).Only(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+
+		err = tx.User.Update().
+			Where(user.IDIn(userIds...), user.Not(user.HasRolesWith(entRole.ID(adminRole.ID)))).
+			AddRoleIDs(adminRole.ID).
+			Exec(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	role.InvalidatePrincipals(userIds...)
+
+	return organization, nil
+}
+
+// PaginatedOrganizations returns a paginated list of the organizations.
+func PaginatedOrganizations(ctx context.Context, input *model.PaginationInput) (*common.PaginatedResult[*ent.Organization], error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := client.Organization.Query()
+	if input != nil && input.Search != nil && *input.Search != "" {
+		query = query.Where(entOrganization.NameContainsFold(*input.Search))
+	}
+
+	newInput := common.FallbackValue(input, common.DefaultPaginationInput)
+
+	return common.EntQueryPaginated(ctx, query, *newInput.Page, *newInput.Limit)
+}
+
+// GetOrganizationsByIDs fetches multiple organizations by their IDs.
+func GetOrganizationsByIDs(ctx context.Context, organizationIds []uuid.UUID) ([]*ent.Organization, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Organization.Query().
+		Where(entOrganization.IDIn(organizationIds...)).
+		All(ctx)
+}
+
+// checkSlugIsFree fails when another organization than organizationId already uses the slug.
+func checkSlugIsFree(ctx context.Context, client *ent.Client, slug string, organizationId *uuid.UUID) error {
+	query := client.Organization.Query().Where(entOrganization.Slug(slug))
+	if organizationId != nil {
+		query = query.Where(entOrganization.IDNEQ(*organizationId))
+	}
+
+	// The slugs are unique across the organizations the tenant doesn't see
+	exists, err := query.Exist(mixin.SkipTenant(ctx))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("organization slug already exists")
+	}
+	return nil
+}
@@ -54,6 +54,20 @@ const (
 	MediaRead            Permission = "MEDIA_READ"
 	MediaUpdate          Permission = "MEDIA_UPDATE"
 	MediaDelete          Permission = "MEDIA_DELETE"
+	RoleCreate           Permission = "ROLE_CREATE"
+	RoleRead             Permission = "ROLE_READ"
+	RoleUpdate           Permission = "ROLE_UPDATE"
+	RoleDelete           Permission = "ROLE_DELETE"
+	GroupCreate          Permission = "GROUP_CREATE"
+	GroupRead            Permission = "GROUP_READ"
+	GroupUpdate          Permission = "GROUP_UPDATE"
+	GroupDelete          Permission = "GROUP_DELETE"
+	OrganizationCreate   Permission = "ORGANIZATION_CREATE"
+	OrganizationRead     Permission = "ORGANIZATION_READ"
+	OrganizationUpdate   Permission = "ORGANIZATION_UPDATE"
+	OrganizationDelete   Permission = "ORGANIZATION_DELETE"
+	TrashRead            Permission = "TRASH_READ"
+	TrashRestore         Permission = "TRASH_RESTORE"
 )
 
 // AllPermissions is the list of permissions that are granted to the owner role. Default to all permissions.
@@ -97,6 +111,20 @@ var AllPermissions = []Permission{
 	MediaRead,
 	MediaUpdate,
 	MediaDelete,
+	RoleCreate,
+	RoleRead,
+	RoleUpdate,
+	RoleDelete,
+	GroupCreate,
+	GroupRead,
+	GroupUpdate,
+	GroupDelete,
+	OrganizationCreate,
+	OrganizationRead,
+	OrganizationUpdate,
+	OrganizationDelete,
+	TrashRead,
+	TrashRestore,
 }
 
 // GetPermissionsByUserIDs fetches permissions for multiple users and returns a map with user ID as key and permissions array as value
@@ -0,0 +1,43 @@
+package question
+
+import (
+	"context"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/access"
+
+	"github.com/google/uuid"
+)
+
+// GetAnswerKeyAccess reports for each question whether the user can see its answer key, which is the case
+// for admins and for the creator and the editors of the question's collection. Deleted questions are included.
+func GetAnswerKeyAccess(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, questionIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	canView := make(map[uuid.UUID]bool, len(questionIds))
+	if isAdminOrOwner {
+		for _, questionId := range questionIds {
+			canView[questionId] = true
+		}
+		return canView, nil
+	}
+
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	editableIds, err := client.Question.Query().
+		Where(
+			question.IDIn(questionIds...),
+			question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)),
+		).
+		IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, questionId := range editableIds {
+		canView[questionId] = true
+	}
+	return canView, nil
+}
@@ -7,23 +7,25 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
-	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/features/access"
 
 	"github.com/google/uuid"
 )
 
 // ExportedQuestion represents a question in the AI Quiz JSON format
 type ExportedQuestion struct {
-	Question string           `json:"question"`
-	Points   int              `json:"points"`
-	Options  []ExportedOption `json:"options"`
+	Question    string           `json:"question"`
+	Points      int              `json:"points"`
+	Explanation *string          `json:"explanation,omitempty"`
+	Options     []ExportedOption `json:"options"`
 }
 
 // ExportedOption represents an option in the AI Quiz JSON format
 type ExportedOption struct {
-	Text    string `json:"text"`
-	Correct bool   `json:"correct"`
+	Text        string  `json:"text"`
+	Correct     bool    `json:"correct"`
+	Explanation *string `json:"explanation,omitempty"`
 }
 
 // ExportQuestions exports questions by their IDs in the AI Quiz JSON format
@@ -37,11 +39,11 @@ func ExportQuestions(ctx context.Context, userId uuid.UUID, questionIDs []uuid.U
 		return "", err
 	}
 
-	// Fetch questions with their options, ensuring user has access through collection ownership
+	// Fetch questions with their options, ensuring user can edit their collections
 	questions, err := client.Question.Query().
 		Where(
 			question.IDIn(questionIDs...),
-			question.HasCollectionWith(questioncollection.CreatorID(userId)),
+			question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)),
 		).
 		WithQuestionOptions(func(q *ent.QuestionOptionQuery) {
 			q.Order(ent.Asc(questionoption.FieldCreatedAt))
@@ -62,15 +64,17 @@ func ExportQuestions(ctx context.Context, userId uuid.UUID, questionIDs []uuid.U
 		exportedOptions := make([]ExportedOption, 0, len(q.Edges.QuestionOptions))
 		for _, opt := range q.Edges.QuestionOptions {
 			exportedOptions = append(exportedOptions, ExportedOption{
-				Text:    opt.OptionText,
-				Correct: opt.IsCorrect,
+				Text:        opt.OptionText,
+				Correct:     opt.IsCorrect,
+				Explanation: opt.Explanation,
 			})
 		}
 
 		exportedQuestions = append(exportedQuestions, ExportedQuestion{
-			Question: q.QuestionText,
-			Points:   q.Points,
-			Options:  exportedOptions,
+			Question:    q.QuestionText,
+			Points:      q.Points,
+			Explanation: q.Explanation,
+			Options:     exportedOptions,
 		})
 	}
 
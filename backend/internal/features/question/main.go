@@ -8,7 +8,9 @@ import (
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/features/access"
 	"template/internal/features/common"
+	"template/internal/features/trash"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -26,7 +28,7 @@ func CreateQuestion(ctx context.Context, userId uuid.UUID, input model.CreateQue
 	collection, err := tx.QuestionCollection.Query().
 		Where(
 			questioncollection.ID(input.QuestionCollectionID),
-			questioncollection.CreatorID(userId),
+			access.QuestionCollectionAccess(userId, access.LevelEditor),
 		).
 		Only(ctx)
 	if err != nil {
@@ -38,6 +40,7 @@ func CreateQuestion(ctx context.Context, userId uuid.UUID, input model.CreateQue
 		SetQuestionText(input.QuestionText).
 		SetCollectionID(collection.ID).
 		SetPoints(input.Points).
+		SetNillableExplanation(input.Explanation).
 		Save(ctx)
 	if err != nil {
 		return nil, db.Rollback(tx, err)
@@ -49,6 +52,7 @@ func CreateQuestion(ctx context.Context, userId uuid.UUID, input model.CreateQue
 			return tx.QuestionOption.Create().
 				SetOptionText(option.OptionText).
 				SetIsCorrect(option.IsCorrect).
+				SetNillableExplanation(option.Explanation).
 				SetQuestionID(newQuestion.ID)
 		})
 
@@ -58,6 +62,11 @@ func CreateQuestion(ctx context.Context, userId uuid.UUID, input model.CreateQue
 		}
 	}
 
+	_, err = RecordQuestionVersions(ctx, tx.Client(), &userId, []uuid.UUID{newQuestion.ID})
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
 	// Commit the transaction
 	if err := tx.Commit(); err != nil {
 		return nil, db.Rollback(tx, err)
@@ -66,7 +75,7 @@ func CreateQuestion(ctx context.Context, userId uuid.UUID, input model.CreateQue
 	return newQuestion, nil
 }
 
-// GetQuestionByID fetches a question by its ID.
+// GetQuestionByID fetches a question by its ID, only if the user can view its collection.
 func GetQuestionByID(ctx context.Context, userId uuid.UUID, questionID uuid.UUID) (*ent.Question, error) {
 	client, err := db.OpenClient()
 	if err != nil {
@@ -74,7 +83,7 @@ func GetQuestionByID(ctx context.Context, userId uuid.UUID, questionID uuid.UUID
 	}
 
 	q, err := client.Question.Query().
-		Where(question.ID(questionID), question.HasCollectionWith(questioncollection.CreatorID(userId))).
+		Where(question.ID(questionID), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelViewer))).
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -106,9 +115,9 @@ func DeleteQuestion(ctx context.Context, userId uuid.UUID, questionID uuid.UUID)
 		return false, err
 	}
 
-	// Verify the question exists and user has access to it
+	// Verify the question exists and user can edit its collection
 	exists, err := client.Question.Query().
-		Where(question.ID(questionID), question.HasCollectionWith(questioncollection.CreatorID(userId))).
+		Where(question.ID(questionID), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor))).
 		Exist(ctx)
 	if err != nil {
 		return false, err
@@ -118,8 +127,8 @@ func DeleteQuestion(ctx context.Context, userId uuid.UUID, questionID uuid.UUID)
 		return false, errors.New("question not found or you don't have access to it")
 	}
 
-	// Delete the question
-	err = client.Question.DeleteOneID(questionID).Exec(ctx)
+	// Move the question to the trash so the answers and versions of past sessions are retained
+	err = trash.SoftDelete(ctx, client, model.TrashEntityTypeQuestion, questionID)
 	if err != nil {
 		return false, err
 	}
@@ -139,10 +148,10 @@ func PaginatedQuestions(ctx context.Context, userId uuid.UUID, input *model.Pagi
 
 	// Filter by questions that are either:
 	// 1. Not associated with any collection
-	// 2. Associated with a collection created by the user
+	// 2. Associated with a collection created by the user or shared with them
 	query = query.Where(
 		question.HasCollectionWith(
-			questioncollection.CreatorID(userId),
+			access.QuestionCollectionAccess(userId, access.LevelViewer),
 		),
 	)
 
@@ -0,0 +1,109 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/questionoption"
+
+	"github.com/google/uuid"
+)
+
+// OptionInput is an option of an edited question, an option with an ID updates that option in place
+type OptionInput struct {
+	ID          *uuid.UUID
+	OptionText  string
+	IsCorrect   bool
+	Explanation *string
+}
+
+// ReplaceQuestionOptions sets the options of each question to the given ones. Existing options are kept and updated,
+// matched by ID or else by their text, so answers and versions that reference them keep pointing at the same option.
+// Options that are no longer listed are deleted.
+func ReplaceQuestionOptions(ctx context.Context, tx *ent.Tx, questionOptions map[uuid.UUID][]OptionInput) error {
+	questionIds := make([]uuid.UUID, 0, len(questionOptions))
+	for questionId := range questionOptions {
+		questionIds = append(questionIds, questionId)
+	}
+
+	existingOptions, err := tx.QuestionOption.Query().
+		Where(questionoption.QuestionIDIn(questionIds...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	existingByQuestion := make(map[uuid.UUID][]*ent.QuestionOption)
+	for _, option := range existingOptions {
+		existingByQuestion[option.QuestionID] = append(existingByQuestion[option.QuestionID], option)
+	}
+
+	var optionCreateQueries []*ent.QuestionOptionCreate
+	var optionIdsToDelete []uuid.UUID
+	for questionId, options := range questionOptions {
+		existing := existingByQuestion[questionId]
+		kept := make(map[uuid.UUID]bool)
+
+		for _, option := range options {
+			var match *ent.QuestionOption
+			for _, existingOption := range existing {
+				if kept[existingOption.ID] {
+					continue
+				}
+				if option.ID != nil {
+					if existingOption.ID == *option.ID {
+						match = existingOption
+						break
+					}
+				} else if existingOption.OptionText == option.OptionText {
+					match = existingOption
+					break
+				}
+			}
+			if option.ID != nil && match == nil {
+				return errors.New("question option not found")
+			}
+
+			if match == nil {
+				optionCreateQueries = append(optionCreateQueries, tx.QuestionOption.Create().
+					SetOptionText(option.OptionText).
+					SetIsCorrect(option.IsCorrect).
+					SetNillableExplanation(option.Explanation).
+					SetQuestionID(questionId))
+				continue
+			}
+
+			kept[match.ID] = true
+			update := tx.QuestionOption.UpdateOneID(match.ID).
+				SetOptionText(option.OptionText).
+				SetIsCorrect(option.IsCorrect)
+			if option.Explanation != nil {
+				update.SetExplanation(*option.Explanation)
+			} else {
+				update.ClearExplanation()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, existingOption := range existing {
+			if !kept[existingOption.ID] {
+				optionIdsToDelete = append(optionIdsToDelete, existingOption.ID)
+			}
+		}
+	}
+
+	if len(optionIdsToDelete) > 0 {
+		if _, err := tx.QuestionOption.Delete().Where(questionoption.IDIn(optionIdsToDelete...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(optionCreateQueries) > 0 {
+		if _, err := tx.QuestionOption.CreateBulk(optionCreateQueries...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
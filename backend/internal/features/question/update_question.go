@@ -3,11 +3,12 @@ package question
 import (
 	"context"
 	"errors"
+	"strings"
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
-	"template/internal/ent/questionoption"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -22,7 +23,7 @@ func UpdateQuestion(ctx context.Context, userId uuid.UUID, questionID uuid.UUID,
 
 	// Verify the question exists and user has access to it
 	exists, err := tx.Question.Query().
-		Where(question.ID(questionID), question.HasCollectionWith(questioncollection.CreatorID(userId))).
+		Where(question.ID(questionID), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor))).
 		Exist(ctx)
 	if err != nil || !exists {
 		return nil, db.Rollback(tx, errors.New("question not found or you don't have access to it"))
@@ -31,12 +32,21 @@ func UpdateQuestion(ctx context.Context, userId uuid.UUID, questionID uuid.UUID,
 	// Start building the update
 	update := tx.Question.UpdateOneID(questionID).SetNillableQuestionText(input.QuestionText).SetPoints(input.Points)
 
+	// An empty explanation clears it
+	if input.Explanation != nil {
+		if explanation := strings.TrimSpace(*input.Explanation); explanation != "" {
+			update = update.SetExplanation(*input.Explanation)
+		} else {
+			update = update.ClearExplanation()
+		}
+	}
+
 	// Update collection ID if provided
 	if input.QuestionCollectionID != nil {
 		collection, err := tx.QuestionCollection.Query().
 			Where(
 				questioncollection.ID(*input.QuestionCollectionID),
-				questioncollection.CreatorID(userId),
+				access.QuestionCollectionAccess(userId, access.LevelEditor),
 			).
 			Only(ctx)
 		if err != nil {
@@ -63,28 +73,19 @@ func UpdateQuestion(ctx context.Context, userId uuid.UUID, questionID uuid.UUID,
 			}
 		}
 
-		// Delete all existing options for this question
-		_, err = tx.QuestionOption.Delete().
-			Where(questionoption.QuestionID(questionID)).
-			Exec(ctx)
-		if err != nil {
+		// Keep the existing options so answers that selected them still match after the edit
+		options := slice.Map(input.Options, func(option *model.QuestionOptionInput) OptionInput {
+			return OptionInput{ID: option.ID, OptionText: option.OptionText, IsCorrect: option.IsCorrect, Explanation: option.Explanation}
+		})
+		if err := ReplaceQuestionOptions(ctx, tx, map[uuid.UUID][]OptionInput{questionID: options}); err != nil {
 			return nil, db.Rollback(tx, err)
 		}
+	}
 
-		// Create new options in batch if any are provided
-		if len(input.Options) > 0 {
-			questionOptionCreateQueries := slice.Map(input.Options, func(option *model.QuestionOptionInput) *ent.QuestionOptionCreate {
-				return tx.QuestionOption.Create().
-					SetOptionText(option.OptionText).
-					SetIsCorrect(option.IsCorrect).
-					SetQuestionID(questionID)
-			})
-
-			_, err = tx.QuestionOption.CreateBulk(questionOptionCreateQueries...).Save(ctx)
-			if err != nil {
-				return nil, db.Rollback(tx, err)
-			}
-		}
+	// Record the edit as a new version, sessions already started keep the version they drew
+	_, err = RecordQuestionVersions(ctx, tx.Client(), &userId, []uuid.UUID{questionID})
+	if err != nil {
+		return nil, db.Rollback(tx, err)
 	}
 
 	// Fetch the updated question with all related data
@@ -0,0 +1,313 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/predicate"
+	"template/internal/ent/question"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/questionversionoption"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/access"
+	"template/internal/features/common"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// RecordQuestionVersions records a new version of each question whose content changed since its latest version,
+// and returns the latest version of every question. createdById is nil when the version is not recorded by an edit.
+// The questions are locked until the transaction of the client ends, so concurrent recordings of a question wait
+// for each other instead of conflicting on the version number.
+func RecordQuestionVersions(ctx context.Context, client *ent.Client, createdById *uuid.UUID, questionIds []uuid.UUID) (map[uuid.UUID]*ent.QuestionVersion, error) {
+	versions := make(map[uuid.UUID]*ent.QuestionVersion)
+	if len(questionIds) == 0 {
+		return versions, nil
+	}
+
+	// The questions are locked in the order of their ids so two transactions can't wait for each other
+	questions, err := client.Question.Query().
+		Where(question.IDIn(slice.Unique(questionIds)...)).
+		Order(ent.Asc(question.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The options are loaded apart so only the questions are locked
+	options, err := client.QuestionOption.Query().
+		Where(questionoption.QuestionIDIn(slice.Map(questions, func(q *ent.Question) uuid.UUID { return q.ID })...)).
+		Order(ent.Asc(questionoption.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	optionsByQuestion := make(map[uuid.UUID][]*ent.QuestionOption)
+	for _, option := range options {
+		optionsByQuestion[option.QuestionID] = append(optionsByQuestion[option.QuestionID], option)
+	}
+	for _, q := range questions {
+		q.Edges.QuestionOptions = optionsByQuestion[q.ID]
+	}
+
+	latestVersions, err := getLatestVersions(ctx, client, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	changedQuestions := []*ent.Question{}
+	for _, q := range questions {
+		latest := latestVersions[q.ID]
+		if latest != nil && isSameVersion(latest, q) {
+			versions[q.ID] = latest
+			continue
+		}
+		changedQuestions = append(changedQuestions, q)
+	}
+	if len(changedQuestions) == 0 {
+		return versions, nil
+	}
+
+	created, err := client.QuestionVersion.CreateBulk(slice.Map(changedQuestions, func(q *ent.Question) *ent.QuestionVersionCreate {
+		nextVersion := 1
+		if latest := latestVersions[q.ID]; latest != nil {
+			nextVersion = latest.Version + 1
+		}
+
+		return client.QuestionVersion.Create().
+			SetQuestionID(q.ID).
+			SetVersion(nextVersion).
+			SetQuestionText(q.QuestionText).
+			SetPoints(q.Points).
+			SetNillableExplanation(q.Explanation).
+			SetNillableCreatedByID(createdById)
+	})...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	optionCreateQueries := []*ent.QuestionVersionOptionCreate{}
+	for index, q := range changedQuestions {
+		for order, option := range q.Edges.QuestionOptions {
+			optionCreateQueries = append(optionCreateQueries, client.QuestionVersionOption.Create().
+				SetVersionID(created[index].ID).
+				SetOptionID(option.ID).
+				SetOptionText(option.OptionText).
+				SetIsCorrect(option.IsCorrect).
+				SetNillableExplanation(option.Explanation).
+				SetOrder(order+1))
+		}
+		versions[q.ID] = created[index]
+	}
+
+	if len(optionCreateQueries) > 0 {
+		_, err = client.QuestionVersionOption.CreateBulk(optionCreateQueries...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return versions, nil
+}
+
+// getLatestVersions returns the latest version of each question with its options, the questions never versioned are missing.
+func getLatestVersions(ctx context.Context, client *ent.Client, questions []*ent.Question) (map[uuid.UUID]*ent.QuestionVersion, error) {
+	var latestNumbers []struct {
+		QuestionID uuid.UUID `json:"question_id"`
+		Max        int
+	}
+	err := client.QuestionVersion.Query().
+		Where(questionversion.QuestionIDIn(slice.Map(questions, func(q *ent.Question) uuid.UUID { return q.ID })...)).
+		GroupBy(questionversion.FieldQuestionID).
+		Aggregate(ent.Max(questionversion.FieldVersion)).
+		Scan(ctx, &latestNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersions := make(map[uuid.UUID]*ent.QuestionVersion)
+	if len(latestNumbers) == 0 {
+		return latestVersions, nil
+	}
+
+	predicates := make([]predicate.QuestionVersion, 0, len(latestNumbers))
+	for _, latest := range latestNumbers {
+		predicates = append(predicates, questionversion.And(
+			questionversion.QuestionID(latest.QuestionID),
+			questionversion.Version(latest.Max),
+		))
+	}
+
+	versions, err := client.QuestionVersion.Query().
+		Where(questionversion.Or(predicates...)).
+		WithOptions(func(q *ent.QuestionVersionOptionQuery) {
+			q.Order(ent.Asc(questionversionoption.FieldOrder))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions {
+		latestVersions[version.QuestionID] = version
+	}
+	return latestVersions, nil
+}
+
+// GetQuestionHistory returns every version of a question from the oldest with the changes from the previous version,
+// only if the user is an admin or the creator of the question's collection. Deleted questions keep their history.
+func GetQuestionHistory(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, questionId uuid.UUID) ([]*model.QuestionVersion, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	questionQuery := client.Question.Query().Where(question.ID(questionId))
+	if !isAdminOrOwner {
+		questionQuery = questionQuery.Where(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)))
+	}
+	exists, err := questionQuery.Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("question not found or you don't have access to it")
+	}
+
+	versions, err := client.QuestionVersion.Query().
+		Where(questionversion.QuestionID(questionId)).
+		WithOptions(func(q *ent.QuestionVersionOptionQuery) {
+			q.Order(ent.Asc(questionversionoption.FieldOrder))
+		}).
+		Order(ent.Asc(questionversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*model.QuestionVersion, 0, len(versions))
+	for index, version := range versions {
+		var previous *ent.QuestionVersion
+		if index > 0 {
+			previous = versions[index-1]
+		}
+
+		converted := model.ConvertQuestionVersionToModel(version)
+		converted.Changes = diffQuestionVersions(previous, version)
+		history = append(history, converted)
+	}
+
+	return history, nil
+}
+
+// isSameVersion reports whether a version has the same content as the question and its options.
+func isSameVersion(version *ent.QuestionVersion, q *ent.Question) bool {
+	if version.QuestionText != q.QuestionText || version.Points != q.Points || !isSameText(version.Explanation, q.Explanation) {
+		return false
+	}
+	if len(version.Edges.Options) != len(q.Edges.QuestionOptions) {
+		return false
+	}
+
+	for index, option := range q.Edges.QuestionOptions {
+		versionOption := version.Edges.Options[index]
+		if versionOption.OptionText != option.OptionText || versionOption.IsCorrect != option.IsCorrect ||
+			!isSameText(versionOption.Explanation, option.Explanation) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffQuestionVersions lists the changes between two versions. Options are compared by text since
+// editing a question replaces its options. The first version has no previous version and no changes.
+func diffQuestionVersions(previous *ent.QuestionVersion, current *ent.QuestionVersion) []*model.QuestionVersionChange {
+	changes := []*model.QuestionVersionChange{}
+	if previous == nil {
+		return changes
+	}
+
+	if previous.QuestionText != current.QuestionText {
+		changes = append(changes, &model.QuestionVersionChange{
+			Type:   model.QuestionVersionChangeTypeQuestionText,
+			Before: &previous.QuestionText,
+			After:  &current.QuestionText,
+		})
+	}
+
+	if previous.Points != current.Points {
+		before, after := strconv.Itoa(previous.Points), strconv.Itoa(current.Points)
+		changes = append(changes, &model.QuestionVersionChange{
+			Type:   model.QuestionVersionChangeTypePoints,
+			Before: &before,
+			After:  &after,
+		})
+	}
+
+	if !isSameText(previous.Explanation, current.Explanation) {
+		changes = append(changes, &model.QuestionVersionChange{
+			Type:   model.QuestionVersionChangeTypeExplanation,
+			Before: previous.Explanation,
+			After:  current.Explanation,
+		})
+	}
+
+	previousOptions := slice.ToMap(previous.Edges.Options, func(o *ent.QuestionVersionOption) (string, *ent.QuestionVersionOption) {
+		return o.OptionText, o
+	})
+	currentOptions := slice.ToMap(current.Edges.Options, func(o *ent.QuestionVersionOption) (string, *ent.QuestionVersionOption) {
+		return o.OptionText, o
+	})
+
+	for _, option := range previous.Edges.Options {
+		if currentOptions[option.OptionText] == nil {
+			changes = append(changes, &model.QuestionVersionChange{
+				Type:   model.QuestionVersionChangeTypeOptionRemoved,
+				Before: &option.OptionText,
+			})
+		}
+	}
+
+	for _, option := range current.Edges.Options {
+		previousOption := previousOptions[option.OptionText]
+		if previousOption == nil {
+			changes = append(changes, &model.QuestionVersionChange{
+				Type:  model.QuestionVersionChangeTypeOptionAdded,
+				After: &option.OptionText,
+			})
+			continue
+		}
+
+		if previousOption.IsCorrect != option.IsCorrect {
+			before := fmt.Sprintf("%s: %t", option.OptionText, previousOption.IsCorrect)
+			after := fmt.Sprintf("%s: %t", option.OptionText, option.IsCorrect)
+			changes = append(changes, &model.QuestionVersionChange{
+				Type:   model.QuestionVersionChangeTypeOptionCorrectness,
+				Before: &before,
+				After:  &after,
+			})
+		}
+
+		if !isSameText(previousOption.Explanation, option.Explanation) {
+			changes = append(changes, &model.QuestionVersionChange{
+				Type:   model.QuestionVersionChangeTypeExplanation,
+				Before: previousOption.Explanation,
+				After:  option.Explanation,
+			})
+		}
+	}
+
+	return changes
+}
+
+// isSameText reports whether two optional texts are equal, a missing text equals an empty one.
+func isSameText(a *string, b *string) bool {
+	return common.FallbackValue(a, "") == common.FallbackValue(b, "")
+}
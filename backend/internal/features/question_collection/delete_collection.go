@@ -5,11 +5,13 @@ import (
 	"errors"
 	"template/internal/ent/db"
 	"template/internal/ent/questioncollection"
+	"template/internal/features/trash"
+	"template/internal/graph/model"
 
 	"github.com/google/uuid"
 )
 
-// DeleteQuestionCollection deletes a question collection, only if the user is the creator.
+// DeleteQuestionCollection moves a question collection to the trash, only if the user is the creator.
 func DeleteQuestionCollection(ctx context.Context, userId uuid.UUID, collectionId uuid.UUID) (bool, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
@@ -27,8 +29,8 @@ func DeleteQuestionCollection(ctx context.Context, userId uuid.UUID, collectionI
 		return false, db.Rollback(tx, errors.New("question collection not found or unauthorized"))
 	}
 
-	// Delete the collection
-	err = tx.QuestionCollection.DeleteOneID(collectionId).Exec(ctx)
+	// Move the collection to the trash with its questions, they are retained for the results of past sessions
+	err = trash.SoftDelete(ctx, tx.Client(), model.TrashEntityTypeQuestionCollection, collectionId)
 	if err != nil {
 		return false, db.Rollback(tx, err)
 	}
@@ -5,11 +5,12 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/questioncollection"
+	"template/internal/features/access"
 
 	"github.com/google/uuid"
 )
 
-// GetQuestionCollectionByID fetches a question collection by its ID, only if the user is the creator.
+// GetQuestionCollectionByID fetches a question collection by its ID, only if the user is the creator or it is shared with them.
 func GetQuestionCollectionByID(ctx context.Context, userId uuid.UUID, collectionId uuid.UUID) (*ent.QuestionCollection, error) {
 	client, err := db.OpenClient()
 	if err != nil {
@@ -17,7 +18,7 @@ func GetQuestionCollectionByID(ctx context.Context, userId uuid.UUID, collection
 	}
 
 	collection, err := client.QuestionCollection.Query().
-		Where(questioncollection.ID(collectionId), questioncollection.CreatorID(userId)).
+		Where(questioncollection.ID(collectionId), access.QuestionCollectionAccess(userId, access.LevelViewer)).
 		First(ctx)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/questioncollection"
+	"template/internal/features/access"
 	"template/internal/features/common"
 	"template/internal/graph/model"
 
@@ -13,7 +14,8 @@ import (
 )
 
 // PaginatedQuestionCollections returns a paginated list of question collections for the authenticated user.
-func PaginatedQuestionCollections(ctx context.Context, userId uuid.UUID, paginationInput *model.PaginationInput) (*common.PaginatedResult[*ent.QuestionCollection], error) {
+// By default it lists the collections the user created and the ones shared with them, directly or through a group.
+func PaginatedQuestionCollections(ctx context.Context, userId uuid.UUID, paginationInput *model.PaginationInput, scope *model.QuestionCollectionScope) (*common.PaginatedResult[*ent.QuestionCollection], error) {
 	// Guard clause: Validate user ID
 	if userId == uuid.Nil {
 		return nil, fmt.Errorf("invalid user ID: cannot be nil")
@@ -25,8 +27,18 @@ func PaginatedQuestionCollections(ctx context.Context, userId uuid.UUID, paginat
 	}
 
 	// Build the query with authorization filter
-	query := client.QuestionCollection.Query().
-		Where(questioncollection.CreatorID(userId))
+	query := client.QuestionCollection.Query()
+	switch common.FallbackValue(scope, model.QuestionCollectionScopeAll) {
+	case model.QuestionCollectionScopeOwned:
+		query = query.Where(questioncollection.CreatorID(userId))
+	case model.QuestionCollectionScopeSharedWithMe:
+		query = query.Where(
+			questioncollection.CreatorIDNEQ(userId),
+			questioncollection.HasAccessGrantsWith(access.GrantedTo(userId, access.LevelViewer)),
+		)
+	default:
+		query = query.Where(access.QuestionCollectionAccess(userId, access.LevelViewer))
+	}
 
 	// Apply search filter if provided
 	if paginationInput != nil && paginationInput.Search != nil && *paginationInput.Search != "" {
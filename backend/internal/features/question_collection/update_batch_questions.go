@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
-	"template/internal/ent/questionoption"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/access"
+	questionFeat "template/internal/features/question"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -20,12 +23,14 @@ type questionUpdateData struct {
 	QuestionText string
 	Options      []*model.UpdateQuestionOptionInput
 	Points       int
+	Explanation  *string
 }
 
 type questionCreateData struct {
 	QuestionText string
 	Options      []*model.UpdateQuestionOptionInput
 	Points       int
+	Explanation  *string
 }
 
 // UpdateBatchQuestionsByCollection handles batch updates of questions within a collection
@@ -39,7 +44,7 @@ func UpdateBatchQuestionsByCollection(ctx context.Context, userId uuid.UUID, inp
 	collection, err := tx.QuestionCollection.Query().
 		Where(
 			questioncollection.ID(input.CollectionID),
-			questioncollection.CreatorID(userId),
+			access.QuestionCollectionAccess(userId, access.LevelEditor),
 		).
 		Select(questioncollection.FieldID).
 		Only(ctx)
@@ -64,6 +69,7 @@ func UpdateBatchQuestionsByCollection(ctx context.Context, userId uuid.UUID, inp
 					QuestionText: *questionData.QuestionText,
 					Options:      questionData.Options,
 					Points:       questionData.Points,
+					Explanation:  questionData.Explanation,
 				})
 			}
 		} else if questionData.QuestionText != nil {
@@ -72,6 +78,7 @@ func UpdateBatchQuestionsByCollection(ctx context.Context, userId uuid.UUID, inp
 				QuestionText: *questionData.QuestionText,
 				Options:      questionData.Options,
 				Points:       questionData.Points,
+				Explanation:  questionData.Explanation,
 			})
 		}
 	}
@@ -100,6 +107,18 @@ func UpdateBatchQuestionsByCollection(ctx context.Context, userId uuid.UUID, inp
 		}
 	}
 
+	// Record the edited and created questions as new versions
+	questionIDs, err := tx.Question.Query().
+		Where(question.CollectionID(collection.ID)).
+		IDs(ctx)
+	if err != nil {
+		return db.Rollback(tx, err)
+	}
+	_, err = questionFeat.RecordQuestionVersions(ctx, tx.Client(), &userId, questionIDs)
+	if err != nil {
+		return db.Rollback(tx, err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return db.Rollback(tx, err)
 	}
@@ -111,7 +130,7 @@ func UpdateBatchQuestionsByCollection(ctx context.Context, userId uuid.UUID, inp
 func handleBatchQuestionsDeletion(ctx context.Context, tx *ent.Tx, questionIDs []uuid.UUID, userId uuid.UUID) error {
 	// Verify the questions exist and user has access to them
 	questions, err := tx.Question.Query().
-		Where(question.IDIn(questionIDs...), question.HasCollectionWith(questioncollection.CreatorID(userId))).
+		Where(question.IDIn(questionIDs...), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor))).
 		Select(question.FieldID).
 		All(ctx)
 	if err != nil {
@@ -122,9 +141,10 @@ func handleBatchQuestionsDeletion(ctx context.Context, tx *ent.Tx, questionIDs [
 		return question.ID
 	})
 
+	// Soft delete the questions so the answers and versions of past sessions are retained
 	_, err = tx.Question.Delete().
 		Where(question.IDIn(validQuestionIDs...)).
-		Exec(ctx)
+		Exec(mixin.WithSoftDelete(ctx))
 	if err != nil {
 		return err
 	}
@@ -140,7 +160,7 @@ func handleBatchQuestionsUpdate(ctx context.Context, tx *ent.Tx, questionsToUpda
 
 	// Verify all questions exist and user has access to them
 	existingQuestions, err := tx.Question.Query().
-		Where(question.IDIn(questionIDs...), question.HasCollectionWith(questioncollection.CreatorID(userId))).
+		Where(question.IDIn(questionIDs...), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor))).
 		Select(question.FieldID).
 		All(ctx)
 	if err != nil {
@@ -153,10 +173,17 @@ func handleBatchQuestionsUpdate(ctx context.Context, tx *ent.Tx, questionsToUpda
 
 	// Batch update question texts
 	for _, questionData := range questionsToUpdate {
-		_, err = tx.Question.UpdateOneID(questionData.ID).
+		update := tx.Question.UpdateOneID(questionData.ID).
 			SetQuestionText(questionData.QuestionText).
-			SetPoints(questionData.Points).
-			Save(ctx)
+			SetPoints(questionData.Points)
+		if questionData.Explanation != nil {
+			if explanation := strings.TrimSpace(*questionData.Explanation); explanation != "" {
+				update = update.SetExplanation(*questionData.Explanation)
+			} else {
+				update = update.ClearExplanation()
+			}
+		}
+		_, err = update.Save(ctx)
 		if err != nil {
 			return err
 		}
@@ -194,6 +221,7 @@ func handleBatchQuestionsCreation(ctx context.Context, tx *ent.Tx, collectionID
 		return tx.Question.Create().
 			SetQuestionText(questionData.QuestionText).
 			SetPoints(questionData.Points).
+			SetNillableExplanation(questionData.Explanation).
 			SetCollectionID(collectionID)
 	})
 
@@ -229,42 +257,21 @@ func handleBatchOptionsUpdate(ctx context.Context, tx *ent.Tx, questionOptionsMa
 		}
 	}
 
-	// Delete all existing options for the questions
-	for questionID := range questionOptionsMap {
-		_, err := tx.QuestionOption.Delete().
-			Where(questionoption.QuestionID(questionID)).
-			Exec(ctx)
-		if err != nil {
-			return err
-		}
-	}
-
-	// Create all new options query for bulk create
-	var optionCreateQueries []*ent.QuestionOptionCreate
+	// Keep the existing options so answers that selected them still match after the edit
+	options := make(map[uuid.UUID][]questionFeat.OptionInput, len(questionOptionsMap))
 	for questionID, newOptions := range questionOptionsMap {
-		for _, newOption := range newOptions {
+		options[questionID] = slice.Map(newOptions, func(option *model.UpdateQuestionOptionInput) questionFeat.OptionInput {
 			optionText := ""
-			if newOption.OptionText != nil {
-				optionText = *newOption.OptionText
+			if option.OptionText != nil {
+				optionText = *option.OptionText
 			}
 			isCorrect := false
-			if newOption.IsCorrect != nil {
-				isCorrect = *newOption.IsCorrect
+			if option.IsCorrect != nil {
+				isCorrect = *option.IsCorrect
 			}
-
-			optionCreate := tx.QuestionOption.Create().
-				SetOptionText(optionText).
-				SetIsCorrect(isCorrect).
-				SetQuestionID(questionID)
-
-			optionCreateQueries = append(optionCreateQueries, optionCreate)
-		}
-	}
-
-	_, err := tx.QuestionOption.CreateBulk(optionCreateQueries...).Save(ctx)
-	if err != nil {
-		return err
+			return questionFeat.OptionInput{OptionText: optionText, IsCorrect: isCorrect, Explanation: option.Explanation}
+		})
 	}
 
-	return nil
+	return questionFeat.ReplaceQuestionOptions(ctx, tx, options)
 }
@@ -6,21 +6,22 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/questioncollection"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
 )
 
-// UpdateQuestionCollection updates an existing question collection, only if the user is the creator.
+// UpdateQuestionCollection updates an existing question collection, only if the user is the creator or an editor.
 func UpdateQuestionCollection(ctx context.Context, userId uuid.UUID, collectionId uuid.UUID, input model.UpdateQuestionCollectionInput) (*ent.QuestionCollection, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the collection exists and the user is the creator
+	// Check if the collection exists and the user can edit it
 	collection, err := tx.QuestionCollection.Query().
-		Where(questioncollection.ID(collectionId), questioncollection.CreatorID(userId)).
+		Where(questioncollection.ID(collectionId), access.QuestionCollectionAccess(userId, access.LevelEditor)).
 		First(ctx)
 	if err != nil {
 		return nil, db.Rollback(tx, errors.New("question collection not found or unauthorized"))
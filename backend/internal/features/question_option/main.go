@@ -3,11 +3,13 @@ package question_option
 import (
 	"context"
 	"errors"
+	"strings"
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
-	"template/internal/ent/questioncollection"
 	"template/internal/ent/questionoption"
+	"template/internal/features/access"
+	questionFeat "template/internal/features/question"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
@@ -15,36 +17,47 @@ import (
 
 // CreateQuestionOption creates a new question option with the given input and userId.
 func CreateQuestionOption(ctx context.Context, userId uuid.UUID, input model.CreateQuestionOptionInput) (*ent.QuestionOption, error) {
-	client, err := db.OpenClient()
+	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify that the question exists and the user has access to it
-	q, err := client.Question.Query().
+	// Verify that the question exists
+	exists, err := tx.Question.Query().
 		Where(question.ID(input.QuestionID)).
-		WithCollection(
-			func(c *ent.QuestionCollectionQuery) {
-				c.Select(questioncollection.FieldCreatorID)
-			},
-		).
-		Only(ctx)
-	if err != nil {
-		return nil, errors.New("question not found")
+		Exist(ctx)
+	if err != nil || !exists {
+		return nil, db.Rollback(tx, errors.New("question not found"))
 	}
 
-	// Verify the user has access to the question through its collection
-	if q.Edges.Collection != nil && q.Edges.Collection.CreatorID != userId {
-		return nil, errors.New("you don't have access to this question")
+	// Verify the user can edit the question through its collection
+	canEdit, err := tx.Question.Query().
+		Where(question.ID(input.QuestionID), question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor))).
+		Exist(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !canEdit {
+		return nil, db.Rollback(tx, errors.New("you don't have access to this question"))
 	}
 
 	// Create the question option
-	option, err := client.QuestionOption.Create().
+	option, err := tx.QuestionOption.Create().
 		SetQuestionID(input.QuestionID).
 		SetOptionText(input.OptionText).
 		SetIsCorrect(input.IsCorrect).
+		SetNillableExplanation(input.Explanation).
 		Save(ctx)
 	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	_, err = questionFeat.RecordQuestionVersions(ctx, tx.Client(), &userId, []uuid.UUID{option.QuestionID})
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -60,7 +73,7 @@ func GetQuestionOptionByID(ctx context.Context, userId uuid.UUID, optionID uuid.
 
 	// Get the option with its associated question and collection
 	option, err := client.QuestionOption.Query().
-		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(questioncollection.CreatorID(userId)))).
+		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)))).
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -71,31 +84,49 @@ func GetQuestionOptionByID(ctx context.Context, userId uuid.UUID, optionID uuid.
 
 // UpdateQuestionOption updates a question option by its ID with the provided input.
 func UpdateQuestionOption(ctx context.Context, userId uuid.UUID, optionID uuid.UUID, input model.UpdateQuestionOptionInput) (*ent.QuestionOption, error) {
-	client, err := db.OpenClient()
+	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the option exists and user has access to it
-	exists, err := client.QuestionOption.Query().
-		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(questioncollection.CreatorID(userId)))).
+	exists, err := tx.QuestionOption.Query().
+		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)))).
 		Exist(ctx)
 	if err != nil {
-		return nil, err
+		return nil, db.Rollback(tx, err)
 	}
 
 	if !exists {
-		return nil, errors.New("question option not found or you don't have access to it")
+		return nil, db.Rollback(tx, errors.New("question option not found or you don't have access to it"))
 	}
 
 	// Start building the update
-	update := client.QuestionOption.UpdateOneID(optionID).
+	update := tx.QuestionOption.UpdateOneID(optionID).
 		SetNillableOptionText(input.OptionText).
 		SetNillableIsCorrect(input.IsCorrect)
 
+	// An empty explanation clears it
+	if input.Explanation != nil {
+		if explanation := strings.TrimSpace(*input.Explanation); explanation != "" {
+			update = update.SetExplanation(*input.Explanation)
+		} else {
+			update = update.ClearExplanation()
+		}
+	}
+
 	// Save the changes
 	updatedOption, err := update.Save(ctx)
 	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	_, err = questionFeat.RecordQuestionVersions(ctx, tx.Client(), &userId, []uuid.UUID{updatedOption.QuestionID})
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -104,26 +135,31 @@ func UpdateQuestionOption(ctx context.Context, userId uuid.UUID, optionID uuid.U
 
 // DeleteQuestionOption deletes a question option by its ID.
 func DeleteQuestionOption(ctx context.Context, userId uuid.UUID, optionID uuid.UUID) (bool, error) {
-	client, err := db.OpenClient()
+	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
 	// Get the option with its associated question and collection
-	exists, err := client.QuestionOption.Query().
-		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(questioncollection.CreatorID(userId)))).
-		Exist(ctx)
+	option, err := tx.QuestionOption.Query().
+		Where(questionoption.ID(optionID), questionoption.HasQuestionWith(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)))).
+		Only(ctx)
 	if err != nil {
-		return false, err
+		return false, db.Rollback(tx, errors.New("question option not found"))
 	}
 
-	if !exists {
-		return false, errors.New("question option not found")
+	// Delete the option
+	err = tx.QuestionOption.DeleteOneID(optionID).Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
 	}
 
-	// Delete the option
-	err = client.QuestionOption.DeleteOneID(optionID).Exec(ctx)
+	_, err = questionFeat.RecordQuestionVersions(ctx, tx.Client(), &userId, []uuid.UUID{option.QuestionID})
 	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return false, err
 	}
 
@@ -3,10 +3,8 @@ package role
 import (
 	"context"
 	"errors"
-	"fmt"
 	"template/internal/ent"
 	"template/internal/ent/db"
-	entRole "template/internal/ent/role"
 	"template/internal/ent/user"
 	permissionFeat "template/internal/features/permission"
 
@@ -34,42 +32,20 @@ func GetAllRoles(ctx context.Context) ([]*ent.Role, error) {
 	return roles, nil
 }
 
+// CheckUserPermissions fails when the user doesn't have all the permissions.
 func CheckUserPermissions(ctx context.Context, userID uuid.UUID, permissions []permissionFeat.Permission) error {
 	// Handle empty permissions list - always allow
 	if len(permissions) == 0 {
 		return nil
 	}
 
-	client, err := db.OpenClient()
+	principal, err := GetPrincipal(ctx, userID)
 	if err != nil {
 		return err
 	}
 
-	// Get the user with their roles and permissions
-	userWithRoles, err := client.User.Query().
-		Where(user.IDEQ(userID)).
-		WithRoles(func(rq *ent.RoleQuery) {
-			rq.WithPermissions()
-		}).
-		Only(ctx)
-
-	if err != nil {
-		return fmt.Errorf("failed to get user with roles: %w", err)
-	}
-
-	// Collect all permissions the user has across all roles
-	userPermissions := make(map[string]bool)
-	for _, role := range userWithRoles.Edges.Roles {
-		for _, perm := range role.Edges.Permissions {
-			userPermissions[perm.Name] = true
-		}
-	}
-
-	// Check if user has all required permissions
-	for _, requiredPerm := range permissions {
-		if !userPermissions[string(requiredPerm)] {
-			return errors.New("insufficient permissions")
-		}
+	if !principal.HasPermissions(permissions) {
+		return errors.New("insufficient permissions")
 	}
 
 	return nil
@@ -77,32 +53,12 @@ func CheckUserPermissions(ctx context.Context, userID uuid.UUID, permissions []p
 
 // IsAdminOrOwner checks if a user has admin or owner role
 func IsAdminOrOwner(ctx context.Context, userID uuid.UUID) (bool, error) {
-	client, err := db.OpenClient()
+	principal, err := GetPrincipal(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 
-	// Get the user with their roles - select only needed fields
-	userWithRoles, err := client.User.Query().
-		Where(user.IDEQ(userID)).
-		WithRoles(func(rq *ent.RoleQuery) {
-			rq.Select(entRole.FieldID, entRole.FieldName)
-		}).
-		Select(user.FieldID).
-		Only(ctx)
-
-	if err != nil {
-		return false, fmt.Errorf("failed to get user with roles: %w", err)
-	}
-
-	// Check if user has admin or owner role
-	for _, r := range userWithRoles.Edges.Roles {
-		if r.Name == RoleAdmin || r.Name == RoleOwner {
-			return true, nil
-		}
-	}
-
-	return false, nil
+	return principal.IsAdminOrOwner(), nil
 }
 
 // GetRolesByUserIDs fetches roles for multiple users and returns a map with user ID as key and roles array as value
@@ -0,0 +1,403 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entPermission "template/internal/ent/permission"
+	entRole "template/internal/ent/role"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/user"
+	permissionFeat "template/internal/features/permission"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// SystemRoles are the roles created by the seeder, they can't be renamed or deleted.
+var SystemRoles = []string{RoleOwner, RoleAdmin, RoleUser}
+
+// IsSystemRole reports whether a role is one of the seeded system roles.
+func IsSystemRole(name string) bool {
+	return slice.Contains(SystemRoles, name)
+}
+
+// GetRoleByID fetches a role by its ID
+func GetRoleByID(ctx context.Context, roleId uuid.UUID) (*ent.Role, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := client.Role.Get(ctx, roleId)
+	if err != nil {
+		return nil, errors.New("role not found")
+	}
+	return role, nil
+}
+
+// GetPermissionsByRoleIDs fetches permissions for multiple roles and returns a map with role ID as key and permissions array as value
+func GetPermissionsByRoleIDs(ctx context.Context, roleIds []uuid.UUID) (map[uuid.UUID][]permissionFeat.Permission, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := client.Role.Query().
+		Where(entRole.IDIn(roleIds...)).
+		WithPermissions(func(pq *ent.PermissionQuery) {
+			pq.Order(ent.Asc(entPermission.FieldName))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePermissionsMap := make(map[uuid.UUID][]permissionFeat.Permission)
+	for _, role := range roles {
+		rolePermissionsMap[role.ID] = slice.Map(role.Edges.Permissions, func(p *ent.Permission) permissionFeat.Permission {
+			return permissionFeat.Permission(p.Name)
+		})
+	}
+
+	return rolePermissionsMap, nil
+}
+
+// CreateRole creates a custom role with the given permissions, the user must have them.
+func CreateRole(ctx context.Context, userId uuid.UUID, input model.CreateRoleInput) (*ent.Role, error) {
+	if err := checkCanGrantPermissions(ctx, userId, input.Permissions); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := tx.Role.Query().Where(entRole.Name(input.Name)).Exist(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if exists {
+		return nil, db.Rollback(tx, errors.New("role already exists"))
+	}
+
+	permissionIds, err := getPermissionIds(ctx, tx.Client(), input.Permissions)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	role, err := tx.Role.Create().
+		SetName(input.Name).
+		SetNillableDescription(input.Description).
+		AddPermissionIDs(permissionIds...).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// UpdateRole updates the name and description of a role. System roles can't be renamed.
+func UpdateRole(ctx context.Context, roleId uuid.UUID, input model.UpdateRoleInput) (*ent.Role, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := tx.Role.Get(ctx, roleId)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("role not found"))
+	}
+
+	update := tx.Role.UpdateOneID(roleId).SetNillableDescription(input.Description)
+	if input.Name != nil && *input.Name != role.Name {
+		if IsSystemRole(role.Name) {
+			return nil, db.Rollback(tx, errors.New("system roles can't be renamed"))
+		}
+
+		exists, err := tx.Role.Query().Where(entRole.Name(*input.Name)).Exist(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		if exists {
+			return nil, db.Rollback(tx, errors.New("role already exists"))
+		}
+		update = update.SetName(*input.Name)
+	}
+
+	updatedRole, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updatedRole, nil
+}
+
+// DeleteRole deletes a custom role and removes it from its users. System roles can't be deleted.
+func DeleteRole(ctx context.Context, roleId uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	role, err := tx.Role.Get(ctx, roleId)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("role not found"))
+	}
+	if IsSystemRole(role.Name) {
+		return false, db.Rollback(tx, errors.New("system roles can't be deleted"))
+	}
+
+	err = tx.Role.DeleteOneID(roleId).Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	InvalidateAllPrincipals()
+
+	return true, nil
+}
+
+// AddRolePermissions attaches permissions to a role, the user must have them. The owner role always has every permission.
+func AddRolePermissions(ctx context.Context, userId uuid.UUID, roleId uuid.UUID, permissions []permissionFeat.Permission) (*ent.Role, error) {
+	if err := checkCanGrantPermissions(ctx, userId, permissions); err != nil {
+		return nil, err
+	}
+
+	return updateRolePermissions(ctx, roleId, permissions, true)
+}
+
+// RemoveRolePermissions detaches permissions from a role. The owner role always has every permission.
+func RemoveRolePermissions(ctx context.Context, roleId uuid.UUID, permissions []permissionFeat.Permission) (*ent.Role, error) {
+	return updateRolePermissions(ctx, roleId, permissions, false)
+}
+
+// SetUserRoles replaces the roles of a user, making sure an active owner remains.
+func SetUserRoles(ctx context.Context, userId uuid.UUID, targetUserId uuid.UUID, roleIds []uuid.UUID) (*ent.User, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ReplaceUserRoles(ctx, tx, userId, targetUserId, roleIds); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	updatedUser, err := tx.User.Get(ctx, targetUserId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	InvalidatePrincipals(targetUserId)
+
+	return updatedUser, nil
+}
+
+// ReplaceUserRoles replaces the roles of the target user within the transaction. It fails when a role doesn't exist,
+// when the user isn't an owner and the owner role is granted or revoked, or when the target user is the last active
+// owner and would lose the owner role.
+func ReplaceUserRoles(ctx context.Context, tx *ent.Tx, userId uuid.UUID, targetUserId uuid.UUID, roleIds []uuid.UUID) error {
+	roleIds = slice.Unique(roleIds)
+	roles, err := tx.Role.Query().Where(entRole.IDIn(roleIds...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(roles) != len(roleIds) {
+		return errors.New("invalid role")
+	}
+
+	isOwner, err := isOwner(ctx, tx.Client(), targetUserId)
+	if err != nil {
+		return err
+	}
+	keepsOwner := slice.Some(roles, func(r *ent.Role) bool { return r.Name == RoleOwner })
+	if isOwner != keepsOwner {
+		if err := CheckCanGrantRole(ctx, userId, RoleOwner); err != nil {
+			return err
+		}
+	}
+	if isOwner && !keepsOwner {
+		if err := EnsureOwnerRemains(ctx, tx, targetUserId); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.User.UpdateOneID(targetUserId).
+		ClearRoles().
+		AddRoleIDs(roleIds...).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("user not found")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CheckCanGrantRole fails when the user can't grant or revoke the role. Only the owners, who access every
+// organization, can grant or revoke the owner role and manage the accounts of the other owners.
+func CheckCanGrantRole(ctx context.Context, userId uuid.UUID, roleName string) error {
+	if roleName != RoleOwner {
+		return nil
+	}
+
+	principal, err := GetPrincipal(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !principal.IsPlatformOwner() {
+		return errors.New("only an owner can grant or revoke the owner role")
+	}
+	return nil
+}
+
+// CheckCanManageUser fails when the target user is an owner and the user isn't, so an admin can't take over
+// an owner account by changing its email or password or deactivate it.
+func CheckCanManageUser(ctx context.Context, client *ent.Client, userId uuid.UUID, targetUserId uuid.UUID) error {
+	isOwner, err := isOwner(ctx, client, targetUserId)
+	if err != nil {
+		return err
+	}
+	if isOwner {
+		return CheckCanGrantRole(ctx, userId, RoleOwner)
+	}
+	return nil
+}
+
+// EnsureOwnerRemains fails when the user is the last active owner, so removing the owner role from the user
+// or deactivating the user would leave the system without an owner. The owner role is locked until the
+// transaction ends so concurrent demotions can't remove every owner.
+func EnsureOwnerRemains(ctx context.Context, tx *ent.Tx, userId uuid.UUID) error {
+	_, err := tx.Role.Query().
+		Where(entRole.Name(RoleOwner)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	isOwner, err := isOwner(ctx, tx.Client(), userId)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return nil
+	}
+
+	// The owners of every organization are counted
+	otherOwners, err := tx.User.Query().
+		Where(
+			user.IDNEQ(userId),
+			user.IsActive(true),
+			user.HasRolesWith(entRole.Name(RoleOwner)),
+		).
+		Count(mixin.SkipTenant(ctx))
+	if err != nil {
+		return err
+	}
+	if otherOwners == 0 {
+		return errors.New("the last owner can't be demoted or deactivated")
+	}
+
+	return nil
+}
+
+// isOwner reports whether the user has the owner role.
+func isOwner(ctx context.Context, client *ent.Client, userId uuid.UUID) (bool, error) {
+	return client.User.Query().
+		Where(user.ID(userId), user.HasRolesWith(entRole.Name(RoleOwner))).
+		Exist(mixin.SkipTenant(ctx))
+}
+
+// checkCanGrantPermissions fails when the user doesn't have one of the permissions, so a role can't be given
+// more permissions than the user granting them.
+func checkCanGrantPermissions(ctx context.Context, userId uuid.UUID, permissions []permissionFeat.Permission) error {
+	principal, err := GetPrincipal(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !principal.HasPermissions(permissions) {
+		return errors.New("permissions you don't have can't be granted")
+	}
+	return nil
+}
+
+// updateRolePermissions adds or removes permissions of a role.
+func updateRolePermissions(ctx context.Context, roleId uuid.UUID, permissions []permissionFeat.Permission, add bool) (*ent.Role, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := tx.Role.Get(ctx, roleId)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("role not found"))
+	}
+	if role.Name == RoleOwner {
+		return nil, db.Rollback(tx, errors.New("the permissions of the owner role can't be changed"))
+	}
+
+	permissionIds, err := getPermissionIds(ctx, tx.Client(), permissions)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	update := tx.Role.UpdateOneID(roleId)
+	if add {
+		update = update.AddPermissionIDs(permissionIds...)
+	} else {
+		update = update.RemovePermissionIDs(permissionIds...)
+	}
+
+	updatedRole, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	InvalidateAllPrincipals()
+
+	return updatedRole, nil
+}
+
+// getPermissionIds returns the IDs of the seeded permissions, failing when one of them isn't seeded.
+func getPermissionIds(ctx context.Context, client *ent.Client, permissions []permissionFeat.Permission) ([]uuid.UUID, error) {
+	names := slice.Unique(slice.Map(permissions, func(p permissionFeat.Permission) string { return string(p) }))
+	if len(names) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	permissionEntities, err := client.Permission.Query().
+		Where(entPermission.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(permissionEntities) != len(names) {
+		return nil, errors.New("invalid permission")
+	}
+
+	return slice.Map(permissionEntities, func(p *ent.Permission) uuid.UUID { return p.ID }), nil
+}
@@ -0,0 +1,209 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entRole "template/internal/ent/role"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/user"
+	permissionFeat "template/internal/features/permission"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Principal is a user with the roles and permissions they have across all their roles.
+type Principal struct {
+	UserID uuid.UUID
+	// OrganizationID is the organization of the user, nil for the users without organization
+	OrganizationID *uuid.UUID
+	Roles          []string
+	Permissions    []permissionFeat.Permission
+	// MustChangePassword is set for the users given a temporary password, they can only change it
+	MustChangePassword bool
+}
+
+// HasPermissions reports whether the principal has all the permissions.
+func (p *Principal) HasPermissions(permissions []permissionFeat.Permission) bool {
+	for _, permission := range permissions {
+		if !slice.Contains(p.Permissions, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPlatformOwner reports whether the principal is an owner without organization, who can access every organization.
+func (p *Principal) IsPlatformOwner() bool {
+	return p.OrganizationID == nil && slice.Contains(p.Roles, RoleOwner)
+}
+
+// IsAdminOrOwner reports whether the principal has the admin or the owner role.
+func (p *Principal) IsAdminOrOwner() bool {
+	return slice.Contains(p.Roles, RoleAdmin) || slice.Contains(p.Roles, RoleOwner)
+}
+
+type requestPrincipalsKey struct{}
+
+// requestPrincipals holds the principals loaded while serving a request.
+type requestPrincipals struct {
+	mu         sync.Mutex
+	principals map[uuid.UUID]*Principal
+}
+
+// WithRequestPrincipals returns a context where the principals are loaded at most once,
+// so the authorization checks of every field of a request share them.
+func WithRequestPrincipals(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestPrincipalsKey{}, &requestPrincipals{principals: map[uuid.UUID]*Principal{}})
+}
+
+// GetPrincipal returns the roles and permissions of a user. They are loaded once per request
+// when the context comes from WithRequestPrincipals, and kept in the principal cache when it is enabled.
+func GetPrincipal(ctx context.Context, userID uuid.UUID) (*Principal, error) {
+	request, ok := ctx.Value(requestPrincipalsKey{}).(*requestPrincipals)
+	if !ok {
+		return getCachedPrincipal(ctx, userID)
+	}
+
+	request.mu.Lock()
+	defer request.mu.Unlock()
+
+	if principal, exists := request.principals[userID]; exists {
+		return principal, nil
+	}
+
+	principal, err := getCachedPrincipal(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	request.principals[userID] = principal
+	return principal, nil
+}
+
+// getCachedPrincipal returns the principal from the principal cache, loading it when it is missing or expired.
+func getCachedPrincipal(ctx context.Context, userID uuid.UUID) (*Principal, error) {
+	principal, generation, ok := principalCache.get(userID)
+	if ok {
+		return principal, nil
+	}
+
+	principal, err := loadPrincipal(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	principalCache.set(principal, generation)
+	return principal, nil
+}
+
+// loadPrincipal loads the roles and permissions of a user from the database.
+func loadPrincipal(ctx context.Context, userID uuid.UUID) (*Principal, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// The principal is loaded before the queries are scoped to its organization
+	ctx = mixin.SkipTenant(ctx)
+	userWithRoles, err := client.User.Query().
+		Where(user.IDEQ(userID)).
+		WithRoles(func(rq *ent.RoleQuery) {
+			rq.Select(entRole.FieldID, entRole.FieldName)
+			rq.WithPermissions()
+		}).
+		Select(user.FieldID, user.FieldOrganizationID, user.FieldMustChangePassword).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user with roles: %w", err)
+	}
+
+	principal := &Principal{
+		UserID:             userID,
+		OrganizationID:     userWithRoles.OrganizationID,
+		Roles:              []string{},
+		Permissions:        []permissionFeat.Permission{},
+		MustChangePassword: userWithRoles.MustChangePassword,
+	}
+	for _, role := range userWithRoles.Edges.Roles {
+		principal.Roles = append(principal.Roles, role.Name)
+		for _, permission := range role.Edges.Permissions {
+			principal.Permissions = append(principal.Permissions, permissionFeat.Permission(permission.Name))
+		}
+	}
+	principal.Permissions = slice.Unique(principal.Permissions)
+
+	return principal, nil
+}
+
+// principalCache keeps the principals in memory for a short time across requests. It is disabled until a TTL is set.
+var principalCache = &principalCacheStore{items: map[uuid.UUID]cachedPrincipal{}}
+
+type cachedPrincipal struct {
+	principal *Principal
+	expiresAt time.Time
+}
+
+type principalCacheStore struct {
+	mu    sync.RWMutex
+	ttl   time.Duration
+	items map[uuid.UUID]cachedPrincipal
+	// generation changes on every invalidation, so a principal loaded before it isn't cached after it
+	generation uint64
+}
+
+// SetPrincipalCacheTTL sets how long the principals are cached across requests, 0 disables the cache.
+// The cache is in process, role changes made by another instance are only seen once the entries expire.
+func SetPrincipalCacheTTL(ttl time.Duration) {
+	principalCache.mu.Lock()
+	defer principalCache.mu.Unlock()
+
+	principalCache.ttl = ttl
+	principalCache.items = map[uuid.UUID]cachedPrincipal{}
+	principalCache.generation++
+}
+
+// InvalidatePrincipals removes users from the principal cache, to be called once their roles or their password changed.
+func InvalidatePrincipals(userIDs ...uuid.UUID) {
+	principalCache.mu.Lock()
+	defer principalCache.mu.Unlock()
+
+	for _, userID := range userIDs {
+		delete(principalCache.items, userID)
+	}
+	principalCache.generation++
+}
+
+// InvalidateAllPrincipals empties the principal cache, to be called once the permissions of a role changed.
+func InvalidateAllPrincipals() {
+	principalCache.mu.Lock()
+	defer principalCache.mu.Unlock()
+
+	principalCache.items = map[uuid.UUID]cachedPrincipal{}
+	principalCache.generation++
+}
+
+// get returns the cached principal of a user, with the current generation to pass to set when it is missing.
+func (c *principalCacheStore) get(userID uuid.UUID) (*Principal, uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, ok := c.items[userID]
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, c.generation, false
+	}
+	return item.principal, c.generation, true
+}
+
+// set caches a principal loaded at the generation, unless the cache was invalidated since.
+func (c *principalCacheStore) set(principal *Principal, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 || generation != c.generation {
+		return
+	}
+	c.items[principal.UserID] = cachedPrincipal{principal: principal, expiresAt: time.Now().Add(c.ttl)}
+}
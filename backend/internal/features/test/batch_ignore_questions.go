@@ -6,9 +6,8 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
-	"template/internal/ent/questioncollection"
-	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -17,18 +16,15 @@ import (
 
 // BatchIgnoreQuestions batch ignores multiple questions for a test with optional reasons.
 // Requires user authentication and authorization (user must own the course containing the test and the questions).
-func BatchIgnoreQuestions(ctx context.Context, userId uuid.UUID, input model.BatchIgnoreQuestionsInput) (bool, error) {
+func BatchIgnoreQuestions(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.BatchIgnoreQuestionsInput) (bool, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	// Get the test and verify ownership through course or course_section
-	testExists, err := tx.Test.Query().
-		Where(test.ID(input.TestID)).
-		Exist(ctx)
-	if err != nil || !testExists {
-		return false, db.Rollback(tx, errors.New("test not found"))
+	// Verify the user can edit the test, as its creator, through its course or through a grant
+	if err := checkCanEditTest(ctx, tx.Client(), userId, isAdminOrOwner, input.TestID); err != nil {
+		return false, db.Rollback(tx, err)
 	}
 
 	// Extract question IDs and validate they exist and are owned by the user
@@ -41,12 +37,11 @@ func BatchIgnoreQuestions(ctx context.Context, userId uuid.UUID, input model.Bat
 	}
 
 	// Verify all questionCount exist and are owned by the user
-	questionCount, err := tx.Question.Query().
-		Where(
-			question.IDIn(questionIDs...),
-			question.HasCollectionWith(questioncollection.CreatorID(userId)),
-		).
-		Count(ctx)
+	questionQuery := tx.Question.Query().Where(question.IDIn(questionIDs...))
+	if !isAdminOrOwner {
+		questionQuery = questionQuery.Where(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelViewer)))
+	}
+	questionCount, err := questionQuery.Count(ctx)
 	if err != nil {
 		return false, db.Rollback(tx, err)
 	}
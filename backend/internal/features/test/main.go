@@ -4,16 +4,21 @@ import (
 	"context"
 	"errors"
 	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/ent/coursesection"
 	"template/internal/ent/db"
 	"template/internal/ent/test"
+	"template/internal/features/access"
 	"template/internal/features/common"
+	"template/internal/features/trash"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
 )
 
-// CreateTest creates a new test with the given input.
-func CreateTest(ctx context.Context, input model.CreateTestInput) (*ent.Test, error) {
+// CreateTest creates a new test with the given input and userId as the creator.
+// A test can only be added to a course the user can edit.
+func CreateTest(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.CreateTestInput) (*ent.Test, error) {
 	client, err := db.OpenClient()
 	if err != nil {
 		return nil, err
@@ -22,11 +27,37 @@ func CreateTest(ctx context.Context, input model.CreateTestInput) (*ent.Test, er
 	if input.TotalTime == 0 {
 		return nil, errors.New("total_time must be greater than 0")
 	}
+	if err := common.ValidateTimeWindow(input.OpenAt, input.CloseAt); err != nil {
+		return nil, err
+	}
+	if input.MaxAttempts != nil && *input.MaxAttempts <= 0 {
+		return nil, errors.New("maxAttempts must be greater than 0")
+	}
+	if input.AttemptCooldownMinutes != nil && *input.AttemptCooldownMinutes < 0 {
+		return nil, errors.New("attemptCooldownMinutes must not be negative")
+	}
+
+	if !isAdminOrOwner && (input.CourseID != nil || input.CourseSectionID != nil) {
+		if err := checkCanEditCourse(ctx, client, userId, input.CourseID, input.CourseSectionID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Build the creation with required Name field
 	query := client.Test.Create().SetName(input.Name).
+		SetCreatorID(userId).
 		SetNillableCourseSectionID(input.CourseSectionID).
-		SetNillableCourseID(input.CourseID).SetTotalTime(input.TotalTime)
+		SetNillableCourseID(input.CourseID).SetTotalTime(input.TotalTime).
+		SetNillableOpenAt(common.ToUTC(input.OpenAt)).
+		SetNillableCloseAt(common.ToUTC(input.CloseAt)).
+		SetNillableMaxAttempts(input.MaxAttempts).
+		SetNillableAttemptCooldownMinutes(input.AttemptCooldownMinutes)
+	if input.ScoringPolicy != nil {
+		query = query.SetScoringPolicy(model.ConvertScoringPolicyToEnt(*input.ScoringPolicy))
+	}
+	if input.FeedbackRelease != nil {
+		query = query.SetFeedbackRelease(model.ConvertFeedbackReleaseToEnt(*input.FeedbackRelease))
+	}
 
 	testEntity, err := query.Save(ctx)
 	if err != nil {
@@ -36,22 +67,69 @@ func CreateTest(ctx context.Context, input model.CreateTestInput) (*ent.Test, er
 	return testEntity, nil
 }
 
-// UpdateTest updates an existing test by its ID.
-func UpdateTest(ctx context.Context, id uuid.UUID, input model.UpdateTestInput) (*ent.Test, error) {
+// UpdateTest updates an existing test by its ID, only if the user can edit it.
+// The clear flags remove the window bounds and attempt limits when no new value is given.
+func UpdateTest(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, id uuid.UUID, input model.UpdateTestInput) (*ent.Test, error) {
 	client, err := db.OpenClient()
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the test exists
-	_, err = client.Test.Get(ctx, id)
+	existing, err := getTestWithAccess(ctx, client, userId, isAdminOrOwner, id, access.LevelEditor)
 	if err != nil {
 		return nil, err
 	}
 
+	// Validate the window resulting from the update
+	openAt := existing.OpenAt
+	switch {
+	case input.OpenAt != nil:
+		openAt = input.OpenAt
+	case input.ClearOpenAt != nil && *input.ClearOpenAt:
+		openAt = nil
+	}
+	closeAt := existing.CloseAt
+	switch {
+	case input.CloseAt != nil:
+		closeAt = input.CloseAt
+	case input.ClearCloseAt != nil && *input.ClearCloseAt:
+		closeAt = nil
+	}
+	if err := common.ValidateTimeWindow(openAt, closeAt); err != nil {
+		return nil, err
+	}
+	if input.MaxAttempts != nil && *input.MaxAttempts <= 0 {
+		return nil, errors.New("maxAttempts must be greater than 0")
+	}
+	if input.AttemptCooldownMinutes != nil && *input.AttemptCooldownMinutes < 0 {
+		return nil, errors.New("attemptCooldownMinutes must not be negative")
+	}
+
 	update := client.Test.UpdateOneID(id).
 		SetNillableName(input.Name).
-		SetNillableTotalTime(input.TotalTime)
+		SetNillableTotalTime(input.TotalTime).
+		SetNillableOpenAt(common.ToUTC(input.OpenAt)).
+		SetNillableCloseAt(common.ToUTC(input.CloseAt)).
+		SetNillableMaxAttempts(input.MaxAttempts).
+		SetNillableAttemptCooldownMinutes(input.AttemptCooldownMinutes)
+	if input.OpenAt == nil && input.ClearOpenAt != nil && *input.ClearOpenAt {
+		update = update.ClearOpenAt()
+	}
+	if input.CloseAt == nil && input.ClearCloseAt != nil && *input.ClearCloseAt {
+		update = update.ClearCloseAt()
+	}
+	if input.MaxAttempts == nil && input.ClearMaxAttempts != nil && *input.ClearMaxAttempts {
+		update = update.ClearMaxAttempts()
+	}
+	if input.AttemptCooldownMinutes == nil && input.ClearAttemptCooldownMinutes != nil && *input.ClearAttemptCooldownMinutes {
+		update = update.ClearAttemptCooldownMinutes()
+	}
+	if input.ScoringPolicy != nil {
+		update = update.SetScoringPolicy(model.ConvertScoringPolicyToEnt(*input.ScoringPolicy))
+	}
+	if input.FeedbackRelease != nil {
+		update = update.SetFeedbackRelease(model.ConvertFeedbackReleaseToEnt(*input.FeedbackRelease))
+	}
 
 	updatedTest, err := update.Save(ctx)
 	if err != nil {
@@ -61,36 +139,38 @@ func UpdateTest(ctx context.Context, id uuid.UUID, input model.UpdateTestInput)
 	return updatedTest, nil
 }
 
-// DeleteTest removes a test by its ID.
-func DeleteTest(ctx context.Context, id uuid.UUID) (bool, error) {
-	client, err := db.OpenClient()
+// DeleteTest moves a test to the trash with its sessions and group assignments, only if the user can edit it.
+func DeleteTest(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, id uuid.UUID) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	// Check if the test exists
-	_, err = client.Test.Get(ctx, id)
+	_, err = getTestWithAccess(ctx, tx.Client(), userId, isAdminOrOwner, id, access.LevelEditor)
 	if err != nil {
-		return false, err
+		return false, db.Rollback(tx, err)
 	}
 
-	// Delete the test
-	err = client.Test.DeleteOneID(id).Exec(ctx)
+	err = trash.SoftDelete(ctx, tx.Client(), model.TrashEntityTypeTest, id)
 	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return false, err
 	}
 
 	return true, nil
 }
 
-// GetTestByID fetches a single test by its ID.
-func GetTestByID(ctx context.Context, id uuid.UUID) (*ent.Test, error) {
+// GetTestByID fetches a single test by its ID, only if the user can view it.
+func GetTestByID(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, id uuid.UUID) (*ent.Test, error) {
 	client, err := db.OpenClient()
 	if err != nil {
 		return nil, err
 	}
 
-	return client.Test.Get(ctx, id)
+	return getTestWithAccess(ctx, client, userId, isAdminOrOwner, id, access.LevelViewer)
 }
 
 // GetTestsByIDs fetches multiple tests by their IDs.
@@ -103,17 +183,69 @@ func GetTestsByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Test, error) {
 	return client.Test.Query().Where(test.IDIn(ids...)).All(ctx)
 }
 
-// PaginatedTests returns a paginated list of tests.
-func PaginatedTests(ctx context.Context, input *model.PaginationInput) (*common.PaginatedResult[*ent.Test], error) {
+// PaginatedTests returns a paginated list of the tests the user can view.
+func PaginatedTests(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input *model.PaginationInput) (*common.PaginatedResult[*ent.Test], error) {
 	client, err := db.OpenClient()
 	if err != nil {
 		return nil, err
 	}
 
 	query := client.Test.Query()
+	if !isAdminOrOwner {
+		query = query.Where(access.TestAccess(userId, access.LevelViewer))
+	}
 
 	// Use default pagination if not provided
 	newInput := common.FallbackValue(input, common.DefaultPaginationInput)
 
 	return common.EntQueryPaginated(ctx, query, *newInput.Page, *newInput.Limit)
 }
+
+// getTestWithAccess fetches a test only if the user has the access level on it.
+func getTestWithAccess(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, id uuid.UUID, level access.Level) (*ent.Test, error) {
+	query := client.Test.Query().Where(test.ID(id))
+	if !isAdminOrOwner {
+		query = query.Where(access.TestAccess(userId, level))
+	}
+
+	testEntity, err := query.Only(ctx)
+	if err != nil {
+		return nil, errors.New("test not found or unauthorized")
+	}
+	return testEntity, nil
+}
+
+// checkCanEditTest makes sure the user can edit the test or is an admin.
+func checkCanEditTest(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID) error {
+	_, err := getTestWithAccess(ctx, client, userId, isAdminOrOwner, testId, access.LevelEditor)
+	return err
+}
+
+// checkCanEditCourse makes sure the user can edit the course or the course of the section a test is added to.
+func checkCanEditCourse(ctx context.Context, client *ent.Client, userId uuid.UUID, courseId *uuid.UUID, courseSectionId *uuid.UUID) error {
+	if courseId != nil {
+		exists, err := client.Course.Query().
+			Where(course.ID(*courseId), access.CourseAccess(userId, access.LevelEditor)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("course not found or unauthorized")
+		}
+	}
+
+	if courseSectionId != nil {
+		exists, err := client.CourseSection.Query().
+			Where(coursesection.ID(*courseSectionId), coursesection.HasCourseWith(access.CourseAccess(userId, access.LevelEditor))).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("course section not found or unauthorized")
+		}
+	}
+
+	return nil
+}
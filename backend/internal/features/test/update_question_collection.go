@@ -5,7 +5,7 @@ import (
 	"errors"
 	"template/internal/ent/db"
 	"template/internal/ent/questioncollection"
-	"template/internal/ent/test"
+	"template/internal/features/access"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -14,27 +14,25 @@ import (
 
 // AddMultiCollectionToTest adds multiple question collections to a test.
 // Requires user authentication and authorization (user must own the course containing the test).
-func UpdateQuestionCollectionsForTest(ctx context.Context, userId uuid.UUID, input model.AddMultiCollectionToTestInput) (bool, error) {
+func UpdateQuestionCollectionsForTest(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.AddMultiCollectionToTestInput) (bool, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	testExists, err := tx.Test.Query().
-		Where(test.ID(input.TestID)).
-		Exist(ctx)
-	if err != nil || !testExists {
-		return false, db.Rollback(tx, errors.New("test not found"))
+	// Verify the user can edit the test, as its creator, through its course or through a grant
+	if err := checkCanEditTest(ctx, tx.Client(), userId, isAdminOrOwner, input.TestID); err != nil {
+		return false, db.Rollback(tx, err)
 	}
 
 	collectionIds := slice.Unique(input.CollectionIds)
 
-	// Get the count of collections that are owned by the user
-	collectionCount, err := tx.QuestionCollection.Query().
-		Where(
-			questioncollection.IDIn(collectionIds...),
-			questioncollection.CreatorID(userId),
-		).Count(ctx)
+	// Get the count of collections that the user can view
+	collectionQuery := tx.QuestionCollection.Query().Where(questioncollection.IDIn(collectionIds...))
+	if !isAdminOrOwner {
+		collectionQuery = collectionQuery.Where(access.QuestionCollectionAccess(userId, access.LevelViewer))
+	}
+	collectionCount, err := collectionQuery.Count(ctx)
 	if err != nil {
 		return false, db.Rollback(tx, err)
 	}
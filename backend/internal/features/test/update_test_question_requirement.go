@@ -2,10 +2,8 @@ package test
 
 import (
 	"context"
-	"errors"
 	"template/internal/ent"
 	"template/internal/ent/db"
-	"template/internal/ent/test"
 	"template/internal/ent/testquestioncount"
 	"template/internal/graph/model"
 
@@ -14,18 +12,15 @@ import (
 
 // UpdateTestQuestionRequirement updates the number of questions requirement based on points per question for a test.
 // Requires user authentication and authorization (user must own the course containing the test).
-func UpdateTestQuestionRequirement(ctx context.Context, userId uuid.UUID, testID uuid.UUID, input []model.UpdateTestQuestionRequirementInput) (bool, error) {
+func UpdateTestQuestionRequirement(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, testID uuid.UUID, input []model.UpdateTestQuestionRequirementInput) (bool, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	// Get the test and verify ownership through course or course_section
-	testExists, err := tx.Test.Query().
-		Where(test.ID(testID)).
-		Exist(ctx)
-	if err != nil || !testExists {
-		return false, db.Rollback(tx, errors.New("test not found"))
+	// Verify the user can edit the test, as its creator, through its course or through a grant
+	if err := checkCanEditTest(ctx, tx.Client(), userId, isAdminOrOwner, testID); err != nil {
+		return false, db.Rollback(tx, err)
 	}
 
 	// Delete existing TestQuestionCount records for this test
@@ -0,0 +1,365 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionbreak"
+	"template/internal/ent/testsessiontimeextension"
+	"template/internal/ent/useraccommodation"
+	"template/internal/graph/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// accommodation is the resolved accommodation applied to a session, session overrides take precedence over the user's.
+type accommodation struct {
+	TimeMultiplier  float64
+	ExtraMinutes    int
+	AllowRestBreaks bool
+	// MaxRestBreaks and MaxRestBreakMinutes are nil when unlimited
+	MaxRestBreaks       *int
+	MaxRestBreakMinutes *int
+}
+
+// SetUserAccommodation creates or updates the accommodation of a user of the organization, only admins and owners can do it.
+// Fields missing from the input keep their current value.
+func SetUserAccommodation(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, targetUserId uuid.UUID, input model.AccommodationInput) (*ent.UserAccommodation, error) {
+	if !isAdminOrOwner {
+		return nil, errors.New("unauthorized: only admins can set accommodations")
+	}
+	if err := validateAccommodationInput(input); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user query is scoped to the tenant, the users of other organizations aren't found
+	if _, err := tx.User.Get(ctx, targetUserId); err != nil {
+		return nil, db.Rollback(tx, errors.New("user not found"))
+	}
+
+	existing, err := tx.UserAccommodation.Query().
+		Where(useraccommodation.UserID(targetUserId)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, db.Rollback(tx, err)
+	}
+
+	var result *ent.UserAccommodation
+	if existing == nil {
+		result, err = tx.UserAccommodation.Create().
+			SetUserID(targetUserId).
+			SetNillableTimeMultiplier(input.TimeMultiplier).
+			SetNillableExtraMinutes(input.ExtraMinutes).
+			SetNillableAllowRestBreaks(input.AllowRestBreaks).
+			SetNillableMaxRestBreaks(input.MaxRestBreaks).
+			SetNillableMaxRestBreakMinutes(input.MaxRestBreakMinutes).
+			SetNillableNotes(input.Notes).
+			Save(ctx)
+	} else {
+		result, err = tx.UserAccommodation.UpdateOne(existing).
+			SetNillableTimeMultiplier(input.TimeMultiplier).
+			SetNillableExtraMinutes(input.ExtraMinutes).
+			SetNillableAllowRestBreaks(input.AllowRestBreaks).
+			SetNillableMaxRestBreaks(input.MaxRestBreaks).
+			SetNillableMaxRestBreakMinutes(input.MaxRestBreakMinutes).
+			SetNillableNotes(input.Notes).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetUserAccommodation returns the accommodation of a user, or nil when the user has none.
+// Users can read their own accommodation, admins and owners can read everyone's.
+func GetUserAccommodation(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, targetUserId uuid.UUID) (*ent.UserAccommodation, error) {
+	if !isAdminOrOwner && userId != targetUserId {
+		return nil, errors.New("unauthorized: cannot read the accommodation of another user")
+	}
+
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := client.User.Get(ctx, targetUserId); err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	result, err := client.UserAccommodation.Query().
+		Where(useraccommodation.UserID(targetUserId)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return result, err
+}
+
+// SetTestSessionAccommodation overrides the user accommodation for a single pending session,
+// only if the user can proctor its test. Fields missing from the input keep their current value.
+func SetTestSessionAccommodation(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID, input model.AccommodationInput) (*ent.TestSession, error) {
+	if err := validateAccommodationInput(input); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := getProctoredSession(ctx, tx, userId, isAdminOrOwner, sessionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if session.Status != testsession.StatusPending {
+		return nil, db.Rollback(tx, errors.New("accommodations can only be set before the session starts, extend the session instead"))
+	}
+
+	session, err = tx.TestSession.UpdateOneID(session.ID).
+		SetNillableTimeMultiplier(input.TimeMultiplier).
+		SetNillableExtraMinutes(input.ExtraMinutes).
+		SetNillableAllowRestBreaks(input.AllowRestBreaks).
+		SetNillableMaxRestBreaks(input.MaxRestBreaks).
+		SetNillableMaxRestBreakMinutes(input.MaxRestBreakMinutes).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// PauseTestSession starts a rest break on the running session of the user, the clock is stopped until it resumes.
+func PauseTestSession(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) (*ent.TestSession, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := getRunningSessionOfUser(ctx, tx, userId, sessionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	resolved, err := resolveAccommodation(ctx, tx.Client(), session)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !resolved.AllowRestBreaks {
+		return nil, db.Rollback(tx, errors.New("rest breaks are not allowed for this session"))
+	}
+	if resolved.MaxRestBreaks != nil {
+		taken, err := tx.TestSessionBreak.Query().
+			Where(testsessionbreak.SessionID(session.ID)).
+			Count(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		if taken >= *resolved.MaxRestBreaks {
+			return nil, db.Rollback(tx, errors.New("no rest breaks left for this session"))
+		}
+	}
+
+	now := time.Now()
+	_, err = tx.TestSessionBreak.Create().
+		SetSessionID(session.ID).
+		SetStartedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	session, err = tx.TestSession.UpdateOneID(session.ID).
+		SetPausedAt(now).
+		SetLastActivityAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	PublishSessionEvent(session)
+
+	return session, nil
+}
+
+// ResumeTestSession ends the rest break of the user's session and pushes back its expiry time by the break duration,
+// up to the break length allowed by the accommodation.
+func ResumeTestSession(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) (*ent.TestSession, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := tx.TestSession.Query().
+		Where(
+			testsession.ID(sessionId),
+			testsession.UserID(userId),
+			testsession.StatusEQ(testsession.StatusInProgress),
+			testsession.PausedAtNotNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("test session not found or not paused"))
+	}
+
+	resolved, err := resolveAccommodation(ctx, tx.Client(), session)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	now := time.Now()
+	breakDuration := now.Sub(*session.PausedAt)
+	if resolved.MaxRestBreakMinutes != nil {
+		breakDuration = min(breakDuration, time.Duration(*resolved.MaxRestBreakMinutes)*time.Minute)
+	}
+
+	if err := endRestBreak(ctx, tx, session.ID, now); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	update := tx.TestSession.UpdateOneID(session.ID).
+		ClearPausedAt().
+		SetLastActivityAt(now)
+	if session.ExpiredAt != nil {
+		update = update.SetExpiredAt(session.ExpiredAt.Add(breakDuration))
+	}
+	session, err = update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	PublishSessionEvent(session)
+
+	return session, nil
+}
+
+// GetTestSessionTimeExtensions returns the time extensions granted on a session, only if the user can proctor its test.
+func GetTestSessionTimeExtensions(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) ([]*ent.TestSessionTimeExtension, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.TestSession.Get(ctx, sessionId)
+	if err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+	if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, session.TestID); err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+
+	return client.TestSessionTimeExtension.Query().
+		Where(testsessiontimeextension.SessionID(sessionId)).
+		Order(ent.Asc(testsessiontimeextension.FieldCreatedAt)).
+		All(ctx)
+}
+
+// resolveAccommodation merges the accommodation of the session's user with the overrides of the session.
+func resolveAccommodation(ctx context.Context, client *ent.Client, session *ent.TestSession) (accommodation, error) {
+	resolved := accommodation{TimeMultiplier: 1}
+
+	if session.UserID != nil {
+		userAccommodation, err := client.UserAccommodation.Query().
+			Where(useraccommodation.UserID(*session.UserID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return resolved, err
+		}
+		if userAccommodation != nil {
+			resolved.TimeMultiplier = userAccommodation.TimeMultiplier
+			resolved.ExtraMinutes = userAccommodation.ExtraMinutes
+			resolved.AllowRestBreaks = userAccommodation.AllowRestBreaks
+			resolved.MaxRestBreaks = userAccommodation.MaxRestBreaks
+			resolved.MaxRestBreakMinutes = userAccommodation.MaxRestBreakMinutes
+		}
+	}
+
+	if session.TimeMultiplier != nil {
+		resolved.TimeMultiplier = *session.TimeMultiplier
+	}
+	if session.ExtraMinutes != nil {
+		resolved.ExtraMinutes = *session.ExtraMinutes
+	}
+	if session.AllowRestBreaks != nil {
+		resolved.AllowRestBreaks = *session.AllowRestBreaks
+	}
+	if session.MaxRestBreaks != nil {
+		resolved.MaxRestBreaks = session.MaxRestBreaks
+	}
+	if session.MaxRestBreakMinutes != nil {
+		resolved.MaxRestBreakMinutes = session.MaxRestBreakMinutes
+	}
+
+	return resolved, nil
+}
+
+// Duration returns the time given for a test of totalTime minutes once the accommodation is applied.
+func (a accommodation) Duration(totalTime int) time.Duration {
+	minutes := float64(totalTime)*a.TimeMultiplier + float64(a.ExtraMinutes)
+	return time.Duration(minutes * float64(time.Minute))
+}
+
+// ExpiresAt returns when the time of a session is up, nil when it never is. The clock of a paused session is stopped
+// for at most the break length allowed, so a paused session only expires when that length is limited.
+func (a accommodation) ExpiresAt(session *ent.TestSession) *time.Time {
+	if session.ExpiredAt == nil || (session.PausedAt != nil && a.MaxRestBreakMinutes == nil) {
+		return nil
+	}
+
+	expiresAt := *session.ExpiredAt
+	if session.PausedAt != nil {
+		expiresAt = expiresAt.Add(time.Duration(*a.MaxRestBreakMinutes) * time.Minute)
+	}
+	return &expiresAt
+}
+
+// endRestBreak ends the open rest break of a session, if any.
+func endRestBreak(ctx context.Context, tx *ent.Tx, sessionId uuid.UUID, endedAt time.Time) error {
+	_, err := tx.TestSessionBreak.Update().
+		Where(testsessionbreak.SessionID(sessionId), testsessionbreak.EndedAtIsNil()).
+		SetEndedAt(endedAt).
+		Save(ctx)
+	return err
+}
+
+func validateAccommodationInput(input model.AccommodationInput) error {
+	if input.TimeMultiplier != nil && *input.TimeMultiplier < 1 {
+		return errors.New("timeMultiplier must be greater than or equal to 1")
+	}
+	if input.ExtraMinutes != nil && *input.ExtraMinutes < 0 {
+		return errors.New("extraMinutes must not be negative")
+	}
+	if input.MaxRestBreaks != nil && *input.MaxRestBreaks < 0 {
+		return errors.New("maxRestBreaks must not be negative")
+	}
+	if input.MaxRestBreakMinutes != nil && *input.MaxRestBreakMinutes < 1 {
+		return errors.New("maxRestBreakMinutes must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package test_session
+
+import (
+	"encoding/json"
+	"template/internal/ent"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// selectedOptionsKey is the metadata key where the texts of the selected options of an answer are stored.
+	selectedOptionsKey = "selected_options"
+	// selectedOptionIdsKey is the metadata key where the selected option ids of an answer are stored.
+	selectedOptionIdsKey = "selected_option_ids"
+	// questionSnapshotKey is the metadata key where the question as shown at session start is stored.
+	questionSnapshotKey = "question_snapshot"
+)
+
+// AnswerMetadata represents the structured metadata stored in test_session_answers
+type AnswerMetadata struct {
+	SelectedOptions   []string          `json:"selected_options,omitempty"`
+	SelectedOptionIds []string          `json:"selected_option_ids,omitempty"`
+	QuestionSnapshot  *QuestionSnapshot `json:"question_snapshot,omitempty"`
+}
+
+// QuestionSnapshot is a copy of a question and its options taken when the session starts,
+// so the result of the session doesn't change when the question is edited afterwards.
+type QuestionSnapshot struct {
+	QuestionText string           `json:"question_text"`
+	Points       int              `json:"points"`
+	Explanation  *string          `json:"explanation,omitempty"`
+	Options      []OptionSnapshot `json:"options"`
+}
+
+// OptionSnapshot is a copy of a question option taken when the session starts.
+type OptionSnapshot struct {
+	ID          uuid.UUID `json:"id"`
+	OptionText  string    `json:"option_text"`
+	IsCorrect   bool      `json:"is_correct"`
+	Explanation *string   `json:"explanation,omitempty"`
+}
+
+// newQuestionSnapshot builds the snapshot of a question loaded with its options.
+func newQuestionSnapshot(q *ent.Question) QuestionSnapshot {
+	options := make([]OptionSnapshot, 0, len(q.Edges.QuestionOptions))
+	for _, option := range q.Edges.QuestionOptions {
+		options = append(options, OptionSnapshot{
+			ID:          option.ID,
+			OptionText:  option.OptionText,
+			IsCorrect:   option.IsCorrect,
+			Explanation: option.Explanation,
+		})
+	}
+
+	return QuestionSnapshot{
+		QuestionText: q.QuestionText,
+		Points:       q.Points,
+		Explanation:  q.Explanation,
+		Options:      options,
+	}
+}
+
+// getQuestionSnapshot returns the question snapshot stored in the metadata of an answer,
+// or nil for answers created before snapshots were stored.
+func getQuestionSnapshot(answer *ent.TestSessionAnswer) *QuestionSnapshot {
+	if _, ok := answer.Metadata[questionSnapshotKey]; !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(answer.Metadata)
+	if err != nil {
+		return nil
+	}
+
+	var metadata AnswerMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil
+	}
+	return metadata.QuestionSnapshot
+}
+
+// getSelectedOptionIds returns the options selected in an answer. Answers graded before the option ids
+// were stored only have the selected option texts, which are matched against the options of the snapshot.
+func getSelectedOptionIds(answer *ent.TestSessionAnswer, snapshot *QuestionSnapshot) []uuid.UUID {
+	if _, ok := answer.Metadata[selectedOptionIdsKey]; ok {
+		return getSavedOptionIds(answer)
+	}
+
+	rawTexts, ok := answer.Metadata[selectedOptionsKey].([]interface{})
+	if !ok {
+		return []uuid.UUID{}
+	}
+
+	optionIds := []uuid.UUID{}
+	for _, rawText := range rawTexts {
+		text, ok := rawText.(string)
+		if !ok {
+			continue
+		}
+		option := slice.Find(snapshot.Options, func(o OptionSnapshot) bool { return o.OptionText == text })
+		if option != nil {
+			optionIds = append(optionIds, option.ID)
+		}
+	}
+	return optionIds
+}
+
+// mergeAnswerMetadata returns a copy of the metadata of an answer with the values set, other keys are kept.
+func mergeAnswerMetadata(metadata map[string]interface{}, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(metadata)+len(values))
+	for key, value := range metadata {
+		merged[key] = value
+	}
+	for key, value := range values {
+		merged[key] = value
+	}
+	return merged
+}
+
+// withAnswerKey returns a copy of the snapshot where the correctness of the options follows the current options of the question,
+// options replaced by an edit are matched by their text.
+func (s QuestionSnapshot) withAnswerKey(questionOptions []*ent.QuestionOption) QuestionSnapshot {
+	options := make([]OptionSnapshot, 0, len(s.Options))
+	for _, option := range s.Options {
+		current := slice.Find(questionOptions, func(qo *ent.QuestionOption) bool { return qo.ID == option.ID })
+		if current == nil {
+			current = slice.Find(questionOptions, func(qo *ent.QuestionOption) bool { return qo.OptionText == option.OptionText })
+		}
+		if current != nil {
+			option.IsCorrect = (*current).IsCorrect
+		}
+		options = append(options, option)
+	}
+	s.Options = options
+	return s
+}
@@ -0,0 +1,148 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetUserTestAttempts returns the attempts of a user on a test and the final grade derived from the scoring policy of the test.
+// Users can read their own attempts, admins and the proctors of the test can read everyone's.
+func GetUserTestAttempts(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID, targetUserId uuid.UUID) (*model.UserTestAttempts, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if userId != targetUserId {
+		if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, testId); err != nil {
+			return nil, err
+		}
+	}
+
+	testEntity, err := client.Test.Get(ctx, testId)
+	if err != nil {
+		return nil, errors.New("test not found or unauthorized")
+	}
+
+	attempts, err := queryStartedAttempts(ctx, client, targetUserId, testId)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := slice.Filter(attempts, func(s *ent.TestSession) bool {
+		return s.Status == testsession.StatusCompleted
+	})
+	finalPoints, finalPercent := ComputeFinalGrade(testEntity.ScoringPolicy, completed)
+
+	result := &model.UserTestAttempts{
+		TestID:                 testId,
+		UserID:                 targetUserId,
+		ScoringPolicy:          model.ConvertEntScoringPolicyToModel(testEntity.ScoringPolicy),
+		MaxAttempts:            testEntity.MaxAttempts,
+		AttemptsUsed:           len(attempts),
+		NextAttemptAvailableAt: nextAttemptAvailableAt(testEntity, attempts, time.Now()),
+		Attempts:               slice.Map(attempts, model.ConvertTestSessionToModel),
+		FinalPoints:            finalPoints,
+		FinalPercent:           finalPercent,
+	}
+	if testEntity.MaxAttempts != nil {
+		remaining := max(*testEntity.MaxAttempts-len(attempts), 0)
+		result.AttemptsRemaining = &remaining
+	}
+
+	return result, nil
+}
+
+// ComputeFinalGrade derives the final points and percent of a user from their completed attempts.
+// Highest keeps the attempt with the best percent, latest the last completed one and average the mean of all of them.
+// Both values are nil when no attempt has been completed.
+func ComputeFinalGrade(policy test.ScoringPolicy, completed []*ent.TestSession) (*float64, *float64) {
+	if len(completed) == 0 {
+		return nil, nil
+	}
+
+	switch policy {
+	case test.ScoringPolicyLatest:
+		latest := completed[0]
+		for _, s := range completed[1:] {
+			if s.CompletedAt != nil && (latest.CompletedAt == nil || s.CompletedAt.After(*latest.CompletedAt)) {
+				latest = s
+			}
+		}
+		points, percent := sessionGrade(latest)
+		return &points, &percent
+	case test.ScoringPolicyAverage:
+		totalPoints, totalPercent := 0.0, 0.0
+		for _, s := range completed {
+			points, percent := sessionGrade(s)
+			totalPoints += points
+			totalPercent += percent
+		}
+		points := totalPoints / float64(len(completed))
+		percent := totalPercent / float64(len(completed))
+		return &points, &percent
+	default:
+		best := completed[0]
+		_, bestPercent := sessionGrade(best)
+		for _, s := range completed[1:] {
+			if _, percent := sessionGrade(s); percent > bestPercent {
+				best, bestPercent = s, percent
+			}
+		}
+		points, percent := sessionGrade(best)
+		return &points, &percent
+	}
+}
+
+// queryStartedAttempts returns the sessions of a user on a test that have been started, oldest first.
+func queryStartedAttempts(ctx context.Context, client *ent.Client, userId uuid.UUID, testId uuid.UUID) ([]*ent.TestSession, error) {
+	return client.TestSession.Query().
+		Where(
+			testsession.UserID(userId),
+			testsession.TestID(testId),
+			testsession.StartedAtNotNil(),
+		).
+		Order(ent.Asc(testsession.FieldStartedAt)).
+		All(ctx)
+}
+
+// nextAttemptAvailableAt returns when the cooldown after the last completed attempt ends, or nil if it already ended.
+func nextAttemptAvailableAt(testEntity *ent.Test, attempts []*ent.TestSession, now time.Time) *time.Time {
+	if testEntity.AttemptCooldownMinutes == nil {
+		return nil
+	}
+
+	var lastCompletedAt *time.Time
+	for _, s := range attempts {
+		if s.CompletedAt != nil && (lastCompletedAt == nil || s.CompletedAt.After(*lastCompletedAt)) {
+			lastCompletedAt = s.CompletedAt
+		}
+	}
+	if lastCompletedAt == nil {
+		return nil
+	}
+
+	availableAt := lastCompletedAt.Add(time.Duration(*testEntity.AttemptCooldownMinutes) * time.Minute)
+	if !availableAt.After(now) {
+		return nil
+	}
+	return &availableAt
+}
+
+// sessionGrade returns the points earned in a session and their percent of the maximum points.
+func sessionGrade(session *ent.TestSession) (float64, float64) {
+	points := float64(session.PointsEarned)
+	if session.MaxPoints == 0 {
+		return points, 0
+	}
+	return points, points * 100 / float64(session.MaxPoints)
+}
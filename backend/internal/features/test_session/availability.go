@@ -0,0 +1,50 @@
+package test_session
+
+import (
+	"context"
+	"fmt"
+	"template/internal/ent"
+	"template/internal/features/common"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// checkTestAvailability makes sure a session can be started now: the window of the assignment, or else of the test,
+// must be open, the user must not have used all the attempts of the test and the cooldown since their last attempt must be over.
+func checkTestAvailability(ctx context.Context, tx *ent.Tx, userId uuid.UUID, session *ent.TestSession, testEntity *ent.Test, now time.Time) error {
+	openAt := testEntity.OpenAt
+	if session.OpenAt != nil {
+		openAt = session.OpenAt
+	}
+	closeAt := testEntity.CloseAt
+	if session.CloseAt != nil {
+		closeAt = session.CloseAt
+	}
+
+	// Windows are stored in UTC, compare in UTC so the error messages are unambiguous
+	now = now.UTC()
+	if openAt != nil && now.Before(openAt.UTC()) {
+		return common.NewBadRequestError(fmt.Sprintf("test is not open yet, it opens at %s", openAt.UTC().Format(time.RFC3339)))
+	}
+	if closeAt != nil && !now.Before(closeAt.UTC()) {
+		return common.NewBadRequestError(fmt.Sprintf("test is closed since %s", closeAt.UTC().Format(time.RFC3339)))
+	}
+
+	if testEntity.MaxAttempts == nil && testEntity.AttemptCooldownMinutes == nil {
+		return nil
+	}
+
+	attempts, err := queryStartedAttempts(ctx, tx.Client(), userId, testEntity.ID)
+	if err != nil {
+		return err
+	}
+	if testEntity.MaxAttempts != nil && len(attempts) >= *testEntity.MaxAttempts {
+		return common.NewBadRequestError(fmt.Sprintf("maximum number of attempts reached (%d)", *testEntity.MaxAttempts))
+	}
+	if availableAt := nextAttemptAvailableAt(testEntity, attempts, now); availableAt != nil {
+		return common.NewBadRequestError(fmt.Sprintf("next attempt is available at %s", availableAt.UTC().Format(time.RFC3339)))
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package test_session
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// GetCandidateQuestions returns the questions of a session as shown to its candidate, from the snapshot taken at
+// session start. The answer key is never included and the options are shuffled, always in the same way for a session.
+// Only the candidate and the users who can proctor the test can read them.
+func GetCandidateQuestions(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) ([]*model.CandidateQuestion, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.TestSession.Get(ctx, sessionId)
+	if err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+	isCandidate := session.UserID != nil && *session.UserID == userId
+	if !isCandidate {
+		if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, session.TestID); err != nil {
+			return nil, errors.New("test session not found or unauthorized")
+		}
+	}
+
+	answers, err := client.TestSessionAnswer.Query().
+		Where(testsessionanswer.SessionID(sessionId)).
+		WithQuestion(func(q *ent.QuestionQuery) {
+			q.WithQuestionOptions(func(oq *ent.QuestionOptionQuery) {
+				oq.Order(ent.Asc(questionoption.FieldCreatedAt))
+			})
+		}).
+		Order(testsessionanswer.ByOrder()).
+		All(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]*model.CandidateQuestion, 0, len(answers))
+	for _, answer := range answers {
+		snapshot := getQuestionSnapshot(answer)
+		if snapshot == nil {
+			if answer.Edges.Question == nil {
+				continue
+			}
+			currentSnapshot := newQuestionSnapshot(answer.Edges.Question)
+			snapshot = &currentSnapshot
+		}
+
+		correctCount := len(slice.Filter(snapshot.Options, func(o OptionSnapshot) bool { return o.IsCorrect }))
+		options := slice.Map(snapshot.Options, func(o OptionSnapshot) *model.CandidateQuestionOption {
+			return &model.CandidateQuestionOption{ID: o.ID, OptionText: o.OptionText}
+		})
+		shuffleOptions(options, sessionId, answer.QuestionID)
+
+		questions = append(questions, &model.CandidateQuestion{
+			ID:               answer.QuestionID,
+			QuestionText:     snapshot.QuestionText,
+			Points:           snapshot.Points,
+			Order:            answer.Order,
+			IsMultipleChoice: correctCount > 1,
+			Options:          options,
+		})
+	}
+
+	return questions, nil
+}
+
+// shuffleOptions shuffles the options with a seed derived from the session and the question,
+// so reloading the session shows the options in the same order.
+func shuffleOptions(options []*model.CandidateQuestionOption, sessionId uuid.UUID, questionId uuid.UUID) {
+	hash := fnv.New64a()
+	hash.Write(sessionId[:])
+	hash.Write(questionId[:])
+	seed := int64(binary.BigEndian.Uint64(hash.Sum(nil)))
+
+	random := rand.New(rand.NewSource(seed))
+	random.Shuffle(len(options), func(i, j int) {
+		options[i], options[j] = options[j], options[i]
+	})
+}
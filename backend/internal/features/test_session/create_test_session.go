@@ -6,6 +6,7 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/testquestioncount"
+	"template/internal/features/common"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
@@ -21,14 +22,33 @@ func CreateTestSession(ctx context.Context, input model.CreateTestSessionInput)
 		return nil, err
 	}
 
+	sessions, err := CreateSessions(ctx, tx, input, nil)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// CreateSessions creates a test session for each user of the input within the transaction,
+// linked to the group assignment they are created for when groupAssignmentId is set.
+func CreateSessions(ctx context.Context, tx *ent.Tx, input model.CreateTestSessionInput, groupAssignmentId *uuid.UUID) ([]*ent.TestSession, error) {
 	// Get the test and calculate max points from test question counts
 	maxPoints, err := calculateMaxPointsForTest(ctx, tx, input.TestID)
 	if err != nil {
-		return nil, db.Rollback(tx, fmt.Errorf("failed to calculate max points: %w", err))
+		return nil, fmt.Errorf("failed to calculate max points: %w", err)
 	}
 
 	if len(input.UserIds) == 0 {
-		return nil, db.Rollback(tx, fmt.Errorf("at least one userID is required to create a test session"))
+		return nil, fmt.Errorf("at least one userID is required to create a test session")
+	}
+
+	if err := common.ValidateTimeWindow(input.OpenAt, input.CloseAt); err != nil {
+		return nil, err
 	}
 
 	var sessions []*ent.TestSession
@@ -37,7 +57,10 @@ func CreateTestSession(ctx context.Context, input model.CreateTestSessionInput)
 		builder := tx.TestSession.Create().
 			SetTestID(input.TestID).
 			SetUserID(userID).
-			SetMaxPoints(maxPoints)
+			SetNillableGroupAssignmentID(groupAssignmentId).
+			SetMaxPoints(maxPoints).
+			SetNillableOpenAt(common.ToUTC(input.OpenAt)).
+			SetNillableCloseAt(common.ToUTC(input.CloseAt))
 
 		if input.ExpiredTime != nil {
 			builder.SetExpiredAt(*input.ExpiredTime)
@@ -45,15 +68,11 @@ func CreateTestSession(ctx context.Context, input model.CreateTestSessionInput)
 
 		session, err := builder.Save(ctx)
 		if err != nil {
-			return nil, db.Rollback(tx, fmt.Errorf("failed to create test session for user %s: %w", userID, err))
+			return nil, fmt.Errorf("failed to create test session for user %s: %w", userID, err)
 		}
 		sessions = append(sessions, session)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
-
 	return sessions, nil
 }
 
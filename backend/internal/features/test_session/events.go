@@ -0,0 +1,36 @@
+package test_session
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/ent/testsession"
+	"template/internal/shared/utilities/pubsub"
+
+	"github.com/google/uuid"
+)
+
+// SessionEvent is published whenever the status or timing of a test session changes.
+type SessionEvent struct {
+	SessionID uuid.UUID
+	TestID    uuid.UUID
+	UserID    *uuid.UUID
+	Status    testsession.Status
+}
+
+var sessionEvents = pubsub.NewBroker[SessionEvent]()
+
+// PublishSessionEvent notifies the subscribers that a session changed.
+// It must be called after the transaction changing the session has been committed.
+func PublishSessionEvent(session *ent.TestSession) {
+	sessionEvents.Publish(SessionEvent{
+		SessionID: session.ID,
+		TestID:    session.TestID,
+		UserID:    session.UserID,
+		Status:    session.Status,
+	})
+}
+
+// SubscribeSessionEvents returns a channel receiving every session event until ctx is done.
+func SubscribeSessionEvents(ctx context.Context) <-chan SessionEvent {
+	return sessionEvents.Subscribe(ctx)
+}
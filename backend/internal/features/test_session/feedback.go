@@ -0,0 +1,27 @@
+package test_session
+
+import (
+	"template/internal/ent"
+	"template/internal/ent/test"
+	"time"
+)
+
+// resolveFeedbackRelease returns whether the feedback of a session is released following the feedback release
+// of its test, and when it is or will be released. A test released after close without close time releases it immediately.
+func resolveFeedbackRelease(testEntity *ent.Test, session *ent.TestSession, now time.Time) (bool, *time.Time) {
+	switch testEntity.FeedbackRelease {
+	case test.FeedbackReleaseNever:
+		return false, nil
+	case test.FeedbackReleaseAfterClose:
+		closeAt := testEntity.CloseAt
+		if session.CloseAt != nil {
+			closeAt = session.CloseAt
+		}
+		if closeAt == nil {
+			return true, nil
+		}
+		return !now.Before(*closeAt), closeAt
+	default:
+		return true, nil
+	}
+}
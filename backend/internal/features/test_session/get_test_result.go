@@ -3,10 +3,14 @@ package test_session
 import (
 	"context"
 	"fmt"
+	"template/internal/ent"
 	"template/internal/ent/db"
 	entTestSession "template/internal/ent/testsession"
 	entTestSessionAnswer "template/internal/ent/testsessionanswer"
 	"template/internal/graph/model"
+	"template/internal/shared/utilities/pointer"
+	"template/internal/shared/utilities/slice"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -33,6 +37,7 @@ func GetTestSessionResult(ctx context.Context, userID uuid.UUID, sessionID uuid.
 			entTestSession.FieldUpdatedAt,
 			entTestSession.FieldStartedAt,
 			entTestSession.FieldExpiredAt,
+			entTestSession.FieldCloseAt,
 		).
 		Only(ctx)
 
@@ -50,7 +55,16 @@ func GetTestSessionResult(ctx context.Context, userID uuid.UUID, sessionID uuid.
 		return nil, fmt.Errorf("test session is not completed")
 	}
 
-	// Get all test session answers
+	testEntity, err := client.Test.Get(ctx, session.TestID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins and owners always see the feedback, candidates only once it's released
+	feedbackReleased, feedbackReleaseAt := resolveFeedbackRelease(testEntity, session, time.Now())
+	includeFeedback := feedbackReleased || isAdminOrOwner
+
+	// Get all test session answers, the current question is only used for answers created before snapshots were stored
 	answers, err := client.TestSessionAnswer.Query().
 		Where(entTestSessionAnswer.SessionID(sessionID)).
 		Select(
@@ -58,46 +72,74 @@ func GetTestSessionResult(ctx context.Context, userID uuid.UUID, sessionID uuid.
 			entTestSessionAnswer.FieldIsCorrect,
 			entTestSessionAnswer.FieldMetadata,
 		).
+		WithQuestion(func(q *ent.QuestionQuery) {
+			q.WithQuestionOptions()
+		}).
+		Order(ent.Asc(entTestSessionAnswer.FieldOrder)).
 		All(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	// Build question results
+	// Build question results from the snapshot of each answer
 	questionResults := make([]*model.QuestionResult, 0, len(answers))
 
 	for _, answer := range answers {
-		// Parse metadata to extract selected options
-		var selectedOptions []*model.SelectedOption
-
-		if answer.Metadata != nil {
-			if selectedOptionsData, ok := answer.Metadata["selected_options"].([]interface{}); ok {
-				for _, opt := range selectedOptionsData {
-					if optionText, ok := opt.(string); ok {
-						selectedOptions = append(selectedOptions, &model.SelectedOption{
-							OptionText: optionText,
-						})
-					}
-				}
+		snapshot := getQuestionSnapshot(answer)
+		if snapshot == nil {
+			if answer.Edges.Question == nil {
+				continue
 			}
+			legacySnapshot := newQuestionSnapshot(answer.Edges.Question)
+			snapshot = &legacySnapshot
 		}
 
-		isCorrect := false
-		if answer.IsCorrect != nil {
-			isCorrect = *answer.IsCorrect
+		selectedOptionIds := getSelectedOptionIds(answer, snapshot)
+
+		var selectedOptions []*model.SelectedOption
+		options := make([]*model.QuestionResultOption, 0, len(snapshot.Options))
+		for _, option := range snapshot.Options {
+			isSelected := slice.Contains(selectedOptionIds, option.ID)
+			if isSelected {
+				selectedOptions = append(selectedOptions, &model.SelectedOption{
+					ID:         pointer.From(option.ID),
+					OptionText: option.OptionText,
+				})
+			}
+
+			resultOption := &model.QuestionResultOption{
+				ID:         option.ID,
+				OptionText: option.OptionText,
+				IsSelected: isSelected,
+			}
+			if includeFeedback {
+				resultOption.IsCorrect = pointer.From(option.IsCorrect)
+				resultOption.Explanation = option.Explanation
+			}
+			options = append(options, resultOption)
 		}
 
-		questionResults = append(questionResults, &model.QuestionResult{
+		questionResult := &model.QuestionResult{
 			Question:        &model.Question{ID: answer.QuestionID},
-			IsCorrect:       isCorrect,
+			QuestionText:    snapshot.QuestionText,
 			SelectedOptions: selectedOptions,
-		})
+			Options:         options,
+		}
+		// The correctness and the points of a question would give the answer key away with the selected options
+		if includeFeedback {
+			questionResult.Points = pointer.From(snapshot.Points)
+			questionResult.IsCorrect = pointer.From(answer.IsCorrect != nil && *answer.IsCorrect)
+			questionResult.Explanation = snapshot.Explanation
+		}
+		questionResults = append(questionResults, questionResult)
 	}
 
 	return &model.TestSessionResult{
-		ID:          session.ID,
-		TestSession: model.ConvertTestSessionToModel(session),
-		Questions:   questionResults,
+		ID:                session.ID,
+		TestSession:       model.ConvertTestSessionToModel(session),
+		FeedbackReleased:  feedbackReleased,
+		FeedbackReleaseAt: feedbackReleaseAt,
+		Questions:         questionResults,
 	}, nil
 }
@@ -2,6 +2,7 @@ package test_session
 
 import (
 	"context"
+	"errors"
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/testsession"
@@ -49,6 +50,11 @@ func PaginatedTestSessions(ctx context.Context, userId uuid.UUID, isAdminOrOwner
 		query = query.Where(testsession.StatusIn(convertStatusesToEntStatuses(filterInput.Statuses)...))
 	}
 
+	// Apply test filter if provided
+	if filterInput != nil && filterInput.TestID != nil {
+		query = query.Where(testsession.TestID(*filterInput.TestID))
+	}
+
 	// Sort by updatedAt descending (most recently updated first)
 	query = query.Order(ent.Desc(testsession.FieldUpdatedAt))
 
@@ -89,7 +95,8 @@ func convertStatusesToEntStatuses(statuses []model.TestSessionStatus) []testsess
 	return result
 }
 
-// DeleteTestSession deletes a test session by its ID.
+// DeleteTestSession deletes a pending test session by its ID.
+// The sessions already started hold the results of the candidate and are kept.
 func DeleteTestSession(ctx context.Context, sessionID uuid.UUID) (bool, error) {
 	// Start a transaction
 	tx, err := db.OpenTransaction(ctx)
@@ -97,6 +104,14 @@ func DeleteTestSession(ctx context.Context, sessionID uuid.UUID) (bool, error) {
 		return false, err
 	}
 
+	session, err := tx.TestSession.Get(ctx, sessionID)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("test session not found"))
+	}
+	if session.Status != testsession.StatusPending {
+		return false, db.Rollback(tx, errors.New("only pending test sessions can be deleted, the results of the candidate are kept"))
+	}
+
 	// Delete the test session within the transaction
 	err = tx.TestSession.DeleteOneID(sessionID).Exec(ctx)
 	if err != nil {
@@ -37,6 +37,50 @@ func TestSessionSelectFields(ctx context.Context) []string {
 		selectFields = append(selectFields, testsession.FieldCompletedAt)
 	}
 
+	if preloads["startedAt"] {
+		selectFields = append(selectFields, testsession.FieldStartedAt)
+	}
+
+	if preloads["expiredAt"] {
+		selectFields = append(selectFields, testsession.FieldExpiredAt)
+	}
+
+	if preloads["lastActivityAt"] {
+		selectFields = append(selectFields, testsession.FieldLastActivityAt)
+	}
+
+	if preloads["pausedAt"] {
+		selectFields = append(selectFields, testsession.FieldPausedAt)
+	}
+
+	if preloads["timeMultiplier"] {
+		selectFields = append(selectFields, testsession.FieldTimeMultiplier)
+	}
+
+	if preloads["extraMinutes"] {
+		selectFields = append(selectFields, testsession.FieldExtraMinutes)
+	}
+
+	if preloads["allowRestBreaks"] {
+		selectFields = append(selectFields, testsession.FieldAllowRestBreaks)
+	}
+
+	if preloads["maxRestBreaks"] {
+		selectFields = append(selectFields, testsession.FieldMaxRestBreaks)
+	}
+
+	if preloads["maxRestBreakMinutes"] {
+		selectFields = append(selectFields, testsession.FieldMaxRestBreakMinutes)
+	}
+
+	if preloads["openAt"] {
+		selectFields = append(selectFields, testsession.FieldOpenAt)
+	}
+
+	if preloads["closeAt"] {
+		selectFields = append(selectFields, testsession.FieldCloseAt)
+	}
+
 	if preloads["createdAt"] {
 		selectFields = append(selectFields, testsession.FieldCreatedAt)
 	}
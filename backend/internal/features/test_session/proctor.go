@@ -0,0 +1,376 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/question"
+	"template/internal/ent/questionoption"
+	"template/internal/ent/test"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionintegrityevent"
+	"template/internal/features/access"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetTestLiveStatus returns the live status of every session of a test, only if the user can proctor the test.
+func GetTestLiveStatus(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID) ([]*model.TestSessionLiveStatus, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, testId); err != nil {
+		return nil, err
+	}
+
+	sessions, err := client.TestSession.Query().
+		Where(testsession.TestID(testId)).
+		WithTestSessionAnswers(func(q *ent.TestSessionAnswerQuery) {
+			q.Select(testsessionanswer.FieldSessionID, testsessionanswer.FieldPoints, testsessionanswer.FieldMetadata)
+		}).
+		WithIntegrityEvents(func(q *ent.TestSessionIntegrityEventQuery) {
+			q.Order(ent.Asc(testsessionintegrityevent.FieldOccurredAt))
+		}).
+		Order(ent.Asc(testsession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Map(sessions, func(s *ent.TestSession) *model.TestSessionLiveStatus {
+		session := model.ConvertTestSessionToModel(s)
+		answeredCount := len(slice.Filter(s.Edges.TestSessionAnswers, isAnswered))
+
+		return &model.TestSessionLiveStatus{
+			SessionID:       s.ID,
+			UserID:          s.UserID,
+			Status:          session.Status,
+			StartedAt:       s.StartedAt,
+			ExpiredAt:       s.ExpiredAt,
+			AnsweredCount:   answeredCount,
+			TotalQuestions:  len(s.Edges.TestSessionAnswers),
+			LastActivityAt:  s.LastActivityAt,
+			IntegrityEvents: slice.Map(s.Edges.IntegrityEvents, model.ConvertTestSessionIntegrityEventToModel),
+		}
+	}), nil
+}
+
+// SaveTestSessionAnswer autosaves the options selected by the candidate for a question of their running session.
+func SaveTestSessionAnswer(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, input model.TestSessionAnswerInput) (bool, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	session, err := getRunningSessionOfUser(ctx, tx, userId, sessionId)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if slice.HasDuplicates(input.QuestionOptionIds) {
+		return false, db.Rollback(tx, errors.New("question options must not contain duplicates"))
+	}
+
+	optionCount, err := tx.QuestionOption.Query().
+		Where(questionoption.IDIn(input.QuestionOptionIds...), questionoption.HasQuestionWith(question.ID(input.QuestionID))).
+		Count(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+	if optionCount != len(input.QuestionOptionIds) {
+		return false, db.Rollback(tx, errors.New("question options do not belong to the question"))
+	}
+
+	answer, err := tx.TestSessionAnswer.Query().
+		Where(
+			testsessionanswer.SessionID(session.ID),
+			testsessionanswer.QuestionID(input.QuestionID),
+			testsessionanswer.PointsIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, errors.New("question is not part of the test session"))
+	}
+
+	optionIds := slice.Map(input.QuestionOptionIds, func(id uuid.UUID) string {
+		return id.String()
+	})
+	err = tx.TestSessionAnswer.UpdateOne(answer).
+		SetMetadata(mergeAnswerMetadata(answer.Metadata, map[string]interface{}{
+			selectedOptionIdsKey: optionIds,
+		})).
+		Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	err = tx.TestSession.UpdateOneID(session.ID).SetLastActivityAt(time.Now()).Exec(ctx)
+	if err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReportIntegrityEvent records an integrity event raised by the candidate's client during their running session.
+func ReportIntegrityEvent(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, input model.ReportIntegrityEventInput) (*ent.TestSessionIntegrityEvent, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := getRunningSessionOfUser(ctx, tx, userId, sessionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	now := time.Now()
+	occurredAt := now
+	if input.OccurredAt != nil {
+		occurredAt = *input.OccurredAt
+	}
+
+	event, err := tx.TestSessionIntegrityEvent.Create().
+		SetSessionID(session.ID).
+		SetEventType(model.ConvertIntegrityEventTypeToEnt(input.EventType)).
+		SetNillableDetails(input.Details).
+		SetOccurredAt(occurredAt).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	err = tx.TestSession.UpdateOneID(session.ID).SetLastActivityAt(now).Exec(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// ExtendTestSession pushes back the expiry time of a pending or running session, only if the user can proctor its test.
+// Every extension is recorded with its reason for auditing.
+func ExtendTestSession(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID, minutes int, reason string) (*ent.TestSession, error) {
+	if minutes <= 0 {
+		return nil, errors.New("minutes must be greater than 0")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("a reason is required to extend a test session")
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := getProctoredSession(ctx, tx, userId, isAdminOrOwner, sessionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if session.Status != testsession.StatusPending && session.Status != testsession.StatusInProgress {
+		return nil, db.Rollback(tx, errors.New("only pending or in progress sessions can be extended"))
+	}
+	if session.ExpiredAt == nil {
+		return nil, db.Rollback(tx, errors.New("test session has no time limit"))
+	}
+
+	resolved, err := resolveAccommodation(ctx, tx.Client(), session)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	// A session whose time is already up restarts counting from now. A paused one is pushed back by the time spent
+	// beyond its allowed break, which its resume adds back, so it restarts counting from now as well.
+	previousExpiredAt := *session.ExpiredAt
+	expiredAt := previousExpiredAt
+	if expiresAt, now := resolved.ExpiresAt(session), time.Now(); expiresAt != nil && expiresAt.Before(now) {
+		expiredAt = expiredAt.Add(now.Sub(*expiresAt))
+	}
+	newExpiredAt := expiredAt.Add(time.Duration(minutes) * time.Minute)
+
+	session, err = tx.TestSession.UpdateOneID(session.ID).
+		SetExpiredAt(newExpiredAt).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	_, err = tx.TestSessionTimeExtension.Create().
+		SetSessionID(session.ID).
+		SetExtendedByID(userId).
+		SetMinutes(minutes).
+		SetReason(reason).
+		SetPreviousExpiredAt(previousExpiredAt).
+		SetNewExpiredAt(newExpiredAt).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	PublishSessionEvent(session)
+
+	return session, nil
+}
+
+// ForceSubmitTestSession submits a running session on behalf of the candidate with their autosaved answers,
+// only if the user can proctor its test. Questions without an autosaved answer earn no points.
+func ForceSubmitTestSession(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) (*ent.TestSession, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := getProctoredSession(ctx, tx, userId, isAdminOrOwner, sessionId)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if session.Status != testsession.StatusInProgress {
+		return nil, db.Rollback(tx, errors.New("only in progress sessions can be submitted"))
+	}
+
+	blankAnswers, err := tx.TestSessionAnswer.Query().
+		Where(testsessionanswer.SessionID(session.ID), testsessionanswer.PointsIsNil()).
+		Select(testsessionanswer.FieldQuestionID, testsessionanswer.FieldMetadata).
+		All(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	input := model.SubmitTestSessionInput{
+		Answers: slice.Map(blankAnswers, func(a *ent.TestSessionAnswer) *model.TestSessionAnswerInput {
+			return &model.TestSessionAnswerInput{
+				QuestionID:        a.QuestionID,
+				QuestionOptionIds: getSavedOptionIds(a),
+			}
+		}),
+	}
+
+	totalPoints, err := calculatePoints(ctx, tx, session, input)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	// A paused session is submitted as is, its rest break ends with it
+	now := time.Now()
+	if err := endRestBreak(ctx, tx, session.ID, now); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	session, err = tx.TestSession.UpdateOneID(session.ID).
+		SetStatus(testsession.StatusCompleted).
+		SetCompletedAt(now).
+		ClearPausedAt().
+		SetPointsEarned(totalPoints).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	PublishSessionEvent(session)
+
+	return session, nil
+}
+
+// checkCanProctorTest makes sure the user is an admin or can edit the test.
+func checkCanProctorTest(ctx context.Context, client *ent.Client, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID) error {
+	query := client.Test.Query().Where(test.ID(testId))
+	if !isAdminOrOwner {
+		query = query.Where(access.TestAccess(userId, access.LevelEditor))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("test not found or unauthorized")
+	}
+
+	return nil
+}
+
+// getProctoredSession fetches a session whose test can be proctored by the user.
+func getProctoredSession(ctx context.Context, tx *ent.Tx, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) (*ent.TestSession, error) {
+	session, err := tx.TestSession.Get(ctx, sessionId)
+	if err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+
+	if err := checkCanProctorTest(ctx, tx.Client(), userId, isAdminOrOwner, session.TestID); err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+
+	return session, nil
+}
+
+// getRunningSessionOfUser fetches an in progress session of the user whose time is not up.
+func getRunningSessionOfUser(ctx context.Context, tx *ent.Tx, userId uuid.UUID, sessionId uuid.UUID) (*ent.TestSession, error) {
+	session, err := tx.TestSession.Query().
+		Where(
+			testsession.ID(sessionId),
+			testsession.UserID(userId),
+			testsession.StatusEQ(testsession.StatusInProgress),
+			testsession.PausedAtIsNil(),
+			testsession.Or(
+				testsession.ExpiredAtIsNil(),
+				testsession.ExpiredAtGT(time.Now()),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("test session not found or not in progress")
+	}
+
+	return session, nil
+}
+
+// isAnswered reports whether the candidate submitted or autosaved an answer for the question.
+func isAnswered(answer *ent.TestSessionAnswer) bool {
+	return answer.Points != nil || len(getSavedOptionIds(answer)) > 0
+}
+
+// getSavedOptionIds returns the option ids autosaved in the metadata of an answer.
+func getSavedOptionIds(answer *ent.TestSessionAnswer) []uuid.UUID {
+	rawIds, ok := answer.Metadata[selectedOptionIdsKey].([]interface{})
+	if !ok {
+		return []uuid.UUID{}
+	}
+
+	optionIds := make([]uuid.UUID, 0, len(rawIds))
+	for _, rawId := range rawIds {
+		id, ok := rawId.(string)
+		if !ok {
+			continue
+		}
+		if optionId, err := uuid.Parse(id); err == nil {
+			optionIds = append(optionIds, optionId)
+		}
+	}
+	return optionIds
+}
@@ -0,0 +1,269 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/predicate"
+	"template/internal/ent/question"
+	"template/internal/ent/testsession"
+	"template/internal/ent/testsessionanswer"
+	"template/internal/ent/testsessionregrade"
+	"template/internal/features/access"
+	questionFeat "template/internal/features/question"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// RegradeQuestion recomputes the graded answers of a question in every completed session from the stored selections,
+// only if the user is an admin or can edit the question's collection. With dryRun nothing is persisted.
+func RegradeQuestion(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, questionId uuid.UUID, dryRun bool) (*model.RegradeResult, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	questionQuery := tx.Question.Query().Where(question.ID(questionId))
+	if !isAdminOrOwner {
+		questionQuery = questionQuery.Where(question.HasCollectionWith(access.QuestionCollectionAccess(userId, access.LevelEditor)))
+	}
+	exists, err := questionQuery.Exist(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if !exists {
+		return nil, db.Rollback(tx, errors.New("question not found or unauthorized"))
+	}
+
+	return regradeAnswers(ctx, tx, userId, testsessionanswer.QuestionID(questionId), testsessionregrade.ScopeQuestion, &questionId, dryRun)
+}
+
+// RegradeTest recomputes every graded answer of the completed sessions of a test from the stored selections,
+// only if the user can proctor the test. With dryRun nothing is persisted.
+func RegradeTest(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, testId uuid.UUID, dryRun bool) (*model.RegradeResult, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanProctorTest(ctx, tx.Client(), userId, isAdminOrOwner, testId); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return regradeAnswers(ctx, tx, userId, testsessionanswer.HasTestSessionWith(testsession.TestID(testId)), testsessionregrade.ScopeTest, nil, dryRun)
+}
+
+// GetTestSessionRegrades returns the regrades applied to a session, to its candidate or to a user who can proctor its test.
+func GetTestSessionRegrades(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) ([]*ent.TestSessionRegrade, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.TestSession.Get(ctx, sessionId)
+	if err != nil {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+	isCandidate := session.UserID != nil && *session.UserID == userId
+	if !isCandidate {
+		if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, session.TestID); err != nil {
+			return nil, errors.New("test session not found or unauthorized")
+		}
+	}
+
+	return client.TestSessionRegrade.Query().
+		Where(testsessionregrade.SessionID(sessionId)).
+		Order(ent.Asc(testsessionregrade.FieldCreatedAt)).
+		All(ctx)
+}
+
+// regradeAnswers regrades the answers matching the predicate within the transaction, which is committed
+// or rolled back for a dry run before returning.
+func regradeAnswers(ctx context.Context, tx *ent.Tx, userId uuid.UUID, answerPredicate predicate.TestSessionAnswer, scope testsessionregrade.Scope, questionId *uuid.UUID, dryRun bool) (*model.RegradeResult, error) {
+	answers, err := tx.TestSessionAnswer.Query().
+		Where(
+			answerPredicate,
+			testsessionanswer.PointsNotNil(),
+			testsessionanswer.HasTestSessionWith(testsession.StatusEQ(testsession.StatusCompleted)),
+		).
+		WithQuestion(func(q *ent.QuestionQuery) {
+			q.WithQuestionOptions()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	result := &model.RegradeResult{
+		DryRun:         dryRun,
+		ScannedAnswers: len(answers),
+		Sessions:       []*model.RegradeSessionDelta{},
+	}
+
+	// The answers are regraded against the latest version of their question, recorded here if the question changed
+	// since, and the answers move to that version so the result and the regrade agree on the answer key and the points.
+	questionIds := slice.Unique(slice.Map(
+		slice.Filter(answers, func(a *ent.TestSessionAnswer) bool { return a.Edges.Question != nil }),
+		func(a *ent.TestSessionAnswer) uuid.UUID { return a.QuestionID },
+	))
+	versions, err := questionFeat.RecordQuestionVersions(ctx, tx.Client(), nil, questionIds)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	type answerChange struct {
+		Answer    *ent.TestSessionAnswer
+		Version   *ent.QuestionVersion
+		IsCorrect bool
+		Points    int
+	}
+
+	changes := []answerChange{}
+	deltaBySession := make(map[uuid.UUID]int)
+	maxDeltaBySession := make(map[uuid.UUID]int)
+	for _, answer := range answers {
+		version := versions[answer.QuestionID]
+		if answer.Edges.Question == nil || version == nil {
+			continue
+		}
+		questionOptions := answer.Edges.Question.Edges.QuestionOptions
+
+		snapshot := getQuestionSnapshot(answer)
+		if snapshot == nil {
+			currentSnapshot := newQuestionSnapshot(answer.Edges.Question)
+			snapshot = &currentSnapshot
+		}
+
+		selectedOptionIds := resolveCurrentOptionIds(getSelectedOptionIds(answer, snapshot), snapshot, questionOptions)
+		isCorrect := isSelectionCorrect(questionOptions, selectedOptionIds)
+		points := 0
+		if isCorrect {
+			points = version.Points
+		}
+
+		// The snapshot holds the points the question was worth in the session
+		maxDelta := version.Points - snapshot.Points
+		wasCorrect := answer.IsCorrect != nil && *answer.IsCorrect
+		if wasCorrect == isCorrect && *answer.Points == points && maxDelta == 0 {
+			continue
+		}
+
+		changes = append(changes, answerChange{Answer: answer, Version: version, IsCorrect: isCorrect, Points: points})
+		deltaBySession[answer.SessionID] += points - *answer.Points
+		maxDeltaBySession[answer.SessionID] += maxDelta
+	}
+	result.ChangedAnswers = len(changes)
+
+	if len(changes) == 0 {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	sessionIds := slice.Unique(slice.Map(changes, func(c answerChange) uuid.UUID { return c.Answer.SessionID }))
+	sessions, err := tx.TestSession.Query().
+		Where(testsession.IDIn(sessionIds...)).
+		Order(ent.Asc(testsession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	for _, session := range sessions {
+		delta := deltaBySession[session.ID]
+		result.Sessions = append(result.Sessions, &model.RegradeSessionDelta{
+			SessionID:       session.ID,
+			TestID:          session.TestID,
+			UserID:          session.UserID,
+			PointsBefore:    session.PointsEarned,
+			PointsAfter:     session.PointsEarned + delta,
+			MaxPointsBefore: session.MaxPoints,
+			MaxPointsAfter:  session.MaxPoints + maxDeltaBySession[session.ID],
+			Delta:           delta,
+		})
+	}
+
+	if dryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	for _, change := range changes {
+		update := tx.TestSessionAnswer.UpdateOne(change.Answer).
+			SetIsCorrect(change.IsCorrect).
+			SetPoints(change.Points).
+			SetQuestionVersionID(change.Version.ID)
+
+		// The snapshot of a regraded answer follows the new answer key and points so the result stays consistent
+		if snapshot := getQuestionSnapshot(change.Answer); snapshot != nil {
+			regraded := snapshot.withAnswerKey(change.Answer.Edges.Question.Edges.QuestionOptions)
+			regraded.Points = change.Version.Points
+			update = update.SetMetadata(mergeAnswerMetadata(change.Answer.Metadata, map[string]interface{}{
+				questionSnapshotKey: regraded,
+			}))
+		}
+
+		_, err := update.Save(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	for _, sessionDelta := range result.Sessions {
+		if sessionDelta.Delta != 0 || sessionDelta.MaxPointsAfter != sessionDelta.MaxPointsBefore {
+			_, err := tx.TestSession.UpdateOneID(sessionDelta.SessionID).
+				SetPointsEarned(sessionDelta.PointsAfter).
+				SetMaxPoints(sessionDelta.MaxPointsAfter).
+				Save(ctx)
+			if err != nil {
+				return nil, db.Rollback(tx, err)
+			}
+		}
+
+		_, err := tx.TestSessionRegrade.Create().
+			SetSessionID(sessionDelta.SessionID).
+			SetRegradedByID(userId).
+			SetScope(scope).
+			SetNillableQuestionID(questionId).
+			SetPointsBefore(sessionDelta.PointsBefore).
+			SetPointsAfter(sessionDelta.PointsAfter).
+			Save(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolveCurrentOptionIds maps the selected options to the current options of the question. Editing a question
+// replaces its options, so the selected options that no longer exist are matched by their text in the snapshot.
+func resolveCurrentOptionIds(selectedOptionIds []uuid.UUID, snapshot *QuestionSnapshot, questionOptions []*ent.QuestionOption) []uuid.UUID {
+	optionIds := make([]uuid.UUID, 0, len(selectedOptionIds))
+	for _, selectedOptionId := range selectedOptionIds {
+		if slice.Some(questionOptions, func(qo *ent.QuestionOption) bool { return qo.ID == selectedOptionId }) {
+			optionIds = append(optionIds, selectedOptionId)
+			continue
+		}
+
+		selected := slice.Find(snapshot.Options, func(o OptionSnapshot) bool { return o.ID == selectedOptionId })
+		if selected == nil {
+			continue
+		}
+		current := slice.Find(questionOptions, func(qo *ent.QuestionOption) bool { return qo.OptionText == selected.OptionText })
+		if current != nil {
+			optionIds = append(optionIds, (*current).ID)
+		}
+	}
+	return slice.Unique(optionIds)
+}
@@ -2,14 +2,18 @@ package test_session
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"template/internal/ent"
 	"template/internal/ent/db"
 	"template/internal/ent/question"
 	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionoption"
 	"template/internal/ent/test"
 	"template/internal/ent/testignorequestion"
 	"template/internal/ent/testsession"
+	"template/internal/features/course_section"
+	questionFeat "template/internal/features/question"
 	"template/internal/shared/utilities/slice"
 	"time"
 
@@ -35,7 +39,16 @@ func StartTestSession(ctx context.Context, userID uuid.UUID, sessionId uuid.UUID
 				testsession.ExpiredAtGT(time.Now()),
 			),
 		).
-		Select(testsession.FieldID, testsession.FieldTestID).
+		Select(
+			testsession.FieldID,
+			testsession.FieldTestID,
+			testsession.FieldUserID,
+			testsession.FieldTimeMultiplier,
+			testsession.FieldExtraMinutes,
+			testsession.FieldAllowRestBreaks,
+			testsession.FieldOpenAt,
+			testsession.FieldCloseAt,
+		).
 		First(ctx)
 
 	if err != nil {
@@ -48,16 +61,39 @@ func StartTestSession(ctx context.Context, userID uuid.UUID, sessionId uuid.UUID
 		return nil, db.Rollback(tx, err)
 	}
 
+	// Refuse to start the session outside of its availability window or when no attempt is left
+	if err := checkTestAvailability(ctx, tx, userID, existingSession, testEntity, time.Now()); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	// Refuse to start the session when the prerequisites of the test's section are not met
+	if testEntity.CourseSectionID != nil {
+		unlocked, err := course_section.IsSectionUnlocked(ctx, tx.Client(), userID, *testEntity.CourseSectionID)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		if !unlocked {
+			return nil, db.Rollback(tx, errors.New("prerequisites for this section are not met"))
+		}
+	}
+
 	var expiryTime *time.Time
 	if testEntity.TotalTime != nil {
-		calculatedExpiry := time.Now().Add(time.Duration(*testEntity.TotalTime) * time.Minute)
+		// Apply the time multiplier and extra minutes of the candidate's accommodation
+		resolved, err := resolveAccommodation(ctx, tx.Client(), existingSession)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		calculatedExpiry := time.Now().Add(resolved.Duration(*testEntity.TotalTime))
 		expiryTime = &calculatedExpiry
 	}
 
 	// Update the session to in progress and set expiry_time if available
+	now := time.Now()
 	existingSession, err = tx.TestSession.UpdateOneID(existingSession.ID).
 		SetStatus(testsession.StatusInProgress).
-		SetStartedAt(time.Now()).
+		SetStartedAt(now).
+		SetLastActivityAt(now).
 		SetNillableExpiredAt(expiryTime).
 		Save(ctx)
 	if err != nil {
@@ -73,6 +109,8 @@ func StartTestSession(ctx context.Context, userID uuid.UUID, sessionId uuid.UUID
 		return nil, err
 	}
 
+	PublishSessionEvent(existingSession)
+
 	return existingSession, nil
 }
 
@@ -127,8 +165,9 @@ func randomGenerateTestSessionAnswers(ctx context.Context, tx *ent.Tx, session *
 	})
 
 	type selectedQuestion struct {
-		ID     uuid.UUID
-		Points int
+		ID       uuid.UUID
+		Points   int
+		Snapshot QuestionSnapshot
 	}
 
 	selectedQuestions := make([]selectedQuestion, 0)
@@ -142,7 +181,10 @@ func randomGenerateTestSessionAnswers(ctx context.Context, tx *ent.Tx, session *
 			).
 			Order((sql.OrderByRand())).
 			Limit(questionCount.NumberOfQuestions).
-			Select(question.FieldID, question.FieldPoints).
+			Select(question.FieldID, question.FieldQuestionText, question.FieldPoints, question.FieldExplanation).
+			WithQuestionOptions(func(q *ent.QuestionOptionQuery) {
+				q.Order(ent.Asc(questionoption.FieldCreatedAt))
+			}).
 			All(ctx)
 		if err != nil {
 			return err
@@ -150,19 +192,32 @@ func randomGenerateTestSessionAnswers(ctx context.Context, tx *ent.Tx, session *
 
 		selectedQuestions = append(selectedQuestions, slice.Map(questions, func(q *ent.Question) selectedQuestion {
 			return selectedQuestion{
-				ID:     q.ID,
-				Points: q.Points,
+				ID:       q.ID,
+				Points:   q.Points,
+				Snapshot: newQuestionSnapshot(q),
 			}
 		})...)
 	}
 
-	// Create test_session_answers for the selected questions. Using bulk create.
+	// Pin the version of each selected question, questions never versioned before get their first version here
+	questionVersions, err := questionFeat.RecordQuestionVersions(ctx, tx.Client(), nil, slice.Map(selectedQuestions, func(q selectedQuestion) uuid.UUID {
+		return q.ID
+	}))
+	if err != nil {
+		return err
+	}
+
+	// Create test_session_answers for the selected questions with the snapshot of the question as shown to the user. Using bulk create.
 	answers := make([]*ent.TestSessionAnswerCreate, len(selectedQuestions))
 	for index, question := range selectedQuestions {
 		answers[index] = tx.TestSessionAnswer.Create().
 			SetTestSessionID(session.ID).
 			SetQuestionID(question.ID).
-			SetOrder(index + 1)
+			SetOrder(index + 1).
+			SetQuestionVersionID(questionVersions[question.ID].ID).
+			SetMetadata(map[string]interface{}{
+				questionSnapshotKey: question.Snapshot,
+			})
 	}
 
 	_, err = tx.TestSessionAnswer.CreateBulk(answers...).Save(ctx)
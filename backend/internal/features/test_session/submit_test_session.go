@@ -6,7 +6,8 @@ import (
 	"template/internal/ent"
 	"template/internal/ent/db"
 	entQuestion "template/internal/ent/question"
-	entQuestionOption "template/internal/ent/questionoption"
+	entQuestionVersion "template/internal/ent/questionversion"
+	"template/internal/ent/schema/mixin"
 	entTestSession "template/internal/ent/testsession"
 	entTestSessionAnswer "template/internal/ent/testsessionanswer"
 	"template/internal/graph/model"
@@ -16,11 +17,6 @@ import (
 	"github.com/google/uuid"
 )
 
-// AnswerMetadata represents the structured metadata stored in test_session_answers
-type AnswerMetadata struct {
-	SelectedOptions []string `json:"selected_options"`
-}
-
 // SubmitTestSession submits a test session with answers and calculates the score
 func SubmitTestSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, input model.SubmitTestSessionInput) (*ent.TestSession, error) {
 	tx, err := db.OpenTransaction(ctx)
@@ -45,15 +41,24 @@ func SubmitTestSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUI
 		return nil, db.Rollback(tx, err)
 	}
 
+	// A session submitted during a rest break ends the break
+	if err := endRestBreak(ctx, tx, sessionID, time.Now()); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
 	// Update session status to completed and set score
 	selectFields := TestSessionSelectFields(ctx)
 
 	updateSessionQuery := tx.TestSession.UpdateOneID(sessionID).
 		SetStatus(entTestSession.StatusCompleted).
 		SetCompletedAt(time.Now()).
+		SetLastActivityAt(time.Now()).
+		ClearPausedAt().
 		SetPointsEarned(totalPoints)
 
 	if len(selectFields) > 0 {
+		// The published event needs the test, the user and the status of the session
+		selectFields = slice.Unique(append(selectFields, entTestSession.FieldTestID, entTestSession.FieldUserID, entTestSession.FieldStatus))
 		updateSessionQuery = updateSessionQuery.Select(entTestSession.FieldID, selectFields...)
 	}
 
@@ -66,6 +71,8 @@ func SubmitTestSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUI
 		return nil, err
 	}
 
+	PublishSessionEvent(updatedSession)
+
 	return updatedSession, nil
 }
 
@@ -73,7 +80,8 @@ func calculatePoints(ctx context.Context, tx *ent.Tx, session *ent.TestSession,
 	totalPoints := 0
 
 	blankAnswers, err := tx.TestSessionAnswer.Query().
-		Where(entTestSessionAnswer.SessionID(session.ID), entTestSessionAnswer.PointsIsNil()).Select(entTestSessionAnswer.FieldID, entTestSessionAnswer.FieldQuestionID).
+		Where(entTestSessionAnswer.SessionID(session.ID), entTestSessionAnswer.PointsIsNil()).
+		Select(entTestSessionAnswer.FieldID, entTestSessionAnswer.FieldQuestionID, entTestSessionAnswer.FieldQuestionVersionID, entTestSessionAnswer.FieldMetadata).
 		All(ctx)
 
 	if err != nil {
@@ -85,66 +93,34 @@ func calculatePoints(ctx context.Context, tx *ent.Tx, session *ent.TestSession,
 		return 0, fmt.Errorf("test answers doesn't match. needed %d answers, got %d", len(blankAnswers), len(input.Answers))
 	}
 
-	questionIDs := slice.Map(blankAnswers, func(a *ent.TestSessionAnswer) uuid.UUID { return a.QuestionID })
-
-	questions, err := tx.Question.Query().
-		Where(entQuestion.IDIn(questionIDs...)).
-		Select(entQuestion.FieldID, entQuestion.FieldPoints).
-		All(ctx)
-
+	gradedQuestions, err := getGradedQuestions(ctx, tx, blankAnswers)
 	if err != nil {
 		return 0, err
 	}
 
-	// Fetch question options separately
-	questionOptions, err := tx.QuestionOption.Query().
-		Where(entQuestionOption.QuestionIDIn(questionIDs...)).
-		All(ctx)
-
-	if err != nil {
-		return 0, err
-	}
-
-	// Group options by question ID
-	optionsByQuestion := make(map[uuid.UUID][]*ent.QuestionOption)
-	for _, opt := range questionOptions {
-		optionsByQuestion[opt.QuestionID] = append(optionsByQuestion[opt.QuestionID], opt)
-	}
-
 	type Answer struct {
 		QuestionID      uuid.UUID
 		IsCorrect       bool
 		Points          int
 		SelectedOptions []string
+		SelectedIds     []string
 	}
 
 	answers := make([]Answer, 0, len(input.Answers))
 
-	questionMap := slice.ToMap(questions, func(q *ent.Question) (uuid.UUID, *ent.Question) {
-		return q.ID, q
+	blankAnswerMap := slice.ToMap(blankAnswers, func(a *ent.TestSessionAnswer) (uuid.UUID, *ent.TestSessionAnswer) {
+		return a.QuestionID, a
 	})
 
 	for _, answerOfUser := range input.Answers {
-		question := questionMap[answerOfUser.QuestionID]
-		if question == nil {
+		question, ok := gradedQuestions[answerOfUser.QuestionID]
+		if !ok || blankAnswerMap[answerOfUser.QuestionID] == nil {
 			return 0, fmt.Errorf("answer of user doesn't match the question")
 		}
 
-		questionOptions := optionsByQuestion[question.ID]
+		questionOptions := question.Options
 		userAnswerOptionIds := answerOfUser.QuestionOptionIds
-		correctOptions := slice.Filter(questionOptions, func(qo *ent.QuestionOption) bool {
-			return qo.IsCorrect
-		})
-
-		// Map the correct options to a map for faster lookup with the user answer options
-		correntOptionMap := slice.ToMap(correctOptions, func(qo *ent.QuestionOption) (uuid.UUID, *ent.QuestionOption) {
-			return qo.ID, qo
-		})
-
-		// Only mark the answer as correct if the user selected all the options match the question options
-		isUserAnswerCorrect := len(userAnswerOptionIds) == len(correctOptions) && slice.Every(userAnswerOptionIds, func(optionId uuid.UUID) bool {
-			return correntOptionMap[optionId] != nil
-		})
+		isUserAnswerCorrect := isSelectionCorrect(questionOptions, userAnswerOptionIds)
 
 		points := 0
 		if isUserAnswerCorrect {
@@ -163,10 +139,13 @@ func calculatePoints(ctx context.Context, tx *ent.Tx, session *ent.TestSession,
 		}
 
 		answer := Answer{
-			QuestionID:      question.ID,
+			QuestionID:      answerOfUser.QuestionID,
 			IsCorrect:       isUserAnswerCorrect,
 			Points:          points,
 			SelectedOptions: selectedOptions,
+			SelectedIds: slice.Map(userAnswerOptionIds, func(id uuid.UUID) string {
+				return id.String()
+			}),
 		}
 
 		answers = append(answers, answer)
@@ -177,9 +156,10 @@ func calculatePoints(ctx context.Context, tx *ent.Tx, session *ent.TestSession,
 	// Batch update the answers record in the table
 
 	for _, answer := range answers {
-		metadata := map[string]interface{}{
-			"selected_options": answer.SelectedOptions,
-		}
+		metadata := mergeAnswerMetadata(blankAnswerMap[answer.QuestionID].Metadata, map[string]interface{}{
+			selectedOptionsKey:   answer.SelectedOptions,
+			selectedOptionIdsKey: answer.SelectedIds,
+		})
 
 		updatedCount, err := tx.TestSessionAnswer.Update().
 			Where(entTestSessionAnswer.QuestionID(answer.QuestionID),
@@ -200,3 +180,78 @@ func calculatePoints(ctx context.Context, tx *ent.Tx, session *ent.TestSession,
 
 	return totalPoints, nil
 }
+
+// gradedQuestion is the question an answer is graded against, with its options as an answer key.
+type gradedQuestion struct {
+	Points  int
+	Options []*ent.QuestionOption
+}
+
+// getGradedQuestions returns the questions of the answers as they were when the session started, from the version
+// pinned by each answer, so editing or deleting a question during the session doesn't change the grading.
+// The answers of the sessions started before the versions were pinned are graded against the current question.
+func getGradedQuestions(ctx context.Context, tx *ent.Tx, answers []*ent.TestSessionAnswer) (map[uuid.UUID]gradedQuestion, error) {
+	gradedQuestions := make(map[uuid.UUID]gradedQuestion, len(answers))
+
+	versionIds := []uuid.UUID{}
+	unversionedQuestionIds := []uuid.UUID{}
+	for _, answer := range answers {
+		if answer.QuestionVersionID != nil {
+			versionIds = append(versionIds, *answer.QuestionVersionID)
+		} else {
+			unversionedQuestionIds = append(unversionedQuestionIds, answer.QuestionID)
+		}
+	}
+
+	versions, err := tx.QuestionVersion.Query().
+		Where(entQuestionVersion.IDIn(versionIds...)).
+		WithOptions().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range versions {
+		gradedQuestions[version.QuestionID] = gradedQuestion{
+			Points: version.Points,
+			Options: slice.Map(version.Edges.Options, func(o *ent.QuestionVersionOption) *ent.QuestionOption {
+				return &ent.QuestionOption{ID: o.OptionID, OptionText: o.OptionText, IsCorrect: o.IsCorrect}
+			}),
+		}
+	}
+
+	if len(unversionedQuestionIds) == 0 {
+		return gradedQuestions, nil
+	}
+
+	// The deleted questions are still graded
+	questions, err := tx.Question.Query().
+		Where(entQuestion.IDIn(unversionedQuestionIds...)).
+		Select(entQuestion.FieldID, entQuestion.FieldPoints).
+		WithQuestionOptions().
+		All(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, question := range questions {
+		gradedQuestions[question.ID] = gradedQuestion{Points: question.Points, Options: question.Edges.QuestionOptions}
+	}
+
+	return gradedQuestions, nil
+}
+
+// isSelectionCorrect reports whether the selected options match exactly the correct options of a question.
+func isSelectionCorrect(questionOptions []*ent.QuestionOption, selectedOptionIds []uuid.UUID) bool {
+	correctOptions := slice.Filter(questionOptions, func(qo *ent.QuestionOption) bool {
+		return qo.IsCorrect
+	})
+
+	// Map the correct options to a map for faster lookup with the user answer options
+	correctOptionMap := slice.ToMap(correctOptions, func(qo *ent.QuestionOption) (uuid.UUID, *ent.QuestionOption) {
+		return qo.ID, qo
+	})
+
+	// Only mark the answer as correct if the user selected all the options match the question options
+	return len(selectedOptionIds) == len(correctOptions) && slice.Every(selectedOptionIds, func(optionId uuid.UUID) bool {
+		return correctOptionMap[optionId] != nil
+	})
+}
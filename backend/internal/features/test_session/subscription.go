@@ -0,0 +1,166 @@
+package test_session
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/testsession"
+	"template/internal/graph/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// timerInterval is how often the remaining time of a session is pushed to its subscribers.
+const timerInterval = time.Second
+
+// WatchTestSessionTimer streams the authoritative remaining time of a session, only if the user owns the session.
+// The stream ends once the session is finished or its time is up.
+func WatchTestSessionTimer(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, sessionId uuid.UUID) (<-chan *model.TestSessionTimer, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.TestSession.Get(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdminOrOwner && (session.UserID == nil || *session.UserID != userId) {
+		return nil, errors.New("test session not found or unauthorized")
+	}
+
+	events := SubscribeSessionEvents(ctx)
+	timers := make(chan *model.TestSessionTimer, 1)
+
+	go func() {
+		defer close(timers)
+
+		ticker := time.NewTicker(timerInterval)
+		defer ticker.Stop()
+
+		for {
+			timer := buildTestSessionTimer(session, time.Now())
+			select {
+			case timers <- timer:
+			case <-ctx.Done():
+				return
+			}
+			if isTimerFinished(timer) {
+				return
+			}
+
+		wait:
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					break wait
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+					if event.SessionID != sessionId {
+						continue
+					}
+					// The session changed (started, submitted, extended...), reload it
+					reloaded, err := client.TestSession.Get(ctx, sessionId)
+					if err != nil {
+						return
+					}
+					session = reloaded
+					break wait
+				}
+			}
+		}
+	}()
+
+	return timers, nil
+}
+
+// WatchTestSessions streams the sessions whose status changed, optionally restricted to a test.
+// Watching every test is reserved to admins and owners, otherwise the user must manage the test's course.
+// When onlySubmitted is true, only the sessions that have just been completed are streamed.
+func WatchTestSessions(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, testId *uuid.UUID, onlySubmitted bool) (<-chan *ent.TestSession, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if testId == nil && !isAdminOrOwner {
+		return nil, errors.New("unauthorized: only admins can watch every test session")
+	}
+	if testId != nil {
+		if err := checkCanProctorTest(ctx, client, userId, isAdminOrOwner, *testId); err != nil {
+			return nil, err
+		}
+	}
+
+	events := SubscribeSessionEvents(ctx)
+	sessions := make(chan *ent.TestSession, 1)
+
+	go func() {
+		defer close(sessions)
+
+		for event := range events {
+			if testId != nil && event.TestID != *testId {
+				continue
+			}
+			if onlySubmitted && event.Status != testsession.StatusCompleted {
+				continue
+			}
+
+			session, err := client.TestSession.Get(ctx, event.SessionID)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case sessions <- session:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return sessions, nil
+}
+
+func buildTestSessionTimer(session *ent.TestSession, now time.Time) *model.TestSessionTimer {
+	timer := &model.TestSessionTimer{
+		SessionID:  session.ID,
+		Status:     model.ConvertTestSessionToModel(session).Status,
+		ExpiredAt:  session.ExpiredAt,
+		PausedAt:   session.PausedAt,
+		ServerTime: now,
+	}
+
+	if session.ExpiredAt != nil {
+		// The clock is stopped during a rest break
+		clock := now
+		if session.PausedAt != nil {
+			clock = *session.PausedAt
+		}
+		remaining := int(session.ExpiredAt.Sub(clock).Seconds())
+		if remaining < 0 {
+			remaining = 0
+		}
+		timer.RemainingSeconds = &remaining
+	}
+
+	return timer
+}
+
+// isTimerFinished reports whether no more timer updates will be pushed for the session.
+func isTimerFinished(timer *model.TestSessionTimer) bool {
+	switch timer.Status {
+	case model.TestSessionStatusCompleted, model.TestSessionStatusCancelled, model.TestSessionStatusExpired:
+		return true
+	case model.TestSessionStatusInProgress:
+		return timer.RemainingSeconds != nil && *timer.RemainingSeconds == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,82 @@
+// Package trash holds the soft-delete policy. Deleting a course, a test, a question collection, a question
+// or a group moves it to the trash along with its children, e.g. the sections, videos, enrollments and tests
+// of a course, the sessions and group assignments of a test. The children share the deletion time of their
+// parent so restoring it only restores them, not the ones deleted before. The other entities are deleted
+// permanently, and the items of the trash are purged once the retention period is over.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/common"
+	"template/internal/graph/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SoftDelete moves an item and its children to the trash.
+func SoftDelete(ctx context.Context, client *ent.Client, entityType model.TrashEntityType, id uuid.UUID) error {
+	policy, err := getPolicy(entityType)
+	if err != nil {
+		return err
+	}
+
+	// Postgres keeps microseconds, the deletion time is compared when restoring the children
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+	return policy.softDelete(mixin.WithDeletedAt(ctx, deletedAt), client, id)
+}
+
+// PaginatedTrash returns the deleted items of a type, most recently deleted first.
+func PaginatedTrash(ctx context.Context, entityType model.TrashEntityType, paginationInput *model.PaginationInput) (*common.PaginatedResult[*model.TrashItem], error) {
+	policy, err := getPolicy(entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := db.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	paginationInputFallback := common.FallbackValue(paginationInput, common.DefaultPaginationInput)
+	page := common.FallbackValue(paginationInputFallback.Page, common.DefaultPage)
+	limit := common.FallbackValue(paginationInputFallback.Limit, common.DefaultLimit)
+	search := common.FallbackValue(paginationInputFallback.Search, "")
+
+	return policy.list(ctx, client, search, page, limit)
+}
+
+// Restore restores a deleted item with the children deleted along with it.
+func Restore(ctx context.Context, entityType model.TrashEntityType, id uuid.UUID) (bool, error) {
+	policy, err := getPolicy(entityType)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := policy.restore(ctx, tx.Client(), id); err != nil {
+		return false, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func getPolicy(entityType model.TrashEntityType) (policy, error) {
+	policy, ok := policies[entityType]
+	if !ok {
+		return policy, fmt.Errorf("unknown trash entity type %q", entityType)
+	}
+	return policy, nil
+}
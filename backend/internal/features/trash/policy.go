@@ -0,0 +1,628 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"template/internal/ent"
+	"template/internal/ent/course"
+	"template/internal/ent/courseenrollment"
+	"template/internal/ent/coursesection"
+	"template/internal/ent/group"
+	"template/internal/ent/grouptestassignment"
+	"template/internal/ent/predicate"
+	"template/internal/ent/question"
+	"template/internal/ent/questioncollection"
+	"template/internal/ent/questionversion"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/test"
+	"template/internal/ent/testignorequestion"
+	"template/internal/ent/testsession"
+	"template/internal/ent/video"
+	"template/internal/ent/videoquestiontimestamp"
+	"template/internal/features/common"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// errNotInTrash is returned when restoring an item which isn't deleted.
+var errNotInTrash = errors.New("item not found in the trash")
+
+// policy tells how the items of a type are moved to the trash, listed, restored and purged.
+type policy struct {
+	// softDelete deletes the item and its children, the context sharing the deletion time.
+	softDelete func(ctx context.Context, client *ent.Client, id uuid.UUID) error
+	// restore restores the item and the children deleted along with it.
+	restore func(ctx context.Context, client *ent.Client, id uuid.UUID) error
+	// list returns the deleted items matching the search, without the ones deleted along with a parent.
+	list func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error)
+	// expired returns the items deleted before the time.
+	expired func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error)
+	// purge permanently deletes an item, the database cascading the deletion to its children.
+	purge func(ctx context.Context, client *ent.Client, id uuid.UUID) error
+}
+
+// purgeOrder purges the parents first, their children being purged along with them.
+var purgeOrder = []model.TrashEntityType{
+	model.TrashEntityTypeCourse,
+	model.TrashEntityTypeCourseSection,
+	model.TrashEntityTypeTest,
+	model.TrashEntityTypeQuestionCollection,
+	model.TrashEntityTypeQuestion,
+	model.TrashEntityTypeGroup,
+}
+
+var policies = map[model.TrashEntityType]policy{
+	model.TrashEntityTypeCourse: {
+		softDelete: softDeleteCourse,
+		restore:    restoreCourse,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.Course.Query().
+				Where(course.DeletedAtNotNil()).
+				Order(ent.Desc(course.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(course.TitleContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(c *ent.Course) *model.TrashItem {
+				return &model.TrashItem{ID: c.ID, Type: model.TrashEntityTypeCourse, Name: c.Title, DeletedAt: *c.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.Course.Query().Where(course.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: purgeCourse,
+	},
+	model.TrashEntityTypeCourseSection: {
+		softDelete: softDeleteCourseSection,
+		restore:    restoreCourseSection,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.CourseSection.Query().
+				Where(coursesection.DeletedAtNotNil(), coursesection.Not(sectionHasTrashedParent())).
+				Order(ent.Desc(coursesection.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(coursesection.TitleContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(s *ent.CourseSection) *model.TrashItem {
+				return &model.TrashItem{ID: s.ID, Type: model.TrashEntityTypeCourseSection, Name: s.Title, DeletedAt: *s.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.CourseSection.Query().Where(coursesection.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: purgeCourseSection,
+	},
+	model.TrashEntityTypeTest: {
+		softDelete: softDeleteTest,
+		restore:    restoreTest,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.Test.Query().
+				Where(test.DeletedAtNotNil(), test.Not(testHasTrashedParent())).
+				Order(ent.Desc(test.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(test.NameContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(t *ent.Test) *model.TrashItem {
+				return &model.TrashItem{ID: t.ID, Type: model.TrashEntityTypeTest, Name: t.Name, DeletedAt: *t.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.Test.Query().Where(test.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: purgeTest,
+	},
+	model.TrashEntityTypeQuestionCollection: {
+		softDelete: softDeleteQuestionCollection,
+		restore:    restoreQuestionCollection,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.QuestionCollection.Query().
+				Where(questioncollection.DeletedAtNotNil()).
+				Order(ent.Desc(questioncollection.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(questioncollection.TitleContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(c *ent.QuestionCollection) *model.TrashItem {
+				return &model.TrashItem{ID: c.ID, Type: model.TrashEntityTypeQuestionCollection, Name: c.Title, DeletedAt: *c.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.QuestionCollection.Query().Where(questioncollection.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: purgeQuestionCollection,
+	},
+	model.TrashEntityTypeQuestion: {
+		softDelete: func(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+			return client.Question.DeleteOneID(id).Exec(ctx)
+		},
+		restore: restoreQuestion,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.Question.Query().
+				Where(question.DeletedAtNotNil(), question.Not(question.HasCollectionWith(questioncollection.DeletedAtNotNil()))).
+				Order(ent.Desc(question.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(question.QuestionTextContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(q *ent.Question) *model.TrashItem {
+				return &model.TrashItem{ID: q.ID, Type: model.TrashEntityTypeQuestion, Name: q.QuestionText, DeletedAt: *q.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.Question.Query().Where(question.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: purgeQuestion,
+	},
+	model.TrashEntityTypeGroup: {
+		softDelete: softDeleteGroup,
+		restore:    restoreGroup,
+		list: func(ctx context.Context, client *ent.Client, search string, page, limit int) (*common.PaginatedResult[*model.TrashItem], error) {
+			query := client.Group.Query().
+				Where(group.DeletedAtNotNil()).
+				Order(ent.Desc(group.FieldDeletedAt))
+			if search != "" {
+				query = query.Where(group.NameContainsFold(search))
+			}
+			result, err := common.EntQueryPaginated(mixin.WithSoftDelete(ctx), query, page, limit)
+			return toTrashItems(result, err, func(g *ent.Group) *model.TrashItem {
+				return &model.TrashItem{ID: g.ID, Type: model.TrashEntityTypeGroup, Name: g.Name, DeletedAt: *g.DeletedAt}
+			})
+		},
+		expired: func(ctx context.Context, client *ent.Client, before time.Time) ([]uuid.UUID, error) {
+			return client.Group.Query().Where(group.DeletedAtLT(before)).IDs(mixin.WithSoftDelete(ctx))
+		},
+		purge: func(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+			return client.Group.DeleteOneID(id).Exec(ctx)
+		},
+	},
+}
+
+// courseTests matches the tests of a course, directly or through one of its sections.
+func courseTests(courseId uuid.UUID) predicate.Test {
+	return test.Or(test.CourseID(courseId), test.HasCourseSectionWith(coursesection.CourseID(courseId)))
+}
+
+// testHasTrashedParent matches the tests whose course or section is in the trash.
+func testHasTrashedParent() predicate.Test {
+	return test.Or(
+		test.HasCourseWith(course.DeletedAtNotNil()),
+		test.HasCourseSectionWith(coursesection.DeletedAtNotNil()),
+	)
+}
+
+func softDeleteCourse(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	// The soft-delete context includes the deleted entities, the tests deleted before are skipped
+	testIds, err := client.Test.Query().Where(courseTests(id), test.DeletedAtIsNil()).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, testId := range testIds {
+		if err := softDeleteTest(ctx, client, testId); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.CourseSection.Delete().Where(coursesection.CourseID(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.Video.Delete().Where(video.CourseID(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.CourseEnrollment.Delete().Where(courseenrollment.CourseID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.Course.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreCourse(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	crs, err := client.Course.Query().
+		Where(course.ID(id), course.DeletedAtNotNil()).
+		Only(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return errNotInTrash
+	}
+	deletedAt := *crs.DeletedAt
+
+	if err := client.Course.UpdateOneID(id).ClearDeletedAt().Exec(ctx); err != nil {
+		return err
+	}
+	err = client.CourseSection.Update().
+		Where(coursesection.CourseID(id), coursesection.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.Video.Update().
+		Where(video.CourseID(id), video.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.CourseEnrollment.Update().
+		Where(courseenrollment.CourseID(id), courseenrollment.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	testIds, err := client.Test.Query().
+		Where(courseTests(id), test.DeletedAtEQ(deletedAt)).
+		IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	for _, testId := range testIds {
+		if err := restoreTestWithChildren(ctx, client, testId, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func purgeCourse(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	testIds, err := client.Test.Query().Where(courseTests(id)).IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if err := checkHasNoSessions(ctx, client, testIds...); err != nil {
+		return err
+	}
+	if _, err := client.TestIgnoreQuestion.Delete().Where(testignorequestion.TestIDIn(testIds...)).Exec(ctx); err != nil {
+		return err
+	}
+
+	// The collections of the sections are deleted by the database along with the sections
+	questionIds, err := client.Question.Query().
+		Where(question.HasCollectionWith(questioncollection.HasCourseSectionWith(coursesection.CourseID(id)))).
+		IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if err := purgeQuestionReferences(ctx, client, questionIds...); err != nil {
+		return err
+	}
+
+	return client.Course.DeleteOneID(id).Exec(ctx)
+}
+
+// sectionTree matches a section and its children.
+func sectionTree(id uuid.UUID) predicate.CourseSection {
+	return coursesection.Or(coursesection.ID(id), coursesection.SectionID(id))
+}
+
+// sectionHasTrashedParent matches the sections whose course or parent section is in the trash.
+func sectionHasTrashedParent() predicate.CourseSection {
+	return coursesection.Or(
+		coursesection.HasCourseWith(course.DeletedAtNotNil()),
+		coursesection.HasParentWith(coursesection.DeletedAtNotNil()),
+	)
+}
+
+func softDeleteCourseSection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	// The soft-delete context includes the deleted entities, the tests deleted before are skipped
+	testIds, err := client.Test.Query().
+		Where(test.HasCourseSectionWith(sectionTree(id)), test.DeletedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, testId := range testIds {
+		if err := softDeleteTest(ctx, client, testId); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.Video.Delete().Where(video.HasCourseSectionWith(sectionTree(id))).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.CourseSection.Delete().Where(coursesection.SectionID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.CourseSection.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreCourseSection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	section, err := client.CourseSection.Query().
+		Where(coursesection.ID(id), coursesection.DeletedAtNotNil()).
+		Only(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return errNotInTrash
+	}
+	deletedAt := *section.DeletedAt
+
+	trashedParent, err := client.CourseSection.Query().
+		Where(coursesection.ID(id), sectionHasTrashedParent()).
+		Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if trashedParent {
+		return errors.New("restore the course or the parent section of the section first")
+	}
+
+	// The section is put back after its siblings, the order may have been taken in the meantime
+	siblingQuery := client.CourseSection.Query().Where(coursesection.CourseID(section.CourseID))
+	if section.SectionID != nil {
+		siblingQuery = siblingQuery.Where(coursesection.SectionID(*section.SectionID))
+	} else {
+		siblingQuery = siblingQuery.Where(coursesection.SectionIDIsNil())
+	}
+	siblingCount, err := siblingQuery.Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CourseSection.UpdateOneID(id).ClearDeletedAt().SetOrder(siblingCount).Exec(ctx); err != nil {
+		return err
+	}
+	err = client.CourseSection.Update().
+		Where(coursesection.SectionID(id), coursesection.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.Video.Update().
+		Where(video.HasCourseSectionWith(sectionTree(id)), video.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	testIds, err := client.Test.Query().
+		Where(test.HasCourseSectionWith(sectionTree(id)), test.DeletedAtEQ(deletedAt)).
+		IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	for _, testId := range testIds {
+		if err := restoreTestWithChildren(ctx, client, testId, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func purgeCourseSection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	softDeleteCtx := mixin.WithSoftDelete(ctx)
+	testIds, err := client.Test.Query().Where(test.HasCourseSectionWith(sectionTree(id))).IDs(softDeleteCtx)
+	if err != nil {
+		return err
+	}
+	if err := checkHasNoSessions(ctx, client, testIds...); err != nil {
+		return err
+	}
+	if _, err := client.TestIgnoreQuestion.Delete().Where(testignorequestion.TestIDIn(testIds...)).Exec(ctx); err != nil {
+		return err
+	}
+
+	// The collections of the sections are deleted by the database along with the sections
+	questionIds, err := client.Question.Query().
+		Where(question.HasCollectionWith(questioncollection.HasCourseSectionWith(sectionTree(id)))).
+		IDs(softDeleteCtx)
+	if err != nil {
+		return err
+	}
+	if err := purgeQuestionReferences(ctx, client, questionIds...); err != nil {
+		return err
+	}
+
+	// The database would move the children to the root, they are deleted first
+	if _, err := client.CourseSection.Delete().Where(coursesection.SectionID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.CourseSection.DeleteOneID(id).Exec(ctx)
+}
+
+func softDeleteTest(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	// The sessions are kept, the candidates keep their results
+	if _, err := client.GroupTestAssignment.Delete().Where(grouptestassignment.TestID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.Test.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreTest(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	t, err := client.Test.Query().
+		Where(test.ID(id), test.DeletedAtNotNil()).
+		Only(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return errNotInTrash
+	}
+
+	trashedParent, err := client.Test.Query().
+		Where(test.ID(id), testHasTrashedParent()).
+		Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if trashedParent {
+		return errors.New("restore the course of the test first")
+	}
+
+	return restoreTestWithChildren(ctx, client, id, *t.DeletedAt)
+}
+
+// restoreTestWithChildren restores a test with the group assignments deleted along with it.
+func restoreTestWithChildren(ctx context.Context, client *ent.Client, id uuid.UUID, deletedAt time.Time) error {
+	if err := client.Test.UpdateOneID(id).ClearDeletedAt().Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.GroupTestAssignment.Update().
+		Where(grouptestassignment.TestID(id), grouptestassignment.DeletedAtEQ(deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+func purgeTest(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if err := checkHasNoSessions(ctx, client, id); err != nil {
+		return err
+	}
+	if _, err := client.TestIgnoreQuestion.Delete().Where(testignorequestion.TestID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.Test.DeleteOneID(id).Exec(ctx)
+}
+
+func softDeleteQuestionCollection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	// The questions are retained for the results of past sessions
+	if _, err := client.Question.Delete().Where(question.CollectionID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.QuestionCollection.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreQuestionCollection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	collection, err := client.QuestionCollection.Query().
+		Where(questioncollection.ID(id), questioncollection.DeletedAtNotNil()).
+		Only(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return errNotInTrash
+	}
+
+	if err := client.QuestionCollection.UpdateOneID(id).ClearDeletedAt().Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.Question.Update().
+		Where(question.CollectionID(id), question.DeletedAtEQ(*collection.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+func purgeQuestionCollection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	questionIds, err := client.Question.Query().
+		Where(question.CollectionID(id)).
+		IDs(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if err := purgeQuestionReferences(ctx, client, questionIds...); err != nil {
+		return err
+	}
+
+	return client.QuestionCollection.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreQuestion(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	exists, err := client.Question.Query().
+		Where(question.ID(id), question.DeletedAtNotNil()).
+		Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errNotInTrash
+	}
+
+	trashedParent, err := client.Question.Query().
+		Where(question.ID(id), question.HasCollectionWith(questioncollection.DeletedAtNotNil())).
+		Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if trashedParent {
+		return errors.New("restore the question collection of the question first")
+	}
+
+	return client.Question.UpdateOneID(id).ClearDeletedAt().Exec(ctx)
+}
+
+func purgeQuestion(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if err := purgeQuestionReferences(ctx, client, id); err != nil {
+		return err
+	}
+
+	return client.Question.DeleteOneID(id).Exec(ctx)
+}
+
+// checkHasNoSessions makes sure none of the tests has a session, purging a test would delete the results of its candidates.
+func checkHasNoSessions(ctx context.Context, client *ent.Client, testIds ...uuid.UUID) error {
+	if len(testIds) == 0 {
+		return nil
+	}
+
+	exists, err := client.TestSession.Query().Where(testsession.TestIDIn(testIds...)).Exist(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("the test has sessions")
+	}
+	return nil
+}
+
+// purgeQuestionReferences deletes the versions, ignored questions and video timestamps of questions about to be purged.
+// The questions answered in a session can't be purged, the answers keep referencing them.
+func purgeQuestionReferences(ctx context.Context, client *ent.Client, questionIds ...uuid.UUID) error {
+	if len(questionIds) == 0 {
+		return nil
+	}
+	if _, err := client.TestIgnoreQuestion.Delete().Where(testignorequestion.QuestionIDIn(questionIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.VideoQuestionTimestamp.Delete().Where(videoquestiontimestamp.QuestionIDIn(questionIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := client.QuestionVersion.Delete().Where(questionversion.QuestionIDIn(questionIds...)).Exec(ctx)
+	return err
+}
+
+func softDeleteGroup(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if _, err := client.GroupTestAssignment.Delete().Where(grouptestassignment.GroupID(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.Group.DeleteOneID(id).Exec(ctx)
+}
+
+func restoreGroup(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	g, err := client.Group.Query().
+		Where(group.ID(id), group.DeletedAtNotNil()).
+		Only(mixin.WithSoftDelete(ctx))
+	if err != nil {
+		return errNotInTrash
+	}
+
+	if err := client.Group.UpdateOneID(id).ClearDeletedAt().Exec(ctx); err != nil {
+		return err
+	}
+
+	return client.GroupTestAssignment.Update().
+		Where(grouptestassignment.GroupID(id), grouptestassignment.DeletedAtEQ(*g.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+// toTrashItems converts a page of deleted entities to trash items.
+func toTrashItems[T any](result *common.PaginatedResult[T], err error, convert func(T) *model.TrashItem) (*common.PaginatedResult[*model.TrashItem], error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.PaginatedResult[*model.TrashItem]{
+		CurrentPage: result.CurrentPage,
+		TotalPages:  result.TotalPages,
+		TotalItems:  result.TotalItems,
+		HasNextPage: result.HasNextPage,
+		HasPrevPage: result.HasPrevPage,
+		Items:       slice.Map(result.Items, convert),
+	}, nil
+}
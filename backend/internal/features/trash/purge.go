@@ -0,0 +1,116 @@
+package trash
+
+import (
+	"context"
+	"log"
+	"template/internal/ent/db"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// purgeInterval is how often the scheduler purges the trash.
+const purgeInterval = time.Hour
+
+// purgeLockKey is the key of the advisory lock taken while purging, so a single server instance purges at a time.
+const purgeLockKey int64 = 0x7472617368 // "trash"
+
+// PurgeTrash permanently deletes the items moved to the trash before the time and returns how many were purged.
+// Each item is purged in its own transaction, the ones still referenced, e.g. the questions answered in a session,
+// are kept.
+func PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, entityType := range purgeOrder {
+		policy := policies[entityType]
+
+		ids, err := policy.expired(ctx, client, before)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, id := range ids {
+			if err := purgeItem(ctx, policy, id); err != nil {
+				log.Printf("Kept %s %s in the trash: %v", entityType, id, err)
+				continue
+			}
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func purgeItem(ctx context.Context, policy policy, id uuid.UUID) error {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.purge(ctx, tx.Client(), id); err != nil {
+		return db.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// StartPurgeScheduler purges the items deleted for longer than the retention period now and then every
+// purgeInterval, until the context is done. A zero retention keeps the items in the trash forever.
+func StartPurgeScheduler(ctx context.Context, retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(purgeInterval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := purgeTrashLocked(ctx, time.Now().UTC().Add(-retention))
+			if err != nil {
+				log.Println("Failed to purge the trash: ", err)
+			} else if purged > 0 {
+				log.Printf("Purged %d items from the trash", purged)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// purgeTrashLocked purges the trash only if no other instance of the server is purging it, holding a session
+// advisory lock on a dedicated connection for the duration of the purge.
+func purgeTrashLocked(ctx context.Context, before time.Time) (int, error) {
+	sqlDB, err := db.OpenDB()
+	if err != nil {
+		return 0, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", purgeLockKey).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", purgeLockKey); err != nil {
+			log.Println("Failed to release the trash purge lock: ", err)
+		}
+	}()
+
+	return PurgeTrash(ctx, before)
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"template/internal/ent"
 	"template/internal/ent/db"
+	"template/internal/ent/schema/mixin"
 	"template/internal/ent/user"
+	roleFeat "template/internal/features/role"
 	"template/internal/graph/model"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func AdminCreateUser(ctx context.Context, input model.AdminCreateUserInput) (*ent.User, error) {
+func AdminCreateUser(ctx context.Context, userId uuid.UUID, input model.AdminCreateUserInput) (*ent.User, error) {
 	// Validate required fields
 	if input.Email == "" {
 		return nil, errors.New("email cannot be empty")
@@ -26,10 +29,10 @@ func AdminCreateUser(ctx context.Context, input model.AdminCreateUserInput) (*en
 		return nil, err
 	}
 
-	// Check if email already exists
+	// Check if email already exists, in every organization as emails are unique
 	emailExists, err := tx.User.Query().
 		Where(user.EmailEQ(input.Email)).
-		Exist(ctx)
+		Exist(mixin.SkipTenant(ctx))
 	if err != nil {
 		return nil, db.Rollback(tx, err)
 	}
@@ -42,6 +45,9 @@ func AdminCreateUser(ctx context.Context, input model.AdminCreateUserInput) (*en
 	if err != nil {
 		return nil, db.Rollback(tx, errors.New("invalid role"))
 	}
+	if err := roleFeat.CheckCanGrantRole(ctx, userId, role.Name); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
 
 	// Hash the password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
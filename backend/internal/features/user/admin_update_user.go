@@ -6,34 +6,39 @@ import (
 	"fmt"
 	"template/internal/ent"
 	"template/internal/ent/db"
+	"template/internal/ent/schema/mixin"
 	"template/internal/ent/user"
+	"template/internal/features/role"
 	"template/internal/graph/model"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func AdminUpdateUser(ctx context.Context, userID uuid.UUID, input model.AdminEditUserInput) (*ent.User, error) {
+func AdminUpdateUser(ctx context.Context, userId uuid.UUID, targetUserId uuid.UUID, input model.AdminEditUserInput) (*ent.User, error) {
 	tx, err := db.OpenTransaction(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check if user exists
-	existingUser, err := tx.User.Get(ctx, userID)
+	existingUser, err := tx.User.Get(ctx, targetUserId)
 	if err != nil {
 		return nil, db.Rollback(tx, errors.New("user not found"))
 	}
+	if err := role.CheckCanManageUser(ctx, tx.Client(), userId, targetUserId); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
 
 	// Start building the update
-	update := tx.User.UpdateOneID(userID)
+	update := tx.User.UpdateOneID(targetUserId)
 
 	// Update email if provided and check for duplicates
 	if input.Email != nil && *input.Email != existingUser.Email {
 		emailExists, err := tx.User.Query().
 			Where(user.EmailEQ(*input.Email)).
-			Where(user.IDNEQ(userID)). // Exclude current user
-			Exist(ctx)
+			Where(user.IDNEQ(targetUserId)). // Exclude current user
+			Exist(mixin.SkipTenant(ctx))     // Emails are unique in every organization
 		if err != nil {
 			return nil, db.Rollback(tx, err)
 		}
@@ -52,25 +57,34 @@ func AdminUpdateUser(ctx context.Context, userID uuid.UUID, input model.AdminEdi
 		update = update.SetPasswordHash(string(hashedPassword))
 	}
 
-	// Update isActive if provided
+	// Update isActive if provided, the last owner can't be deactivated
 	if input.IsActive != nil {
+		if !*input.IsActive && existingUser.IsActive {
+			if err := role.EnsureOwnerRemains(ctx, tx, targetUserId); err != nil {
+				return nil, db.Rollback(tx, err)
+			}
+		}
 		update = update.SetIsActive(*input.IsActive)
 	}
 
-	// Update role if provided
-	if input.RoleID != nil {
-		// Verify role exists
-		role, err := tx.Role.Get(ctx, *input.RoleID)
-		if err != nil {
-			return nil, db.Rollback(tx, errors.New("invalid role"))
-		}
+	// Save the changes
+	_, err = update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
 
-		// Clear existing roles and set new role
-		update = update.ClearRoles().AddRoleIDs(role.ID)
+	// Replace the roles if provided, roleIds takes precedence over the deprecated single roleId
+	roleIds := input.RoleIds
+	if roleIds == nil && input.RoleID != nil {
+		roleIds = []uuid.UUID{*input.RoleID}
+	}
+	if roleIds != nil {
+		if err := role.ReplaceUserRoles(ctx, tx, userId, targetUserId, roleIds); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
 	}
 
-	// Save the changes
-	updatedUser, err := update.Save(ctx)
+	updatedUser, err := tx.User.Get(ctx, targetUserId)
 	if err != nil {
 		return nil, db.Rollback(tx, err)
 	}
@@ -78,6 +92,9 @@ func AdminUpdateUser(ctx context.Context, userID uuid.UUID, input model.AdminEdi
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	if roleIds != nil {
+		role.InvalidatePrincipals(targetUserId)
+	}
 
 	return updatedUser, nil
 }
@@ -0,0 +1,559 @@
+package user
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	entGroup "template/internal/ent/group"
+	entRole "template/internal/ent/role"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/user"
+	groupFeat "template/internal/features/group"
+	roleFeat "template/internal/features/role"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/secret"
+	"template/internal/shared/utilities/slice"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// userCSVColumns are the columns of the users CSV, shared by the import and the export.
+var userCSVColumns = []string{"email", "first_name", "last_name", "username", "roles", "group"}
+
+const (
+	// csvListSeparator separates several roles or groups in a cell.
+	csvListSeparator = ";"
+	// maxImportedUsers is the maximum number of users of an import.
+	maxImportedUsers = 5000
+	// inviteTokenTTL is how long an invite token can be accepted.
+	inviteTokenTTL = 7 * 24 * time.Hour
+)
+
+// importedUser is a row of the users CSV.
+type importedUser struct {
+	row       *model.UserImportRow
+	firstName string
+	lastName  string
+	username  string
+	roles     []string
+	groups    []string
+}
+
+// userImporter creates or updates the imported users within a transaction.
+type userImporter struct {
+	tx             *ent.Tx
+	userId         uuid.UUID
+	isAdminOrOwner bool
+	credentials    model.UserImportCredentials
+	dryRun         bool
+	roles          map[string]*ent.Role
+	groupIds       map[string]uuid.UUID
+}
+
+// ImportUsers creates or updates the users of a CSV, matching the existing users by email regardless of its case. The rows are validated
+// first and nothing is imported while a row is invalid, a dry run only reports what would be done.
+// The created users get a temporary password or an invite token, returned once in the report.
+func ImportUsers(ctx context.Context, userId uuid.UUID, isAdminOrOwner bool, input model.ImportUsersInput) (*model.UserImportReport, error) {
+	dryRun := input.DryRun != nil && *input.DryRun
+	credentials := model.UserImportCredentialsTemporaryPassword
+	if input.Credentials != nil {
+		credentials = *input.Credentials
+	}
+
+	importedUsers, err := parseUsersCSV(input.CSV)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := tx.Role.Query().All(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	importer := &userImporter{
+		tx:             tx,
+		userId:         userId,
+		isAdminOrOwner: isAdminOrOwner,
+		credentials:    credentials,
+		dryRun:         dryRun,
+		roles:          slice.ToMap(roles, func(r *ent.Role) (string, *ent.Role) { return r.Name, r }),
+		groupIds:       map[string]uuid.UUID{},
+	}
+
+	if err := importer.validate(ctx, importedUsers); err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	report := &model.UserImportReport{DryRun: dryRun, Rows: []*model.UserImportRow{}}
+	updatedUserIds := []uuid.UUID{}
+	for _, importedUser := range importedUsers {
+		report.Rows = append(report.Rows, importedUser.row)
+		if len(importedUser.row.Errors) > 0 || report.ErrorCount > 0 {
+			continue
+		}
+
+		// A failed statement aborts the transaction, the next rows are only validated
+		userId, err := importer.importUser(ctx, importedUser)
+		if err != nil {
+			importedUser.row.Errors = append(importedUser.row.Errors, err.Error())
+			report.ErrorCount++
+			continue
+		}
+		if *importedUser.row.Action == model.UserImportActionUpdate {
+			updatedUserIds = append(updatedUserIds, userId)
+		}
+	}
+
+	report.ErrorCount = 0
+	for _, row := range report.Rows {
+		switch {
+		case len(row.Errors) > 0:
+			report.ErrorCount++
+		case row.Action == nil:
+		case *row.Action == model.UserImportActionCreate:
+			report.CreatedCount++
+		case *row.Action == model.UserImportActionUpdate:
+			report.UpdatedCount++
+		default:
+			report.UnchangedCount++
+		}
+	}
+
+	if dryRun || report.ErrorCount > 0 {
+		for _, row := range report.Rows {
+			row.TemporaryPassword = nil
+			row.InviteToken = nil
+		}
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return report, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	roleFeat.InvalidatePrincipals(updatedUserIds...)
+	report.Imported = true
+
+	return report, nil
+}
+
+// ExportUsers returns the users as a CSV with the columns of the import, ordered by email.
+func ExportUsers(ctx context.Context) (string, error) {
+	client, err := db.OpenClient()
+	if err != nil {
+		return "", err
+	}
+
+	users, err := client.User.Query().
+		WithRoles(func(rq *ent.RoleQuery) {
+			rq.Order(ent.Asc(entRole.FieldName))
+		}).
+		WithMemberGroups(func(gq *ent.GroupQuery) {
+			gq.Order(ent.Asc(entGroup.FieldName))
+		}).
+		Order(ent.Asc(user.FieldEmail)).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	writer := csv.NewWriter(&builder)
+	if err := writer.Write(userCSVColumns); err != nil {
+		return "", err
+	}
+	for _, u := range users {
+		record := []string{
+			u.Email,
+			valueOrEmpty(u.FirstName),
+			valueOrEmpty(u.LastName),
+			u.Username,
+			strings.Join(slice.Map(u.Edges.Roles, func(r *ent.Role) string { return r.Name }), csvListSeparator),
+			strings.Join(slice.Map(u.Edges.MemberGroups, func(g *ent.Group) string { return g.Name }), csvListSeparator),
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+// parseUsersCSV reads the rows of the users CSV, the first row being the header.
+func parseUsersCSV(content string) ([]*importedUser, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, errors.New("the CSV must have a header row and at least one user")
+	}
+	if len(records)-1 > maxImportedUsers {
+		return nil, fmt.Errorf("the CSV can't have more than %d users", maxImportedUsers)
+	}
+
+	columns := map[string]int{}
+	for index, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slice.Contains(userCSVColumns, name) {
+			return nil, fmt.Errorf("unknown column %q, the columns are %s", name, strings.Join(userCSVColumns, ", "))
+		}
+		if _, exists := columns[name]; exists {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		columns[name] = index
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, errors.New("the email column is required")
+	}
+
+	importedUsers := make([]*importedUser, 0, len(records)-1)
+	for index, record := range records[1:] {
+		cell := func(column string) string {
+			position, ok := columns[column]
+			if !ok || position >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[position])
+		}
+
+		importedUsers = append(importedUsers, &importedUser{
+			row: &model.UserImportRow{
+				Line:   index + 2,
+				Email:  cell("email"),
+				Errors: []string{},
+			},
+			firstName: cell("first_name"),
+			lastName:  cell("last_name"),
+			username:  cell("username"),
+			roles:     splitCSVList(cell("roles")),
+			groups:    splitCSVList(cell("group")),
+		})
+	}
+
+	return importedUsers, nil
+}
+
+// validate adds the errors of the invalid rows to their report row.
+func (i *userImporter) validate(ctx context.Context, importedUsers []*importedUser) error {
+	emails := map[string]int{}
+	usernames := map[string]int{}
+
+	for _, importedUser := range importedUsers {
+		row := importedUser.row
+		addError := func(format string, args ...any) {
+			row.Errors = append(row.Errors, fmt.Sprintf(format, args...))
+		}
+
+		if row.Email == "" {
+			addError("email is required")
+			continue
+		}
+		if address, err := mail.ParseAddress(row.Email); err != nil || address.Address != row.Email {
+			addError("invalid email %q", row.Email)
+			continue
+		}
+		if line, exists := emails[strings.ToLower(row.Email)]; exists {
+			addError("duplicate email, already on line %d", line)
+			continue
+		}
+		emails[strings.ToLower(row.Email)] = row.Line
+
+		// Emails are unique in every organization
+		existing, err := i.tx.User.Query().Where(user.EmailEqualFold(row.Email)).Only(mixin.SkipTenant(ctx))
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			visible, err := i.tx.User.Query().Where(user.ID(existing.ID)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !visible {
+				addError("email is used by another organization")
+				continue
+			}
+		}
+
+		username := importedUser.username
+		if username == "" && existing == nil {
+			username = row.Email
+		}
+		if username != "" {
+			if line, exists := usernames[strings.ToLower(username)]; exists {
+				addError("duplicate username, already on line %d", line)
+			}
+			usernames[strings.ToLower(username)] = row.Line
+		}
+		switch {
+		case importedUser.username != "":
+			existingId := uuid.Nil
+			if existing != nil {
+				existingId = existing.ID
+			}
+			if err := ValidateUsername(ctx, i.tx.Client(), existingId, importedUser.username); err != nil {
+				addError("%s", err.Error())
+			}
+		case username != "":
+			// The email is the default username of the created users
+			taken, err := i.tx.User.Query().
+				Where(user.UsernameEqualFold(username)).
+				Exist(mixin.SkipTenant(ctx))
+			if err != nil {
+				return err
+			}
+			if taken {
+				addError("username %q already exists", username)
+			}
+		}
+
+		for _, name := range importedUser.roles {
+			if _, exists := i.roles[name]; !exists {
+				addError("unknown role %q", name)
+			} else if err := roleFeat.CheckCanGrantRole(ctx, i.userId, name); err != nil {
+				addError("%s", err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// importUser creates or updates the user of a valid row, setting the action of the row.
+func (i *userImporter) importUser(ctx context.Context, importedUser *importedUser) (uuid.UUID, error) {
+	existing, err := i.tx.User.Query().Where(user.EmailEqualFold(importedUser.row.Email)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return uuid.Nil, err
+	}
+
+	var userId uuid.UUID
+	action := model.UserImportActionUnchanged
+	if existing == nil {
+		userId, err = i.createUser(ctx, importedUser)
+		action = model.UserImportActionCreate
+	} else {
+		userId = existing.ID
+		var changed bool
+		changed, err = i.updateUser(ctx, existing, importedUser)
+		if changed {
+			action = model.UserImportActionUpdate
+		}
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	joined, err := i.joinGroups(ctx, userId, importedUser.groups)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if joined && action == model.UserImportActionUnchanged {
+		action = model.UserImportActionUpdate
+	}
+
+	importedUser.row.Action = &action
+	return userId, nil
+}
+
+// createUser creates the user of a row with a temporary password or an invite token.
+func (i *userImporter) createUser(ctx context.Context, importedUser *importedUser) (uuid.UUID, error) {
+	roleNames := importedUser.roles
+	if len(roleNames) == 0 {
+		roleNames = []string{roleFeat.RoleUser}
+	}
+	roleIds := slice.Map(roleNames, func(name string) uuid.UUID { return i.roles[name].ID })
+
+	username := importedUser.username
+	if username == "" {
+		username = importedUser.row.Email
+	}
+
+	password, err := secret.NewToken(12)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// The password isn't hashed for a dry run as the user is rolled back
+	passwordHash := "dry-run"
+	if !i.dryRun {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		passwordHash = string(hash)
+	}
+
+	created, err := i.tx.User.Create().
+		SetEmail(importedUser.row.Email).
+		SetUsername(username).
+		SetNillableFirstName(nilIfEmpty(importedUser.firstName)).
+		SetNillableLastName(nilIfEmpty(importedUser.lastName)).
+		SetPasswordHash(passwordHash).
+		SetMustChangePassword(i.credentials == model.UserImportCredentialsTemporaryPassword).
+		AddRoleIDs(roleIds...).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if i.credentials == model.UserImportCredentialsTemporaryPassword {
+		importedUser.row.TemporaryPassword = &password
+		return created.ID, nil
+	}
+
+	token, err := secret.NewToken(32)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	_, err = i.tx.UserInvite.Create().
+		SetUserID(created.ID).
+		SetTokenHash(secret.HashToken(token)).
+		SetExpiresAt(time.Now().UTC().Add(inviteTokenTTL)).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	importedUser.row.InviteToken = &token
+
+	return created.ID, nil
+}
+
+// updateUser updates the names, the username and the roles given in the row, the empty cells are left unchanged.
+func (i *userImporter) updateUser(ctx context.Context, existing *ent.User, importedUser *importedUser) (bool, error) {
+	if err := roleFeat.CheckCanManageUser(ctx, i.tx.Client(), i.userId, existing.ID); err != nil {
+		return false, err
+	}
+
+	changed := false
+	update := i.tx.User.UpdateOneID(existing.ID)
+	if importedUser.firstName != "" && valueOrEmpty(existing.FirstName) != importedUser.firstName {
+		update = update.SetFirstName(importedUser.firstName)
+		changed = true
+	}
+	if importedUser.lastName != "" && valueOrEmpty(existing.LastName) != importedUser.lastName {
+		update = update.SetLastName(importedUser.lastName)
+		changed = true
+	}
+	if importedUser.username != "" && existing.Username != importedUser.username {
+		update = update.SetUsername(importedUser.username)
+		changed = true
+	}
+	if changed {
+		if err := update.Exec(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	if len(importedUser.roles) == 0 {
+		return changed, nil
+	}
+
+	currentRoles, err := existing.QueryRoles().Select(entRole.FieldName).Strings(ctx)
+	if err != nil {
+		return false, err
+	}
+	roleNames := slice.Unique(importedUser.roles)
+	sameRoles := len(currentRoles) == len(roleNames) && slice.Every(roleNames, func(name string) bool {
+		return slice.Contains(currentRoles, name)
+	})
+	if sameRoles {
+		return changed, nil
+	}
+
+	roleIds := slice.Map(roleNames, func(name string) uuid.UUID { return i.roles[name].ID })
+	if err := roleFeat.ReplaceUserRoles(ctx, i.tx, i.userId, existing.ID, roleIds); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// joinGroups adds the user to the groups they aren't a member of yet, creating the missing groups.
+func (i *userImporter) joinGroups(ctx context.Context, userId uuid.UUID, groupNames []string) (bool, error) {
+	joined := false
+	for _, name := range slice.Unique(groupNames) {
+		groupId, err := i.getOrCreateGroup(ctx, name)
+		if err != nil {
+			return false, err
+		}
+
+		newMemberIds, err := groupFeat.InsertGroupMembers(ctx, i.tx, i.userId, i.isAdminOrOwner, groupId, []uuid.UUID{userId})
+		if err != nil {
+			return false, fmt.Errorf("group %q: %w", name, err)
+		}
+		if len(newMemberIds) > 0 {
+			joined = true
+		}
+	}
+
+	return joined, nil
+}
+
+// getOrCreateGroup returns the group with the name the importing user can manage, creating it with the importing user
+// as the creator when there is none.
+func (i *userImporter) getOrCreateGroup(ctx context.Context, name string) (uuid.UUID, error) {
+	if groupId, ok := i.groupIds[name]; ok {
+		return groupId, nil
+	}
+
+	query := i.tx.Group.Query().Where(entGroup.Name(name))
+	if !i.isAdminOrOwner {
+		query = query.Where(entGroup.CreatorID(i.userId))
+	}
+	group, err := query.Order(ent.Asc(entGroup.FieldCreatedAt)).First(ctx)
+	if ent.IsNotFound(err) {
+		group, err = groupFeat.InsertGroup(ctx, i.tx, i.userId, model.CreateGroupInput{Name: name})
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	i.groupIds[name] = group.ID
+	return group.ID, nil
+}
+
+// splitCSVList splits a cell listing several values, ignoring the empty ones.
+func splitCSVList(cell string) []string {
+	values := []string{}
+	for _, value := range strings.Split(cell, csvListSeparator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func nilIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
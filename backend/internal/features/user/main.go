@@ -44,7 +44,10 @@ func GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*ent.User, error
 			user.FieldUsername,
 			user.FieldFirstName,
 			user.FieldLastName,
+			user.FieldAvatarID,
 			user.FieldIsActive,
+			user.FieldMustChangePassword,
+			user.FieldOrganizationID,
 		).
 		All(ctx)
 }
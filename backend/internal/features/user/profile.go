@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"template/internal/ent"
+	"template/internal/ent/db"
+	"template/internal/ent/media"
+	"template/internal/ent/schema/mixin"
+	"template/internal/ent/user"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// usernamePattern is the format of the usernames chosen by the users.
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{3,32}$`)
+
+// UpdateMyProfile updates the names, the username and the avatar of a user, the omitted fields are left unchanged.
+func UpdateMyProfile(ctx context.Context, userId uuid.UUID, input model.UpdateMyProfileInput) (*ent.User, error) {
+	tx, err := db.OpenTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingUser, err := tx.User.Get(ctx, userId)
+	if err != nil {
+		return nil, db.Rollback(tx, errors.New("user not found"))
+	}
+
+	update := tx.User.UpdateOneID(userId)
+
+	if input.FirstName != nil {
+		if firstName := strings.TrimSpace(*input.FirstName); firstName != "" {
+			update = update.SetFirstName(firstName)
+		} else {
+			update = update.ClearFirstName()
+		}
+	}
+	if input.LastName != nil {
+		if lastName := strings.TrimSpace(*input.LastName); lastName != "" {
+			update = update.SetLastName(lastName)
+		} else {
+			update = update.ClearLastName()
+		}
+	}
+
+	if input.Username != nil && *input.Username != existingUser.Username {
+		if err := ValidateUsername(ctx, tx.Client(), userId, *input.Username); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		update = update.SetUsername(*input.Username)
+	}
+
+	switch {
+	case input.AvatarID != nil:
+		if err := checkCanUseAvatar(ctx, tx.Client(), userId, *input.AvatarID); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+		update = update.SetAvatarID(*input.AvatarID)
+	case input.RemoveAvatar != nil && *input.RemoveAvatar:
+		update = update.ClearAvatarID()
+	}
+
+	updatedUser, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updatedUser, nil
+}
+
+// ValidateUsername checks the format of a username chosen by a user and that no other user has it,
+// whatever the case. The user id is uuid.Nil for a user who isn't created yet.
+func ValidateUsername(ctx context.Context, client *ent.Client, userId uuid.UUID, username string) error {
+	if !usernamePattern.MatchString(username) {
+		return errors.New("username must be 3 to 32 letters, digits, dots, dashes or underscores")
+	}
+
+	taken, err := client.User.Query().
+		Where(user.UsernameEqualFold(username), user.IDNEQ(userId)).
+		Exist(mixin.SkipTenant(ctx)) // Usernames are unique in every organization
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New("username already exists")
+	}
+
+	return nil
+}
+
+// checkCanUseAvatar makes sure the media is an image uploaded by the user.
+func checkCanUseAvatar(ctx context.Context, client *ent.Client, userId uuid.UUID, mediaId uuid.UUID) error {
+	avatar, err := client.Media.Query().
+		Where(media.ID(mediaId), media.UploaderID(userId)).
+		Only(ctx)
+	if err != nil {
+		return errors.New("media not found or unauthorized")
+	}
+	if !strings.HasPrefix(avatar.MimeType, "image/") {
+		return errors.New("the avatar must be an image")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/access"
+	"template/internal/features/role"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// User is the resolver for the user field.
+func (r *accessGrantResolver) User(ctx context.Context, obj *model.AccessGrant) (*model.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return dataloader.GetUser(ctx, *obj.UserID)
+}
+
+// Group is the resolver for the group field.
+func (r *accessGrantResolver) Group(ctx context.Context, obj *model.AccessGrant) (*model.Group, error) {
+	if obj.GroupID == nil {
+		return nil, nil
+	}
+	return dataloader.GetGroup(ctx, *obj.GroupID)
+}
+
+// ShareResource is the resolver for the shareResource field.
+func (r *mutationResolver) ShareResource(ctx context.Context, input model.ShareResourceInput) (*model.AccessGrant, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := access.ShareResource(ctx, userId, isAdminOrOwner, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertAccessGrantToModel(grant), nil
+}
+
+// RevokeAccessGrant is the resolver for the revokeAccessGrant field.
+func (r *mutationResolver) RevokeAccessGrant(ctx context.Context, id uuid.UUID) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return access.RevokeAccessGrant(ctx, userId, isAdminOrOwner, id)
+}
+
+// AccessGrants is the resolver for the accessGrants field.
+func (r *queryResolver) AccessGrants(ctx context.Context, resourceType model.AccessResourceType, resourceID uuid.UUID) ([]*model.AccessGrant, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := access.GetAccessGrants(ctx, userId, isAdminOrOwner, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Map(grants, model.ConvertAccessGrantToModel), nil
+}
+
+// AccessGrant returns AccessGrantResolver implementation.
+func (r *Resolver) AccessGrant() AccessGrantResolver { return &accessGrantResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type accessGrantResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
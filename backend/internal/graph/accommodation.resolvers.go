@@ -0,0 +1,91 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/role"
+	"template/internal/features/test_session"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// SetUserAccommodation is the resolver for the setUserAccommodation field.
+func (r *mutationResolver) SetUserAccommodation(ctx context.Context, userID uuid.UUID, input model.AccommodationInput) (*model.UserAccommodation, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	accommodation, err := test_session.SetUserAccommodation(ctx, userId, isAdminOrOwner, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertUserAccommodationToModel(accommodation), nil
+}
+
+// SetTestSessionAccommodation is the resolver for the setTestSessionAccommodation field.
+func (r *mutationResolver) SetTestSessionAccommodation(ctx context.Context, sessionID uuid.UUID, input model.AccommodationInput) (*model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := test_session.SetTestSessionAccommodation(ctx, userId, isAdminOrOwner, sessionID, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertTestSessionToModel(session), nil
+}
+
+// UserAccommodation is the resolver for the userAccommodation field.
+func (r *queryResolver) UserAccommodation(ctx context.Context, userID uuid.UUID) (*model.UserAccommodation, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	accommodation, err := test_session.GetUserAccommodation(ctx, userId, isAdminOrOwner, userID)
+	if err != nil || accommodation == nil {
+		return nil, err
+	}
+	return model.ConvertUserAccommodationToModel(accommodation), nil
+}
+
+// TestSessionTimeExtensions is the resolver for the testSessionTimeExtensions field.
+func (r *queryResolver) TestSessionTimeExtensions(ctx context.Context, sessionID uuid.UUID) ([]*model.TestSessionTimeExtension, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, err := test_session.GetTestSessionTimeExtensions(ctx, userId, isAdminOrOwner, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(extensions, model.ConvertTestSessionTimeExtensionToModel), nil
+}
@@ -0,0 +1,19 @@
+package graph
+
+import (
+	"context"
+	"template/internal/graph/dataloader"
+
+	"github.com/google/uuid"
+)
+
+// canViewAnswerKey reports whether the user of the request can see the correct options and explanations of a question,
+// which are only returned to the author of the question and to admins so candidates can't read them during a session.
+func canViewAnswerKey(ctx context.Context, questionId uuid.UUID) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return dataloader.CanViewAnswerKey(ctx, userId, questionId)
+}
@@ -69,6 +69,21 @@ func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, input model.ChangePasswordInput) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	token, err := ExtractJwtTokenFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return auth.ChangePassword(ctx, userId, token, input)
+}
+
 // RenewToken is the resolver for the renewToken field.
 func (r *mutationResolver) RenewToken(ctx context.Context, refreshToken string) (*model.Auth, error) {
 	// Validate the refresh token
@@ -108,6 +123,11 @@ func (r *mutationResolver) RenewToken(ctx context.Context, refreshToken string)
 	}, nil
 }
 
+// AcceptInvite is the resolver for the acceptInvite field.
+func (r *mutationResolver) AcceptInvite(ctx context.Context, input model.AcceptInviteInput) (bool, error) {
+	return auth.AcceptInvite(ctx, input)
+}
+
 // Me is the resolver for the me field.
 func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
 	currentUser, err := GetUserFromRequestContext(ctx)
@@ -126,12 +146,3 @@ func (r *queryResolver) IsAuthenticated(ctx context.Context) (bool, error) {
 	}
 	return true, nil
 }
-
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
-
-// Query returns QueryResolver implementation.
-func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
-
-type mutationResolver struct{ *Resolver }
-type queryResolver struct{ *Resolver }
@@ -8,7 +8,7 @@ import (
 	"context"
 	"errors"
 	"template/internal/features/course"
-	"template/internal/features/permission"
+	"template/internal/features/role"
 	"template/internal/graph/dataloader"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
@@ -23,9 +23,7 @@ func (r *courseResolver) Creator(ctx context.Context, obj *model.Course) (*model
 
 // CreateCourse is the resolver for the createCourse field.
 func (r *mutationResolver) CreateCourse(ctx context.Context, input model.CreateCourseInput) (*model.Course, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -40,21 +38,22 @@ func (r *mutationResolver) CreateCourse(ctx context.Context, input model.CreateC
 
 // RemoveCourse is the resolver for the removeCourse field.
 func (r *mutationResolver) RemoveCourse(ctx context.Context, id uuid.UUID) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	return course.RemoveCourse(ctx, userId, id)
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return course.RemoveCourse(ctx, userId, isAdminOrOwner, id)
 }
 
 // UpdateCourse is the resolver for the updateCourse field.
 func (r *mutationResolver) UpdateCourse(ctx context.Context, id uuid.UUID, input model.UpdateCourseInput) (*model.Course, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +65,95 @@ func (r *mutationResolver) UpdateCourse(ctx context.Context, id uuid.UUID, input
 	return model.ConvertCourseToModel(updatedCourse), nil
 }
 
+// SubmitCourseForReview is the resolver for the submitCourseForReview field.
+func (r *mutationResolver) SubmitCourseForReview(ctx context.Context, id uuid.UUID) (*model.Course, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCourse, err := course.SubmitCourseForReview(ctx, userId, isAdminOrOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertCourseToModel(updatedCourse), nil
+}
+
+// PublishCourse is the resolver for the publishCourse field.
+func (r *mutationResolver) PublishCourse(ctx context.Context, id uuid.UUID) (*model.Course, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCourse, err := course.PublishCourse(ctx, userId, isAdminOrOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertCourseToModel(updatedCourse), nil
+}
+
+// ArchiveCourse is the resolver for the archiveCourse field.
+func (r *mutationResolver) ArchiveCourse(ctx context.Context, id uuid.UUID) (*model.Course, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCourse, err := course.ArchiveCourse(ctx, userId, isAdminOrOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertCourseToModel(updatedCourse), nil
+}
+
+// CloneCourse is the resolver for the cloneCourse field.
+func (r *mutationResolver) CloneCourse(ctx context.Context, courseID uuid.UUID, newTitle string) (*model.Course, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	clonedCourse, err := course.CloneCourse(ctx, userId, isAdminOrOwner, courseID, newTitle)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertCourseToModel(clonedCourse), nil
+}
+
 // Course is the resolver for the course field.
 func (r *queryResolver) Course(ctx context.Context, id uuid.UUID) (*model.Course, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
-	foundCourse, err := course.GetCourseByID(ctx, id)
+	foundCourse, err := course.GetVisibleCourseByID(ctx, userId, id, isAdminOrOwner)
 	if err != nil {
 		return nil, errors.New("course not found")
 	}
@@ -85,9 +163,7 @@ func (r *queryResolver) Course(ctx context.Context, id uuid.UUID) (*model.Course
 
 // PaginatedCourses is the resolver for the paginatedCourses field.
 func (r *queryResolver) PaginatedCourses(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedCourse, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
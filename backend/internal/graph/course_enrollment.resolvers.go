@@ -0,0 +1,144 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/course_enrollment"
+	"template/internal/features/role"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// Course is the resolver for the course field.
+func (r *courseEnrollmentResolver) Course(ctx context.Context, obj *model.CourseEnrollment) (*model.Course, error) {
+	return dataloader.GetCourse(ctx, obj.CourseID)
+}
+
+// User is the resolver for the user field.
+func (r *courseEnrollmentResolver) User(ctx context.Context, obj *model.CourseEnrollment) (*model.User, error) {
+	return dataloader.GetUser(ctx, obj.UserID)
+}
+
+// EnrollCourse is the resolver for the enrollCourse field.
+func (r *mutationResolver) EnrollCourse(ctx context.Context, input model.EnrollCourseInput) (*model.CourseEnrollment, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := course_enrollment.EnrollUser(ctx, userId, isAdminOrOwner, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertCourseEnrollmentToModel(enrollment), nil
+}
+
+// BulkEnrollCourse is the resolver for the bulkEnrollCourse field.
+func (r *mutationResolver) BulkEnrollCourse(ctx context.Context, input model.BulkEnrollCourseInput) (*model.BulkEnrollCourseResult, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := course_enrollment.BulkEnrollByEmails(ctx, userId, isAdminOrOwner, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.BulkEnrollCourseResult{
+		Enrollments:   slice.Map(result.Enrollments, model.ConvertCourseEnrollmentToModel),
+		UnknownEmails: result.UnknownEmails,
+	}, nil
+}
+
+// UnenrollCourse is the resolver for the unenrollCourse field.
+func (r *mutationResolver) UnenrollCourse(ctx context.Context, courseID uuid.UUID, userID uuid.UUID) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return course_enrollment.Unenroll(ctx, userId, isAdminOrOwner, courseID, userID)
+}
+
+// MyCourses is the resolver for the myCourses field.
+func (r *queryResolver) MyCourses(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedCourse, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedCourse, err := course_enrollment.MyCourses(ctx, userId, paginationInput)
+	if err != nil {
+		return nil, err
+	}
+	items := slice.Map(paginatedCourse.Items, model.ConvertCourseToModel)
+	pagination := &model.Pagination{
+		CurrentPage:     paginatedCourse.CurrentPage,
+		TotalPages:      paginatedCourse.TotalPages,
+		TotalItems:      paginatedCourse.TotalItems,
+		HasNextPage:     paginatedCourse.HasNextPage,
+		HasPreviousPage: paginatedCourse.HasPrevPage,
+	}
+	return &model.PaginatedCourse{
+		Pagination: pagination,
+		Items:      items,
+	}, nil
+}
+
+// CourseEnrollments is the resolver for the courseEnrollments field.
+func (r *queryResolver) CourseEnrollments(ctx context.Context, courseID uuid.UUID, paginationInput *model.PaginationInput, filterInput *model.CourseEnrollmentFilterInput) (*model.PaginatedCourseEnrollment, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := course_enrollment.PaginatedCourseEnrollments(ctx, userId, isAdminOrOwner, courseID, paginationInput, filterInput)
+	if err != nil {
+		return nil, err
+	}
+	items := slice.Map(result.Items, model.ConvertCourseEnrollmentToModel)
+	pagination := &model.Pagination{
+		CurrentPage:     result.CurrentPage,
+		TotalPages:      result.TotalPages,
+		TotalItems:      result.TotalItems,
+		HasNextPage:     result.HasNextPage,
+		HasPreviousPage: result.HasPrevPage,
+	}
+	return &model.PaginatedCourseEnrollment{
+		Pagination: pagination,
+		Items:      items,
+	}, nil
+}
+
+// CourseEnrollment returns CourseEnrollmentResolver implementation.
+func (r *Resolver) CourseEnrollment() CourseEnrollmentResolver { return &courseEnrollmentResolver{r} }
+
+type courseEnrollmentResolver struct{ *Resolver }
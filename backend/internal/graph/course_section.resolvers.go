@@ -7,18 +7,32 @@ package graph
 import (
 	"context"
 	"template/internal/features/course_section"
-	"template/internal/features/permission"
+	"template/internal/features/role"
+	"template/internal/graph/dataloader"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
 
 	"github.com/google/uuid"
 )
 
+// IsUnlocked is the resolver for the isUnlocked field.
+func (r *courseSectionResolver) IsUnlocked(ctx context.Context, obj *model.CourseSection) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return dataloader.IsSectionUnlocked(ctx, userId, obj.ID)
+}
+
+// PrerequisiteSection is the resolver for the prerequisiteSection field.
+func (r *courseSectionPrerequisiteResolver) PrerequisiteSection(ctx context.Context, obj *model.CourseSectionPrerequisite) (*model.CourseSection, error) {
+	return dataloader.GetCourseSection(ctx, obj.PrerequisiteSectionID)
+}
+
 // CreateCourseSection is the resolver for the createCourseSection field.
 func (r *mutationResolver) CreateCourseSection(ctx context.Context, input model.CreateCourseSectionInput) (*model.CourseSection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseSectionCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -32,9 +46,7 @@ func (r *mutationResolver) CreateCourseSection(ctx context.Context, input model.
 
 // UpdateCourseSection is the resolver for the updateCourseSection field.
 func (r *mutationResolver) UpdateCourseSection(ctx context.Context, id uuid.UUID, input model.UpdateCourseSectionInput) (*model.CourseSection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseSectionUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,9 +60,7 @@ func (r *mutationResolver) UpdateCourseSection(ctx context.Context, id uuid.UUID
 
 // RemoveCourseSection is the resolver for the removeCourseSection field.
 func (r *mutationResolver) RemoveCourseSection(ctx context.Context, id uuid.UUID) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseSectionDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -58,11 +68,51 @@ func (r *mutationResolver) RemoveCourseSection(ctx context.Context, id uuid.UUID
 	return course_section.RemoveCourseSection(ctx, userId, id)
 }
 
+// MoveCourseSection is the resolver for the moveCourseSection field.
+func (r *mutationResolver) MoveCourseSection(ctx context.Context, id uuid.UUID, newParentID *uuid.UUID, position int) (*model.CourseSection, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	courseSection, err := course_section.MoveCourseSection(ctx, userId, id, newParentID, position)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertCourseSectionToModel(courseSection), nil
+}
+
+// ReorderCourseSections is the resolver for the reorderCourseSections field.
+func (r *mutationResolver) ReorderCourseSections(ctx context.Context, courseID uuid.UUID, parentID *uuid.UUID, orderedIds []uuid.UUID) ([]*model.CourseSection, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := course_section.ReorderCourseSections(ctx, userId, courseID, parentID, orderedIds)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(sections, model.ConvertCourseSectionToModel), nil
+}
+
+// SetSectionPrerequisites is the resolver for the setSectionPrerequisites field.
+func (r *mutationResolver) SetSectionPrerequisites(ctx context.Context, sectionID uuid.UUID, input []*model.SectionPrerequisiteInput) ([]*model.CourseSectionPrerequisite, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisites, err := course_section.SetSectionPrerequisites(ctx, userId, sectionID, input)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(prerequisites, model.ConvertCourseSectionPrerequisiteToModel), nil
+}
+
 // CourseSection is the resolver for the courseSection field.
 func (r *queryResolver) CourseSection(ctx context.Context, id uuid.UUID) (*model.CourseSection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseSectionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +126,7 @@ func (r *queryResolver) CourseSection(ctx context.Context, id uuid.UUID) (*model
 
 // CourseSectionsByCourseID is the resolver for the courseSectionsByCourseId field.
 func (r *queryResolver) CourseSectionsByCourseID(ctx context.Context, courseID uuid.UUID, filter *model.CourseSectionFilterInput) ([]*model.CourseSection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CourseSectionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -90,3 +138,48 @@ func (r *queryResolver) CourseSectionsByCourseID(ctx context.Context, courseID u
 
 	return slice.Map(sections, model.ConvertCourseSectionToModel), nil
 }
+
+// CourseOutline is the resolver for the courseOutline field.
+func (r *queryResolver) CourseOutline(ctx context.Context, courseID uuid.UUID) ([]*model.CourseOutlineSection, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return course_section.GetCourseOutline(ctx, userId, isAdminOrOwner, courseID)
+}
+
+// SectionPrerequisites is the resolver for the sectionPrerequisites field.
+func (r *queryResolver) SectionPrerequisites(ctx context.Context, sectionID uuid.UUID) ([]*model.CourseSectionPrerequisite, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisites, err := course_section.GetSectionPrerequisites(ctx, userId, isAdminOrOwner, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(prerequisites, model.ConvertCourseSectionPrerequisiteToModel), nil
+}
+
+// CourseSection returns CourseSectionResolver implementation.
+func (r *Resolver) CourseSection() CourseSectionResolver { return &courseSectionResolver{r} }
+
+// CourseSectionPrerequisite returns CourseSectionPrerequisiteResolver implementation.
+func (r *Resolver) CourseSectionPrerequisite() CourseSectionPrerequisiteResolver {
+	return &courseSectionPrerequisiteResolver{r}
+}
+
+type courseSectionResolver struct{ *Resolver }
+type courseSectionPrerequisiteResolver struct{ *Resolver }
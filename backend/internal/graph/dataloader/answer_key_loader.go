@@ -0,0 +1,54 @@
+package dataloader
+
+import (
+	"context"
+	"template/internal/features/question"
+	"template/internal/features/role"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// AnswerKeyAccessKey identifies whether a user can see the answer key of a question.
+type AnswerKeyAccessKey struct {
+	UserID     uuid.UUID
+	QuestionID uuid.UUID
+}
+
+func getAnswerKeyAccess(ctx context.Context, keys []AnswerKeyAccessKey) ([]bool, []error) {
+	items := make([]bool, len(keys))
+	errs := make([]error, len(keys))
+
+	userIDs := slice.Unique(slice.Map(keys, func(key AnswerKeyAccessKey) uuid.UUID { return key.UserID }))
+	for _, userID := range userIDs {
+		indexes := []int{}
+		for i, key := range keys {
+			if key.UserID == userID {
+				indexes = append(indexes, i)
+			}
+		}
+		questionIDs := slice.Map(indexes, func(i int) uuid.UUID { return keys[i].QuestionID })
+
+		isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userID)
+		var access map[uuid.UUID]bool
+		if err == nil {
+			access, err = question.GetAnswerKeyAccess(ctx, userID, isAdminOrOwner, questionIDs)
+		}
+
+		for _, i := range indexes {
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			items[i] = access[keys[i].QuestionID]
+		}
+	}
+
+	return items, errs
+}
+
+// CanViewAnswerKey returns whether a user can see the answer key of a question using the dataloader.
+func CanViewAnswerKey(ctx context.Context, userID uuid.UUID, questionID uuid.UUID) (bool, error) {
+	loaders := For(ctx)
+	return loaders.AnswerKeyAccessLoader.Load(ctx, AnswerKeyAccessKey{UserID: userID, QuestionID: questionID})
+}
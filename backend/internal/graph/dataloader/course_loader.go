@@ -0,0 +1,31 @@
+package dataloader
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/features/course"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+func getCourses(ctx context.Context, courseIDs []uuid.UUID) ([]*model.Course, []error) {
+	lu := NewLoaderByIds[ent.Course, model.Course](courseIDs)
+
+	lu.LoadItemsOneToOne(ctx, course.GetCoursesByIDs, func(id uuid.UUID, items []*ent.Course) *ent.Course {
+		return slice.FindValue(items, func(item *ent.Course) bool {
+			return item.ID == id
+		})
+	}, func(entCourse *ent.Course) (*model.Course, error) {
+		return model.ConvertCourseToModel(entCourse), nil
+	})
+
+	return lu.Items, lu.Errors
+}
+
+// GetCourse returns a single course by ID using the dataloader.
+func GetCourse(ctx context.Context, courseID uuid.UUID) (*model.Course, error) {
+	loaders := For(ctx)
+	return loaders.CourseLoader.Load(ctx, courseID)
+}
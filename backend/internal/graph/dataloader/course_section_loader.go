@@ -14,7 +14,7 @@ func getCourseSections(ctx context.Context, sectionIDs []uuid.UUID) ([]*model.Co
 	lu := NewLoaderByIds[ent.CourseSection, model.CourseSection](sectionIDs)
 
 	lu.LoadItemsOneToOne(ctx, course_section.GetCourseSectionsByIDs, func(id uuid.UUID, items []*ent.CourseSection) *ent.CourseSection {
-		return *slice.Find(items, func(item *ent.CourseSection) bool {
+		return slice.FindValue(items, func(item *ent.CourseSection) bool {
 			return item.ID == id
 		})
 	}, func(entSection *ent.CourseSection) (*model.CourseSection, error) {
@@ -21,6 +21,7 @@ type Loaders struct {
 	QuestionLoader                  *dataloadgen.Loader[uuid.UUID, *model.Question]
 	RolesByUserLoader               *dataloadgen.Loader[uuid.UUID, []*model.Role]
 	PermissionsByUserLoader         *dataloadgen.Loader[uuid.UUID, []permission.Permission]
+	PermissionsByRoleLoader         *dataloadgen.Loader[uuid.UUID, []permission.Permission]
 	CourseSectionLoader             *dataloadgen.Loader[uuid.UUID, *model.CourseSection]
 	QuestionOptionLoader            *dataloadgen.Loader[uuid.UUID, []*model.QuestionOption]
 	CorrectOptionCountLoader        *dataloadgen.Loader[uuid.UUID, int]
@@ -32,6 +33,12 @@ type Loaders struct {
 	TestQuestionCountsByTestLoader  *dataloadgen.Loader[uuid.UUID, []*model.TestQuestionCount]
 	TestIgnoreQuestionsByTestLoader *dataloadgen.Loader[uuid.UUID, []*model.TestIgnoreQuestion]
 	TestLoader                      *dataloadgen.Loader[uuid.UUID, *model.Test]
+	CourseLoader                    *dataloadgen.Loader[uuid.UUID, *model.Course]
+	AnswerKeyAccessLoader           *dataloadgen.Loader[AnswerKeyAccessKey, bool]
+	SectionUnlockLoader             *dataloadgen.Loader[SectionUnlockKey, bool]
+	GroupLoader                     *dataloadgen.Loader[uuid.UUID, *model.Group]
+	MembersByGroupLoader            *dataloadgen.Loader[uuid.UUID, []*model.User]
+	OrganizationLoader              *dataloadgen.Loader[uuid.UUID, *model.Organization]
 }
 
 // NewLoaders instantiates and returns a new Loaders struct with a UserLoader.
@@ -41,6 +48,7 @@ func NewLoaders() *Loaders {
 		QuestionLoader:                  dataloadgen.NewLoader(getQuestions, dataloadgen.WithWait(time.Millisecond)),
 		RolesByUserLoader:               dataloadgen.NewLoader(getRolesByUserIDs, dataloadgen.WithWait(time.Millisecond)),
 		PermissionsByUserLoader:         dataloadgen.NewLoader(getPermissionsByUserIDs, dataloadgen.WithWait(time.Millisecond)),
+		PermissionsByRoleLoader:         dataloadgen.NewLoader(getPermissionsByRoleIDs, dataloadgen.WithWait(time.Millisecond)),
 		CourseSectionLoader:             dataloadgen.NewLoader(getCourseSections, dataloadgen.WithWait(time.Millisecond)),
 		QuestionOptionLoader:            dataloadgen.NewLoader(getQuestionOptionsByQuestionIDs, dataloadgen.WithWait(time.Millisecond)),
 		CorrectOptionCountLoader:        dataloadgen.NewLoader(GetCorrectOptionCountByQuestionIds, dataloadgen.WithWait(time.Millisecond)),
@@ -52,6 +60,12 @@ func NewLoaders() *Loaders {
 		TestQuestionCountsByTestLoader:  dataloadgen.NewLoader(getTestQuestionCountsByTestIDs, dataloadgen.WithWait(time.Millisecond)),
 		TestIgnoreQuestionsByTestLoader: dataloadgen.NewLoader(getTestIgnoreQuestionsByTestIDs, dataloadgen.WithWait(time.Millisecond)),
 		TestLoader:                      dataloadgen.NewLoader(getTests, dataloadgen.WithWait(time.Millisecond)),
+		CourseLoader:                    dataloadgen.NewLoader(getCourses, dataloadgen.WithWait(time.Millisecond)),
+		AnswerKeyAccessLoader:           dataloadgen.NewLoader(getAnswerKeyAccess, dataloadgen.WithWait(time.Millisecond)),
+		SectionUnlockLoader:             dataloadgen.NewLoader(getSectionUnlocks, dataloadgen.WithWait(time.Millisecond)),
+		GroupLoader:                     dataloadgen.NewLoader(getGroups, dataloadgen.WithWait(time.Millisecond)),
+		MembersByGroupLoader:            dataloadgen.NewLoader(getMembersByGroupIDs, dataloadgen.WithWait(time.Millisecond)),
+		OrganizationLoader:              dataloadgen.NewLoader(getOrganizations, dataloadgen.WithWait(time.Millisecond)),
 	}
 }
 
@@ -0,0 +1,58 @@
+package dataloader
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/features/group"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+func getGroups(ctx context.Context, groupIDs []uuid.UUID) ([]*model.Group, []error) {
+	lu := NewLoaderByIds[ent.Group, model.Group](groupIDs)
+
+	lu.LoadItemsOneToOne(ctx, group.GetGroupsByIDs, func(id uuid.UUID, items []*ent.Group) *ent.Group {
+		return slice.FindValue(items, func(item *ent.Group) bool {
+			return item.ID == id
+		})
+	}, func(entGroup *ent.Group) (*model.Group, error) {
+		return model.ConvertGroupToModel(entGroup), nil
+	})
+
+	return lu.Items, lu.Errors
+}
+
+// GetGroup returns a single group by ID using the dataloader.
+func GetGroup(ctx context.Context, groupID uuid.UUID) (*model.Group, error) {
+	loaders := For(ctx)
+	return loaders.GroupLoader.Load(ctx, groupID)
+}
+
+func getMembersByGroupIDs(ctx context.Context, groupIDs []uuid.UUID) ([][]*model.User, []error) {
+	groupMembersMap, err := group.GetMembersByGroupIDs(ctx, groupIDs)
+
+	items := make([][]*model.User, len(groupIDs))
+	errs := make([]error, len(groupIDs))
+
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+			items[i] = []*model.User{}
+		}
+		return items, errs
+	}
+
+	for i, groupID := range groupIDs {
+		items[i] = slice.Map(groupMembersMap[groupID], model.ConvertUserToModel)
+	}
+
+	return items, errs
+}
+
+// GetMembersByGroupID returns the members of a group using the dataloader.
+func GetMembersByGroupID(ctx context.Context, groupID uuid.UUID) ([]*model.User, error) {
+	loaders := For(ctx)
+	return loaders.MembersByGroupLoader.Load(ctx, groupID)
+}
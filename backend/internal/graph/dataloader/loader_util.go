@@ -105,10 +105,11 @@ func (lu *LoaderUtils[OriginalType, ReturnType]) LoadItemsOneToOne(
 		return lu.Items, lu.Errors
 	}
 
-	// Build a map from each item's key to the original item.
+	// Build a map from each item's key to the original item, the missing ones are reported as not found.
 	for _, uid := range lu.UUIDs {
-		o := itemFunc(uid, items)
-		lu.ItemMap[uid.String()] = o
+		if o := itemFunc(uid, items); o != nil {
+			lu.ItemMap[uid.String()] = o
+		}
 	}
 
 	// For each input id from the IdMap, get the corresponding original item if available.
@@ -0,0 +1,31 @@
+package dataloader
+
+import (
+	"context"
+	"template/internal/ent"
+	"template/internal/features/organization"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+func getOrganizations(ctx context.Context, organizationIDs []uuid.UUID) ([]*model.Organization, []error) {
+	lu := NewLoaderByIds[ent.Organization, model.Organization](organizationIDs)
+
+	lu.LoadItemsOneToOne(ctx, organization.GetOrganizationsByIDs, func(id uuid.UUID, items []*ent.Organization) *ent.Organization {
+		return slice.FindValue(items, func(item *ent.Organization) bool {
+			return item.ID == id
+		})
+	}, func(entOrganization *ent.Organization) (*model.Organization, error) {
+		return model.ConvertOrganizationToModel(entOrganization), nil
+	})
+
+	return lu.Items, lu.Errors
+}
+
+// GetOrganization returns a single organization by ID using the dataloader.
+func GetOrganization(ctx context.Context, organizationID uuid.UUID) (*model.Organization, error) {
+	loaders := For(ctx)
+	return loaders.OrganizationLoader.Load(ctx, organizationID)
+}
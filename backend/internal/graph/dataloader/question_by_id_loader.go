@@ -14,7 +14,7 @@ func getQuestions(ctx context.Context, questionIDs []uuid.UUID) ([]*model.Questi
 	lu := NewLoaderByIds[ent.Question, model.Question](questionIDs)
 
 	lu.LoadItemsOneToOne(ctx, question.GetByIDs, func(id uuid.UUID, items []*ent.Question) *ent.Question {
-		return *slice.Find(items, func(item *ent.Question) bool {
+		return slice.FindValue(items, func(item *ent.Question) bool {
 			return item.ID == id
 		})
 	}, func(entQuestion *ent.Question) (*model.Question, error) {
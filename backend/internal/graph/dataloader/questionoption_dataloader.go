@@ -20,10 +20,11 @@ func getQuestionOptionsByQuestionIDs(ctx context.Context, questionIDs []uuid.UUI
 	}, func(items []*ent.QuestionOption) ([]*model.QuestionOption, error) {
 		result := slice.Map(items, func(option *ent.QuestionOption) *model.QuestionOption {
 			return &model.QuestionOption{
-				ID:         option.ID,
-				OptionText: option.OptionText,
-				IsCorrect:  option.IsCorrect,
-				QuestionID: option.QuestionID,
+				ID:          option.ID,
+				OptionText:  option.OptionText,
+				IsCorrect:   option.IsCorrect,
+				QuestionID:  option.QuestionID,
+				Explanation: option.Explanation,
 			}
 		})
 		return result, nil
@@ -2,6 +2,7 @@ package dataloader
 
 import (
 	"context"
+	"template/internal/features/permission"
 	"template/internal/features/role"
 	"template/internal/graph/model"
 
@@ -47,3 +48,34 @@ func GetRolesByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Role, err
 	loaders := For(ctx)
 	return loaders.RolesByUserLoader.Load(ctx, userID)
 }
+
+func getPermissionsByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([][]permission.Permission, []error) {
+	rolePermissionsMap, err := role.GetPermissionsByRoleIDs(ctx, roleIDs)
+
+	items := make([][]permission.Permission, len(roleIDs))
+	errs := make([]error, len(roleIDs))
+
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+			items[i] = []permission.Permission{}
+		}
+		return items, errs
+	}
+
+	for i, roleID := range roleIDs {
+		if permissions, exists := rolePermissionsMap[roleID]; exists {
+			items[i] = permissions
+		} else {
+			items[i] = []permission.Permission{}
+		}
+	}
+
+	return items, errs
+}
+
+// GetPermissionsByRoleID returns permissions for a role ID using the dataloader.
+func GetPermissionsByRoleID(ctx context.Context, roleID uuid.UUID) ([]permission.Permission, error) {
+	loaders := For(ctx)
+	return loaders.PermissionsByRoleLoader.Load(ctx, roleID)
+}
@@ -0,0 +1,48 @@
+package dataloader
+
+import (
+	"context"
+	"template/internal/features/course_section"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// SectionUnlockKey identifies whether a user has met the prerequisites of a course section.
+type SectionUnlockKey struct {
+	UserID    uuid.UUID
+	SectionID uuid.UUID
+}
+
+func getSectionUnlocks(ctx context.Context, keys []SectionUnlockKey) ([]bool, []error) {
+	items := make([]bool, len(keys))
+	errs := make([]error, len(keys))
+
+	userIDs := slice.Unique(slice.Map(keys, func(key SectionUnlockKey) uuid.UUID { return key.UserID }))
+	for _, userID := range userIDs {
+		indexes := []int{}
+		for i, key := range keys {
+			if key.UserID == userID {
+				indexes = append(indexes, i)
+			}
+		}
+		sectionIDs := slice.Map(indexes, func(i int) uuid.UUID { return keys[i].SectionID })
+
+		unlocked, err := course_section.GetUnlockedSections(ctx, userID, sectionIDs)
+		for _, i := range indexes {
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			items[i] = unlocked[keys[i].SectionID]
+		}
+	}
+
+	return items, errs
+}
+
+// IsSectionUnlocked returns whether a user has met every prerequisite of a course section using the dataloader.
+func IsSectionUnlocked(ctx context.Context, userID uuid.UUID, sectionID uuid.UUID) (bool, error) {
+	loaders := For(ctx)
+	return loaders.SectionUnlockLoader.Load(ctx, SectionUnlockKey{UserID: userID, SectionID: sectionID})
+}
@@ -14,7 +14,7 @@ func getTests(ctx context.Context, testIDs []uuid.UUID) ([]*model.Test, []error)
 	lu := NewLoaderByIds[ent.Test, model.Test](testIDs)
 
 	lu.LoadItemsOneToOne(ctx, test.GetTestsByIDs, func(id uuid.UUID, items []*ent.Test) *ent.Test {
-		return *slice.Find(items, func(item *ent.Test) bool {
+		return slice.FindValue(items, func(item *ent.Test) bool {
 			return item.ID == id
 		})
 	}, func(entTest *ent.Test) (*model.Test, error) {
@@ -3,6 +3,7 @@ package dataloader
 import (
 	"context"
 	"template/internal/ent"
+	"template/internal/ent/schema/mixin"
 	"template/internal/features/user"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
@@ -13,8 +14,10 @@ import (
 func getUsers(ctx context.Context, userIDs []uuid.UUID) ([]*model.User, []error) {
 	lu := NewLoaderByIds[ent.User, model.User](userIDs)
 
-	lu.LoadItemsOneToOne(ctx, user.GetUsersByIDs, func(id uuid.UUID, items []*ent.User) *ent.User {
-		return *slice.Find(items, func(item *ent.User) bool {
+	// The users are loaded as the creators or members of the resources of the tenant, and keep
+	// being resolved once they are assigned to another organization.
+	lu.LoadItemsOneToOne(mixin.SkipTenant(ctx), user.GetUsersByIDs, func(id uuid.UUID, items []*ent.User) *ent.User {
+		return slice.FindValue(items, func(item *ent.User) bool {
 			return item.ID == id
 		})
 	}, func(entUser *ent.User) (*model.User, error) {
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/permission"
+	"template/internal/features/role"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// authDirectives are the directives declaring who can resolve a root field.
+var authDirectives = []string{"public", "authenticated", "hasPermission"}
+
+// passwordChangeFields are the root fields a user who must change their password can still resolve.
+var passwordChangeFields = []string{"changePassword", "logout", "me"}
+
+type authenticatedUserKey struct{}
+
+// NewDirectiveRoot returns the implementation of the schema directives.
+func NewDirectiveRoot() DirectiveRoot {
+	return DirectiveRoot{
+		Public:        publicDirective,
+		Authenticated: authenticatedDirective,
+		HasPermission: hasPermissionDirective,
+	}
+}
+
+// publicDirective resolves the field without any check.
+func publicDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	return next(ctx)
+}
+
+// authenticatedDirective resolves the field only for a valid access token.
+func authenticatedDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	return next(withAuthenticatedUser(ctx, userId))
+}
+
+// hasPermissionDirective resolves the field only if the user has all the permissions.
+func hasPermissionDirective(ctx context.Context, obj interface{}, next graphql.Resolver, all []permission.Permission) (interface{}, error) {
+	userId, err := CheckUserPermissions(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	return next(withAuthenticatedUser(ctx, userId))
+}
+
+// authorizeUser only lets a user given a temporary password change it, and scopes the queries of the operation
+// to the organization of the user, so the data of the other organizations can't be read or changed.
+// The platform owners access every organization.
+func authorizeUser(ctx context.Context, userId uuid.UUID) error {
+	principal, err := role.GetPrincipal(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if principal.MustChangePassword && !slice.Contains(passwordChangeFields, graphql.GetFieldContext(ctx).Field.Name) {
+		return errors.New("you must change your password first")
+	}
+	if !principal.IsPlatformOwner() {
+		mixin.SetTenant(ctx, principal.OrganizationID)
+	}
+	return nil
+}
+
+// withAuthenticatedUser stores the user authenticated by a directive so the resolver doesn't validate the token again.
+func withAuthenticatedUser(ctx context.Context, userId uuid.UUID) context.Context {
+	return context.WithValue(ctx, authenticatedUserKey{}, userId)
+}
+
+// ValidateAuthDirectives returns an error listing the Query, Mutation and Subscription fields
+// without an authorization directive, so a field can't be exposed without declaring who can resolve it.
+func ValidateAuthDirectives(schema *ast.Schema) error {
+	missing := []string{}
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		if root == nil {
+			continue
+		}
+
+		for _, field := range root.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			hasAuthDirective := false
+			for _, name := range authDirectives {
+				if field.Directives.ForName(name) != nil {
+					hasAuthDirective = true
+					break
+				}
+			}
+			if !hasAuthDirective {
+				missing = append(missing, root.Name+"."+field.Name)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("fields without an authorization directive (@public, @authenticated or @hasPermission): %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"template/internal/features/common"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -101,6 +102,12 @@ func CustomErrorPresenter(ctx context.Context, e error) *gqlerror.Error {
 	// Start with the default error presenter
 	err := graphql.DefaultErrorPresenter(ctx, e)
 
+	// Bad request errors raised by features are presented as custom errors
+	var badRequestErr *common.BadRequestError
+	if errors.As(e, &badRequestErr) {
+		e = NewBadRequestError(badRequestErr.Message)
+	}
+
 	// Check if it's our custom error
 	var customErr *CustomError
 	if errors.As(e, &customErr) {
@@ -0,0 +1,258 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/group"
+	"template/internal/features/role"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// Creator is the resolver for the creator field.
+func (r *groupResolver) Creator(ctx context.Context, obj *model.Group) (*model.User, error) {
+	return dataloader.GetUser(ctx, obj.CreatorID)
+}
+
+// Members is the resolver for the members field.
+func (r *groupResolver) Members(ctx context.Context, obj *model.Group) ([]*model.User, error) {
+	return dataloader.GetMembersByGroupID(ctx, obj.ID)
+}
+
+// Group is the resolver for the group field.
+func (r *groupTestAssignmentResolver) Group(ctx context.Context, obj *model.GroupTestAssignment) (*model.Group, error) {
+	return dataloader.GetGroup(ctx, obj.GroupID)
+}
+
+// Test is the resolver for the test field.
+func (r *groupTestAssignmentResolver) Test(ctx context.Context, obj *model.GroupTestAssignment) (*model.Test, error) {
+	return dataloader.GetTest(ctx, obj.TestID)
+}
+
+// AssignedBy is the resolver for the assignedBy field.
+func (r *groupTestAssignmentResolver) AssignedBy(ctx context.Context, obj *model.GroupTestAssignment) (*model.User, error) {
+	if obj.AssignedByID == nil {
+		return nil, nil
+	}
+	return dataloader.GetUser(ctx, *obj.AssignedByID)
+}
+
+// CreateGroup is the resolver for the createGroup field.
+func (r *mutationResolver) CreateGroup(ctx context.Context, input model.CreateGroupInput) (*model.Group, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroup, err := group.CreateGroup(ctx, userId, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupToModel(entGroup), nil
+}
+
+// UpdateGroup is the resolver for the updateGroup field.
+func (r *mutationResolver) UpdateGroup(ctx context.Context, id uuid.UUID, input model.UpdateGroupInput) (*model.Group, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroup, err := group.UpdateGroup(ctx, userId, isAdminOrOwner, id, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupToModel(entGroup), nil
+}
+
+// DeleteGroup is the resolver for the deleteGroup field.
+func (r *mutationResolver) DeleteGroup(ctx context.Context, id uuid.UUID) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return group.DeleteGroup(ctx, userId, isAdminOrOwner, id)
+}
+
+// AddGroupMembers is the resolver for the addGroupMembers field.
+func (r *mutationResolver) AddGroupMembers(ctx context.Context, groupID uuid.UUID, userIds []uuid.UUID) (*model.Group, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroup, err := group.AddGroupMembers(ctx, userId, isAdminOrOwner, groupID, userIds)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupToModel(entGroup), nil
+}
+
+// RemoveGroupMembers is the resolver for the removeGroupMembers field.
+func (r *mutationResolver) RemoveGroupMembers(ctx context.Context, groupID uuid.UUID, userIds []uuid.UUID) (*model.Group, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroup, err := group.RemoveGroupMembers(ctx, userId, isAdminOrOwner, groupID, userIds)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupToModel(entGroup), nil
+}
+
+// AssignTestToGroup is the resolver for the assignTestToGroup field.
+func (r *mutationResolver) AssignTestToGroup(ctx context.Context, input model.AssignTestToGroupInput) (*model.GroupTestAssignment, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := group.AssignTestToGroup(ctx, userId, isAdminOrOwner, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupTestAssignmentToModel(assignment), nil
+}
+
+// UnassignTestFromGroup is the resolver for the unassignTestFromGroup field.
+func (r *mutationResolver) UnassignTestFromGroup(ctx context.Context, id uuid.UUID) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return group.UnassignTestFromGroup(ctx, userId, isAdminOrOwner, id)
+}
+
+// Group is the resolver for the group field.
+func (r *queryResolver) Group(ctx context.Context, id uuid.UUID) (*model.Group, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroup, err := group.GetGroupByID(ctx, userId, isAdminOrOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertGroupToModel(entGroup), nil
+}
+
+// PaginatedGroups is the resolver for the paginatedGroups field.
+func (r *queryResolver) PaginatedGroups(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedGroup, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedGroup, err := group.PaginatedGroups(ctx, userId, isAdminOrOwner, paginationInput)
+	if err != nil {
+		return nil, err
+	}
+	items := slice.Map(paginatedGroup.Items, model.ConvertGroupToModel)
+	pagination := &model.Pagination{
+		CurrentPage:     paginatedGroup.CurrentPage,
+		TotalPages:      paginatedGroup.TotalPages,
+		TotalItems:      paginatedGroup.TotalItems,
+		HasNextPage:     paginatedGroup.HasNextPage,
+		HasPreviousPage: paginatedGroup.HasPrevPage,
+	}
+	return &model.PaginatedGroup{
+		Pagination: pagination,
+		Items:      items,
+	}, nil
+}
+
+// GroupTestAssignments is the resolver for the groupTestAssignments field.
+func (r *queryResolver) GroupTestAssignments(ctx context.Context, groupID uuid.UUID) ([]*model.GroupTestAssignment, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := group.GetGroupTestAssignments(ctx, userId, isAdminOrOwner, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(assignments, model.ConvertGroupTestAssignmentToModel), nil
+}
+
+// GroupTestResultSummary is the resolver for the groupTestResultSummary field.
+func (r *queryResolver) GroupTestResultSummary(ctx context.Context, assignmentID uuid.UUID) (*model.GroupTestResultSummary, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.GetGroupTestResultSummary(ctx, userId, isAdminOrOwner, assignmentID)
+}
+
+// Group returns GroupResolver implementation.
+func (r *Resolver) Group() GroupResolver { return &groupResolver{r} }
+
+// GroupTestAssignment returns GroupTestAssignmentResolver implementation.
+func (r *Resolver) GroupTestAssignment() GroupTestAssignmentResolver {
+	return &groupTestAssignmentResolver{r}
+}
+
+type groupResolver struct{ *Resolver }
+type groupTestAssignmentResolver struct{ *Resolver }
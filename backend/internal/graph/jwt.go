@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"sync"
 	"template/internal/features/jwt"
 	"template/internal/features/jwt_token"
 	"template/internal/features/permission"
@@ -38,7 +39,39 @@ func GetUserIdFromJwtToken(token string) (string, error) {
 	return claims.UserID, nil
 }
 
+type requestAuthKey struct{}
+
+// requestAuth holds the user authenticated by the token of an operation, the token is validated at most once.
+type requestAuth struct {
+	once   sync.Once
+	userId uuid.UUID
+	err    error
+}
+
+// withRequestAuth returns a context where the token is validated once for all the fields of the operation.
+func withRequestAuth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestAuthKey{}, &requestAuth{})
+}
+
 func GetUserIdFromRequestContext(ctx context.Context) (uuid.UUID, error) {
+	// The user was already authenticated by an authorization directive of the field
+	if userId, ok := ctx.Value(authenticatedUserKey{}).(uuid.UUID); ok {
+		return userId, nil
+	}
+
+	auth, ok := ctx.Value(requestAuthKey{}).(*requestAuth)
+	if !ok {
+		return authenticateRequest(ctx)
+	}
+
+	auth.once.Do(func() {
+		auth.userId, auth.err = authenticateRequest(ctx)
+	})
+	return auth.userId, auth.err
+}
+
+// authenticateRequest validates the token of the request and returns the ID of its user.
+func authenticateRequest(ctx context.Context) (uuid.UUID, error) {
 	token, err := ExtractJwtTokenFromRequestContext(ctx)
 	if err != nil {
 		return uuid.Nil, err
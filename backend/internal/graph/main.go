@@ -3,14 +3,67 @@ package graph
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"template/internal/ent/schema/mixin"
+	"template/internal/features/role"
 	"template/internal/graph/dataloader"
+	"template/internal/shared/environment"
+	"template/internal/shared/utilities/slice"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func GraphQLHandler() gin.HandlerFunc {
-	h := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{}}))
+	executableSchema := NewExecutableSchema(Config{Resolvers: &Resolver{}, Directives: NewDirectiveRoot()})
+	if err := ValidateAuthDirectives(executableSchema.Schema()); err != nil {
+		log.Fatal(err)
+	}
+
+	h := handler.New(executableSchema)
+
+	// Subscriptions are served over websocket, the client authenticates in the connection init payload
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: checkWebsocketOrigin,
+		},
+		InitFunc: WebsocketInitFunc,
+	})
+	h.AddTransport(transport.Options{})
+	h.AddTransport(transport.GET{})
+	h.AddTransport(transport.POST{})
+	h.AddTransport(transport.MultipartForm{})
+
+	h.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	// Validate the token and load the roles and permissions once per operation, whatever the number of fields.
+	// The queries of the operation are scoped to the organization of the user once authenticated.
+	role.SetPrincipalCacheTTL(environment.PERMISSION_CACHE_TTL)
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(mixin.WithTenantScope(withRequestAuth(role.WithRequestPrincipals(ctx))))
+	})
+
+	// Build the dataloaders for every response, a subscription resolves each of its events with fresh loaders
+	// instead of caching the data for the whole websocket connection
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		return next(dataloader.AddToContext(ctx))
+	})
+
+	h.Use(extension.Introspection{})
+	h.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
 
 	// Set custom error presenter
 	h.SetErrorPresenter(CustomErrorPresenter)
@@ -21,9 +74,26 @@ func GraphQLHandler() gin.HandlerFunc {
 
 	return func(c *gin.Context) {
 		ctx := context.WithValue(c.Request.Context(), RequestKey{}, c.Request)
-		ctx = dataloader.AddToContext(ctx)
 		c.Request = c.Request.WithContext(ctx)
 
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
+
+// checkWebsocketOrigin accepts the websocket upgrades from the allowed origins, or from the same origin when none
+// is configured. Requests without an Origin header don't come from a browser and are accepted.
+func checkWebsocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if len(environment.ALLOWED_ORIGINS) > 0 {
+		return slice.Contains(environment.ALLOWED_ORIGINS, origin)
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(originURL.Host, r.Host)
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"template/internal/ent"
+	"template/internal/ent/accessgrant"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessGrant shares a test, a course or a question collection with a user or a group
+type AccessGrant struct {
+	ID           uuid.UUID          `json:"id"`
+	ResourceType AccessResourceType `json:"resourceType"`
+	ResourceID   uuid.UUID          `json:"resourceId"`
+	UserID       *uuid.UUID         `json:"userId"`
+	GroupID      *uuid.UUID         `json:"groupId"`
+	Role         AccessRole         `json:"role"`
+	GrantedByID  *uuid.UUID         `json:"grantedById"`
+	CreatedAt    time.Time          `json:"createdAt"`
+}
+
+// ConvertAccessGrantToModel converts an ent.AccessGrant to a GraphQL model AccessGrant.
+func ConvertAccessGrantToModel(grant *ent.AccessGrant) *AccessGrant {
+	result := &AccessGrant{
+		ID:          grant.ID,
+		UserID:      grant.UserID,
+		GroupID:     grant.GroupID,
+		Role:        ConvertEntAccessRoleToModel(grant.Role),
+		GrantedByID: grant.GrantedByID,
+		CreatedAt:   grant.CreatedAt,
+	}
+
+	switch {
+	case grant.TestID != nil:
+		result.ResourceType = AccessResourceTypeTest
+		result.ResourceID = *grant.TestID
+	case grant.CourseID != nil:
+		result.ResourceType = AccessResourceTypeCourse
+		result.ResourceID = *grant.CourseID
+	case grant.QuestionCollectionID != nil:
+		result.ResourceType = AccessResourceTypeQuestionCollection
+		result.ResourceID = *grant.QuestionCollectionID
+	}
+
+	return result
+}
+
+// ConvertEntAccessRoleToModel converts the ent access grant role to a GraphQL AccessRole
+func ConvertEntAccessRoleToModel(role accessgrant.Role) AccessRole {
+	if role == accessgrant.RoleEditor {
+		return AccessRoleEditor
+	}
+	return AccessRoleViewer
+}
+
+// ConvertAccessRoleToEnt converts a GraphQL AccessRole to the ent access grant role
+func ConvertAccessRoleToEnt(role AccessRole) accessgrant.Role {
+	if role == AccessRoleEditor {
+		return accessgrant.RoleEditor
+	}
+	return accessgrant.RoleViewer
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"template/internal/ent"
+)
+
+// ConvertUserAccommodationToModel converts an ent.UserAccommodation to a GraphQL model UserAccommodation.
+func ConvertUserAccommodationToModel(a *ent.UserAccommodation) *UserAccommodation {
+	return &UserAccommodation{
+		ID:                  a.ID,
+		UserID:              a.UserID,
+		TimeMultiplier:      a.TimeMultiplier,
+		ExtraMinutes:        a.ExtraMinutes,
+		AllowRestBreaks:     a.AllowRestBreaks,
+		MaxRestBreaks:       a.MaxRestBreaks,
+		MaxRestBreakMinutes: a.MaxRestBreakMinutes,
+		Notes:               a.Notes,
+		CreatedAt:           a.CreatedAt,
+		UpdatedAt:           a.UpdatedAt,
+	}
+}
+
+// ConvertTestSessionTimeExtensionToModel converts an ent.TestSessionTimeExtension to a GraphQL model TestSessionTimeExtension.
+func ConvertTestSessionTimeExtensionToModel(e *ent.TestSessionTimeExtension) *TestSessionTimeExtension {
+	return &TestSessionTimeExtension{
+		ID:                e.ID,
+		SessionID:         e.SessionID,
+		ExtendedByID:      e.ExtendedByID,
+		Minutes:           e.Minutes,
+		Reason:            e.Reason,
+		PreviousExpiredAt: e.PreviousExpiredAt,
+		NewExpiredAt:      e.NewExpiredAt,
+		CreatedAt:         e.CreatedAt,
+	}
+}
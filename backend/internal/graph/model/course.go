@@ -2,18 +2,24 @@ package model
 
 import (
 	"template/internal/ent"
+	"template/internal/ent/course"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Course struct {
-	ID          uuid.UUID `json:"id"`
-	Title       string    `json:"title"`
-	Description *string   `json:"description"`
-	CreatorID   uuid.UUID `json:"creatorId"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID          uuid.UUID    `json:"id"`
+	Title       string       `json:"title"`
+	Description *string      `json:"description"`
+	MediaID     *uuid.UUID   `json:"mediaId"`
+	Status      CourseStatus `json:"status"`
+	IsPublished bool         `json:"isPublished"`
+	PublishedAt *time.Time   `json:"publishedAt"`
+	ArchivedAt  *time.Time   `json:"archivedAt"`
+	CreatorID   uuid.UUID    `json:"creatorId"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
 }
 
 func ConvertCourseToModel(c *ent.Course) *Course {
@@ -21,8 +27,27 @@ func ConvertCourseToModel(c *ent.Course) *Course {
 		ID:          c.ID,
 		Title:       c.Title,
 		Description: c.Description,
+		MediaID:     c.MediaID,
+		Status:      convertEntCourseStatusToGraphQLStatus(c.Status),
+		IsPublished: c.IsPublished,
+		PublishedAt: c.PublishedAt,
+		ArchivedAt:  c.ArchivedAt,
 		CreatorID:   c.CreatorID,
 		CreatedAt:   c.CreatedAt,
 		UpdatedAt:   c.UpdatedAt,
 	}
 }
+
+// convertEntCourseStatusToGraphQLStatus converts ent Course status to GraphQL CourseStatus
+func convertEntCourseStatusToGraphQLStatus(entStatus course.Status) CourseStatus {
+	switch entStatus {
+	case course.StatusReview:
+		return CourseStatusReview
+	case course.StatusPublished:
+		return CourseStatusPublished
+	case course.StatusArchived:
+		return CourseStatusArchived
+	default:
+		return CourseStatusDraft
+	}
+}
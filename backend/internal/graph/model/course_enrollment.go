@@ -0,0 +1,59 @@
+package model
+
+import (
+	"template/internal/ent"
+	"template/internal/ent/courseenrollment"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CourseEnrollment is used for internal conversion between ent.CourseEnrollment and GraphQL CourseEnrollment
+type CourseEnrollment struct {
+	ID         uuid.UUID              `json:"id"`
+	CourseID   uuid.UUID              `json:"courseId"`
+	UserID     uuid.UUID              `json:"userId"`
+	Role       CourseEnrollmentRole   `json:"role"`
+	Status     CourseEnrollmentStatus `json:"status"`
+	EnrolledAt time.Time              `json:"enrolledAt"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	UpdatedAt  time.Time              `json:"updatedAt"`
+}
+
+// ConvertCourseEnrollmentToModel converts an ent.CourseEnrollment to a GraphQL model CourseEnrollment.
+func ConvertCourseEnrollmentToModel(ce *ent.CourseEnrollment) *CourseEnrollment {
+	return &CourseEnrollment{
+		ID:         ce.ID,
+		CourseID:   ce.CourseID,
+		UserID:     ce.UserID,
+		Role:       convertEntEnrollmentRoleToGraphQLRole(ce.Role),
+		Status:     convertEntEnrollmentStatusToGraphQLStatus(ce.Status),
+		EnrolledAt: ce.EnrolledAt,
+		CreatedAt:  ce.CreatedAt,
+		UpdatedAt:  ce.UpdatedAt,
+	}
+}
+
+// convertEntEnrollmentRoleToGraphQLRole converts ent CourseEnrollment role to GraphQL CourseEnrollmentRole
+func convertEntEnrollmentRoleToGraphQLRole(entRole courseenrollment.Role) CourseEnrollmentRole {
+	switch entRole {
+	case courseenrollment.RoleInstructor:
+		return CourseEnrollmentRoleInstructor
+	case courseenrollment.RoleTeachingAssistant:
+		return CourseEnrollmentRoleTeachingAssistant
+	default:
+		return CourseEnrollmentRoleLearner
+	}
+}
+
+// convertEntEnrollmentStatusToGraphQLStatus converts ent CourseEnrollment status to GraphQL CourseEnrollmentStatus
+func convertEntEnrollmentStatusToGraphQLStatus(entStatus courseenrollment.Status) CourseEnrollmentStatus {
+	switch entStatus {
+	case courseenrollment.StatusSuspended:
+		return CourseEnrollmentStatusSuspended
+	case courseenrollment.StatusCompleted:
+		return CourseEnrollmentStatusCompleted
+	default:
+		return CourseEnrollmentStatusActive
+	}
+}
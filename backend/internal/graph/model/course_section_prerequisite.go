@@ -0,0 +1,23 @@
+package model
+
+import (
+	"template/internal/ent"
+
+	"github.com/google/uuid"
+)
+
+type CourseSectionPrerequisite struct {
+	ID                    uuid.UUID `json:"id"`
+	SectionID             uuid.UUID `json:"sectionId"`
+	PrerequisiteSectionID uuid.UUID `json:"prerequisiteSectionId"`
+	MinScorePercent       *int      `json:"minScorePercent"`
+}
+
+func ConvertCourseSectionPrerequisiteToModel(p *ent.CourseSectionPrerequisite) *CourseSectionPrerequisite {
+	return &CourseSectionPrerequisite{
+		ID:                    p.ID,
+		SectionID:             p.SectionID,
+		PrerequisiteSectionID: p.PrerequisiteSectionID,
+		MinScorePercent:       p.MinScorePercent,
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"template/internal/ent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Group gathers users, e.g. a class or a department
+type Group struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	CreatorID   uuid.UUID `json:"creatorId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ConvertGroupToModel converts an ent.Group to a GraphQL model Group.
+func ConvertGroupToModel(group *ent.Group) *Group {
+	return &Group{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatorID:   group.CreatorID,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
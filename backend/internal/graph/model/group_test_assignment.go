@@ -0,0 +1,36 @@
+package model
+
+import (
+	"template/internal/ent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupTestAssignment assigns a test to the members of a group
+type GroupTestAssignment struct {
+	ID                  uuid.UUID  `json:"id"`
+	GroupID             uuid.UUID  `json:"groupId"`
+	TestID              uuid.UUID  `json:"testId"`
+	AssignedByID        *uuid.UUID `json:"assignedById"`
+	IncludeLaterMembers bool       `json:"includeLaterMembers"`
+	ExpiredAt           *time.Time `json:"expiredAt"`
+	OpenAt              *time.Time `json:"openAt"`
+	CloseAt             *time.Time `json:"closeAt"`
+	CreatedAt           time.Time  `json:"createdAt"`
+}
+
+// ConvertGroupTestAssignmentToModel converts an ent.GroupTestAssignment to a GraphQL model GroupTestAssignment.
+func ConvertGroupTestAssignmentToModel(assignment *ent.GroupTestAssignment) *GroupTestAssignment {
+	return &GroupTestAssignment{
+		ID:                  assignment.ID,
+		GroupID:             assignment.GroupID,
+		TestID:              assignment.TestID,
+		AssignedByID:        assignment.AssignedByID,
+		IncludeLaterMembers: assignment.IncludeLaterMembers,
+		ExpiredAt:           assignment.ExpiredAt,
+		OpenAt:              assignment.OpenAt,
+		CloseAt:             assignment.CloseAt,
+		CreatedAt:           assignment.CreatedAt,
+	}
+}
@@ -6,11 +6,26 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"template/internal/features/permission"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type AcceptInviteInput struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type AccommodationInput struct {
+	TimeMultiplier      *float64 `json:"timeMultiplier,omitempty"`
+	ExtraMinutes        *int     `json:"extraMinutes,omitempty"`
+	AllowRestBreaks     *bool    `json:"allowRestBreaks,omitempty"`
+	MaxRestBreaks       *int     `json:"maxRestBreaks,omitempty"`
+	MaxRestBreakMinutes *int     `json:"maxRestBreakMinutes,omitempty"`
+	Notes               *string  `json:"notes,omitempty"`
+}
+
 type AddMultiCollectionToTestInput struct {
 	TestID        uuid.UUID   `json:"testId"`
 	CollectionIds []uuid.UUID `json:"collectionIds"`
@@ -23,10 +38,26 @@ type AdminCreateUserInput struct {
 }
 
 type AdminEditUserInput struct {
-	Email    *string    `json:"email,omitempty"`
-	Password *string    `json:"password,omitempty"`
-	IsActive *bool      `json:"isActive,omitempty"`
-	RoleID   *uuid.UUID `json:"roleId,omitempty"`
+	Email    *string     `json:"email,omitempty"`
+	Password *string     `json:"password,omitempty"`
+	IsActive *bool       `json:"isActive,omitempty"`
+	RoleID   *uuid.UUID  `json:"roleId,omitempty"`
+	RoleIds  []uuid.UUID `json:"roleIds,omitempty"`
+}
+
+type AssignOrganizationUsersInput struct {
+	OrganizationID uuid.UUID   `json:"organizationId"`
+	UserIds        []uuid.UUID `json:"userIds"`
+	AsAdmin        *bool       `json:"asAdmin,omitempty"`
+}
+
+type AssignTestToGroupInput struct {
+	GroupID             uuid.UUID  `json:"groupId"`
+	TestID              uuid.UUID  `json:"testId"`
+	IncludeLaterMembers *bool      `json:"includeLaterMembers,omitempty"`
+	ExpiredTime         *time.Time `json:"expiredTime,omitempty"`
+	OpenAt              *time.Time `json:"openAt,omitempty"`
+	CloseAt             *time.Time `json:"closeAt,omitempty"`
 }
 
 type Auth struct {
@@ -49,13 +80,59 @@ type BatchUpdateQuestionPointsInput struct {
 	QuestionPoints []*QuestionPointsInput `json:"questionPoints"`
 }
 
+type BulkEnrollCourseInput struct {
+	CourseID uuid.UUID             `json:"courseId"`
+	Emails   []string              `json:"emails"`
+	Role     *CourseEnrollmentRole `json:"role,omitempty"`
+}
+
+type BulkEnrollCourseResult struct {
+	Enrollments   []*CourseEnrollment `json:"enrollments"`
+	UnknownEmails []string            `json:"unknownEmails"`
+}
+
+type CandidateQuestion struct {
+	ID               uuid.UUID                  `json:"id"`
+	QuestionText     string                     `json:"questionText"`
+	Points           int                        `json:"points"`
+	Order            int                        `json:"order"`
+	IsMultipleChoice bool                       `json:"isMultipleChoice"`
+	Options          []*CandidateQuestionOption `json:"options"`
+}
+
+type CandidateQuestionOption struct {
+	ID         uuid.UUID `json:"id"`
+	OptionText string    `json:"optionText"`
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+type CourseEnrollmentFilterInput struct {
+	Roles    []CourseEnrollmentRole   `json:"roles,omitempty"`
+	Statuses []CourseEnrollmentStatus `json:"statuses,omitempty"`
+}
+
+type CourseOutlineSection struct {
+	ID          uuid.UUID               `json:"id"`
+	Title       string                  `json:"title"`
+	Description *string                 `json:"description,omitempty"`
+	CourseID    uuid.UUID               `json:"courseId"`
+	SectionID   *uuid.UUID              `json:"sectionId,omitempty"`
+	Order       int                     `json:"order"`
+	Children    []*CourseOutlineSection `json:"children"`
+}
+
 type CourseSectionFilterInput struct {
 	OnlyRoot *bool `json:"onlyRoot,omitempty"`
 }
 
 type CreateCourseInput struct {
-	Title       string  `json:"title"`
-	Description *string `json:"description,omitempty"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	MediaID     *uuid.UUID `json:"mediaId,omitempty"`
 }
 
 type CreateCourseSectionInput struct {
@@ -65,6 +142,18 @@ type CreateCourseSectionInput struct {
 	SectionID   *uuid.UUID `json:"sectionId,omitempty"`
 }
 
+type CreateGroupInput struct {
+	Name        string      `json:"name"`
+	Description *string     `json:"description,omitempty"`
+	MemberIds   []uuid.UUID `json:"memberIds,omitempty"`
+}
+
+type CreateOrganizationInput struct {
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Description *string `json:"description,omitempty"`
+}
+
 type CreateQuestionCollectionInput struct {
 	Title       string  `json:"title"`
 	Description *string `json:"description,omitempty"`
@@ -75,25 +164,67 @@ type CreateQuestionInput struct {
 	QuestionCollectionID uuid.UUID              `json:"questionCollectionId"`
 	Options              []*QuestionOptionInput `json:"options"`
 	Points               int                    `json:"points"`
+	Explanation          *string                `json:"explanation,omitempty"`
 }
 
 type CreateQuestionOptionInput struct {
-	QuestionID uuid.UUID `json:"questionId"`
-	OptionText string    `json:"optionText"`
-	IsCorrect  bool      `json:"isCorrect"`
+	QuestionID  uuid.UUID `json:"questionId"`
+	OptionText  string    `json:"optionText"`
+	IsCorrect   bool      `json:"isCorrect"`
+	Explanation *string   `json:"explanation,omitempty"`
+}
+
+type CreateRoleInput struct {
+	Name        string                  `json:"name"`
+	Description *string                 `json:"description,omitempty"`
+	Permissions []permission.Permission `json:"permissions,omitempty"`
 }
 
 type CreateTestInput struct {
-	Name            string     `json:"name"`
-	CourseSectionID *uuid.UUID `json:"courseSectionId,omitempty"`
-	CourseID        *uuid.UUID `json:"courseId,omitempty"`
-	TotalTime       int        `json:"totalTime"`
+	Name                   string               `json:"name"`
+	CourseSectionID        *uuid.UUID           `json:"courseSectionId,omitempty"`
+	CourseID               *uuid.UUID           `json:"courseId,omitempty"`
+	TotalTime              int                  `json:"totalTime"`
+	OpenAt                 *time.Time           `json:"openAt,omitempty"`
+	CloseAt                *time.Time           `json:"closeAt,omitempty"`
+	MaxAttempts            *int                 `json:"maxAttempts,omitempty"`
+	AttemptCooldownMinutes *int                 `json:"attemptCooldownMinutes,omitempty"`
+	ScoringPolicy          *TestScoringPolicy   `json:"scoringPolicy,omitempty"`
+	FeedbackRelease        *TestFeedbackRelease `json:"feedbackRelease,omitempty"`
 }
 
 type CreateTestSessionInput struct {
 	TestID      uuid.UUID   `json:"testId"`
 	UserIds     []uuid.UUID `json:"userIds,omitempty"`
 	ExpiredTime *time.Time  `json:"expiredTime,omitempty"`
+	OpenAt      *time.Time  `json:"openAt,omitempty"`
+	CloseAt     *time.Time  `json:"closeAt,omitempty"`
+}
+
+type EnrollCourseInput struct {
+	CourseID uuid.UUID               `json:"courseId"`
+	UserID   uuid.UUID               `json:"userId"`
+	Role     *CourseEnrollmentRole   `json:"role,omitempty"`
+	Status   *CourseEnrollmentStatus `json:"status,omitempty"`
+}
+
+type GroupTestResultSummary struct {
+	Assignment          *GroupTestAssignment `json:"assignment"`
+	SessionCount        int                  `json:"sessionCount"`
+	PendingCount        int                  `json:"pendingCount"`
+	InProgressCount     int                  `json:"inProgressCount"`
+	CompletedCount      int                  `json:"completedCount"`
+	MissedCount         int                  `json:"missedCount"`
+	AverageScorePercent *float64             `json:"averageScorePercent,omitempty"`
+	HighestScorePercent *float64             `json:"highestScorePercent,omitempty"`
+	LowestScorePercent  *float64             `json:"lowestScorePercent,omitempty"`
+	Sessions            []*TestSession       `json:"sessions"`
+}
+
+type ImportUsersInput struct {
+	CSV         string                 `json:"csv"`
+	DryRun      *bool                  `json:"dryRun,omitempty"`
+	Credentials *UserImportCredentials `json:"credentials,omitempty"`
 }
 
 type LoginInput struct {
@@ -113,6 +244,21 @@ type PaginatedCourse struct {
 	Items      []*Course   `json:"items"`
 }
 
+type PaginatedCourseEnrollment struct {
+	Pagination *Pagination         `json:"pagination"`
+	Items      []*CourseEnrollment `json:"items"`
+}
+
+type PaginatedGroup struct {
+	Pagination *Pagination `json:"pagination"`
+	Items      []*Group    `json:"items"`
+}
+
+type PaginatedOrganization struct {
+	Pagination *Pagination     `json:"pagination"`
+	Items      []*Organization `json:"items"`
+}
+
 type PaginatedQuestion struct {
 	Pagination *Pagination `json:"pagination"`
 	Items      []*Question `json:"items"`
@@ -138,6 +284,11 @@ type PaginatedTestSession struct {
 	Items      []*TestSession `json:"items"`
 }
 
+type PaginatedTrashItem struct {
+	Pagination *Pagination  `json:"pagination"`
+	Items      []*TrashItem `json:"items"`
+}
+
 type PaginatedUser struct {
 	Pagination *Pagination `json:"pagination"`
 	Items      []*User     `json:"items"`
@@ -166,8 +317,10 @@ type QuestionIgnoreData struct {
 }
 
 type QuestionOptionInput struct {
-	OptionText string `json:"optionText"`
-	IsCorrect  bool   `json:"isCorrect"`
+	ID          *uuid.UUID `json:"id,omitempty"`
+	OptionText  string     `json:"optionText"`
+	IsCorrect   bool       `json:"isCorrect"`
+	Explanation *string    `json:"explanation,omitempty"`
 }
 
 type QuestionOrder struct {
@@ -186,23 +339,81 @@ type QuestionPointsInput struct {
 }
 
 type QuestionResult struct {
-	Question        *Question         `json:"question"`
-	IsCorrect       bool              `json:"isCorrect"`
-	SelectedOptions []*SelectedOption `json:"selectedOptions,omitempty"`
+	Question        *Question               `json:"question"`
+	QuestionText    string                  `json:"questionText"`
+	Points          *int                    `json:"points,omitempty"`
+	IsCorrect       *bool                   `json:"isCorrect,omitempty"`
+	Explanation     *string                 `json:"explanation,omitempty"`
+	SelectedOptions []*SelectedOption       `json:"selectedOptions,omitempty"`
+	Options         []*QuestionResultOption `json:"options"`
+}
+
+type QuestionResultOption struct {
+	ID          uuid.UUID `json:"id"`
+	OptionText  string    `json:"optionText"`
+	IsCorrect   *bool     `json:"isCorrect,omitempty"`
+	Explanation *string   `json:"explanation,omitempty"`
+	IsSelected  bool      `json:"isSelected"`
+}
+
+type QuestionVersionChange struct {
+	Type   QuestionVersionChangeType `json:"type"`
+	Before *string                   `json:"before,omitempty"`
+	After  *string                   `json:"after,omitempty"`
+}
+
+type QuestionVersionOption struct {
+	ID          uuid.UUID `json:"id"`
+	OptionID    uuid.UUID `json:"optionId"`
+	OptionText  string    `json:"optionText"`
+	IsCorrect   bool      `json:"isCorrect"`
+	Explanation *string   `json:"explanation,omitempty"`
+	Order       int       `json:"order"`
 }
 
 type RegisterInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+	Username *string `json:"username,omitempty"`
+}
+
+type RegradeResult struct {
+	DryRun         bool                   `json:"dryRun"`
+	ScannedAnswers int                    `json:"scannedAnswers"`
+	ChangedAnswers int                    `json:"changedAnswers"`
+	Sessions       []*RegradeSessionDelta `json:"sessions"`
+}
+
+type ReportIntegrityEventInput struct {
+	EventType  IntegrityEventType `json:"eventType"`
+	Details    *string            `json:"details,omitempty"`
+	OccurredAt *time.Time         `json:"occurredAt,omitempty"`
 }
 
 type Role struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	ID          uuid.UUID               `json:"id"`
+	Name        string                  `json:"name"`
+	Description *string                 `json:"description,omitempty"`
+	IsSystem    bool                    `json:"isSystem"`
+	Permissions []permission.Permission `json:"permissions"`
+}
+
+type SectionPrerequisiteInput struct {
+	PrerequisiteSectionID uuid.UUID `json:"prerequisiteSectionId"`
+	MinScorePercent       *int      `json:"minScorePercent,omitempty"`
 }
 
 type SelectedOption struct {
-	OptionText string `json:"optionText"`
+	ID         *uuid.UUID `json:"id,omitempty"`
+	OptionText string     `json:"optionText"`
+}
+
+type ShareResourceInput struct {
+	ResourceType AccessResourceType `json:"resourceType"`
+	ResourceID   uuid.UUID          `json:"resourceId"`
+	UserID       *uuid.UUID         `json:"userId,omitempty"`
+	GroupID      *uuid.UUID         `json:"groupId,omitempty"`
+	Role         AccessRole         `json:"role"`
 }
 
 type StartTestSessionInput struct {
@@ -214,6 +425,9 @@ type SubmitTestSessionInput struct {
 	Answers []*TestSessionAnswerInput `json:"answers"`
 }
 
+type Subscription struct {
+}
+
 type TestIgnoreQuestion struct {
 	ID         uuid.UUID `json:"id"`
 	TestID     uuid.UUID `json:"testId"`
@@ -236,12 +450,54 @@ type TestSessionAnswerInput struct {
 
 type TestSessionFilterInput struct {
 	Statuses []TestSessionStatus `json:"statuses,omitempty"`
+	TestID   *uuid.UUID          `json:"testId,omitempty"`
+}
+
+type TestSessionIntegrityEvent struct {
+	ID         uuid.UUID          `json:"id"`
+	SessionID  uuid.UUID          `json:"sessionId"`
+	EventType  IntegrityEventType `json:"eventType"`
+	Details    *string            `json:"details,omitempty"`
+	OccurredAt time.Time          `json:"occurredAt"`
+}
+
+type TestSessionRegrade struct {
+	ID           uuid.UUID    `json:"id"`
+	SessionID    uuid.UUID    `json:"sessionId"`
+	RegradedByID uuid.UUID    `json:"regradedById"`
+	Scope        RegradeScope `json:"scope"`
+	QuestionID   *uuid.UUID   `json:"questionId,omitempty"`
+	PointsBefore int          `json:"pointsBefore"`
+	PointsAfter  int          `json:"pointsAfter"`
+	CreatedAt    time.Time    `json:"createdAt"`
 }
 
 type TestSessionResult struct {
-	ID          uuid.UUID         `json:"id"`
-	TestSession *TestSession      `json:"testSession"`
-	Questions   []*QuestionResult `json:"questions"`
+	ID                uuid.UUID         `json:"id"`
+	TestSession       *TestSession      `json:"testSession"`
+	FeedbackReleased  bool              `json:"feedbackReleased"`
+	FeedbackReleaseAt *time.Time        `json:"feedbackReleaseAt,omitempty"`
+	Questions         []*QuestionResult `json:"questions"`
+}
+
+type TestSessionTimeExtension struct {
+	ID                uuid.UUID `json:"id"`
+	SessionID         uuid.UUID `json:"sessionId"`
+	ExtendedByID      uuid.UUID `json:"extendedById"`
+	Minutes           int       `json:"minutes"`
+	Reason            string    `json:"reason"`
+	PreviousExpiredAt time.Time `json:"previousExpiredAt"`
+	NewExpiredAt      time.Time `json:"newExpiredAt"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type TestSessionTimer struct {
+	SessionID        uuid.UUID         `json:"sessionId"`
+	Status           TestSessionStatus `json:"status"`
+	ExpiredAt        *time.Time        `json:"expiredAt,omitempty"`
+	RemainingSeconds *int              `json:"remainingSeconds,omitempty"`
+	PausedAt         *time.Time        `json:"pausedAt,omitempty"`
+	ServerTime       time.Time         `json:"serverTime"`
 }
 
 type Todo struct {
@@ -249,14 +505,22 @@ type Todo struct {
 	Text string    `json:"text"`
 }
 
+type TrashItem struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      TrashEntityType `json:"type"`
+	Name      string          `json:"name"`
+	DeletedAt time.Time       `json:"deletedAt"`
+}
+
 type UpdateBatchQuestionsByCollectionInput struct {
 	CollectionID uuid.UUID             `json:"collectionId"`
 	Questions    []*UpdateQuestionData `json:"questions"`
 }
 
 type UpdateCourseInput struct {
-	Title       *string `json:"title,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	MediaID     *uuid.UUID `json:"mediaId,omitempty"`
 }
 
 type UpdateCourseSectionInput struct {
@@ -265,6 +529,25 @@ type UpdateCourseSectionInput struct {
 	SectionID   *uuid.UUID `json:"sectionId,omitempty"`
 }
 
+type UpdateGroupInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type UpdateMyProfileInput struct {
+	FirstName    *string    `json:"firstName,omitempty"`
+	LastName     *string    `json:"lastName,omitempty"`
+	Username     *string    `json:"username,omitempty"`
+	AvatarID     *uuid.UUID `json:"avatarId,omitempty"`
+	RemoveAvatar *bool      `json:"removeAvatar,omitempty"`
+}
+
+type UpdateOrganizationInput struct {
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
 type UpdateQuestionCollectionInput struct {
 	Title       *string `json:"title,omitempty"`
 	Description *string `json:"description,omitempty"`
@@ -275,6 +558,7 @@ type UpdateQuestionData struct {
 	QuestionText *string                      `json:"questionText,omitempty"`
 	Options      []*UpdateQuestionOptionInput `json:"options"`
 	Points       int                          `json:"points"`
+	Explanation  *string                      `json:"explanation,omitempty"`
 }
 
 type UpdateQuestionInput struct {
@@ -282,11 +566,13 @@ type UpdateQuestionInput struct {
 	QuestionCollectionID *uuid.UUID             `json:"questionCollectionId,omitempty"`
 	Options              []*QuestionOptionInput `json:"options,omitempty"`
 	Points               int                    `json:"points"`
+	Explanation          *string                `json:"explanation,omitempty"`
 }
 
 type UpdateQuestionOptionInput struct {
-	OptionText *string `json:"optionText,omitempty"`
-	IsCorrect  *bool   `json:"isCorrect,omitempty"`
+	OptionText  *string `json:"optionText,omitempty"`
+	IsCorrect   *bool   `json:"isCorrect,omitempty"`
+	Explanation *string `json:"explanation,omitempty"`
 }
 
 type UpdateQuestionPointsByCollectionInput struct {
@@ -295,9 +581,24 @@ type UpdateQuestionPointsByCollectionInput struct {
 	Points       int       `json:"points"`
 }
 
+type UpdateRoleInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
 type UpdateTestInput struct {
-	Name      *string `json:"name,omitempty"`
-	TotalTime *int    `json:"totalTime,omitempty"`
+	Name                        *string              `json:"name,omitempty"`
+	TotalTime                   *int                 `json:"totalTime,omitempty"`
+	OpenAt                      *time.Time           `json:"openAt,omitempty"`
+	ClearOpenAt                 *bool                `json:"clearOpenAt,omitempty"`
+	CloseAt                     *time.Time           `json:"closeAt,omitempty"`
+	ClearCloseAt                *bool                `json:"clearCloseAt,omitempty"`
+	MaxAttempts                 *int                 `json:"maxAttempts,omitempty"`
+	ClearMaxAttempts            *bool                `json:"clearMaxAttempts,omitempty"`
+	AttemptCooldownMinutes      *int                 `json:"attemptCooldownMinutes,omitempty"`
+	ClearAttemptCooldownMinutes *bool                `json:"clearAttemptCooldownMinutes,omitempty"`
+	ScoringPolicy               *TestScoringPolicy   `json:"scoringPolicy,omitempty"`
+	FeedbackRelease             *TestFeedbackRelease `json:"feedbackRelease,omitempty"`
 }
 
 type UpdateTestQuestionRequirementInput struct {
@@ -310,6 +611,532 @@ type UpdateTestSessionInput struct {
 	TotalScore  *int       `json:"totalScore,omitempty"`
 }
 
+type UserAccommodation struct {
+	ID                  uuid.UUID `json:"id"`
+	UserID              uuid.UUID `json:"userId"`
+	TimeMultiplier      float64   `json:"timeMultiplier"`
+	ExtraMinutes        int       `json:"extraMinutes"`
+	AllowRestBreaks     bool      `json:"allowRestBreaks"`
+	MaxRestBreaks       *int      `json:"maxRestBreaks,omitempty"`
+	MaxRestBreakMinutes *int      `json:"maxRestBreakMinutes,omitempty"`
+	Notes               *string   `json:"notes,omitempty"`
+	CreatedAt           time.Time `json:"createdAt"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+type UserImportReport struct {
+	DryRun         bool             `json:"dryRun"`
+	Imported       bool             `json:"imported"`
+	CreatedCount   int              `json:"createdCount"`
+	UpdatedCount   int              `json:"updatedCount"`
+	UnchangedCount int              `json:"unchangedCount"`
+	ErrorCount     int              `json:"errorCount"`
+	Rows           []*UserImportRow `json:"rows"`
+}
+
+type UserImportRow struct {
+	Line              int               `json:"line"`
+	Email             string            `json:"email"`
+	Action            *UserImportAction `json:"action,omitempty"`
+	Errors            []string          `json:"errors"`
+	TemporaryPassword *string           `json:"temporaryPassword,omitempty"`
+	InviteToken       *string           `json:"inviteToken,omitempty"`
+}
+
+type UserTestAttempts struct {
+	TestID                 uuid.UUID         `json:"testId"`
+	UserID                 uuid.UUID         `json:"userId"`
+	ScoringPolicy          TestScoringPolicy `json:"scoringPolicy"`
+	MaxAttempts            *int              `json:"maxAttempts,omitempty"`
+	AttemptsUsed           int               `json:"attemptsUsed"`
+	AttemptsRemaining      *int              `json:"attemptsRemaining,omitempty"`
+	NextAttemptAvailableAt *time.Time        `json:"nextAttemptAvailableAt,omitempty"`
+	Attempts               []*TestSession    `json:"attempts"`
+	FinalPoints            *float64          `json:"finalPoints,omitempty"`
+	FinalPercent           *float64          `json:"finalPercent,omitempty"`
+}
+
+type AccessResourceType string
+
+const (
+	AccessResourceTypeTest               AccessResourceType = "TEST"
+	AccessResourceTypeCourse             AccessResourceType = "COURSE"
+	AccessResourceTypeQuestionCollection AccessResourceType = "QUESTION_COLLECTION"
+)
+
+var AllAccessResourceType = []AccessResourceType{
+	AccessResourceTypeTest,
+	AccessResourceTypeCourse,
+	AccessResourceTypeQuestionCollection,
+}
+
+func (e AccessResourceType) IsValid() bool {
+	switch e {
+	case AccessResourceTypeTest, AccessResourceTypeCourse, AccessResourceTypeQuestionCollection:
+		return true
+	}
+	return false
+}
+
+func (e AccessResourceType) String() string {
+	return string(e)
+}
+
+func (e *AccessResourceType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessResourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessResourceType", str)
+	}
+	return nil
+}
+
+func (e AccessResourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AccessRole string
+
+const (
+	AccessRoleViewer AccessRole = "VIEWER"
+	AccessRoleEditor AccessRole = "EDITOR"
+)
+
+var AllAccessRole = []AccessRole{
+	AccessRoleViewer,
+	AccessRoleEditor,
+}
+
+func (e AccessRole) IsValid() bool {
+	switch e {
+	case AccessRoleViewer, AccessRoleEditor:
+		return true
+	}
+	return false
+}
+
+func (e AccessRole) String() string {
+	return string(e)
+}
+
+func (e *AccessRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessRole", str)
+	}
+	return nil
+}
+
+func (e AccessRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CourseEnrollmentRole string
+
+const (
+	CourseEnrollmentRoleLearner           CourseEnrollmentRole = "LEARNER"
+	CourseEnrollmentRoleInstructor        CourseEnrollmentRole = "INSTRUCTOR"
+	CourseEnrollmentRoleTeachingAssistant CourseEnrollmentRole = "TEACHING_ASSISTANT"
+)
+
+var AllCourseEnrollmentRole = []CourseEnrollmentRole{
+	CourseEnrollmentRoleLearner,
+	CourseEnrollmentRoleInstructor,
+	CourseEnrollmentRoleTeachingAssistant,
+}
+
+func (e CourseEnrollmentRole) IsValid() bool {
+	switch e {
+	case CourseEnrollmentRoleLearner, CourseEnrollmentRoleInstructor, CourseEnrollmentRoleTeachingAssistant:
+		return true
+	}
+	return false
+}
+
+func (e CourseEnrollmentRole) String() string {
+	return string(e)
+}
+
+func (e *CourseEnrollmentRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CourseEnrollmentRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CourseEnrollmentRole", str)
+	}
+	return nil
+}
+
+func (e CourseEnrollmentRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CourseEnrollmentStatus string
+
+const (
+	CourseEnrollmentStatusActive    CourseEnrollmentStatus = "ACTIVE"
+	CourseEnrollmentStatusSuspended CourseEnrollmentStatus = "SUSPENDED"
+	CourseEnrollmentStatusCompleted CourseEnrollmentStatus = "COMPLETED"
+)
+
+var AllCourseEnrollmentStatus = []CourseEnrollmentStatus{
+	CourseEnrollmentStatusActive,
+	CourseEnrollmentStatusSuspended,
+	CourseEnrollmentStatusCompleted,
+}
+
+func (e CourseEnrollmentStatus) IsValid() bool {
+	switch e {
+	case CourseEnrollmentStatusActive, CourseEnrollmentStatusSuspended, CourseEnrollmentStatusCompleted:
+		return true
+	}
+	return false
+}
+
+func (e CourseEnrollmentStatus) String() string {
+	return string(e)
+}
+
+func (e *CourseEnrollmentStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CourseEnrollmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CourseEnrollmentStatus", str)
+	}
+	return nil
+}
+
+func (e CourseEnrollmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CourseStatus string
+
+const (
+	CourseStatusDraft     CourseStatus = "DRAFT"
+	CourseStatusReview    CourseStatus = "REVIEW"
+	CourseStatusPublished CourseStatus = "PUBLISHED"
+	CourseStatusArchived  CourseStatus = "ARCHIVED"
+)
+
+var AllCourseStatus = []CourseStatus{
+	CourseStatusDraft,
+	CourseStatusReview,
+	CourseStatusPublished,
+	CourseStatusArchived,
+}
+
+func (e CourseStatus) IsValid() bool {
+	switch e {
+	case CourseStatusDraft, CourseStatusReview, CourseStatusPublished, CourseStatusArchived:
+		return true
+	}
+	return false
+}
+
+func (e CourseStatus) String() string {
+	return string(e)
+}
+
+func (e *CourseStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CourseStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CourseStatus", str)
+	}
+	return nil
+}
+
+func (e CourseStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IntegrityEventType string
+
+const (
+	IntegrityEventTypeTabSwitch      IntegrityEventType = "TAB_SWITCH"
+	IntegrityEventTypeFocusLost      IntegrityEventType = "FOCUS_LOST"
+	IntegrityEventTypeFullscreenExit IntegrityEventType = "FULLSCREEN_EXIT"
+	IntegrityEventTypeCopyPaste      IntegrityEventType = "COPY_PASTE"
+	IntegrityEventTypeOther          IntegrityEventType = "OTHER"
+)
+
+var AllIntegrityEventType = []IntegrityEventType{
+	IntegrityEventTypeTabSwitch,
+	IntegrityEventTypeFocusLost,
+	IntegrityEventTypeFullscreenExit,
+	IntegrityEventTypeCopyPaste,
+	IntegrityEventTypeOther,
+}
+
+func (e IntegrityEventType) IsValid() bool {
+	switch e {
+	case IntegrityEventTypeTabSwitch, IntegrityEventTypeFocusLost, IntegrityEventTypeFullscreenExit, IntegrityEventTypeCopyPaste, IntegrityEventTypeOther:
+		return true
+	}
+	return false
+}
+
+func (e IntegrityEventType) String() string {
+	return string(e)
+}
+
+func (e *IntegrityEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntegrityEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntegrityEventType", str)
+	}
+	return nil
+}
+
+func (e IntegrityEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type QuestionCollectionScope string
+
+const (
+	QuestionCollectionScopeAll          QuestionCollectionScope = "ALL"
+	QuestionCollectionScopeOwned        QuestionCollectionScope = "OWNED"
+	QuestionCollectionScopeSharedWithMe QuestionCollectionScope = "SHARED_WITH_ME"
+)
+
+var AllQuestionCollectionScope = []QuestionCollectionScope{
+	QuestionCollectionScopeAll,
+	QuestionCollectionScopeOwned,
+	QuestionCollectionScopeSharedWithMe,
+}
+
+func (e QuestionCollectionScope) IsValid() bool {
+	switch e {
+	case QuestionCollectionScopeAll, QuestionCollectionScopeOwned, QuestionCollectionScopeSharedWithMe:
+		return true
+	}
+	return false
+}
+
+func (e QuestionCollectionScope) String() string {
+	return string(e)
+}
+
+func (e *QuestionCollectionScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QuestionCollectionScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionCollectionScope", str)
+	}
+	return nil
+}
+
+func (e QuestionCollectionScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type QuestionVersionChangeType string
+
+const (
+	QuestionVersionChangeTypeQuestionText      QuestionVersionChangeType = "QUESTION_TEXT"
+	QuestionVersionChangeTypePoints            QuestionVersionChangeType = "POINTS"
+	QuestionVersionChangeTypeExplanation       QuestionVersionChangeType = "EXPLANATION"
+	QuestionVersionChangeTypeOptionAdded       QuestionVersionChangeType = "OPTION_ADDED"
+	QuestionVersionChangeTypeOptionRemoved     QuestionVersionChangeType = "OPTION_REMOVED"
+	QuestionVersionChangeTypeOptionCorrectness QuestionVersionChangeType = "OPTION_CORRECTNESS"
+)
+
+var AllQuestionVersionChangeType = []QuestionVersionChangeType{
+	QuestionVersionChangeTypeQuestionText,
+	QuestionVersionChangeTypePoints,
+	QuestionVersionChangeTypeExplanation,
+	QuestionVersionChangeTypeOptionAdded,
+	QuestionVersionChangeTypeOptionRemoved,
+	QuestionVersionChangeTypeOptionCorrectness,
+}
+
+func (e QuestionVersionChangeType) IsValid() bool {
+	switch e {
+	case QuestionVersionChangeTypeQuestionText, QuestionVersionChangeTypePoints, QuestionVersionChangeTypeExplanation, QuestionVersionChangeTypeOptionAdded, QuestionVersionChangeTypeOptionRemoved, QuestionVersionChangeTypeOptionCorrectness:
+		return true
+	}
+	return false
+}
+
+func (e QuestionVersionChangeType) String() string {
+	return string(e)
+}
+
+func (e *QuestionVersionChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QuestionVersionChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionVersionChangeType", str)
+	}
+	return nil
+}
+
+func (e QuestionVersionChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RegradeScope string
+
+const (
+	RegradeScopeQuestion RegradeScope = "QUESTION"
+	RegradeScopeTest     RegradeScope = "TEST"
+)
+
+var AllRegradeScope = []RegradeScope{
+	RegradeScopeQuestion,
+	RegradeScopeTest,
+}
+
+func (e RegradeScope) IsValid() bool {
+	switch e {
+	case RegradeScopeQuestion, RegradeScopeTest:
+		return true
+	}
+	return false
+}
+
+func (e RegradeScope) String() string {
+	return string(e)
+}
+
+func (e *RegradeScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegradeScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegradeScope", str)
+	}
+	return nil
+}
+
+func (e RegradeScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TestFeedbackRelease string
+
+const (
+	TestFeedbackReleaseImmediately TestFeedbackRelease = "IMMEDIATELY"
+	TestFeedbackReleaseAfterClose  TestFeedbackRelease = "AFTER_CLOSE"
+	TestFeedbackReleaseNever       TestFeedbackRelease = "NEVER"
+)
+
+var AllTestFeedbackRelease = []TestFeedbackRelease{
+	TestFeedbackReleaseImmediately,
+	TestFeedbackReleaseAfterClose,
+	TestFeedbackReleaseNever,
+}
+
+func (e TestFeedbackRelease) IsValid() bool {
+	switch e {
+	case TestFeedbackReleaseImmediately, TestFeedbackReleaseAfterClose, TestFeedbackReleaseNever:
+		return true
+	}
+	return false
+}
+
+func (e TestFeedbackRelease) String() string {
+	return string(e)
+}
+
+func (e *TestFeedbackRelease) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TestFeedbackRelease(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TestFeedbackRelease", str)
+	}
+	return nil
+}
+
+func (e TestFeedbackRelease) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TestScoringPolicy string
+
+const (
+	TestScoringPolicyHighest TestScoringPolicy = "HIGHEST"
+	TestScoringPolicyLatest  TestScoringPolicy = "LATEST"
+	TestScoringPolicyAverage TestScoringPolicy = "AVERAGE"
+)
+
+var AllTestScoringPolicy = []TestScoringPolicy{
+	TestScoringPolicyHighest,
+	TestScoringPolicyLatest,
+	TestScoringPolicyAverage,
+}
+
+func (e TestScoringPolicy) IsValid() bool {
+	switch e {
+	case TestScoringPolicyHighest, TestScoringPolicyLatest, TestScoringPolicyAverage:
+		return true
+	}
+	return false
+}
+
+func (e TestScoringPolicy) String() string {
+	return string(e)
+}
+
+func (e *TestScoringPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TestScoringPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TestScoringPolicy", str)
+	}
+	return nil
+}
+
+func (e TestScoringPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TestSessionStatus string
 
 const (
@@ -356,3 +1183,136 @@ func (e *TestSessionStatus) UnmarshalGQL(v interface{}) error {
 func (e TestSessionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type TrashEntityType string
+
+const (
+	TrashEntityTypeCourse             TrashEntityType = "COURSE"
+	TrashEntityTypeCourseSection      TrashEntityType = "COURSE_SECTION"
+	TrashEntityTypeTest               TrashEntityType = "TEST"
+	TrashEntityTypeQuestionCollection TrashEntityType = "QUESTION_COLLECTION"
+	TrashEntityTypeQuestion           TrashEntityType = "QUESTION"
+	TrashEntityTypeGroup              TrashEntityType = "GROUP"
+)
+
+var AllTrashEntityType = []TrashEntityType{
+	TrashEntityTypeCourse,
+	TrashEntityTypeCourseSection,
+	TrashEntityTypeTest,
+	TrashEntityTypeQuestionCollection,
+	TrashEntityTypeQuestion,
+	TrashEntityTypeGroup,
+}
+
+func (e TrashEntityType) IsValid() bool {
+	switch e {
+	case TrashEntityTypeCourse, TrashEntityTypeCourseSection, TrashEntityTypeTest, TrashEntityTypeQuestionCollection, TrashEntityTypeQuestion, TrashEntityTypeGroup:
+		return true
+	}
+	return false
+}
+
+func (e TrashEntityType) String() string {
+	return string(e)
+}
+
+func (e *TrashEntityType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrashEntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrashEntityType", str)
+	}
+	return nil
+}
+
+func (e TrashEntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserImportAction string
+
+const (
+	UserImportActionCreate    UserImportAction = "CREATE"
+	UserImportActionUpdate    UserImportAction = "UPDATE"
+	UserImportActionUnchanged UserImportAction = "UNCHANGED"
+)
+
+var AllUserImportAction = []UserImportAction{
+	UserImportActionCreate,
+	UserImportActionUpdate,
+	UserImportActionUnchanged,
+}
+
+func (e UserImportAction) IsValid() bool {
+	switch e {
+	case UserImportActionCreate, UserImportActionUpdate, UserImportActionUnchanged:
+		return true
+	}
+	return false
+}
+
+func (e UserImportAction) String() string {
+	return string(e)
+}
+
+func (e *UserImportAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserImportAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserImportAction", str)
+	}
+	return nil
+}
+
+func (e UserImportAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserImportCredentials string
+
+const (
+	UserImportCredentialsTemporaryPassword UserImportCredentials = "TEMPORARY_PASSWORD"
+	UserImportCredentialsInviteToken       UserImportCredentials = "INVITE_TOKEN"
+)
+
+var AllUserImportCredentials = []UserImportCredentials{
+	UserImportCredentialsTemporaryPassword,
+	UserImportCredentialsInviteToken,
+}
+
+func (e UserImportCredentials) IsValid() bool {
+	switch e {
+	case UserImportCredentialsTemporaryPassword, UserImportCredentialsInviteToken:
+		return true
+	}
+	return false
+}
+
+func (e UserImportCredentials) String() string {
+	return string(e)
+}
+
+func (e *UserImportCredentials) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserImportCredentials(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserImportCredentials", str)
+	}
+	return nil
+}
+
+func (e UserImportCredentials) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"template/internal/ent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization is a tenant, e.g. a school, owning its users and resources
+type Organization struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description *string   `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ConvertOrganizationToModel converts an ent.Organization to a GraphQL model Organization.
+func ConvertOrganizationToModel(organization *ent.Organization) *Organization {
+	return &Organization{
+		ID:          organization.ID,
+		Name:        organization.Name,
+		Slug:        organization.Slug,
+		Description: organization.Description,
+		CreatedAt:   organization.CreatedAt,
+		UpdatedAt:   organization.UpdatedAt,
+	}
+}
@@ -11,6 +11,7 @@ type Question struct {
 	QuestionText string    `json:"questionText"`
 	CollectionID uuid.UUID `json:"collectionId"`
 	Points       int       `json:"points"`
+	Explanation  *string   `json:"explanation,omitempty"`
 }
 
 // ConvertQuestionToModel converts an ent.Question to a GraphQL model Question.
@@ -20,6 +21,7 @@ func ConvertQuestionToModel(q *ent.Question) *Question {
 		QuestionText: q.QuestionText,
 		CollectionID: q.CollectionID,
 		Points:       q.Points,
+		Explanation:  q.Explanation,
 	}
 
 	return result
@@ -7,19 +7,21 @@ import (
 )
 
 type QuestionOption struct {
-	ID         uuid.UUID `json:"id"`
-	OptionText string    `json:"optionText"`
-	IsCorrect  bool      `json:"isCorrect"`
-	QuestionID uuid.UUID `json:"questionId"`
+	ID          uuid.UUID `json:"id"`
+	OptionText  string    `json:"optionText"`
+	IsCorrect   bool      `json:"isCorrect"`
+	QuestionID  uuid.UUID `json:"questionId"`
+	Explanation *string   `json:"explanation,omitempty"`
 }
 
 // ConvertQuestionOptionToModel converts an ent.QuestionOption to a GraphQL model QuestionOption.
 func ConvertQuestionOptionToModel(option *ent.QuestionOption) *QuestionOption {
 	result := &QuestionOption{
-		ID:         option.ID,
-		OptionText: option.OptionText,
-		IsCorrect:  option.IsCorrect,
-		QuestionID: option.QuestionID,
+		ID:          option.ID,
+		OptionText:  option.OptionText,
+		IsCorrect:   option.IsCorrect,
+		QuestionID:  option.QuestionID,
+		Explanation: option.Explanation,
 	}
 
 	return result
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"template/internal/ent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuestionVersion is used for internal conversion between ent.QuestionVersion and GraphQL QuestionVersion
+type QuestionVersion struct {
+	ID           uuid.UUID                `json:"id"`
+	QuestionID   uuid.UUID                `json:"questionId"`
+	Version      int                      `json:"version"`
+	QuestionText string                   `json:"questionText"`
+	Points       int                      `json:"points"`
+	Explanation  *string                  `json:"explanation,omitempty"`
+	Options      []*QuestionVersionOption `json:"options"`
+	CreatedByID  *uuid.UUID               `json:"createdById,omitempty"`
+	CreatedAt    time.Time                `json:"createdAt"`
+	Changes      []*QuestionVersionChange `json:"changes"`
+}
+
+// ConvertQuestionVersionToModel converts an ent.QuestionVersion loaded with its options to a GraphQL model QuestionVersion.
+func ConvertQuestionVersionToModel(v *ent.QuestionVersion) *QuestionVersion {
+	options := make([]*QuestionVersionOption, 0, len(v.Edges.Options))
+	for _, option := range v.Edges.Options {
+		options = append(options, &QuestionVersionOption{
+			ID:          option.ID,
+			OptionID:    option.OptionID,
+			OptionText:  option.OptionText,
+			IsCorrect:   option.IsCorrect,
+			Explanation: option.Explanation,
+			Order:       option.Order,
+		})
+	}
+
+	return &QuestionVersion{
+		ID:           v.ID,
+		QuestionID:   v.QuestionID,
+		Version:      v.Version,
+		QuestionText: v.QuestionText,
+		Points:       v.Points,
+		Explanation:  v.Explanation,
+		Options:      options,
+		CreatedByID:  v.CreatedByID,
+		CreatedAt:    v.CreatedAt,
+		Changes:      []*QuestionVersionChange{},
+	}
+}
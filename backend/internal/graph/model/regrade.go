@@ -0,0 +1,39 @@
+package model
+
+import (
+	"template/internal/ent"
+	"template/internal/ent/testsessionregrade"
+
+	"github.com/google/uuid"
+)
+
+// RegradeSessionDelta is the change of points of a completed session caused by a regrade
+type RegradeSessionDelta struct {
+	SessionID       uuid.UUID  `json:"sessionId"`
+	TestID          uuid.UUID  `json:"testId"`
+	UserID          *uuid.UUID `json:"userId"`
+	PointsBefore    int        `json:"pointsBefore"`
+	PointsAfter     int        `json:"pointsAfter"`
+	MaxPointsBefore int        `json:"maxPointsBefore"`
+	MaxPointsAfter  int        `json:"maxPointsAfter"`
+	Delta           int        `json:"delta"`
+}
+
+// ConvertTestSessionRegradeToModel converts an ent.TestSessionRegrade to a GraphQL model TestSessionRegrade.
+func ConvertTestSessionRegradeToModel(r *ent.TestSessionRegrade) *TestSessionRegrade {
+	scope := RegradeScopeTest
+	if r.Scope == testsessionregrade.ScopeQuestion {
+		scope = RegradeScopeQuestion
+	}
+
+	return &TestSessionRegrade{
+		ID:           r.ID,
+		SessionID:    r.SessionID,
+		RegradedByID: r.RegradedByID,
+		Scope:        scope,
+		QuestionID:   r.QuestionID,
+		PointsBefore: r.PointsBefore,
+		PointsAfter:  r.PointsAfter,
+		CreatedAt:    r.CreatedAt,
+	}
+}
@@ -7,7 +7,8 @@ import (
 // ConvertRoleToModel converts an ent.Role to a GraphQL model Role.
 func ConvertRoleToModel(role *ent.Role) *Role {
 	return &Role{
-		ID:   role.ID,
-		Name: role.Name,
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
 	}
 }
@@ -2,6 +2,8 @@ package model
 
 import (
 	"template/internal/ent"
+	"template/internal/ent/test"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -12,14 +14,74 @@ func ConvertTestToModel(entTest *ent.Test) *Test {
 		return nil
 	}
 	return &Test{
-		ID:        entTest.ID,
-		Name:      entTest.Name,
-		TotalTime: entTest.TotalTime,
+		ID:                     entTest.ID,
+		Name:                   entTest.Name,
+		TotalTime:              entTest.TotalTime,
+		OpenAt:                 entTest.OpenAt,
+		CloseAt:                entTest.CloseAt,
+		MaxAttempts:            entTest.MaxAttempts,
+		AttemptCooldownMinutes: entTest.AttemptCooldownMinutes,
+		ScoringPolicy:          ConvertEntScoringPolicyToModel(entTest.ScoringPolicy),
+		FeedbackRelease:        ConvertEntFeedbackReleaseToModel(entTest.FeedbackRelease),
 	}
 }
 
 type Test struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	TotalTime *int      `json:"totalTime"`
+	ID                     uuid.UUID           `json:"id"`
+	Name                   string              `json:"name"`
+	TotalTime              *int                `json:"totalTime"`
+	OpenAt                 *time.Time          `json:"openAt"`
+	CloseAt                *time.Time          `json:"closeAt"`
+	MaxAttempts            *int                `json:"maxAttempts"`
+	AttemptCooldownMinutes *int                `json:"attemptCooldownMinutes"`
+	ScoringPolicy          TestScoringPolicy   `json:"scoringPolicy"`
+	FeedbackRelease        TestFeedbackRelease `json:"feedbackRelease"`
+}
+
+// ConvertEntScoringPolicyToModel converts an ent test scoring policy to a GraphQL TestScoringPolicy
+func ConvertEntScoringPolicyToModel(policy test.ScoringPolicy) TestScoringPolicy {
+	switch policy {
+	case test.ScoringPolicyLatest:
+		return TestScoringPolicyLatest
+	case test.ScoringPolicyAverage:
+		return TestScoringPolicyAverage
+	default:
+		return TestScoringPolicyHighest
+	}
+}
+
+// ConvertScoringPolicyToEnt converts a GraphQL TestScoringPolicy to the ent test scoring policy
+func ConvertScoringPolicyToEnt(policy TestScoringPolicy) test.ScoringPolicy {
+	switch policy {
+	case TestScoringPolicyLatest:
+		return test.ScoringPolicyLatest
+	case TestScoringPolicyAverage:
+		return test.ScoringPolicyAverage
+	default:
+		return test.ScoringPolicyHighest
+	}
+}
+
+// ConvertEntFeedbackReleaseToModel converts an ent test feedback release to a GraphQL TestFeedbackRelease
+func ConvertEntFeedbackReleaseToModel(release test.FeedbackRelease) TestFeedbackRelease {
+	switch release {
+	case test.FeedbackReleaseAfterClose:
+		return TestFeedbackReleaseAfterClose
+	case test.FeedbackReleaseNever:
+		return TestFeedbackReleaseNever
+	default:
+		return TestFeedbackReleaseImmediately
+	}
+}
+
+// ConvertFeedbackReleaseToEnt converts a GraphQL TestFeedbackRelease to the ent test feedback release
+func ConvertFeedbackReleaseToEnt(release TestFeedbackRelease) test.FeedbackRelease {
+	switch release {
+	case TestFeedbackReleaseAfterClose:
+		return test.FeedbackReleaseAfterClose
+	case TestFeedbackReleaseNever:
+		return test.FeedbackReleaseNever
+	default:
+		return test.FeedbackReleaseImmediately
+	}
 }
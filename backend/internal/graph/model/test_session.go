@@ -10,33 +10,51 @@ import (
 
 // TestSession is used for internal conversion between ent.TestSession and GraphQL TestSession
 type TestSession struct {
-	ID           uuid.UUID         `json:"id"`
-	CompletedAt  *time.Time        `json:"completedAt,omitempty"`
-	MaxPoints    int               `json:"maxPoints"`
-	PointsEarned int               `json:"pointsEarned"`
-	CreatedAt    time.Time         `json:"createdAt"`
-	UpdatedAt    time.Time         `json:"updatedAt"`
-	Status       TestSessionStatus `json:"status"`
-	StartedAt    *time.Time        `json:"startedAt,omitempty"`
-	ExpiredAt    *time.Time        `json:"expiredAt,omitempty"`
-	TestID       uuid.UUID         `json:"testId"`
-	UserID       *uuid.UUID        `json:"userId"`
+	ID                  uuid.UUID         `json:"id"`
+	CompletedAt         *time.Time        `json:"completedAt,omitempty"`
+	LastActivityAt      *time.Time        `json:"lastActivityAt,omitempty"`
+	PausedAt            *time.Time        `json:"pausedAt,omitempty"`
+	TimeMultiplier      *float64          `json:"timeMultiplier,omitempty"`
+	ExtraMinutes        *int              `json:"extraMinutes,omitempty"`
+	AllowRestBreaks     *bool             `json:"allowRestBreaks,omitempty"`
+	MaxRestBreaks       *int              `json:"maxRestBreaks,omitempty"`
+	MaxRestBreakMinutes *int              `json:"maxRestBreakMinutes,omitempty"`
+	OpenAt              *time.Time        `json:"openAt,omitempty"`
+	CloseAt             *time.Time        `json:"closeAt,omitempty"`
+	MaxPoints           int               `json:"maxPoints"`
+	PointsEarned        int               `json:"pointsEarned"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	UpdatedAt           time.Time         `json:"updatedAt"`
+	Status              TestSessionStatus `json:"status"`
+	StartedAt           *time.Time        `json:"startedAt,omitempty"`
+	ExpiredAt           *time.Time        `json:"expiredAt,omitempty"`
+	TestID              uuid.UUID         `json:"testId"`
+	UserID              *uuid.UUID        `json:"userId"`
 }
 
 // ConvertTestSessionToModel converts an ent.TestSession to a GraphQL model TestSession.
 func ConvertTestSessionToModel(ts *ent.TestSession) *TestSession {
 	result := &TestSession{
-		ID:           ts.ID,
-		CompletedAt:  ts.CompletedAt,
-		MaxPoints:    ts.MaxPoints,
-		PointsEarned: ts.PointsEarned,
-		CreatedAt:    ts.CreatedAt,
-		UpdatedAt:    ts.UpdatedAt,
-		StartedAt:    ts.StartedAt,
-		ExpiredAt:    ts.ExpiredAt,
-		Status:       convertEntStatusToGraphQLStatus(ts.Status),
-		TestID:       ts.TestID,
-		UserID:       ts.UserID,
+		ID:                  ts.ID,
+		CompletedAt:         ts.CompletedAt,
+		LastActivityAt:      ts.LastActivityAt,
+		PausedAt:            ts.PausedAt,
+		TimeMultiplier:      ts.TimeMultiplier,
+		ExtraMinutes:        ts.ExtraMinutes,
+		AllowRestBreaks:     ts.AllowRestBreaks,
+		MaxRestBreaks:       ts.MaxRestBreaks,
+		MaxRestBreakMinutes: ts.MaxRestBreakMinutes,
+		OpenAt:              ts.OpenAt,
+		CloseAt:             ts.CloseAt,
+		MaxPoints:           ts.MaxPoints,
+		PointsEarned:        ts.PointsEarned,
+		CreatedAt:           ts.CreatedAt,
+		UpdatedAt:           ts.UpdatedAt,
+		StartedAt:           ts.StartedAt,
+		ExpiredAt:           ts.ExpiredAt,
+		Status:              convertEntStatusToGraphQLStatus(ts.Status),
+		TestID:              ts.TestID,
+		UserID:              ts.UserID,
 	}
 
 	return result
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"template/internal/ent"
+	"template/internal/ent/testsessionintegrityevent"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TestSessionLiveStatus is the proctoring view of a candidate's session
+type TestSessionLiveStatus struct {
+	SessionID       uuid.UUID                    `json:"sessionId"`
+	UserID          *uuid.UUID                   `json:"userId"`
+	Status          TestSessionStatus            `json:"status"`
+	StartedAt       *time.Time                   `json:"startedAt,omitempty"`
+	ExpiredAt       *time.Time                   `json:"expiredAt,omitempty"`
+	AnsweredCount   int                          `json:"answeredCount"`
+	TotalQuestions  int                          `json:"totalQuestions"`
+	LastActivityAt  *time.Time                   `json:"lastActivityAt,omitempty"`
+	IntegrityEvents []*TestSessionIntegrityEvent `json:"integrityEvents"`
+}
+
+// ConvertTestSessionIntegrityEventToModel converts an ent.TestSessionIntegrityEvent to a GraphQL model TestSessionIntegrityEvent.
+func ConvertTestSessionIntegrityEventToModel(e *ent.TestSessionIntegrityEvent) *TestSessionIntegrityEvent {
+	return &TestSessionIntegrityEvent{
+		ID:         e.ID,
+		SessionID:  e.SessionID,
+		EventType:  convertEntIntegrityEventType(e.EventType),
+		Details:    e.Details,
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+// ConvertIntegrityEventTypeToEnt converts a GraphQL IntegrityEventType to the ent event type
+func ConvertIntegrityEventTypeToEnt(eventType IntegrityEventType) testsessionintegrityevent.EventType {
+	switch eventType {
+	case IntegrityEventTypeTabSwitch:
+		return testsessionintegrityevent.EventTypeTabSwitch
+	case IntegrityEventTypeFocusLost:
+		return testsessionintegrityevent.EventTypeFocusLost
+	case IntegrityEventTypeFullscreenExit:
+		return testsessionintegrityevent.EventTypeFullscreenExit
+	case IntegrityEventTypeCopyPaste:
+		return testsessionintegrityevent.EventTypeCopyPaste
+	default:
+		return testsessionintegrityevent.EventTypeOther
+	}
+}
+
+func convertEntIntegrityEventType(eventType testsessionintegrityevent.EventType) IntegrityEventType {
+	switch eventType {
+	case testsessionintegrityevent.EventTypeTabSwitch:
+		return IntegrityEventTypeTabSwitch
+	case testsessionintegrityevent.EventTypeFocusLost:
+		return IntegrityEventTypeFocusLost
+	case testsessionintegrityevent.EventTypeFullscreenExit:
+		return IntegrityEventTypeFullscreenExit
+	case testsessionintegrityevent.EventTypeCopyPaste:
+		return IntegrityEventTypeCopyPaste
+	default:
+		return IntegrityEventTypeOther
+	}
+}
@@ -7,22 +7,29 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID
-	Email     string
-	Username  string
-	FirstName *string
-	LastName  *string
-	IsActive  bool
+	ID                 uuid.UUID
+	Email              string
+	Username           string
+	FirstName          *string
+	LastName           *string
+	AvatarID           *uuid.UUID
+	IsActive           bool
+	MustChangePassword bool
+	// OrganizationID is the organization of the user, nil for the users without organization
+	OrganizationID *uuid.UUID
 }
 
 // ConvertUserToModel converts an Ent User entity to a GraphQL User model
 func ConvertUserToModel(entUser *ent.User) *User {
 	return &User{
-		ID:        entUser.ID,
-		Email:     entUser.Email,
-		Username:  entUser.Username,
-		FirstName: entUser.FirstName,
-		LastName:  entUser.LastName,
-		IsActive:  entUser.IsActive,
+		ID:                 entUser.ID,
+		Email:              entUser.Email,
+		Username:           entUser.Username,
+		FirstName:          entUser.FirstName,
+		LastName:           entUser.LastName,
+		AvatarID:           entUser.AvatarID,
+		IsActive:           entUser.IsActive,
+		MustChangePassword: entUser.MustChangePassword,
+		OrganizationID:     entUser.OrganizationID,
 	}
 }
@@ -0,0 +1,89 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/organization"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// CreateOrganization is the resolver for the createOrganization field.
+func (r *mutationResolver) CreateOrganization(ctx context.Context, input model.CreateOrganizationInput) (*model.Organization, error) {
+	entOrganization, err := organization.CreateOrganization(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertOrganizationToModel(entOrganization), nil
+}
+
+// UpdateOrganization is the resolver for the updateOrganization field.
+func (r *mutationResolver) UpdateOrganization(ctx context.Context, id uuid.UUID, input model.UpdateOrganizationInput) (*model.Organization, error) {
+	entOrganization, err := organization.UpdateOrganization(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertOrganizationToModel(entOrganization), nil
+}
+
+// DeleteOrganization is the resolver for the deleteOrganization field.
+func (r *mutationResolver) DeleteOrganization(ctx context.Context, id uuid.UUID) (bool, error) {
+	return organization.DeleteOrganization(ctx, id)
+}
+
+// AssignOrganizationUsers is the resolver for the assignOrganizationUsers field.
+func (r *mutationResolver) AssignOrganizationUsers(ctx context.Context, input model.AssignOrganizationUsersInput) (*model.Organization, error) {
+	entOrganization, err := organization.AssignOrganizationUsers(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertOrganizationToModel(entOrganization), nil
+}
+
+// Organization is the resolver for the organization field.
+func (r *queryResolver) Organization(ctx context.Context, id uuid.UUID) (*model.Organization, error) {
+	entOrganization, err := organization.GetOrganizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertOrganizationToModel(entOrganization), nil
+}
+
+// PaginatedOrganizations is the resolver for the paginatedOrganizations field.
+func (r *queryResolver) PaginatedOrganizations(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedOrganization, error) {
+	paginatedOrganization, err := organization.PaginatedOrganizations(ctx, paginationInput)
+	if err != nil {
+		return nil, err
+	}
+	items := slice.Map(paginatedOrganization.Items, model.ConvertOrganizationToModel)
+	pagination := &model.Pagination{
+		CurrentPage:     paginatedOrganization.CurrentPage,
+		TotalPages:      paginatedOrganization.TotalPages,
+		TotalItems:      paginatedOrganization.TotalItems,
+		HasNextPage:     paginatedOrganization.HasNextPage,
+		HasPreviousPage: paginatedOrganization.HasPrevPage,
+	}
+	return &model.PaginatedOrganization{
+		Pagination: pagination,
+		Items:      items,
+	}, nil
+}
+
+// MyOrganization is the resolver for the myOrganization field.
+func (r *queryResolver) MyOrganization(ctx context.Context) (*model.Organization, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entOrganization, err := organization.GetUserOrganization(ctx, userId)
+	if err != nil || entOrganization == nil {
+		return nil, err
+	}
+	return model.ConvertOrganizationToModel(entOrganization), nil
+}
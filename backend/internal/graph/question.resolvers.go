@@ -6,7 +6,6 @@ package graph
 
 import (
 	"context"
-	"template/internal/features/permission"
 	"template/internal/features/question"
 	"template/internal/graph/dataloader"
 	"template/internal/graph/model"
@@ -17,9 +16,7 @@ import (
 
 // CreateQuestion is the resolver for the createQuestion field.
 func (r *mutationResolver) CreateQuestion(ctx context.Context, input model.CreateQuestionInput) (*model.Question, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -36,9 +33,7 @@ func (r *mutationResolver) CreateQuestion(ctx context.Context, input model.Creat
 
 // UpdateQuestion is the resolver for the updateQuestion field.
 func (r *mutationResolver) UpdateQuestion(ctx context.Context, id uuid.UUID, input model.UpdateQuestionInput) (*model.Question, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,9 +50,7 @@ func (r *mutationResolver) UpdateQuestion(ctx context.Context, id uuid.UUID, inp
 
 // DeleteQuestion is the resolver for the deleteQuestion field.
 func (r *mutationResolver) DeleteQuestion(ctx context.Context, id uuid.UUID) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -68,9 +61,7 @@ func (r *mutationResolver) DeleteQuestion(ctx context.Context, id uuid.UUID) (bo
 
 // Question is the resolver for the question field.
 func (r *queryResolver) Question(ctx context.Context, id uuid.UUID) (*model.Question, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -87,13 +78,6 @@ func (r *queryResolver) Question(ctx context.Context, id uuid.UUID) (*model.Ques
 
 // Questions is the resolver for the questions field.
 func (r *queryResolver) Questions(ctx context.Context, ids []uuid.UUID) ([]*model.Question, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionRead,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	// Get the questions by IDs
 	questions, err := question.GetByIDs(ctx, ids)
 	if err != nil {
@@ -106,9 +90,7 @@ func (r *queryResolver) Questions(ctx context.Context, ids []uuid.UUID) ([]*mode
 
 // PaginatedQuestions is the resolver for the paginatedQuestions field.
 func (r *queryResolver) PaginatedQuestions(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedQuestion, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -145,9 +127,27 @@ func (r *questionResolver) Options(ctx context.Context, obj *model.Question) ([]
 	return dataloader.GetQuestionOptions(ctx, obj.ID)
 }
 
+// Explanation is the resolver for the explanation field.
+func (r *questionResolver) Explanation(ctx context.Context, obj *model.Question) (*string, error) {
+	canView, err := canViewAnswerKey(ctx, obj.ID)
+	if err != nil || !canView {
+		return nil, err
+	}
+	return obj.Explanation, nil
+}
+
 // CorrectOptionCount is the resolver for the correctOptionCount field.
-func (r *questionResolver) CorrectOptionCount(ctx context.Context, obj *model.Question) (int, error) {
-	return dataloader.GetCorrectOptionCount(ctx, obj.ID)
+func (r *questionResolver) CorrectOptionCount(ctx context.Context, obj *model.Question) (*int, error) {
+	canView, err := canViewAnswerKey(ctx, obj.ID)
+	if err != nil || !canView {
+		return nil, err
+	}
+
+	count, err := dataloader.GetCorrectOptionCount(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &count, nil
 }
 
 // Question returns QuestionResolver implementation.
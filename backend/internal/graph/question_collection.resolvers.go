@@ -6,7 +6,6 @@ package graph
 
 import (
 	"context"
-	"template/internal/features/permission"
 	"template/internal/features/question"
 	"template/internal/features/question_collection"
 	"template/internal/graph/dataloader"
@@ -18,9 +17,7 @@ import (
 
 // CreateQuestionCollection is the resolver for the createQuestionCollection field.
 func (r *mutationResolver) CreateQuestionCollection(ctx context.Context, input model.CreateQuestionCollectionInput) (*model.QuestionCollection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -34,9 +31,7 @@ func (r *mutationResolver) CreateQuestionCollection(ctx context.Context, input m
 
 // UpdateQuestionCollection is the resolver for the updateQuestionCollection field.
 func (r *mutationResolver) UpdateQuestionCollection(ctx context.Context, id uuid.UUID, input model.UpdateQuestionCollectionInput) (*model.QuestionCollection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,9 +45,7 @@ func (r *mutationResolver) UpdateQuestionCollection(ctx context.Context, id uuid
 
 // DeleteQuestionCollection is the resolver for the deleteQuestionCollection field.
 func (r *mutationResolver) DeleteQuestionCollection(ctx context.Context, id uuid.UUID) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -62,9 +55,7 @@ func (r *mutationResolver) DeleteQuestionCollection(ctx context.Context, id uuid
 
 // UpdateBatchQuestionsByCollection is the resolver for the updateBatchQuestionsByCollection field.
 func (r *mutationResolver) UpdateBatchQuestionsByCollection(ctx context.Context, input model.UpdateBatchQuestionsByCollectionInput) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -79,9 +70,7 @@ func (r *mutationResolver) UpdateBatchQuestionsByCollection(ctx context.Context,
 
 // QuestionCollection is the resolver for the questionCollection field.
 func (r *queryResolver) QuestionCollection(ctx context.Context, id uuid.UUID) (*model.QuestionCollection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -94,15 +83,13 @@ func (r *queryResolver) QuestionCollection(ctx context.Context, id uuid.UUID) (*
 }
 
 // PaginatedQuestionCollections is the resolver for the paginatedQuestionCollections field.
-func (r *queryResolver) PaginatedQuestionCollections(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedQuestionCollection, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.CollectionRead,
-	})
+func (r *queryResolver) PaginatedQuestionCollections(ctx context.Context, paginationInput *model.PaginationInput, scope *model.QuestionCollectionScope) (*model.PaginatedQuestionCollection, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := question_collection.PaginatedQuestionCollections(ctx, userId, paginationInput)
+	result, err := question_collection.PaginatedQuestionCollections(ctx, userId, paginationInput, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -124,9 +111,7 @@ func (r *queryResolver) PaginatedQuestionCollections(ctx context.Context, pagina
 
 // ExportQuestions is the resolver for the exportQuestions field.
 func (r *queryResolver) ExportQuestions(ctx context.Context, questionIds []uuid.UUID) (string, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -137,13 +122,6 @@ func (r *queryResolver) ExportQuestions(ctx context.Context, questionIds []uuid.
 
 // QuestionCountByPoints is the resolver for the questionCountByPoints field.
 func (r *queryResolver) QuestionCountByPoints(ctx context.Context, collectionIds []uuid.UUID) ([]*model.QuestionPointsCount, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionRead,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	return question_collection.GetQuestionCountByPoints(ctx, collectionIds)
 }
 
@@ -7,7 +7,6 @@ package graph
 import (
 	"context"
 	"fmt"
-	"template/internal/features/permission"
 	"template/internal/features/question_option"
 	"template/internal/graph/model"
 
@@ -16,9 +15,7 @@ import (
 
 // CreateQuestionOption is the resolver for the createQuestionOption field.
 func (r *mutationResolver) CreateQuestionOption(ctx context.Context, input model.CreateQuestionOptionInput) (*model.QuestionOption, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionOptionCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -33,9 +30,7 @@ func (r *mutationResolver) CreateQuestionOption(ctx context.Context, input model
 
 // UpdateQuestionOption is the resolver for the updateQuestionOption field.
 func (r *mutationResolver) UpdateQuestionOption(ctx context.Context, id uuid.UUID, input model.UpdateQuestionOptionInput) (*model.QuestionOption, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionOptionUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,9 +45,7 @@ func (r *mutationResolver) UpdateQuestionOption(ctx context.Context, id uuid.UUI
 
 // DeleteQuestionOption is the resolver for the deleteQuestionOption field.
 func (r *mutationResolver) DeleteQuestionOption(ctx context.Context, id uuid.UUID) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionOptionDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -62,9 +55,7 @@ func (r *mutationResolver) DeleteQuestionOption(ctx context.Context, id uuid.UUI
 
 // QuestionOption is the resolver for the questionOption field.
 func (r *queryResolver) QuestionOption(ctx context.Context, id uuid.UUID) (*model.QuestionOption, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.QuestionOptionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +73,24 @@ func (r *questionOptionResolver) Question(ctx context.Context, obj *model.Questi
 	panic(fmt.Errorf("not implemented: Question - question"))
 }
 
+// IsCorrect is the resolver for the isCorrect field.
+func (r *questionOptionResolver) IsCorrect(ctx context.Context, obj *model.QuestionOption) (*bool, error) {
+	canView, err := canViewAnswerKey(ctx, obj.QuestionID)
+	if err != nil || !canView {
+		return nil, err
+	}
+	return &obj.IsCorrect, nil
+}
+
+// Explanation is the resolver for the explanation field.
+func (r *questionOptionResolver) Explanation(ctx context.Context, obj *model.QuestionOption) (*string, error) {
+	canView, err := canViewAnswerKey(ctx, obj.QuestionID)
+	if err != nil || !canView {
+		return nil, err
+	}
+	return obj.Explanation, nil
+}
+
 // QuestionOption returns QuestionOptionResolver implementation.
 func (r *Resolver) QuestionOption() QuestionOptionResolver { return &questionOptionResolver{r} }
 
@@ -0,0 +1,43 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/question"
+	"template/internal/features/role"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// QuestionHistory is the resolver for the questionHistory field.
+func (r *queryResolver) QuestionHistory(ctx context.Context, id uuid.UUID) ([]*model.QuestionVersion, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return question.GetQuestionHistory(ctx, userId, isAdminOrOwner, id)
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *questionVersionResolver) CreatedBy(ctx context.Context, obj *model.QuestionVersion) (*model.User, error) {
+	if obj.CreatedByID == nil {
+		return nil, nil
+	}
+	return dataloader.GetUser(ctx, *obj.CreatedByID)
+}
+
+// QuestionVersion returns QuestionVersionResolver implementation.
+func (r *Resolver) QuestionVersion() QuestionVersionResolver { return &questionVersionResolver{r} }
+
+type questionVersionResolver struct{ *Resolver }
@@ -0,0 +1,80 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/role"
+	"template/internal/features/test_session"
+	"template/internal/graph/dataloader"
+	"template/internal/graph/model"
+	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
+)
+
+// RegradeQuestion is the resolver for the regradeQuestion field.
+func (r *mutationResolver) RegradeQuestion(ctx context.Context, questionID uuid.UUID, dryRun *bool) (*model.RegradeResult, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.RegradeQuestion(ctx, userId, isAdminOrOwner, questionID, dryRun != nil && *dryRun)
+}
+
+// RegradeTest is the resolver for the regradeTest field.
+func (r *mutationResolver) RegradeTest(ctx context.Context, testID uuid.UUID, dryRun *bool) (*model.RegradeResult, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.RegradeTest(ctx, userId, isAdminOrOwner, testID, dryRun != nil && *dryRun)
+}
+
+// TestSessionRegrades is the resolver for the testSessionRegrades field.
+func (r *queryResolver) TestSessionRegrades(ctx context.Context, sessionID uuid.UUID) ([]*model.TestSessionRegrade, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	regrades, err := test_session.GetTestSessionRegrades(ctx, userId, isAdminOrOwner, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(regrades, model.ConvertTestSessionRegradeToModel), nil
+}
+
+// User is the resolver for the user field.
+func (r *regradeSessionDeltaResolver) User(ctx context.Context, obj *model.RegradeSessionDelta) (*model.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return dataloader.GetUser(ctx, *obj.UserID)
+}
+
+// RegradeSessionDelta returns RegradeSessionDeltaResolver implementation.
+func (r *Resolver) RegradeSessionDelta() RegradeSessionDeltaResolver {
+	return &regradeSessionDeltaResolver{r}
+}
+
+type regradeSessionDeltaResolver struct{ *Resolver }
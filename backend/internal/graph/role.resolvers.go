@@ -6,11 +6,84 @@ package graph
 
 import (
 	"context"
+	"template/internal/features/permission"
 	"template/internal/features/role"
 	"template/internal/graph/model"
 	"template/internal/shared/utilities/slice"
+
+	"github.com/google/uuid"
 )
 
+// CreateRole is the resolver for the createRole field.
+func (r *mutationResolver) CreateRole(ctx context.Context, input model.CreateRoleInput) (*model.Role, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdRole, err := role.CreateRole(ctx, userId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertRoleToModel(createdRole), nil
+}
+
+// UpdateRole is the resolver for the updateRole field.
+func (r *mutationResolver) UpdateRole(ctx context.Context, id uuid.UUID, input model.UpdateRoleInput) (*model.Role, error) {
+	updatedRole, err := role.UpdateRole(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertRoleToModel(updatedRole), nil
+}
+
+// DeleteRole is the resolver for the deleteRole field.
+func (r *mutationResolver) DeleteRole(ctx context.Context, id uuid.UUID) (bool, error) {
+	return role.DeleteRole(ctx, id)
+}
+
+// AddRolePermissions is the resolver for the addRolePermissions field.
+func (r *mutationResolver) AddRolePermissions(ctx context.Context, roleID uuid.UUID, permissions []permission.Permission) (*model.Role, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedRole, err := role.AddRolePermissions(ctx, userId, roleID, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertRoleToModel(updatedRole), nil
+}
+
+// RemoveRolePermissions is the resolver for the removeRolePermissions field.
+func (r *mutationResolver) RemoveRolePermissions(ctx context.Context, roleID uuid.UUID, permissions []permission.Permission) (*model.Role, error) {
+	updatedRole, err := role.RemoveRolePermissions(ctx, roleID, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertRoleToModel(updatedRole), nil
+}
+
+// SetUserRoles is the resolver for the setUserRoles field.
+func (r *mutationResolver) SetUserRoles(ctx context.Context, userID uuid.UUID, roleIds []uuid.UUID) (*model.User, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := role.SetUserRoles(ctx, userId, userID, roleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertUserToModel(updatedUser), nil
+}
+
 // GetAllRoles is the resolver for the getAllRoles field.
 func (r *queryResolver) GetAllRoles(ctx context.Context) ([]*model.Role, error) {
 	// Call the role feature function to get all roles
@@ -22,3 +95,13 @@ func (r *queryResolver) GetAllRoles(ctx context.Context) ([]*model.Role, error)
 	// Convert ent.Role entities to GraphQL models using the conversion function
 	return slice.Map(roles, model.ConvertRoleToModel), nil
 }
+
+// Role is the resolver for the role field.
+func (r *queryResolver) Role(ctx context.Context, id uuid.UUID) (*model.Role, error) {
+	foundRole, err := role.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertRoleToModel(foundRole), nil
+}
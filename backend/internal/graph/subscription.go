@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"template/internal/ent"
+	"template/internal/graph/model"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// WebsocketInitFunc authenticates a websocket connection with the token sent in its init payload.
+// The token is exposed as the Authorization header of the request so resolvers check permissions as usual.
+func WebsocketInitFunc(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	httpRequest, ok := ctx.Value(RequestKey{}).(*http.Request)
+	if !ok {
+		return ctx, nil, NewUnauthorizedError("could not extract HTTP request from context")
+	}
+
+	authorization := initPayload.Authorization()
+	if authorization != "" {
+		httpRequest = httpRequest.Clone(ctx)
+		httpRequest.Header.Set("Authorization", authorization)
+		ctx = context.WithValue(ctx, RequestKey{}, httpRequest)
+	}
+
+	if _, err := GetUserIdFromRequestContext(ctx); err != nil {
+		return ctx, nil, err
+	}
+
+	return ctx, &initPayload, nil
+}
+
+// convertTestSessionStream converts a stream of test sessions to their model, the stream closes with its source.
+func convertTestSessionStream(ctx context.Context, sessions <-chan *ent.TestSession) <-chan *model.TestSession {
+	models := make(chan *model.TestSession, 1)
+
+	go func() {
+		defer close(models)
+		for session := range sessions {
+			select {
+			case models <- model.ConvertTestSessionToModel(session):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return models
+}
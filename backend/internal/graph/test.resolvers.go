@@ -6,8 +6,9 @@ package graph
 
 import (
 	"context"
-	"template/internal/features/permission"
+	"template/internal/features/role"
 	"template/internal/features/test"
+	"template/internal/features/test_session"
 	"template/internal/graph/dataloader"
 	"template/internal/graph/model"
 
@@ -16,13 +17,17 @@ import (
 
 // CreateTest is the resolver for the createTest field.
 func (r *mutationResolver) CreateTest(ctx context.Context, input model.CreateTestInput) (*model.Test, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	entTest, err := test.CreateTest(ctx, input)
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entTest, err := test.CreateTest(ctx, userId, isAdminOrOwner, input)
 	if err != nil {
 		return nil, err
 	}
@@ -31,13 +36,17 @@ func (r *mutationResolver) CreateTest(ctx context.Context, input model.CreateTes
 
 // UpdateTest is the resolver for the updateTest field.
 func (r *mutationResolver) UpdateTest(ctx context.Context, id uuid.UUID, input model.UpdateTestInput) (*model.Test, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-	entTest, err := test.UpdateTest(ctx, id, input)
+
+	entTest, err := test.UpdateTest(ctx, userId, isAdminOrOwner, id, input)
 	if err != nil {
 		return nil, err
 	}
@@ -46,35 +55,45 @@ func (r *mutationResolver) UpdateTest(ctx context.Context, id uuid.UUID, input m
 
 // DeleteTest is the resolver for the deleteTest field.
 func (r *mutationResolver) DeleteTest(ctx context.Context, id uuid.UUID) (bool, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestDelete,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	return test.DeleteTest(ctx, id)
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return test.DeleteTest(ctx, userId, isAdminOrOwner, id)
 }
 
 // AddMultiCollectionToTest is the resolver for the addMultiCollectionToTest field.
 func (r *mutationResolver) AddMultiCollectionToTest(ctx context.Context, input model.AddMultiCollectionToTestInput) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
 	if err != nil {
 		return false, err
 	}
-	return test.UpdateQuestionCollectionsForTest(ctx, userId, input)
+
+	return test.UpdateQuestionCollectionsForTest(ctx, userId, isAdminOrOwner, input)
 }
 
 // UpdateTestQuestionRequirement is the resolver for the updateTestQuestionRequirement field.
 func (r *mutationResolver) UpdateTestQuestionRequirement(ctx context.Context, testID uuid.UUID, input []*model.UpdateTestQuestionRequirementInput) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
 	// Convert []*model.UpdateTestQuestionRequirementInput to []model.UpdateTestQuestionRequirementInput
 	convertedInput := make([]model.UpdateTestQuestionRequirementInput, len(input))
 	for i, item := range input {
@@ -82,46 +101,70 @@ func (r *mutationResolver) UpdateTestQuestionRequirement(ctx context.Context, te
 			convertedInput[i] = *item
 		}
 	}
-	return test.UpdateTestQuestionRequirement(ctx, userId, testID, convertedInput)
+	return test.UpdateTestQuestionRequirement(ctx, userId, isAdminOrOwner, testID, convertedInput)
 }
 
 // BatchIgnoreQuestions is the resolver for the batchIgnoreQuestions field.
 func (r *mutationResolver) BatchIgnoreQuestions(ctx context.Context, input model.BatchIgnoreQuestionsInput) (bool, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return false, err
 	}
-	return test.BatchIgnoreQuestions(ctx, userId, input)
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return test.BatchIgnoreQuestions(ctx, userId, isAdminOrOwner, input)
 }
 
 // Test is the resolver for the test field.
 func (r *queryResolver) Test(ctx context.Context, id uuid.UUID) (*model.Test, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	entTest, err := test.GetTestByID(ctx, id)
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	entTest, err := test.GetTestByID(ctx, userId, isAdminOrOwner, id)
 	if err != nil {
 		return nil, err
 	}
 	return model.ConvertTestToModel(entTest), nil
 }
 
+// UserTestAttempts is the resolver for the userTestAttempts field.
+func (r *queryResolver) UserTestAttempts(ctx context.Context, testID uuid.UUID, userID uuid.UUID) (*model.UserTestAttempts, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.GetUserTestAttempts(ctx, userId, isAdminOrOwner, testID, userID)
+}
+
 // PaginatedTests is the resolver for the paginatedTests field.
 func (r *queryResolver) PaginatedTests(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedTest, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.TestRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
-	paginated, err := test.PaginatedTests(ctx, paginationInput)
+	paginated, err := test.PaginatedTests(ctx, userId, isAdminOrOwner, paginationInput)
 	if err != nil {
 		return nil, err
 	}
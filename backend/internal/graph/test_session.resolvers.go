@@ -7,7 +7,6 @@ package graph
 import (
 	"context"
 	"fmt"
-	"template/internal/features/permission"
 	"template/internal/features/role"
 	"template/internal/features/test_session"
 	"template/internal/graph/dataloader"
@@ -19,9 +18,7 @@ import (
 
 // CreateTestSession is the resolver for the createTestSession field.
 func (r *mutationResolver) CreateTestSession(ctx context.Context, input model.CreateTestSessionInput) ([]*model.TestSession, error) {
-	userID, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionCreate,
-	})
+	userID, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -42,21 +39,12 @@ func (r *mutationResolver) CreateTestSession(ctx context.Context, input model.Cr
 
 // DeleteTestSession is the resolver for the deleteTestSession field.
 func (r *mutationResolver) DeleteTestSession(ctx context.Context, id uuid.UUID) (bool, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionDelete,
-	})
-	if err != nil {
-		return false, err
-	}
-
 	return test_session.DeleteTestSession(ctx, id)
 }
 
 // SubmitTestSession is the resolver for the submitTestSession field.
 func (r *mutationResolver) SubmitTestSession(ctx context.Context, sessionID uuid.UUID, input model.SubmitTestSessionInput) (*model.TestSession, error) {
-	userID, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionUpdate,
-	})
+	userID, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -73,9 +61,7 @@ func (r *mutationResolver) SubmitTestSession(ctx context.Context, sessionID uuid
 
 // StartTestSession is the resolver for the startTestSession field.
 func (r *mutationResolver) StartTestSession(ctx context.Context, id uuid.UUID) (*model.TestSession, error) {
-	userID, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionUpdate,
-	})
+	userID, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -90,15 +76,98 @@ func (r *mutationResolver) StartTestSession(ctx context.Context, id uuid.UUID) (
 	return model.ConvertTestSessionToModel(session), nil
 }
 
-// TestSession is the resolver for the testSession field.
-func (r *queryResolver) TestSession(ctx context.Context, id uuid.UUID) (*model.TestSession, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionRead,
-	})
+// SaveTestSessionAnswer is the resolver for the saveTestSessionAnswer field.
+func (r *mutationResolver) SaveTestSessionAnswer(ctx context.Context, sessionID uuid.UUID, input model.TestSessionAnswerInput) (bool, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return test_session.SaveTestSessionAnswer(ctx, userId, sessionID, input)
+}
+
+// ReportIntegrityEvent is the resolver for the reportIntegrityEvent field.
+func (r *mutationResolver) ReportIntegrityEvent(ctx context.Context, sessionID uuid.UUID, input model.ReportIntegrityEventInput) (*model.TestSessionIntegrityEvent, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := test_session.ReportIntegrityEvent(ctx, userId, sessionID, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertTestSessionIntegrityEventToModel(event), nil
+}
+
+// ExtendTestSession is the resolver for the extendTestSession field.
+func (r *mutationResolver) ExtendTestSession(ctx context.Context, sessionID uuid.UUID, minutes int, reason string) (*model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := test_session.ExtendTestSession(ctx, userId, isAdminOrOwner, sessionID, minutes, reason)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertTestSessionToModel(session), nil
+}
+
+// ForceSubmitTestSession is the resolver for the forceSubmitTestSession field.
+func (r *mutationResolver) ForceSubmitTestSession(ctx context.Context, sessionID uuid.UUID) (*model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := test_session.ForceSubmitTestSession(ctx, userId, isAdminOrOwner, sessionID)
 	if err != nil {
 		return nil, err
 	}
+	return model.ConvertTestSessionToModel(session), nil
+}
 
+// PauseTestSession is the resolver for the pauseTestSession field.
+func (r *mutationResolver) PauseTestSession(ctx context.Context, sessionID uuid.UUID) (*model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := test_session.PauseTestSession(ctx, userId, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertTestSessionToModel(session), nil
+}
+
+// ResumeTestSession is the resolver for the resumeTestSession field.
+func (r *mutationResolver) ResumeTestSession(ctx context.Context, sessionID uuid.UUID) (*model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := test_session.ResumeTestSession(ctx, userId, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConvertTestSessionToModel(session), nil
+}
+
+// TestSession is the resolver for the testSession field.
+func (r *queryResolver) TestSession(ctx context.Context, id uuid.UUID) (*model.TestSession, error) {
 	session, err := test_session.GetTestSessionByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -108,9 +177,7 @@ func (r *queryResolver) TestSession(ctx context.Context, id uuid.UUID) (*model.T
 
 // PaginatedTestSessions is the resolver for the paginatedTestSessions field.
 func (r *queryResolver) PaginatedTestSessions(ctx context.Context, paginationInput *model.PaginationInput, filterInput *model.TestSessionFilterInput) (*model.PaginatedTestSession, error) {
-	userId, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionRead,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -143,9 +210,7 @@ func (r *queryResolver) PaginatedTestSessions(ctx context.Context, paginationInp
 
 // TestSessionResult is the resolver for the testSessionResult field.
 func (r *queryResolver) TestSessionResult(ctx context.Context, id uuid.UUID) (*model.TestSessionResult, error) {
-	userID, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.SessionRead,
-	})
+	userID, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +230,21 @@ func (r *queryResolver) TestSessionResult(ctx context.Context, id uuid.UUID) (*m
 	return result, nil
 }
 
+// TestLiveStatus is the resolver for the testLiveStatus field.
+func (r *queryResolver) TestLiveStatus(ctx context.Context, testID uuid.UUID) ([]*model.TestSessionLiveStatus, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.GetTestLiveStatus(ctx, userId, isAdminOrOwner, testID)
+}
+
 // Question is the resolver for the question field.
 func (r *questionResultResolver) Question(ctx context.Context, obj *model.QuestionResult) (*model.Question, error) {
 	// Get questionID from the QuestionResult (stored during GetTestSessionResult)
@@ -174,6 +254,59 @@ func (r *questionResultResolver) Question(ctx context.Context, obj *model.Questi
 	return nil, fmt.Errorf("question ID not found in QuestionResult")
 }
 
+// TestSessionTimer is the resolver for the testSessionTimer field.
+func (r *subscriptionResolver) TestSessionTimer(ctx context.Context, sessionID uuid.UUID) (<-chan *model.TestSessionTimer, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.WatchTestSessionTimer(ctx, userId, isAdminOrOwner, sessionID)
+}
+
+// TestSessionStatusChanged is the resolver for the testSessionStatusChanged field.
+func (r *subscriptionResolver) TestSessionStatusChanged(ctx context.Context, testID *uuid.UUID) (<-chan *model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := test_session.WatchTestSessions(ctx, userId, isAdminOrOwner, testID, false)
+	if err != nil {
+		return nil, err
+	}
+	return convertTestSessionStream(ctx, sessions), nil
+}
+
+// SessionSubmitted is the resolver for the sessionSubmitted field.
+func (r *subscriptionResolver) SessionSubmitted(ctx context.Context, testID uuid.UUID) (<-chan *model.TestSession, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := test_session.WatchTestSessions(ctx, userId, isAdminOrOwner, &testID, true)
+	if err != nil {
+		return nil, err
+	}
+	return convertTestSessionStream(ctx, sessions), nil
+}
+
 // Test is the resolver for the test field.
 func (r *testSessionResolver) Test(ctx context.Context, obj *model.TestSession) (*model.Test, error) {
 	return dataloader.GetTest(ctx, obj.TestID)
@@ -197,11 +330,44 @@ func (r *testSessionResolver) OrderedQuestions(ctx context.Context, obj *model.T
 	return dataloader.GetOrderedQuestionsBySessionID(ctx, obj.ID)
 }
 
+// CandidateQuestions is the resolver for the candidateQuestions field.
+func (r *testSessionResolver) CandidateQuestions(ctx context.Context, obj *model.TestSession) ([]*model.CandidateQuestion, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return test_session.GetCandidateQuestions(ctx, userId, isAdminOrOwner, obj.ID)
+}
+
+// User is the resolver for the user field.
+func (r *testSessionLiveStatusResolver) User(ctx context.Context, obj *model.TestSessionLiveStatus) (*model.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return dataloader.GetUser(ctx, *obj.UserID)
+}
+
 // QuestionResult returns QuestionResultResolver implementation.
 func (r *Resolver) QuestionResult() QuestionResultResolver { return &questionResultResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // TestSession returns TestSessionResolver implementation.
 func (r *Resolver) TestSession() TestSessionResolver { return &testSessionResolver{r} }
 
+// TestSessionLiveStatus returns TestSessionLiveStatusResolver implementation.
+func (r *Resolver) TestSessionLiveStatus() TestSessionLiveStatusResolver {
+	return &testSessionLiveStatusResolver{r}
+}
+
 type questionResultResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type testSessionResolver struct{ *Resolver }
+type testSessionLiveStatusResolver struct{ *Resolver }
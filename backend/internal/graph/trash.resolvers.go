@@ -0,0 +1,37 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.57
+
+import (
+	"context"
+	"template/internal/features/trash"
+	"template/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// Restore is the resolver for the restore field.
+func (r *mutationResolver) Restore(ctx context.Context, typeArg model.TrashEntityType, id uuid.UUID) (bool, error) {
+	return trash.Restore(ctx, typeArg, id)
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context, typeArg model.TrashEntityType, paginationInput *model.PaginationInput) (*model.PaginatedTrashItem, error) {
+	paginatedTrash, err := trash.PaginatedTrash(ctx, typeArg, paginationInput)
+	if err != nil {
+		return nil, err
+	}
+	pagination := &model.Pagination{
+		CurrentPage:     paginatedTrash.CurrentPage,
+		TotalPages:      paginatedTrash.TotalPages,
+		TotalItems:      paginatedTrash.TotalItems,
+		HasNextPage:     paginatedTrash.HasNextPage,
+		HasPreviousPage: paginatedTrash.HasPrevPage,
+	}
+	return &model.PaginatedTrashItem{
+		Pagination: pagination,
+		Items:      paginatedTrash.Items,
+	}, nil
+}
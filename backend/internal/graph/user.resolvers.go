@@ -7,6 +7,7 @@ package graph
 import (
 	"context"
 	"template/internal/features/permission"
+	"template/internal/features/role"
 	"template/internal/features/user"
 	"template/internal/graph/dataloader"
 	"template/internal/graph/model"
@@ -17,15 +18,13 @@ import (
 
 // AdminCreateUser is the resolver for the adminCreateUser field.
 func (r *mutationResolver) AdminCreateUser(ctx context.Context, input model.AdminCreateUserInput) (*model.User, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.UserCreate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create the user
-	newUser, err := user.AdminCreateUser(ctx, input)
+	newUser, err := user.AdminCreateUser(ctx, userId, input)
 	if err != nil {
 		return nil, err
 	}
@@ -36,15 +35,13 @@ func (r *mutationResolver) AdminCreateUser(ctx context.Context, input model.Admi
 
 // AdminEditUser is the resolver for the adminEditUser field.
 func (r *mutationResolver) AdminEditUser(ctx context.Context, id uuid.UUID, input model.AdminEditUserInput) (*model.User, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.UserUpdate,
-	})
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Update the user
-	updatedUser, err := user.AdminUpdateUser(ctx, id, input)
+	updatedUser, err := user.AdminUpdateUser(ctx, userId, id, input)
 	if err != nil {
 		return nil, err
 	}
@@ -53,15 +50,38 @@ func (r *mutationResolver) AdminEditUser(ctx context.Context, id uuid.UUID, inpu
 	return model.ConvertUserToModel(updatedUser), nil
 }
 
-// PaginatedUsers is the resolver for the paginatedUsers field.
-func (r *queryResolver) PaginatedUsers(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedUser, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.UserRead,
-	})
+// ImportUsers is the resolver for the importUsers field.
+func (r *mutationResolver) ImportUsers(ctx context.Context, input model.ImportUsersInput) (*model.UserImportReport, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdminOrOwner, err := role.IsAdminOrOwner(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ImportUsers(ctx, userId, isAdminOrOwner, input)
+}
+
+// UpdateMyProfile is the resolver for the updateMyProfile field.
+func (r *mutationResolver) UpdateMyProfile(ctx context.Context, input model.UpdateMyProfileInput) (*model.User, error) {
+	userId, err := GetUserIdFromRequestContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	updatedUser, err := user.UpdateMyProfile(ctx, userId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertUserToModel(updatedUser), nil
+}
+
+// PaginatedUsers is the resolver for the paginatedUsers field.
+func (r *queryResolver) PaginatedUsers(ctx context.Context, paginationInput *model.PaginationInput) (*model.PaginatedUser, error) {
 	result, err := user.PaginatedUsers(ctx, paginationInput)
 	if err != nil {
 		return nil, err
@@ -82,15 +102,13 @@ func (r *queryResolver) PaginatedUsers(ctx context.Context, paginationInput *mod
 	}, nil
 }
 
+// ExportUsers is the resolver for the exportUsers field.
+func (r *queryResolver) ExportUsers(ctx context.Context) (string, error) {
+	return user.ExportUsers(ctx)
+}
+
 // GetUserByID is the resolver for the getUserById field.
 func (r *queryResolver) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
-	_, err := CheckUserPermissions(ctx, []permission.Permission{
-		permission.UserRead,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	// Fetch the user by ID
 	foundUser, err := user.GetUserByID(ctx, id)
 	if err != nil {
@@ -101,6 +119,24 @@ func (r *queryResolver) GetUserByID(ctx context.Context, id uuid.UUID) (*model.U
 	return model.ConvertUserToModel(foundUser), nil
 }
 
+// IsSystem is the resolver for the isSystem field.
+func (r *roleResolver) IsSystem(ctx context.Context, obj *model.Role) (bool, error) {
+	return role.IsSystemRole(obj.Name), nil
+}
+
+// Permissions is the resolver for the permissions field.
+func (r *roleResolver) Permissions(ctx context.Context, obj *model.Role) ([]permission.Permission, error) {
+	return dataloader.GetPermissionsByRoleID(ctx, obj.ID)
+}
+
+// Organization is the resolver for the organization field.
+func (r *userResolver) Organization(ctx context.Context, obj *model.User) (*model.Organization, error) {
+	if obj.OrganizationID == nil {
+		return nil, nil
+	}
+	return dataloader.GetOrganization(ctx, *obj.OrganizationID)
+}
+
 // Roles is the resolver for the roles field.
 func (r *userResolver) Roles(ctx context.Context, obj *model.User) ([]*model.Role, error) {
 	return dataloader.GetRolesByUserID(ctx, obj.ID)
@@ -111,7 +147,11 @@ func (r *userResolver) Permissions(ctx context.Context, obj *model.User) ([]perm
 	return dataloader.GetPermissionsByUserID(ctx, obj.ID)
 }
 
+// Role returns RoleResolver implementation.
+func (r *Resolver) Role() RoleResolver { return &roleResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
+type roleResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
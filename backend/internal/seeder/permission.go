@@ -174,6 +174,62 @@ func GetDefaultPermissions() []PermissionData {
 			Name:        string(permissionFeat.MediaDelete),
 			Description: pointer.From("Delete media file information"),
 		},
+		{
+			Name:        string(permissionFeat.RoleCreate),
+			Description: pointer.From("Create a new role"),
+		},
+		{
+			Name:        string(permissionFeat.RoleRead),
+			Description: pointer.From("Read role information"),
+		},
+		{
+			Name:        string(permissionFeat.RoleUpdate),
+			Description: pointer.From("Update role information and assign roles to users"),
+		},
+		{
+			Name:        string(permissionFeat.RoleDelete),
+			Description: pointer.From("Delete a role"),
+		},
+		{
+			Name:        string(permissionFeat.GroupCreate),
+			Description: pointer.From("Create a new group of users"),
+		},
+		{
+			Name:        string(permissionFeat.GroupRead),
+			Description: pointer.From("Read group information and members"),
+		},
+		{
+			Name:        string(permissionFeat.GroupUpdate),
+			Description: pointer.From("Update group information and members"),
+		},
+		{
+			Name:        string(permissionFeat.GroupDelete),
+			Description: pointer.From("Delete a group"),
+		},
+		{
+			Name:        string(permissionFeat.OrganizationCreate),
+			Description: pointer.From("Create a new organization"),
+		},
+		{
+			Name:        string(permissionFeat.OrganizationRead),
+			Description: pointer.From("Read the information of every organization"),
+		},
+		{
+			Name:        string(permissionFeat.OrganizationUpdate),
+			Description: pointer.From("Update an organization and assign its users and admins"),
+		},
+		{
+			Name:        string(permissionFeat.OrganizationDelete),
+			Description: pointer.From("Delete an organization"),
+		},
+		{
+			Name:        string(permissionFeat.TrashRead),
+			Description: pointer.From("List the deleted courses, tests, question collections, questions and groups"),
+		},
+		{
+			Name:        string(permissionFeat.TrashRestore),
+			Description: pointer.From("Restore a deleted item with the items deleted along with it"),
+		},
 	}
 }
 
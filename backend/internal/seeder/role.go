@@ -78,6 +78,13 @@ func GetDefaultRoles() []RoleData {
 				string(permissionFeat.MediaRead),
 				string(permissionFeat.MediaUpdate),
 				string(permissionFeat.MediaDelete),
+				string(permissionFeat.RoleRead),
+				string(permissionFeat.GroupCreate),
+				string(permissionFeat.GroupRead),
+				string(permissionFeat.GroupUpdate),
+				string(permissionFeat.GroupDelete),
+				string(permissionFeat.TrashRead),
+				string(permissionFeat.TrashRestore),
 			},
 		},
 		{
@@ -86,6 +93,7 @@ func GetDefaultRoles() []RoleData {
 			Permissions: []string{
 				string(permissionFeat.SessionRead),
 				string(permissionFeat.SessionUpdate),
+				string(permissionFeat.CourseRead),
 			},
 		},
 	}
@@ -2,7 +2,10 @@ package environment
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -18,6 +21,19 @@ var JWT_SECRET string
 var JWT_REFRESH_SECRET string
 var DEBUG string
 
+// ALLOWED_ORIGINS are the browser origins allowed to call the API, read from a comma separated list.
+// When empty every origin is allowed for HTTP requests and only the same origin for websockets.
+var ALLOWED_ORIGINS []string
+
+// PERMISSION_CACHE_TTL is how long the roles and permissions of a user are cached across requests, 0 disables the cache
+var PERMISSION_CACHE_TTL time.Duration
+
+// TRASH_RETENTION is how long the deleted items stay in the trash before being purged, 0 keeps them
+var TRASH_RETENTION time.Duration
+
+// defaultTrashRetention is the retention of the trash when TRASH_RETENTION isn't set.
+const defaultTrashRetention = 30 * 24 * time.Hour
+
 func LoadEnvironment(filename ...string) error {
 	err := godotenv.Load(filename...)
 
@@ -38,9 +54,32 @@ func LoadEnvironment(filename ...string) error {
 	JWT_SECRET = os.Getenv("JWT_SECRET")
 	JWT_REFRESH_SECRET = os.Getenv("JWT_REFRESH_SECRET")
 	DEBUG = os.Getenv("DEBUG")
+	ALLOWED_ORIGINS = []string{}
+	for _, origin := range strings.Split(os.Getenv("ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			ALLOWED_ORIGINS = append(ALLOWED_ORIGINS, origin)
+		}
+	}
+	PERMISSION_CACHE_TTL = parseDuration("PERMISSION_CACHE_TTL", 0)
+	TRASH_RETENTION = parseDuration("TRASH_RETENTION", defaultTrashRetention)
 	return err
 }
 
+// parseDuration reads a duration variable, falling back to the default when it is empty or invalid.
+func parseDuration(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid %s %q, falling back to %s: %v", name, value, fallback, err)
+		return fallback
+	}
+	return duration
+}
+
 func IsDebug() bool {
 	return DEBUG == "true"
 }
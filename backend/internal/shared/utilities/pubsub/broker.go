@@ -0,0 +1,55 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriberBufferSize is the number of events buffered per subscriber before new events are dropped.
+const subscriberBufferSize = 16
+
+// Broker is an in-process publish/subscribe hub. Every subscriber receives every published event.
+type Broker[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[chan T]struct{}
+}
+
+// NewBroker creates an empty broker.
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{
+		subscribers: make(map[chan T]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber. The returned channel is closed once ctx is done.
+func (b *Broker[T]) Subscribe(ctx context.Context) <-chan T {
+	ch := make(chan T, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Publish sends the event to every subscriber without blocking.
+// Slow subscribers whose buffer is full miss the event.
+func (b *Broker[T]) Publish(event T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBroker_PublishToAllSubscribers(t *testing.T) {
+	broker := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := broker.Subscribe(ctx)
+	second := broker.Subscribe(ctx)
+
+	broker.Publish(42)
+
+	for _, ch := range []<-chan int{first, second} {
+		select {
+		case got := <-ch:
+			if got != 42 {
+				t.Errorf("expected 42, got %d", got)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("subscriber did not receive the event")
+		}
+	}
+}
+
+func TestBroker_UnsubscribeOnContextDone(t *testing.T) {
+	broker := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch := broker.Subscribe(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("expected the channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed after the context was done")
+	}
+
+	// Publishing after unsubscribing must not panic or block
+	broker.Publish(1)
+}
+
+func TestBroker_PublishDoesNotBlockOnFullSubscriber(t *testing.T) {
+	broker := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker.Subscribe(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < subscriberBufferSize*2; i++ {
+			broker.Publish(i)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked on a full subscriber")
+	}
+}
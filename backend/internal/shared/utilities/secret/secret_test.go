@@ -0,0 +1,34 @@
+package secret
+
+import "testing"
+
+func TestNewToken(t *testing.T) {
+	first, err := NewToken(16)
+	if err != nil {
+		t.Fatalf("NewToken returned an error: %v", err)
+	}
+	second, err := NewToken(16)
+	if err != nil {
+		t.Fatalf("NewToken returned an error: %v", err)
+	}
+
+	if len(first) != 22 {
+		t.Errorf("Expected a 22 characters token for 16 bytes, got %d", len(first))
+	}
+	if first == second {
+		t.Error("Expected two different tokens")
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	hash := HashToken("token")
+	if hash != HashToken("token") {
+		t.Error("Expected the same hash for the same token")
+	}
+	if hash == HashToken("other") {
+		t.Error("Expected different hashes for different tokens")
+	}
+	if len(hash) != 64 {
+		t.Errorf("Expected a 64 characters hex hash, got %d", len(hash))
+	}
+}
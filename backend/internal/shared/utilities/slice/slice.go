@@ -45,6 +45,14 @@ func Find[T any](slice []T, fn func(T) bool) *T {
 	return nil
 }
 
+func FindValue[T any](slice []T, fn func(T) bool) T {
+	if v := Find(slice, fn); v != nil {
+		return *v
+	}
+	var zero T
+	return zero
+}
+
 func Contains[T comparable](slice []T, value T) bool {
 	v := Find(slice, func(v T) bool {
 		return v == value